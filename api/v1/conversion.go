@@ -0,0 +1,1850 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// SparkApplication implements conversion.Convertible.
+var _ conversion.Convertible = &SparkApplication{}
+
+// ScheduledSparkApplication implements conversion.Convertible.
+var _ conversion.Convertible = &ScheduledSparkApplication{}
+
+// ConvertTo converts this SparkApplication to the hub version (v1beta2).
+func (src *SparkApplication) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta2.SparkApplication)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	convertSparkApplicationSpecToHub(&src.Spec, &dst.Spec)
+	convertSparkApplicationStatusToHub(&src.Status, &dst.Status)
+	return convertResourcesToHub(&src.Spec, &dst.Spec, &dst.ObjectMeta)
+}
+
+// ConvertFrom converts from the hub version (v1beta2) to this SparkApplication.
+func (dst *SparkApplication) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta2.SparkApplication)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	convertSparkApplicationSpecFromHub(&src.Spec, &dst.Spec)
+	convertSparkApplicationStatusFromHub(&src.Status, &dst.Status)
+	return convertResourcesFromHub(&src.Spec, &dst.Spec, &dst.ObjectMeta)
+}
+
+// ConvertTo converts this ScheduledSparkApplication to the hub version (v1beta2).
+func (src *ScheduledSparkApplication) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta2.ScheduledSparkApplication)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	convertScheduledSparkApplicationSpecToHub(&src.Spec, &dst.Spec)
+	convertScheduledSparkApplicationStatusToHub(&src.Status, &dst.Status)
+	return convertResourcesToHub(&src.Spec.Template, &dst.Spec.Template, &dst.ObjectMeta)
+}
+
+// ConvertFrom converts from the hub version (v1beta2) to this ScheduledSparkApplication.
+func (dst *ScheduledSparkApplication) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta2.ScheduledSparkApplication)
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	convertScheduledSparkApplicationSpecFromHub(&src.Spec, &dst.Spec)
+	convertScheduledSparkApplicationStatusFromHub(&src.Status, &dst.Status)
+	return convertResourcesFromHub(&src.Spec.Template, &dst.Spec.Template, &dst.ObjectMeta)
+}
+
+// The functions below convert the fields that are identical in both versions. The driver and executor
+// resources differ between the versions and are converted separately, see convertResourcesToHub and convertResourcesFromHub.
+
+func convertApplicationStateToHub(in *ApplicationState, out *v1beta2.ApplicationState) {
+	out.State = v1beta2.ApplicationStateType(in.State)
+	out.ErrorMessage = in.ErrorMessage
+}
+
+func convertApplicationStateFromHub(in *v1beta2.ApplicationState, out *ApplicationState) {
+	out.State = ApplicationStateType(in.State)
+	out.ErrorMessage = in.ErrorMessage
+}
+
+func convertBatchSchedulerConfigurationToHub(in *BatchSchedulerConfiguration, out *v1beta2.BatchSchedulerConfiguration) {
+	out.Queue = in.Queue
+	out.PriorityClassName = in.PriorityClassName
+	out.Resources = in.Resources
+}
+
+func convertBatchSchedulerConfigurationFromHub(in *v1beta2.BatchSchedulerConfiguration, out *BatchSchedulerConfiguration) {
+	out.Queue = in.Queue
+	out.PriorityClassName = in.PriorityClassName
+	out.Resources = in.Resources
+}
+
+func convertCancellationStatusToHub(in *CancellationStatus, out *v1beta2.CancellationStatus) {
+	out.CancelledBy = in.CancelledBy
+	out.Reason = in.Reason
+	out.CancellationTime = in.CancellationTime
+}
+
+func convertCancellationStatusFromHub(in *v1beta2.CancellationStatus, out *CancellationStatus) {
+	out.CancelledBy = in.CancelledBy
+	out.Reason = in.Reason
+	out.CancellationTime = in.CancellationTime
+}
+
+func convertDependenciesToHub(in *Dependencies, out *v1beta2.Dependencies) {
+	out.Jars = in.Jars
+	out.Files = in.Files
+	out.PyFiles = in.PyFiles
+	out.Packages = in.Packages
+	out.ExcludePackages = in.ExcludePackages
+	out.Repositories = in.Repositories
+	out.Archives = in.Archives
+}
+
+func convertDependenciesFromHub(in *v1beta2.Dependencies, out *Dependencies) {
+	out.Jars = in.Jars
+	out.Files = in.Files
+	out.PyFiles = in.PyFiles
+	out.Packages = in.Packages
+	out.ExcludePackages = in.ExcludePackages
+	out.Repositories = in.Repositories
+	out.Archives = in.Archives
+}
+
+func convertDriverInfoToHub(in *DriverInfo, out *v1beta2.DriverInfo) {
+	out.WebUIServiceName = in.WebUIServiceName
+	out.WebUIAddress = in.WebUIAddress
+	out.WebUIPort = in.WebUIPort
+	out.WebUIIngressName = in.WebUIIngressName
+	out.WebUIIngressAddress = in.WebUIIngressAddress
+	out.PodName = in.PodName
+	out.ServiceName = in.ServiceName
+	if in.ServiceEndpoints != nil {
+		out.ServiceEndpoints = make([]v1beta2.DriverServiceEndpoint, len(in.ServiceEndpoints))
+		for i := range in.ServiceEndpoints {
+			convertDriverServiceEndpointToHub(&in.ServiceEndpoints[i], &out.ServiceEndpoints[i])
+		}
+	}
+	out.Zone = in.Zone
+}
+
+func convertDriverInfoFromHub(in *v1beta2.DriverInfo, out *DriverInfo) {
+	out.WebUIServiceName = in.WebUIServiceName
+	out.WebUIAddress = in.WebUIAddress
+	out.WebUIPort = in.WebUIPort
+	out.WebUIIngressName = in.WebUIIngressName
+	out.WebUIIngressAddress = in.WebUIIngressAddress
+	out.PodName = in.PodName
+	out.ServiceName = in.ServiceName
+	if in.ServiceEndpoints != nil {
+		out.ServiceEndpoints = make([]DriverServiceEndpoint, len(in.ServiceEndpoints))
+		for i := range in.ServiceEndpoints {
+			convertDriverServiceEndpointFromHub(&in.ServiceEndpoints[i], &out.ServiceEndpoints[i])
+		}
+	}
+	out.Zone = in.Zone
+}
+
+func convertDriverIngressConfigurationToHub(in *DriverIngressConfiguration, out *v1beta2.DriverIngressConfiguration) {
+	out.ServicePort = in.ServicePort
+	out.ServicePortName = in.ServicePortName
+	out.ServiceType = in.ServiceType
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	out.IngressURLFormat = in.IngressURLFormat
+	out.IngressAnnotations = in.IngressAnnotations
+	out.IngressTLS = in.IngressTLS
+}
+
+func convertDriverIngressConfigurationFromHub(in *v1beta2.DriverIngressConfiguration, out *DriverIngressConfiguration) {
+	out.ServicePort = in.ServicePort
+	out.ServicePortName = in.ServicePortName
+	out.ServiceType = in.ServiceType
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	out.IngressURLFormat = in.IngressURLFormat
+	out.IngressAnnotations = in.IngressAnnotations
+	out.IngressTLS = in.IngressTLS
+}
+
+func convertDriverSpecToHub(in *DriverSpec, out *v1beta2.DriverSpec) {
+	convertSparkPodSpecToHub(&in.SparkPodSpec, &out.SparkPodSpec)
+	out.PodName = in.PodName
+	out.JavaOptions = in.JavaOptions
+	out.Lifecycle = in.Lifecycle
+	out.KubernetesMaster = in.KubernetesMaster
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	if in.Ports != nil {
+		out.Ports = make([]v1beta2.Port, len(in.Ports))
+		for i := range in.Ports {
+			convertPortToHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.UI != nil {
+		out.UI = new(v1beta2.DriverUISpec)
+		convertDriverUISpecToHub(in.UI, out.UI)
+	}
+	if in.Diagnostics != nil {
+		out.Diagnostics = new(v1beta2.DriverDiagnostics)
+		convertDriverDiagnosticsToHub(in.Diagnostics, out.Diagnostics)
+	}
+	if in.Service != nil {
+		out.Service = new(v1beta2.DriverServiceSpec)
+		convertDriverServiceSpecToHub(in.Service, out.Service)
+	}
+	if in.HeadlessService != nil {
+		out.HeadlessService = new(v1beta2.DriverHeadlessServiceSpec)
+		convertDriverHeadlessServiceSpecToHub(in.HeadlessService, out.HeadlessService)
+	}
+}
+
+func convertDriverSpecFromHub(in *v1beta2.DriverSpec, out *DriverSpec) {
+	convertSparkPodSpecFromHub(&in.SparkPodSpec, &out.SparkPodSpec)
+	out.PodName = in.PodName
+	out.JavaOptions = in.JavaOptions
+	out.Lifecycle = in.Lifecycle
+	out.KubernetesMaster = in.KubernetesMaster
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	if in.Ports != nil {
+		out.Ports = make([]Port, len(in.Ports))
+		for i := range in.Ports {
+			convertPortFromHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.UI != nil {
+		out.UI = new(DriverUISpec)
+		convertDriverUISpecFromHub(in.UI, out.UI)
+	}
+	if in.Diagnostics != nil {
+		out.Diagnostics = new(DriverDiagnostics)
+		convertDriverDiagnosticsFromHub(in.Diagnostics, out.Diagnostics)
+	}
+	if in.Service != nil {
+		out.Service = new(DriverServiceSpec)
+		convertDriverServiceSpecFromHub(in.Service, out.Service)
+	}
+	if in.HeadlessService != nil {
+		out.HeadlessService = new(DriverHeadlessServiceSpec)
+		convertDriverHeadlessServiceSpecFromHub(in.HeadlessService, out.HeadlessService)
+	}
+}
+
+func convertDriverServiceSpecToHub(in *DriverServiceSpec, out *v1beta2.DriverServiceSpec) {
+	out.Type = v1beta2.DriverServiceType(in.Type)
+	if in.Ports != nil {
+		out.Ports = make([]v1beta2.DriverServicePort, len(in.Ports))
+		for i := range in.Ports {
+			convertDriverServicePortToHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.Annotations = in.Annotations
+	out.Labels = in.Labels
+}
+
+func convertDriverServiceSpecFromHub(in *v1beta2.DriverServiceSpec, out *DriverServiceSpec) {
+	out.Type = DriverServiceType(in.Type)
+	if in.Ports != nil {
+		out.Ports = make([]DriverServicePort, len(in.Ports))
+		for i := range in.Ports {
+			convertDriverServicePortFromHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.Annotations = in.Annotations
+	out.Labels = in.Labels
+}
+
+func convertDriverServicePortToHub(in *DriverServicePort, out *v1beta2.DriverServicePort) {
+	out.Name = in.Name
+	out.Port = in.Port
+	out.TargetPort = in.TargetPort
+	out.Protocol = in.Protocol
+	out.NodePort = in.NodePort
+}
+
+func convertDriverServicePortFromHub(in *v1beta2.DriverServicePort, out *DriverServicePort) {
+	out.Name = in.Name
+	out.Port = in.Port
+	out.TargetPort = in.TargetPort
+	out.Protocol = in.Protocol
+	out.NodePort = in.NodePort
+}
+
+func convertDriverHeadlessServiceSpecToHub(in *DriverHeadlessServiceSpec, out *v1beta2.DriverHeadlessServiceSpec) {
+	out.Name = in.Name
+	out.PublishNotReadyAddresses = in.PublishNotReadyAddresses
+}
+
+func convertDriverHeadlessServiceSpecFromHub(in *v1beta2.DriverHeadlessServiceSpec, out *DriverHeadlessServiceSpec) {
+	out.Name = in.Name
+	out.PublishNotReadyAddresses = in.PublishNotReadyAddresses
+}
+
+func convertDriverServiceEndpointToHub(in *DriverServiceEndpoint, out *v1beta2.DriverServiceEndpoint) {
+	out.Name = in.Name
+	out.Address = in.Address
+	out.NodePort = in.NodePort
+	out.ExternalAddress = in.ExternalAddress
+}
+
+func convertDriverServiceEndpointFromHub(in *v1beta2.DriverServiceEndpoint, out *DriverServiceEndpoint) {
+	out.Name = in.Name
+	out.Address = in.Address
+	out.NodePort = in.NodePort
+	out.ExternalAddress = in.ExternalAddress
+}
+
+func convertDriverUISpecToHub(in *DriverUISpec, out *v1beta2.DriverUISpec) {
+	out.Enabled = in.Enabled
+}
+
+func convertDriverUISpecFromHub(in *v1beta2.DriverUISpec, out *DriverUISpec) {
+	out.Enabled = in.Enabled
+}
+
+func convertDriverDiagnosticsToHub(in *DriverDiagnostics, out *v1beta2.DriverDiagnostics) {
+	out.HeapDumpOnOutOfMemoryError = in.HeapDumpOnOutOfMemoryError
+	out.ClaimName = in.ClaimName
+	out.SizeLimit = in.SizeLimit
+	out.Collect = in.Collect
+}
+
+func convertDriverDiagnosticsFromHub(in *v1beta2.DriverDiagnostics, out *DriverDiagnostics) {
+	out.HeapDumpOnOutOfMemoryError = in.HeapDumpOnOutOfMemoryError
+	out.ClaimName = in.ClaimName
+	out.SizeLimit = in.SizeLimit
+	out.Collect = in.Collect
+}
+
+func convertDryRunStatusToHub(in *DryRunStatus, out *v1beta2.DryRunStatus) {
+	out.ConfigMapName = in.ConfigMapName
+	out.ObservedGeneration = in.ObservedGeneration
+	out.RenderTime = in.RenderTime
+	out.ErrorMessage = in.ErrorMessage
+}
+
+func convertDryRunStatusFromHub(in *v1beta2.DryRunStatus, out *DryRunStatus) {
+	out.ConfigMapName = in.ConfigMapName
+	out.ObservedGeneration = in.ObservedGeneration
+	out.RenderTime = in.RenderTime
+	out.ErrorMessage = in.ErrorMessage
+}
+
+func convertDynamicAllocationToHub(in *DynamicAllocation, out *v1beta2.DynamicAllocation) {
+	out.Enabled = in.Enabled
+	out.InitialExecutors = in.InitialExecutors
+	out.MinExecutors = in.MinExecutors
+	out.MaxExecutors = in.MaxExecutors
+	out.ShuffleTrackingEnabled = in.ShuffleTrackingEnabled
+	out.ShuffleTrackingTimeout = in.ShuffleTrackingTimeout
+}
+
+func convertDynamicAllocationFromHub(in *v1beta2.DynamicAllocation, out *DynamicAllocation) {
+	out.Enabled = in.Enabled
+	out.InitialExecutors = in.InitialExecutors
+	out.MinExecutors = in.MinExecutors
+	out.MaxExecutors = in.MaxExecutors
+	out.ShuffleTrackingEnabled = in.ShuffleTrackingEnabled
+	out.ShuffleTrackingTimeout = in.ShuffleTrackingTimeout
+}
+
+func convertEnvSecretRefToHub(in *EnvSecretRef, out *v1beta2.EnvSecretRef) {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	out.EnvName = in.EnvName
+}
+
+func convertEnvSecretRefFromHub(in *v1beta2.EnvSecretRef, out *EnvSecretRef) {
+	out.SecretName = in.SecretName
+	out.Key = in.Key
+	out.EnvName = in.EnvName
+}
+
+func convertExecutorDecommissionToHub(in *ExecutorDecommission, out *v1beta2.ExecutorDecommission) {
+	out.NodeName = in.NodeName
+	out.Reason = in.Reason
+	out.DecommissionTime = in.DecommissionTime
+}
+
+func convertExecutorDecommissionFromHub(in *v1beta2.ExecutorDecommission, out *ExecutorDecommission) {
+	out.NodeName = in.NodeName
+	out.Reason = in.Reason
+	out.DecommissionTime = in.DecommissionTime
+}
+
+func convertExecutorEphemeralPVCToHub(in *ExecutorEphemeralPVC, out *v1beta2.ExecutorEphemeralPVC) {
+	out.VolumeName = in.VolumeName
+	out.MountPath = in.MountPath
+	out.StorageClass = in.StorageClass
+	out.SizeLimit = in.SizeLimit
+	out.ReuseClaims = in.ReuseClaims
+}
+
+func convertExecutorEphemeralPVCFromHub(in *v1beta2.ExecutorEphemeralPVC, out *ExecutorEphemeralPVC) {
+	out.VolumeName = in.VolumeName
+	out.MountPath = in.MountPath
+	out.StorageClass = in.StorageClass
+	out.SizeLimit = in.SizeLimit
+	out.ReuseClaims = in.ReuseClaims
+}
+
+func convertExecutorInPlaceScalingToHub(in *ExecutorInPlaceScaling, out *v1beta2.ExecutorInPlaceScaling) {
+	out.PluginClass = in.PluginClass
+	out.Path = in.Path
+	out.Port = in.Port
+	out.MaxInstances = in.MaxInstances
+}
+
+func convertExecutorInPlaceScalingFromHub(in *v1beta2.ExecutorInPlaceScaling, out *ExecutorInPlaceScaling) {
+	out.PluginClass = in.PluginClass
+	out.Path = in.Path
+	out.Port = in.Port
+	out.MaxInstances = in.MaxInstances
+}
+
+func convertExecutorPodDisruptionBudgetToHub(in *ExecutorPodDisruptionBudget, out *v1beta2.ExecutorPodDisruptionBudget) {
+	out.MinAvailable = in.MinAvailable
+	out.MaxUnavailable = in.MaxUnavailable
+}
+
+func convertExecutorPodDisruptionBudgetFromHub(in *v1beta2.ExecutorPodDisruptionBudget, out *ExecutorPodDisruptionBudget) {
+	out.MinAvailable = in.MinAvailable
+	out.MaxUnavailable = in.MaxUnavailable
+}
+
+func convertExecutorSpecToHub(in *ExecutorSpec, out *v1beta2.ExecutorSpec) {
+	convertSparkPodSpecToHub(&in.SparkPodSpec, &out.SparkPodSpec)
+	out.Instances = in.Instances
+	out.JavaOptions = in.JavaOptions
+	out.Lifecycle = in.Lifecycle
+	out.DeleteOnTermination = in.DeleteOnTermination
+	if in.Ports != nil {
+		out.Ports = make([]v1beta2.Port, len(in.Ports))
+		for i := range in.Ports {
+			convertPortToHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.PodDisruptionBudget != nil {
+		out.PodDisruptionBudget = new(v1beta2.ExecutorPodDisruptionBudget)
+		convertExecutorPodDisruptionBudgetToHub(in.PodDisruptionBudget, out.PodDisruptionBudget)
+	}
+	out.DecommissionOnNodeEviction = in.DecommissionOnNodeEviction
+	if in.EphemeralPVC != nil {
+		out.EphemeralPVC = new(v1beta2.ExecutorEphemeralPVC)
+		convertExecutorEphemeralPVCToHub(in.EphemeralPVC, out.EphemeralPVC)
+	}
+	out.TopologySpreadConstraints = in.TopologySpreadConstraints
+	if in.InPlaceScaling != nil {
+		out.InPlaceScaling = new(v1beta2.ExecutorInPlaceScaling)
+		convertExecutorInPlaceScalingToHub(in.InPlaceScaling, out.InPlaceScaling)
+	}
+}
+
+func convertExecutorSpecFromHub(in *v1beta2.ExecutorSpec, out *ExecutorSpec) {
+	convertSparkPodSpecFromHub(&in.SparkPodSpec, &out.SparkPodSpec)
+	out.Instances = in.Instances
+	out.JavaOptions = in.JavaOptions
+	out.Lifecycle = in.Lifecycle
+	out.DeleteOnTermination = in.DeleteOnTermination
+	if in.Ports != nil {
+		out.Ports = make([]Port, len(in.Ports))
+		for i := range in.Ports {
+			convertPortFromHub(&in.Ports[i], &out.Ports[i])
+		}
+	}
+	out.PriorityClassName = in.PriorityClassName
+	if in.PodDisruptionBudget != nil {
+		out.PodDisruptionBudget = new(ExecutorPodDisruptionBudget)
+		convertExecutorPodDisruptionBudgetFromHub(in.PodDisruptionBudget, out.PodDisruptionBudget)
+	}
+	out.DecommissionOnNodeEviction = in.DecommissionOnNodeEviction
+	if in.EphemeralPVC != nil {
+		out.EphemeralPVC = new(ExecutorEphemeralPVC)
+		convertExecutorEphemeralPVCFromHub(in.EphemeralPVC, out.EphemeralPVC)
+	}
+	out.TopologySpreadConstraints = in.TopologySpreadConstraints
+	if in.InPlaceScaling != nil {
+		out.InPlaceScaling = new(ExecutorInPlaceScaling)
+		convertExecutorInPlaceScalingFromHub(in.InPlaceScaling, out.InPlaceScaling)
+	}
+}
+
+func convertHTTPHookToHub(in *HTTPHook, out *v1beta2.HTTPHook) {
+	out.URL = in.URL
+	out.Method = in.Method
+	out.Headers = in.Headers
+	out.TimeoutSeconds = in.TimeoutSeconds
+}
+
+func convertHTTPHookFromHub(in *v1beta2.HTTPHook, out *HTTPHook) {
+	out.URL = in.URL
+	out.Method = in.Method
+	out.Headers = in.Headers
+	out.TimeoutSeconds = in.TimeoutSeconds
+}
+
+func convertHookStatusToHub(in *HookStatus, out *v1beta2.HookStatus) {
+	out.Name = in.Name
+	out.Event = v1beta2.HookEvent(in.Event)
+	out.SubmissionID = in.SubmissionID
+	out.Phase = v1beta2.HookPhase(in.Phase)
+	out.Message = in.Message
+	out.JobName = in.JobName
+	out.Time = in.Time
+}
+
+func convertHookStatusFromHub(in *v1beta2.HookStatus, out *HookStatus) {
+	out.Name = in.Name
+	out.Event = HookEvent(in.Event)
+	out.SubmissionID = in.SubmissionID
+	out.Phase = HookPhase(in.Phase)
+	out.Message = in.Message
+	out.JobName = in.JobName
+	out.Time = in.Time
+}
+
+func convertHooksToHub(in *Hooks, out *v1beta2.Hooks) {
+	out.Driver = in.Driver
+	out.Executor = in.Executor
+	if in.Operator != nil {
+		out.Operator = make([]v1beta2.OperatorHook, len(in.Operator))
+		for i := range in.Operator {
+			convertOperatorHookToHub(&in.Operator[i], &out.Operator[i])
+		}
+	}
+}
+
+func convertHooksFromHub(in *v1beta2.Hooks, out *Hooks) {
+	out.Driver = in.Driver
+	out.Executor = in.Executor
+	if in.Operator != nil {
+		out.Operator = make([]OperatorHook, len(in.Operator))
+		for i := range in.Operator {
+			convertOperatorHookFromHub(&in.Operator[i], &out.Operator[i])
+		}
+	}
+}
+
+func convertInteractiveSpecToHub(in *InteractiveSpec, out *v1beta2.InteractiveSpec) {
+	out.Protocol = v1beta2.InteractiveProtocol(in.Protocol)
+	out.Port = in.Port
+	out.IdleTimeoutSeconds = in.IdleTimeoutSeconds
+}
+
+func convertInteractiveSpecFromHub(in *v1beta2.InteractiveSpec, out *InteractiveSpec) {
+	out.Protocol = InteractiveProtocol(in.Protocol)
+	out.Port = in.Port
+	out.IdleTimeoutSeconds = in.IdleTimeoutSeconds
+}
+
+func convertInteractiveStatusToHub(in *InteractiveStatus, out *v1beta2.InteractiveStatus) {
+	out.Endpoint = in.Endpoint
+	out.LastActivityTime = in.LastActivityTime
+}
+
+func convertInteractiveStatusFromHub(in *v1beta2.InteractiveStatus, out *InteractiveStatus) {
+	out.Endpoint = in.Endpoint
+	out.LastActivityTime = in.LastActivityTime
+}
+
+func convertMetadataPropagationToHub(in *MetadataPropagation, out *v1beta2.MetadataPropagation) {
+	out.Labels = in.Labels
+	out.Annotations = in.Annotations
+}
+
+func convertMetadataPropagationFromHub(in *v1beta2.MetadataPropagation, out *MetadataPropagation) {
+	out.Labels = in.Labels
+	out.Annotations = in.Annotations
+}
+
+func convertLoggingSpecToHub(in *LoggingSpec, out *v1beta2.LoggingSpec) {
+	out.Format = v1beta2.LogFormat(in.Format)
+}
+
+func convertLoggingSpecFromHub(in *v1beta2.LoggingSpec, out *LoggingSpec) {
+	out.Format = LogFormat(in.Format)
+}
+
+func convertCloudStorageSpecToHub(in *CloudStorageSpec, out *v1beta2.CloudStorageSpec) {
+	if in.S3 != nil {
+		out.S3 = &v1beta2.S3StorageSpec{
+			Endpoint:          in.S3.Endpoint,
+			Region:            in.S3.Region,
+			PathStyleAccess:   in.S3.PathStyleAccess,
+			CredentialsSecret: in.S3.CredentialsSecret,
+			RoleARN:           in.S3.RoleARN,
+		}
+	}
+	if in.GCS != nil {
+		out.GCS = &v1beta2.GCSStorageSpec{
+			ProjectID:               in.GCS.ProjectID,
+			ServiceAccountKeySecret: in.GCS.ServiceAccountKeySecret,
+		}
+	}
+	if in.Azure != nil {
+		out.Azure = &v1beta2.AzureStorageSpec{
+			StorageAccount:   in.Azure.StorageAccount,
+			AccountKeySecret: in.Azure.AccountKeySecret,
+		}
+		if in.Azure.ManagedIdentity != nil {
+			out.Azure.ManagedIdentity = &v1beta2.AzureManagedIdentity{
+				ClientID: in.Azure.ManagedIdentity.ClientID,
+				TenantID: in.Azure.ManagedIdentity.TenantID,
+			}
+		}
+	}
+}
+
+func convertCloudStorageSpecFromHub(in *v1beta2.CloudStorageSpec, out *CloudStorageSpec) {
+	if in.S3 != nil {
+		out.S3 = &S3StorageSpec{
+			Endpoint:          in.S3.Endpoint,
+			Region:            in.S3.Region,
+			PathStyleAccess:   in.S3.PathStyleAccess,
+			CredentialsSecret: in.S3.CredentialsSecret,
+			RoleARN:           in.S3.RoleARN,
+		}
+	}
+	if in.GCS != nil {
+		out.GCS = &GCSStorageSpec{
+			ProjectID:               in.GCS.ProjectID,
+			ServiceAccountKeySecret: in.GCS.ServiceAccountKeySecret,
+		}
+	}
+	if in.Azure != nil {
+		out.Azure = &AzureStorageSpec{
+			StorageAccount:   in.Azure.StorageAccount,
+			AccountKeySecret: in.Azure.AccountKeySecret,
+		}
+		if in.Azure.ManagedIdentity != nil {
+			out.Azure.ManagedIdentity = &AzureManagedIdentity{
+				ClientID: in.Azure.ManagedIdentity.ClientID,
+				TenantID: in.Azure.ManagedIdentity.TenantID,
+			}
+		}
+	}
+}
+
+func convertAzureSpecToHub(in *AzureSpec, out *v1beta2.AzureSpec) {
+	if in.WorkloadIdentity != nil {
+		out.WorkloadIdentity = &v1beta2.AzureWorkloadIdentity{ClientID: in.WorkloadIdentity.ClientID, TenantID: in.WorkloadIdentity.TenantID}
+	}
+	if in.KeyVault != nil {
+		out.KeyVault = &v1beta2.AzureKeyVault{Name: in.KeyVault.Name, MountPath: in.KeyVault.MountPath}
+		if in.KeyVault.Objects != nil {
+			out.KeyVault.Objects = make([]v1beta2.AzureKeyVaultObject, len(in.KeyVault.Objects))
+			for i, object := range in.KeyVault.Objects {
+				out.KeyVault.Objects[i] = v1beta2.AzureKeyVaultObject{Name: object.Name, Type: object.Type, Alias: object.Alias}
+			}
+		}
+	}
+}
+
+func convertAzureSpecFromHub(in *v1beta2.AzureSpec, out *AzureSpec) {
+	if in.WorkloadIdentity != nil {
+		out.WorkloadIdentity = &AzureWorkloadIdentity{ClientID: in.WorkloadIdentity.ClientID, TenantID: in.WorkloadIdentity.TenantID}
+	}
+	if in.KeyVault != nil {
+		out.KeyVault = &AzureKeyVault{Name: in.KeyVault.Name, MountPath: in.KeyVault.MountPath}
+		if in.KeyVault.Objects != nil {
+			out.KeyVault.Objects = make([]AzureKeyVaultObject, len(in.KeyVault.Objects))
+			for i, object := range in.KeyVault.Objects {
+				out.KeyVault.Objects[i] = AzureKeyVaultObject{Name: object.Name, Type: object.Type, Alias: object.Alias}
+			}
+		}
+	}
+}
+
+func convertMonitoringSpecToHub(in *MonitoringSpec, out *v1beta2.MonitoringSpec) {
+	out.ExposeDriverMetrics = in.ExposeDriverMetrics
+	out.ExposeExecutorMetrics = in.ExposeExecutorMetrics
+	out.MetricsProperties = in.MetricsProperties
+	out.MetricsPropertiesFile = in.MetricsPropertiesFile
+	if in.Prometheus != nil {
+		out.Prometheus = new(v1beta2.PrometheusSpec)
+		convertPrometheusSpecToHub(in.Prometheus, out.Prometheus)
+	}
+	if in.PrometheusServlet != nil {
+		out.PrometheusServlet = new(v1beta2.PrometheusServletSpec)
+		convertPrometheusServletSpecToHub(in.PrometheusServlet, out.PrometheusServlet)
+	}
+	if in.TaskMetrics != nil {
+		out.TaskMetrics = new(v1beta2.TaskMetricsSpec)
+		convertTaskMetricsSpecToHub(in.TaskMetrics, out.TaskMetrics)
+	}
+}
+
+func convertMonitoringSpecFromHub(in *v1beta2.MonitoringSpec, out *MonitoringSpec) {
+	out.ExposeDriverMetrics = in.ExposeDriverMetrics
+	out.ExposeExecutorMetrics = in.ExposeExecutorMetrics
+	out.MetricsProperties = in.MetricsProperties
+	out.MetricsPropertiesFile = in.MetricsPropertiesFile
+	if in.Prometheus != nil {
+		out.Prometheus = new(PrometheusSpec)
+		convertPrometheusSpecFromHub(in.Prometheus, out.Prometheus)
+	}
+	if in.PrometheusServlet != nil {
+		out.PrometheusServlet = new(PrometheusServletSpec)
+		convertPrometheusServletSpecFromHub(in.PrometheusServlet, out.PrometheusServlet)
+	}
+	if in.TaskMetrics != nil {
+		out.TaskMetrics = new(TaskMetricsSpec)
+		convertTaskMetricsSpecFromHub(in.TaskMetrics, out.TaskMetrics)
+	}
+}
+
+func convertNameKeyToHub(in *NameKey, out *v1beta2.NameKey) {
+	out.Name = in.Name
+	out.Key = in.Key
+}
+
+func convertNameKeyFromHub(in *v1beta2.NameKey, out *NameKey) {
+	out.Name = in.Name
+	out.Key = in.Key
+}
+
+func convertNamePathToHub(in *NamePath, out *v1beta2.NamePath) {
+	out.Name = in.Name
+	out.Path = in.Path
+}
+
+func convertNamePathFromHub(in *v1beta2.NamePath, out *NamePath) {
+	out.Name = in.Name
+	out.Path = in.Path
+}
+
+func convertNotificationEmailToHub(in *NotificationEmail, out *v1beta2.NotificationEmail) {
+	out.Name = in.Name
+	out.To = in.To
+	if in.Events != nil {
+		out.Events = make([]v1beta2.NotificationEvent, len(in.Events))
+		for i := range in.Events {
+			out.Events[i] = v1beta2.NotificationEvent(in.Events[i])
+		}
+	}
+	out.MaxRetries = in.MaxRetries
+}
+
+func convertNotificationEmailFromHub(in *v1beta2.NotificationEmail, out *NotificationEmail) {
+	out.Name = in.Name
+	out.To = in.To
+	if in.Events != nil {
+		out.Events = make([]NotificationEvent, len(in.Events))
+		for i := range in.Events {
+			out.Events[i] = NotificationEvent(in.Events[i])
+		}
+	}
+	out.MaxRetries = in.MaxRetries
+}
+
+func convertNotificationSpecToHub(in *NotificationSpec, out *v1beta2.NotificationSpec) {
+	if in.Webhooks != nil {
+		out.Webhooks = make([]v1beta2.NotificationWebhook, len(in.Webhooks))
+		for i := range in.Webhooks {
+			convertNotificationWebhookToHub(&in.Webhooks[i], &out.Webhooks[i])
+		}
+	}
+	if in.Emails != nil {
+		out.Emails = make([]v1beta2.NotificationEmail, len(in.Emails))
+		for i := range in.Emails {
+			convertNotificationEmailToHub(&in.Emails[i], &out.Emails[i])
+		}
+	}
+}
+
+func convertNotificationSpecFromHub(in *v1beta2.NotificationSpec, out *NotificationSpec) {
+	if in.Webhooks != nil {
+		out.Webhooks = make([]NotificationWebhook, len(in.Webhooks))
+		for i := range in.Webhooks {
+			convertNotificationWebhookFromHub(&in.Webhooks[i], &out.Webhooks[i])
+		}
+	}
+	if in.Emails != nil {
+		out.Emails = make([]NotificationEmail, len(in.Emails))
+		for i := range in.Emails {
+			convertNotificationEmailFromHub(&in.Emails[i], &out.Emails[i])
+		}
+	}
+}
+
+func convertNotificationStatusToHub(in *NotificationStatus, out *v1beta2.NotificationStatus) {
+	out.Name = in.Name
+	out.Event = v1beta2.NotificationEvent(in.Event)
+	out.SubmissionID = in.SubmissionID
+	out.Phase = v1beta2.NotificationPhase(in.Phase)
+	out.Attempts = in.Attempts
+	out.Message = in.Message
+	out.EventTime = in.EventTime
+	out.LastAttemptTime = in.LastAttemptTime
+}
+
+func convertNotificationStatusFromHub(in *v1beta2.NotificationStatus, out *NotificationStatus) {
+	out.Name = in.Name
+	out.Event = NotificationEvent(in.Event)
+	out.SubmissionID = in.SubmissionID
+	out.Phase = NotificationPhase(in.Phase)
+	out.Attempts = in.Attempts
+	out.Message = in.Message
+	out.EventTime = in.EventTime
+	out.LastAttemptTime = in.LastAttemptTime
+}
+
+func convertNotificationWebhookToHub(in *NotificationWebhook, out *v1beta2.NotificationWebhook) {
+	out.Name = in.Name
+	out.Type = v1beta2.NotificationWebhookType(in.Type)
+	out.URL = in.URL
+	out.URLSecret = in.URLSecret
+	out.AuthSecret = in.AuthSecret
+	if in.Events != nil {
+		out.Events = make([]v1beta2.NotificationEvent, len(in.Events))
+		for i := range in.Events {
+			out.Events[i] = v1beta2.NotificationEvent(in.Events[i])
+		}
+	}
+	out.MaxRetries = in.MaxRetries
+	out.TimeoutSeconds = in.TimeoutSeconds
+}
+
+func convertNotificationWebhookFromHub(in *v1beta2.NotificationWebhook, out *NotificationWebhook) {
+	out.Name = in.Name
+	out.Type = NotificationWebhookType(in.Type)
+	out.URL = in.URL
+	out.URLSecret = in.URLSecret
+	out.AuthSecret = in.AuthSecret
+	if in.Events != nil {
+		out.Events = make([]NotificationEvent, len(in.Events))
+		for i := range in.Events {
+			out.Events[i] = NotificationEvent(in.Events[i])
+		}
+	}
+	out.MaxRetries = in.MaxRetries
+	out.TimeoutSeconds = in.TimeoutSeconds
+}
+
+func convertOperatorHookToHub(in *OperatorHook, out *v1beta2.OperatorHook) {
+	out.Name = in.Name
+	if in.Events != nil {
+		out.Events = make([]v1beta2.HookEvent, len(in.Events))
+		for i, event := range in.Events {
+			out.Events[i] = v1beta2.HookEvent(event)
+		}
+	}
+	out.Job = in.Job
+	if in.HTTP != nil {
+		out.HTTP = new(v1beta2.HTTPHook)
+		convertHTTPHookToHub(in.HTTP, out.HTTP)
+	}
+}
+
+func convertOperatorHookFromHub(in *v1beta2.OperatorHook, out *OperatorHook) {
+	out.Name = in.Name
+	if in.Events != nil {
+		out.Events = make([]HookEvent, len(in.Events))
+		for i, event := range in.Events {
+			out.Events[i] = HookEvent(event)
+		}
+	}
+	out.Job = in.Job
+	if in.HTTP != nil {
+		out.HTTP = new(HTTPHook)
+		convertHTTPHookFromHub(in.HTTP, out.HTTP)
+	}
+}
+
+func convertPlacementSpecToHub(in *PlacementSpec, out *v1beta2.PlacementSpec) {
+	out.ColocateInZone = (*v1beta2.ZoneColocation)(in.ColocateInZone)
+}
+
+func convertPlacementSpecFromHub(in *v1beta2.PlacementSpec, out *PlacementSpec) {
+	out.ColocateInZone = (*ZoneColocation)(in.ColocateInZone)
+}
+
+func convertPortToHub(in *Port, out *v1beta2.Port) {
+	out.Name = in.Name
+	out.Protocol = in.Protocol
+	out.ContainerPort = in.ContainerPort
+}
+
+func convertPortFromHub(in *v1beta2.Port, out *Port) {
+	out.Name = in.Name
+	out.Protocol = in.Protocol
+	out.ContainerPort = in.ContainerPort
+}
+
+func convertPrometheusSpecToHub(in *PrometheusSpec, out *v1beta2.PrometheusSpec) {
+	out.JmxExporterJar = in.JmxExporterJar
+	out.Port = in.Port
+	out.PortName = in.PortName
+	out.ConfigFile = in.ConfigFile
+	out.Configuration = in.Configuration
+}
+
+func convertPrometheusSpecFromHub(in *v1beta2.PrometheusSpec, out *PrometheusSpec) {
+	out.JmxExporterJar = in.JmxExporterJar
+	out.Port = in.Port
+	out.PortName = in.PortName
+	out.ConfigFile = in.ConfigFile
+	out.Configuration = in.Configuration
+}
+
+func convertPrometheusServletSpecToHub(in *PrometheusServletSpec, out *v1beta2.PrometheusServletSpec) {
+	out.Path = in.Path
+	if in.RemoteWrite != nil {
+		out.RemoteWrite = new(v1beta2.PrometheusRemoteWriteSpec)
+		convertPrometheusRemoteWriteSpecToHub(in.RemoteWrite, out.RemoteWrite)
+	}
+}
+
+func convertPrometheusServletSpecFromHub(in *v1beta2.PrometheusServletSpec, out *PrometheusServletSpec) {
+	out.Path = in.Path
+	if in.RemoteWrite != nil {
+		out.RemoteWrite = new(PrometheusRemoteWriteSpec)
+		convertPrometheusRemoteWriteSpecFromHub(in.RemoteWrite, out.RemoteWrite)
+	}
+}
+
+func convertPrometheusRemoteWriteSpecToHub(in *PrometheusRemoteWriteSpec, out *v1beta2.PrometheusRemoteWriteSpec) {
+	out.URL = in.URL
+	out.BearerTokenSecret = in.BearerTokenSecret
+	out.IntervalSeconds = in.IntervalSeconds
+	out.Image = in.Image
+}
+
+func convertPrometheusRemoteWriteSpecFromHub(in *v1beta2.PrometheusRemoteWriteSpec, out *PrometheusRemoteWriteSpec) {
+	out.URL = in.URL
+	out.BearerTokenSecret = in.BearerTokenSecret
+	out.IntervalSeconds = in.IntervalSeconds
+	out.Image = in.Image
+}
+
+func convertRestartPolicyToHub(in *RestartPolicy, out *v1beta2.RestartPolicy) {
+	out.Type = v1beta2.RestartPolicyType(in.Type)
+	out.OnSubmissionFailureRetries = in.OnSubmissionFailureRetries
+	out.OnFailureRetries = in.OnFailureRetries
+	out.OnSubmissionFailureRetryInterval = in.OnSubmissionFailureRetryInterval
+	out.OnFailureRetryInterval = in.OnFailureRetryInterval
+	out.OnRestartRequest = v1beta2.RestartRequestPolicy(in.OnRestartRequest)
+	out.OnOOMIncreaseMemoryPercent = in.OnOOMIncreaseMemoryPercent
+	out.OnOOMMaxMemory = in.OnOOMMaxMemory
+}
+
+func convertRestartPolicyFromHub(in *v1beta2.RestartPolicy, out *RestartPolicy) {
+	out.Type = RestartPolicyType(in.Type)
+	out.OnSubmissionFailureRetries = in.OnSubmissionFailureRetries
+	out.OnFailureRetries = in.OnFailureRetries
+	out.OnSubmissionFailureRetryInterval = in.OnSubmissionFailureRetryInterval
+	out.OnFailureRetryInterval = in.OnFailureRetryInterval
+	out.OnRestartRequest = RestartRequestPolicy(in.OnRestartRequest)
+	out.OnOOMIncreaseMemoryPercent = in.OnOOMIncreaseMemoryPercent
+	out.OnOOMMaxMemory = in.OnOOMMaxMemory
+}
+
+func convertSchedulingProfileToHub(in *SchedulingProfile, out *v1beta2.SchedulingProfile) {
+	out.Name = in.Name
+	out.NodeSelector = in.NodeSelector
+	out.Tolerations = in.Tolerations
+	out.Affinity = in.Affinity
+	out.PendingTimeoutSeconds = in.PendingTimeoutSeconds
+}
+
+func convertSchedulingProfileFromHub(in *v1beta2.SchedulingProfile, out *SchedulingProfile) {
+	out.Name = in.Name
+	out.NodeSelector = in.NodeSelector
+	out.Tolerations = in.Tolerations
+	out.Affinity = in.Affinity
+	out.PendingTimeoutSeconds = in.PendingTimeoutSeconds
+}
+
+func convertMemoryAdjustmentToHub(in *MemoryAdjustment, out *v1beta2.MemoryAdjustment) {
+	out.Role = in.Role
+	out.Memory = in.Memory
+	out.MemoryOverhead = in.MemoryOverhead
+	out.MemoryLimit = in.MemoryLimit
+	out.Adjustments = in.Adjustments
+	out.LastAdjustmentTime = in.LastAdjustmentTime
+}
+
+func convertMemoryAdjustmentFromHub(in *v1beta2.MemoryAdjustment, out *MemoryAdjustment) {
+	out.Role = in.Role
+	out.Memory = in.Memory
+	out.MemoryOverhead = in.MemoryOverhead
+	out.MemoryLimit = in.MemoryLimit
+	out.Adjustments = in.Adjustments
+	out.LastAdjustmentTime = in.LastAdjustmentTime
+}
+
+func convertScheduleBackfillToHub(in *ScheduleBackfill, out *v1beta2.ScheduleBackfill) {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Parallelism = in.Parallelism
+}
+
+func convertScheduleBackfillFromHub(in *v1beta2.ScheduleBackfill, out *ScheduleBackfill) {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Parallelism = in.Parallelism
+}
+
+func convertScheduleBackfillStatusToHub(in *ScheduleBackfillStatus, out *v1beta2.ScheduleBackfillStatus) {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.State = v1beta2.BackfillState(in.State)
+	out.NextScheduledTime = in.NextScheduledTime
+	out.TotalRuns = in.TotalRuns
+	out.StartedRuns = in.StartedRuns
+	out.SuccessfulRuns = in.SuccessfulRuns
+	out.FailedRuns = in.FailedRuns
+	out.CompletionTime = in.CompletionTime
+}
+
+func convertScheduleBackfillStatusFromHub(in *v1beta2.ScheduleBackfillStatus, out *ScheduleBackfillStatus) {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.State = BackfillState(in.State)
+	out.NextScheduledTime = in.NextScheduledTime
+	out.TotalRuns = in.TotalRuns
+	out.StartedRuns = in.StartedRuns
+	out.SuccessfulRuns = in.SuccessfulRuns
+	out.FailedRuns = in.FailedRuns
+	out.CompletionTime = in.CompletionTime
+}
+
+func convertScheduleBackpressureToHub(in *ScheduleBackpressure, out *v1beta2.ScheduleBackpressure) {
+	out.MaxActiveApplications = in.MaxActiveApplications
+	out.Action = v1beta2.BackpressureAction(in.Action)
+	out.RetryInterval = in.RetryInterval
+}
+
+func convertScheduleBackpressureFromHub(in *v1beta2.ScheduleBackpressure, out *ScheduleBackpressure) {
+	out.MaxActiveApplications = in.MaxActiveApplications
+	out.Action = BackpressureAction(in.Action)
+	out.RetryInterval = in.RetryInterval
+}
+
+func convertScheduleTriggerToHub(in *ScheduleTrigger, out *v1beta2.ScheduleTrigger) {
+	out.Name = in.Name
+	out.Threshold = in.Threshold
+	if in.Kafka != nil {
+		out.Kafka = &v1beta2.KafkaTrigger{
+			RESTProxyURL:  in.Kafka.RESTProxyURL,
+			ClusterID:     in.Kafka.ClusterID,
+			ConsumerGroup: in.Kafka.ConsumerGroup,
+			AuthSecret:    in.Kafka.AuthSecret,
+		}
+	}
+	if in.SQS != nil {
+		out.SQS = &v1beta2.SQSTrigger{
+			QueueURL:              in.SQS.QueueURL,
+			Region:                in.SQS.Region,
+			AccessKeyIDSecret:     in.SQS.AccessKeyIDSecret,
+			SecretAccessKeySecret: in.SQS.SecretAccessKeySecret,
+			SessionTokenSecret:    in.SQS.SessionTokenSecret,
+		}
+	}
+	if in.Prometheus != nil {
+		out.Prometheus = &v1beta2.PrometheusTrigger{
+			ServerAddress: in.Prometheus.ServerAddress,
+			Query:         in.Prometheus.Query,
+			AuthSecret:    in.Prometheus.AuthSecret,
+		}
+	}
+}
+
+func convertScheduleTriggerFromHub(in *v1beta2.ScheduleTrigger, out *ScheduleTrigger) {
+	out.Name = in.Name
+	out.Threshold = in.Threshold
+	if in.Kafka != nil {
+		out.Kafka = &KafkaTrigger{
+			RESTProxyURL:  in.Kafka.RESTProxyURL,
+			ClusterID:     in.Kafka.ClusterID,
+			ConsumerGroup: in.Kafka.ConsumerGroup,
+			AuthSecret:    in.Kafka.AuthSecret,
+		}
+	}
+	if in.SQS != nil {
+		out.SQS = &SQSTrigger{
+			QueueURL:              in.SQS.QueueURL,
+			Region:                in.SQS.Region,
+			AccessKeyIDSecret:     in.SQS.AccessKeyIDSecret,
+			SecretAccessKeySecret: in.SQS.SecretAccessKeySecret,
+			SessionTokenSecret:    in.SQS.SessionTokenSecret,
+		}
+	}
+	if in.Prometheus != nil {
+		out.Prometheus = &PrometheusTrigger{
+			ServerAddress: in.Prometheus.ServerAddress,
+			Query:         in.Prometheus.Query,
+			AuthSecret:    in.Prometheus.AuthSecret,
+		}
+	}
+}
+
+func convertScheduledRunRecordToHub(in *ScheduledRunRecord, out *v1beta2.ScheduledRunRecord) {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Duration = in.Duration
+	out.State = v1beta2.ApplicationStateType(in.State)
+	out.FailureReason = in.FailureReason
+}
+
+func convertScheduledRunRecordFromHub(in *v1beta2.ScheduledRunRecord, out *ScheduledRunRecord) {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Duration = in.Duration
+	out.State = ApplicationStateType(in.State)
+	out.FailureReason = in.FailureReason
+}
+
+func convertScheduledSparkApplicationSpecToHub(in *ScheduledSparkApplicationSpec, out *v1beta2.ScheduledSparkApplicationSpec) {
+	out.Schedule = in.Schedule
+	out.TimeZone = in.TimeZone
+	convertSparkApplicationSpecToHub(&in.Template, &out.Template)
+	out.Suspend = in.Suspend
+	out.ConcurrencyPolicy = v1beta2.ConcurrencyPolicy(in.ConcurrencyPolicy)
+	out.SuccessfulRunHistoryLimit = in.SuccessfulRunHistoryLimit
+	out.FailedRunHistoryLimit = in.FailedRunHistoryLimit
+	out.RunHistoryLimit = in.RunHistoryLimit
+	if in.Backpressure != nil {
+		out.Backpressure = new(v1beta2.ScheduleBackpressure)
+		convertScheduleBackpressureToHub(in.Backpressure, out.Backpressure)
+	}
+	if in.Triggers != nil {
+		out.Triggers = make([]v1beta2.ScheduleTrigger, len(in.Triggers))
+		for i := range in.Triggers {
+			convertScheduleTriggerToHub(&in.Triggers[i], &out.Triggers[i])
+		}
+	}
+	out.TriggerPollingInterval = in.TriggerPollingInterval
+	if in.Backfill != nil {
+		out.Backfill = new(v1beta2.ScheduleBackfill)
+		convertScheduleBackfillToHub(in.Backfill, out.Backfill)
+	}
+}
+
+func convertScheduledSparkApplicationSpecFromHub(in *v1beta2.ScheduledSparkApplicationSpec, out *ScheduledSparkApplicationSpec) {
+	out.Schedule = in.Schedule
+	out.TimeZone = in.TimeZone
+	convertSparkApplicationSpecFromHub(&in.Template, &out.Template)
+	out.Suspend = in.Suspend
+	out.ConcurrencyPolicy = ConcurrencyPolicy(in.ConcurrencyPolicy)
+	out.SuccessfulRunHistoryLimit = in.SuccessfulRunHistoryLimit
+	out.FailedRunHistoryLimit = in.FailedRunHistoryLimit
+	out.RunHistoryLimit = in.RunHistoryLimit
+	if in.Backpressure != nil {
+		out.Backpressure = new(ScheduleBackpressure)
+		convertScheduleBackpressureFromHub(in.Backpressure, out.Backpressure)
+	}
+	if in.Triggers != nil {
+		out.Triggers = make([]ScheduleTrigger, len(in.Triggers))
+		for i := range in.Triggers {
+			convertScheduleTriggerFromHub(&in.Triggers[i], &out.Triggers[i])
+		}
+	}
+	out.TriggerPollingInterval = in.TriggerPollingInterval
+	if in.Backfill != nil {
+		out.Backfill = new(ScheduleBackfill)
+		convertScheduleBackfillFromHub(in.Backfill, out.Backfill)
+	}
+}
+
+func convertScheduledSparkApplicationStatusToHub(in *ScheduledSparkApplicationStatus, out *v1beta2.ScheduledSparkApplicationStatus) {
+	out.LastRun = in.LastRun
+	out.NextRun = in.NextRun
+	out.LastRunName = in.LastRunName
+	out.PastSuccessfulRunNames = in.PastSuccessfulRunNames
+	out.PastFailedRunNames = in.PastFailedRunNames
+	out.ScheduleState = v1beta2.ScheduleState(in.ScheduleState)
+	out.Reason = in.Reason
+	out.LastSkippedRun = in.LastSkippedRun
+	out.SkippedRuns = in.SkippedRuns
+	if in.RunHistory != nil {
+		out.RunHistory = make([]v1beta2.ScheduledRunRecord, len(in.RunHistory))
+		for i := range in.RunHistory {
+			convertScheduledRunRecordToHub(&in.RunHistory[i], &out.RunHistory[i])
+		}
+	}
+	out.SuccessfulRuns = in.SuccessfulRuns
+	out.FailedRuns = in.FailedRuns
+	if in.Backfill != nil {
+		out.Backfill = new(v1beta2.ScheduleBackfillStatus)
+		convertScheduleBackfillStatusToHub(in.Backfill, out.Backfill)
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	if in.Triggers != nil {
+		out.Triggers = make([]v1beta2.ScheduleTriggerStatus, len(in.Triggers))
+		for i, trigger := range in.Triggers {
+			out.Triggers[i] = v1beta2.ScheduleTriggerStatus(trigger)
+		}
+	}
+	out.Conditions = in.Conditions
+}
+
+func convertScheduledSparkApplicationStatusFromHub(in *v1beta2.ScheduledSparkApplicationStatus, out *ScheduledSparkApplicationStatus) {
+	out.LastRun = in.LastRun
+	out.NextRun = in.NextRun
+	out.LastRunName = in.LastRunName
+	out.PastSuccessfulRunNames = in.PastSuccessfulRunNames
+	out.PastFailedRunNames = in.PastFailedRunNames
+	out.ScheduleState = ScheduleState(in.ScheduleState)
+	out.Reason = in.Reason
+	out.LastSkippedRun = in.LastSkippedRun
+	out.SkippedRuns = in.SkippedRuns
+	if in.RunHistory != nil {
+		out.RunHistory = make([]ScheduledRunRecord, len(in.RunHistory))
+		for i := range in.RunHistory {
+			convertScheduledRunRecordFromHub(&in.RunHistory[i], &out.RunHistory[i])
+		}
+	}
+	out.SuccessfulRuns = in.SuccessfulRuns
+	out.FailedRuns = in.FailedRuns
+	if in.Backfill != nil {
+		out.Backfill = new(ScheduleBackfillStatus)
+		convertScheduleBackfillStatusFromHub(in.Backfill, out.Backfill)
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	if in.Triggers != nil {
+		out.Triggers = make([]ScheduleTriggerStatus, len(in.Triggers))
+		for i, trigger := range in.Triggers {
+			out.Triggers[i] = ScheduleTriggerStatus(trigger)
+		}
+	}
+	out.Conditions = in.Conditions
+}
+
+func convertSecretInfoToHub(in *SecretInfo, out *v1beta2.SecretInfo) {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.Type = v1beta2.SecretType(in.Type)
+	if in.Vault != nil {
+		out.Vault = &v1beta2.VaultSecret{
+			Injector:            v1beta2.VaultInjector(in.Vault.Injector),
+			Role:                in.Vault.Role,
+			FileName:            in.Vault.FileName,
+			Template:            in.Vault.Template,
+			SecretProviderClass: in.Vault.SecretProviderClass,
+		}
+	}
+}
+
+func convertSecretInfoFromHub(in *v1beta2.SecretInfo, out *SecretInfo) {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.Type = SecretType(in.Type)
+	if in.Vault != nil {
+		out.Vault = &VaultSecret{
+			Injector:            VaultInjector(in.Vault.Injector),
+			Role:                in.Vault.Role,
+			FileName:            in.Vault.FileName,
+			Template:            in.Vault.Template,
+			SecretProviderClass: in.Vault.SecretProviderClass,
+		}
+	}
+}
+
+func convertSparkApplicationSpecToHub(in *SparkApplicationSpec, out *v1beta2.SparkApplicationSpec) {
+	out.Suspend = in.Suspend
+	if in.Terminate != nil {
+		out.Terminate = new(v1beta2.TerminateSpec)
+		convertTerminateSpecToHub(in.Terminate, out.Terminate)
+	}
+	out.TemplateRef = in.TemplateRef
+	out.DependsOn = in.DependsOn
+	if in.ExecutionWindow != nil {
+		out.ExecutionWindow = &v1beta2.ExecutionWindow{
+			Windows:     in.ExecutionWindow.Windows,
+			OnWindowEnd: v1beta2.ExecutionWindowEndPolicy(in.ExecutionWindow.OnWindowEnd),
+		}
+	}
+	out.Type = v1beta2.SparkApplicationType(in.Type)
+	out.SparkVersion = in.SparkVersion
+	out.Mode = v1beta2.DeployMode(in.Mode)
+	out.ProxyUser = in.ProxyUser
+	out.Image = in.Image
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.ImagePullSecrets = in.ImagePullSecrets
+	out.MainClass = in.MainClass
+	out.MainApplicationFile = in.MainApplicationFile
+	out.Arguments = in.Arguments
+	out.SparkConf = in.SparkConf
+	out.HadoopConf = in.HadoopConf
+	out.SparkConfigMap = in.SparkConfigMap
+	out.SparkConfigMapReloadPolicy = v1beta2.SparkConfigMapReloadPolicy(in.SparkConfigMapReloadPolicy)
+	out.HadoopConfigMap = in.HadoopConfigMap
+	if in.HadoopSecurity != nil {
+		out.HadoopSecurity = &v1beta2.HadoopSecuritySpec{
+			Principal:               in.HadoopSecurity.Principal,
+			KerberosSecret:          in.HadoopSecurity.KerberosSecret,
+			AccessHadoopFileSystems: in.HadoopSecurity.AccessHadoopFileSystems,
+		}
+	}
+	if in.CloudStorage != nil {
+		out.CloudStorage = new(v1beta2.CloudStorageSpec)
+		convertCloudStorageSpecToHub(in.CloudStorage, out.CloudStorage)
+	}
+	if in.GCP != nil {
+		out.GCP = &v1beta2.GCPSpec{}
+		if in.GCP.WorkloadIdentity != nil {
+			out.GCP.WorkloadIdentity = &v1beta2.GCPWorkloadIdentity{ServiceAccount: in.GCP.WorkloadIdentity.ServiceAccount}
+		}
+	}
+	if in.Azure != nil {
+		out.Azure = new(v1beta2.AzureSpec)
+		convertAzureSpecToHub(in.Azure, out.Azure)
+	}
+	out.Volumes = in.Volumes
+	convertDriverSpecToHub(&in.Driver, &out.Driver)
+	convertExecutorSpecToHub(&in.Executor, &out.Executor)
+	convertDependenciesToHub(&in.Deps, &out.Deps)
+	convertRestartPolicyToHub(&in.RestartPolicy, &out.RestartPolicy)
+	out.NodeSelector = in.NodeSelector
+	if in.SchedulingProfiles != nil {
+		out.SchedulingProfiles = make([]v1beta2.SchedulingProfile, len(in.SchedulingProfiles))
+		for i := range in.SchedulingProfiles {
+			convertSchedulingProfileToHub(&in.SchedulingProfiles[i], &out.SchedulingProfiles[i])
+		}
+	}
+	out.Arch = (*v1beta2.Arch)(in.Arch)
+	if in.Placement != nil {
+		out.Placement = new(v1beta2.PlacementSpec)
+		convertPlacementSpecToHub(in.Placement, out.Placement)
+	}
+	out.FailureRetries = in.FailureRetries
+	out.RetryInterval = in.RetryInterval
+	out.PythonVersion = in.PythonVersion
+	out.MemoryOverheadFactor = in.MemoryOverheadFactor
+	if in.Monitoring != nil {
+		out.Monitoring = new(v1beta2.MonitoringSpec)
+		convertMonitoringSpecToHub(in.Monitoring, out.Monitoring)
+	}
+	if in.Logging != nil {
+		out.Logging = new(v1beta2.LoggingSpec)
+		convertLoggingSpecToHub(in.Logging, out.Logging)
+	}
+	out.EventPolicy = v1beta2.EventPolicy(in.EventPolicy)
+	out.BatchScheduler = in.BatchScheduler
+	out.TimeToLiveSeconds = in.TimeToLiveSeconds
+	out.CascadeDeletePolicy = (*v1beta2.CascadeDeletePolicy)(in.CascadeDeletePolicy)
+	if in.BatchSchedulerOptions != nil {
+		out.BatchSchedulerOptions = new(v1beta2.BatchSchedulerConfiguration)
+		convertBatchSchedulerConfigurationToHub(in.BatchSchedulerOptions, out.BatchSchedulerOptions)
+	}
+	if in.SparkUIOptions != nil {
+		out.SparkUIOptions = new(v1beta2.SparkUIConfiguration)
+		convertSparkUIConfigurationToHub(in.SparkUIOptions, out.SparkUIOptions)
+	}
+	if in.DriverIngressOptions != nil {
+		out.DriverIngressOptions = make([]v1beta2.DriverIngressConfiguration, len(in.DriverIngressOptions))
+		for i := range in.DriverIngressOptions {
+			convertDriverIngressConfigurationToHub(&in.DriverIngressOptions[i], &out.DriverIngressOptions[i])
+		}
+	}
+	if in.DynamicAllocation != nil {
+		out.DynamicAllocation = new(v1beta2.DynamicAllocation)
+		convertDynamicAllocationToHub(in.DynamicAllocation, out.DynamicAllocation)
+	}
+	if in.Streaming != nil {
+		out.Streaming = new(v1beta2.StreamingSpec)
+		convertStreamingSpecToHub(in.Streaming, out.Streaming)
+	}
+	if in.Interactive != nil {
+		out.Interactive = new(v1beta2.InteractiveSpec)
+		convertInteractiveSpecToHub(in.Interactive, out.Interactive)
+	}
+	if in.MetadataPropagation != nil {
+		out.MetadataPropagation = new(v1beta2.MetadataPropagation)
+		convertMetadataPropagationToHub(in.MetadataPropagation, out.MetadataPropagation)
+	}
+	if in.Hooks != nil {
+		out.Hooks = new(v1beta2.Hooks)
+		convertHooksToHub(in.Hooks, out.Hooks)
+	}
+	if in.Notifications != nil {
+		out.Notifications = new(v1beta2.NotificationSpec)
+		convertNotificationSpecToHub(in.Notifications, out.Notifications)
+	}
+}
+
+func convertSparkApplicationSpecFromHub(in *v1beta2.SparkApplicationSpec, out *SparkApplicationSpec) {
+	out.Suspend = in.Suspend
+	if in.Terminate != nil {
+		out.Terminate = new(TerminateSpec)
+		convertTerminateSpecFromHub(in.Terminate, out.Terminate)
+	}
+	out.TemplateRef = in.TemplateRef
+	out.DependsOn = in.DependsOn
+	if in.ExecutionWindow != nil {
+		out.ExecutionWindow = &ExecutionWindow{
+			Windows:     in.ExecutionWindow.Windows,
+			OnWindowEnd: ExecutionWindowEndPolicy(in.ExecutionWindow.OnWindowEnd),
+		}
+	}
+	out.Type = SparkApplicationType(in.Type)
+	out.SparkVersion = in.SparkVersion
+	out.Mode = DeployMode(in.Mode)
+	out.ProxyUser = in.ProxyUser
+	out.Image = in.Image
+	out.ImagePullPolicy = in.ImagePullPolicy
+	out.ImagePullSecrets = in.ImagePullSecrets
+	out.MainClass = in.MainClass
+	out.MainApplicationFile = in.MainApplicationFile
+	out.Arguments = in.Arguments
+	out.SparkConf = in.SparkConf
+	out.HadoopConf = in.HadoopConf
+	out.SparkConfigMap = in.SparkConfigMap
+	out.SparkConfigMapReloadPolicy = SparkConfigMapReloadPolicy(in.SparkConfigMapReloadPolicy)
+	out.HadoopConfigMap = in.HadoopConfigMap
+	if in.HadoopSecurity != nil {
+		out.HadoopSecurity = &HadoopSecuritySpec{
+			Principal:               in.HadoopSecurity.Principal,
+			KerberosSecret:          in.HadoopSecurity.KerberosSecret,
+			AccessHadoopFileSystems: in.HadoopSecurity.AccessHadoopFileSystems,
+		}
+	}
+	if in.CloudStorage != nil {
+		out.CloudStorage = new(CloudStorageSpec)
+		convertCloudStorageSpecFromHub(in.CloudStorage, out.CloudStorage)
+	}
+	if in.GCP != nil {
+		out.GCP = &GCPSpec{}
+		if in.GCP.WorkloadIdentity != nil {
+			out.GCP.WorkloadIdentity = &GCPWorkloadIdentity{ServiceAccount: in.GCP.WorkloadIdentity.ServiceAccount}
+		}
+	}
+	if in.Azure != nil {
+		out.Azure = new(AzureSpec)
+		convertAzureSpecFromHub(in.Azure, out.Azure)
+	}
+	out.Volumes = in.Volumes
+	convertDriverSpecFromHub(&in.Driver, &out.Driver)
+	convertExecutorSpecFromHub(&in.Executor, &out.Executor)
+	convertDependenciesFromHub(&in.Deps, &out.Deps)
+	convertRestartPolicyFromHub(&in.RestartPolicy, &out.RestartPolicy)
+	out.NodeSelector = in.NodeSelector
+	if in.SchedulingProfiles != nil {
+		out.SchedulingProfiles = make([]SchedulingProfile, len(in.SchedulingProfiles))
+		for i := range in.SchedulingProfiles {
+			convertSchedulingProfileFromHub(&in.SchedulingProfiles[i], &out.SchedulingProfiles[i])
+		}
+	}
+	out.Arch = (*Arch)(in.Arch)
+	if in.Placement != nil {
+		out.Placement = new(PlacementSpec)
+		convertPlacementSpecFromHub(in.Placement, out.Placement)
+	}
+	out.FailureRetries = in.FailureRetries
+	out.RetryInterval = in.RetryInterval
+	out.PythonVersion = in.PythonVersion
+	out.MemoryOverheadFactor = in.MemoryOverheadFactor
+	if in.Monitoring != nil {
+		out.Monitoring = new(MonitoringSpec)
+		convertMonitoringSpecFromHub(in.Monitoring, out.Monitoring)
+	}
+	if in.Logging != nil {
+		out.Logging = new(LoggingSpec)
+		convertLoggingSpecFromHub(in.Logging, out.Logging)
+	}
+	out.EventPolicy = EventPolicy(in.EventPolicy)
+	out.BatchScheduler = in.BatchScheduler
+	out.TimeToLiveSeconds = in.TimeToLiveSeconds
+	out.CascadeDeletePolicy = (*CascadeDeletePolicy)(in.CascadeDeletePolicy)
+	if in.BatchSchedulerOptions != nil {
+		out.BatchSchedulerOptions = new(BatchSchedulerConfiguration)
+		convertBatchSchedulerConfigurationFromHub(in.BatchSchedulerOptions, out.BatchSchedulerOptions)
+	}
+	if in.SparkUIOptions != nil {
+		out.SparkUIOptions = new(SparkUIConfiguration)
+		convertSparkUIConfigurationFromHub(in.SparkUIOptions, out.SparkUIOptions)
+	}
+	if in.DriverIngressOptions != nil {
+		out.DriverIngressOptions = make([]DriverIngressConfiguration, len(in.DriverIngressOptions))
+		for i := range in.DriverIngressOptions {
+			convertDriverIngressConfigurationFromHub(&in.DriverIngressOptions[i], &out.DriverIngressOptions[i])
+		}
+	}
+	if in.DynamicAllocation != nil {
+		out.DynamicAllocation = new(DynamicAllocation)
+		convertDynamicAllocationFromHub(in.DynamicAllocation, out.DynamicAllocation)
+	}
+	if in.Streaming != nil {
+		out.Streaming = new(StreamingSpec)
+		convertStreamingSpecFromHub(in.Streaming, out.Streaming)
+	}
+	if in.Interactive != nil {
+		out.Interactive = new(InteractiveSpec)
+		convertInteractiveSpecFromHub(in.Interactive, out.Interactive)
+	}
+	if in.MetadataPropagation != nil {
+		out.MetadataPropagation = new(MetadataPropagation)
+		convertMetadataPropagationFromHub(in.MetadataPropagation, out.MetadataPropagation)
+	}
+	if in.Hooks != nil {
+		out.Hooks = new(Hooks)
+		convertHooksFromHub(in.Hooks, out.Hooks)
+	}
+	if in.Notifications != nil {
+		out.Notifications = new(NotificationSpec)
+		convertNotificationSpecFromHub(in.Notifications, out.Notifications)
+	}
+}
+
+func convertSparkApplicationStatusToHub(in *SparkApplicationStatus, out *v1beta2.SparkApplicationStatus) {
+	out.SparkApplicationID = in.SparkApplicationID
+	out.HistoryServerURL = in.HistoryServerURL
+	out.SubmissionID = in.SubmissionID
+	out.LastSubmissionAttemptTime = in.LastSubmissionAttemptTime
+	out.TerminationTime = in.TerminationTime
+	convertDriverInfoToHub(&in.DriverInfo, &out.DriverInfo)
+	convertApplicationStateToHub(&in.AppState, &out.AppState)
+	out.Health = v1beta2.ApplicationHealth(in.Health)
+	out.Phase = v1beta2.ApplicationPhase(in.Phase)
+	if in.ExecutorState != nil {
+		out.ExecutorState = make(map[string]v1beta2.ExecutorState, len(in.ExecutorState))
+		for k, v := range in.ExecutorState {
+			out.ExecutorState[k] = v1beta2.ExecutorState(v)
+		}
+	}
+	out.ExecutorReplicas = in.ExecutorReplicas
+	out.ExecutorSelector = in.ExecutorSelector
+	out.ExecutorInstances = in.ExecutorInstances
+	if in.DecommissionedExecutors != nil {
+		out.DecommissionedExecutors = make(map[string]v1beta2.ExecutorDecommission, len(in.DecommissionedExecutors))
+		for k, v := range in.DecommissionedExecutors {
+			var converted v1beta2.ExecutorDecommission
+			convertExecutorDecommissionToHub(&v, &converted)
+			out.DecommissionedExecutors[k] = converted
+		}
+	}
+	if in.Streaming != nil {
+		out.Streaming = new(v1beta2.StreamingStatus)
+		convertStreamingStatusToHub(in.Streaming, out.Streaming)
+	}
+	if in.Interactive != nil {
+		out.Interactive = new(v1beta2.InteractiveStatus)
+		convertInteractiveStatusToHub(in.Interactive, out.Interactive)
+	}
+	if in.DryRun != nil {
+		out.DryRun = new(v1beta2.DryRunStatus)
+		convertDryRunStatusToHub(in.DryRun, out.DryRun)
+	}
+	out.ExecutionAttempts = in.ExecutionAttempts
+	out.SubmissionAttempts = in.SubmissionAttempts
+	out.LastRestartedAt = in.LastRestartedAt
+	out.SparkConfigMapHash = in.SparkConfigMapHash
+	if in.Hooks != nil {
+		out.Hooks = make([]v1beta2.HookStatus, len(in.Hooks))
+		for i := range in.Hooks {
+			convertHookStatusToHub(&in.Hooks[i], &out.Hooks[i])
+		}
+	}
+	if in.Notifications != nil {
+		out.Notifications = make([]v1beta2.NotificationStatus, len(in.Notifications))
+		for i := range in.Notifications {
+			convertNotificationStatusToHub(&in.Notifications[i], &out.Notifications[i])
+		}
+	}
+	out.ArchivePath = in.ArchivePath
+	out.DiagnosticsURL = in.DiagnosticsURL
+	out.OOMKilled = in.OOMKilled
+	if in.MemoryAdjustments != nil {
+		out.MemoryAdjustments = make([]v1beta2.MemoryAdjustment, len(in.MemoryAdjustments))
+		for i := range in.MemoryAdjustments {
+			convertMemoryAdjustmentToHub(&in.MemoryAdjustments[i], &out.MemoryAdjustments[i])
+		}
+	}
+	out.SchedulingProfile = in.SchedulingProfile
+	if in.Cancellation != nil {
+		out.Cancellation = new(v1beta2.CancellationStatus)
+		convertCancellationStatusToHub(in.Cancellation, out.Cancellation)
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = in.Conditions
+}
+
+func convertSparkApplicationStatusFromHub(in *v1beta2.SparkApplicationStatus, out *SparkApplicationStatus) {
+	out.SparkApplicationID = in.SparkApplicationID
+	out.HistoryServerURL = in.HistoryServerURL
+	out.SubmissionID = in.SubmissionID
+	out.LastSubmissionAttemptTime = in.LastSubmissionAttemptTime
+	out.TerminationTime = in.TerminationTime
+	convertDriverInfoFromHub(&in.DriverInfo, &out.DriverInfo)
+	convertApplicationStateFromHub(&in.AppState, &out.AppState)
+	out.Health = ApplicationHealth(in.Health)
+	out.Phase = ApplicationPhase(in.Phase)
+	if in.ExecutorState != nil {
+		out.ExecutorState = make(map[string]ExecutorState, len(in.ExecutorState))
+		for k, v := range in.ExecutorState {
+			out.ExecutorState[k] = ExecutorState(v)
+		}
+	}
+	out.ExecutorReplicas = in.ExecutorReplicas
+	out.ExecutorSelector = in.ExecutorSelector
+	out.ExecutorInstances = in.ExecutorInstances
+	if in.DecommissionedExecutors != nil {
+		out.DecommissionedExecutors = make(map[string]ExecutorDecommission, len(in.DecommissionedExecutors))
+		for k, v := range in.DecommissionedExecutors {
+			var converted ExecutorDecommission
+			convertExecutorDecommissionFromHub(&v, &converted)
+			out.DecommissionedExecutors[k] = converted
+		}
+	}
+	if in.Streaming != nil {
+		out.Streaming = new(StreamingStatus)
+		convertStreamingStatusFromHub(in.Streaming, out.Streaming)
+	}
+	if in.Interactive != nil {
+		out.Interactive = new(InteractiveStatus)
+		convertInteractiveStatusFromHub(in.Interactive, out.Interactive)
+	}
+	if in.DryRun != nil {
+		out.DryRun = new(DryRunStatus)
+		convertDryRunStatusFromHub(in.DryRun, out.DryRun)
+	}
+	out.ExecutionAttempts = in.ExecutionAttempts
+	out.SubmissionAttempts = in.SubmissionAttempts
+	out.LastRestartedAt = in.LastRestartedAt
+	out.SparkConfigMapHash = in.SparkConfigMapHash
+	if in.Hooks != nil {
+		out.Hooks = make([]HookStatus, len(in.Hooks))
+		for i := range in.Hooks {
+			convertHookStatusFromHub(&in.Hooks[i], &out.Hooks[i])
+		}
+	}
+	if in.Notifications != nil {
+		out.Notifications = make([]NotificationStatus, len(in.Notifications))
+		for i := range in.Notifications {
+			convertNotificationStatusFromHub(&in.Notifications[i], &out.Notifications[i])
+		}
+	}
+	out.ArchivePath = in.ArchivePath
+	out.DiagnosticsURL = in.DiagnosticsURL
+	out.OOMKilled = in.OOMKilled
+	if in.MemoryAdjustments != nil {
+		out.MemoryAdjustments = make([]MemoryAdjustment, len(in.MemoryAdjustments))
+		for i := range in.MemoryAdjustments {
+			convertMemoryAdjustmentFromHub(&in.MemoryAdjustments[i], &out.MemoryAdjustments[i])
+		}
+	}
+	out.SchedulingProfile = in.SchedulingProfile
+	if in.Cancellation != nil {
+		out.Cancellation = new(CancellationStatus)
+		convertCancellationStatusFromHub(in.Cancellation, out.Cancellation)
+	}
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = in.Conditions
+}
+
+func convertSparkPodSpecToHub(in *SparkPodSpec, out *v1beta2.SparkPodSpec) {
+	out.Template = in.Template
+	out.Cores = in.Cores
+	out.Image = in.Image
+	if in.ConfigMaps != nil {
+		out.ConfigMaps = make([]v1beta2.NamePath, len(in.ConfigMaps))
+		for i := range in.ConfigMaps {
+			convertNamePathToHub(&in.ConfigMaps[i], &out.ConfigMaps[i])
+		}
+	}
+	if in.Secrets != nil {
+		out.Secrets = make([]v1beta2.SecretInfo, len(in.Secrets))
+		for i := range in.Secrets {
+			convertSecretInfoToHub(&in.Secrets[i], &out.Secrets[i])
+		}
+	}
+	out.Env = in.Env
+	out.EnvVars = in.EnvVars
+	out.EnvFrom = in.EnvFrom
+	if in.EnvSecretKeyRefs != nil {
+		out.EnvSecretKeyRefs = make(map[string]v1beta2.NameKey, len(in.EnvSecretKeyRefs))
+		for k, v := range in.EnvSecretKeyRefs {
+			var converted v1beta2.NameKey
+			convertNameKeyToHub(&v, &converted)
+			out.EnvSecretKeyRefs[k] = converted
+		}
+	}
+	if in.EnvSecretRefs != nil {
+		out.EnvSecretRefs = make([]v1beta2.EnvSecretRef, len(in.EnvSecretRefs))
+		for i := range in.EnvSecretRefs {
+			convertEnvSecretRefToHub(&in.EnvSecretRefs[i], &out.EnvSecretRefs[i])
+		}
+	}
+	out.Labels = in.Labels
+	out.Annotations = in.Annotations
+	out.VolumeMounts = in.VolumeMounts
+	out.Affinity = in.Affinity
+	out.Tolerations = in.Tolerations
+	out.PodSecurityContext = in.PodSecurityContext
+	out.SecurityContext = in.SecurityContext
+	out.SchedulerName = in.SchedulerName
+	out.Sidecars = in.Sidecars
+	out.InitContainers = in.InitContainers
+	out.HostNetwork = in.HostNetwork
+	out.NodeSelector = in.NodeSelector
+	out.Arch = (*v1beta2.Arch)(in.Arch)
+	out.DNSConfig = in.DNSConfig
+	out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
+	out.ServiceAccount = in.ServiceAccount
+	out.IAMRoleARN = in.IAMRoleARN
+	out.HostAliases = in.HostAliases
+	out.ShareProcessNamespace = in.ShareProcessNamespace
+}
+
+func convertSparkPodSpecFromHub(in *v1beta2.SparkPodSpec, out *SparkPodSpec) {
+	out.Template = in.Template
+	out.Cores = in.Cores
+	out.Image = in.Image
+	if in.ConfigMaps != nil {
+		out.ConfigMaps = make([]NamePath, len(in.ConfigMaps))
+		for i := range in.ConfigMaps {
+			convertNamePathFromHub(&in.ConfigMaps[i], &out.ConfigMaps[i])
+		}
+	}
+	if in.Secrets != nil {
+		out.Secrets = make([]SecretInfo, len(in.Secrets))
+		for i := range in.Secrets {
+			convertSecretInfoFromHub(&in.Secrets[i], &out.Secrets[i])
+		}
+	}
+	out.Env = in.Env
+	out.EnvVars = in.EnvVars
+	out.EnvFrom = in.EnvFrom
+	if in.EnvSecretKeyRefs != nil {
+		out.EnvSecretKeyRefs = make(map[string]NameKey, len(in.EnvSecretKeyRefs))
+		for k, v := range in.EnvSecretKeyRefs {
+			var converted NameKey
+			convertNameKeyFromHub(&v, &converted)
+			out.EnvSecretKeyRefs[k] = converted
+		}
+	}
+	if in.EnvSecretRefs != nil {
+		out.EnvSecretRefs = make([]EnvSecretRef, len(in.EnvSecretRefs))
+		for i := range in.EnvSecretRefs {
+			convertEnvSecretRefFromHub(&in.EnvSecretRefs[i], &out.EnvSecretRefs[i])
+		}
+	}
+	out.Labels = in.Labels
+	out.Annotations = in.Annotations
+	out.VolumeMounts = in.VolumeMounts
+	out.Affinity = in.Affinity
+	out.Tolerations = in.Tolerations
+	out.PodSecurityContext = in.PodSecurityContext
+	out.SecurityContext = in.SecurityContext
+	out.SchedulerName = in.SchedulerName
+	out.Sidecars = in.Sidecars
+	out.InitContainers = in.InitContainers
+	out.HostNetwork = in.HostNetwork
+	out.NodeSelector = in.NodeSelector
+	out.Arch = (*Arch)(in.Arch)
+	out.DNSConfig = in.DNSConfig
+	out.TerminationGracePeriodSeconds = in.TerminationGracePeriodSeconds
+	out.ServiceAccount = in.ServiceAccount
+	out.IAMRoleARN = in.IAMRoleARN
+	out.HostAliases = in.HostAliases
+	out.ShareProcessNamespace = in.ShareProcessNamespace
+}
+
+func convertSparkUIConfigurationToHub(in *SparkUIConfiguration, out *v1beta2.SparkUIConfiguration) {
+	out.ServicePort = in.ServicePort
+	out.ServicePortName = in.ServicePortName
+	out.ServiceType = in.ServiceType
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	out.IngressAnnotations = in.IngressAnnotations
+	out.IngressTLS = in.IngressTLS
+}
+
+func convertSparkUIConfigurationFromHub(in *v1beta2.SparkUIConfiguration, out *SparkUIConfiguration) {
+	out.ServicePort = in.ServicePort
+	out.ServicePortName = in.ServicePortName
+	out.ServiceType = in.ServiceType
+	out.ServiceAnnotations = in.ServiceAnnotations
+	out.ServiceLabels = in.ServiceLabels
+	out.IngressAnnotations = in.IngressAnnotations
+	out.IngressTLS = in.IngressTLS
+}
+
+func convertStreamingLivenessCheckToHub(in *StreamingLivenessCheck, out *v1beta2.StreamingLivenessCheck) {
+	out.Path = in.Path
+	out.Port = in.Port
+	out.ProgressMetric = in.ProgressMetric
+	out.MaxBatchDelay = in.MaxBatchDelay
+	out.PeriodSeconds = in.PeriodSeconds
+	out.MaxRestarts = in.MaxRestarts
+}
+
+func convertStreamingLivenessCheckFromHub(in *v1beta2.StreamingLivenessCheck, out *StreamingLivenessCheck) {
+	out.Path = in.Path
+	out.Port = in.Port
+	out.ProgressMetric = in.ProgressMetric
+	out.MaxBatchDelay = in.MaxBatchDelay
+	out.PeriodSeconds = in.PeriodSeconds
+	out.MaxRestarts = in.MaxRestarts
+}
+
+func convertStreamingSpecToHub(in *StreamingSpec, out *v1beta2.StreamingSpec) {
+	out.CheckpointLocation = in.CheckpointLocation
+	if in.LivenessCheck != nil {
+		out.LivenessCheck = new(v1beta2.StreamingLivenessCheck)
+		convertStreamingLivenessCheckToHub(in.LivenessCheck, out.LivenessCheck)
+	}
+}
+
+func convertStreamingSpecFromHub(in *v1beta2.StreamingSpec, out *StreamingSpec) {
+	out.CheckpointLocation = in.CheckpointLocation
+	if in.LivenessCheck != nil {
+		out.LivenessCheck = new(StreamingLivenessCheck)
+		convertStreamingLivenessCheckFromHub(in.LivenessCheck, out.LivenessCheck)
+	}
+}
+
+func convertStreamingStatusToHub(in *StreamingStatus, out *v1beta2.StreamingStatus) {
+	out.LastProgressValue = in.LastProgressValue
+	out.LastProgressTime = in.LastProgressTime
+	out.StallRestarts = in.StallRestarts
+	out.LastStallRestartTime = in.LastStallRestartTime
+}
+
+func convertStreamingStatusFromHub(in *v1beta2.StreamingStatus, out *StreamingStatus) {
+	out.LastProgressValue = in.LastProgressValue
+	out.LastProgressTime = in.LastProgressTime
+	out.StallRestarts = in.StallRestarts
+	out.LastStallRestartTime = in.LastStallRestartTime
+}
+
+func convertTaskMetricsSpecToHub(in *TaskMetricsSpec, out *v1beta2.TaskMetricsSpec) {
+	out.PluginClass = in.PluginClass
+	out.PushgatewayURL = in.PushgatewayURL
+	out.IntervalSeconds = in.IntervalSeconds
+}
+
+func convertTaskMetricsSpecFromHub(in *v1beta2.TaskMetricsSpec, out *TaskMetricsSpec) {
+	out.PluginClass = in.PluginClass
+	out.PushgatewayURL = in.PushgatewayURL
+	out.IntervalSeconds = in.IntervalSeconds
+}
+
+func convertTerminateSpecToHub(in *TerminateSpec, out *v1beta2.TerminateSpec) {
+	out.Reason = in.Reason
+	out.RequestedBy = in.RequestedBy
+	out.GracePeriodSeconds = in.GracePeriodSeconds
+}
+
+func convertTerminateSpecFromHub(in *v1beta2.TerminateSpec, out *TerminateSpec) {
+	out.Reason = in.Reason
+	out.RequestedBy = in.RequestedBy
+	out.GracePeriodSeconds = in.GracePeriodSeconds
+}
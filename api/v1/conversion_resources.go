@@ -0,0 +1,389 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const (
+	// V1Beta2ResourcesAnnotation is set on v1 objects converted from v1beta2 to preserve the v1beta2
+	// driver and executor resources that do not survive a round trip through v1, e.g. "4096m".
+	V1Beta2ResourcesAnnotation = "sparkoperator.k8s.io/v1beta2-resources"
+
+	// V1ResourcesAnnotation is set on v1beta2 objects converted from v1 to preserve the v1 driver and
+	// executor resources that do not survive a round trip through v1beta2, e.g. a fractional memory overhead.
+	V1ResourcesAnnotation = "sparkoperator.k8s.io/v1-resources"
+)
+
+// javaMemoryPattern matches the JVM memory strings accepted by Spark, e.g. "512m" or "4g".
+var javaMemoryPattern = regexp.MustCompile(`^([0-9]+)([kmgtp]?)b?$`)
+
+// javaMemoryUnits are the binary multipliers of the JVM memory string suffixes, largest first.
+var javaMemoryUnits = []struct {
+	suffix string
+	bytes  int64
+}{
+	{"p", 1 << 50},
+	{"t", 1 << 40},
+	{"g", 1 << 30},
+	{"m", 1 << 20},
+	{"k", 1 << 10},
+}
+
+// podResources are the resources of a v1 driver or executor.
+type podResources struct {
+	Resources      *corev1.ResourceRequirements `json:"resources,omitempty"`
+	MemoryOverhead *resource.Quantity           `json:"memoryOverhead,omitempty"`
+}
+
+// hubPodResources are the resources of a v1beta2 driver or executor.
+type hubPodResources struct {
+	CoreRequest    *string                      `json:"coreRequest,omitempty"`
+	CoreLimit      *string                      `json:"coreLimit,omitempty"`
+	Memory         *string                      `json:"memory,omitempty"`
+	MemoryLimit    *string                      `json:"memoryLimit,omitempty"`
+	MemoryOverhead *string                      `json:"memoryOverhead,omitempty"`
+	GPU            *v1beta2.GPUSpec             `json:"gpu,omitempty"`
+	Resources      *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// conversionData is the content of the resources annotations.
+type conversionData[T any] struct {
+	Driver   *T `json:"driver,omitempty"`
+	Executor *T `json:"executor,omitempty"`
+}
+
+// convertResourcesToHub sets the driver and executor resources of out from in. The v1beta2 resources
+// preserved in meta are restored if the v1 resources have not changed since, and the v1 resources that
+// cannot be represented in v1beta2 are preserved in meta in turn.
+func convertResourcesToHub(in *SparkApplicationSpec, out *v1beta2.SparkApplicationSpec, meta *metav1.ObjectMeta) error {
+	var preserved conversionData[hubPodResources]
+	if err := popConversionData(meta, V1Beta2ResourcesAnnotation, &preserved); err != nil {
+		return err
+	}
+
+	var lost conversionData[podResources]
+	var driver, executor hubPodResources
+	driver, lost.Driver = podResourcesToHub(getPodResources(&in.Driver.SparkPodSpec), preserved.Driver)
+	setHubPodResources(&out.Driver.SparkPodSpec, &out.Driver.CoreRequest, driver)
+	executor, lost.Executor = podResourcesToHub(getPodResources(&in.Executor.SparkPodSpec), preserved.Executor)
+	setHubPodResources(&out.Executor.SparkPodSpec, &out.Executor.CoreRequest, executor)
+
+	return pushConversionData(meta, V1ResourcesAnnotation, lost)
+}
+
+// convertResourcesFromHub sets the driver and executor resources of out from in. The v1 resources
+// preserved in meta are restored if the v1beta2 resources have not changed since, and the v1beta2
+// resources that do not survive a round trip through v1 are preserved in meta in turn.
+func convertResourcesFromHub(in *v1beta2.SparkApplicationSpec, out *SparkApplicationSpec, meta *metav1.ObjectMeta) error {
+	var preserved conversionData[podResources]
+	if err := popConversionData(meta, V1ResourcesAnnotation, &preserved); err != nil {
+		return err
+	}
+
+	var lost conversionData[hubPodResources]
+	var driver, executor podResources
+	driver, lost.Driver = podResourcesFromHub(getHubPodResources(&in.Driver.SparkPodSpec, in.Driver.CoreRequest), preserved.Driver)
+	setPodResources(&out.Driver.SparkPodSpec, driver)
+	executor, lost.Executor = podResourcesFromHub(getHubPodResources(&in.Executor.SparkPodSpec, in.Executor.CoreRequest), preserved.Executor)
+	setPodResources(&out.Executor.SparkPodSpec, executor)
+
+	return pushConversionData(meta, V1Beta2ResourcesAnnotation, lost)
+}
+
+// podResourcesToHub converts in to v1beta2, preferring preserved if it still matches in. It also returns
+// in if it cannot be represented in v1beta2.
+func podResourcesToHub(in podResources, preserved *hubPodResources) (hubPodResources, *podResources) {
+	if preserved != nil && equality.Semantic.DeepEqual(preserved.toV1(), in) {
+		return *preserved, nil
+	}
+	out := in.toHub()
+	if !equality.Semantic.DeepEqual(out.toV1(), in) {
+		return out, &in
+	}
+	return out, nil
+}
+
+// podResourcesFromHub converts in to v1, preferring preserved if it still matches in. It also returns
+// in if it does not survive a round trip through v1.
+func podResourcesFromHub(in hubPodResources, preserved *podResources) (podResources, *hubPodResources) {
+	if preserved != nil && equality.Semantic.DeepEqual(preserved.toHub(), in) {
+		return *preserved, nil
+	}
+	out := in.toV1()
+	if !equality.Semantic.DeepEqual(out.toHub(), in) {
+		return out, &in
+	}
+	return out, nil
+}
+
+// toHub converts the resources to their v1beta2 representation. The cpu and memory and a single extended
+// resource are mapped to the string-based fields, and the remaining resources are kept as structured resources.
+func (r podResources) toHub() hubPodResources {
+	var out hubPodResources
+	if r.MemoryOverhead != nil {
+		out.MemoryOverhead = quantityToJavaMemoryString(*r.MemoryOverhead)
+	}
+	if r.Resources == nil {
+		return out
+	}
+
+	remaining := r.Resources.DeepCopy()
+	if q, ok := remaining.Requests[corev1.ResourceCPU]; ok {
+		out.CoreRequest = quantityToString(q)
+		delete(remaining.Requests, corev1.ResourceCPU)
+	}
+	if q, ok := remaining.Requests[corev1.ResourceMemory]; ok {
+		if out.Memory = quantityToJavaMemoryString(q); out.Memory != nil {
+			delete(remaining.Requests, corev1.ResourceMemory)
+		}
+	}
+	if q, ok := remaining.Limits[corev1.ResourceCPU]; ok {
+		out.CoreLimit = quantityToString(q)
+		delete(remaining.Limits, corev1.ResourceCPU)
+	}
+	if q, ok := remaining.Limits[corev1.ResourceMemory]; ok {
+		out.MemoryLimit = quantityToString(q)
+		delete(remaining.Limits, corev1.ResourceMemory)
+	}
+
+	var extended []corev1.ResourceName
+	for name := range remaining.Limits {
+		if strings.Contains(string(name), "/") {
+			extended = append(extended, name)
+		}
+	}
+	if len(extended) == 1 {
+		q := remaining.Limits[extended[0]]
+		if value, ok := q.AsInt64(); ok {
+			out.GPU = &v1beta2.GPUSpec{Name: string(extended[0]), Quantity: value}
+			delete(remaining.Limits, extended[0])
+		}
+	}
+
+	if len(remaining.Requests) > 0 || len(remaining.Limits) > 0 || len(remaining.Claims) > 0 {
+		if len(remaining.Requests) == 0 {
+			remaining.Requests = nil
+		}
+		if len(remaining.Limits) == 0 {
+			remaining.Limits = nil
+		}
+		out.Resources = remaining
+	}
+	return out
+}
+
+// toV1 converts the resources to their v1 representation, dropping the values that cannot be parsed. The
+// structured resources take precedence over the string-based fields, which are defaulted from them.
+func (r hubPodResources) toV1() podResources {
+	var out podResources
+	if r.MemoryOverhead != nil {
+		out.MemoryOverhead = javaMemoryStringToQuantity(*r.MemoryOverhead)
+	}
+
+	resources := &corev1.ResourceRequirements{}
+	if r.Resources != nil {
+		resources = r.Resources.DeepCopy()
+	}
+	if resources.Requests == nil {
+		resources.Requests = corev1.ResourceList{}
+	}
+	if resources.Limits == nil {
+		resources.Limits = corev1.ResourceList{}
+	}
+	setIfMissing := func(list corev1.ResourceList, name corev1.ResourceName, q *resource.Quantity) {
+		if _, ok := list[name]; !ok && q != nil {
+			list[name] = *q
+		}
+	}
+	setIfMissing(resources.Requests, corev1.ResourceCPU, stringToQuantity(r.CoreRequest))
+	if r.Memory != nil {
+		setIfMissing(resources.Requests, corev1.ResourceMemory, javaMemoryStringToQuantity(*r.Memory))
+	}
+	setIfMissing(resources.Limits, corev1.ResourceCPU, stringToQuantity(r.CoreLimit))
+	setIfMissing(resources.Limits, corev1.ResourceMemory, stringToQuantity(r.MemoryLimit))
+	if r.GPU != nil && r.GPU.Name != "" {
+		setIfMissing(resources.Limits, corev1.ResourceName(r.GPU.Name), resource.NewQuantity(r.GPU.Quantity, resource.DecimalSI))
+	}
+
+	if len(resources.Requests) == 0 {
+		resources.Requests = nil
+	}
+	if len(resources.Limits) == 0 {
+		resources.Limits = nil
+	}
+	if resources.Requests != nil || resources.Limits != nil || len(resources.Claims) > 0 {
+		out.Resources = resources
+	}
+	return out
+}
+
+func getPodResources(spec *SparkPodSpec) podResources {
+	return podResources{
+		Resources:      spec.Resources.DeepCopy(),
+		MemoryOverhead: copyQuantity(spec.MemoryOverhead),
+	}
+}
+
+func setPodResources(spec *SparkPodSpec, r podResources) {
+	spec.Resources = r.Resources.DeepCopy()
+	spec.MemoryOverhead = copyQuantity(r.MemoryOverhead)
+}
+
+func getHubPodResources(spec *v1beta2.SparkPodSpec, coreRequest *string) hubPodResources {
+	return hubPodResources{
+		CoreRequest:    copyString(coreRequest),
+		CoreLimit:      copyString(spec.CoreLimit),
+		Memory:         copyString(spec.Memory),
+		MemoryLimit:    copyString(spec.MemoryLimit),
+		MemoryOverhead: copyString(spec.MemoryOverhead),
+		GPU:            spec.GPU.DeepCopy(),
+		Resources:      spec.Resources.DeepCopy(),
+	}
+}
+
+func setHubPodResources(spec *v1beta2.SparkPodSpec, coreRequest **string, r hubPodResources) {
+	*coreRequest = copyString(r.CoreRequest)
+	spec.CoreLimit = copyString(r.CoreLimit)
+	spec.Memory = copyString(r.Memory)
+	spec.MemoryLimit = copyString(r.MemoryLimit)
+	spec.MemoryOverhead = copyString(r.MemoryOverhead)
+	spec.GPU = r.GPU.DeepCopy()
+	spec.Resources = r.Resources.DeepCopy()
+}
+
+// popConversionData unmarshals the annotation with the given key into data and removes it from meta.
+func popConversionData[T any](meta *metav1.ObjectMeta, key string, data *conversionData[T]) error {
+	value, ok := meta.Annotations[key]
+	if !ok {
+		return nil
+	}
+	delete(meta.Annotations, key)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+	if err := json.Unmarshal([]byte(value), data); err != nil {
+		return fmt.Errorf("failed to unmarshal annotation %s: %v", key, err)
+	}
+	return nil
+}
+
+// pushConversionData marshals data into the annotation with the given key, or removes the annotation
+// if there is nothing to preserve.
+func pushConversionData[T any](meta *metav1.ObjectMeta, key string, data conversionData[T]) error {
+	if data.Driver == nil && data.Executor == nil {
+		delete(meta.Annotations, key)
+		return nil
+	}
+	value, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal annotation %s: %v", key, err)
+	}
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[key] = string(value)
+	return nil
+}
+
+// javaMemoryStringToQuantity parses a JVM memory string such as "512m" or "4g". A string without a
+// suffix is in MiB, as for `spark.driver.memory` and `spark.executor.memory`.
+func javaMemoryStringToQuantity(s string) *resource.Quantity {
+	match := javaMemoryPattern.FindStringSubmatch(strings.ToLower(s))
+	if match == nil {
+		return nil
+	}
+	value, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return nil
+	}
+	unit := int64(1 << 20)
+	switch {
+	case match[2] != "":
+		for _, u := range javaMemoryUnits {
+			if u.suffix == match[2] {
+				unit = u.bytes
+			}
+		}
+	case strings.HasSuffix(match[0], "b"):
+		unit = 1
+	}
+	if value > (1<<63-1)/unit {
+		return nil
+	}
+	return resource.NewQuantity(value*unit, resource.BinarySI)
+}
+
+// quantityToJavaMemoryString formats q as a JVM memory string using the largest exact unit.
+func quantityToJavaMemoryString(q resource.Quantity) *string {
+	bytes, ok := q.AsInt64()
+	if !ok || bytes <= 0 {
+		return nil
+	}
+	for _, u := range javaMemoryUnits {
+		if bytes%u.bytes == 0 {
+			s := fmt.Sprintf("%d%s", bytes/u.bytes, u.suffix)
+			return &s
+		}
+	}
+	s := fmt.Sprintf("%db", bytes)
+	return &s
+}
+
+func stringToQuantity(s *string) *resource.Quantity {
+	if s == nil {
+		return nil
+	}
+	q, err := resource.ParseQuantity(*s)
+	if err != nil {
+		return nil
+	}
+	return &q
+}
+
+func quantityToString(q resource.Quantity) *string {
+	s := q.String()
+	return &s
+}
+
+func copyQuantity(q *resource.Quantity) *resource.Quantity {
+	if q == nil {
+		return nil
+	}
+	c := q.DeepCopy()
+	return &c
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	return &c
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const fuzzIterations = 500
+
+// resourceFuzzerFuncs makes the fuzzed resources look like real ones most of the time, so that both the
+// lossless and the lossy conversion paths are exercised.
+func resourceFuzzerFuncs(_ serializer.CodecFactory) []interface{} {
+	javaMemory := []string{"512m", "4g", "4096m", "1024", "1g", "2gb", "300k", "0", "bad"}
+	cpu := []string{"1", "500m", "1.5", "0.5", "1200m", "bad"}
+	pick := func(c fuzz.Continue, values []string) *string {
+		if c.RandBool() {
+			return nil
+		}
+		return ptr.To(values[c.Intn(len(values))])
+	}
+	return []interface{}{
+		func(s *v1beta2.SparkPodSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			s.CoreLimit = pick(c, cpu)
+			s.Memory = pick(c, javaMemory)
+			s.MemoryLimit = pick(c, []string{"4Gi", "5000Mi", "1G", "bad"})
+			s.MemoryOverhead = pick(c, javaMemory)
+		},
+		func(s *v1beta2.DriverSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			s.CoreRequest = pick(c, cpu)
+		},
+		func(s *v1beta2.ExecutorSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			s.CoreRequest = pick(c, cpu)
+		},
+		func(s *SparkPodSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(s)
+			if c.RandBool() {
+				s.Resources = &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu[c.Intn(len(cpu)-1)]),
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("5Gi"),
+						"nvidia.com/gpu":      *resource.NewQuantity(c.Int63n(8), resource.DecimalSI),
+					},
+				}
+			}
+		},
+	}
+}
+
+func newFuzzer(t *testing.T) *fuzz.Fuzzer {
+	scheme := runtime.NewScheme()
+	require.NoError(t, AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	seed := rand.Int63()
+	t.Logf("fuzzer seed: %d", seed)
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, resourceFuzzerFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
+
+func TestSparkApplicationRoundTrip(t *testing.T) {
+	f := newFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		t.Run("hub-spoke-hub", func(t *testing.T) {
+			hub := &v1beta2.SparkApplication{}
+			f.Fuzz(hub)
+			testHubSpokeHub(t, hub, &SparkApplication{}, &v1beta2.SparkApplication{})
+		})
+		t.Run("spoke-hub-spoke", func(t *testing.T) {
+			spoke := &SparkApplication{}
+			f.Fuzz(spoke)
+			testSpokeHubSpoke(t, spoke, &v1beta2.SparkApplication{}, &SparkApplication{})
+		})
+	}
+}
+
+func TestScheduledSparkApplicationRoundTrip(t *testing.T) {
+	f := newFuzzer(t)
+	for i := 0; i < fuzzIterations; i++ {
+		t.Run("hub-spoke-hub", func(t *testing.T) {
+			hub := &v1beta2.ScheduledSparkApplication{}
+			f.Fuzz(hub)
+			testHubSpokeHub(t, hub, &ScheduledSparkApplication{}, &v1beta2.ScheduledSparkApplication{})
+		})
+		t.Run("spoke-hub-spoke", func(t *testing.T) {
+			spoke := &ScheduledSparkApplication{}
+			f.Fuzz(spoke)
+			testSpokeHubSpoke(t, spoke, &v1beta2.ScheduledSparkApplication{}, &ScheduledSparkApplication{})
+		})
+	}
+}
+
+func testHubSpokeHub(t *testing.T, hub conversion.Hub, spoke conversion.Convertible, result conversion.Hub) {
+	// The type meta is set by the conversion webhook rather than by the conversion functions.
+	hub.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	require.NoError(t, spoke.ConvertFrom(hub))
+	require.NoError(t, spoke.ConvertTo(result))
+	if !equality.Semantic.DeepEqual(hub, result) {
+		t.Errorf("hub changed after round trip:\n%s", diff.ObjectReflectDiff(hub, result))
+	}
+}
+
+func testSpokeHubSpoke(t *testing.T, spoke conversion.Convertible, hub conversion.Hub, result conversion.Convertible) {
+	spoke.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	require.NoError(t, spoke.ConvertTo(hub))
+	require.NoError(t, result.ConvertFrom(hub))
+	if !equality.Semantic.DeepEqual(spoke, result) {
+		t.Errorf("spoke changed after round trip:\n%s", diff.ObjectReflectDiff(spoke, result))
+	}
+}
+
+func TestConvertResources(t *testing.T) {
+	hub := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Cores:          ptr.To[int32](1),
+					CoreLimit:      ptr.To("1200m"),
+					Memory:         ptr.To("4g"),
+					MemoryOverhead: ptr.To("512m"),
+				},
+				CoreRequest: ptr.To("500m"),
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Memory:      ptr.To("4096m"),
+					MemoryLimit: ptr.To("5Gi"),
+					GPU:         &v1beta2.GPUSpec{Name: "nvidia.com/gpu", Quantity: 1},
+				},
+			},
+		},
+	}
+
+	spoke := &SparkApplication{}
+	require.NoError(t, spoke.ConvertFrom(hub))
+
+	driver := spoke.Spec.Driver
+	assert.Equal(t, ptr.To[int32](1), driver.Cores)
+	require.NotNil(t, driver.Resources)
+	assert.True(t, resource.MustParse("500m").Equal(driver.Resources.Requests[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("4Gi").Equal(driver.Resources.Requests[corev1.ResourceMemory]))
+	assert.True(t, resource.MustParse("1200m").Equal(driver.Resources.Limits[corev1.ResourceCPU]))
+	require.NotNil(t, driver.MemoryOverhead)
+	assert.True(t, resource.MustParse("512Mi").Equal(*driver.MemoryOverhead))
+
+	executor := spoke.Spec.Executor
+	require.NotNil(t, executor.Resources)
+	assert.True(t, resource.MustParse("4Gi").Equal(executor.Resources.Requests[corev1.ResourceMemory]))
+	assert.True(t, resource.MustParse("5Gi").Equal(executor.Resources.Limits[corev1.ResourceMemory]))
+	assert.True(t, resource.MustParse("1").Equal(executor.Resources.Limits["nvidia.com/gpu"]))
+
+	// "4096m" is represented as "4g" in v1beta2, so it is preserved for the way back.
+	assert.Contains(t, spoke.Annotations, V1Beta2ResourcesAnnotation)
+
+	result := &v1beta2.SparkApplication{}
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, hub, result)
+
+	// Once the v1 resources change, the preserved v1beta2 resources are discarded.
+	spoke.Spec.Executor.Resources.Requests[corev1.ResourceMemory] = resource.MustParse("8Gi")
+	require.NoError(t, spoke.ConvertTo(result))
+	assert.Equal(t, ptr.To("8g"), result.Spec.Executor.Memory)
+	assert.NotContains(t, result.Annotations, V1Beta2ResourcesAnnotation)
+	assert.NotContains(t, result.Annotations, V1ResourcesAnnotation)
+}
+
+func TestConvertStructuredResources(t *testing.T) {
+	spoke := &SparkApplication{
+		Spec: SparkApplicationSpec{
+			Executor: ExecutorSpec{
+				SparkPodSpec: SparkPodSpec{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:              resource.MustParse("2"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	hub := &v1beta2.SparkApplication{}
+	require.NoError(t, spoke.ConvertTo(hub))
+	assert.Equal(t, ptr.To("2"), hub.Spec.Executor.CoreRequest)
+	// The resources without a string-based field are kept as structured resources.
+	require.NotNil(t, hub.Spec.Executor.Resources)
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+	}, hub.Spec.Executor.Resources.Requests)
+	assert.Empty(t, hub.Annotations)
+
+	result := &SparkApplication{}
+	require.NoError(t, result.ConvertFrom(hub))
+	assert.True(t, equality.Semantic.DeepEqual(spoke, result))
+	assert.Empty(t, result.Annotations)
+}
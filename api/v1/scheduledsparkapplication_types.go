@@ -0,0 +1,373 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduledSparkApplicationSpec defines the desired state of ScheduledSparkApplication.
+type ScheduledSparkApplicationSpec struct {
+	// Schedule is a cron schedule on which the application should run.
+	// It may be left empty when Triggers are specified, in which case runs are only started by the triggers.
+	// +optional
+	Schedule string `json:"schedule"`
+	// TimeZone is the time zone in which the cron schedule will be interpreted in.
+	// This value is passed to time.LoadLocation, so it must be either "Local", "UTC",
+	// or a valid IANA location name e.g. "America/New_York".
+	// +optional
+	// Defaults to "Local".
+	TimeZone string `json:"timeZone,omitempty"`
+	// Template is a template from which SparkApplication instances can be created.
+	Template SparkApplicationSpec `json:"template"`
+	// Suspend is a flag telling the controller to suspend subsequent runs of the application if set to true.
+	// +optional
+	// Defaults to false.
+	Suspend *bool `json:"suspend,omitempty"`
+	// ConcurrencyPolicy is the policy governing concurrent SparkApplication runs.
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// SuccessfulRunHistoryLimit is the number of past successful runs of the application to keep.
+	// +optional
+	// Defaults to 1.
+	SuccessfulRunHistoryLimit *int32 `json:"successfulRunHistoryLimit,omitempty"`
+	// FailedRunHistoryLimit is the number of past failed runs of the application to keep.
+	// +optional
+	// Defaults to 1.
+	FailedRunHistoryLimit *int32 `json:"failedRunHistoryLimit,omitempty"`
+	// RunHistoryLimit is the number of finished runs of the application to keep in the run history
+	// of the status. Unlike the run history limits above, it does not cause SparkApplications to be deleted.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Defaults to 10.
+	RunHistoryLimit *int32 `json:"runHistoryLimit,omitempty"`
+	// Backpressure skips or delays runs while the namespace is already loaded with active SparkApplications.
+	// +optional
+	Backpressure *ScheduleBackpressure `json:"backpressure,omitempty"`
+	// Triggers start a run when a metric of an external event source, such as the lag of a Kafka consumer group
+	// or the depth of an SQS queue, reaches a threshold. Runs started by triggers honor the ConcurrencyPolicy
+	// and the Backpressure of the application.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Triggers []ScheduleTrigger `json:"triggers,omitempty"`
+	// TriggerPollingInterval is the interval at which the event sources of the Triggers are polled.
+	// +optional
+	// Defaults to 30s.
+	TriggerPollingInterval *metav1.Duration `json:"triggerPollingInterval,omitempty"`
+	// Backfill generates the runs of the application for the scheduled times of a past time window, e.g. when
+	// migrating date-partitioned jobs. Backfill runs ignore the ConcurrencyPolicy and are paused while the
+	// application is suspended.
+	// +optional
+	Backfill *ScheduleBackfill `json:"backfill,omitempty"`
+}
+
+// ScheduleTrigger starts a run when a metric of an external event source reaches a threshold.
+// Exactly one of Kafka, SQS and Prometheus must be specified.
+type ScheduleTrigger struct {
+	// Name is the name of the trigger, unique among the triggers of the application.
+	Name string `json:"name"`
+	// Threshold is the metric value at or above which the trigger fires.
+	// +optional
+	// Defaults to 1.
+	Threshold *resource.Quantity `json:"threshold,omitempty"`
+	// Kafka fires on the total lag of a Kafka consumer group.
+	// +optional
+	Kafka *KafkaTrigger `json:"kafka,omitempty"`
+	// SQS fires on the approximate number of messages visible in an Amazon SQS queue.
+	// +optional
+	SQS *SQSTrigger `json:"sqs,omitempty"`
+	// Prometheus fires on the value of a Prometheus query.
+	// +optional
+	Prometheus *PrometheusTrigger `json:"prometheus,omitempty"`
+}
+
+// KafkaTrigger reads the lag of a consumer group from a Kafka REST proxy serving the v3 API.
+type KafkaTrigger struct {
+	// RESTProxyURL is the base URL of the Kafka REST proxy, e.g. `http://kafka-rest.kafka.svc:8082`.
+	RESTProxyURL string `json:"restProxyURL"`
+	// ClusterID is the ID of the Kafka cluster.
+	ClusterID string `json:"clusterID"`
+	// ConsumerGroup is the consumer group whose total lag is compared to the threshold.
+	ConsumerGroup string `json:"consumerGroup"`
+	// AuthSecret selects a key of a Secret in the namespace of the application whose value is sent as the
+	// Authorization header of the requests to the REST proxy, e.g. `Basic <credentials>`.
+	// +optional
+	AuthSecret *corev1.SecretKeySelector `json:"authSecret,omitempty"`
+}
+
+// SQSTrigger reads the depth of an Amazon SQS queue.
+type SQSTrigger struct {
+	// QueueURL is the URL of the queue, e.g. `https://sqs.us-east-1.amazonaws.com/123456789012/events`.
+	QueueURL string `json:"queueURL"`
+	// Region is the AWS region of the queue.
+	// +optional
+	// Defaults to the region in the host of QueueURL.
+	Region string `json:"region,omitempty"`
+	// AccessKeyIDSecret selects a key of a Secret in the namespace of the application holding the AWS access key ID.
+	AccessKeyIDSecret *corev1.SecretKeySelector `json:"accessKeyIDSecret"`
+	// SecretAccessKeySecret selects a key of a Secret in the namespace of the application holding the AWS secret access key.
+	SecretAccessKeySecret *corev1.SecretKeySelector `json:"secretAccessKeySecret"`
+	// SessionTokenSecret selects a key of a Secret in the namespace of the application holding an AWS session token,
+	// for temporary credentials.
+	// +optional
+	SessionTokenSecret *corev1.SecretKeySelector `json:"sessionTokenSecret,omitempty"`
+}
+
+// PrometheusTrigger evaluates a Prometheus query.
+type PrometheusTrigger struct {
+	// ServerAddress is the base URL of the Prometheus server, e.g. `http://prometheus.monitoring.svc:9090`.
+	ServerAddress string `json:"serverAddress"`
+	// Query is an instant query returning a scalar or a single-element vector. An empty result reads as 0.
+	Query string `json:"query"`
+	// AuthSecret selects a key of a Secret in the namespace of the application whose value is sent as the
+	// Authorization header of the queries, e.g. `Bearer <token>`.
+	// +optional
+	AuthSecret *corev1.SecretKeySelector `json:"authSecret,omitempty"`
+}
+
+// ScheduleBackpressure defines when and how runs are held back because of the namespace load.
+type ScheduleBackpressure struct {
+	// MaxActiveApplications is the number of queued or running SparkApplications in the namespace
+	// at or above which a due run is held back.
+	// +kubebuilder:validation:Minimum=1
+	MaxActiveApplications int32 `json:"maxActiveApplications"`
+	// Action is what to do with a run held back because of the load.
+	// Skip drops the run and waits for the next scheduled time, Delay retries the run until the load
+	// drops or the next scheduled time is reached, in which case the run is skipped.
+	// +kubebuilder:validation:Enum={Skip,Delay}
+	// +optional
+	// Defaults to Skip.
+	Action BackpressureAction `json:"action,omitempty"`
+	// RetryInterval is the interval between retries of a delayed run.
+	// +optional
+	// Defaults to 1m.
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+}
+
+// ScheduleBackfill defines the time window for which the runs of a ScheduledSparkApplication are backfilled.
+type ScheduleBackfill struct {
+	// StartTime is the start of the window. The first run is the one scheduled at or right after it.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end of the window. Runs scheduled at or after it are not backfilled.
+	EndTime metav1.Time `json:"endTime"`
+	// Parallelism is the maximum number of backfill runs active at the same time.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// Defaults to 1.
+	Parallelism *int32 `json:"parallelism,omitempty"`
+}
+
+// ScheduledSparkApplicationStatus defines the observed state of ScheduledSparkApplication.
+type ScheduledSparkApplicationStatus struct {
+	// LastRun is the time when the last run of the application started.
+	// +nullable
+	LastRun metav1.Time `json:"lastRun,omitempty"`
+	// NextRun is the time when the next run of the application will start.
+	// +nullable
+	NextRun metav1.Time `json:"nextRun,omitempty"`
+	// LastRunName is the name of the SparkApplication for the most recent run of the application.
+	LastRunName string `json:"lastRunName,omitempty"`
+	// PastSuccessfulRunNames keeps the names of SparkApplications for past successful runs.
+	PastSuccessfulRunNames []string `json:"pastSuccessfulRunNames,omitempty"`
+	// PastFailedRunNames keeps the names of SparkApplications for past failed runs.
+	PastFailedRunNames []string `json:"pastFailedRunNames,omitempty"`
+	// ScheduleState is the current scheduling state of the application.
+	ScheduleState ScheduleState `json:"scheduleState,omitempty"`
+	// Reason tells why the ScheduledSparkApplication is in the particular ScheduleState.
+	Reason string `json:"reason,omitempty"`
+	// LastSkippedRun is the time when a run of the application was last skipped because of the namespace load.
+	// +nullable
+	LastSkippedRun metav1.Time `json:"lastSkippedRun,omitempty"`
+	// SkippedRuns is the number of runs skipped because of the namespace load.
+	SkippedRuns int32 `json:"skippedRuns,omitempty"`
+	// RunHistory keeps the records of the most recent finished runs of the application, newest first.
+	// +optional
+	RunHistory []ScheduledRunRecord `json:"runHistory,omitempty"`
+	// SuccessfulRuns is the number of runs of the application that completed successfully.
+	// +optional
+	SuccessfulRuns int32 `json:"successfulRuns,omitempty"`
+	// FailedRuns is the number of runs of the application that failed.
+	// +optional
+	FailedRuns int32 `json:"failedRuns,omitempty"`
+	// Backfill is the progress of the backfill of the application.
+	// +optional
+	Backfill *ScheduleBackfillStatus `json:"backfill,omitempty"`
+	// ObservedGeneration is the generation of the spec the status was last computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Triggers is the observed state of the triggers of the application.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Triggers []ScheduleTriggerStatus `json:"triggers,omitempty"`
+	// Conditions represent the latest available observations of the ScheduledSparkApplication.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubeflow/spark-operator/pull/1298"
+// +kubebuilder:resource:scope=Namespaced,shortName=scheduledsparkapp,singular=scheduledsparkapplication
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=.spec.schedule,name=Schedule,type=string
+// +kubebuilder:printcolumn:JSONPath=.spec.timeZone,name=TimeZone,type=string
+// +kubebuilder:printcolumn:JSONPath=.spec.suspend,name=Suspend,type=string
+// +kubebuilder:printcolumn:JSONPath=.status.lastRun,name=Last Run,type=date
+// +kubebuilder:printcolumn:JSONPath=.status.lastRunName,name=Last Run Name,type=string
+// +kubebuilder:printcolumn:JSONPath=.metadata.creationTimestamp,name=Age,type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScheduledSparkApplication is the Schema for the scheduledsparkapplications API.
+type ScheduledSparkApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   ScheduledSparkApplicationSpec   `json:"spec"`
+	Status ScheduledSparkApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScheduledSparkApplicationList contains a list of ScheduledSparkApplication.
+type ScheduledSparkApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScheduledSparkApplication `json:"items"`
+}
+
+// ScheduleTriggerStatus is the observed state of a trigger.
+type ScheduleTriggerStatus struct {
+	// Name is the name of the trigger.
+	Name string `json:"name"`
+	// Value is the metric value read at the last poll of the event source.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Active tells whether the value read at the last poll reached the threshold.
+	// +optional
+	Active bool `json:"active,omitempty"`
+	// LastPollTime is the time when the event source was last polled.
+	// +nullable
+	// +optional
+	LastPollTime metav1.Time `json:"lastPollTime,omitempty"`
+	// LastFireTime is the time when the trigger last started a run.
+	// +nullable
+	// +optional
+	LastFireTime metav1.Time `json:"lastFireTime,omitempty"`
+	// Message is the error of the last poll of the event source, if it failed.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ScheduledRunRecord is the record of a finished run of a ScheduledSparkApplication.
+type ScheduledRunRecord struct {
+	// Name is the name of the SparkApplication of the run.
+	Name string `json:"name"`
+	// StartTime is the time when the run was started.
+	// +nullable
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time when the run terminated.
+	// +nullable
+	// +optional
+	EndTime metav1.Time `json:"endTime,omitempty"`
+	// Duration is the time the run took from its start to its termination, including retries.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
+	// State is the final state of the run, either COMPLETED or FAILED.
+	State ApplicationStateType `json:"state"`
+	// FailureReason is the error message of the run if it failed.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+// ScheduleBackfillStatus is the progress of the backfill of a ScheduledSparkApplication.
+type ScheduleBackfillStatus struct {
+	// StartTime is the start of the window being backfilled.
+	StartTime metav1.Time `json:"startTime"`
+	// EndTime is the end of the window being backfilled.
+	EndTime metav1.Time `json:"endTime"`
+	// State is the state of the backfill.
+	State BackfillState `json:"state"`
+	// NextScheduledTime is the scheduled time of the next backfill run to start, unset once all runs started.
+	// +nullable
+	// +optional
+	NextScheduledTime metav1.Time `json:"nextScheduledTime,omitempty"`
+	// TotalRuns is the number of runs scheduled in the window.
+	TotalRuns int32 `json:"totalRuns"`
+	// StartedRuns is the number of backfill runs started so far.
+	// +optional
+	StartedRuns int32 `json:"startedRuns,omitempty"`
+	// SuccessfulRuns is the number of backfill runs that completed successfully.
+	// +optional
+	SuccessfulRuns int32 `json:"successfulRuns,omitempty"`
+	// FailedRuns is the number of backfill runs that failed.
+	// +optional
+	FailedRuns int32 `json:"failedRuns,omitempty"`
+	// CompletionTime is the time when the last backfill run finished.
+	// +nullable
+	// +optional
+	CompletionTime metav1.Time `json:"completionTime,omitempty"`
+}
+
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyAllow allows SparkApplications to run concurrently.
+	ConcurrencyAllow ConcurrencyPolicy = "Allow"
+	// ConcurrencyForbid forbids concurrent runs of SparkApplications, skipping the next run if the previous
+	// one hasn't finished yet.
+	ConcurrencyForbid ConcurrencyPolicy = "Forbid"
+	// ConcurrencyReplace kills the currently running SparkApplication instance and replaces it with a new one.
+	ConcurrencyReplace ConcurrencyPolicy = "Replace"
+)
+
+type BackpressureAction string
+
+const (
+	// BackpressureActionSkip skips a run held back because of the namespace load.
+	BackpressureActionSkip BackpressureAction = "Skip"
+	// BackpressureActionDelay retries a run held back because of the namespace load until the next scheduled time.
+	BackpressureActionDelay BackpressureAction = "Delay"
+)
+
+type BackfillState string
+
+const (
+	// BackfillStateRunning means that backfill runs are being started or are still active.
+	BackfillStateRunning BackfillState = "Running"
+	// BackfillStateCompleted means that all the backfill runs finished.
+	BackfillStateCompleted BackfillState = "Completed"
+)
+
+type ScheduleState string
+
+const (
+	ScheduleStateNew              ScheduleState = ""
+	ScheduleStateValidating       ScheduleState = "Validating"
+	ScheduleStateScheduled        ScheduleState = "Scheduled"
+	ScheduleStateFailedValidation ScheduleState = "FailedValidation"
+)
+
+const (
+	// ScheduleReasonSkippedDueToLoad is the reason recorded when a run is delayed or skipped because of the namespace load.
+	ScheduleReasonSkippedDueToLoad = "SkippedDueToLoad"
+)
@@ -0,0 +1,2046 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// SparkApplicationSpec defines the desired state of SparkApplication
+// It carries every pieces of information a spark-submit command takes and recognizes.
+type SparkApplicationSpec struct {
+	// Suspend indicates whether the SparkApplication should be suspended.
+	// When true, the controller skips submitting the Spark job.
+	// If a SparkApplication is suspended after creation
+	// (i.e. the flag goes from false to true), the Spark operator will delete
+	// all active Pods associated with this SparkApplication.
+	// Users must design their Spark application to gracefully handle this.
+	Suspend *bool `json:"suspend,omitempty"`
+	// Terminate cancels the application. Its driver is sent SIGTERM and given the grace period to stop, e.g. for
+	// streaming queries to commit their checkpoints, before the application is failed and its resources are
+	// deleted. Unlike other fields, setting it does not rerun the application.
+	// +optional
+	Terminate *TerminateSpec `json:"terminate,omitempty"`
+	// TemplateRef is the name of a cluster-scoped SparkApplicationTemplate merged under the spec at admission,
+	// so that only the fields specific to the application need to be set.
+	// +optional
+	TemplateRef *string `json:"templateRef,omitempty"`
+	// DependsOn is the names of the SparkApplications in the same namespace that must complete before this
+	// application is submitted. The application fails without being submitted if any of them fails.
+	// +listType=set
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// ExecutionWindow restricts the submission of the application to recurring periods of time, e.g. the nights
+	// when the cluster has spare batch capacity.
+	// +optional
+	ExecutionWindow *ExecutionWindow `json:"executionWindow,omitempty"`
+	// Type tells the type of the Spark application.
+	// +kubebuilder:validation:Enum={Java,Python,Scala,R}
+	Type SparkApplicationType `json:"type"`
+	// SparkVersion is the version of Spark the application uses.
+	SparkVersion string `json:"sparkVersion"`
+	// Mode is the deployment mode of the Spark application.
+	// In cluster mode, spark-submit creates the driver pod. In client mode, the operator creates the driver pod,
+	// in which spark-submit runs the driver in client mode, and executor pod templates are not supported.
+	// +kubebuilder:validation:Enum={cluster,client}
+	Mode DeployMode `json:"mode,omitempty"`
+	// ProxyUser specifies the user to impersonate when submitting the application.
+	// It maps to the command-line flag "--proxy-user" in spark-submit.
+	// +optional
+	ProxyUser *string `json:"proxyUser,omitempty"`
+	// Image is the container image for the driver, executor, and init-container. Any custom container images for the
+	// driver, executor, or init-container takes precedence over this.
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// ImagePullPolicy is the image pull policy for the driver, executor, and init-container.
+	// +optional
+	ImagePullPolicy *string `json:"imagePullPolicy,omitempty"`
+	// ImagePullSecrets is the list of image-pull secrets.
+	// +optional
+	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+	// MainClass is the fully-qualified main class of the Spark application.
+	// This only applies to Java/Scala Spark applications.
+	// +optional
+	MainClass *string `json:"mainClass,omitempty"`
+	// MainFile is the path to a bundled JAR, Python, or R file of the application.
+	// It must be set unless the application is interactive.
+	// +optional
+	MainApplicationFile *string `json:"mainApplicationFile,omitempty"`
+	// Arguments is a list of arguments to be passed to the application.
+	// +optional
+	Arguments []string `json:"arguments,omitempty"`
+	// SparkConf carries user-specified Spark configuration properties as they would use the  "--conf" option in
+	// spark-submit.
+	// +optional
+	SparkConf map[string]string `json:"sparkConf,omitempty"`
+	// HadoopConf carries user-specified Hadoop configuration properties as they would use the "--conf" option
+	// in spark-submit. The SparkApplication controller automatically adds prefix "spark.hadoop." to Hadoop
+	// configuration properties.
+	// +optional
+	HadoopConf map[string]string `json:"hadoopConf,omitempty"`
+	// SparkConfigMap carries the name of the ConfigMap containing Spark configuration files such as log4j.properties.
+	// The controller will add environment variable SPARK_CONF_DIR to the path where the ConfigMap is mounted to.
+	// +optional
+	SparkConfigMap *string `json:"sparkConfigMap,omitempty"`
+	// SparkConfigMapReloadPolicy defines what the controller does when the data of the SparkConfigMap of a running
+	// application changes. None (default) leaves the application running as is. Restart re-submits the application.
+	// Signal records the hash of the new data in the `sparkoperator.k8s.io/spark-conf-hash` annotation of the driver
+	// pod, which the driver can watch through the downward API to reload the updated files of SPARK_CONF_DIR.
+	// +kubebuilder:validation:Enum={None,Restart,Signal}
+	// +optional
+	SparkConfigMapReloadPolicy SparkConfigMapReloadPolicy `json:"sparkConfigMapReloadPolicy,omitempty"`
+	// HadoopConfigMap carries the name of the ConfigMap containing Hadoop configuration files such as core-site.xml.
+	// The controller will add environment variable HADOOP_CONF_DIR to the path where the ConfigMap is mounted to.
+	// +optional
+	HadoopConfigMap *string `json:"hadoopConfigMap,omitempty"`
+	// HadoopSecurity configures the Kerberos authentication of the application to secured Hadoop services such as
+	// HDFS and the Hive metastore.
+	// +optional
+	HadoopSecurity *HadoopSecuritySpec `json:"hadoopSecurity,omitempty"`
+	// CloudStorage configures the Hadoop connectors of the cloud object stores the application accesses, and the
+	// credentials they authenticate with, instead of setting the connector properties in HadoopConf.
+	// +optional
+	CloudStorage *CloudStorageSpec `json:"cloudStorage,omitempty"`
+	// GCP configures the access of the application to Google Cloud.
+	// +optional
+	GCP *GCPSpec `json:"gcp,omitempty"`
+	// Azure configures the access of the application to Azure.
+	// +optional
+	Azure *AzureSpec `json:"azure,omitempty"`
+	// Volumes is the list of Kubernetes volumes that can be mounted by the driver and/or executors.
+	// +optional
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// Driver is the driver specification.
+	Driver DriverSpec `json:"driver"`
+	// Executor is the executor specification.
+	Executor ExecutorSpec `json:"executor"`
+	// Deps captures all possible types of dependencies of a Spark application.
+	// +optional
+	Deps Dependencies `json:"deps,omitempty"`
+	// RestartPolicy defines the policy on if and in which conditions the controller should restart an application.
+	RestartPolicy RestartPolicy `json:"restartPolicy,omitempty"`
+	// NodeSelector is the Kubernetes node selector to be added to the driver and executor pods.
+	// This field is mutually exclusive with nodeSelector at podSpec level (driver or executor).
+	// This field will be deprecated in future versions (at SparkApplicationSpec level).
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// SchedulingProfiles are node selectors, tolerations and affinities added to the executors in order of
+	// preference, e.g. spot nodes first and on-demand nodes as fallback. The application is re-submitted with the
+	// next profile when executors stay unschedulable for longer than the pendingTimeoutSeconds of the current one.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SchedulingProfiles []SchedulingProfile `json:"schedulingProfiles,omitempty"`
+	// Arch is the CPU architecture of the nodes the driver and executor pods are scheduled on.
+	// The `image` pre-flight check verifies that the images are built for it before the application is submitted.
+	// It can be overridden for the driver and the executors, e.g. to run the executors on cheaper arm64 nodes.
+	// +kubebuilder:validation:Enum={amd64,arm64}
+	// +optional
+	Arch *Arch `json:"arch,omitempty"`
+	// Placement constrains where the executor pods are scheduled relative to the driver pod.
+	// +optional
+	Placement *PlacementSpec `json:"placement,omitempty"`
+	// FailureRetries is the number of times to retry a failed application before giving up.
+	// This is best effort and actual retry attempts can be >= the value specified.
+	// +optional
+	FailureRetries *int32 `json:"failureRetries,omitempty"`
+	// RetryInterval is the unit of intervals in seconds between submission retries.
+	// +optional
+	RetryInterval *int64 `json:"retryInterval,omitempty"`
+	// This sets the major Python version of the docker
+	// image used to run the driver and executor containers. Can either be 2 or 3, default 2.
+	// +optional
+	// +kubebuilder:validation:Enum={"2","3"}
+	PythonVersion *string `json:"pythonVersion,omitempty"`
+	// This sets the Memory Overhead Factor that will allocate memory to non-JVM memory.
+	// For JVM-based jobs this value will default to 0.10, for non-JVM jobs 0.40. Value of this field will
+	// be overridden by `Spec.Driver.MemoryOverhead` and `Spec.Executor.MemoryOverhead` if they are set.
+	// +optional
+	MemoryOverheadFactor *string `json:"memoryOverheadFactor,omitempty"`
+	// Monitoring configures how monitoring is handled.
+	// +optional
+	Monitoring *MonitoringSpec `json:"monitoring,omitempty"`
+	// Logging configures the log output of the driver and executors.
+	// +optional
+	Logging *LoggingSpec `json:"logging,omitempty"`
+	// EventPolicy overrides the operator-wide policy deciding which Kubernetes events are emitted
+	// for this application.
+	// +kubebuilder:validation:Enum={All,StateChangesOnly,ErrorsOnly}
+	// +optional
+	EventPolicy EventPolicy `json:"eventPolicy,omitempty"`
+	// BatchScheduler configures which batch scheduler will be used for scheduling
+	// +optional
+	BatchScheduler *string `json:"batchScheduler,omitempty"`
+	// TimeToLiveSeconds defines the Time-To-Live (TTL) duration in seconds for this SparkApplication
+	// after its termination.
+	// The SparkApplication object will be garbage collected if the current time is more than the
+	// TimeToLiveSeconds since its termination.
+	// +optional
+	TimeToLiveSeconds *int64 `json:"timeToLiveSeconds,omitempty"`
+	// CascadeDeletePolicy controls whether the executor PersistentVolumeClaims of the application and the Services
+	// and ConfigMaps the operator creates for it are deleted along with it. With Orphan, they are not owned by the
+	// application but labeled with its name and sparkoperator.k8s.io/orphaned=true, for retention tooling to
+	// manage them. The web UI Service is still deleted with the driver.
+	// Defaults to Cascade.
+	// +kubebuilder:validation:Enum={Cascade,Orphan}
+	// +optional
+	CascadeDeletePolicy *CascadeDeletePolicy `json:"cascadeDeletePolicy,omitempty"`
+	// BatchSchedulerOptions provides fine-grained control on how to batch scheduling.
+	// +optional
+	BatchSchedulerOptions *BatchSchedulerConfiguration `json:"batchSchedulerOptions,omitempty"`
+	// SparkUIOptions allows configuring the Service and the Ingress to expose the sparkUI
+	// +optional
+	SparkUIOptions *SparkUIConfiguration `json:"sparkUIOptions,omitempty"`
+	// DriverIngressOptions allows configuring the Service and the Ingress to expose ports inside Spark Driver
+	// +optional
+	DriverIngressOptions []DriverIngressConfiguration `json:"driverIngressOptions,omitempty"`
+	// DynamicAllocation configures dynamic allocation that becomes available for the Kubernetes
+	// scheduler backend since Spark 3.0.
+	// +optional
+	DynamicAllocation *DynamicAllocation `json:"dynamicAllocation,omitempty"`
+	// Streaming configures the health checking of long-running streaming applications.
+	// +optional
+	Streaming *StreamingSpec `json:"streaming,omitempty"`
+	// Interactive runs the driver as a long-running Spark Connect or Thrift Server endpoint for notebook kernels
+	// and other clients instead of running a main application file.
+	// +optional
+	Interactive *InteractiveSpec `json:"interactive,omitempty"`
+	// MetadataPropagation selects the labels and annotations of the application propagated to its driver and
+	// executor pods, the Services created for its driver and its on-demand executor PersistentVolumeClaims.
+	// Defaults to the propagation policy of the operator, which propagates all the labels and no annotations
+	// unless configured otherwise.
+	// +optional
+	MetadataPropagation *MetadataPropagation `json:"metadataPropagation,omitempty"`
+	// Hooks configures the lifecycle hooks of the driver and executors and the hooks run by the operator
+	// when the application is submitted, completes or fails.
+	// +optional
+	Hooks *Hooks `json:"hooks,omitempty"`
+	// Notifications configures the notifications sent by the operator on state transitions of the application.
+	// +optional
+	Notifications *NotificationSpec `json:"notifications,omitempty"`
+}
+
+// SparkApplicationStatus defines the observed state of SparkApplication
+type SparkApplicationStatus struct {
+	// SparkApplicationID is set by the spark-distribution(via spark.app.id config) on the driver and executor pods
+	SparkApplicationID string `json:"sparkApplicationId,omitempty"`
+	// HistoryServerURL is the URL of the application in the Spark History Server, recorded once the Spark
+	// application ID is known if the operator is configured with a history server URL format.
+	// +optional
+	HistoryServerURL string `json:"historyServerURL,omitempty"`
+	// SubmissionID is a unique ID of the current submission of the application.
+	SubmissionID string `json:"submissionID,omitempty"`
+	// LastSubmissionAttemptTime is the time for the last application submission attempt.
+	// +nullable
+	LastSubmissionAttemptTime metav1.Time `json:"lastSubmissionAttemptTime,omitempty"`
+	// CompletionTime is the time when the application runs to completion if it does.
+	// +nullable
+	TerminationTime metav1.Time `json:"terminationTime,omitempty"`
+	// DriverInfo has information about the driver.
+	DriverInfo DriverInfo `json:"driverInfo"`
+	// AppState tells the overall application state.
+	AppState ApplicationState `json:"applicationState,omitempty"`
+	// Health summarizes the application state as Healthy, Progressing or Degraded so that
+	// GitOps tools such as Argo CD can assess the resource without custom health scripts.
+	// +optional
+	Health ApplicationHealth `json:"health,omitempty"`
+	// Phase summarizes the application state as Pending, Running, Succeeded or Failed like the phase of a pod, so
+	// that workflow engines such as Argo Workflows can wait for the application with success and failure conditions.
+	// +optional
+	Phase ApplicationPhase `json:"phase,omitempty"`
+	// ExecutorState records the state of executors by executor Pod names.
+	ExecutorState map[string]ExecutorState `json:"executorState,omitempty"`
+	// ExecutorReplicas is the number of executors of the current run that have not terminated, read as the
+	// replicas of the scale subresource.
+	// +optional
+	ExecutorReplicas int32 `json:"executorReplicas,omitempty"`
+	// ExecutorSelector is the label selector of the executor pods of the current run, which a HorizontalPodAutoscaler
+	// uses through the scale subresource to read their metrics.
+	// +optional
+	ExecutorSelector string `json:"executorSelector,omitempty"`
+	// ExecutorInstances is the number of executors the current run was last submitted or scaled in place with,
+	// recorded if spec.executor.inPlaceScaling is set.
+	// +optional
+	ExecutorInstances int32 `json:"executorInstances,omitempty"`
+	// DecommissionedExecutors records the executors decommissioned by the operator because their nodes
+	// were being evicted, keyed by executor Pod names.
+	// +optional
+	DecommissionedExecutors map[string]ExecutorDecommission `json:"decommissionedExecutors,omitempty"`
+	// Streaming records the progress observed by the streaming liveness check.
+	// +optional
+	Streaming *StreamingStatus `json:"streaming,omitempty"`
+	// Interactive records the endpoint and the activity of an interactive application.
+	// +optional
+	Interactive *InteractiveStatus `json:"interactive,omitempty"`
+	// DryRun records the rendering of a SparkApplication with the dry-run annotation.
+	// +optional
+	DryRun *DryRunStatus `json:"dryRun,omitempty"`
+	// ExecutionAttempts is the total number of attempts to run a submitted application to completion.
+	// Incremented upon each attempted run of the application and reset upon invalidation.
+	ExecutionAttempts int32 `json:"executionAttempts,omitempty"`
+	// SubmissionAttempts is the total number of attempts to submit an application to run.
+	// Incremented upon each attempted submission of the application and reset upon invalidation and rerun.
+	SubmissionAttempts int32 `json:"submissionAttempts,omitempty"`
+	// LastRestartedAt is the value of the `spark-operator.kubeflow.org/restartedAt` annotation at the time of
+	// the latest submission. The application is restarted when the annotation is set to a different value.
+	// +optional
+	LastRestartedAt string `json:"lastRestartedAt,omitempty"`
+	// SparkConfigMapHash is the hash of the data of the SparkConfigMap the current run was submitted with,
+	// or last signaled about. It is only recorded if the SparkConfigMapReloadPolicy is Restart or Signal.
+	// +optional
+	SparkConfigMapHash string `json:"sparkConfigMapHash,omitempty"`
+	// Hooks records the latest run of each operator hook for each event.
+	// +optional
+	Hooks []HookStatus `json:"hooks,omitempty"`
+	// Notifications records the delivery of the latest notification of each webhook and email for each event.
+	// +optional
+	Notifications []NotificationStatus `json:"notifications,omitempty"`
+	// ArchivePath is the location in object storage the application was archived to after it terminated.
+	// +optional
+	ArchivePath string `json:"archivePath,omitempty"`
+	// DiagnosticsURL is the location in object storage the dumps of the driver were collected to after it failed.
+	// +optional
+	DiagnosticsURL string `json:"diagnosticsURL,omitempty"`
+	// OOMKilled lists the roles, driver or executor, whose containers were OOMKilled during the current attempt.
+	// +optional
+	OOMKilled []string `json:"oomKilled,omitempty"`
+	// MemoryAdjustments are the memory settings of the driver or executors increased after their containers were
+	// OOMKilled, which the next attempts are submitted with instead of those of the spec.
+	// +optional
+	MemoryAdjustments []MemoryAdjustment `json:"memoryAdjustments,omitempty"`
+	// SchedulingProfile is the name of the scheduling profile the executors of the current attempt are scheduled with.
+	// +optional
+	SchedulingProfile string `json:"schedulingProfile,omitempty"`
+	// Cancellation records the cancellation of the application through spec.terminate.
+	// +optional
+	Cancellation *CancellationStatus `json:"cancellation,omitempty"`
+	// ObservedGeneration is the generation of the spec the status was last computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions represent the latest available observations of the application.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubeflow/spark-operator/pull/1298"
+// +kubebuilder:resource:scope=Namespaced,shortName=sparkapp,singular=sparkapplication
+// +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.executor.instances,statuspath=.status.executorReplicas,selectorpath=.status.executorSelector
+// +kubebuilder:printcolumn:JSONPath=.spec.suspend,name=Suspend,type=boolean
+// +kubebuilder:printcolumn:JSONPath=.status.applicationState.state,name=Status,type=string
+// +kubebuilder:printcolumn:JSONPath=.status.health,name=Health,type=string,priority=1
+// +kubebuilder:printcolumn:JSONPath=.status.executionAttempts,name=Attempts,type=string
+// +kubebuilder:printcolumn:JSONPath=.status.lastSubmissionAttemptTime,name=Start,type=string
+// +kubebuilder:printcolumn:JSONPath=.status.terminationTime,name=Finish,type=string
+// +kubebuilder:printcolumn:JSONPath=.metadata.creationTimestamp,name=Age,type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SparkApplication is the Schema for the sparkapplications API
+type SparkApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   SparkApplicationSpec   `json:"spec"`
+	Status SparkApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SparkApplicationList contains a list of SparkApplication
+type SparkApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SparkApplication `json:"items"`
+}
+
+// SparkApplicationType describes the type of a Spark application.
+type SparkApplicationType string
+
+// Different types of Spark applications.
+const (
+	SparkApplicationTypeJava   SparkApplicationType = "Java"
+	SparkApplicationTypeScala  SparkApplicationType = "Scala"
+	SparkApplicationTypePython SparkApplicationType = "Python"
+	SparkApplicationTypeR      SparkApplicationType = "R"
+)
+
+// DeployMode describes the type of deployment of a Spark application.
+type DeployMode string
+
+// Different types of deployments.
+const (
+	DeployModeCluster         DeployMode = "cluster"
+	DeployModeClient          DeployMode = "client"
+	DeployModeInClusterClient DeployMode = "in-cluster-client"
+)
+
+// TerminateSpec is a request to cancel a SparkApplication.
+type TerminateSpec struct {
+	// Reason is why the application is cancelled.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// RequestedBy is the user who requested the cancellation. It is always set by the webhook to the user who set
+	// spec.terminate, and kept by updates leaving spec.terminate unchanged.
+	// +optional
+	RequestedBy string `json:"requestedBy,omitempty"`
+	// GracePeriodSeconds is how long the driver is given to stop after SIGTERM before it is killed.
+	// Defaults to the termination grace period of the driver pod.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// CancellationStatus records the cancellation of a SparkApplication through spec.terminate.
+type CancellationStatus struct {
+	// CancelledBy is the user who requested the cancellation.
+	// +optional
+	CancelledBy string `json:"cancelledBy,omitempty"`
+	// Reason is why the application was cancelled.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// CancellationTime is the time the driver was sent SIGTERM.
+	CancellationTime metav1.Time `json:"cancellationTime"`
+}
+
+// ExecutionWindow is the recurring periods of time a SparkApplication is allowed to run in.
+type ExecutionWindow struct {
+	// Windows are the periods in the format `<cron schedule>;<duration>`, e.g. `0 22 * * *;8h` for every night
+	// from 22:00 to 06:00. The schedule may start with `CRON_TZ=<timezone>` and defaults to the local time of the
+	// operator otherwise. Outside of them, the submission is queued until the next one starts.
+	// +kubebuilder:validation:MinItems=1
+	Windows []string `json:"windows"`
+	// OnWindowEnd is what happens to the application still running when its window ends.
+	// `Continue` lets it run to completion, `Pause` stops it and runs it again from the start when the next window
+	// starts, and `Kill` stops it and fails it.
+	// +kubebuilder:validation:Enum={Continue,Pause,Kill}
+	// +kubebuilder:default=Continue
+	// +optional
+	OnWindowEnd ExecutionWindowEndPolicy `json:"onWindowEnd,omitempty"`
+}
+
+// ExecutionWindowEndPolicy is what happens to a running SparkApplication when its execution window ends.
+type ExecutionWindowEndPolicy string
+
+// Different policies for running applications at the end of their execution window.
+const (
+	ExecutionWindowEndContinue ExecutionWindowEndPolicy = "Continue"
+	ExecutionWindowEndPause    ExecutionWindowEndPolicy = "Pause"
+	ExecutionWindowEndKill     ExecutionWindowEndPolicy = "Kill"
+)
+
+// Arch is the CPU architecture of a node, as in its `kubernetes.io/arch` label.
+type Arch string
+
+// Different CPU architectures.
+const (
+	ArchAMD64 Arch = "amd64"
+	ArchARM64 Arch = "arm64"
+)
+
+// PlacementSpec constrains where the executor pods are scheduled relative to the driver pod.
+type PlacementSpec struct {
+	// ColocateInZone schedules the executor pods in the zone of the driver pod, reducing the cost of cross-zone
+	// shuffle traffic. The zone of the driver is recorded in status.driverInfo.zone once it is scheduled.
+	// With Required, executors stay pending if their zone has no capacity left. With Preferred, they are
+	// scheduled in other zones if needed.
+	// +kubebuilder:validation:Enum={Preferred,Required}
+	// +optional
+	ColocateInZone *ZoneColocation `json:"colocateInZone,omitempty"`
+}
+
+// ZoneColocation is how strictly the executor pods are scheduled in the zone of the driver pod.
+type ZoneColocation string
+
+// Different zone colocations.
+const (
+	ZoneColocationPreferred ZoneColocation = "Preferred"
+	ZoneColocationRequired  ZoneColocation = "Required"
+)
+
+// CascadeDeletePolicy is the policy of whether the dependent resources of an application are deleted with it.
+type CascadeDeletePolicy string
+
+// Different cascade delete policies.
+const (
+	CascadeDeletePolicyCascade CascadeDeletePolicy = "Cascade"
+	CascadeDeletePolicyOrphan  CascadeDeletePolicy = "Orphan"
+)
+
+// RestartPolicy is the policy of if and in which conditions the controller should restart a terminated application.
+// This completely defines actions to be taken on any kind of Failures during an application run.
+type RestartPolicy struct {
+	// Type specifies the RestartPolicyType.
+	// +kubebuilder:validation:Enum={Never,Always,OnFailure}
+	Type RestartPolicyType `json:"type,omitempty"`
+
+	// OnSubmissionFailureRetries is the number of times to retry submitting an application before giving up.
+	// This is best effort and actual retry attempts can be >= the value specified due to caching.
+	// These are required if RestartPolicy is OnFailure.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnSubmissionFailureRetries *int32 `json:"onSubmissionFailureRetries,omitempty"`
+
+	// OnFailureRetries the number of times to retry running an application before giving up.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	OnFailureRetries *int32 `json:"onFailureRetries,omitempty"`
+
+	// OnSubmissionFailureRetryInterval is the interval in seconds between retries on failed submissions.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	OnSubmissionFailureRetryInterval *int64 `json:"onSubmissionFailureRetryInterval,omitempty"`
+
+	// OnFailureRetryInterval is the interval in seconds between retries on failed runs.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	OnFailureRetryInterval *int64 `json:"onFailureRetryInterval,omitempty"`
+
+	// OnRestartRequest defines when the application is re-submitted after its
+	// `spark-operator.kubeflow.org/restartedAt` annotation is changed. IfTerminated (default) re-submits a
+	// completed or failed application right away and an active one once it terminates. Always also restarts
+	// submitted and running applications right away by killing the current run and submitting it again, which
+	// keeps the submission and execution attempts of the application.
+	// +kubebuilder:validation:Enum={IfTerminated,Always}
+	// +optional
+	OnRestartRequest RestartRequestPolicy `json:"onRestartRequest,omitempty"`
+
+	// OnOOMIncreaseMemoryPercent is the percentage by which the memory of the driver or executors is increased
+	// when the application is retried after their containers were OOMKilled. The memory overhead and memory
+	// limit are increased by the same ratio if set.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	OnOOMIncreaseMemoryPercent *int32 `json:"onOOMIncreaseMemoryPercent,omitempty"`
+
+	// OnOOMMaxMemory is the memory, e.g. 8g, beyond which the memory of the driver or executors is not increased.
+	// The memory is increased on every retry if unset.
+	// +optional
+	OnOOMMaxMemory *string `json:"onOOMMaxMemory,omitempty"`
+}
+
+type RestartPolicyType string
+
+const (
+	RestartPolicyNever     RestartPolicyType = "Never"
+	RestartPolicyOnFailure RestartPolicyType = "OnFailure"
+	RestartPolicyAlways    RestartPolicyType = "Always"
+)
+
+// SparkConfigMapReloadPolicy defines what the controller does when the SparkConfigMap of a running application changes.
+type SparkConfigMapReloadPolicy string
+
+const (
+	SparkConfigMapReloadPolicyNone    SparkConfigMapReloadPolicy = "None"
+	SparkConfigMapReloadPolicyRestart SparkConfigMapReloadPolicy = "Restart"
+	SparkConfigMapReloadPolicySignal  SparkConfigMapReloadPolicy = "Signal"
+)
+
+// RestartRequestPolicy defines when an application is re-submitted upon a restart request.
+type RestartRequestPolicy string
+
+const (
+	RestartRequestPolicyIfTerminated RestartRequestPolicy = "IfTerminated"
+	RestartRequestPolicyAlways       RestartRequestPolicy = "Always"
+)
+
+// SchedulingProfile is a set of scheduling constraints added to the executors.
+type SchedulingProfile struct {
+	// Name identifies the profile in the status.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// NodeSelector is merged into the node selector of the executors.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are added to the tolerations of the executors.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity replaces the affinity of the executors.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// PendingTimeoutSeconds is how long executors may stay unschedulable with this profile before the application
+	// is re-submitted with the next one. Defaults to 300. Unused for the last profile.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PendingTimeoutSeconds *int64 `json:"pendingTimeoutSeconds,omitempty"`
+}
+
+// MemoryAdjustment records the memory of the driver or executors increased after their containers were OOMKilled.
+type MemoryAdjustment struct {
+	// Role is either driver or executor.
+	Role string `json:"role"`
+	// Memory is the increased memory, e.g. 1228m.
+	Memory string `json:"memory"`
+	// MemoryOverhead is the increased memory overhead, if the spec sets one.
+	// +optional
+	MemoryOverhead *string `json:"memoryOverhead,omitempty"`
+	// MemoryLimit is the increased memory limit, if the spec sets one.
+	// +optional
+	MemoryLimit *string `json:"memoryLimit,omitempty"`
+	// Adjustments is the number of times the memory was increased.
+	Adjustments int32 `json:"adjustments"`
+	// LastAdjustmentTime is the time the memory was last increased.
+	// +optional
+	LastAdjustmentTime metav1.Time `json:"lastAdjustmentTime,omitempty"`
+}
+
+// BatchSchedulerConfiguration used to configure how to batch scheduling Spark Application
+type BatchSchedulerConfiguration struct {
+	// Queue stands for the resource queue which the application belongs to, it's being used in Volcano batch scheduler.
+	// +optional
+	Queue *string `json:"queue,omitempty"`
+	// PriorityClassName stands for the name of k8s PriorityClass resource, it's being used in Volcano batch scheduler.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// Resources stands for the resource list custom request for. Usually it is used to define the lower-bound limit.
+	// If specified, volcano scheduler will consider it as the resources requested.
+	// +optional
+	Resources corev1.ResourceList `json:"resources,omitempty"`
+}
+
+// SparkUIConfiguration is for driver UI specific configuration parameters.
+type SparkUIConfiguration struct {
+	// ServicePort allows configuring the port at service level that might be different from the targetPort.
+	// TargetPort should be the same as the one defined in spark.ui.port
+	// +optional
+	ServicePort *int32 `json:"servicePort,omitempty"`
+	// ServicePortName allows configuring the name of the service port.
+	// This may be useful for sidecar proxies like Envoy injected by Istio which require specific ports names to treat traffic as proper HTTP.
+	// Defaults to spark-driver-ui-port.
+	// +optional
+	ServicePortName *string `json:"servicePortName,omitempty"`
+	// ServiceType allows configuring the type of the service. Defaults to ClusterIP.
+	// +optional
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+	// ServiceAnnotations is a map of key,value pairs of annotations that might be added to the service object.
+	// +optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+	// ServiceLabels is a map of key,value pairs of labels that might be added to the service object.
+	// +optional
+	ServiceLabels map[string]string `json:"serviceLabels,omitempty"`
+	// IngressAnnotations is a map of key,value pairs of annotations that might be added to the ingress object. i.e. specify nginx as ingress.class
+	// +optional
+	IngressAnnotations map[string]string `json:"ingressAnnotations,omitempty"`
+	// TlsHosts is useful If we need to declare SSL certificates to the ingress object
+	// +optional
+	IngressTLS []networkingv1.IngressTLS `json:"ingressTLS,omitempty"`
+}
+
+// DriverIngressConfiguration is for driver ingress specific configuration parameters.
+type DriverIngressConfiguration struct {
+	// ServicePort allows configuring the port at service level that might be different from the targetPort.
+	ServicePort *int32 `json:"servicePort"`
+	// ServicePortName allows configuring the name of the service port.
+	// This may be useful for sidecar proxies like Envoy injected by Istio which require specific ports names to treat traffic as proper HTTP.
+	ServicePortName *string `json:"servicePortName"`
+	// ServiceType allows configuring the type of the service. Defaults to ClusterIP.
+	// +optional
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+	// ServiceAnnotations is a map of key,value pairs of annotations that might be added to the service object.
+	// +optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+	// ServiceLabels is a map of key,value pairs of labels that might be added to the service object.
+	// +optional
+	ServiceLabels map[string]string `json:"serviceLabels,omitempty"`
+	// IngressURLFormat is the URL for the ingress.
+	IngressURLFormat string `json:"ingressURLFormat,omitempty"`
+	// IngressAnnotations is a map of key,value pairs of annotations that might be added to the ingress object. i.e. specify nginx as ingress.class
+	// +optional
+	IngressAnnotations map[string]string `json:"ingressAnnotations,omitempty"`
+	// TlsHosts is useful If we need to declare SSL certificates to the ingress object
+	// +optional
+	IngressTLS []networkingv1.IngressTLS `json:"ingressTLS,omitempty"`
+}
+
+// ApplicationStateType represents the type of the current state of an application.
+type ApplicationStateType string
+
+// Different states an application may have.
+const (
+	ApplicationStateNew              ApplicationStateType = ""
+	ApplicationStateSubmitted        ApplicationStateType = "SUBMITTED"
+	ApplicationStateRunning          ApplicationStateType = "RUNNING"
+	ApplicationStateCompleted        ApplicationStateType = "COMPLETED"
+	ApplicationStateFailed           ApplicationStateType = "FAILED"
+	ApplicationStateFailedSubmission ApplicationStateType = "SUBMISSION_FAILED"
+	ApplicationStatePreflightFailed  ApplicationStateType = "PREFLIGHT_FAILED"
+	ApplicationStateQuotaWait        ApplicationStateType = "QUOTA_WAIT"
+	ApplicationStatePendingRerun     ApplicationStateType = "PENDING_RERUN"
+	ApplicationStateInvalidating     ApplicationStateType = "INVALIDATING"
+	ApplicationStateSucceeding       ApplicationStateType = "SUCCEEDING"
+	ApplicationStateFailing          ApplicationStateType = "FAILING"
+	ApplicationStateSuspending       ApplicationStateType = "SUSPENDING"
+	ApplicationStateSuspended        ApplicationStateType = "SUSPENDED"
+	ApplicationStateResuming         ApplicationStateType = "RESUMING"
+	ApplicationStateUnknown          ApplicationStateType = "UNKNOWN"
+)
+
+// Types of the conditions following the kstatus conventions, which are set on both SparkApplications and
+// ScheduledSparkApplications so that tools such as Argo CD and Flux can assess their health.
+const (
+	// ConditionReady is true once the resource has reached its desired state.
+	ConditionReady = "Ready"
+	// ConditionReconciling is only present, and true, while the resource is progressing towards its desired state.
+	ConditionReconciling = "Reconciling"
+	// ConditionStalled is only present, and true, if the resource cannot reach its desired state.
+	ConditionStalled = "Stalled"
+)
+
+// Reasons of the kstatus conditions.
+const (
+	// ReasonProgressing means the resource is progressing towards its desired state.
+	ReasonProgressing = "Progressing"
+)
+
+// Types of the conditions of a SparkApplication.
+const (
+	// SparkApplicationConditionSubmitted is true once the application has been submitted successfully.
+	SparkApplicationConditionSubmitted = "Submitted"
+	// SparkApplicationConditionDriverReady is true while the driver is running.
+	SparkApplicationConditionDriverReady = "DriverReady"
+	// SparkApplicationConditionExecutorsReady is true while the driver is running and no executor is pending.
+	SparkApplicationConditionExecutorsReady = "ExecutorsReady"
+	// SparkApplicationConditionUIAvailable is true while the Spark web UI of the running driver is exposed.
+	SparkApplicationConditionUIAvailable = "UIAvailable"
+	// SparkApplicationConditionCompleted is true once the application has completed successfully.
+	SparkApplicationConditionCompleted = "Completed"
+	// SparkApplicationConditionFailed is true once the application has failed to be submitted or to run.
+	SparkApplicationConditionFailed = "Failed"
+	// SparkApplicationConditionSubmissionQueued is true while the submission of a new application is
+	// queued, e.g. during an operator maintenance window.
+	SparkApplicationConditionSubmissionQueued = "SubmissionQueued"
+)
+
+// Reasons of the conditions of a SparkApplication.
+const (
+	// SparkApplicationReasonMaintenanceWindow means the submission is queued until a maintenance window ends.
+	SparkApplicationReasonMaintenanceWindow = "MaintenanceWindow"
+	// SparkApplicationReasonExecutionWindow means the submission is queued until the next execution window of the
+	// application starts.
+	SparkApplicationReasonExecutionWindow = "ExecutionWindow"
+	// SparkApplicationReasonQuotaWait means the submission is held until the ResourceQuotas of the namespace have
+	// enough headroom for the driver and the minimum number of executors.
+	SparkApplicationReasonQuotaWait = "QuotaWait"
+	// SparkApplicationReasonDependenciesPending means the submission is held until the SparkApplications the
+	// application depends on have completed.
+	SparkApplicationReasonDependenciesPending = "DependenciesPending"
+	// SparkApplicationReasonPending means the application has not reached the condition yet.
+	SparkApplicationReasonPending = "Pending"
+	// SparkApplicationReasonInProgress means the application has been submitted and has not terminated yet.
+	SparkApplicationReasonInProgress = "InProgress"
+	// SparkApplicationReasonSubmitted means the application has been submitted.
+	SparkApplicationReasonSubmitted = "Submitted"
+	// SparkApplicationReasonSubmissionFailed means the application failed to be submitted.
+	SparkApplicationReasonSubmissionFailed = "SubmissionFailed"
+	// SparkApplicationReasonPreflightFailed means the application failed the pre-flight checks run before its submission.
+	SparkApplicationReasonPreflightFailed = "PreflightFailed"
+	// SparkApplicationReasonRunning means the driver is running.
+	SparkApplicationReasonRunning = "Running"
+	// SparkApplicationReasonExecutorsPending means some executors are not running yet.
+	SparkApplicationReasonExecutorsPending = "ExecutorsPending"
+	// SparkApplicationReasonUINotExposed means the Spark web UI is not exposed through a service or an ingress.
+	SparkApplicationReasonUINotExposed = "UINotExposed"
+	// SparkApplicationReasonTerminated means the driver is no longer running.
+	SparkApplicationReasonTerminated = "Terminated"
+	// SparkApplicationReasonCompleted means the application has completed successfully.
+	SparkApplicationReasonCompleted = "Completed"
+	// SparkApplicationReasonFailed means the application has failed.
+	SparkApplicationReasonFailed = "Failed"
+	// SparkApplicationReasonSuspended means the application is suspended.
+	SparkApplicationReasonSuspended = "Suspended"
+)
+
+// ApplicationHealth represents the health of a SparkApplication as consumed by GitOps tools.
+// +kubebuilder:validation:Enum=Healthy;Progressing;Degraded
+type ApplicationHealth string
+
+// Different health statuses of a SparkApplication.
+const (
+	// ApplicationHealthHealthy means the application is running or has completed successfully.
+	ApplicationHealthHealthy ApplicationHealth = "Healthy"
+	// ApplicationHealthProgressing means the application is transitioning and has not reached a steady state yet.
+	ApplicationHealthProgressing ApplicationHealth = "Progressing"
+	// ApplicationHealthDegraded means the application has failed or its state cannot be determined.
+	ApplicationHealthDegraded ApplicationHealth = "Degraded"
+)
+
+// ApplicationPhase represents the phase of a SparkApplication as consumed by workflow engines.
+// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+type ApplicationPhase string
+
+// Different phases of a SparkApplication.
+const (
+	// ApplicationPhasePending means the application has not started running yet, or is about to run again.
+	ApplicationPhasePending ApplicationPhase = "Pending"
+	// ApplicationPhaseRunning means the driver of the application is running or terminating.
+	ApplicationPhaseRunning ApplicationPhase = "Running"
+	// ApplicationPhaseSucceeded means the application has completed successfully, which is final.
+	ApplicationPhaseSucceeded ApplicationPhase = "Succeeded"
+	// ApplicationPhaseFailed means the application has failed and will not be retried, which is final.
+	ApplicationPhaseFailed ApplicationPhase = "Failed"
+)
+
+// ApplicationState tells the current state of the application and an error message in case of failures.
+type ApplicationState struct {
+	State        ApplicationStateType `json:"state"`
+	ErrorMessage string               `json:"errorMessage,omitempty"`
+}
+
+// DriverState tells the current state of a spark driver.
+type DriverState string
+
+// Different states a spark driver may have.
+const (
+	DriverStatePending   DriverState = "PENDING"
+	DriverStateRunning   DriverState = "RUNNING"
+	DriverStateCompleted DriverState = "COMPLETED"
+	DriverStateFailed    DriverState = "FAILED"
+	DriverStateUnknown   DriverState = "UNKNOWN"
+)
+
+// ExecutorState tells the current state of an executor.
+type ExecutorState string
+
+// Different states an executor may have.
+const (
+	ExecutorStatePending   ExecutorState = "PENDING"
+	ExecutorStateRunning   ExecutorState = "RUNNING"
+	ExecutorStateCompleted ExecutorState = "COMPLETED"
+	ExecutorStateFailed    ExecutorState = "FAILED"
+	ExecutorStateUnknown   ExecutorState = "UNKNOWN"
+)
+
+// Dependencies specifies all possible types of dependencies of a Spark application.
+type Dependencies struct {
+	// Jars is a list of JAR files the Spark application depends on.
+	// +optional
+	Jars []string `json:"jars,omitempty"`
+	// Files is a list of files the Spark application depends on.
+	// +optional
+	Files []string `json:"files,omitempty"`
+	// PyFiles is a list of Python files the Spark application depends on.
+	// +optional
+	PyFiles []string `json:"pyFiles,omitempty"`
+	// Packages is a list of maven coordinates of jars to include on the driver and executor
+	// classpaths. This will search the local maven repo, then maven central and any additional
+	// remote repositories given by the "repositories" option.
+	// Each package should be of the form "groupId:artifactId:version".
+	// +optional
+	Packages []string `json:"packages,omitempty"`
+	// ExcludePackages is a list of "groupId:artifactId", to exclude while resolving the
+	// dependencies provided in Packages to avoid dependency conflicts.
+	// +optional
+	ExcludePackages []string `json:"excludePackages,omitempty"`
+	// Repositories is a list of additional remote repositories to search for the maven coordinate
+	// given with the "packages" option.
+	// +optional
+	Repositories []string `json:"repositories,omitempty"`
+	// Archives is a list of archives to be extracted into the working directory of each executor.
+	// +optional
+	Archives []string `json:"archives,omitempty"`
+}
+
+// SparkPodSpec defines common things that can be customized for a Spark driver or executor pod.
+// TODO: investigate if we should use v1.PodSpec and limit what can be set instead.
+type SparkPodSpec struct {
+	// Template is a pod template that can be used to define the driver or executor pod configurations that Spark configurations do not support.
+	// Spark version >= 3.0.0 is required.
+	// Ref: https://spark.apache.org/docs/latest/running-on-kubernetes.html#pod-template.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type:=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template *corev1.PodTemplateSpec `json:"template,omitempty"`
+	// Cores maps to `spark.driver.cores` or `spark.executor.cores` for the driver and executors, respectively.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Cores *int32 `json:"cores,omitempty"`
+	// Resources are the compute resources of the pod.
+	// The cpu request maps to `spark.kubernetes.{driver,executor}.request.cores` and the cpu limit to
+	// `spark.kubernetes.{driver,executor}.limit.cores`. The memory request is the JVM heap size, i.e.
+	// `spark.driver.memory` or `spark.executor.memory`, and the memory limit overrides the memory limit of the pod.
+	// Extended resources, such as nvidia.com/gpu, are requested through the limits.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// MemoryOverhead is the amount of off-heap memory to allocate in cluster mode.
+	// +optional
+	MemoryOverhead *resource.Quantity `json:"memoryOverhead,omitempty"`
+	// Image is the container image to use. Overrides Spec.Image if set.
+	// +optional
+	Image *string `json:"image,omitempty"`
+	// ConfigMaps carries information of other ConfigMaps to add to the pod.
+	// +optional
+	ConfigMaps []NamePath `json:"configMaps,omitempty"`
+	// Secrets carries information of secrets to add to the pod.
+	// +optional
+	Secrets []SecretInfo `json:"secrets,omitempty"`
+	// Env carries the environment variables to add to the pod.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// EnvVars carries the environment variables to add to the pod.
+	// Deprecated. Consider using `env` instead.
+	// +optional
+	EnvVars map[string]string `json:"envVars,omitempty"`
+	// EnvFrom is a list of sources to populate environment variables in the container.
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+	// EnvSecretKeyRefs holds a mapping from environment variable names to SecretKeyRefs.
+	// Deprecated. Consider using `env` instead.
+	// +optional
+	EnvSecretKeyRefs map[string]NameKey `json:"envSecretKeyRefs,omitempty"`
+	// EnvSecretRefs maps keys of Secrets in the application namespace to environment variables of the pod.
+	// +optional
+	EnvSecretRefs []EnvSecretRef `json:"envSecretRefs,omitempty"`
+	// Labels are the Kubernetes labels to be added to the pod.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are the Kubernetes annotations to be added to the pod.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// VolumeMounts specifies the volumes listed in ".spec.volumes" to mount into the main container's filesystem.
+	// +optional
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// Affinity specifies the affinity/anti-affinity settings for the pod.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// Tolerations specifies the tolerations listed in ".spec.tolerations" to be applied to the pod.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// PodSecurityContext specifies the PodSecurityContext to apply.
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext specifies the container's SecurityContext to apply.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// SchedulerName specifies the scheduler that will be used for scheduling
+	// +optional
+	SchedulerName *string `json:"schedulerName,omitempty"`
+	// Sidecars is a list of sidecar containers that run along side the main Spark container.
+	// +optional
+	Sidecars []corev1.Container `json:"sidecars,omitempty"`
+	// InitContainers is a list of init-containers that run to completion before the main Spark container.
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+	// HostNetwork indicates whether to request host networking for the pod or not.
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+	// NodeSelector is the Kubernetes node selector to be added to the driver and executor pods.
+	// This field is mutually exclusive with nodeSelector at SparkApplication level (which will be deprecated).
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Arch is the CPU architecture of the nodes the pod is scheduled on, overriding the arch of the application.
+	// +kubebuilder:validation:Enum={amd64,arm64}
+	// +optional
+	Arch *Arch `json:"arch,omitempty"`
+	// DnsConfig dns settings for the pod, following the Kubernetes specifications.
+	// +optional
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	// Termination grace period seconds for the pod
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ServiceAccount is the name of the custom Kubernetes service account used by the pod.
+	// Set to `auto` to use a service account, role and role binding with the minimal permissions of the driver,
+	// provisioned by the operator for the application and deleted along with it.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+	// IAMRoleARN is the ARN of the AWS IAM role the pod assumes with a web identity token of its service account,
+	// as with IAM Roles for Service Accounts (IRSA). The operator projects the token into the pod and configures the
+	// S3A connector to use it. The `auto` service account is annotated with the role for the EKS Pod Identity
+	// Webhook. Takes precedence over the role of the S3 cloud storage configuration.
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+	// HostAliases settings for the pod, following the Kubernetes specifications.
+	// +optional
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+	// ShareProcessNamespace settings for the pod, following the Kubernetes specifications.
+	// +optional
+	ShareProcessNamespace *bool `json:"shareProcessNamespace,omitempty"`
+}
+
+// DriverSpec is specification of the driver.
+type DriverSpec struct {
+	SparkPodSpec `json:",inline"`
+	// PodName is the name of the driver pod that the user creates. This is used for the
+	// in-cluster client mode in which the user creates a client pod where the driver of
+	// the user application runs. It's an error to set this field if Mode is not
+	// in-cluster-client.
+	// +optional
+	// +kubebuilder:validation:Pattern=[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*
+	PodName *string `json:"podName,omitempty"`
+	// JavaOptions is a string of extra JVM options to pass to the driver. For instance,
+	// GC settings or other logging.
+	// +optional
+	JavaOptions *string `json:"javaOptions,omitempty"`
+	// Lifecycle for running preStop or postStart commands
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
+	// KubernetesMaster is the URL of the Kubernetes master used by the driver to manage executor pods and
+	// other Kubernetes resources. Default to https://kubernetes.default.svc.
+	// +optional
+	KubernetesMaster *string `json:"kubernetesMaster,omitempty"`
+	// ServiceAnnotations defines the annotations to be added to the Kubernetes headless service used by
+	// executors to connect to the driver.
+	// +optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+	// ServiceLabels defines the labels to be added to the Kubernetes headless service used by
+	// executors to connect to the driver.
+	// +optional
+	ServiceLabels map[string]string `json:"serviceLabels,omitempty"`
+	// Ports settings for the pods, following the Kubernetes specifications.
+	// +optional
+	Ports []Port `json:"ports,omitempty"`
+	// PriorityClassName is the name of the PriorityClass for the driver pod.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// UI configures the Spark web UI of the driver.
+	// +optional
+	UI *DriverUISpec `json:"ui,omitempty"`
+	// Diagnostics configures the collection of heap dumps and fatal error logs of the driver JVM.
+	// +optional
+	Diagnostics *DriverDiagnostics `json:"diagnostics,omitempty"`
+	// Service exposes ports of the driver, such as the JDBC port of a Spark Thrift Server, through a Service
+	// managed by the operator. Unlike the headless Service created by Spark, its name is stable across
+	// submissions of the application.
+	// +optional
+	Service *DriverServiceSpec `json:"service,omitempty"`
+	// HeadlessService customizes how executors and other clients of the cluster reach the driver.
+	// +optional
+	HeadlessService *DriverHeadlessServiceSpec `json:"headlessService,omitempty"`
+}
+
+// DriverUISpec configures the Spark web UI of the driver.
+type DriverUISpec struct {
+	// Enabled specifies whether the Spark web UI is enabled. When disabled, spark.ui.enabled is set to false
+	// and no UI Service or Ingress is created. Defaults to the operator setting.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// DriverServiceSpec configures the Service exposing ports of the driver.
+type DriverServiceSpec struct {
+	// Type is the type of the Service. `Route` creates a ClusterIP Service and an OpenShift Route for each of its
+	// ports, which only carry HTTP traffic, e.g. the Spark Thrift Server in HTTP transport mode.
+	// +kubebuilder:validation:Enum={ClusterIP,NodePort,LoadBalancer,Route}
+	// +kubebuilder:default=ClusterIP
+	// +optional
+	Type DriverServiceType `json:"type,omitempty"`
+	// Ports are the ports of the driver exposed by the Service.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Ports []DriverServicePort `json:"ports"`
+	// Annotations are added to the Service, e.g. to configure the load balancer of the cloud provider.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels are added to the Service.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// DriverServiceType is the type of the Service exposing ports of the driver.
+type DriverServiceType string
+
+// Different types of Services exposing ports of the driver.
+const (
+	DriverServiceTypeClusterIP    DriverServiceType = "ClusterIP"
+	DriverServiceTypeNodePort     DriverServiceType = "NodePort"
+	DriverServiceTypeLoadBalancer DriverServiceType = "LoadBalancer"
+	DriverServiceTypeRoute        DriverServiceType = "Route"
+)
+
+// DriverServicePort is a port of the driver exposed by a Service.
+type DriverServicePort struct {
+	// Name is the name of the port in the Service.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Port is the port of the Service.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+	// TargetPort is the port the driver listens on. Defaults to port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	TargetPort *int32 `json:"targetPort,omitempty"`
+	// Protocol is the protocol of the port. Defaults to TCP.
+	// +optional
+	Protocol *corev1.Protocol `json:"protocol,omitempty"`
+	// NodePort is the port of the nodes the port is exposed on when the Service is a NodePort or LoadBalancer
+	// Service. Allocated by Kubernetes if unset.
+	// +optional
+	NodePort *int32 `json:"nodePort,omitempty"`
+}
+
+// DriverHeadlessServiceSpec customizes the headless Services of the driver.
+type DriverHeadlessServiceSpec struct {
+	// Name is the name of an additional headless Service created by the operator for the driver. Unlike the one
+	// created by Spark, whose name is generated on each submission, it gives the driver the stable hostnames
+	// <name>.<namespace>.svc and <driver pod name>.<name>.<namespace>.svc.
+	// The serviceLabels and serviceAnnotations of the driver are also added to this Service.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// PublishNotReadyAddresses makes the headless Services of the driver publish its address before it is ready,
+	// so that it can be resolved while its readiness is held back, e.g. by a service mesh sidecar.
+	// +optional
+	PublishNotReadyAddresses *bool `json:"publishNotReadyAddresses,omitempty"`
+}
+
+// DriverDiagnostics configures the volume the driver JVM writes heap dumps and fatal error logs to, and whether
+// they are copied to object storage when the driver fails.
+type DriverDiagnostics struct {
+	// HeapDumpOnOutOfMemoryError makes the driver JVM write a heap dump when it runs out of memory.
+	// Defaults to true.
+	// +optional
+	HeapDumpOnOutOfMemoryError *bool `json:"heapDumpOnOutOfMemoryError,omitempty"`
+	// ClaimName is the name of a PersistentVolumeClaim the dumps are written to, so that they outlive the driver pod.
+	// The dumps are written to an emptyDir volume if unset.
+	// +optional
+	ClaimName *string `json:"claimName,omitempty"`
+	// SizeLimit is the size limit of the emptyDir volume the dumps are written to.
+	// +optional
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+	// Collect copies the dumps to the archive object storage of the operator when the driver fails, and records
+	// their URL in the status. A sidecar container keeps the dumps available until they have been collected.
+	// Requires archival and pod exec to be enabled on the operator.
+	// +optional
+	Collect *bool `json:"collect,omitempty"`
+}
+
+// ExecutorSpec is specification of the executor.
+type ExecutorSpec struct {
+	SparkPodSpec `json:",inline"`
+	// Instances is the number of executor instances.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Instances *int32 `json:"instances,omitempty"`
+	// JavaOptions is a string of extra JVM options to pass to the executors. For instance,
+	// GC settings or other logging.
+	// +optional
+	JavaOptions *string `json:"javaOptions,omitempty"`
+	// Lifecycle for running preStop or postStart commands
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
+	// DeleteOnTermination specify whether executor pods should be deleted in case of failure or normal termination.
+	// Maps to `spark.kubernetes.executor.deleteOnTermination` that is available since Spark 3.0.
+	// +optional
+	DeleteOnTermination *bool `json:"deleteOnTermination,omitempty"`
+	// Ports settings for the pods, following the Kubernetes specifications.
+	// +optional
+	Ports []Port `json:"ports,omitempty"`
+	// PriorityClassName is the name of the PriorityClass for the executor pod.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+	// PodDisruptionBudget, if specified, makes the operator create a PodDisruptionBudget selecting
+	// the executor pods of the application, protecting them from voluntary disruptions such as node drains.
+	// +optional
+	PodDisruptionBudget *ExecutorPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// DecommissionOnNodeEviction specifies whether executors running on nodes that are cordoned or tainted
+	// for termination (e.g. spot/preemptible instance reclaims) should be decommissioned gracefully by the operator.
+	// Enables `spark.decommission.enabled` and `spark.storage.decommission.enabled` unless set explicitly.
+	// Requires the `ExecutorDecommission` feature gate on the operator.
+	// +optional
+	DecommissionOnNodeEviction *bool `json:"decommissionOnNodeEviction,omitempty"`
+	// EphemeralPVC, if specified, makes Spark create a PersistentVolumeClaim on demand for every executor,
+	// e.g. for shuffle and scratch data. The operator deletes the claims left over by the application
+	// once it terminates.
+	// +optional
+	EphemeralPVC *ExecutorEphemeralPVC `json:"ephemeralPVC,omitempty"`
+	// TopologySpreadConstraints are added to the executor pods, e.g. to spread them evenly across zones or nodes.
+	// Constraints without a label selector select the executors of the application.
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// InPlaceScaling makes changes of Instances apply to a running application without rerunning it, by asking
+	// its driver to add or remove executors. Without it, changing Instances reruns the application.
+	// +optional
+	InPlaceScaling *ExecutorInPlaceScaling `json:"inPlaceScaling,omitempty"`
+}
+
+// ExecutorInPlaceScaling configures the driver plugin the operator calls to change the number of executors of a
+// running application. Spark does not serve such an endpoint itself; the operator ships a driver plugin serving
+// it, see spark-plugins/executor-scaling.
+type ExecutorInPlaceScaling struct {
+	// PluginClass is the fully qualified class name of the driver plugin serving Path, which is appended to
+	// `spark.plugins`, e.g. `org.kubeflow.spark.operator.plugin.ExecutorScalingPlugin`.
+	// The plugin jar must be available on the driver classpath, e.g. through the image or `spec.deps.jars`.
+	PluginClass string `json:"pluginClass"`
+	// Path is the HTTP path on the driver the new number of executors is POSTed to, as the JSON object
+	// `{"totalExecutors": <instances>}`. Defaults to `/executors/scale`.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// Port is the driver port the plugin serves Path on. Defaults to 4045.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// MaxInstances is the largest number of executors the application may be scaled to. Instances may not exceed
+	// it, and the plugin does not request more executors than it. Defaults to 100.
+	// +optional
+	MaxInstances *int32 `json:"maxInstances,omitempty"`
+}
+
+// StreamingSpec configures the health checking of streaming applications.
+type StreamingSpec struct {
+	// CheckpointLocation is the directory where the streaming queries checkpoint their progress. It is passed to
+	// Spark as `spark.sql.streaming.checkpointLocation` on every submission so that a restarted driver resumes
+	// from the same checkpoint. A local path must be inside a driver volume mount backed by a
+	// PersistentVolumeClaim, in which case the controller waits for the claim to be released by the previous
+	// driver before resubmitting the application.
+	// +optional
+	CheckpointLocation *string `json:"checkpointLocation,omitempty"`
+	// LivenessCheck, if specified, makes the controller restart the application when its
+	// streaming queries stop making progress without failing.
+	// +optional
+	LivenessCheck *StreamingLivenessCheck `json:"livenessCheck,omitempty"`
+}
+
+// StreamingLivenessCheck describes how the controller detects stalled streaming queries. The controller
+// periodically scrapes ProgressMetric from the driver and considers the application stalled if the metric
+// value has not changed for MaxBatchDelay.
+type StreamingLivenessCheck struct {
+	// Path is the HTTP path on the driver serving metrics in the Prometheus text format.
+	// Defaults to `/metrics/prometheus`, served when the Spark PrometheusServlet sink is configured.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// Port is the driver port serving Path. Defaults to the Spark web UI port.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// ProgressMetric is the name of a metric that changes as the streaming queries make progress,
+	// e.g. a counter of processed batches or rows. Values of all series with this name are summed up.
+	ProgressMetric string `json:"progressMetric"`
+	// MaxBatchDelay is the longest time ProgressMetric may remain unchanged before the application is
+	// considered stalled.
+	MaxBatchDelay metav1.Duration `json:"maxBatchDelay"`
+	// PeriodSeconds is how often the check is performed. Defaults to 30.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	PeriodSeconds *int32 `json:"periodSeconds,omitempty"`
+	// MaxRestarts is the maximum number of restarts triggered by the liveness check. Once reached,
+	// a stalled application is failed instead, which leaves the decision to the restart policy.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+}
+
+// StreamingStatus records the progress observed by the streaming liveness check.
+type StreamingStatus struct {
+	// LastProgressValue is the last observed value of the progress metric.
+	// +optional
+	LastProgressValue string `json:"lastProgressValue,omitempty"`
+	// LastProgressTime is the time when the progress metric was last observed to change.
+	// +optional
+	// +nullable
+	LastProgressTime metav1.Time `json:"lastProgressTime,omitempty"`
+	// StallRestarts is the number of restarts triggered by the streaming liveness check.
+	// +optional
+	StallRestarts int32 `json:"stallRestarts,omitempty"`
+	// LastStallRestartTime is the time of the last restart triggered by the streaming liveness check.
+	// +optional
+	// +nullable
+	LastStallRestartTime metav1.Time `json:"lastStallRestartTime,omitempty"`
+}
+
+// InteractiveProtocol is the protocol clients of an interactive application connect to the driver with.
+type InteractiveProtocol string
+
+const (
+	// InteractiveProtocolConnect serves Spark Connect clients.
+	InteractiveProtocolConnect InteractiveProtocol = "connect"
+	// InteractiveProtocolThrift serves JDBC and ODBC clients through the Spark Thrift Server.
+	InteractiveProtocolThrift InteractiveProtocol = "thrift"
+)
+
+// InteractiveSpec configures an interactive application, whose driver keeps running a server for clients to
+// submit work to until it is stopped or has been idle for too long.
+type InteractiveSpec struct {
+	// Protocol is the protocol clients connect to the driver with. Spark Connect requires Spark 3.4 or higher
+	// and the Thrift Server requires a Spark distribution built with Hive.
+	// +kubebuilder:validation:Enum={connect,thrift}
+	// +kubebuilder:default=connect
+	// +optional
+	Protocol InteractiveProtocol `json:"protocol,omitempty"`
+	// Port is the port the driver serves clients on.
+	// Defaults to 15002 for Spark Connect and 10000 for the Thrift Server.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// IdleTimeoutSeconds is how long the driver may run no Spark job before the operator stops the application,
+	// which then completes. Activity is observed through the REST API of the driver web UI.
+	// The application runs until it is stopped if unset.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	IdleTimeoutSeconds *int64 `json:"idleTimeoutSeconds,omitempty"`
+}
+
+// MetadataPropagation selects the labels and annotations of a SparkApplication propagated to the resources created
+// for it. Keys are matched exactly, or by prefix if they end with `*`, e.g. `cost-center.example.com/*`, and `*`
+// alone matches all keys. Labels and annotations set explicitly on the resources, e.g. in spec.driver.labels,
+// take precedence over propagated ones.
+type MetadataPropagation struct {
+	// Labels are the keys of the labels propagated. Kueue labels are never propagated.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+	// Annotations are the keys of the annotations propagated.
+	// +optional
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// InteractiveStatus records the endpoint and the activity of an interactive application.
+type InteractiveStatus struct {
+	// Endpoint is the URL clients connect to the driver at, e.g. `sc://spark-pi-connect.default.svc:15002`.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// LastActivityTime is the last time the driver was observed running a Spark job, or started running.
+	// +optional
+	// +nullable
+	LastActivityTime metav1.Time `json:"lastActivityTime,omitempty"`
+}
+
+// DryRunStatus records the rendering of a SparkApplication with the dry-run annotation, which is rendered instead
+// of being submitted.
+type DryRunStatus struct {
+	// ConfigMapName is the name of the ConfigMap holding the spark-submit arguments, Spark configuration, driver
+	// pod and Services the operator would create for the application.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+	// ObservedGeneration is the generation of the application that was rendered.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// RenderTime is the time the application was rendered.
+	// +optional
+	// +nullable
+	RenderTime metav1.Time `json:"renderTime,omitempty"`
+	// ErrorMessage is the error the submission of the application would fail with, if any.
+	// +optional
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+// Hooks configures the container lifecycle hooks of the driver and executors and the hooks run by the
+// operator when the application reaches certain states.
+type Hooks struct {
+	// Driver is the lifecycle of the driver container. It is used when `spec.driver.lifecycle` is not set.
+	// +optional
+	Driver *corev1.Lifecycle `json:"driver,omitempty"`
+	// Executor is the lifecycle of the executor containers. It is used when `spec.executor.lifecycle` is not set.
+	// +optional
+	Executor *corev1.Lifecycle `json:"executor,omitempty"`
+	// Operator is a list of hooks run by the operator, e.g. to trigger downstream pipelines or cleanup.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Operator []OperatorHook `json:"operator,omitempty"`
+}
+
+// HookEvent is an application event an operator hook can be run on.
+// +kubebuilder:validation:Enum={Submitted,FailedSubmission,Completed,Failed}
+type HookEvent string
+
+// Application events operator hooks can be run on.
+const (
+	HookEventSubmitted        HookEvent = "Submitted"
+	HookEventFailedSubmission HookEvent = "FailedSubmission"
+	HookEventCompleted        HookEvent = "Completed"
+	HookEventFailed           HookEvent = "Failed"
+)
+
+// OperatorHook is a hook run by the operator once per submission for each of its events.
+// Exactly one of Job and HTTP must be set.
+type OperatorHook struct {
+	// Name is the name of the hook, unique within the application.
+	Name string `json:"name"`
+	// Events are the application events the hook is run on.
+	// +kubebuilder:validation:MinItems=1
+	Events []HookEvent `json:"events"`
+	// Job is a template of a Job created in the namespace of the application. The containers of the Job
+	// get the SPARK_APPLICATION_NAME, SPARK_APPLICATION_NAMESPACE, SPARK_APPLICATION_STATE and
+	// SPARK_APPLICATION_ID environment variables.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type:=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Job *batchv1.JobTemplateSpec `json:"job,omitempty"`
+	// HTTP is an HTTP request sent with a JSON description of the application and the event.
+	// +optional
+	HTTP *HTTPHook `json:"http,omitempty"`
+}
+
+// HTTPHook is an HTTP request sent by the operator.
+type HTTPHook struct {
+	// URL is the absolute http or https URL the request is sent to.
+	URL string `json:"url"`
+	// Method is the HTTP method of the request. Defaults to POST.
+	// +optional
+	Method *string `json:"method,omitempty"`
+	// Headers are additional headers of the request.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+	// TimeoutSeconds is the timeout of the request. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// HookPhase is the outcome of running an operator hook.
+type HookPhase string
+
+// Outcomes of running an operator hook.
+const (
+	HookPhasePending   HookPhase = "Pending"
+	HookPhaseSucceeded HookPhase = "Succeeded"
+	HookPhaseFailed    HookPhase = "Failed"
+)
+
+// HookStatus records the latest run of an operator hook for an event.
+type HookStatus struct {
+	// Name is the name of the hook.
+	Name string `json:"name"`
+	// Event is the application event the hook was run on.
+	Event HookEvent `json:"event"`
+	// SubmissionID is the ID of the submission the hook was run for.
+	// +optional
+	SubmissionID string `json:"submissionID,omitempty"`
+	// Phase tells whether the Job was created or the HTTP request succeeded.
+	Phase HookPhase `json:"phase"`
+	// Message is a human-readable description of the outcome.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// JobName is the name of the Job created for a Job hook.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+	// Time is the time when the hook was run.
+	Time metav1.Time `json:"time"`
+}
+
+// NotificationSpec configures the notifications sent by the operator on state transitions of an application.
+type NotificationSpec struct {
+	// Webhooks are HTTP endpoints a JSON description of the application is POSTed to on state transitions.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Webhooks []NotificationWebhook `json:"webhooks,omitempty"`
+	// Emails are messages sent through the SMTP server configured on the operator on state transitions.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Emails []NotificationEmail `json:"emails,omitempty"`
+}
+
+// NotificationWebhook is an HTTP endpoint notified of the state transitions of an application.
+type NotificationWebhook struct {
+	// Name is the name of the webhook, unique among the webhooks and emails of the application.
+	Name string `json:"name"`
+	// Type is the format of the notifications. Generic webhooks receive a JSON description of the application,
+	// Slack and Teams webhooks receive a human-readable message for an incoming webhook of the chat service.
+	// +kubebuilder:validation:Enum={Generic,Slack,Teams}
+	// +optional
+	Type NotificationWebhookType `json:"type,omitempty"`
+	// URL is the absolute http or https URL the notifications are POSTed to.
+	// Exactly one of URL and URLSecret must be specified.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// URLSecret selects a key of a Secret in the namespace of the application holding the URL, for endpoints
+	// whose URL embeds a credential such as Slack and Teams incoming webhooks.
+	// +optional
+	URLSecret *corev1.SecretKeySelector `json:"urlSecret,omitempty"`
+	// AuthSecret selects a key of a Secret in the namespace of the application whose value is sent as the
+	// Authorization header of the notifications, e.g. `Bearer <token>`.
+	// +optional
+	AuthSecret *corev1.SecretKeySelector `json:"authSecret,omitempty"`
+	// Events are the states whose transitions are notified. Defaults to all of them for generic webhooks,
+	// and to COMPLETED and FAILED for Slack and Teams webhooks.
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+	// MaxRetries is the number of times a failed delivery is retried with exponential backoff. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// TimeoutSeconds is the timeout of each delivery attempt. Defaults to 10.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// NotificationWebhookType is the format of the notifications sent to a webhook.
+type NotificationWebhookType string
+
+// Formats of the notifications sent to webhooks.
+const (
+	NotificationWebhookTypeGeneric NotificationWebhookType = "Generic"
+	NotificationWebhookTypeSlack   NotificationWebhookType = "Slack"
+	NotificationWebhookTypeTeams   NotificationWebhookType = "Teams"
+)
+
+// NotificationEmail is a list of recipients emailed on the state transitions of an application.
+type NotificationEmail struct {
+	// Name is the name of the email notification, unique among the webhooks and emails of the application.
+	Name string `json:"name"`
+	// To are the email addresses of the recipients.
+	// +kubebuilder:validation:MinItems=1
+	To []string `json:"to"`
+	// Events are the states whose transitions are notified. Defaults to COMPLETED and FAILED.
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+	// MaxRetries is the number of times a failed delivery is retried with exponential backoff. Defaults to 3.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
+// NotificationEvent is an application state whose transitions can be notified.
+// +kubebuilder:validation:Enum={SUBMITTED,RUNNING,COMPLETED,FAILED}
+type NotificationEvent string
+
+// Application states whose transitions can be notified.
+const (
+	NotificationEventSubmitted NotificationEvent = "SUBMITTED"
+	NotificationEventRunning   NotificationEvent = "RUNNING"
+	NotificationEventCompleted NotificationEvent = "COMPLETED"
+	NotificationEventFailed    NotificationEvent = "FAILED"
+)
+
+// NotificationPhase is the delivery phase of a notification.
+type NotificationPhase string
+
+// Delivery phases of a notification.
+const (
+	NotificationPhasePending   NotificationPhase = "Pending"
+	NotificationPhaseDelivered NotificationPhase = "Delivered"
+	NotificationPhaseFailed    NotificationPhase = "Failed"
+)
+
+// NotificationStatus records the delivery of the latest notification of a webhook or email for an event.
+type NotificationStatus struct {
+	// Name is the name of the webhook or email notification.
+	Name string `json:"name"`
+	// Event is the state transition that is notified.
+	Event NotificationEvent `json:"event"`
+	// SubmissionID is the ID of the submission the notification is sent for.
+	// +optional
+	SubmissionID string `json:"submissionID,omitempty"`
+	// Phase tells whether the notification is still being delivered, was delivered or could not be delivered.
+	Phase NotificationPhase `json:"phase"`
+	// Attempts is the number of delivery attempts so far.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty"`
+	// Message is a human-readable description of the outcome of the latest attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// EventTime is the time of the state transition.
+	EventTime metav1.Time `json:"eventTime"`
+	// LastAttemptTime is the time of the latest delivery attempt.
+	// +optional
+	// +nullable
+	LastAttemptTime metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
+// ExecutorDecommission records the graceful decommissioning of an executor triggered by a node eviction.
+type ExecutorDecommission struct {
+	// NodeName is the name of the node the executor was running on.
+	NodeName string `json:"nodeName"`
+	// Reason tells why the node is considered to be evicted.
+	Reason string `json:"reason"`
+	// DecommissionTime is the time when the operator started decommissioning the executor.
+	DecommissionTime metav1.Time `json:"decommissionTime"`
+}
+
+// ExecutorPodDisruptionBudget describes the PodDisruptionBudget created for the executor pods.
+// At most one of MinAvailable and MaxUnavailable can be specified.
+type ExecutorPodDisruptionBudget struct {
+	// MinAvailable is the number or percentage of executor pods that must still be available
+	// after an eviction.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// MaxUnavailable is the number or percentage of executor pods that can be unavailable
+	// after an eviction.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// ExecutorEphemeralPVC describes the PersistentVolumeClaims Spark creates on demand for the executors.
+type ExecutorEphemeralPVC struct {
+	// VolumeName is the name of the executor volume. Volumes whose name starts with `spark-local-dir-`
+	// are used by Spark as local storage for shuffle and scratch data. Defaults to `spark-local-dir-1`.
+	// +optional
+	VolumeName *string `json:"volumeName,omitempty"`
+	// MountPath is the path the volume is mounted at in the executor containers. Defaults to `/data`.
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+	// StorageClass is the storage class of the claims. Defaults to the default storage class of the cluster.
+	// +optional
+	StorageClass *string `json:"storageClass,omitempty"`
+	// SizeLimit is the requested size of each claim.
+	SizeLimit resource.Quantity `json:"sizeLimit"`
+	// ReuseClaims specifies whether the driver owns the claims and reuses the claims of lost executors for
+	// new executors. Defaults to true.
+	// +optional
+	ReuseClaims *bool `json:"reuseClaims,omitempty"`
+}
+
+// NamePath is a pair of a name and a path to which the named objects should be mounted to.
+type NamePath struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// SecretType tells the type of a secret.
+type SecretType string
+
+// An enumeration of secret types supported.
+const (
+	// SecretTypeGCPServiceAccount is for secrets from a GCP service account Json key file that needs
+	// the environment variable GOOGLE_APPLICATION_CREDENTIALS.
+	SecretTypeGCPServiceAccount SecretType = "GCPServiceAccount"
+	// SecretTypeHadoopDelegationToken is for secrets from an Hadoop delegation token that needs the
+	// environment variable HADOOP_TOKEN_FILE_LOCATION.
+	SecretTypeHadoopDelegationToken SecretType = "HadoopDelegationToken"
+	// SecretTypeGeneric is for secrets that needs no special handling.
+	SecretTypeGeneric SecretType = "Generic"
+	// SecretTypeVault is for secrets read from HashiCorp Vault instead of a Kubernetes Secret, in which case
+	// the name of the secret is its path in Vault.
+	SecretTypeVault SecretType = "Vault"
+)
+
+// VaultInjector tells how a secret is injected from HashiCorp Vault.
+type VaultInjector string
+
+const (
+	// VaultInjectorAgent injects the secret with the Vault Agent Injector, through pod annotations.
+	VaultInjectorAgent VaultInjector = "AgentInjector"
+	// VaultInjectorCSI mounts the secret with the Vault provider of the Secrets Store CSI driver.
+	VaultInjectorCSI VaultInjector = "CSI"
+)
+
+// VaultSecret describes how a secret is injected from HashiCorp Vault.
+type VaultSecret struct {
+	// Injector tells how the secret is injected, either AgentInjector or CSI. Defaults to AgentInjector.
+	// +kubebuilder:validation:Enum={AgentInjector,CSI}
+	// +optional
+	Injector VaultInjector `json:"injector,omitempty"`
+	// Role is the Vault role the pods authenticate as through the Kubernetes auth method.
+	// Required by the AgentInjector injector.
+	// +optional
+	Role string `json:"role,omitempty"`
+	// FileName is the name of the file the secret is rendered to under the secret path.
+	// Defaults to the last element of the secret name. Only used by the AgentInjector injector.
+	// +optional
+	FileName string `json:"fileName,omitempty"`
+	// Template is the Vault Agent template rendering the secret, e.g. into a properties file.
+	// Only used by the AgentInjector injector.
+	// +optional
+	Template string `json:"template,omitempty"`
+	// SecretProviderClass is the name of the SecretProviderClass mounting the secret.
+	// Required by the CSI injector.
+	// +optional
+	SecretProviderClass string `json:"secretProviderClass,omitempty"`
+}
+
+// DriverInfo captures information about the driver.
+type DriverInfo struct {
+	WebUIServiceName string `json:"webUIServiceName,omitempty"`
+	// UI Details for the UI created via ClusterIP service accessible from within the cluster.
+	WebUIAddress string `json:"webUIAddress,omitempty"`
+	WebUIPort    int32  `json:"webUIPort,omitempty"`
+	// Ingress Details if an ingress for the UI was created.
+	WebUIIngressName    string `json:"webUIIngressName,omitempty"`
+	WebUIIngressAddress string `json:"webUIIngressAddress,omitempty"`
+	PodName             string `json:"podName,omitempty"`
+	// ServiceName is the name of the Service exposing the ports of spec.driver.service.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+	// ServiceEndpoints are the addresses the ports of spec.driver.service are reachable at.
+	// +optional
+	ServiceEndpoints []DriverServiceEndpoint `json:"serviceEndpoints,omitempty"`
+	// Zone is the zone of the node the driver pod is scheduled on, recorded if spec.placement.colocateInZone is set.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// DriverServiceEndpoint is the addresses a port of the driver is reachable at.
+type DriverServiceEndpoint struct {
+	// Name is the name of the port.
+	Name string `json:"name"`
+	// Address is the in-cluster address of the port, as `<service>.<namespace>.svc:<port>`.
+	Address string `json:"address"`
+	// NodePort is the port of the nodes the port is exposed on by a NodePort or LoadBalancer Service.
+	// +optional
+	NodePort int32 `json:"nodePort,omitempty"`
+	// ExternalAddress is the address of the port outside of the cluster, i.e. the load balancer address and port of
+	// a LoadBalancer Service or the host of a Route. Empty until the load balancer or Route has been provisioned.
+	// +optional
+	ExternalAddress string `json:"externalAddress,omitempty"`
+}
+
+// SecretInfo captures information of a secret.
+type SecretInfo struct {
+	Name string     `json:"name"`
+	Path string     `json:"path"`
+	Type SecretType `json:"secretType"`
+	// Vault tells how the secret is injected from HashiCorp Vault. Required if the secret type is Vault.
+	// +optional
+	Vault *VaultSecret `json:"vault,omitempty"`
+}
+
+// NameKey represents the name and key of a SecretKeyRef.
+type NameKey struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// EnvSecretRef maps a key of a Secret to an environment variable.
+type EnvSecretRef struct {
+	// SecretName is the name of the Secret in the SparkApplication namespace.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+	// Key is the key of the Secret whose value populates the environment variable.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+	// EnvName is the name of the environment variable. Defaults to the key.
+	// +optional
+	EnvName *string `json:"envName,omitempty"`
+}
+
+// Port represents the port definition in the pods objects.
+type Port struct {
+	Name          string `json:"name"`
+	Protocol      string `json:"protocol"`
+	ContainerPort int32  `json:"containerPort"`
+}
+
+// MonitoringSpec defines the monitoring specification.
+type MonitoringSpec struct {
+	// ExposeDriverMetrics specifies whether to expose metrics on the driver.
+	ExposeDriverMetrics bool `json:"exposeDriverMetrics"`
+	// ExposeExecutorMetrics specifies whether to expose metrics on the executors.
+	ExposeExecutorMetrics bool `json:"exposeExecutorMetrics"`
+	// MetricsProperties is the content of a custom metrics.properties for configuring the Spark metric system.
+	// +optional
+	// If not specified, the content in spark-docker/conf/metrics.properties will be used.
+	MetricsProperties *string `json:"metricsProperties,omitempty"`
+	// MetricsPropertiesFile is the container local path of file metrics.properties for configuring
+	// the Spark metric system. If not specified, value /etc/metrics/conf/metrics.properties will be used.
+	// +optional
+	MetricsPropertiesFile *string `json:"metricsPropertiesFile,omitempty"`
+	// Prometheus is for configuring the Prometheus JMX exporter.
+	// +optional
+	Prometheus *PrometheusSpec `json:"prometheus,omitempty"`
+	// PrometheusServlet is for configuring the native PrometheusServlet metrics sink of Spark, which serves the
+	// metrics on the Spark UI port of the driver without the Prometheus JMX exporter.
+	// +optional
+	PrometheusServlet *PrometheusServletSpec `json:"prometheusServlet,omitempty"`
+	// TaskMetrics is for configuring the driver plugin that reports task-level metrics.
+	// +optional
+	TaskMetrics *TaskMetricsSpec `json:"taskMetrics,omitempty"`
+}
+
+// TaskMetricsSpec configures a Spark driver plugin that periodically reports a trimmed set of task and
+// stage metrics (completed/failed tasks, shuffle bytes, spills) of the application. The metrics are pushed
+// to the operator metrics endpoint, or to a Prometheus Pushgateway if PushgatewayURL is specified.
+type TaskMetricsSpec struct {
+	// PluginClass is the fully qualified class name of the driver plugin, which is appended to `spark.plugins`.
+	// The plugin jar must be available on the driver classpath, e.g. through the image or `spec.deps.jars`.
+	PluginClass string `json:"pluginClass"`
+	// PushgatewayURL is the URL of the Prometheus Pushgateway to push the metrics to.
+	// If not specified, the metrics are pushed to the operator metrics endpoint.
+	// +optional
+	PushgatewayURL *string `json:"pushgatewayURL,omitempty"`
+	// IntervalSeconds is the interval at which metrics are reported. Defaults to 30.
+	// +kubebuilder:validation:Minimum=5
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+}
+
+// PrometheusSpec defines the Prometheus specification when Prometheus is to be used for
+// collecting and exposing metrics.
+type PrometheusSpec struct {
+	// JmxExporterJar is the path to the Prometheus JMX exporter jar in the container.
+	JmxExporterJar string `json:"jmxExporterJar"`
+	// Port is the port of the HTTP server run by the Prometheus JMX exporter.
+	// If not specified, 8090 will be used as the default.
+	// +kubebuilder:validation:Minimum=1024
+	// +kubebuilder:validation:Maximum=49151
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// PortName is the port name of prometheus JMX exporter port.
+	// If not specified, jmx-exporter will be used as the default.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+	// ConfigFile is the path to the custom Prometheus configuration file provided in the Spark image.
+	// ConfigFile takes precedence over Configuration, which is shown below.
+	// +optional
+	ConfigFile *string `json:"configFile,omitempty"`
+	// Configuration is the content of the Prometheus configuration needed by the Prometheus JMX exporter.
+	// If not specified, the content in spark-docker/conf/prometheus.yaml will be used.
+	// Configuration has no effect if ConfigFile is set.
+	// +optional
+	Configuration *string `json:"configuration,omitempty"`
+}
+
+// PrometheusServletSpec configures the PrometheusServlet metrics sink of Spark. The driver metrics are served on
+// Path of the Spark UI, and, if the executor metrics are exposed, the executor metrics are served by the driver on
+// /metrics/executors/prometheus. The scrape annotations of the driver pod point to the driver metrics.
+type PrometheusServletSpec struct {
+	// Path is the path of the Spark UI the driver metrics are served on.
+	// If not specified, /metrics/prometheus will be used as the default.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// RemoteWrite configures a Prometheus agent sidecar in the driver pod which scrapes the driver and executor
+	// metrics and pushes them to a Prometheus remote-write endpoint, e.g. where Spark pods are not scraped.
+	// +optional
+	RemoteWrite *PrometheusRemoteWriteSpec `json:"remoteWrite,omitempty"`
+}
+
+// PrometheusRemoteWriteSpec defines the Prometheus remote-write endpoint the metrics of the driver and executors are
+// pushed to by a Prometheus agent running as a native sidecar of the driver pod.
+type PrometheusRemoteWriteSpec struct {
+	// URL is the URL of the Prometheus remote-write endpoint.
+	URL string `json:"url"`
+	// BearerTokenSecret selects the key of a secret in the namespace of the application holding the bearer token
+	// sent to the endpoint.
+	// +optional
+	BearerTokenSecret *corev1.SecretKeySelector `json:"bearerTokenSecret,omitempty"`
+	// IntervalSeconds is the interval at which the metrics are scraped. Defaults to 30.
+	// +kubebuilder:validation:Minimum=5
+	// +optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+	// Image is the container image of the Prometheus agent.
+	// If not specified, quay.io/prometheus/prometheus:v3.5.0 will be used as the default.
+	// +optional
+	Image *string `json:"image,omitempty"`
+}
+
+// LogFormat is the format of the driver and executor logs.
+type LogFormat string
+
+const (
+	// LogFormatText keeps the logging configuration shipped with the Spark image.
+	LogFormatText LogFormat = "text"
+	// LogFormatJSON writes one JSON object per log event to stdout.
+	LogFormatJSON LogFormat = "json"
+)
+
+// LoggingSpec defines how the driver and executors log.
+type LoggingSpec struct {
+	// Format is the format of the driver and executor logs. If set to json, the operator mounts a log4j2
+	// configuration matching the Spark version that writes structured logs to stdout, so that they can be
+	// collected by the platform log pipeline without sidecars or custom images. Defaults to text.
+	// +kubebuilder:validation:Enum={text,json}
+	// +optional
+	Format LogFormat `json:"format,omitempty"`
+}
+
+// HadoopSecuritySpec configures the Kerberos authentication of a SparkApplication. The operator logs in as the
+// principal with its keytab when submitting the application, so that spark-submit obtains the delegation tokens the
+// driver and executors authenticate with. The keytab is also mounted in the driver, which obtains new delegation
+// tokens before they expire and distributes them to the executors, so that long-running applications outlive the
+// maximum lifetime of the tokens.
+type HadoopSecuritySpec struct {
+	// Principal is the Kerberos principal the application authenticates as, e.g. etl@EXAMPLE.COM.
+	Principal string `json:"principal"`
+	// KerberosSecret is the name of a Secret holding the keytab of the principal in its krb5.keytab key and the
+	// Kerberos configuration in its krb5.conf key.
+	KerberosSecret string `json:"kerberosSecret"`
+	// AccessHadoopFileSystems are the secured Hadoop filesystems the application accesses besides the default
+	// filesystem, e.g. hdfs://namenode2:8020, which delegation tokens are also obtained for.
+	// +optional
+	AccessHadoopFileSystems []string `json:"accessHadoopFileSystems,omitempty"`
+}
+
+// CloudStorageSpec configures the access of a SparkApplication to cloud object stores.
+type CloudStorageSpec struct {
+	// S3 configures the S3A connector used to access Amazon S3 and S3-compatible object stores.
+	// +optional
+	S3 *S3StorageSpec `json:"s3,omitempty"`
+	// GCS configures the Cloud Storage connector used to access Google Cloud Storage.
+	// +optional
+	GCS *GCSStorageSpec `json:"gcs,omitempty"`
+	// Azure configures the ABFS connector used to access an Azure Data Lake Storage Gen2 storage account.
+	// +optional
+	Azure *AzureStorageSpec `json:"azure,omitempty"`
+}
+
+// S3StorageSpec configures the S3A connector. The connector authenticates with the credentials of the default
+// AWS provider chain unless static credentials or an IAM role are given.
+type S3StorageSpec struct {
+	// Endpoint is the endpoint of the S3 API, for S3-compatible object stores or regional endpoints.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+	// Region is the AWS region of the buckets.
+	// +optional
+	Region *string `json:"region,omitempty"`
+	// PathStyleAccess addresses buckets by path instead of virtual host, as most S3-compatible object stores
+	// require.
+	// +optional
+	PathStyleAccess *bool `json:"pathStyleAccess,omitempty"`
+	// CredentialsSecret is the name of a Secret holding static credentials in its AWS_ACCESS_KEY_ID and
+	// AWS_SECRET_ACCESS_KEY keys, exposed to the driver and executors as environment variables.
+	// +optional
+	CredentialsSecret *string `json:"credentialsSecret,omitempty"`
+	// RoleARN is the ARN of an IAM role assumed with a web identity token of the service account of the driver
+	// and executors, as with IAM Roles for Service Accounts (IRSA). The token is projected in the pods, so the
+	// service accounts do not need to be annotated for the EKS Pod Identity Webhook.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+}
+
+// GCSStorageSpec configures the Cloud Storage connector. The connector authenticates with the application default
+// credentials, which are those of the Google service account bound to the service account of the driver and
+// executors with GKE Workload Identity unless a service account key is given.
+type GCSStorageSpec struct {
+	// ProjectID is the ID of the Google Cloud project the buckets belong to.
+	// +optional
+	ProjectID *string `json:"projectId,omitempty"`
+	// ServiceAccountKeySecret is the name of a Secret holding the JSON key of a Google service account in its
+	// key.json key, mounted in the driver and executors.
+	// +optional
+	ServiceAccountKeySecret *string `json:"serviceAccountKeySecret,omitempty"`
+}
+
+// AzureStorageSpec configures the ABFS connector for a storage account. The connector authenticates with the
+// access key of the storage account if a Secret is given, or with a managed identity otherwise.
+type AzureStorageSpec struct {
+	// StorageAccount is the name of the storage account.
+	StorageAccount string `json:"storageAccount"`
+	// AccountKeySecret is the name of a Secret holding the access key of the storage account in its
+	// AZURE_STORAGE_ACCOUNT_KEY key, exposed to the driver and executors as an environment variable.
+	// +optional
+	AccountKeySecret *string `json:"accountKeySecret,omitempty"`
+	// ManagedIdentity configures the managed identity used to authenticate when no account key is given.
+	// Defaults to the system-assigned managed identity of the nodes.
+	// +optional
+	ManagedIdentity *AzureManagedIdentity `json:"managedIdentity,omitempty"`
+}
+
+// AzureManagedIdentity identifies an Azure managed identity.
+type AzureManagedIdentity struct {
+	// ClientID is the client ID of a user-assigned managed identity.
+	// +optional
+	ClientID *string `json:"clientId,omitempty"`
+	// TenantID is the ID of the Microsoft Entra tenant of the managed identity.
+	// +optional
+	TenantID *string `json:"tenantId,omitempty"`
+}
+
+// GCPSpec configures the access of a SparkApplication to Google Cloud.
+type GCPSpec struct {
+	// WorkloadIdentity runs the driver and executors as a Google service account with GKE Workload Identity, and
+	// configures the Cloud Storage connector to authenticate as it.
+	// +optional
+	WorkloadIdentity *GCPWorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// GCPWorkloadIdentity binds the Kubernetes service accounts of the driver and executors to a Google service account.
+type GCPWorkloadIdentity struct {
+	// ServiceAccount is the email of the Google service account, which must grant the Workload Identity User role
+	// to the Kubernetes service accounts. The operator annotates the Kubernetes service accounts with it, and fails
+	// the submission if they are already bound to another Google service account.
+	ServiceAccount string `json:"serviceAccount"`
+}
+
+// AzureSpec configures the access of a SparkApplication to Azure.
+type AzureSpec struct {
+	// WorkloadIdentity runs the driver and executors as a Microsoft Entra identity with Microsoft Entra Workload ID,
+	// and configures the ABFS connector to authenticate as it.
+	// +optional
+	WorkloadIdentity *AzureWorkloadIdentity `json:"workloadIdentity,omitempty"`
+	// KeyVault mounts objects of an Azure Key Vault in the driver and executors with the Azure provider of the
+	// Secrets Store CSI driver, authenticating as the workload identity.
+	// +optional
+	KeyVault *AzureKeyVault `json:"keyVault,omitempty"`
+}
+
+// AzureWorkloadIdentity identifies the Microsoft Entra application or user-assigned managed identity the driver and
+// executors run as. The identity must have a federated identity credential for their Kubernetes service accounts.
+type AzureWorkloadIdentity struct {
+	// ClientID is the client ID of the identity.
+	ClientID string `json:"clientId"`
+	// TenantID is the ID of the Microsoft Entra tenant of the identity.
+	TenantID string `json:"tenantId"`
+}
+
+// AzureKeyVault describes the objects of an Azure Key Vault mounted in the driver and executors. The operator creates
+// a SecretProviderClass for them, so the Secrets Store CSI driver and its Azure provider must be installed.
+type AzureKeyVault struct {
+	// Name is the name of the key vault.
+	Name string `json:"name"`
+	// Objects are the objects of the key vault mounted as files.
+	// +kubebuilder:validation:MinItems=1
+	Objects []AzureKeyVaultObject `json:"objects"`
+	// MountPath is the directory the objects are mounted in. Defaults to /etc/spark/azure-key-vault.
+	// +optional
+	MountPath *string `json:"mountPath,omitempty"`
+}
+
+// AzureKeyVaultObject is an object of an Azure Key Vault.
+type AzureKeyVaultObject struct {
+	// Name is the name of the object in the key vault.
+	Name string `json:"name"`
+	// Type is the type of the object, either secret, key or cert. Defaults to secret.
+	// +kubebuilder:validation:Enum={secret,key,cert}
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// Alias is the name of the file the object is mounted as. Defaults to the name of the object.
+	// +optional
+	Alias *string `json:"alias,omitempty"`
+}
+
+// EventPolicy decides which Kubernetes events the operator emits for a SparkApplication.
+type EventPolicy string
+
+const (
+	// EventPolicyAll emits every event.
+	EventPolicyAll EventPolicy = "All"
+	// EventPolicyStateChangesOnly omits the events recorded for every executor that becomes pending,
+	// running or completed, which can amount to thousands of events for large applications.
+	EventPolicyStateChangesOnly EventPolicy = "StateChangesOnly"
+	// EventPolicyErrorsOnly only emits warning events.
+	EventPolicyErrorsOnly EventPolicy = "ErrorsOnly"
+)
+
+// DynamicAllocation contains configuration options for dynamic allocation.
+type DynamicAllocation struct {
+	// Enabled controls whether dynamic allocation is enabled or not.
+	Enabled bool `json:"enabled,omitempty"`
+	// InitialExecutors is the initial number of executors to request. If .spec.executor.instances
+	// is also set, the initial number of executors is set to the bigger of that and this option.
+	// +optional
+	InitialExecutors *int32 `json:"initialExecutors,omitempty"`
+	// MinExecutors is the lower bound for the number of executors if dynamic allocation is enabled.
+	// +optional
+	MinExecutors *int32 `json:"minExecutors,omitempty"`
+	// MaxExecutors is the upper bound for the number of executors if dynamic allocation is enabled.
+	// +optional
+	MaxExecutors *int32 `json:"maxExecutors,omitempty"`
+	// ShuffleTrackingEnabled enables shuffle file tracking for executors, which allows dynamic allocation without
+	// the need for an external shuffle service. This option will try to keep alive executors that are storing
+	// shuffle data for active jobs. If external shuffle service is enabled, set ShuffleTrackingEnabled to false.
+	// ShuffleTrackingEnabled is true by default if dynamicAllocation.enabled is true.
+	// +optional
+	ShuffleTrackingEnabled *bool `json:"shuffleTrackingEnabled,omitempty"`
+	// ShuffleTrackingTimeout controls the timeout in milliseconds for executors that are holding
+	// shuffle data if shuffle tracking is enabled (true by default if dynamic allocation is enabled).
+	// +optional
+	ShuffleTrackingTimeout *int64 `json:"shuffleTrackingTimeout,omitempty"`
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&LabelPolicy{}, &LabelPolicyList{})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubeflow/spark-operator/pull/1298"
+// +kubebuilder:resource:scope=Cluster,shortName=labelpol,singular=labelpolicy
+// +kubebuilder:printcolumn:JSONPath=.metadata.creationTimestamp,name=Age,type=date
+
+// LabelPolicy injects labels, annotations and environment variables defined by platform teams into the Spark pods
+// of the namespaces and SparkApplications it selects, e.g. a cost-center label per namespace. Label policies are
+// evaluated by the mutating webhook of Spark pods.
+type LabelPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec LabelPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// LabelPolicyList contains a list of LabelPolicy.
+type LabelPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LabelPolicy `json:"items"`
+}
+
+// LabelPolicySpec defines the Spark pods a label policy selects and the metadata and environment injected into them.
+type LabelPolicySpec struct {
+	// NamespaceSelector selects the namespaces of the Spark pods by their labels. Unset selects every namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// ApplicationSelector selects the SparkApplications of the Spark pods by their labels. Unset selects every
+	// SparkApplication.
+	// +optional
+	ApplicationSelector *metav1.LabelSelector `json:"applicationSelector,omitempty"`
+	// Roles lists the Spark roles, driver or executor, of the pods the policy applies to. Empty selects both.
+	// +optional
+	Roles []LabelPolicyRole `json:"roles,omitempty"`
+	// Labels are added to the selected pods, unless they already have labels with the same keys.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the selected pods, unless they already have annotations with the same keys.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Env is added to the Spark container of the selected pods, unless it already defines variables with the same
+	// names.
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+}
+
+// LabelPolicyRole is the Spark role of the pods a label policy applies to.
+// +kubebuilder:validation:Enum={driver,executor}
+type LabelPolicyRole string
+
+// Different Spark roles of the pods a label policy applies to.
+const (
+	LabelPolicyRoleDriver   LabelPolicyRole = "driver"
+	LabelPolicyRoleExecutor LabelPolicyRole = "executor"
+)
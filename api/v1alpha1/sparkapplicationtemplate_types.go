@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	SchemeBuilder.Register(&SparkApplicationTemplate{}, &SparkApplicationTemplateList{})
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:annotations="api-approved.kubernetes.io=https://github.com/kubeflow/spark-operator/pull/1298"
+// +kubebuilder:resource:scope=Cluster,shortName=sparkapptemplate,singular=sparkapplicationtemplate
+// +kubebuilder:printcolumn:JSONPath=.metadata.creationTimestamp,name=Age,type=date
+
+// SparkApplicationTemplate is a baseline of SparkApplication specs defined by platform teams, e.g. hardened images,
+// security contexts, monitoring and tolerations. SparkApplications reference it with spec.templateRef.
+type SparkApplicationTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec SparkApplicationTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// SparkApplicationTemplateList contains a list of SparkApplicationTemplate.
+type SparkApplicationTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SparkApplicationTemplate `json:"items"`
+}
+
+// SparkApplicationTemplateSpec defines the baseline of the SparkApplications referencing the template.
+type SparkApplicationTemplateSpec struct {
+	// Template is a partial SparkApplication spec merged under the spec of the SparkApplications referencing the
+	// template by the defaulting webhook. Objects are merged field by field and maps key by key, while values set
+	// by the application, including lists, replace those of the template.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type:=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Template runtime.RawExtension `json:"template"`
+}
@@ -17,8 +17,12 @@ limitations under the License.
 package v1beta2
 
 import (
+	"fmt"
+	"math"
 	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
@@ -65,11 +69,16 @@ func SetSparkApplicationDefaults(app *SparkApplication) {
 		}
 	}
 
+	if app.Spec.Interactive != nil && app.Spec.Interactive.Protocol == "" {
+		app.Spec.Interactive.Protocol = InteractiveProtocolConnect
+	}
+
 	setDriverSpecDefaults(&app.Spec.Driver, app.Spec.SparkConf)
 	setExecutorSpecDefaults(&app.Spec.Executor, app.Spec.SparkConf, app.Spec.DynamicAllocation)
 }
 
 func setDriverSpecDefaults(spec *DriverSpec, sparkConf map[string]string) {
+	setPodResourcesDefaults(&spec.SparkPodSpec, &spec.CoreRequest)
 	if _, exists := sparkConf["spark.driver.cores"]; !exists && spec.Cores == nil {
 		spec.Cores = new(int32)
 		*spec.Cores = 1
@@ -81,6 +90,7 @@ func setDriverSpecDefaults(spec *DriverSpec, sparkConf map[string]string) {
 }
 
 func setExecutorSpecDefaults(spec *ExecutorSpec, sparkConf map[string]string, allocSpec *DynamicAllocation) {
+	setPodResourcesDefaults(&spec.SparkPodSpec, &spec.CoreRequest)
 	if _, exists := sparkConf["spark.executor.cores"]; !exists && spec.Cores == nil {
 		spec.Cores = new(int32)
 		*spec.Cores = 1
@@ -105,6 +115,47 @@ func setExecutorSpecDefaults(spec *ExecutorSpec, sparkConf map[string]string, al
 	}
 }
 
+// setPodResourcesDefaults sets the string-based resource fields of a driver or executor that are not set
+// from its structured resources, which is how the rest of the operator consumes them.
+func setPodResourcesDefaults(spec *SparkPodSpec, coreRequest **string) {
+	if spec.Resources == nil {
+		return
+	}
+
+	if cpu, ok := spec.Resources.Requests[corev1.ResourceCPU]; ok {
+		if *coreRequest == nil {
+			*coreRequest = ptr.To(cpu.String())
+		}
+		// Spark cores are whole numbers, a fractional request only sets the pod cpu request.
+		if spec.Cores == nil && cpu.MilliValue()%1000 == 0 && cpu.Value() >= 1 && cpu.Value() <= math.MaxInt32 {
+			spec.Cores = ptr.To(int32(cpu.Value()))
+		}
+	}
+	if cpu, ok := spec.Resources.Limits[corev1.ResourceCPU]; ok && spec.CoreLimit == nil {
+		spec.CoreLimit = ptr.To(cpu.String())
+	}
+	if memory, ok := spec.Resources.Requests[corev1.ResourceMemory]; ok && spec.Memory == nil {
+		spec.Memory = ptr.To(javaMemoryString(memory))
+	}
+	if memory, ok := spec.Resources.Limits[corev1.ResourceMemory]; ok && spec.MemoryLimit == nil {
+		spec.MemoryLimit = ptr.To(memory.String())
+	}
+}
+
+// javaMemoryString formats a memory quantity as a JVM memory string using the largest exact unit, e.g. "4g" for 4Gi.
+func javaMemoryString(q resource.Quantity) string {
+	bytes := q.Value()
+	for _, unit := range []struct {
+		suffix string
+		shift  uint
+	}{{"g", 30}, {"m", 20}, {"k", 10}} {
+		if bytes != 0 && bytes%(1<<unit.shift) == 0 {
+			return fmt.Sprintf("%d%s", bytes>>unit.shift, unit.suffix)
+		}
+	}
+	return fmt.Sprintf("%db", bytes)
+}
+
 func isDynamicAllocationEnabled(sparkConf map[string]string, allocSpec *DynamicAllocation) bool {
 	if allocSpec != nil {
 		return allocSpec.Enabled
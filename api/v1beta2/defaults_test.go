@@ -19,6 +19,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
 )
 
 func TestSetSparkApplicationDefaultsNilSparkApplicationShouldNotModifySparkApplication(t *testing.T) {
@@ -224,3 +227,57 @@ func TestSetSparkApplicationDefaultsExecutorSpecDefaults(t *testing.T) {
 	SetSparkApplicationDefaults(app)
 	assert.Nil(t, app.Spec.Executor.Instances)
 }
+
+func TestSetSparkApplicationDefaultsResourcesShouldSetUnsetResourceFields(t *testing.T) {
+	app := &SparkApplication{
+		Spec: SparkApplicationSpec{
+			Driver: DriverSpec{
+				SparkPodSpec: SparkPodSpec{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:              resource.MustParse("2"),
+							corev1.ResourceMemory:           resource.MustParse("4Gi"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2500m"),
+							corev1.ResourceMemory: resource.MustParse("5Gi"),
+						},
+					},
+				},
+			},
+			Executor: ExecutorSpec{
+				SparkPodSpec: SparkPodSpec{
+					Memory: ptr.To("2g"),
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("1536Mi"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	SetSparkApplicationDefaults(app)
+
+	assert.Equal(t, ptr.To[int32](2), app.Spec.Driver.Cores)
+	assert.Equal(t, ptr.To("2"), app.Spec.Driver.CoreRequest)
+	assert.Equal(t, ptr.To("2500m"), app.Spec.Driver.CoreLimit)
+	assert.Equal(t, ptr.To("4g"), app.Spec.Driver.Memory)
+	assert.Equal(t, ptr.To("5Gi"), app.Spec.Driver.MemoryLimit)
+
+	// A fractional cpu request only sets the core request, and set fields are left untouched.
+	assert.Equal(t, ptr.To[int32](1), app.Spec.Executor.Cores)
+	assert.Equal(t, ptr.To("500m"), app.Spec.Executor.CoreRequest)
+	assert.Equal(t, ptr.To("2g"), app.Spec.Executor.Memory)
+	assert.Nil(t, app.Spec.Executor.MemoryLimit)
+}
+
+func TestJavaMemoryString(t *testing.T) {
+	assert.Equal(t, "4g", javaMemoryString(resource.MustParse("4Gi")))
+	assert.Equal(t, "1536m", javaMemoryString(resource.MustParse("1536Mi")))
+	assert.Equal(t, "1000000k", javaMemoryString(resource.MustParse("1024M")))
+	assert.Equal(t, "1000b", javaMemoryString(resource.MustParse("1k")))
+}
@@ -104,6 +104,13 @@ Create the name of the service used by controller
 {{ include "spark-operator.controller.name" . }}-svc
 {{- end -}}
 
+{{/*
+Create the name of the configmap describing the resources of onboarded namespaces
+*/}}
+{{- define "spark-operator.controller.namespaceOnboardingConfigMapName" -}}
+{{ include "spark-operator.controller.name" . }}-namespace-onboarding
+{{- end -}}
+
 {{/*
 Create the role policy rules for the controller in every Spark job namespace
 */}}
@@ -157,14 +164,53 @@ Create the role policy rules for the controller in every Spark job namespace
   - update
   - patch
   - delete
+- apiGroups:
+  - ""
+  resources:
+  - pods/log
+  verbs:
+  - get
+{{- if .Values.controller.podExec.enable }}
+- apiGroups:
+  - ""
+  resources:
+  - pods/exec
+  verbs:
+  - create
+{{- end }}
 - apiGroups:
   - ""
   resources:
   - events
   verbs:
+  - list
   - create
   - update
   - patch
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+  - create
+  - update
+- apiGroups:
+  - ""
+  resources:
+  - serviceaccounts
+  verbs:
+  - get
+  - create
+- apiGroups:
+  - rbac.authorization.k8s.io
+  resources:
+  - roles
+  - rolebindings
+  verbs:
+  - get
+  - create
+  - update
 - apiGroups:
   - extensions
   - networking.k8s.io
@@ -177,6 +223,57 @@ Create the role policy rules for the controller in every Spark job namespace
   - create
   - update
   - delete
+- apiGroups:
+  - batch
+  resources:
+  - jobs
+  verbs:
+  - get
+  - create
+- apiGroups:
+  - policy
+  resources:
+  - poddisruptionbudgets
+  verbs:
+  - get
+  - list
+  - watch
+  - create
+  - update
+  - delete
+{{- if or (has "quota" .Values.controller.preflightChecks) .Values.controller.quotaWait.enable }}
+- apiGroups:
+  - ""
+  resources:
+  - resourcequotas
+  verbs:
+  - list
+{{- end }}
+{{- if .Values.controller.networkPolicies.enable }}
+- apiGroups:
+  - networking.k8s.io
+  resources:
+  - networkpolicies
+  verbs:
+  - get
+  - create
+  - update
+{{- end }}
+- apiGroups:
+  - route.openshift.io
+  resources:
+  - routes
+  verbs:
+  - get
+  - create
+- apiGroups:
+  - secrets-store.csi.x-k8s.io
+  resources:
+  - secretproviderclasses
+  verbs:
+  - get
+  - create
+  - update
 - apiGroups:
   - sparkoperator.k8s.io
   resources:
@@ -216,3 +313,34 @@ Create the role policy rules for the controller in every Spark job namespace
   - "*"
 {{- end }}
 {{- end -}}
+
+{{/*
+Validate that the controller manages only the release namespace without cluster-scoped access in lightweight mode.
+*/}}
+{{- define "spark-operator.controller.validateLightweight" -}}
+{{- if .Values.controller.lightweight.enable }}
+{{- if ne (toJson .Values.spark.jobNamespaces) (toJson (list .Release.Namespace)) }}
+{{- fail "controller.lightweight.enable requires spark.jobNamespaces to be the release namespace only" }}
+{{- end }}
+{{- if .Values.webhook.enable }}
+{{- fail "controller.lightweight.enable requires webhook.enable to be false" }}
+{{- end }}
+{{- if .Values.controller.leaderElection.enable }}
+{{- fail "controller.lightweight.enable requires controller.leaderElection.enable to be false" }}
+{{- end }}
+{{- if gt (int .Values.controller.replicas) 1 }}
+{{- fail "controller.lightweight.enable requires controller.replicas to be 1" }}
+{{- end }}
+{{- if .Values.hook.upgradeCrd }}
+{{- fail "controller.lightweight.enable requires hook.upgradeCrd to be false" }}
+{{- end }}
+{{- if or .Values.controller.priorityClasses.enable .Values.controller.namespaceOnboarding.enable .Values.controller.diagnostics.enable }}
+{{- fail "controller.lightweight.enable does not support controller.priorityClasses, controller.namespaceOnboarding or controller.diagnostics, which require cluster-scoped access" }}
+{{- end }}
+{{- range .Values.controller.featureGates }}
+{{- if and (eq .name "ExecutorDecommission") .enabled }}
+{{- fail "controller.lightweight.enable does not support the ExecutorDecommission feature gate, which watches nodes" }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end -}}
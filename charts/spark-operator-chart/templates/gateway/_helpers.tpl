@@ -0,0 +1,119 @@
+{{/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/}}
+
+{{/*
+Create the name of gateway component
+*/}}
+{{- define "spark-operator.gateway.name" -}}
+{{- include "spark-operator.fullname" . }}-gateway
+{{- end -}}
+
+{{/*
+Common labels for the gateway
+*/}}
+{{- define "spark-operator.gateway.labels" -}}
+{{ include "spark-operator.labels" . }}
+app.kubernetes.io/component: gateway
+{{- end -}}
+
+{{/*
+Selector labels for the gateway
+*/}}
+{{- define "spark-operator.gateway.selectorLabels" -}}
+{{ include "spark-operator.selectorLabels" . }}
+app.kubernetes.io/component: gateway
+{{- end -}}
+
+{{/*
+Create the name of service account to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.serviceAccountName" -}}
+{{- if .Values.gateway.serviceAccount.create -}}
+{{ .Values.gateway.serviceAccount.name | default (include "spark-operator.gateway.name" .) }}
+{{- else -}}
+{{ .Values.gateway.serviceAccount.name | default "default" }}
+{{- end -}}
+{{- end -}}
+
+{{/*
+Create the name of the cluster role to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.clusterRoleName" -}}
+{{ include "spark-operator.gateway.name" . }}
+{{- end }}
+
+{{/*
+Create the name of the cluster role binding to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.clusterRoleBindingName" -}}
+{{ include "spark-operator.gateway.clusterRoleName" . }}
+{{- end }}
+
+{{/*
+Create the name of the role to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.roleName" -}}
+{{ include "spark-operator.gateway.name" . }}
+{{- end }}
+
+{{/*
+Create the name of the role binding to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.roleBindingName" -}}
+{{ include "spark-operator.gateway.roleName" . }}
+{{- end }}
+
+{{/*
+Create the name of the deployment to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.deploymentName" -}}
+{{ include "spark-operator.gateway.name" . }}
+{{- end -}}
+
+{{/*
+Create the name of the service to be used by the gateway
+*/}}
+{{- define "spark-operator.gateway.serviceName" -}}
+{{ include "spark-operator.gateway.name" . }}-svc
+{{- end -}}
+
+{{/*
+Create the role policy rules for the gateway in every Spark job namespace
+*/}}
+{{- define "spark-operator.gateway.policyRules" -}}
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - get
+  - list
+- apiGroups:
+  - ""
+  resources:
+  - pods/log
+  verbs:
+  - get
+- apiGroups:
+  - sparkoperator.k8s.io
+  resources:
+  - sparkapplications
+  verbs:
+  - get
+  - list
+  - create
+  - delete
+{{- end -}}
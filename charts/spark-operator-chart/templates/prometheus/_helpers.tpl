@@ -20,3 +20,23 @@ Create the name of pod monitor
 {{- define "spark-operator.prometheus.podMonitorName" -}}
 {{- include "spark-operator.fullname" . }}-podmonitor
 {{- end -}}
+
+{{/*
+Create the namespace of the Grafana dashboard config maps
+*/}}
+{{- define "spark-operator.prometheus.grafanaDashboardsNamespace" -}}
+{{- if .Values.prometheus.grafanaDashboards.namespace -}}
+{{- .Values.prometheus.grafanaDashboards.namespace }}
+{{- else if .Values.prometheus.grafanaDashboards.openshift.enable -}}
+openshift-config-managed
+{{- else -}}
+{{- .Release.Namespace }}
+{{- end -}}
+{{- end -}}
+
+{{/*
+Create the name of prometheus rule
+*/}}
+{{- define "spark-operator.prometheus.prometheusRuleName" -}}
+{{- include "spark-operator.fullname" . }}-prometheusrule
+{{- end -}}
@@ -45,3 +45,10 @@ Create the name of the role binding to be used by spark service account
 {{- define "spark-operator.spark.roleBindingName" -}}
 {{- include "spark-operator.spark.serviceAccountName" . }}
 {{- end -}}
+
+{{/*
+Create the name of the cluster role allowing spark applications to report task metrics to the controller
+*/}}
+{{- define "spark-operator.spark.taskMetricsClusterRoleName" -}}
+{{ include "spark-operator.spark.name" . }}-task-metrics
+{{- end -}}
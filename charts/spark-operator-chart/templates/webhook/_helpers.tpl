@@ -90,6 +90,20 @@ Create the name of the service to be used by webhook
 {{ include "spark-operator.webhook.name" . }}-svc
 {{- end -}}
 
+{{/*
+Create the name of the configmap of the placement policy of the webhook
+*/}}
+{{- define "spark-operator.webhook.placementPolicyConfigMapName" -}}
+{{ include "spark-operator.webhook.name" . }}-placement-policy
+{{- end -}}
+
+{{/*
+Create the name of the configmap of the configuration policy of the webhook
+*/}}
+{{- define "spark-operator.webhook.configurationPolicyConfigMapName" -}}
+{{ include "spark-operator.webhook.name" . }}-configuration-policy
+{{- end -}}
+
 {{/*
 Create the name of mutating webhook configuration
 */}}
@@ -141,10 +155,19 @@ Create the role policy rules for the webhook in every Spark job namespace
   - ""
   resources:
   - resourcequotas
+  - limitranges
   verbs:
   - get
   - list
   - watch
+{{- if ne .Values.webhook.envSecretRefValidation "disabled" }}
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+{{- end }}
 - apiGroups:
   - sparkoperator.k8s.io
   resources:
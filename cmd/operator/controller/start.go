@@ -17,12 +17,16 @@ limitations under the License.
 package controller
 
 import (
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -30,7 +34,9 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	// Import features package to register feature gates.
-	_ "github.com/kubeflow/spark-operator/v2/pkg/features"
+	"github.com/kubeflow/spark-operator/v2/pkg/features"
+	"k8s.io/apiserver/pkg/apis/apiserver"
+	"k8s.io/apiserver/pkg/authentication/authenticatorfactory"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/client-go/rest"
 
@@ -39,10 +45,18 @@ import (
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/time/rate"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -50,20 +64,33 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	sparkoperator "github.com/kubeflow/spark-operator/v2"
 	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/archive"
+	"github.com/kubeflow/spark-operator/v2/internal/audit"
+	"github.com/kubeflow/spark-operator/v2/internal/cloudevents"
+	"github.com/kubeflow/spark-operator/v2/internal/controller/namespace"
+	"github.com/kubeflow/spark-operator/v2/internal/controller/priorityclass"
 	"github.com/kubeflow/spark-operator/v2/internal/controller/scheduledsparkapplication"
 	"github.com/kubeflow/spark-operator/v2/internal/controller/sparkapplication"
 	"github.com/kubeflow/spark-operator/v2/internal/controller/sparkconnect"
+	"github.com/kubeflow/spark-operator/v2/internal/diagnostics"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/internal/health"
 	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+	"github.com/kubeflow/spark-operator/v2/internal/preflight"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/kubescheduler"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/volcano"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/yunikorn"
+	"github.com/kubeflow/spark-operator/v2/internal/sharding"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	operatorscheme "github.com/kubeflow/spark-operator/v2/pkg/scheme"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
@@ -74,18 +101,28 @@ var (
 	logger = ctrl.Log.WithName("")
 )
 
+// controllerNames are the names of the controllers run by the operator.
+var controllerNames = []string{"SparkApplication", "ScheduledSparkApplication", "SparkConnect", "PriorityClass", "Namespace"}
+
 var (
-	namespaces []string
+	namespaces  []string
+	lightweight bool
 
 	// Controller
 	controllerThreads        int
+	controllerThreadsByName  map[string]int
 	cacheSyncTimeout         time.Duration
+	cacheResyncPeriod        time.Duration
 	maxTrackedExecutorPerApp int
+	executorPodMetadataOnly  bool
+	executorPodCache         cache.Cache
 
 	//WorkQueue
 	workqueueRateLimiterBucketQPS  int
 	workqueueRateLimiterBucketSize int
 	workqueueRateLimiterMaxDelay   time.Duration
+	workqueueRateLimiterBaseDelay  time.Duration
+	workqueueRateLimiterFailureMax time.Duration
 
 	// Batch scheduler
 	enableBatchScheduler  bool
@@ -94,20 +131,101 @@ var (
 
 	// Spark web UI service and ingress
 	enableUIService    bool
+	disableSparkUI     bool
 	ingressClassName   string
 	ingressURLFormat   string
 	ingressTLS         []networkingv1.IngressTLS
 	ingressAnnotations map[string]string
 
+	// Spark History Server
+	historyServerURLFormat string
+
 	// Leader election
-	enableLeaderElection        bool
-	leaderElectionLockName      string
-	leaderElectionLockNamespace string
-	leaderElectionLeaseDuration time.Duration
-	leaderElectionRenewDeadline time.Duration
-	leaderElectionRetryPeriod   time.Duration
+	enableLeaderElection          bool
+	leaderElectionLockName        string
+	leaderElectionLockNamespace   string
+	leaderElectionLeaseDuration   time.Duration
+	leaderElectionRenewDeadline   time.Duration
+	leaderElectionRetryPeriod     time.Duration
+	leaderElectionReleaseOnCancel bool
+	readinessRequiresLeadership   bool
+
+	// Sharding
+	shardID         string
+	shardIDs        []string
+	shardsConfigMap string
+	shard           *sharding.Shard
+
+	driverPodCreationGracePeriod    time.Duration
+	executorImagePullFailureTimeout time.Duration
+	statusUpdateInterval            time.Duration
+	eventPolicy                     string
+	maintenanceWindowSpecs          []string
+	maintenanceWindows              []sparkapplication.MaintenanceWindow
+
+	enablePriorityClasses bool
+
+	enableNetworkPolicies          bool
+	enableDriftCorrection          bool
+	networkPolicyOperatorNamespace string
+
+	serviceMeshMode string
+	enablePodExec   bool
+
+	defaultImagePullSecret    string
+	defaultImagePullSecretKey types.NamespacedName
+
+	propagatedLabels      []string
+	propagatedAnnotations []string
+
+	preflightChecks []string
+
+	enableQuotaWait          bool
+	quotaWaitRequeueInterval time.Duration
+
+	// Namespace onboarding
+	enableNamespaceOnboarding   bool
+	namespaceOnboardingSelector string
+	namespaceOnboardingConfig   string
+
+	// Egress
+	egressAllowedHosts []string
+
+	// Notifications
+	notificationsConfigMap    string
+	notificationLogsURLFormat string
+	smtpAddress               string
+	smtpFrom                  string
+	smtpUsername              string
+	smtpPasswordFile          string
+	smtpOptions               *sparkapplication.SMTPOptions
 
-	driverPodCreationGracePeriod time.Duration
+	// CloudEvents
+	cloudEventsSinkType   string
+	cloudEventsSinkURL    string
+	cloudEventsKafkaTopic string
+	cloudEventsConfigMap  string
+	cloudEventsEmitter    *cloudevents.Emitter
+
+	// Archival
+	archiveURL          string
+	archivePathFormat   string
+	archiveLogTailLines int64
+	archiveEndpoint     string
+	archiveRegion       string
+	archiver            *archive.Archiver
+	podExecutor         sparkapplication.PodExecutor
+
+	// Audit
+	auditLogPath       string
+	auditLogMaxSize    int
+	auditLogMaxBackups int
+	auditLogger        *audit.Logger
+
+	// Tracing
+	tracingEndpoint      string
+	tracingInsecure      bool
+	tracingSamplingRatio float64
 
 	// Metrics
 	enableMetrics                 bool
@@ -116,9 +234,11 @@ var (
 	metricsPrefix                 string
 	metricsLabels                 []string
 	metricsJobStartLatencyBuckets []float64
+	taskMetricsEndpoint           string
 
 	healthProbeBindAddress string
 	pprofBindAddress       string
+	enableDiagnostics      bool
 	secureMetrics          bool
 	enableHTTP2            bool
 	development            bool
@@ -153,22 +273,38 @@ func NewStartCommand() *cobra.Command {
 	}
 
 	command.Flags().IntVar(&controllerThreads, "controller-threads", 10, "Number of worker threads used by the SparkApplication controller.")
+	command.Flags().StringToIntVar(&controllerThreadsByName, "controller-threads-by-controller", map[string]int{}, "Number of worker threads of the given controllers "+
+		"overriding --controller-threads, e.g. ScheduledSparkApplication=2,SparkConnect=1. Controllers are "+strings.Join(controllerNames, ", ")+".")
+	command.Flags().BoolVar(&lightweight, "lightweight", false, "Run in lightweight mode, which manages the single namespace set with --namespaces "+
+		"without leader election and without accessing cluster-scoped resources, so that the controller only needs a Role in that namespace. "+
+		"Options requiring cluster-scoped access are rejected and the volcano batch scheduler is not available in this mode.")
 	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "The Kubernetes namespace to manage. Will manage custom resource objects of the managed CRD types for the whole cluster if unset or contains empty string.")
 	command.Flags().DurationVar(&cacheSyncTimeout, "cache-sync-timeout", 30*time.Second, "Informer cache sync timeout.")
+	command.Flags().DurationVar(&cacheResyncPeriod, "cache-resync-period", 10*time.Hour, "Period after which the informer caches are resynced, "+
+		"which requeues all the cached objects for reconciliation.")
 	command.Flags().IntVar(&maxTrackedExecutorPerApp, "max-tracked-executor-per-app", 1000, "The maximum number of tracked executors per SparkApplication.")
+	command.Flags().BoolVar(&executorPodMetadataOnly, "executor-pod-metadata-only", false, "Watch only the metadata of executor pods and read them from the API server when needed, "+
+		"which reduces the operator memory use on clusters running many executors. Executor pod metrics are not recorded in this mode.")
 
 	command.Flags().IntVar(&workqueueRateLimiterBucketQPS, "workqueue-ratelimiter-bucket-qps", 10, "QPS of the bucket rate of the workqueue.")
 	command.Flags().IntVar(&workqueueRateLimiterBucketSize, "workqueue-ratelimiter-bucket-size", 100, "The token bucket size of the workqueue.")
 	command.Flags().DurationVar(&workqueueRateLimiterMaxDelay, "workqueue-ratelimiter-max-delay", rate.InfDuration, "The maximum delay of the workqueue.")
+	command.Flags().DurationVar(&workqueueRateLimiterBaseDelay, "workqueue-ratelimiter-base-delay", 5*time.Millisecond, "The delay after which an item is retried "+
+		"after its first failure, doubled on each subsequent failure.")
+	command.Flags().DurationVar(&workqueueRateLimiterFailureMax, "workqueue-ratelimiter-failure-max-delay", 1000*time.Second, "The maximum delay after which a failing item is retried.")
 
 	command.Flags().BoolVar(&enableBatchScheduler, "enable-batch-scheduler", false, "Enable batch schedulers.")
 	command.Flags().StringSliceVar(&kubeSchedulerNames, "kube-scheduler-names", []string{}, "The kube-scheduler names for scheduling Spark applications.")
 	command.Flags().StringVar(&defaultBatchScheduler, "default-batch-scheduler", "", "Default batch scheduler.")
 
 	command.Flags().BoolVar(&enableUIService, "enable-ui-service", true, "Enable Spark Web UI service.")
+	command.Flags().BoolVar(&disableSparkUI, "disable-spark-ui", false, "Disable the Spark web UI of SparkApplications that do not set spec.driver.ui.enabled or spark.ui.enabled, "+
+		"which also skips creating their UI Service and Ingress.")
 	command.Flags().StringVar(&ingressClassName, "ingress-class-name", "", "Set ingressClassName for ingress resources created.")
 	command.Flags().StringVar(&ingressURLFormat, "ingress-url-format", "", "Ingress URL format.")
 	command.Flags().StringVar(&ingressTLSstring, "ingress-tls", "", "JSON format string for the default TLS config on the Spark UI ingresses. e.g. '[{\"hosts\":[\"*.example.com\"],\"secretName\":\"example-secret\"}]'. `ingressTLS` in the SparkApplication spec will override this value.")
+	command.Flags().StringVar(&historyServerURLFormat, "history-server-url-format", "", "URL format of SparkApplications in the Spark History Server recorded in their status, "+
+		"e.g. 'https://spark-history.example.com/history/{{$appId}}/jobs/'. Supports the {{$appName}}, {{$appNamespace}} and {{$appId}} placeholders.")
 	command.Flags().StringVar(&ingressAnnotationsString, "ingress-annotations", "", "JSON format string for the default ingress annotations for the Spark UI ingresses. e.g. '[{\"cert-manager.io/cluster-issuer\": \"letsencrypt\"}]'. `ingressAnnotations` in the SparkApplication spec will override this value.")
 
 	command.Flags().BoolVar(&enableLeaderElection, "leader-election", false, "Enable leader election for controller manager. "+
@@ -178,8 +314,103 @@ func NewStartCommand() *cobra.Command {
 	command.Flags().DurationVar(&leaderElectionLeaseDuration, "leader-election-lease-duration", 15*time.Second, "Leader election lease duration.")
 	command.Flags().DurationVar(&leaderElectionRenewDeadline, "leader-election-renew-deadline", 10*time.Second, "Leader election renew deadline.")
 	command.Flags().DurationVar(&leaderElectionRetryPeriod, "leader-election-retry-period", 2*time.Second, "Leader election retry period.")
+	command.Flags().BoolVar(&leaderElectionReleaseOnCancel, "leader-election-release-on-cancel", true, "Release the leader lease when the controller stops "+
+		"so that a standby replica takes over without waiting for the lease to expire.")
+	command.Flags().BoolVar(&readinessRequiresLeadership, "readiness-requires-leadership", false, "Report the controller as ready only while it holds the leader lease. "+
+		"Standby replicas then stay unready, which requires a deployment strategy that does not wait for new replicas to become ready.")
+
+	command.Flags().StringVar(&shardID, "shard-id", "", "ID of this operator instance when sharding namespaces between several instances. "+
+		"Each instance only reconciles the namespaces that a consistent hash ring of all the shard IDs assigns to it. Sharding is disabled if unset.")
+	command.Flags().StringSliceVar(&shardIDs, "shards", []string{}, "IDs of all the operator instances sharing the namespaces. Required if --shard-id is set, unless --shards-config-map is set.")
+	command.Flags().StringVar(&shardsConfigMap, "shards-config-map", "", "ConfigMap in the form namespace/name listing the IDs of all the operator instances "+
+		"under the key "+sharding.ConfigMapShardsKey+", separated by commas or new lines. Takes precedence over --shards and is read on startup.")
+
+	command.Flags().BoolVar(&enablePriorityClasses, "enable-priority-classes", false, "Create and maintain the "+
+		common.PriorityClassSparkCritical+", "+common.PriorityClassSparkDefault+" and "+common.PriorityClassSparkPreemptible+" PriorityClasses. "+
+		"Existing PriorityClasses with the same names that were not created by the operator are left untouched.")
+
+	command.Flags().BoolVar(&enableNetworkPolicies, "enable-network-policies", false, "Create a NetworkPolicy for every SparkApplication only admitting the traffic "+
+		"between its driver and executors, from the operator pods, and to its web UI, driver ingress and Prometheus ports.")
+	command.Flags().StringVar(&networkPolicyOperatorNamespace, "network-policy-operator-namespace", "spark-operator", "Namespace of the operator pods admitted by the NetworkPolicies of SparkApplications.")
+
+	command.Flags().BoolVar(&enableDriftCorrection, "enable-drift-correction", false, "Recreate the web UI and driver ingress services and ingresses, "+
+		"and the Prometheus and logging ConfigMaps, of running SparkApplications that were deleted out-of-band, and repair those that were modified.")
+
+	command.Flags().StringVar(&serviceMeshMode, "service-mesh-mode", "", "Service mesh whose sidecars Spark pods are made compatible with. Only istio is supported, "+
+		"which excludes the Spark ports from sidecar interception, holds Spark containers until the sidecar starts and injects it as a native sidecar terminating with them. "+
+		"Sidecars that are not native are shut down with pilot-agent through the exec subresource of Spark pods if --enable-pod-exec is set.")
+	command.Flags().BoolVar(&enablePodExec, "enable-pod-exec", false, "Run commands in Spark pods through their exec subresource, to collect the diagnostics of failed drivers "+
+		"and to shut down service mesh sidecars that are not native. SparkApplications collecting driver diagnostics fail to submit if it is not set.")
+
+	command.Flags().StringVar(&defaultImagePullSecret, "default-image-pull-secret", "", "Image pull secret added to all SparkApplications, either as name "+
+		"for a secret in the namespace of each application, or as namespace/name for a secret copied into the namespaces of the applications.")
+
+	command.Flags().StringSliceVar(&propagatedLabels, "propagate-labels", util.DefaultMetadataPropagation.Labels, "Keys of the labels propagated from "+
+		"SparkApplications to their pods, Services and executor PVCs, unless they set spec.metadataPropagation. Keys ending with * match by prefix.")
+	command.Flags().StringSliceVar(&propagatedAnnotations, "propagate-annotations", util.DefaultMetadataPropagation.Annotations, "Keys of the annotations "+
+		"propagated from SparkApplications to their pods, Services and executor PVCs, unless they set spec.metadataPropagation. Keys ending with * match by prefix.")
+
+	command.Flags().StringSliceVar(&preflightChecks, "preflight-checks", []string{}, "Pre-flight checks run before submitting SparkApplications, among "+
+		strings.Join(preflight.GetRegistry().GetRegisteredCheckNames(), ", ")+". SparkApplications failing them move to the PREFLIGHT_FAILED state.")
+
+	command.Flags().BoolVar(&enableQuotaWait, "enable-quota-wait", false, "Hold the submission of SparkApplications in the QUOTA_WAIT state while the ResourceQuotas "+
+		"of their namespace lack the headroom for their driver and minimum number of executors. SparkApplications requesting more than the hard limits of a ResourceQuota fail instead.")
+	command.Flags().DurationVar(&quotaWaitRequeueInterval, "quota-wait-requeue-interval", 30*time.Second, "How often the quota of SparkApplications in the QUOTA_WAIT state is checked again.")
+
+	command.Flags().BoolVar(&enableNamespaceOnboarding, "enable-namespace-onboarding", false, "Provision the service account, RBAC, default ResourceQuota, "+
+		"NetworkPolicies and OpenShift SCC role binding needed to run Spark applications in the namespaces matching --namespace-onboarding-selector.")
+	command.Flags().StringVar(&namespaceOnboardingSelector, "namespace-onboarding-selector", "", "Label selector of the namespaces to onboard, e.g. \"spark-operator.kubeflow.org/onboard=true\". "+
+		"Resources created by the operator are deleted from namespaces that stop matching it. Required if --enable-namespace-onboarding is set.")
+	command.Flags().StringVar(&namespaceOnboardingConfig, "namespace-onboarding-config", "", "Path to a YAML file describing the service account name, ResourceQuota spec, "+
+		"NetworkPolicies and SecurityContextConstraints of onboarded namespaces.")
 
 	command.Flags().DurationVar(&driverPodCreationGracePeriod, "driver-pod-creation-grace-period", 10*time.Second, "Grace period after a successful spark-submit when driver pod not found errors will be retried. Useful if the driver pod can take some time to be created.")
+	command.Flags().DurationVar(&executorImagePullFailureTimeout, "executor-image-pull-failure-timeout", 0, "How long executors may fail to pull their image (ErrImagePull/ImagePullBackOff) before the SparkApplication is failed. Set to 0 to disable.")
+	command.Flags().DurationVar(&statusUpdateInterval, "status-update-interval", 0, "Minimum interval between two writes of the executor states of a running SparkApplication. "+
+		"Executor state changes within the interval are coalesced and written with server-side apply. Set to 0 to write them on every change.")
+	command.Flags().StringArrayVar(&maintenanceWindowSpecs, "maintenance-window", []string{}, "Maintenance window during which new SparkApplications are queued instead of submitted, "+
+		"in the format <cron schedule>;<duration>, e.g. \"0 2 * * SAT;4h\". Can be specified multiple times.")
+	command.Flags().StringVar(&eventPolicy, "event-policy", string(v1beta2.EventPolicyAll), "Which events are emitted for SparkApplications that do not set spec.eventPolicy. "+
+		"Available options are All, StateChangesOnly (omit executor pending, running and completed events) or ErrorsOnly (only warning events).")
+
+	command.Flags().StringSliceVar(&egressAllowedHosts, "egress-allowed-hosts", []string{}, "Host names, IP addresses or wildcard domains like *.example.com "+
+		"HTTP hooks of SparkApplications, triggers of ScheduledSparkApplications, the image registries of the image pre-flight check and the CloudEvents sinks of namespaces may send requests to. They may only send requests to public addresses if unset.")
+
+	command.Flags().StringVar(&notificationsConfigMap, "notifications-config-map", "", "Name of the ConfigMap holding, under the "+common.NotificationsConfigKey+" key, "+
+		"the notifications of the SparkApplications of its namespace. Notifications of a SparkApplication take precedence over the ones of its namespace with the same name.")
+	command.Flags().StringVar(&notificationLogsURLFormat, "notification-logs-url-format", "", "Format of the link to the logs of a SparkApplication included in Slack, Teams and email notifications, "+
+		"e.g. https://grafana.example.com/explore?app={{$appNamespace}}/{{$appName}}.")
+	command.Flags().StringVar(&smtpAddress, "smtp-address", "", "The host:port of the SMTP server email notifications are sent through. Email notifications are disabled if unset.")
+	command.Flags().StringVar(&smtpFrom, "smtp-from", "", "The sender address of email notifications.")
+	command.Flags().StringVar(&smtpUsername, "smtp-username", "", "The username authenticating to the SMTP server.")
+	command.Flags().StringVar(&smtpPasswordFile, "smtp-password-file", "", "The file containing the password authenticating to the SMTP server.")
+
+	command.Flags().StringVar(&cloudEventsSinkURL, "cloudevents-sink-url", "", "URL of the sink CloudEvents describing the lifecycle transitions of SparkApplications are sent to, "+
+		"e.g. a Knative broker or a Kafka REST proxy. CloudEvents are only sent for namespaces configuring their own sink if unset.")
+	command.Flags().StringVar(&cloudEventsSinkType, "cloudevents-sink-type", string(cloudevents.SinkTypeHTTP), "Type of the CloudEvents sink. Available options are http (binary content mode) "+
+		"or kafka (structured content mode, produced through a Kafka REST proxy).")
+	command.Flags().StringVar(&cloudEventsKafkaTopic, "cloudevents-kafka-topic", "", "Kafka topic CloudEvents are produced to when the sink type is kafka.")
+	command.Flags().StringVar(&cloudEventsConfigMap, "cloudevents-config-map", "", "Name of the ConfigMap configuring, under the "+cloudevents.ConfigMapSinkKey+" key, "+
+		"the CloudEvents sink of the SparkApplications of its namespace, which takes precedence over the operator sink.")
+
+	command.Flags().StringVar(&archiveURL, "archive-url", "", "URL of the object storage location terminated SparkApplications are archived to, "+
+		"e.g. s3://bucket/prefix, gs://bucket/prefix or azblob://container/prefix. S3 and GCS credentials are read from the AWS_ACCESS_KEY_ID, "+
+		"AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables, Azure ones from AZURE_STORAGE_ACCOUNT and AZURE_STORAGE_SAS_TOKEN. Archival is disabled if unset.")
+	command.Flags().StringVar(&archivePathFormat, "archive-path-format", archive.DefaultPathFormat, "Layout of the archives under the archive URL. "+
+		"Available placeholders are {{$appName}}, {{$appNamespace}}, {{$appUID}}, {{$submissionID}}, {{$state}}, and {{$year}}, {{$month}} and {{$day}} of the termination time.")
+	command.Flags().Int64Var(&archiveLogTailLines, "archive-log-tail-lines", archive.DefaultLogTailLines, "Number of lines archived from the end of the driver log. Set to 0 to not archive driver logs.")
+	command.Flags().StringVar(&archiveEndpoint, "archive-endpoint", "", "Endpoint of the object store, e.g. the URL of a MinIO server. Defaults to the endpoint of the archive URL scheme.")
+	command.Flags().StringVar(&archiveRegion, "archive-region", "", "Region of the S3 bucket. Defaults to the AWS_REGION environment variable, or us-east-1.")
+
+	command.Flags().StringVar(&auditLogPath, "audit-log-path", "", "File the audit log of the submissions, kills and resources created and deleted by the operator is written to as JSON lines. "+
+		"Set to - to write it to the standard output. Auditing is disabled if unset.")
+	command.Flags().IntVar(&auditLogMaxSize, "audit-log-max-size", 100, "Size in megabytes past which the audit log file is rotated. Set to 0 to disable rotation.")
+	command.Flags().IntVar(&auditLogMaxBackups, "audit-log-max-backups", 5, "Number of rotated audit log files to retain.")
+
+	command.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The host:port of the OTLP gRPC receiver the OpenTelemetry spans of the reconciliation "+
+		"and submission of SparkApplications are exported to, e.g. otel-collector.observability:4317. Tracing is disabled if unset.")
+	command.Flags().BoolVar(&tracingInsecure, "tracing-insecure", false, "Export spans to the OTLP receiver without TLS.")
+	command.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "Ratio of the traces sampled, between 0 and 1.")
 
 	command.Flags().BoolVar(&enableMetrics, "enable-metrics", false, "Enable metrics.")
 	command.Flags().StringVar(&metricsBindAddress, "metrics-bind-address", "0", "The address the metric endpoint binds to. "+
@@ -188,6 +419,9 @@ func NewStartCommand() *cobra.Command {
 	command.Flags().StringVar(&metricsPrefix, "metrics-prefix", "", "Prefix for the metrics.")
 	command.Flags().StringSliceVar(&metricsLabels, "metrics-labels", []string{}, "Labels to be added to the metrics.")
 	command.Flags().Float64SliceVar(&metricsJobStartLatencyBuckets, "metrics-job-start-latency-buckets", []float64{30, 60, 90, 120, 150, 180, 210, 240, 270, 300}, "Buckets for the job start latency histogram.")
+	command.Flags().StringVar(&taskMetricsEndpoint, "task-metrics-endpoint", "", "URL at which Spark drivers reach the operator to report task metrics, e.g. http://spark-operator-controller-svc.spark-operator:8080"+common.TaskMetricsPath+". "+
+		"Reports are authenticated with TokenReviews and authorized with SubjectAccessReviews for the post verb on "+common.TaskMetricsPath+". "+
+		"Requires metrics to be enabled.")
 
 	command.Flags().StringVar(&healthProbeBindAddress, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	command.Flags().BoolVar(&secureMetrics, "secure-metrics", false, "If set the metrics endpoint is served securely")
@@ -195,6 +429,9 @@ func NewStartCommand() *cobra.Command {
 
 	command.Flags().StringVar(&pprofBindAddress, "pprof-bind-address", "0", "The address the pprof endpoint binds to. "+
 		"If not set, it will be 0 in order to disable the pprof server")
+	command.Flags().BoolVar(&enableDiagnostics, "enable-diagnostics", false, "Serve pprof profiles, workqueue depths, informer cache sizes and "+
+		"per-namespace SparkApplication counts under "+diagnostics.Path+" on the metrics server. Requests are authenticated with TokenReviews "+
+		"and authorized with SubjectAccessReviews for the get verb on the request path. Requires the metrics server to be enabled.")
 
 	flagSet := flag.NewFlagSet("controller", flag.ExitOnError)
 	ctrl.RegisterFlags(flagSet)
@@ -217,15 +454,81 @@ func start() {
 		os.Exit(1)
 	}
 
+	if err := sparkapplication.ValidateEventPolicy(v1beta2.EventPolicy(eventPolicy)); err != nil {
+		logger.Error(err, "Invalid event policy")
+		os.Exit(1)
+	}
+
+	if err := validateLightweightMode(); err != nil {
+		logger.Error(err, "Invalid lightweight mode configuration")
+		os.Exit(1)
+	}
+
+	if err := validateControllerThreads(); err != nil {
+		logger.Error(err, "Invalid controller worker threads")
+		os.Exit(1)
+	}
+
+	if err := sparkapplication.ValidateServiceMeshMode(serviceMeshMode); err != nil {
+		logger.Error(err, "Invalid service mesh mode")
+		os.Exit(1)
+	}
+
+	if defaultImagePullSecretKey, err = sparkapplication.ParseDefaultImagePullSecret(defaultImagePullSecret); err != nil {
+		logger.Error(err, "Invalid default image pull secret")
+		os.Exit(1)
+	}
+
+	for _, spec := range maintenanceWindowSpecs {
+		window, err := sparkapplication.ParseMaintenanceWindow(spec)
+		if err != nil {
+			logger.Error(err, "Invalid maintenance window")
+			os.Exit(1)
+		}
+		maintenanceWindows = append(maintenanceWindows, window)
+	}
+
+	if smtpOptions, err = newSMTPOptions(); err != nil {
+		logger.Error(err, "Invalid SMTP configuration")
+		os.Exit(1)
+	}
+
+	if shard, err = newShard(cfg); err != nil {
+		logger.Error(err, "Failed to set up sharding")
+		os.Exit(1)
+	}
+	if shard != nil {
+		logger.Info("Sharding namespaces between operator instances", "shard", shard.ID())
+		leaderElectionLockName = fmt.Sprintf("%s-%s", leaderElectionLockName, shard.ID())
+	}
+
 	// Create the manager.
 	tlsOptions := newTLSOptions()
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: operatorscheme.ControllerScheme,
 		Cache:  newCacheOptions(),
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// Secrets holding the credentials of notification webhooks, and the service accounts and roles
+				// provisioned for SparkApplications, are read directly to avoid caching them all in the cluster.
+				DisableFor: []client.Object{
+					&corev1.Secret{},
+					&corev1.ServiceAccount{},
+					&rbacv1.Role{},
+					&rbacv1.RoleBinding{},
+					&corev1.ResourceQuota{},
+					&networkingv1.NetworkPolicy{},
+				},
+			},
+		},
 		Metrics: metricsserver.Options{
 			BindAddress:   metricsBindAddress,
 			SecureServing: secureMetrics,
 			TLSOpts:       tlsOptions,
+			ExtraHandlers: map[string]http.Handler{
+				health.ArgoCDSparkApplicationHealthPath: health.NewArgoCDSparkApplicationHealthHandler(),
+				health.BuildInfoPath:                    health.NewBuildInfoHandler("controller", newBuildInfoConfiguration()),
+			},
 		},
 		WebhookServer: ctrlwebhook.NewServer(ctrlwebhook.Options{
 			TLSOpts: tlsOptions,
@@ -244,17 +547,36 @@ func start() {
 		// speeds up voluntary leader transitions as the new leader don't have to wait
 		// LeaseDuration time first.
 		//
-		// In the default scaffold provided, the program ends immediately after
-		// the manager stops, so would be fine to enable this option. However,
-		// if you are doing or is intended to do any operation such as perform cleanups
-		// after the manager stops then its usage might be unsafe.
-		// LeaderElectionReleaseOnCancel: true,
+		// The program ends immediately after the manager stops, so it is safe to enable.
+		LeaderElectionReleaseOnCancel: leaderElectionReleaseOnCancel,
 	})
 	if err != nil {
 		logger.Error(err, "failed to create manager")
 		os.Exit(1)
 	}
 
+	if executorPodMetadataOnly {
+		if executorPodCache, err = newExecutorPodCache(mgr); err != nil {
+			logger.Error(err, "Failed to create executor pod metadata cache")
+			os.Exit(1)
+		}
+		if err := mgr.Add(executorPodCache); err != nil {
+			logger.Error(err, "Failed to add executor pod metadata cache to manager")
+			os.Exit(1)
+		}
+	}
+
+	if cloudEventsEmitter, err = newCloudEventsEmitter(mgr); err != nil {
+		logger.Error(err, "Invalid CloudEvents configuration")
+		os.Exit(1)
+	}
+	if cloudEventsEmitter != nil {
+		if err := mgr.Add(cloudEventsEmitter); err != nil {
+			logger.Error(err, "Failed to add CloudEvents emitter to manager")
+			os.Exit(1)
+		}
+	}
+
 	clientset, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		logger.Error(err, "failed to create clientset")
@@ -266,10 +588,48 @@ func start() {
 		os.Exit(1)
 	}
 
+	if archiver, err = newArchiver(clientset); err != nil {
+		logger.Error(err, "Invalid archive configuration")
+		os.Exit(1)
+	}
+
+	if enablePodExec {
+		if podExecutor, err = sparkapplication.NewPodExecutor(cfg); err != nil {
+			logger.Error(err, "Failed to create pod executor")
+			os.Exit(1)
+		}
+	}
+
+	if auditLogger, err = newAuditLogger(clientset); err != nil {
+		logger.Error(err, "Failed to create audit logger")
+		os.Exit(1)
+	}
+
+	if tracingEndpoint != "" {
+		provider, err := tracing.NewProvider(context.TODO(), tracing.Options{
+			Endpoint:       tracingEndpoint,
+			Insecure:       tracingInsecure,
+			SamplingRatio:  tracingSamplingRatio,
+			ServiceName:    "spark-operator-controller",
+			ServiceVersion: sparkoperator.GetVersion().Version,
+		})
+		if err != nil {
+			logger.Error(err, "Failed to set up tracing")
+			os.Exit(1)
+		}
+		if err := mgr.Add(provider); err != nil {
+			logger.Error(err, "Failed to add tracing provider to manager")
+			os.Exit(1)
+		}
+	}
+
 	var registry *scheduler.Registry
 	if enableBatchScheduler {
 		registry = scheduler.GetRegistry()
-		_ = registry.Register(common.VolcanoSchedulerName, volcano.Factory)
+		// The volcano scheduler looks up its CustomResourceDefinitions, which are cluster-scoped.
+		if !lightweight {
+			_ = registry.Register(common.VolcanoSchedulerName, volcano.Factory)
+		}
 		_ = registry.Register(yunikorn.SchedulerName, yunikorn.Factory)
 
 		// Register kube-schedulers.
@@ -286,16 +646,39 @@ func start() {
 
 	sparkSubmitter := &sparkapplication.SparkSubmitter{}
 
+	sparkApplicationReconcilerOptions := newSparkApplicationReconcilerOptions()
+	// Pre-flight checks read through the API server to avoid caching all ConfigMaps and PersistentVolumeClaims.
+	if sparkApplicationReconcilerOptions.PreflightChecks, err = preflight.GetRegistry().GetChecks(
+		preflightChecks,
+		mgr.GetAPIReader(),
+		preflight.Config{EgressPolicy: sparkApplicationReconcilerOptions.EgressPolicy},
+	); err != nil {
+		logger.Error(err, "Failed to create pre-flight checks")
+		os.Exit(1)
+	}
+	if enableDiagnostics {
+		if err := addDiagnosticsHandler(mgr); err != nil {
+			logger.Error(err, "Failed to add diagnostics handler")
+			os.Exit(1)
+		}
+	}
+	if sparkApplicationReconcilerOptions.SparkTaskMetrics != nil && taskMetricsEndpoint != "" {
+		if err := addTaskMetricsHandler(mgr, sparkApplicationReconcilerOptions.SparkTaskMetrics); err != nil {
+			logger.Error(err, "Failed to add task metrics handler")
+			os.Exit(1)
+		}
+	}
+
 	// Setup controller for SparkApplication.
 	if err = sparkapplication.NewReconciler(
 		mgr,
 		mgr.GetScheme(),
-		mgr.GetClient(),
+		audit.NewClient(mgr.GetClient(), auditLogger),
 		mgr.GetEventRecorderFor("spark-application-controller"),
 		registry,
 		sparkSubmitter,
-		newSparkApplicationReconcilerOptions(),
-	).SetupWithManager(mgr, newControllerOptions()); err != nil {
+		sparkApplicationReconcilerOptions,
+	).SetupWithManager(mgr, newControllerOptions("SparkApplication")); err != nil {
 		logger.Error(err, "Failed to create controller", "controller", "SparkApplication")
 		os.Exit(1)
 	}
@@ -303,11 +686,11 @@ func start() {
 	// Setup controller for ScheduledSparkApplication.
 	if err = scheduledsparkapplication.NewReconciler(
 		mgr.GetScheme(),
-		mgr.GetClient(),
+		audit.NewClient(mgr.GetClient(), auditLogger),
 		mgr.GetEventRecorderFor("scheduled-spark-application-controller"),
 		clock.RealClock{},
 		newScheduledSparkApplicationReconcilerOptions(),
-	).SetupWithManager(mgr, newControllerOptions()); err != nil {
+	).SetupWithManager(mgr, newControllerOptions("ScheduledSparkApplication")); err != nil {
 		logger.Error(err, "Failed to create controller", "controller", "ScheduledSparkApplication")
 		os.Exit(1)
 	}
@@ -316,14 +699,52 @@ func start() {
 	if err = sparkconnect.NewReconciler(
 		mgr,
 		mgr.GetScheme(),
-		mgr.GetClient(),
+		audit.NewClient(mgr.GetClient(), auditLogger),
 		mgr.GetEventRecorderFor("SparkConnect"),
 		newSparkConnectReconcilerOptions(),
-	).SetupWithManager(mgr, newControllerOptions()); err != nil {
+	).SetupWithManager(mgr, newControllerOptions("SparkConnect")); err != nil {
 		logger.Error(err, "Failed to create controller", "controller", "SparkConnect")
 		os.Exit(1)
 	}
 
+	// Setup controller for the PriorityClasses managed by the operator.
+	if enablePriorityClasses {
+		if err = priorityclass.NewReconciler(
+			mgr.GetClient(),
+			mgr.GetEventRecorderFor("spark-priorityclass-controller"),
+			priorityclass.DefaultPriorityClasses(),
+		).SetupWithManager(mgr, newControllerOptions("PriorityClass")); err != nil {
+			logger.Error(err, "Failed to create controller", "controller", "PriorityClass")
+			os.Exit(1)
+		}
+	}
+
+	// Setup controller for onboarding namespaces.
+	if enableNamespaceOnboarding {
+		selector, err := labels.Parse(namespaceOnboardingSelector)
+		if err == nil && selector.Empty() {
+			err = fmt.Errorf("--namespace-onboarding-selector is required")
+		}
+		if err != nil {
+			logger.Error(err, "Invalid namespace onboarding selector", "selector", namespaceOnboardingSelector)
+			os.Exit(1)
+		}
+		config, err := namespace.LoadConfig(namespaceOnboardingConfig)
+		if err != nil {
+			logger.Error(err, "Failed to load namespace onboarding config")
+			os.Exit(1)
+		}
+		if err = namespace.NewReconciler(
+			audit.NewClient(mgr.GetClient(), auditLogger),
+			mgr.GetEventRecorderFor("spark-namespace-controller"),
+			selector,
+			config,
+		).SetupWithManager(mgr, newControllerOptions("Namespace")); err != nil {
+			logger.Error(err, "Failed to create controller", "controller", "Namespace")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -336,6 +757,18 @@ func start() {
 		os.Exit(1)
 	}
 
+	if err := mgr.AddReadyzCheck("informers", health.NewCacheSyncChecker(mgr.GetCache(), time.Second)); err != nil {
+		logger.Error(err, "Failed to set up informer sync check")
+		os.Exit(1)
+	}
+
+	if enableLeaderElection && readinessRequiresLeadership {
+		if err := mgr.AddReadyzCheck("leader", health.NewLeaderChecker(mgr.Elected())); err != nil {
+			logger.Error(err, "Failed to set up leader check")
+			os.Exit(1)
+		}
+	}
+
 	logger.Info("Starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		logger.Error(err, "Failed to start manager")
@@ -387,18 +820,30 @@ func newCacheOptions() cache.Options {
 		}
 	}
 
+	podSelector := labels.SelectorFromSet(labels.Set{
+		common.LabelLaunchedBySparkOperator: "true",
+	})
+	if executorPodMetadataOnly {
+		// Executor pods are watched through a separate metadata-only cache, see newExecutorPodCache.
+		notExecutor, _ := labels.NewRequirement(common.LabelSparkRole, selection.NotEquals, []string{common.SparkRoleExecutor})
+		podSelector = podSelector.Add(*notExecutor)
+	}
+
 	options := cache.Options{
 		Scheme:            operatorscheme.ControllerScheme,
 		DefaultNamespaces: defaultNamespaces,
+		SyncPeriod:        &cacheResyncPeriod,
+		// The operator never reads managed fields, which often make up a large part of the cached objects.
+		DefaultTransform: cache.TransformStripManagedFields(),
 		ByObject: map[client.Object]cache.ByObject{
 			&corev1.Pod{}: {
-				Label: labels.SelectorFromSet(labels.Set{
-					common.LabelLaunchedBySparkOperator: "true",
-				}),
+				Label:     podSelector,
+				Transform: util.NewStripMetadataTransform(corev1.LastAppliedConfigAnnotation),
 			},
 			&corev1.ConfigMap{}:                  {},
 			&corev1.PersistentVolumeClaim{}:      {},
 			&corev1.Service{}:                    {},
+			&policyv1.PodDisruptionBudget{}:      {},
 			&v1beta2.SparkApplication{}:          {},
 			&v1beta2.ScheduledSparkApplication{}: {},
 			&v1alpha1.SparkConnect{}:             {},
@@ -408,37 +853,146 @@ func newCacheOptions() cache.Options {
 	return options
 }
 
-// newControllerOptions creates and returns a controller.Options instance configured with the given options.
-func newControllerOptions() controller.Options {
+// newExecutorPodCache creates a cache holding only the metadata of the executor pods launched by the operator.
+func newExecutorPodCache(mgr ctrl.Manager) (cache.Cache, error) {
+	options := newCacheOptions()
+	options.HTTPClient = mgr.GetHTTPClient()
+	options.Mapper = mgr.GetRESTMapper()
+	options.DefaultTransform = util.NewStripMetadataTransform(corev1.LastAppliedConfigAnnotation)
+	options.ByObject = map[client.Object]cache.ByObject{
+		&corev1.Pod{}: {
+			Label: labels.SelectorFromSet(labels.Set{
+				common.LabelLaunchedBySparkOperator: "true",
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+			}),
+		},
+	}
+	return cache.New(mgr.GetConfig(), options)
+}
+
+// newControllerOptions creates and returns a controller.Options instance of the named controller configured with the
+// given options. The depth, retries and latency of its workqueue are exported by controller-runtime as the workqueue_*
+// metrics labeled with the controller name.
+func newControllerOptions(name string) controller.Options {
+	threads := controllerThreads
+	if n, ok := controllerThreadsByName[name]; ok {
+		threads = n
+	}
 	options := controller.Options{
-		MaxConcurrentReconciles: controllerThreads,
+		MaxConcurrentReconciles: threads,
 		CacheSyncTimeout:        cacheSyncTimeout,
-		RateLimiter:             util.NewRateLimiter[ctrl.Request](workqueueRateLimiterBucketQPS, workqueueRateLimiterBucketSize, workqueueRateLimiterMaxDelay),
+		RateLimiter: util.NewRateLimiter[ctrl.Request](
+			workqueueRateLimiterBucketQPS,
+			workqueueRateLimiterBucketSize,
+			workqueueRateLimiterMaxDelay,
+			workqueueRateLimiterBaseDelay,
+			workqueueRateLimiterFailureMax,
+		),
 	}
 	return options
 }
 
+// validateLightweightMode validates that the controller manages a single namespace and that no option requiring
+// cluster-scoped access is set in lightweight mode.
+func validateLightweightMode() error {
+	if !lightweight {
+		return nil
+	}
+	if len(namespaces) != 1 || namespaces[0] == cache.AllNamespaces {
+		return fmt.Errorf("lightweight mode requires exactly one namespace set with --namespaces, got %q", namespaces)
+	}
+
+	var conflicts []string
+	if enableLeaderElection {
+		conflicts = append(conflicts, "--leader-election")
+	}
+	if shardID != "" {
+		conflicts = append(conflicts, "--shard-id")
+	}
+	if enablePriorityClasses {
+		conflicts = append(conflicts, "--enable-priority-classes")
+	}
+	if enableNamespaceOnboarding {
+		conflicts = append(conflicts, "--enable-namespace-onboarding")
+	}
+	if enableDiagnostics {
+		conflicts = append(conflicts, "--enable-diagnostics")
+	}
+	if features.Enabled(features.ExecutorDecommission) {
+		conflicts = append(conflicts, fmt.Sprintf("--feature-gates=%s=true", features.ExecutorDecommission))
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("lightweight mode does not support %s, which require cluster-scoped access", strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// validateControllerThreads validates the worker threads of the controllers set with --controller-threads-by-controller.
+func validateControllerThreads() error {
+	for name, threads := range controllerThreadsByName {
+		if !slices.Contains(controllerNames, name) {
+			return fmt.Errorf("unknown controller %q, controllers are %s", name, strings.Join(controllerNames, ", "))
+		}
+		if threads < 1 {
+			return fmt.Errorf("number of worker threads of controller %s must be positive, got %d", name, threads)
+		}
+	}
+	return nil
+}
+
 func newSparkApplicationReconcilerOptions() sparkapplication.Options {
 	var sparkApplicationMetrics *metrics.SparkApplicationMetrics
 	var sparkExecutorMetrics *metrics.SparkExecutorMetrics
+	var sparkTaskMetrics *metrics.SparkTaskMetrics
 	if enableMetrics {
 		sparkApplicationMetrics = metrics.NewSparkApplicationMetrics(metricsPrefix, metricsLabels, metricsJobStartLatencyBuckets)
 		sparkApplicationMetrics.Register()
 		sparkExecutorMetrics = metrics.NewSparkExecutorMetrics(metricsPrefix, metricsLabels)
 		sparkExecutorMetrics.Register()
+		sparkTaskMetrics = metrics.NewSparkTaskMetrics(metricsPrefix)
+		sparkTaskMetrics.Register()
 	}
 	options := sparkapplication.Options{
-		Namespaces:                   namespaces,
-		EnableUIService:              enableUIService,
-		IngressClassName:             ingressClassName,
-		IngressURLFormat:             ingressURLFormat,
-		IngressTLS:                   ingressTLS,
-		IngressAnnotations:           ingressAnnotations,
-		DefaultBatchScheduler:        defaultBatchScheduler,
-		DriverPodCreationGracePeriod: driverPodCreationGracePeriod,
-		SparkApplicationMetrics:      sparkApplicationMetrics,
-		SparkExecutorMetrics:         sparkExecutorMetrics,
-		MaxTrackedExecutorPerApp:     maxTrackedExecutorPerApp,
+		Namespaces:                      namespaces,
+		EnableUIService:                 enableUIService,
+		DisableSparkUI:                  disableSparkUI,
+		IngressClassName:                ingressClassName,
+		IngressURLFormat:                ingressURLFormat,
+		IngressTLS:                      ingressTLS,
+		IngressAnnotations:              ingressAnnotations,
+		HistoryServerURLFormat:          historyServerURLFormat,
+		DefaultBatchScheduler:           defaultBatchScheduler,
+		DriverPodCreationGracePeriod:    driverPodCreationGracePeriod,
+		ExecutorImagePullFailureTimeout: executorImagePullFailureTimeout,
+		StatusUpdateInterval:            statusUpdateInterval,
+		EventPolicy:                     v1beta2.EventPolicy(eventPolicy),
+		SparkApplicationMetrics:         sparkApplicationMetrics,
+		SparkExecutorMetrics:            sparkExecutorMetrics,
+		SparkTaskMetrics:                sparkTaskMetrics,
+		TaskMetricsEndpoint:             taskMetricsEndpoint,
+		MaxTrackedExecutorPerApp:        maxTrackedExecutorPerApp,
+		MaintenanceWindows:              maintenanceWindows,
+		NotificationsConfigMap:          notificationsConfigMap,
+		NotificationLogsURLFormat:       notificationLogsURLFormat,
+		SMTP:                            smtpOptions,
+		CloudEvents:                     cloudEventsEmitter,
+		Archiver:                        archiver,
+		PodExecutor:                     podExecutor,
+		AuditLogger:                     auditLogger,
+		EnableNetworkPolicies:           enableNetworkPolicies,
+		EnableDriftCorrection:           enableDriftCorrection,
+		OperatorNamespace:               networkPolicyOperatorNamespace,
+		ServiceMeshMode:                 serviceMeshMode,
+		DefaultImagePullSecret:          defaultImagePullSecretKey,
+		EnableQuotaWait:                 enableQuotaWait,
+		QuotaWaitRequeueInterval:        quotaWaitRequeueInterval,
+		Shard:                           shard,
+		ExecutorPodCache:                executorPodCache,
+		EgressPolicy:                    &egress.Policy{AllowedHosts: egressAllowedHosts},
+		MetadataPropagation: &v1beta2.MetadataPropagation{
+			Labels:      propagatedLabels,
+			Annotations: propagatedAnnotations,
+		},
 	}
 	if enableBatchScheduler {
 		options.KubeSchedulerNames = kubeSchedulerNames
@@ -447,15 +1001,255 @@ func newSparkApplicationReconcilerOptions() sparkapplication.Options {
 }
 
 func newScheduledSparkApplicationReconcilerOptions() scheduledsparkapplication.Options {
+	var scheduledSparkApplicationMetrics *metrics.ScheduledSparkApplicationMetrics
+	if enableMetrics {
+		scheduledSparkApplicationMetrics = metrics.NewScheduledSparkApplicationMetrics(metricsPrefix)
+		scheduledSparkApplicationMetrics.Register()
+	}
 	options := scheduledsparkapplication.Options{
-		Namespaces: namespaces,
+		Namespaces:                       namespaces,
+		Shard:                            shard,
+		ScheduledSparkApplicationMetrics: scheduledSparkApplicationMetrics,
+		EgressPolicy:                     &egress.Policy{AllowedHosts: egressAllowedHosts},
 	}
 	return options
 }
 
 func newSparkConnectReconcilerOptions() sparkconnect.Options {
 	options := sparkconnect.Options{
-		Namespaces: namespaces,
+		Namespaces:       namespaces,
+		Shard:            shard,
+		ExecutorPodCache: executorPodCache,
 	}
 	return options
 }
+
+// newBuildInfoConfiguration returns the controller configuration reported by the build information endpoint.
+func newBuildInfoConfiguration() map[string]string {
+	configuration := map[string]string{
+		"namespaces":                strings.Join(namespaces, ","),
+		"lightweight":               strconv.FormatBool(lightweight),
+		"enableBatchScheduler":      strconv.FormatBool(enableBatchScheduler),
+		"kubeSchedulerNames":        strings.Join(kubeSchedulerNames, ","),
+		"defaultBatchScheduler":     defaultBatchScheduler,
+		"enableUIService":           strconv.FormatBool(enableUIService),
+		"disableSparkUI":            strconv.FormatBool(disableSparkUI),
+		"ingressClassName":          ingressClassName,
+		"historyServerURLFormat":    historyServerURLFormat,
+		"enableMetrics":             strconv.FormatBool(enableMetrics),
+		"enablePriorityClasses":     strconv.FormatBool(enablePriorityClasses),
+		"enableNetworkPolicies":     strconv.FormatBool(enableNetworkPolicies),
+		"enableDriftCorrection":     strconv.FormatBool(enableDriftCorrection),
+		"eventPolicy":               eventPolicy,
+		"serviceMeshMode":           serviceMeshMode,
+		"enablePodExec":             strconv.FormatBool(enablePodExec),
+		"defaultImagePullSecret":    defaultImagePullSecret,
+		"preflightChecks":           strings.Join(preflightChecks, ","),
+		"enableQuotaWait":           strconv.FormatBool(enableQuotaWait),
+		"maintenanceWindows":        strings.Join(maintenanceWindowSpecs, ","),
+		"shardID":                   shardID,
+		"egressAllowedHosts":        strings.Join(egressAllowedHosts, ","),
+		"notificationsConfigMap":    notificationsConfigMap,
+		"smtpAddress":               smtpAddress,
+		"cloudEventsSinkType":       cloudEventsSinkType,
+		"cloudEventsConfigMap":      cloudEventsConfigMap,
+		"archiveURL":                archiveURL,
+		"auditLogPath":              auditLogPath,
+		"tracingEndpoint":           tracingEndpoint,
+		"enableNamespaceOnboarding": strconv.FormatBool(enableNamespaceOnboarding),
+	}
+	return configuration
+}
+
+// newCloudEventsEmitter returns the emitter of CloudEvents, or nil if no CloudEvents sink is configured.
+func newCloudEventsEmitter(mgr ctrl.Manager) (*cloudevents.Emitter, error) {
+	if cloudEventsSinkURL == "" && cloudEventsConfigMap == "" {
+		return nil, nil
+	}
+
+	options := cloudevents.Options{
+		ConfigMapName: cloudEventsConfigMap,
+		EgressPolicy:  &egress.Policy{AllowedHosts: egressAllowedHosts},
+	}
+	if enableMetrics {
+		options.Metrics = metrics.NewCloudEventsMetrics(metricsPrefix)
+		options.Metrics.Register()
+	}
+	if cloudEventsSinkURL != "" {
+		options.Sink = &cloudevents.Sink{
+			Type:  cloudevents.SinkType(cloudEventsSinkType),
+			URL:   cloudEventsSinkURL,
+			Topic: cloudEventsKafkaTopic,
+		}
+		if err := options.Sink.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return cloudevents.NewEmitter(mgr.GetClient(), options), nil
+}
+
+// addDiagnosticsHandler serves the diagnostics of the operator on the metrics server, to the users authorized to get
+// the diagnostics paths.
+func addDiagnosticsHandler(mgr ctrl.Manager) error {
+	if metricsBindAddress == "0" {
+		return fmt.Errorf("diagnostics require the metrics server to be enabled")
+	}
+	if !secureMetrics {
+		logger.Info("Diagnostics are served without TLS, the bearer tokens of their requests are sent in plain text")
+	}
+
+	filter, err := filters.WithAuthenticationAndAuthorization(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return err
+	}
+	// Only the kinds the controllers already watch are listed, to avoid starting informers for others.
+	handler, err := filter(logger.WithName("diagnostics"), diagnostics.NewHandler(
+		mgr.GetCache(),
+		ctrlmetrics.Registry,
+		map[string]func() client.ObjectList{
+			"Pod":                       func() client.ObjectList { return &corev1.PodList{} },
+			"SparkApplication":          func() client.ObjectList { return &v1beta2.SparkApplicationList{} },
+			"ScheduledSparkApplication": func() client.ObjectList { return &v1beta2.ScheduledSparkApplicationList{} },
+		},
+	))
+	if err != nil {
+		return err
+	}
+	return mgr.AddMetricsServerExtraHandler(diagnostics.Path, handler)
+}
+
+// addTaskMetricsHandler serves the endpoint the task metrics driver plugins report to on the metrics server, to the
+// users authorized to post to the task metrics path. The handler authenticates the callers again to only accept the
+// reports of the service accounts of the namespace of the reported SparkApplication.
+func addTaskMetricsHandler(mgr ctrl.Manager, sparkTaskMetrics *metrics.SparkTaskMetrics) error {
+	filter, err := filters.WithAuthenticationAndAuthorization(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return err
+	}
+	authenticationClient, err := authenticationv1client.NewForConfigAndClient(mgr.GetConfig(), mgr.GetHTTPClient())
+	if err != nil {
+		return err
+	}
+	authenticator, _, err := authenticatorfactory.DelegatingAuthenticatorConfig{
+		Anonymous:                &apiserver.AnonymousAuthConfig{Enabled: false},
+		CacheTTL:                 time.Minute,
+		TokenAccessReviewClient:  authenticationClient,
+		TokenAccessReviewTimeout: 10 * time.Second,
+		WebhookRetryBackoff: &wait.Backoff{
+			Duration: 500 * time.Millisecond,
+			Factor:   1.5,
+			Jitter:   0.2,
+			Steps:    5,
+		},
+	}.New()
+	if err != nil {
+		return err
+	}
+	handler, err := filter(logger.WithName("task-metrics"), sparkTaskMetrics.NewHandler(mgr.GetClient(), authenticator))
+	if err != nil {
+		return err
+	}
+	return mgr.AddMetricsServerExtraHandler(common.TaskMetricsPath, handler)
+}
+
+// newArchiver returns the archiver of terminated SparkApplications, or nil if archival is disabled.
+func newArchiver(clientset kubernetes.Interface) (*archive.Archiver, error) {
+	if archiveURL == "" {
+		return nil, nil
+	}
+
+	region := archiveRegion
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	store, prefix, err := archive.NewStore(archiveURL, archive.StoreOptions{
+		Endpoint:        archiveEndpoint,
+		Region:          region,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		AzureAccount:    os.Getenv("AZURE_STORAGE_ACCOUNT"),
+		AzureSASToken:   os.Getenv("AZURE_STORAGE_SAS_TOKEN"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return archive.NewArchiver(clientset, store, prefix, archive.Options{
+		PathFormat:   archivePathFormat,
+		LogTailLines: archiveLogTailLines,
+	}), nil
+}
+
+// newAuditLogger returns the logger of the audit log, or nil if auditing is disabled.
+func newAuditLogger(clientset kubernetes.Interface) (*audit.Logger, error) {
+	if auditLogPath == "" {
+		return nil, nil
+	}
+
+	// Record actions with the identity the operator authenticates as, e.g. its service account.
+	actor := "spark-operator"
+	review, err := clientset.AuthenticationV1().SelfSubjectReviews().Create(context.TODO(), &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		logger.Error(err, "Failed to look up operator identity, recording audit entries without it")
+	} else if review.Status.UserInfo.Username != "" {
+		actor = review.Status.UserInfo.Username
+	}
+
+	instance, _ := os.Hostname()
+	return audit.NewLogger(audit.Options{
+		Path:       auditLogPath,
+		MaxSizeMB:  auditLogMaxSize,
+		MaxBackups: auditLogMaxBackups,
+		Actor:      actor,
+		Instance:   instance,
+	})
+}
+
+// newSMTPOptions returns the SMTP server email notifications are sent through, or nil if none is configured.
+func newSMTPOptions() (*sparkapplication.SMTPOptions, error) {
+	if smtpAddress == "" {
+		return nil, nil
+	}
+	if smtpFrom == "" {
+		return nil, fmt.Errorf("--smtp-from is required when --smtp-address is set")
+	}
+
+	options := &sparkapplication.SMTPOptions{
+		Address:  smtpAddress,
+		From:     smtpFrom,
+		Username: smtpUsername,
+	}
+	if smtpPasswordFile != "" {
+		password, err := os.ReadFile(smtpPasswordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read SMTP password: %v", err)
+		}
+		options.Password = strings.TrimSpace(string(password))
+	}
+	return options, nil
+}
+
+// newShard returns the shard of this operator instance, or nil if sharding is disabled.
+func newShard(cfg *rest.Config) (*sharding.Shard, error) {
+	if shardID == "" {
+		return nil, nil
+	}
+
+	ids := shardIDs
+	if shardsConfigMap != "" {
+		namespace, name, ok := strings.Cut(shardsConfigMap, "/")
+		if !ok {
+			return nil, fmt.Errorf("invalid shards ConfigMap %q, expected namespace/name", shardsConfigMap)
+		}
+		clientset, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create clientset: %v", err)
+		}
+		configMap, err := clientset.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get shards ConfigMap %s: %v", shardsConfigMap, err)
+		}
+		ids = sharding.ParseShards(configMap.Data[sharding.ConfigMapShardsKey])
+	}
+	return sharding.NewShard(shardID, ids)
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	sparkoperator "github.com/kubeflow/spark-operator/v2"
+	"github.com/kubeflow/spark-operator/v2/internal/gateway"
+	"github.com/kubeflow/spark-operator/v2/internal/health"
+	operatorscheme "github.com/kubeflow/spark-operator/v2/pkg/scheme"
+)
+
+var (
+	logger = ctrl.Log.WithName("")
+)
+
+var (
+	namespaces []string
+
+	// Gateway
+	bindAddress          string
+	tlsCertFile          string
+	tlsKeyFile           string
+	enableAuthentication bool
+	tokenAudiences       []string
+	shutdownTimeout      time.Duration
+
+	// Livy
+	enableLivy         bool
+	livyImage          string
+	livySparkVersion   string
+	livyServiceAccount string
+
+	enableHTTP2 bool
+	development bool
+	zapOptions  = logzap.Options{}
+)
+
+func NewStartCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "start",
+		Short: "Start submission gateway",
+		PreRun: func(_ *cobra.Command, args []string) {
+			development = viper.GetBool("development")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			sparkoperator.PrintVersion(false)
+			start()
+		},
+	}
+
+	command.Flags().StringSliceVar(&namespaces, "namespaces", []string{}, "The Kubernetes namespaces whose SparkApplications are served. Will serve SparkApplications of the whole cluster if unset or contains empty string.")
+
+	// Gateway
+	command.Flags().StringVar(&bindAddress, "bind-address", ":8080", "The address the gateway API binds to.")
+	command.Flags().StringVar(&tlsCertFile, "tls-cert-file", "", "The file containing the TLS certificate of the gateway API. The API is served over plain HTTP if unset.")
+	command.Flags().StringVar(&tlsKeyFile, "tls-key-file", "", "The file containing the TLS key of the gateway API.")
+	command.Flags().BoolVar(&enableAuthentication, "enable-authentication", true, "Whether to authenticate requests with their bearer token and authorize them against the RBAC permissions of their user. "+
+		"ServiceAccount tokens and, if the API server is configured with an OIDC issuer, OIDC tokens are accepted.")
+	command.Flags().StringSliceVar(&tokenAudiences, "token-audiences", []string{}, "Audiences the bearer tokens must be issued for. Tokens must be issued for the API server if unset.")
+	command.Flags().DurationVar(&shutdownTimeout, "shutdown-timeout", 30*time.Second, "How long to wait for in-flight requests, e.g. followed logs, when shutting down.")
+
+	// Livy
+	command.Flags().BoolVar(&enableLivy, "enable-livy", false, "Whether to serve an Apache Livy compatible batch API under /livy/namespaces/{namespace}.")
+	command.Flags().StringVar(&livyImage, "livy-image", "", "Container image of the SparkApplications submitted as Livy batches.")
+	command.Flags().StringVar(&livySparkVersion, "livy-spark-version", "", "Spark version of the SparkApplications submitted as Livy batches.")
+	command.Flags().StringVar(&livyServiceAccount, "livy-service-account", "", "Driver service account of the SparkApplications submitted as Livy batches.")
+
+	command.Flags().BoolVar(&enableHTTP2, "enable-http2", false, "If set, HTTP/2 will be enabled for the gateway API")
+
+	flagSet := flag.NewFlagSet("gateway", flag.ExitOnError)
+	ctrl.RegisterFlags(flagSet)
+	zapOptions.BindFlags(flagSet)
+	command.Flags().AddGoFlagSet(flagSet)
+
+	return command
+}
+
+func start() {
+	setupLog()
+
+	if (tlsCertFile == "") != (tlsKeyFile == "") {
+		logger.Error(nil, "Both or none of the TLS certificate and key files must be set")
+		os.Exit(1)
+	}
+
+	// Create the client rest config. Use kubeConfig if given, otherwise assume in-cluster.
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		logger.Error(err, "failed to get kube config")
+		os.Exit(1)
+	}
+
+	client, err := client.New(cfg, client.Options{Scheme: operatorscheme.ControllerScheme})
+	if err != nil {
+		logger.Error(err, "Failed to create client")
+		os.Exit(1)
+	}
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		logger.Error(err, "Failed to create clientset")
+		os.Exit(1)
+	}
+
+	var auth *gateway.Authenticator
+	if enableAuthentication {
+		auth = gateway.NewAuthenticator(clientset, tokenAudiences)
+	} else {
+		logger.Info("Authentication is disabled, every request is served with the permissions of the gateway")
+	}
+
+	var livy *gateway.LivyOptions
+	if enableLivy {
+		if livyImage == "" || livySparkVersion == "" {
+			logger.Error(nil, "The Livy image and Spark version must be set to enable the Livy API")
+			os.Exit(1)
+		}
+		livy = &gateway.LivyOptions{
+			Image:          livyImage,
+			SparkVersion:   livySparkVersion,
+			ServiceAccount: livyServiceAccount,
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gateway.NewServer(client, clientset, auth, namespaces, livy).Handler())
+	mux.Handle("/healthz", &healthz.Handler{Checks: map[string]healthz.Checker{"ping": healthz.Ping}})
+	mux.Handle("/readyz", &healthz.Handler{Checks: map[string]healthz.Checker{"ping": healthz.Ping}})
+	mux.Handle(health.BuildInfoPath, health.NewBuildInfoHandler("gateway", newBuildInfoConfiguration()))
+
+	server := &http.Server{
+		Addr:              bindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 30 * time.Second,
+		TLSConfig:         newTLSConfig(),
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Error(err, "Failed to shut down gateway")
+		}
+	}()
+
+	logger.Info("Starting gateway", "address", bindAddress, "tls", tlsCertFile != "")
+	if tlsCertFile != "" {
+		err = server.ListenAndServeTLS(tlsCertFile, tlsKeyFile)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error(err, "Failed to start gateway")
+		os.Exit(1)
+	}
+}
+
+// newBuildInfoConfiguration returns the gateway configuration reported by the build information endpoint.
+func newBuildInfoConfiguration() map[string]string {
+	configuration := map[string]string{
+		"namespaces":           strings.Join(namespaces, ","),
+		"enableTLS":            strconv.FormatBool(tlsCertFile != ""),
+		"enableAuthentication": strconv.FormatBool(enableAuthentication),
+		"tokenAudiences":       strings.Join(tokenAudiences, ","),
+		"enableLivy":           strconv.FormatBool(enableLivy),
+	}
+	return configuration
+}
+
+// setupLog Configures the logging system
+func setupLog() {
+	ctrl.SetLogger(logzap.New(
+		logzap.UseFlagOptions(&zapOptions),
+		func(o *logzap.Options) {
+			o.Development = development
+			o.ZapOpts = append(o.ZapOpts, zap.AddCaller())
+			o.EncoderConfigOptions = append(o.EncoderConfigOptions, func(config *zapcore.EncoderConfig) {
+				config.EncodeLevel = zapcore.CapitalLevelEncoder
+				config.EncodeTime = zapcore.ISO8601TimeEncoder
+				config.EncodeCaller = zapcore.ShortCallerEncoder
+			})
+		}),
+	)
+}
+
+func newTLSConfig() *tls.Config {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	// if the enable-http2 flag is false (the default), http/2 should be disabled
+	// due to its vulnerabilities. For more information see:
+	// - https://github.com/advisories/GHSA-qppj-fm5r-hxr3
+	// - https://github.com/advisories/GHSA-4374-p667-p6c8
+	if !enableHTTP2 {
+		config.NextProtos = []string{"http/1.1"}
+	}
+	return config
+}
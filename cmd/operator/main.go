@@ -23,6 +23,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kubeflow/spark-operator/v2/cmd/operator/controller"
+	"github.com/kubeflow/spark-operator/v2/cmd/operator/gateway"
+	"github.com/kubeflow/spark-operator/v2/cmd/operator/run"
+	"github.com/kubeflow/spark-operator/v2/cmd/operator/validate"
 	"github.com/kubeflow/spark-operator/v2/cmd/operator/version"
 	"github.com/kubeflow/spark-operator/v2/cmd/operator/webhook"
 )
@@ -37,6 +40,9 @@ func NewCommand() *cobra.Command {
 	}
 	command.AddCommand(controller.NewCommand())
 	command.AddCommand(webhook.NewCommand())
+	command.AddCommand(gateway.NewCommand())
+	command.AddCommand(run.NewCommand())
+	command.AddCommand(validate.NewCommand())
 	command.AddCommand(version.NewCommand())
 	return command
 }
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	// to ensure that exec-entrypoint and run can make use of them.
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"github.com/spf13/cobra"
+	"go.uber.org/zap/zapcore"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/runner"
+	operatorscheme "github.com/kubeflow/spark-operator/v2/pkg/scheme"
+)
+
+var (
+	filename   string
+	manifest   string
+	namespace  string
+	timeout    time.Duration
+	resultsDir string
+
+	zapOptions = logzap.Options{}
+)
+
+func NewCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "run",
+		Short: "Submit a SparkApplication and wait for it to terminate",
+		Long: "Submit a SparkApplication and wait for it to complete or fail, optionally writing its application ID, " +
+			"duration in seconds, failure reason and final state as files to a results directory, e.g. the results " +
+			"directory of a Tekton step. Exits with an error unless the SparkApplication has completed successfully.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+			return run()
+		},
+	}
+
+	command.Flags().StringVarP(&filename, "filename", "f", "", "The file containing the SparkApplication manifest, or - to read it from the standard input.")
+	command.Flags().StringVar(&manifest, "manifest", "", "The SparkApplication manifest, as an alternative to --filename.")
+	command.Flags().StringVarP(&namespace, "namespace", "n", "", "The namespace to create the SparkApplication in. Defaults to the namespace of the manifest.")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "How long to wait for the SparkApplication to terminate. Waits indefinitely if 0.")
+	command.Flags().StringVar(&resultsDir, "results-dir", "", "The directory to write the results to, e.g. /tekton/results. Results are not written if unset.")
+
+	flagSet := flag.NewFlagSet("run", flag.ExitOnError)
+	ctrl.RegisterFlags(flagSet)
+	zapOptions.BindFlags(flagSet)
+	command.Flags().AddGoFlagSet(flagSet)
+
+	return command
+}
+
+func run() error {
+	ctrl.SetLogger(logzap.New(
+		logzap.UseFlagOptions(&zapOptions),
+		func(o *logzap.Options) {
+			o.EncoderConfigOptions = append(o.EncoderConfigOptions, func(config *zapcore.EncoderConfig) {
+				config.EncodeLevel = zapcore.CapitalLevelEncoder
+				config.EncodeTime = zapcore.ISO8601TimeEncoder
+			})
+		}),
+	)
+
+	app, err := readSparkApplication()
+	if err != nil {
+		return err
+	}
+	if namespace != "" {
+		app.Namespace = namespace
+	}
+	if app.Namespace == "" {
+		app.Namespace = "default"
+	}
+
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get kube config: %v", err)
+	}
+	c, err := client.NewWithWatch(cfg, client.Options{Scheme: operatorscheme.ControllerScheme})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %v", err)
+	}
+
+	ctx := log.IntoContext(ctrl.SetupSignalHandler(), ctrl.Log.WithName("run"))
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	result, err := runner.Run(ctx, c, app)
+	if err != nil {
+		return err
+	}
+	if resultsDir != "" {
+		if err := result.WriteTo(resultsDir); err != nil {
+			return err
+		}
+	}
+	if !result.Succeeded() {
+		return fmt.Errorf("SparkApplication %s/%s failed: %s", app.Namespace, result.Name, result.FailureReason)
+	}
+	ctrl.Log.WithName("run").Info("SparkApplication completed", "name", result.Name, "appID", result.AppID, "duration", result.Duration)
+	return nil
+}
+
+// readSparkApplication reads the SparkApplication from the manifest or file given on the command line.
+func readSparkApplication() (*v1beta2.SparkApplication, error) {
+	var reader io.Reader
+	switch {
+	case manifest != "" && filename != "":
+		return nil, errors.New("only one of --manifest and --filename can be set")
+	case manifest != "":
+		reader = strings.NewReader(manifest)
+	case filename == "-":
+		reader = os.Stdin
+	case filename != "":
+		file, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %v", filename, err)
+		}
+		defer file.Close()
+		reader = file
+	default:
+		return nil, errors.New("one of --manifest and --filename must be set")
+	}
+
+	app := &v1beta2.SparkApplication{}
+	if err := yaml.NewYAMLOrJSONDecoder(reader, 4096).Decode(app); err != nil {
+		return nil, fmt.Errorf("failed to decode SparkApplication: %v", err)
+	}
+	if app.Kind != "" && app.Kind != "SparkApplication" {
+		return nil, fmt.Errorf("expected a SparkApplication manifest, got %s", app.Kind)
+	}
+	return app, nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	sigsyaml "sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
+)
+
+var (
+	filename           string
+	sparkVersion       string
+	enableMemoryTuning bool
+	allowedVolumeTypes []string
+	deniedVolumeTypes  []string
+	quiet              bool
+)
+
+func NewCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate SparkApplication manifests without a cluster",
+		Long: "Run the defaulting and validation of the SparkApplication webhooks on the SparkApplications of a manifest " +
+			"without a cluster, e.g. in CI pipelines or pre-commit hooks, and print the defaulted SparkApplications. " +
+			"Checks depending on objects of the cluster, i.e. ResourceQuotas, LimitRanges and Secrets, are skipped. " +
+			"Exits with an error if any SparkApplication is invalid.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+			return validate(cmd.Context(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+
+	command.Flags().StringVarP(&filename, "filename", "f", "", "The file containing the SparkApplication manifests, or - to read them from the standard input.")
+	command.Flags().StringVar(&sparkVersion, "spark-version", "", "The Spark version to validate the SparkApplications against, overriding their spec.sparkVersion.")
+	command.Flags().BoolVar(&enableMemoryTuning, "enable-memory-tuning", false, "Whether to derive the driver and executor memory settings like the webhook flag of the same name.")
+	command.Flags().StringSliceVar(&allowedVolumeTypes, "allowed-volume-types", []string{}, "Volume types SparkApplications may declare, e.g. configMap,secret,emptyDir. All types that are not denied are allowed if unset.")
+	command.Flags().StringSliceVar(&deniedVolumeTypes, "denied-volume-types", []string{}, "Volume types SparkApplications may not declare, e.g. hostPath,csi.")
+	command.Flags().BoolVarP(&quiet, "quiet", "q", false, "Do not print the defaulted SparkApplications.")
+
+	return command
+}
+
+func validate(ctx context.Context, out io.Writer, errOut io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// The webhooks log through the logger of the context, which would clutter the output.
+	ctx = log.IntoContext(ctx, logr.Discard())
+
+	options := webhook.OfflineOptions{EnableMemoryTuning: enableMemoryTuning}
+	if len(allowedVolumeTypes) > 0 || len(deniedVolumeTypes) > 0 {
+		options.VolumePolicy = &webhook.VolumePolicy{AllowedTypes: allowedVolumeTypes, DeniedTypes: deniedVolumeTypes}
+		if err := options.VolumePolicy.Validate(); err != nil {
+			return err
+		}
+	}
+
+	var reader io.Reader
+	switch filename {
+	case "":
+		return errors.New("--filename must be set")
+	case "-":
+		reader = os.Stdin
+	default:
+		file, err := os.Open(filename)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %v", filename, err)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	apps, err := readSparkApplications(reader)
+	if err != nil {
+		return err
+	}
+
+	invalid, printed := 0, 0
+	for _, app := range apps {
+		if sparkVersion != "" {
+			app.Spec.SparkVersion = sparkVersion
+		}
+		warnings, err := webhook.DefaultAndValidate(ctx, app, options)
+		for _, warning := range warnings {
+			fmt.Fprintf(errOut, "Warning: SparkApplication %s: %s\n", app.Name, warning)
+		}
+		if err != nil {
+			fmt.Fprintf(errOut, "SparkApplication %s is invalid: %v\n", app.Name, err)
+			invalid++
+			continue
+		}
+		if quiet {
+			continue
+		}
+		data, err := marshalSparkApplication(app)
+		if err != nil {
+			return fmt.Errorf("failed to encode SparkApplication %s: %v", app.Name, err)
+		}
+		if printed > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+		printed++
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d SparkApplications are invalid", invalid, len(apps))
+	}
+	return nil
+}
+
+// marshalSparkApplication encodes the given SparkApplication as YAML, leaving out its empty status and the
+// metadata fields only set by the API server.
+func marshalSparkApplication(app *v1beta2.SparkApplication) ([]byte, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return nil, err
+	}
+	delete(object, "status")
+	unstructured.RemoveNestedField(object, "metadata", "creationTimestamp")
+	return sigsyaml.Marshal(object)
+}
+
+// readSparkApplications reads the SparkApplications of the YAML or JSON documents of the given reader.
+func readSparkApplications(reader io.Reader) ([]*v1beta2.SparkApplication, error) {
+	var apps []*v1beta2.SparkApplication
+	decoder := yaml.NewYAMLOrJSONDecoder(reader, 4096)
+	for {
+		app := &v1beta2.SparkApplication{}
+		if err := decoder.Decode(app); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to decode SparkApplication: %v", err)
+		}
+		// Skip empty documents, e.g. after a leading document separator.
+		if app.Kind == "" && app.Name == "" {
+			continue
+		}
+		if app.Kind != "SparkApplication" {
+			return nil, fmt.Errorf("expected a SparkApplication manifest, got %q", app.Kind)
+		}
+		apps = append(apps, app)
+	}
+	if len(apps) == 0 {
+		return nil, errors.New("no SparkApplication found")
+	}
+	return apps, nil
+}
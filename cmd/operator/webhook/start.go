@@ -20,8 +20,11 @@ import (
 	"context"
 	"crypto/tls"
 	"flag"
+	"net/http"
 	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
@@ -37,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -47,9 +51,12 @@ import (
 	ctrlwebhook "sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	sparkoperator "github.com/kubeflow/spark-operator/v2"
+	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/controller/customresourcedefinition"
 	"github.com/kubeflow/spark-operator/v2/internal/controller/mutatingwebhookconfiguration"
 	"github.com/kubeflow/spark-operator/v2/internal/controller/validatingwebhookconfiguration"
+	"github.com/kubeflow/spark-operator/v2/internal/health"
 	"github.com/kubeflow/spark-operator/v2/internal/webhook"
 	"github.com/kubeflow/spark-operator/v2/pkg/certificate"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
@@ -70,17 +77,30 @@ var (
 	cacheSyncTimeout  time.Duration
 
 	// Webhook
-	enableResourceQuotaEnforcement bool
-	webhookCertDir                 string
-	webhookCertName                string
-	webhookKeyName                 string
-	mutatingWebhookName            string
-	validatingWebhookName          string
-	webhookPort                    int
-	webhookSecretName              string
-	webhookSecretNamespace         string
-	webhookServiceName             string
-	webhookServiceNamespace        string
+	enableResourceQuotaEnforcement   bool
+	enableRestrictedSecurityDefaults bool
+	enableMemoryTuning               bool
+	envSecretRefValidation           string
+	allowedVolumeTypes               []string
+	deniedVolumeTypes                []string
+	volumePolicyExemptNamespaces     []string
+	placementPolicyFile              string
+	limitRangeValidation             string
+	webhookCertDir                   string
+	webhookCertName                  string
+	webhookKeyName                   string
+	mutatingWebhookName              string
+	validatingWebhookName            string
+	webhookPort                      int
+	webhookSecretName                string
+	webhookSecretNamespace           string
+	webhookServiceName               string
+	webhookServiceNamespace          string
+	webhookFailurePolicy             string
+	webhookNamespaceSelector         string
+	webhookObjectSelector            string
+	webhookExcludedNamespaces        []string
+	webhookPolicyConfigMap           string
 
 	// Cert Manager
 	enableCertManager bool
@@ -138,7 +158,26 @@ func NewStartCommand() *cobra.Command {
 	command.Flags().StringVar(&webhookSecretNamespace, "webhook-secret-namespace", "spark-operator", "The namespace of the secret that contains the webhook server's TLS certificate and key.")
 	command.Flags().StringVar(&webhookServiceName, "webhook-svc-name", "spark-webhook", "The name of the Service for the webhook server.")
 	command.Flags().StringVar(&webhookServiceNamespace, "webhook-svc-namespace", "spark-webhook", "The name of the Service for the webhook server.")
+	command.Flags().StringVar(&webhookFailurePolicy, "webhook-failure-policy", "", "Failure policy of the webhooks, one of Fail or Ignore. Left as configured if unset.")
+	command.Flags().StringVar(&webhookNamespaceSelector, "webhook-namespace-selector", "", "Label selector of the namespaces the webhooks are called for, e.g. 'env in (dev,prod)'. Left as configured if unset.")
+	command.Flags().StringVar(&webhookObjectSelector, "webhook-object-selector", "", "Label selector added to the object selector of the webhooks, e.g. team=data.")
+	command.Flags().StringSliceVar(&webhookExcludedNamespaces, "webhook-excluded-namespaces", []string{}, "Namespaces the webhooks are not called for. Glob patterns are matched against the existing namespaces, e.g. kube-system,openshift-*.")
+	command.Flags().StringVar(&webhookPolicyConfigMap, "webhook-policy-config-map", "", "Name of the ConfigMap in the namespace of the webhook Service holding, under the "+common.WebhookConfigurationPolicyKey+" key, "+
+		"the failurePolicy, namespaceSelector, objectSelector and excludedNamespaces of the webhooks. It is read at runtime and replaces the other webhook policy flags.")
 	command.Flags().BoolVar(&enableResourceQuotaEnforcement, "enable-resource-quota-enforcement", false, "Whether to enable ResourceQuota enforcement for SparkApplication resources. Requires the webhook to be enabled.")
+	command.Flags().BoolVar(&enableRestrictedSecurityDefaults, "enable-restricted-security-defaults", false, "Whether to apply the Pod Security Standards restricted profile defaults (drop all capabilities, disallow privilege escalation, RuntimeDefault seccomp profile, run as non-root) to Spark pods. "+
+		"A SparkApplication can opt out by setting the annotation "+common.AnnotationRestrictedSecurityDefaults+" to \"false\".")
+	command.Flags().StringVar(&envSecretRefValidation, "env-secret-ref-validation", string(webhook.EnvSecretRefValidationWarn), "How to handle envSecretRefs referencing missing Secret keys at admission. "+
+		"Available options are enforce (reject), warn (admit with a warning) or disabled.")
+	command.Flags().StringVar(&limitRangeValidation, "limit-range-validation", string(webhook.LimitRangeValidationDisabled), "How to handle driver and executor resources violating the LimitRanges of the SparkApplication namespace at admission. "+
+		"Available options are enforce (reject), clamp (lower resources above the maximum, reject other violations) or disabled.")
+	command.Flags().BoolVar(&enableMemoryTuning, "enable-memory-tuning", false, "Whether to derive the driver and executor heap and memory overhead from their memory limit, or the memory limit from them, "+
+		"and reject SparkApplications whose memory limit is lower than their heap, memory overhead and PySpark memory.")
+	command.Flags().StringSliceVar(&allowedVolumeTypes, "allowed-volume-types", []string{}, "Volume types SparkApplications may declare in their volumes, pod templates or spark.kubernetes.{driver,executor}.volumes.* properties, e.g. configMap,secret,emptyDir. All types that are not denied are allowed if unset.")
+	command.Flags().StringSliceVar(&deniedVolumeTypes, "denied-volume-types", []string{}, "Volume types SparkApplications may not declare, e.g. hostPath,csi.")
+	command.Flags().StringSliceVar(&volumePolicyExemptNamespaces, "volume-policy-exempt-namespaces", []string{}, "Namespaces in which the allowed and denied volume types are not enforced.")
+	command.Flags().StringVar(&placementPolicyFile, "placement-policy", "", "Path to a YAML file of rules adding default tolerations, node selectors and topology spread constraints to the Spark pods of selected namespaces. "+
+		"A SparkApplication can opt out by setting the annotation "+common.AnnotationPlacementDefaults+" to \"false\".")
 
 	// Cert Manager
 	command.Flags().BoolVar(&enableCertManager, "enable-cert-manager", false, "Enable cert-manager to manage the webhook server's TLS certificate.")
@@ -175,6 +214,36 @@ func NewStartCommand() *cobra.Command {
 func start() {
 	setupLog()
 
+	switch webhook.EnvSecretRefValidationMode(envSecretRefValidation) {
+	case webhook.EnvSecretRefValidationEnforce, webhook.EnvSecretRefValidationWarn, webhook.EnvSecretRefValidationDisabled:
+	default:
+		logger.Error(nil, "Invalid env secret ref validation mode", "mode", envSecretRefValidation)
+		os.Exit(1)
+	}
+
+	switch webhook.LimitRangeValidationMode(limitRangeValidation) {
+	case webhook.LimitRangeValidationEnforce, webhook.LimitRangeValidationClamp, webhook.LimitRangeValidationDisabled:
+	default:
+		logger.Error(nil, "Invalid limit range validation mode", "mode", limitRangeValidation)
+		os.Exit(1)
+	}
+
+	volumePolicy := &webhook.VolumePolicy{
+		AllowedTypes:     allowedVolumeTypes,
+		DeniedTypes:      deniedVolumeTypes,
+		ExemptNamespaces: volumePolicyExemptNamespaces,
+	}
+	if err := volumePolicy.Validate(); err != nil {
+		logger.Error(err, "Invalid volume policy")
+		os.Exit(1)
+	}
+
+	placementPolicy, err := webhook.LoadPlacementPolicy(placementPolicyFile)
+	if err != nil {
+		logger.Error(err, "Invalid placement policy")
+		os.Exit(1)
+	}
+
 	// Create the client rest config. Use kubeConfig if given, otherwise assume in-cluster.
 	cfg, err := ctrl.GetConfig()
 	if err != nil {
@@ -187,10 +256,19 @@ func start() {
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		Scheme: operatorscheme.WebhookScheme,
 		Cache:  newCacheOptions(),
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// Secrets referenced by envSecretRefs are read directly to avoid caching every Secret in the cluster.
+				DisableFor: []client.Object{&corev1.Secret{}},
+			},
+		},
 		Metrics: metricsserver.Options{
 			BindAddress:   metricsBindAddress,
 			SecureServing: secureMetrics,
 			TLSOpts:       tlsOptions,
+			ExtraHandlers: map[string]http.Handler{
+				health.BuildInfoPath: health.NewBuildInfoHandler("webhook", newBuildInfoConfiguration()),
+			},
 		},
 		WebhookServer: ctrlwebhook.NewServer(ctrlwebhook.Options{
 			Port:     webhookPort,
@@ -260,11 +338,34 @@ func start() {
 		os.Exit(1)
 	}
 
-	if !enableCertManager {
+	webhookPolicy, err := webhook.NewConfigurationPolicy(webhookFailurePolicy, webhookNamespaceSelector, webhookObjectSelector, webhookExcludedNamespaces)
+	if err != nil {
+		logger.Error(err, "Invalid webhook configuration policy")
+		os.Exit(1)
+	}
+	var webhookPolicyConfigMapKey types.NamespacedName
+	if webhookPolicyConfigMap != "" {
+		if !webhookPolicy.IsEmpty() {
+			logger.Error(nil, "The webhook policy flags cannot be set together with --webhook-policy-config-map")
+			os.Exit(1)
+		}
+		webhookPolicyConfigMapKey = types.NamespacedName{Name: webhookPolicyConfigMap, Namespace: webhookServiceNamespace}
+	}
+
+	// The webhook configurations are reconciled to inject the CA bundle unless cert-manager does it,
+	// and to apply the webhook configuration policy.
+	if !enableCertManager || !webhookPolicy.IsEmpty() || webhookPolicyConfigMap != "" {
+		caProvider := certProvider
+		if enableCertManager {
+			caProvider = nil
+		}
+
 		if err := mutatingwebhookconfiguration.NewReconciler(
 			mgr.GetClient(),
-			certProvider,
+			caProvider,
 			mutatingWebhookName,
+			webhookPolicy,
+			webhookPolicyConfigMapKey,
 		).SetupWithManager(mgr, controller.Options{}); err != nil {
 			logger.Error(err, "Failed to create controller", "controller", "MutatingWebhookConfiguration")
 			os.Exit(1)
@@ -272,18 +373,44 @@ func start() {
 
 		if err := validatingwebhookconfiguration.NewReconciler(
 			mgr.GetClient(),
-			certProvider,
+			caProvider,
 			validatingWebhookName,
+			webhookPolicy,
+			webhookPolicyConfigMapKey,
 		).SetupWithManager(mgr, controller.Options{}); err != nil {
 			logger.Error(err, "Failed to create controller", "controller", "ValidatingWebhookConfiguration")
 			os.Exit(1)
 		}
 	}
 
+	// Point the conversion webhook of the CRDs at this webhook server. The conversion handler itself is
+	// registered by the webhook builders below, as SparkApplication and ScheduledSparkApplication are convertible.
+	if err := customresourcedefinition.NewReconciler(
+		mgr.GetClient(),
+		certProvider,
+		[]string{
+			v1beta2.Resource("sparkapplications").String(),
+			v1beta2.Resource("scheduledsparkapplications").String(),
+		},
+		webhookServiceName,
+		webhookServiceNamespace,
+		int32(webhookPort),
+	).SetupWithManager(mgr, controller.Options{}); err != nil {
+		logger.Error(err, "Failed to create controller", "controller", "CustomResourceDefinition")
+		os.Exit(1)
+	}
+
 	if err := ctrl.NewWebhookManagedBy(mgr).
 		For(&v1beta2.SparkApplication{}).
-		WithDefaulter(webhook.NewSparkApplicationDefaulter()).
-		WithValidator(webhook.NewSparkApplicationValidator(mgr.GetClient(), enableResourceQuotaEnforcement)).
+		WithDefaulter(webhook.NewSparkApplicationDefaulter(mgr.GetClient(), webhook.LimitRangeValidationMode(limitRangeValidation), enableMemoryTuning)).
+		WithValidator(webhook.NewSparkApplicationValidator(
+			mgr.GetClient(),
+			enableResourceQuotaEnforcement,
+			webhook.EnvSecretRefValidationMode(envSecretRefValidation),
+			volumePolicy,
+			webhook.LimitRangeValidationMode(limitRangeValidation),
+			enableMemoryTuning,
+		)).
 		WithLogConstructor(webhook.LogConstructor).
 		Complete(); err != nil {
 		logger.Error(err, "Failed to create mutating webhook for Spark application")
@@ -293,7 +420,7 @@ func start() {
 	if err := ctrl.NewWebhookManagedBy(mgr).
 		For(&v1beta2.ScheduledSparkApplication{}).
 		WithDefaulter(webhook.NewScheduledSparkApplicationDefaulter()).
-		WithValidator(webhook.NewScheduledSparkApplicationValidator()).
+		WithValidator(webhook.NewScheduledSparkApplicationValidator(volumePolicy)).
 		WithLogConstructor(webhook.LogConstructor).
 		Complete(); err != nil {
 		logger.Error(err, "Failed to create mutating webhook for Scheduled Spark application")
@@ -302,7 +429,7 @@ func start() {
 
 	if err := ctrl.NewWebhookManagedBy(mgr).
 		For(&corev1.Pod{}).
-		WithDefaulter(webhook.NewSparkPodDefaulter(mgr.GetClient(), namespaces)).
+		WithDefaulter(webhook.NewSparkPodDefaulter(mgr.GetClient(), namespaces, enableRestrictedSecurityDefaults, placementPolicy)).
 		WithLogConstructor(webhook.LogConstructor).
 		Complete(); err != nil {
 		logger.Error(err, "Failed to create mutating webhook for Spark pod")
@@ -328,6 +455,29 @@ func start() {
 	}
 }
 
+// newBuildInfoConfiguration returns the webhook configuration reported by the build information endpoint.
+func newBuildInfoConfiguration() map[string]string {
+	configuration := map[string]string{
+		"namespaces":                       strings.Join(namespaces, ","),
+		"mutatingWebhookName":              mutatingWebhookName,
+		"validatingWebhookName":            validatingWebhookName,
+		"enableCertManager":                strconv.FormatBool(enableCertManager),
+		"enableResourceQuotaEnforcement":   strconv.FormatBool(enableResourceQuotaEnforcement),
+		"enableRestrictedSecurityDefaults": strconv.FormatBool(enableRestrictedSecurityDefaults),
+		"envSecretRefValidation":           envSecretRefValidation,
+		"limitRangeValidation":             limitRangeValidation,
+		"enableMemoryTuning":               strconv.FormatBool(enableMemoryTuning),
+		"allowedVolumeTypes":               strings.Join(allowedVolumeTypes, ","),
+		"deniedVolumeTypes":                strings.Join(deniedVolumeTypes, ","),
+		"webhookFailurePolicy":             webhookFailurePolicy,
+		"webhookNamespaceSelector":         webhookNamespaceSelector,
+		"webhookObjectSelector":            webhookObjectSelector,
+		"webhookExcludedNamespaces":        strings.Join(webhookExcludedNamespaces, ","),
+		"webhookPolicyConfigMap":           webhookPolicyConfigMap,
+	}
+	return configuration
+}
+
 // setupLog Configures the logging system
 func setupLog() {
 	ctrl.SetLogger(logzap.New(
@@ -380,6 +530,8 @@ func newCacheOptions() cache.Options {
 		},
 		&v1beta2.SparkApplication{}:          {},
 		&v1beta2.ScheduledSparkApplication{}: {},
+		&v1alpha1.SparkApplicationTemplate{}: {},
+		&v1alpha1.LabelPolicy{}:              {},
 		&admissionregistrationv1.MutatingWebhookConfiguration{}: {
 			Field: fields.SelectorFromSet(fields.Set{
 				"metadata.name": mutatingWebhookName,
@@ -396,6 +548,15 @@ func newCacheOptions() cache.Options {
 		byObject[&corev1.ResourceQuota{}] = cache.ByObject{}
 	}
 
+	if webhookPolicyConfigMap != "" {
+		byObject[&corev1.ConfigMap{}] = cache.ByObject{
+			Namespaces: map[string]cache.Config{webhookServiceNamespace: {}},
+			Field: fields.SelectorFromSet(fields.Set{
+				"metadata.name": webhookPolicyConfigMap,
+			}),
+		}
+	}
+
 	options := cache.Options{
 		Scheme:            operatorscheme.WebhookScheme,
 		DefaultNamespaces: defaultNamespaces,
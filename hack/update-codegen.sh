@@ -31,9 +31,10 @@ kube::codegen::gen_register \
     --boilerplate "${SCRIPT_ROOT}/hack/boilerplate.go.txt" \
     "${SCRIPT_ROOT}"
 
-# Generate client code: client, lister, informer in client-go directory
+# Generate client code: client, lister, informer and apply configurations in client-go directory
 kube::codegen::gen_client \
     --with-watch \
+    --with-applyconfig \
     --output-dir "${SCRIPT_ROOT}/pkg/client" \
     --output-pkg "${SPARK_OPERATOR_PKG}/pkg/client" \
     --boilerplate "${SCRIPT_ROOT}/hack/boilerplate.go.txt" \
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package archive archives terminated SparkApplications, along with the tail of their driver log and their
+// events, to object storage, so that applications deleted once their TTL expires remain auditable.
+package archive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+const (
+	// DefaultPathFormat lays out archives by namespace and termination date, so that retention
+	// rules of the object store can apply per namespace.
+	DefaultPathFormat = "{{$appNamespace}}/{{$year}}/{{$month}}/{{$day}}/{{$appName}}-{{$submissionID}}"
+
+	// DefaultLogTailLines is the default number of lines archived from the end of the driver log.
+	DefaultLogTailLines = 1000
+
+	// ApplicationFile holds the final SparkApplication, including its spec and status.
+	ApplicationFile = "application.yaml"
+
+	// EventsFile holds the events of the SparkApplication and of its driver pod.
+	EventsFile = "events.yaml"
+
+	// DriverLogFile holds the tail of the driver log.
+	DriverLogFile = "driver.log"
+
+	// DiagnosticsFile holds the heap dumps and fatal error logs collected from the driver.
+	DiagnosticsFile = "diagnostics.tar.gz"
+)
+
+// Options configures an Archiver.
+type Options struct {
+	// PathFormat is the layout of the archives in the store, e.g. `{{$appNamespace}}/{{$year}}/{{$appName}}`.
+	// Available placeholders are {{$appName}}, {{$appNamespace}}, {{$appUID}}, {{$submissionID}}, {{$state}},
+	// and {{$year}}, {{$month}} and {{$day}} of the termination time.
+	PathFormat string
+
+	// LogTailLines is the number of lines archived from the end of the driver log. Zero disables driver logs.
+	LogTailLines int64
+}
+
+// Archiver archives terminated SparkApplications to an object store.
+type Archiver struct {
+	clientset kubernetes.Interface
+	store     Store
+	prefix    string
+	options   Options
+}
+
+// NewArchiver creates a new Archiver writing to the given store under the given key prefix.
+func NewArchiver(clientset kubernetes.Interface, store Store, prefix string, options Options) *Archiver {
+	if options.PathFormat == "" {
+		options.PathFormat = DefaultPathFormat
+	}
+	return &Archiver{
+		clientset: clientset,
+		store:     store,
+		prefix:    strings.Trim(prefix, "/"),
+		options:   options,
+	}
+}
+
+// Archive writes the application, its events and the tail of its driver log to the store,
+// and returns the URL of the archive.
+func (a *Archiver) Archive(ctx context.Context, app *v1beta2.SparkApplication) (string, error) {
+	dir := a.getPath(app)
+
+	application, err := marshalApplication(app)
+	if err != nil {
+		return "", err
+	}
+	if err := a.store.Put(ctx, dir+"/"+ApplicationFile, application, "application/yaml"); err != nil {
+		return "", err
+	}
+
+	events, err := a.getEvents(ctx, app)
+	if err != nil {
+		return "", err
+	}
+	if err := a.store.Put(ctx, dir+"/"+EventsFile, events, "application/yaml"); err != nil {
+		return "", err
+	}
+
+	driverLog, err := a.getDriverLogTail(ctx, app)
+	if err != nil {
+		return "", err
+	}
+	if driverLog != nil {
+		if err := a.store.Put(ctx, dir+"/"+DriverLogFile, driverLog, "text/plain"); err != nil {
+			return "", err
+		}
+	}
+
+	return a.store.URL(dir), nil
+}
+
+// ArchiveDiagnostics writes the given gzipped tarball of driver dumps next to the archive of the application,
+// and returns its URL.
+func (a *Archiver) ArchiveDiagnostics(ctx context.Context, app *v1beta2.SparkApplication, data []byte) (string, error) {
+	key := a.getPath(app) + "/" + DiagnosticsFile
+	if err := a.store.Put(ctx, key, data, "application/gzip"); err != nil {
+		return "", err
+	}
+	return a.store.URL(key), nil
+}
+
+// getPath returns the key prefix of the archive of the application.
+func (a *Archiver) getPath(app *v1beta2.SparkApplication) string {
+	terminationTime := app.Status.TerminationTime.Time
+	if terminationTime.IsZero() {
+		terminationTime = time.Now()
+	}
+	terminationTime = terminationTime.UTC()
+
+	path := strings.NewReplacer(
+		"{{$appName}}", app.Name,
+		"{{$appNamespace}}", app.Namespace,
+		"{{$appUID}}", string(app.UID),
+		"{{$submissionID}}", app.Status.SubmissionID,
+		"{{$state}}", strings.ToLower(string(app.Status.AppState.State)),
+		"{{$year}}", fmt.Sprintf("%04d", terminationTime.Year()),
+		"{{$month}}", fmt.Sprintf("%02d", terminationTime.Month()),
+		"{{$day}}", fmt.Sprintf("%02d", terminationTime.Day()),
+	).Replace(a.options.PathFormat)
+	path = strings.Trim(path, "/")
+	if a.prefix != "" {
+		path = a.prefix + "/" + path
+	}
+	return path
+}
+
+// marshalApplication serializes the application without its managed fields.
+func marshalApplication(app *v1beta2.SparkApplication) ([]byte, error) {
+	app = app.DeepCopy()
+	app.APIVersion = v1beta2.SchemeGroupVersion.String()
+	app.Kind = "SparkApplication"
+	app.ManagedFields = nil
+
+	data, err := yaml.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SparkApplication: %v", err)
+	}
+	return data, nil
+}
+
+// getEvents returns the events of the application and of its driver pod, ordered by time.
+func (a *Archiver) getEvents(ctx context.Context, app *v1beta2.SparkApplication) ([]byte, error) {
+	selectors := []fields.Set{
+		{"involvedObject.kind": "SparkApplication", "involvedObject.name": app.Name},
+		{"involvedObject.kind": "Pod", "involvedObject.name": util.GetDriverPodName(app)},
+	}
+
+	events := &corev1.EventList{}
+	for _, selector := range selectors {
+		list, err := a.clientset.CoreV1().Events(app.Namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: selector.AsSelector().String(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list events: %v", err)
+		}
+		for _, event := range list.Items {
+			event.ManagedFields = nil
+			events.Items = append(events.Items, event)
+		}
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return getEventTime(&events.Items[i]).Before(getEventTime(&events.Items[j]))
+	})
+
+	events.APIVersion = "v1"
+	events.Kind = "EventList"
+	data, err := yaml.Marshal(events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal events: %v", err)
+	}
+	return data, nil
+}
+
+// getDriverLogTail returns the tail of the driver log, or nil if the driver pod no longer exists.
+func (a *Archiver) getDriverLogTail(ctx context.Context, app *v1beta2.SparkApplication) ([]byte, error) {
+	if a.options.LogTailLines <= 0 {
+		return nil, nil
+	}
+
+	logger := log.FromContext(ctx)
+	podName := util.GetDriverPodName(app)
+	tailLines := a.options.LogTailLines
+	stream, err := a.clientset.CoreV1().Pods(app.Namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: common.SparkDriverContainerName,
+		TailLines: &tailLines,
+	}).Stream(ctx)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Driver pod not found, archiving SparkApplication without driver log", "pod", podName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get driver log: %v", err)
+	}
+	defer stream.Close()
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read driver log: %v", err)
+	}
+	return data, nil
+}
+
+func getEventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// memoryStore is a Store keeping objects in memory.
+type memoryStore map[string]string
+
+func (s memoryStore) Put(_ context.Context, key string, data []byte, _ string) error {
+	s[key] = string(data)
+	return nil
+}
+
+func (s memoryStore) URL(key string) string {
+	return "s3://archive/" + key
+}
+
+func TestArchive(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:          "test-app",
+			Namespace:     "default",
+			UID:           "test-uid",
+			ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+		},
+		Spec: v1beta2.SparkApplicationSpec{MainClass: ptr.To("org.apache.spark.examples.SparkPi")},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID:    "submission-1",
+			TerminationTime: metav1.NewTime(time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC)),
+			AppState:        v1beta2.ApplicationState{State: v1beta2.ApplicationStateFailed, ErrorMessage: "driver failed"},
+		},
+	}
+	clientset := fake.NewSimpleClientset(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"}},
+		&corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "test-app.1", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{Kind: "SparkApplication", Name: "test-app"},
+			Reason:         "SparkApplicationFailed",
+		},
+	)
+
+	t.Run("default layout", func(t *testing.T) {
+		store := memoryStore{}
+		archiver := NewArchiver(clientset, store, "/spark/", Options{LogTailLines: 100})
+
+		path, err := archiver.Archive(context.Background(), app)
+		require.NoError(t, err)
+		assert.Equal(t, "s3://archive/spark/default/2025/03/07/test-app-submission-1", path)
+
+		archived := &v1beta2.SparkApplication{}
+		require.NoError(t, yaml.Unmarshal([]byte(store["spark/default/2025/03/07/test-app-submission-1/application.yaml"]), archived))
+		assert.Equal(t, "SparkApplication", archived.Kind)
+		assert.Equal(t, "sparkoperator.k8s.io/v1beta2", archived.APIVersion)
+		assert.Nil(t, archived.ManagedFields)
+		assert.Equal(t, app.Spec, archived.Spec)
+		assert.Equal(t, "driver failed", archived.Status.AppState.ErrorMessage)
+
+		events := &corev1.EventList{}
+		require.NoError(t, yaml.Unmarshal([]byte(store["spark/default/2025/03/07/test-app-submission-1/events.yaml"]), events))
+		require.NotEmpty(t, events.Items)
+		assert.Equal(t, "SparkApplicationFailed", events.Items[0].Reason)
+
+		assert.Equal(t, "fake logs", store["spark/default/2025/03/07/test-app-submission-1/driver.log"])
+	})
+
+	t.Run("custom layout without driver log", func(t *testing.T) {
+		store := memoryStore{}
+		archiver := NewArchiver(clientset, store, "", Options{PathFormat: "{{$state}}/{{$appNamespace}}/{{$appUID}}/"})
+
+		path, err := archiver.Archive(context.Background(), app)
+		require.NoError(t, err)
+		assert.Equal(t, "s3://archive/failed/default/test-uid", path)
+		assert.Contains(t, store, "failed/default/test-uid/application.yaml")
+		assert.Contains(t, store, "failed/default/test-uid/events.yaml")
+		assert.NotContains(t, store, "failed/default/test-uid/driver.log")
+	})
+
+	t.Run("diagnostics", func(t *testing.T) {
+		store := memoryStore{}
+		archiver := NewArchiver(clientset, store, "spark", Options{})
+
+		url, err := archiver.ArchiveDiagnostics(context.Background(), app, []byte("dumps"))
+		require.NoError(t, err)
+		assert.Equal(t, "s3://archive/spark/default/2025/03/07/test-app-submission-1/diagnostics.tar.gz", url)
+		assert.Equal(t, "dumps", store["spark/default/2025/03/07/test-app-submission-1/diagnostics.tar.gz"])
+	})
+}
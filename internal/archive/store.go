@@ -0,0 +1,309 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// SchemeS3 is the URL scheme of Amazon S3 and S3 compatible stores, e.g. `s3://bucket/prefix`.
+	SchemeS3 = "s3"
+
+	// SchemeGCS is the URL scheme of Google Cloud Storage, e.g. `gs://bucket/prefix`, which is
+	// accessed through its S3 compatible XML API with HMAC keys.
+	SchemeGCS = "gs"
+
+	// SchemeAzureBlob is the URL scheme of Azure Blob Storage, e.g. `azblob://container/prefix`.
+	SchemeAzureBlob = "azblob"
+
+	defaultS3Region  = "us-east-1"
+	gcsEndpoint      = "https://storage.googleapis.com"
+	azureBlobVersion = "2021-08-06"
+	putObjectTimeout = 30 * time.Second
+	// putObjectMinRate is the slowest upload rate in bytes per second large objects are given time for.
+	putObjectMinRate = 1 << 20
+	amzDateFormat    = "20060102T150405Z"
+)
+
+// Store writes objects to object storage.
+type Store interface {
+	// Put writes the object with the given key, replacing any existing object.
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// URL returns the URL of the object with the given key.
+	URL(key string) string
+}
+
+// StoreOptions holds the endpoint and credentials of an object store.
+type StoreOptions struct {
+	// Endpoint overrides the endpoint of the store, e.g. the URL of a MinIO server.
+	Endpoint string
+
+	// Region is the region of S3 buckets. Defaults to us-east-1.
+	Region string
+
+	// AccessKeyID and SecretAccessKey sign the requests to S3 and GCS, which requires HMAC keys.
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// SessionToken is the session token of temporary S3 credentials.
+	SessionToken string
+
+	// AzureAccount is the Azure storage account of Azure Blob Storage containers.
+	AzureAccount string
+
+	// AzureSASToken is the shared access signature authorizing writes to Azure Blob Storage containers.
+	AzureSASToken string
+}
+
+// NewStore returns the store of the given URL, along with the prefix of the keys of the objects it holds.
+func NewStore(rawURL string, options StoreOptions) (Store, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid archive url %q: %v", rawURL, err)
+	}
+	if u.Host == "" {
+		return nil, "", fmt.Errorf("invalid archive url %q: missing bucket", rawURL)
+	}
+	prefix := strings.Trim(u.Path, "/")
+
+	switch u.Scheme {
+	case SchemeS3, SchemeGCS:
+		if options.AccessKeyID == "" || options.SecretAccessKey == "" {
+			return nil, "", fmt.Errorf("an access key is required to write to %s buckets", u.Scheme)
+		}
+		store := &s3Store{
+			scheme:          u.Scheme,
+			bucket:          u.Host,
+			endpoint:        options.Endpoint,
+			region:          options.Region,
+			accessKeyID:     options.AccessKeyID,
+			secretAccessKey: options.SecretAccessKey,
+			sessionToken:    options.SessionToken,
+		}
+		if store.region == "" {
+			store.region = defaultS3Region
+		}
+		if store.endpoint == "" {
+			if u.Scheme == SchemeGCS {
+				store.endpoint = gcsEndpoint
+			} else {
+				store.endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", store.region)
+			}
+		}
+		return store, prefix, nil
+	case SchemeAzureBlob:
+		if options.AzureAccount == "" && options.Endpoint == "" {
+			return nil, "", fmt.Errorf("an Azure storage account is required to write to azblob containers")
+		}
+		if options.AzureSASToken == "" {
+			return nil, "", fmt.Errorf("a SAS token is required to write to azblob containers")
+		}
+		store := &azureBlobStore{
+			container: u.Host,
+			endpoint:  options.Endpoint,
+			sasToken:  strings.TrimPrefix(options.AzureSASToken, "?"),
+		}
+		if store.endpoint == "" {
+			store.endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", options.AzureAccount)
+		}
+		return store, prefix, nil
+	default:
+		return nil, "", fmt.Errorf("invalid archive url %q: unsupported scheme %q, must be s3, gs or azblob", rawURL, u.Scheme)
+	}
+}
+
+// s3Store writes objects to S3 compatible stores with path-style requests signed with AWS Signature Version 4.
+type s3Store struct {
+	scheme          string
+	bucket          string
+	endpoint        string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// s3Store implements Store.
+var _ Store = &s3Store{}
+
+// Put implements Store.
+func (s *s3Store) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	ctx, cancel := context.WithTimeout(ctx, getPutObjectTimeout(len(data)))
+	defer cancel()
+
+	objectPath := "/" + s.bucket + "/" + key
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, strings.TrimSuffix(s.endpoint, "/")+escapePath(objectPath), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+	signV4(req, s.accessKeyID, s.secretAccessKey, s.region, "s3", hashSHA256(data), time.Now())
+	return doRequest(req, s.URL(key))
+}
+
+// URL implements Store.
+func (s *s3Store) URL(key string) string {
+	return fmt.Sprintf("%s://%s/%s", s.scheme, s.bucket, key)
+}
+
+// azureBlobStore writes block blobs to Azure Blob Storage containers authorized by a SAS token.
+type azureBlobStore struct {
+	container string
+	endpoint  string
+	sasToken  string
+}
+
+// azureBlobStore implements Store.
+var _ Store = &azureBlobStore{}
+
+// Put implements Store.
+func (s *azureBlobStore) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	ctx, cancel := context.WithTimeout(ctx, getPutObjectTimeout(len(data)))
+	defer cancel()
+
+	blobURL := strings.TrimSuffix(s.endpoint, "/") + escapePath("/"+s.container+"/"+key) + "?" + s.sasToken
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, blobURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Ms-Blob-Type", "BlockBlob")
+	req.Header.Set("X-Ms-Version", azureBlobVersion)
+	return doRequest(req, s.URL(key))
+}
+
+// URL implements Store.
+func (s *azureBlobStore) URL(key string) string {
+	return fmt.Sprintf("%s://%s/%s", SchemeAzureBlob, s.container, key)
+}
+
+// getPutObjectTimeout returns how long writing an object of the given size may take.
+func getPutObjectTimeout(size int) time.Duration {
+	return putObjectTimeout + time.Duration(size/putObjectMinRate)*time.Second
+}
+
+func doRequest(req *http.Request, objectURL string) error {
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %v", objectURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to write %s: %s: %s", objectURL, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// signV4 signs the request with AWS Signature Version 4, covering the host and all the headers of the request.
+func signV4(req *http.Request, accessKeyID, secretAccessKey, region, service, payloadHash string, now time.Time) {
+	timestamp := now.UTC().Format(amzDateFormat)
+	date := timestamp[:8]
+	req.Header.Set("X-Amz-Date", timestamp)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		timestamp,
+		scope,
+		hashSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	for _, part := range []string{region, service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func hashSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// escapePath percent-encodes all the bytes of the path but its slashes and unreserved characters,
+// as expected by signed S3 requests.
+func escapePath(p string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(path.Clean(p)) {
+		if b == '/' || b == '-' || b == '_' || b == '.' || b == '~' ||
+			('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9') {
+			escaped.WriteByte(b)
+		} else {
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package audit records the actions the operator performs on behalf of SparkApplications, such as
+// submissions, kills and the creation and deletion of resources, as a stream of JSON entries.
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Action is an action performed by the operator.
+type Action string
+
+// Actions recorded in the audit log.
+const (
+	ActionSubmit   Action = "submit"
+	ActionResubmit Action = "resubmit"
+	ActionKill     Action = "kill"
+	ActionCreate   Action = "create"
+	ActionDelete   Action = "delete"
+)
+
+// Outcome is the outcome of an action.
+type Outcome string
+
+// Outcomes of actions.
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// StdoutPath writes the audit log to the standard output.
+const StdoutPath = "-"
+
+// Entry is an entry of the audit log.
+type Entry struct {
+	// Time is when the action was performed.
+	Time time.Time `json:"time"`
+	// Actor is the identity the operator performs the action with.
+	Actor string `json:"actor"`
+	// Instance is the operator instance that performed the action.
+	Instance string `json:"instance,omitempty"`
+	// Action is the action performed.
+	Action Action `json:"action"`
+	// Resource is the resource the action was performed on.
+	Resource Resource `json:"resource"`
+	// Application is the SparkApplication the action was performed for, if any.
+	Application string `json:"application,omitempty"`
+	// Outcome is the outcome of the action.
+	Outcome Outcome `json:"outcome"`
+	// Error is the error the action failed with.
+	Error string `json:"error,omitempty"`
+	// Details holds additional information about the action, e.g. the reason of a kill.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Resource identifies a Kubernetes resource.
+type Resource struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// Options configures a Logger.
+type Options struct {
+	// Path is the file the audit log is written to, or StdoutPath.
+	Path string
+
+	// MaxSizeMB is the size in megabytes past which the audit log file is rotated. Zero disables rotation.
+	MaxSizeMB int
+
+	// MaxBackups is the number of rotated audit log files retained.
+	MaxBackups int
+
+	// Actor is the identity the operator performs actions with.
+	Actor string
+
+	// Instance identifies the operator instance, e.g. its pod name.
+	Instance string
+}
+
+// Logger writes the entries of the audit log. A nil Logger discards entries.
+type Logger struct {
+	mu       sync.Mutex
+	writer   io.Writer
+	actor    string
+	instance string
+}
+
+// NewLogger creates a new Logger writing to the file or stream of the given options.
+func NewLogger(options Options) (*Logger, error) {
+	logger := &Logger{
+		actor:    options.Actor,
+		instance: options.Instance,
+	}
+	if options.Path == StdoutPath {
+		logger.writer = os.Stdout
+		return logger, nil
+	}
+
+	writer, err := newRotatingFile(options.Path, int64(options.MaxSizeMB)*1024*1024, options.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+	logger.writer = writer
+	return logger, nil
+}
+
+// Record writes the entry to the audit log, filling in its time, actor and instance.
+func (l *Logger) Record(entry Entry) {
+	if l == nil {
+		return
+	}
+
+	if entry.Time.IsZero() {
+		entry.Time = time.Now().UTC()
+	}
+	entry.Actor = l.actor
+	entry.Instance = l.instance
+	data, err := json.Marshal(entry)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to marshal audit entry: %v\n", err)
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.writer.Write(append(data, '\n')); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write audit entry: %v\n", err)
+	}
+}
+
+// GetOutcome returns the outcome of an action that returned the given error.
+func GetOutcome(err error) (Outcome, string) {
+	if err != nil {
+		return OutcomeFailure, err.Error()
+	}
+	return OutcomeSuccess, ""
+}
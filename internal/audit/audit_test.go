@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readEntries reads the entries of the audit log file.
+func readEntries(t *testing.T, path string) []Entry {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := Entry{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.NoError(t, scanner.Err())
+	return entries
+}
+
+func TestLoggerRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	logger, err := NewLogger(Options{
+		Path:     path,
+		Actor:    "system:serviceaccount:spark-operator:spark-operator-controller",
+		Instance: "spark-operator-controller-0",
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC)
+	logger.Record(Entry{
+		Time:        now,
+		Action:      ActionSubmit,
+		Resource:    Resource{APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication", Namespace: "default", Name: "spark-pi"},
+		Application: "default/spark-pi",
+		Outcome:     OutcomeSuccess,
+		Details:     map[string]string{"submissionID": "submission-1"},
+	})
+	outcome, message := GetOutcome(fmt.Errorf("forbidden"))
+	logger.Record(Entry{
+		Action:   ActionCreate,
+		Resource: Resource{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "spark-pi-ui-svc"},
+		Outcome:  outcome,
+		Error:    message,
+	})
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.Equal(t, Entry{
+		Time:        now,
+		Actor:       "system:serviceaccount:spark-operator:spark-operator-controller",
+		Instance:    "spark-operator-controller-0",
+		Action:      ActionSubmit,
+		Resource:    Resource{APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication", Namespace: "default", Name: "spark-pi"},
+		Application: "default/spark-pi",
+		Outcome:     OutcomeSuccess,
+		Details:     map[string]string{"submissionID": "submission-1"},
+	}, entries[0])
+	assert.False(t, entries[1].Time.IsZero())
+	assert.Equal(t, OutcomeFailure, entries[1].Outcome)
+	assert.Equal(t, "forbidden", entries[1].Error)
+}
+
+func TestNilLogger(t *testing.T) {
+	var logger *Logger
+	logger.Record(Entry{Action: ActionKill})
+}
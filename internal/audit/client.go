@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// Client is a client recording the resources it creates and deletes in the audit log.
+type Client struct {
+	client.Client
+	logger *Logger
+}
+
+// Client implements client.Client.
+var _ client.Client = &Client{}
+
+// NewClient returns a client recording the resources created and deleted through the given client,
+// or the given client itself if the logger is nil.
+func NewClient(c client.Client, logger *Logger) client.Client {
+	if logger == nil {
+		return c
+	}
+	return &Client{Client: c, logger: logger}
+}
+
+// Create implements client.Client.
+func (c *Client) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	err := c.Client.Create(ctx, obj, opts...)
+	if !isDryRun(opts) {
+		c.record(ActionCreate, obj, err)
+	}
+	return err
+}
+
+// Delete implements client.Client.
+func (c *Client) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	err := c.Client.Delete(ctx, obj, opts...)
+	// Deleting a resource that does not exist is not an action.
+	if !errors.IsNotFound(err) {
+		c.record(ActionDelete, obj, err)
+	}
+	return err
+}
+
+// DeleteAllOf implements client.Client.
+func (c *Client) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	err := c.Client.DeleteAllOf(ctx, obj, opts...)
+	deleteAllOfOptions := &client.DeleteAllOfOptions{}
+	deleteAllOfOptions.ApplyOptions(opts)
+
+	entry := c.newEntry(ActionDelete, obj, err)
+	entry.Resource.Name = "*"
+	if deleteAllOfOptions.Namespace != "" {
+		entry.Resource.Namespace = deleteAllOfOptions.Namespace
+	}
+	if deleteAllOfOptions.LabelSelector != nil {
+		entry.Details = map[string]string{"labelSelector": deleteAllOfOptions.LabelSelector.String()}
+	}
+	c.logger.Record(entry)
+	return err
+}
+
+func (c *Client) record(action Action, obj client.Object, err error) {
+	c.logger.Record(c.newEntry(action, obj, err))
+}
+
+func (c *Client) newEntry(action Action, obj client.Object, err error) Entry {
+	entry := Entry{
+		Action:   action,
+		Resource: Resource{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+	}
+	if gvk, gvkErr := apiutil.GVKForObject(obj, c.Scheme()); gvkErr == nil {
+		entry.Resource.APIVersion, entry.Resource.Kind = gvk.ToAPIVersionAndKind()
+	}
+	if entry.Resource.Kind == "SparkApplication" {
+		entry.Application = obj.GetNamespace() + "/" + obj.GetName()
+	} else if appName := obj.GetLabels()[common.LabelSparkAppName]; appName != "" {
+		entry.Application = obj.GetNamespace() + "/" + appName
+	}
+	entry.Outcome, entry.Error = GetOutcome(err)
+	return entry
+}
+
+func isDryRun(opts []client.CreateOption) bool {
+	createOptions := &client.CreateOptions{}
+	createOptions.ApplyOptions(opts)
+	return len(createOptions.DryRun) > 0
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestClient(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := NewLogger(Options{Path: path, Actor: "spark-operator"})
+	require.NoError(t, err)
+
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "default"}}
+	c := NewClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).Build(), logger)
+
+	svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{
+		Name:      "spark-pi-ui-svc",
+		Namespace: "default",
+		Labels:    map[string]string{common.LabelSparkAppName: "spark-pi"},
+	}}
+	require.NoError(t, c.Create(ctx, svc.DeepCopy()))
+	require.Error(t, c.Create(ctx, svc.DeepCopy()))
+	require.NoError(t, c.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "dry-run", Namespace: "default"}}, client.DryRunAll))
+	require.NoError(t, c.Delete(ctx, svc))
+	require.Error(t, c.Delete(ctx, svc))
+	require.NoError(t, c.Delete(ctx, app))
+	require.NoError(t, c.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace("default"), client.MatchingLabels{common.LabelSparkAppName: "spark-pi"}))
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 5)
+
+	service := Resource{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "spark-pi-ui-svc"}
+	assert.Equal(t, ActionCreate, entries[0].Action)
+	assert.Equal(t, service, entries[0].Resource)
+	assert.Equal(t, "default/spark-pi", entries[0].Application)
+	assert.Equal(t, OutcomeSuccess, entries[0].Outcome)
+	assert.Equal(t, "spark-operator", entries[0].Actor)
+
+	assert.Equal(t, ActionCreate, entries[1].Action)
+	assert.Equal(t, OutcomeFailure, entries[1].Outcome)
+	assert.Contains(t, entries[1].Error, "already exists")
+
+	assert.Equal(t, ActionDelete, entries[2].Action)
+	assert.Equal(t, service, entries[2].Resource)
+	assert.Equal(t, OutcomeSuccess, entries[2].Outcome)
+
+	assert.Equal(t, ActionDelete, entries[3].Action)
+	assert.Equal(t, Resource{APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication", Namespace: "default", Name: "spark-pi"}, entries[3].Resource)
+	assert.Equal(t, "default/spark-pi", entries[3].Application)
+
+	assert.Equal(t, ActionDelete, entries[4].Action)
+	assert.Equal(t, Resource{APIVersion: "v1", Kind: "Pod", Namespace: "default", Name: "*"}, entries[4].Resource)
+	assert.Equal(t, map[string]string{"labelSelector": common.LabelSparkAppName + "=spark-pi"}, entries[4].Details)
+}
+
+func TestNewClientWithoutLogger(t *testing.T) {
+	c := fake.NewClientBuilder().Build()
+	assert.Equal(t, c, NewClient(c, nil))
+}
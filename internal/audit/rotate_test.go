@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	// Each file holds at most two 5-byte lines.
+	file, err := newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n", "dddd\n", "eeee\n", "ffff\n", "gggg\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	assertContent := func(path, content string) {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+	}
+	assertContent(path, "gggg\n")
+	assertContent(path+".1", "eeee\nffff\n")
+	assertContent(path+".2", "cccc\ndddd\n")
+	assert.NoFileExists(t, path+".3")
+
+	// Reopening the file appends to it.
+	file, err = newRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+	_, err = file.Write([]byte("hhhh\n"))
+	require.NoError(t, err)
+	assertContent(path, "gggg\nhhhh\n")
+}
+
+func TestRotatingFileWithoutBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	file, err := newRotatingFile(path, 10, 0)
+	require.NoError(t, err)
+
+	for _, line := range []string{"aaaa\n", "bbbb\n", "cccc\n"} {
+		_, err := file.Write([]byte(line))
+		require.NoError(t, err)
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "cccc\n", string(data))
+	assert.NoFileExists(t, path+".1")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cloudevents emits CloudEvents describing the lifecycle transitions of SparkApplications to an HTTP
+// sink, such as a Knative broker, or to a Kafka topic.
+package cloudevents
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const (
+	// SpecVersion is the version of the CloudEvents specification the events conform to.
+	SpecVersion = "1.0"
+
+	// EventTypePrefix prefixes the types of the events, which end with the lifecycle transition,
+	// e.g. `io.k8s.sparkoperator.sparkapplication.completed`.
+	EventTypePrefix = "io.k8s.sparkoperator.sparkapplication."
+
+	// EventTypeCreated is the type of the events emitted when a SparkApplication is created.
+	EventTypeCreated = EventTypePrefix + "created"
+
+	// EventTypeDeleted is the type of the events emitted when a SparkApplication is deleted.
+	EventTypeDeleted = EventTypePrefix + "deleted"
+
+	// ConfigMapSinkKey is the key of the sink in the ConfigMap configuring the sink of a namespace.
+	ConfigMapSinkKey = "sink.yaml"
+)
+
+// SinkType is the kind of endpoint events are sent to.
+type SinkType string
+
+const (
+	// SinkTypeHTTP sends events over HTTP in binary content mode, e.g. to a Knative broker or channel.
+	SinkTypeHTTP SinkType = "http"
+
+	// SinkTypeKafka produces events in structured content mode to a Kafka topic through a Kafka REST proxy,
+	// such as the Strimzi Kafka Bridge or the Confluent REST Proxy.
+	SinkTypeKafka SinkType = "kafka"
+)
+
+// Sink is an endpoint events are sent to.
+type Sink struct {
+	// Type is the kind of endpoint. Defaults to http.
+	Type SinkType `json:"type,omitempty"`
+	// URL is the URL of the HTTP endpoint or of the Kafka REST proxy.
+	URL string `json:"url"`
+	// Topic is the Kafka topic events are produced to. Required for Kafka sinks.
+	Topic string `json:"topic,omitempty"`
+}
+
+// Validate ensures the sink is well-formed.
+func (s *Sink) Validate() error {
+	switch s.Type {
+	case "", SinkTypeHTTP:
+	case SinkTypeKafka:
+		if s.Topic == "" {
+			return fmt.Errorf("kafka sink requires a topic")
+		}
+	default:
+		return fmt.Errorf("invalid sink type %q, must be %s or %s", s.Type, SinkTypeHTTP, SinkTypeKafka)
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid sink url %q", s.URL)
+	}
+	return nil
+}
+
+// Event is a CloudEvent describing a lifecycle transition of a SparkApplication.
+type Event struct {
+	SpecVersion     string    `json:"specversion"`
+	ID              string    `json:"id"`
+	Source          string    `json:"source"`
+	Type            string    `json:"type"`
+	Subject         string    `json:"subject"`
+	Time            time.Time `json:"time"`
+	DataContentType string    `json:"datacontenttype"`
+	Data            EventData `json:"data"`
+}
+
+// EventData is the data of an Event.
+type EventData struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	UID                string `json:"uid"`
+	State              string `json:"state,omitempty"`
+	PreviousState      string `json:"previousState,omitempty"`
+	SubmissionID       string `json:"submissionID,omitempty"`
+	SparkApplicationID string `json:"sparkApplicationId,omitempty"`
+	ErrorMessage       string `json:"errorMessage,omitempty"`
+	ExecutionAttempts  int32  `json:"executionAttempts,omitempty"`
+}
+
+// NewEvent creates an event of the given type for the given SparkApplication, which transitioned from the given
+// previous state.
+func NewEvent(app *v1beta2.SparkApplication, eventType string, previousState v1beta2.ApplicationStateType) Event {
+	return Event{
+		SpecVersion: SpecVersion,
+		// The resource version identifies the transition, so that sinks can deduplicate redelivered events.
+		ID:              fmt.Sprintf("%s-%s", app.UID, app.ResourceVersion),
+		Source:          fmt.Sprintf("/apis/%s/namespaces/%s/sparkapplications/%s", v1beta2.SchemeGroupVersion, app.Namespace, app.Name),
+		Type:            eventType,
+		Subject:         app.Name,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		Data: EventData{
+			Name:               app.Name,
+			Namespace:          app.Namespace,
+			UID:                string(app.UID),
+			State:              string(app.Status.AppState.State),
+			PreviousState:      string(previousState),
+			SubmissionID:       app.Status.SubmissionID,
+			SparkApplicationID: app.Status.SparkApplicationID,
+			ErrorMessage:       app.Status.AppState.ErrorMessage,
+			ExecutionAttempts:  app.Status.ExecutionAttempts,
+		},
+	}
+}
+
+// GetStateEventType returns the type of the events emitted when a SparkApplication enters the given state.
+func GetStateEventType(state v1beta2.ApplicationStateType) string {
+	return EventTypePrefix + strings.ToLower(string(state))
+}
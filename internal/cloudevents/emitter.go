@@ -0,0 +1,356 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+)
+
+const (
+	// defaultQueueSize is the number of events that may wait to be sent before new events are dropped.
+	defaultQueueSize = 1000
+
+	// sinkQueueSize is the number of events that may wait to be sent to a single sink before new events for the
+	// sink are dropped, so that a slow sink does not delay the events of other sinks.
+	sinkQueueSize = 100
+
+	// sinkIdleTimeout is how long the worker sending the events of a sink waits for new events before it stops.
+	sinkIdleTimeout = 5 * time.Minute
+
+	// sendAttempts is the number of times sending an event is attempted before it is dropped.
+	sendAttempts = 3
+
+	// sendTimeout bounds each attempt to send an event.
+	sendTimeout = 10 * time.Second
+)
+
+// Reasons for dropping events, as reported by the metrics.
+const (
+	dropReasonQueueFull  = "queue_full"
+	dropReasonSinkError  = "sink_error"
+	dropReasonSendFailed = "send_failed"
+)
+
+// Options configures an Emitter.
+type Options struct {
+	// Sink receives the events of the SparkApplications of namespaces that do not configure their own sink.
+	// Nil only emits the events of namespaces configuring a sink.
+	Sink *Sink
+
+	// ConfigMapName is the name of the ConfigMap configuring, under the sink.yaml key, the sink of the
+	// SparkApplications of its namespace. Empty disables namespace sinks.
+	ConfigMapName string
+
+	// QueueSize is the number of events that may wait to be sent before new events are dropped.
+	QueueSize int
+
+	// EgressPolicy restricts the hosts the sinks configured by namespaces may send events to. Nil only allows hosts
+	// resolving to public addresses. The default sink is configured by the cluster admin and is not restricted.
+	EgressPolicy *egress.Policy
+
+	// Metrics counts the events that were dropped. Nil disables the metrics.
+	Metrics *metrics.CloudEventsMetrics
+}
+
+// Emitter sends the events of SparkApplications to their sink in the background. Each sink is sent its events by a
+// worker of its own, in the order they were emitted, so that a slow or unreachable sink only delays its own events.
+// Events are sent at least once on a best-effort basis: an event that cannot be queued or sent after a few attempts
+// is dropped. A nil Emitter emits nothing.
+type Emitter struct {
+	client      client.Reader
+	options     Options
+	queue       chan Event
+	backoff     time.Duration
+	idleTimeout time.Duration
+
+	// defaultClient sends the events to the default sink and namespaceClient to the sinks configured by
+	// namespaces, enforcing the egress policy.
+	defaultClient   *http.Client
+	namespaceClient *http.Client
+}
+
+// Emitter implements manager.LeaderElectionRunnable so that only the leader emits events.
+var _ manager.LeaderElectionRunnable = &Emitter{}
+
+// NewEmitter creates a new Emitter reading the ConfigMaps configuring namespace sinks with the given client.
+func NewEmitter(client client.Reader, options Options) *Emitter {
+	queueSize := options.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	return &Emitter{
+		client:          client,
+		options:         options,
+		queue:           make(chan Event, queueSize),
+		backoff:         time.Second,
+		idleTimeout:     sinkIdleTimeout,
+		defaultClient:   &http.Client{Timeout: sendTimeout},
+		namespaceClient: options.EgressPolicy.NewClient(sendTimeout),
+	}
+}
+
+// Emit queues an event of the given type for the given SparkApplication, which transitioned from the given
+// previous state.
+func (e *Emitter) Emit(ctx context.Context, app *v1beta2.SparkApplication, eventType string, previousState v1beta2.ApplicationStateType) {
+	if e == nil {
+		return
+	}
+
+	event := NewEvent(app, eventType, previousState)
+	select {
+	case e.queue <- event:
+	default:
+		log.FromContext(ctx).Info("Dropping CloudEvent as the queue is full", "type", event.Type, "name", app.Name, "namespace", app.Namespace)
+		e.options.Metrics.HandleDroppedEvent(app.Namespace, dropReasonQueueFull)
+	}
+}
+
+// sinkKey identifies the worker sending the events of a sink.
+type sinkKey struct {
+	sinkType  SinkType
+	url       string
+	topic     string
+	namespace bool
+}
+
+// sinkWorker sends the events queued for a sink.
+type sinkWorker struct {
+	key    sinkKey
+	sink   *Sink
+	client *http.Client
+	queue  chan Event
+}
+
+// Start implements manager.Runnable. It dispatches the queued events to the workers of their sink until the context
+// is done. Workers are started on demand and stop once they have been idle for a while.
+func (e *Emitter) Start(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithName("cloudevents")
+	workers := map[sinkKey]*sinkWorker{}
+	idle := make(chan *sinkWorker)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case worker := <-idle:
+			// The worker keeps running if events were queued for it since it reported being idle.
+			if len(worker.queue) == 0 {
+				delete(workers, worker.key)
+				close(worker.queue)
+			}
+		case event := <-e.queue:
+			sink, err := e.getSink(ctx, event.Data.Namespace)
+			if err != nil {
+				logger.Error(err, "Dropping CloudEvent as its sink cannot be determined", "id", event.ID, "type", event.Type, "name", event.Data.Name, "namespace", event.Data.Namespace)
+				e.options.Metrics.HandleDroppedEvent(event.Data.Namespace, dropReasonSinkError)
+				continue
+			}
+			if sink == nil {
+				continue
+			}
+
+			key := sinkKey{sinkType: sink.Type, url: sink.URL, topic: sink.Topic, namespace: sink != e.options.Sink}
+			worker, ok := workers[key]
+			if !ok {
+				worker = &sinkWorker{key: key, sink: sink, client: e.defaultClient, queue: make(chan Event, sinkQueueSize)}
+				if key.namespace {
+					worker.client = e.namespaceClient
+				}
+				workers[key] = worker
+				go e.runWorker(ctx, worker, idle)
+			}
+			select {
+			case worker.queue <- event:
+			default:
+				logger.Info("Dropping CloudEvent as the queue of its sink is full", "id", event.ID, "type", event.Type, "name", event.Data.Name, "namespace", event.Data.Namespace, "sink", sink.URL)
+				e.options.Metrics.HandleDroppedEvent(event.Data.Namespace, dropReasonQueueFull)
+			}
+		}
+	}
+}
+
+// runWorker sends the events queued for the sink of the given worker until its queue is closed or the context is
+// done, reporting the worker on the idle channel once no event was queued for the idle timeout.
+func (e *Emitter) runWorker(ctx context.Context, worker *sinkWorker, idle chan<- *sinkWorker) {
+	logger := log.FromContext(ctx).WithName("cloudevents")
+	timer := time.NewTimer(e.idleTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-worker.queue:
+			if !ok {
+				return
+			}
+			if err := e.send(ctx, worker.sink, worker.client, event); err != nil {
+				logger.Error(err, "Failed to send CloudEvent", "id", event.ID, "type", event.Type, "name", event.Data.Name, "namespace", event.Data.Namespace)
+				e.options.Metrics.HandleDroppedEvent(event.Data.Namespace, dropReasonSendFailed)
+			}
+		case <-timer.C:
+			select {
+			case <-ctx.Done():
+				return
+			case idle <- worker:
+			}
+		}
+		timer.Reset(e.idleTimeout)
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (e *Emitter) NeedLeaderElection() bool {
+	return true
+}
+
+// send sends the given event to the given sink with the given client, retrying failed attempts with exponential
+// backoff.
+func (e *Emitter) send(ctx context.Context, sink *Sink, httpClient *http.Client, event Event) error {
+	backoff := e.backoff
+	for attempt := 1; ; attempt++ {
+		err := sendToSink(ctx, sink, httpClient, event)
+		if err == nil || attempt == sendAttempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// getSink returns the sink of the given namespace, which is the sink configured by the ConfigMap of the namespace,
+// if any, or the default sink otherwise.
+func (e *Emitter) getSink(ctx context.Context, namespace string) (*Sink, error) {
+	if e.options.ConfigMapName == "" {
+		return e.options.Sink, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := e.client.Get(ctx, types.NamespacedName{Name: e.options.ConfigMapName, Namespace: namespace}, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return e.options.Sink, nil
+		}
+		return nil, fmt.Errorf("failed to get config map %s/%s: %v", namespace, e.options.ConfigMapName, err)
+	}
+	data, ok := configMap.Data[ConfigMapSinkKey]
+	if !ok {
+		return e.options.Sink, nil
+	}
+	sink := &Sink{}
+	if err := yaml.UnmarshalStrict([]byte(data), sink); err != nil {
+		return nil, fmt.Errorf("failed to parse sink of namespace %s: %v", namespace, err)
+	}
+	if err := sink.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid sink of namespace %s: %v", namespace, err)
+	}
+	return sink, nil
+}
+
+// sendToSink makes one attempt to send the given event to the sink with the given client.
+func sendToSink(ctx context.Context, sink *Sink, httpClient *http.Client, event Event) error {
+	ctx, cancel := context.WithTimeout(ctx, sendTimeout)
+	defer cancel()
+
+	var req *http.Request
+	var err error
+	if sink.Type == SinkTypeKafka {
+		req, err = newKafkaRequest(ctx, sink, event)
+	} else {
+		req, err = newHTTPRequest(ctx, sink, event)
+	}
+	if err != nil {
+		return err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s returned %s", req.URL, resp.Status)
+	}
+	return nil
+}
+
+// newHTTPRequest creates a request carrying the given event in binary content mode, with the event attributes in
+// headers and its data in the body.
+func newHTTPRequest(ctx context.Context, sink *Sink, event Event) (*http.Request, error) {
+	body, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event data: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", event.DataContentType)
+	req.Header.Set("Ce-Specversion", event.SpecVersion)
+	req.Header.Set("Ce-Id", event.ID)
+	req.Header.Set("Ce-Source", event.Source)
+	req.Header.Set("Ce-Type", event.Type)
+	req.Header.Set("Ce-Subject", event.Subject)
+	req.Header.Set("Ce-Time", event.Time.Format(time.RFC3339Nano))
+	return req, nil
+}
+
+// kafkaRecords is the body of a request producing records through a Kafka REST proxy.
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+// newKafkaRequest creates a request producing the given event in structured content mode to the topic of the sink.
+// Records are keyed by the UID of the SparkApplication so that the events of an application are kept in order.
+func newKafkaRequest(ctx context.Context, sink *Sink, event Event) (*http.Request, error) {
+	body, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: event.Data.UID, Value: event}}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %v", err)
+	}
+	endpoint := strings.TrimSuffix(sink.URL, "/") + "/topics/" + url.PathEscape(sink.Topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	return req, nil
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+)
+
+func newTestApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test-app",
+			Namespace:       "default",
+			UID:             "test-uid",
+			ResourceVersion: "42",
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			SparkApplicationID: "spark-123",
+			SubmissionID:       "submission-1",
+			AppState:           v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted},
+		},
+	}
+}
+
+func TestSinkValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		sink    Sink
+		wantErr string
+	}{
+		{name: "http sink", sink: Sink{URL: "http://broker-ingress.knative-eventing.svc/default/default"}},
+		{name: "kafka sink", sink: Sink{Type: SinkTypeKafka, URL: "http://bridge.kafka.svc:8080", Topic: "spark-events"}},
+		{name: "kafka sink without topic", sink: Sink{Type: SinkTypeKafka, URL: "http://bridge.kafka.svc:8080"}, wantErr: "kafka sink requires a topic"},
+		{name: "invalid type", sink: Sink{Type: "amqp", URL: "http://broker"}, wantErr: `invalid sink type "amqp", must be http or kafka`},
+		{name: "relative url", sink: Sink{URL: "/events"}, wantErr: `invalid sink url "/events"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.sink.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	event := NewEvent(newTestApp(), GetStateEventType(v1beta2.ApplicationStateCompleted), v1beta2.ApplicationStateSucceeding)
+	assert.Equal(t, "1.0", event.SpecVersion)
+	assert.Equal(t, "test-uid-42", event.ID)
+	assert.Equal(t, "/apis/sparkoperator.k8s.io/v1beta2/namespaces/default/sparkapplications/test-app", event.Source)
+	assert.Equal(t, "io.k8s.sparkoperator.sparkapplication.completed", event.Type)
+	assert.Equal(t, "test-app", event.Subject)
+	assert.Equal(t, "COMPLETED", event.Data.State)
+	assert.Equal(t, "SUCCEEDING", event.Data.PreviousState)
+	assert.Equal(t, "spark-123", event.Data.SparkApplicationID)
+
+	assert.Equal(t, "io.k8s.sparkoperator.sparkapplication.submission_failed", GetStateEventType(v1beta2.ApplicationStateFailedSubmission))
+}
+
+func TestEmitterSend_HTTP(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests <- r
+		bodies <- body
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	emitter := NewEmitter(nil, Options{Sink: &Sink{URL: server.URL}})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = emitter.Start(ctx) }()
+
+	emitter.Emit(ctx, newTestApp(), GetStateEventType(v1beta2.ApplicationStateCompleted), v1beta2.ApplicationStateSucceeding)
+
+	select {
+	case req := <-requests:
+		assert.Equal(t, "1.0", req.Header.Get("Ce-Specversion"))
+		assert.Equal(t, "test-uid-42", req.Header.Get("Ce-Id"))
+		assert.Equal(t, "io.k8s.sparkoperator.sparkapplication.completed", req.Header.Get("Ce-Type"))
+		assert.Equal(t, "test-app", req.Header.Get("Ce-Subject"))
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+
+		data := EventData{}
+		require.NoError(t, json.Unmarshal(<-bodies, &data))
+		assert.Equal(t, "COMPLETED", data.State)
+		assert.Equal(t, "SUCCEEDING", data.PreviousState)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+}
+
+func TestEmitterSend_Kafka(t *testing.T) {
+	attempts := 0
+	var path string
+	var records kafkaRecords
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		// The first attempt fails and is retried.
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		path = r.URL.Path
+		assert.Equal(t, "application/vnd.kafka.json.v2+json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&records))
+	}))
+	defer server.Close()
+
+	emitter := NewEmitter(nil, Options{Sink: &Sink{Type: SinkTypeKafka, URL: server.URL + "/", Topic: "spark-events"}})
+	emitter.backoff = time.Millisecond
+	event := NewEvent(newTestApp(), EventTypeDeleted, v1beta2.ApplicationStateCompleted)
+	require.NoError(t, emitter.send(context.Background(), emitter.options.Sink, emitter.defaultClient, event))
+
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "/topics/spark-events", path)
+	require.Len(t, records.Records, 1)
+	assert.Equal(t, "test-uid", records.Records[0].Key)
+	assert.Equal(t, EventTypeDeleted, records.Records[0].Value.Type)
+	assert.Equal(t, "1.0", records.Records[0].Value.SpecVersion)
+}
+
+func TestEmitterGetSink(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "spark-cloudevents", Namespace: "team-a"},
+			Data:       map[string]string{ConfigMapSinkKey: "type: kafka\nurl: http://bridge.kafka.svc:8080\ntopic: team-a\n"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "spark-cloudevents", Namespace: "team-b"},
+			Data:       map[string]string{ConfigMapSinkKey: "type: kafka\nurl: http://bridge.kafka.svc:8080\n"},
+		},
+	).Build()
+
+	defaultSink := &Sink{URL: "http://broker-ingress.knative-eventing.svc/default/default"}
+	emitter := NewEmitter(client, Options{Sink: defaultSink, ConfigMapName: "spark-cloudevents"})
+
+	sink, err := emitter.getSink(ctx, "team-a")
+	require.NoError(t, err)
+	assert.Equal(t, &Sink{Type: SinkTypeKafka, URL: "http://bridge.kafka.svc:8080", Topic: "team-a"}, sink)
+
+	sink, err = emitter.getSink(ctx, "default")
+	require.NoError(t, err)
+	assert.Equal(t, defaultSink, sink)
+
+	_, err = emitter.getSink(ctx, "team-b")
+	assert.EqualError(t, err, "invalid sink of namespace team-b: kafka sink requires a topic")
+
+	// Namespaces without a sink emit nothing when there is no default sink.
+	emitter = NewEmitter(client, Options{ConfigMapName: "spark-cloudevents"})
+	sink, err = emitter.getSink(ctx, "default")
+	require.NoError(t, err)
+	assert.Nil(t, sink)
+}
+
+// newSinkClient returns a client reading a ConfigMap configuring a sink with the given URL in each given namespace.
+func newSinkClient(t *testing.T, sinkURL string, namespaces ...string) client.Reader {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, namespace := range namespaces {
+		builder.WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "spark-cloudevents", Namespace: namespace},
+			Data:       map[string]string{ConfigMapSinkKey: "url: " + sinkURL + "/" + namespace + "\n"},
+		})
+	}
+	return builder.Build()
+}
+
+// newTestAppInNamespace returns a test app in the given namespace.
+func newTestAppInNamespace(namespace string) *v1beta2.SparkApplication {
+	app := newTestApp()
+	app.Namespace = namespace
+	return app
+}
+
+// assertDropped asserts that the given number of events of the given namespace are eventually counted as dropped
+// for the given reason by the metrics with the given prefix.
+func assertDropped(t *testing.T, prefix, namespace, reason string, count int) {
+	name := prefix + "cloudevents_dropped_count"
+	expected := fmt.Sprintf(`# HELP %[1]s Total number of CloudEvents that were not delivered to their sink
+# TYPE %[1]s counter
+%[1]s{namespace=%[2]q,reason=%[3]q} %[4]d
+`, name, namespace, reason, count)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.NoError(c, testutil.GatherAndCompare(ctrlmetrics.Registry, strings.NewReader(expected), name))
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestEmitterSinkWorkers(t *testing.T) {
+	hanging := make(chan struct{}, 1)
+	release := make(chan struct{})
+	received := make(chan string, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The sink of team-a hangs until the end of the test.
+		if r.URL.Path == "/team-a" {
+			select {
+			case hanging <- struct{}{}:
+			default:
+			}
+			<-release
+			return
+		}
+		received <- r.URL.Path
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// The test server listens on a loopback address, which namespace sinks may only send to when allowed explicitly.
+	m := metrics.NewCloudEventsMetrics("sink_workers_test_")
+	m.Register()
+	emitter := NewEmitter(newSinkClient(t, server.URL, "team-a", "team-b"), Options{
+		ConfigMapName: "spark-cloudevents",
+		EgressPolicy:  &egress.Policy{AllowedHosts: []string{"127.0.0.1"}},
+		Metrics:       m,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = emitter.Start(ctx) }()
+
+	emitter.Emit(ctx, newTestAppInNamespace("team-a"), EventTypeCreated, "")
+	select {
+	case <-hanging:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+
+	// Events beyond the queue of the hanging sink are dropped, while the events of other sinks are still sent.
+	for range sinkQueueSize + 1 {
+		emitter.Emit(ctx, newTestAppInNamespace("team-a"), EventTypeCreated, "")
+	}
+	emitter.Emit(ctx, newTestAppInNamespace("team-b"), EventTypeCreated, "")
+
+	select {
+	case path := <-received:
+		assert.Equal(t, "/team-b", path)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+	assertDropped(t, "sink_workers_test_", "team-a", dropReasonQueueFull, 1)
+}
+
+func TestEmitterEgressPolicy(t *testing.T) {
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.URL.Path
+	}))
+	defer server.Close()
+
+	m := metrics.NewCloudEventsMetrics("egress_policy_test_")
+	m.Register()
+	emitter := NewEmitter(newSinkClient(t, server.URL, "team-a"), Options{
+		Sink:          &Sink{URL: server.URL + "/default"},
+		ConfigMapName: "spark-cloudevents",
+		Metrics:       m,
+	})
+	emitter.backoff = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = emitter.Start(ctx) }()
+
+	// The sink of team-a is on a loopback address, which is not allowed by the default egress policy, whereas the
+	// default sink configured by the cluster admin is not restricted.
+	emitter.Emit(ctx, newTestAppInNamespace("team-a"), EventTypeCreated, "")
+	assertDropped(t, "egress_policy_test_", "team-a", dropReasonSendFailed, 1)
+	emitter.Emit(ctx, newTestApp(), EventTypeCreated, "")
+	select {
+	case path := <-received:
+		assert.Equal(t, "/default", path)
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+}
+
+func TestNilEmitter(t *testing.T) {
+	var emitter *Emitter
+	emitter.Emit(context.Background(), newTestApp(), EventTypeCreated, "")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customresourcedefinition
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/certificate"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var (
+	logger = ctrl.Log.WithName("")
+)
+
+// ConversionWebhookPath is the path the conversion webhook is served on.
+const ConversionWebhookPath = "/convert"
+
+// Reconciler configures the conversion webhook of CustomResourceDefinitions serving multiple versions.
+type Reconciler struct {
+	client           client.Client
+	certProvider     *certificate.Provider
+	names            []string
+	serviceName      string
+	serviceNamespace string
+	servicePort      int32
+}
+
+// Reconciler implements reconcile.Reconciler.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// NewReconciler creates a new Reconciler instance.
+func NewReconciler(
+	client client.Client,
+	certProvider *certificate.Provider,
+	names []string,
+	serviceName string,
+	serviceNamespace string,
+	servicePort int32,
+) *Reconciler {
+	return &Reconciler{
+		client:           client,
+		certProvider:     certProvider,
+		names:            names,
+		serviceName:      serviceName,
+		serviceNamespace: serviceNamespace,
+		servicePort:      servicePort,
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	kind := "CustomResourceDefinition"
+	name := strings.ToLower(kind)
+
+	// Use a custom log constructor.
+	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		Watches(
+			&apiextensionsv1.CustomResourceDefinition{},
+			NewEventHandler(),
+			builder.WithPredicates(
+				NewEventFilter(r.names),
+			),
+		).
+		WithOptions(options).
+		Complete(r)
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger.Info("Updating conversion webhook of CustomResourceDefinition", "name", req.Name)
+	if err := r.updateCustomResourceDefinition(ctx, req.NamespacedName); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) updateCustomResourceDefinition(ctx context.Context, key types.NamespacedName) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.client.Get(ctx, key, crd); err != nil {
+		return fmt.Errorf("failed to get custom resource definition %v: %v", key, err)
+	}
+
+	// The conversion webhook is only called when there is more than one version to convert between.
+	if len(crd.Spec.Versions) < 2 {
+		return nil
+	}
+
+	caBundle, err := r.certProvider.CACert()
+	if err != nil {
+		return fmt.Errorf("failed to get CA certificate: %v", err)
+	}
+
+	path := ConversionWebhookPath
+	conversion := &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig: &apiextensionsv1.WebhookClientConfig{
+				Service: &apiextensionsv1.ServiceReference{
+					Name:      r.serviceName,
+					Namespace: r.serviceNamespace,
+					Path:      &path,
+					Port:      &r.servicePort,
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+	if equality.Semantic.DeepEqual(crd.Spec.Conversion, conversion) {
+		return nil
+	}
+
+	newCRD := crd.DeepCopy()
+	newCRD.Spec.Conversion = conversion
+	if err := r.client.Update(ctx, newCRD); err != nil {
+		return fmt.Errorf("failed to update custom resource definition %v: %v", key, err)
+	}
+
+	return nil
+}
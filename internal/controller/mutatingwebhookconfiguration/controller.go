@@ -22,13 +22,18 @@ import (
 	"strings"
 
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
 	"github.com/kubeflow/spark-operator/v2/pkg/certificate"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
@@ -42,17 +47,30 @@ type Reconciler struct {
 	client       client.Client
 	certProvider *certificate.Provider
 	name         string
+	policy       *webhook.ConfigurationPolicy
+	// policyConfigMap is the ConfigMap the policy is read from at runtime, if any, in which case policy is ignored.
+	policyConfigMap types.NamespacedName
 }
 
 // MutatingWebhookConfigurationReconciler implements reconcile.Reconciler.
 var _ reconcile.Reconciler = &Reconciler{}
 
 // NewReconciler creates a new MutatingWebhookConfigurationReconciler instance.
-func NewReconciler(client client.Client, certProvider *certificate.Provider, name string) *Reconciler {
+// The CA bundle of the webhooks is left untouched if certProvider is nil, e.g. when it is injected by cert-manager.
+// The policy is read from policyConfigMap at runtime if its name is not empty.
+func NewReconciler(
+	client client.Client,
+	certProvider *certificate.Provider,
+	name string,
+	policy *webhook.ConfigurationPolicy,
+	policyConfigMap types.NamespacedName,
+) *Reconciler {
 	return &Reconciler{
-		client:       client,
-		certProvider: certProvider,
-		name:         name,
+		client:          client,
+		certProvider:    certProvider,
+		name:            name,
+		policy:          policy,
+		policyConfigMap: policyConfigMap,
 	}
 }
 
@@ -63,7 +81,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 	// Use a custom log constructor.
 	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		Watches(
 			&admissionregistrationv1.MutatingWebhookConfiguration{},
@@ -71,13 +89,41 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 			builder.WithPredicates(
 				NewEventFilter(r.name),
 			),
-		).
-		WithOptions(options).
-		Complete(r)
+		)
+
+	enqueue := handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: r.name}}}
+	})
+
+	// The policy is applied again whenever its ConfigMap changes.
+	if r.policyConfigMap.Name != "" {
+		b = b.Watches(
+			&corev1.ConfigMap{},
+			enqueue,
+			builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+				return object.GetNamespace() == r.policyConfigMap.Namespace && object.GetName() == r.policyConfigMap.Name
+			})),
+		)
+	}
+
+	// Namespaces matching the excluded namespace patterns of the policy are excluded once they are created.
+	if r.policyConfigMap.Name != "" || r.policy.HasNamespacePatterns() {
+		b = b.Watches(
+			&corev1.Namespace{},
+			enqueue,
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+				DeleteFunc:  func(event.DeleteEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			}),
+		)
+	}
+
+	return b.WithOptions(options).Complete(r)
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	logger.Info("Updating CA bundle of MutatingWebhookConfiguration", "name", req.Name)
+	logger.Info("Updating MutatingWebhookConfiguration", "name", req.Name)
 	if err := r.updateMutatingWebhookConfiguration(ctx, req.NamespacedName); err != nil {
 		return ctrl.Result{Requeue: true}, err
 	}
@@ -85,23 +131,70 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 func (r *Reconciler) updateMutatingWebhookConfiguration(ctx context.Context, key types.NamespacedName) error {
-	webhook := &admissionregistrationv1.MutatingWebhookConfiguration{}
-	if err := r.client.Get(ctx, key, webhook); err != nil {
+	webhookConfiguration := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := r.client.Get(ctx, key, webhookConfiguration); err != nil {
 		return fmt.Errorf("failed to get mutating webhook configuration %v: %v", key, err)
 	}
 
-	caBundle, err := r.certProvider.CACert()
+	policy := r.policy
+	if r.policyConfigMap.Name != "" {
+		var err error
+		if policy, err = webhook.GetConfigurationPolicy(ctx, r.client, r.policyConfigMap); err != nil {
+			return err
+		}
+	}
+
+	namespaces, err := r.getNamespaceNames(ctx, policy)
 	if err != nil {
-		return fmt.Errorf("failed to get CA certificate: %v", err)
+		return err
 	}
 
-	newWebhook := webhook.DeepCopy()
+	newWebhook := webhookConfiguration.DeepCopy()
+	settings := make([]webhook.WebhookSettings, 0, len(newWebhook.Webhooks))
 	for i := range newWebhook.Webhooks {
-		newWebhook.Webhooks[i].ClientConfig.CABundle = caBundle
+		w := &newWebhook.Webhooks[i]
+		settings = append(settings, webhook.WebhookSettings{
+			Name:              w.Name,
+			FailurePolicy:     &w.FailurePolicy,
+			NamespaceSelector: &w.NamespaceSelector,
+			ObjectSelector:    &w.ObjectSelector,
+		})
+	}
+	if err := policy.Reconcile(newWebhook, settings, namespaces); err != nil {
+		return fmt.Errorf("failed to apply webhook configuration policy to mutating webhook configuration %v: %v", key, err)
+	}
+
+	if r.certProvider != nil {
+		caBundle, err := r.certProvider.CACert()
+		if err != nil {
+			return fmt.Errorf("failed to get CA certificate: %v", err)
+		}
+		for i := range newWebhook.Webhooks {
+			newWebhook.Webhooks[i].ClientConfig.CABundle = caBundle
+		}
 	}
+
 	if err := r.client.Update(ctx, newWebhook); err != nil {
 		return fmt.Errorf("failed to update mutating webhook configuration %v: %v", key, err)
 	}
 
 	return nil
 }
+
+// getNamespaceNames returns the names of the existing namespaces if they are needed to resolve the excluded
+// namespace patterns of the given policy.
+func (r *Reconciler) getNamespaceNames(ctx context.Context, policy *webhook.ConfigurationPolicy) ([]string, error) {
+	if !policy.HasNamespacePatterns() {
+		return nil, nil
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.client.List(ctx, namespaces); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %v", err)
+	}
+	names := make([]string, 0, len(namespaces.Items))
+	for _, namespace := range namespaces.Items {
+		names = append(names, namespace.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"fmt"
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultServiceAccountName is the default name of the service account Spark applications run with in onboarded namespaces.
+const DefaultServiceAccountName = "spark"
+
+// Config describes the resources provisioned in onboarded namespaces.
+type Config struct {
+	// ServiceAccountName is the name of the service account bound to the permissions of Spark drivers.
+	// Defaults to spark.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// ResourceQuota is the spec of the default ResourceQuota of onboarded namespaces. Nil creates no ResourceQuota.
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+
+	// NetworkPolicies are the NetworkPolicies created in onboarded namespaces.
+	NetworkPolicies []NetworkPolicy `json:"networkPolicies,omitempty"`
+
+	// SecurityContextConstraints is the name of the OpenShift SecurityContextConstraints the service account is
+	// granted use of, through the `system:openshift:scc:<name>` ClusterRole. Empty grants none.
+	SecurityContextConstraints string `json:"securityContextConstraints,omitempty"`
+}
+
+// NetworkPolicy is a NetworkPolicy created in onboarded namespaces.
+type NetworkPolicy struct {
+	// Name is the name of the NetworkPolicy.
+	Name string `json:"name"`
+
+	// Spec is the spec of the NetworkPolicy.
+	Spec networkingv1.NetworkPolicySpec `json:"spec"`
+}
+
+// LoadConfig loads the onboarding configuration from the given YAML file, or returns the default configuration
+// if the path is empty.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{}
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read namespace onboarding config: %v", err)
+		}
+		if err := yaml.UnmarshalStrict(data, config); err != nil {
+			return nil, fmt.Errorf("failed to parse namespace onboarding config: %v", err)
+		}
+	}
+
+	if config.ServiceAccountName == "" {
+		config.ServiceAccountName = DefaultServiceAccountName
+	}
+	names := make(map[string]bool, len(config.NetworkPolicies))
+	for _, policy := range config.NetworkPolicies {
+		if policy.Name == "" {
+			return nil, fmt.Errorf("invalid namespace onboarding config: network policy name is required")
+		}
+		if names[policy.Name] {
+			return nil, fmt.Errorf("invalid namespace onboarding config: duplicate network policy %s", policy.Name)
+		}
+		names[policy.Name] = true
+	}
+	return config, nil
+}
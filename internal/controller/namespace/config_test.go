@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestLoadConfig(t *testing.T) {
+	config, err := LoadConfig("")
+	require.NoError(t, err)
+	assert.Equal(t, &Config{ServiceAccountName: DefaultServiceAccountName}, config)
+
+	testCases := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name: "valid config",
+			content: `
+serviceAccountName: spark-tenant
+resourceQuota:
+  hard:
+    pods: "50"
+networkPolicies:
+- name: deny-ingress
+  spec:
+    podSelector: {}
+    policyTypes: [Ingress]
+securityContextConstraints: nonroot
+`,
+		},
+		{
+			name:    "unknown field",
+			content: "unknown: true\n",
+			wantErr: "failed to parse namespace onboarding config",
+		},
+		{
+			name:    "missing network policy name",
+			content: "networkPolicies:\n- spec: {}\n",
+			wantErr: "network policy name is required",
+		},
+		{
+			name:    "duplicate network policy",
+			content: "networkPolicies:\n- name: a\n- name: a\n",
+			wantErr: "duplicate network policy a",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o644))
+
+			config, err := LoadConfig(path)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "spark-tenant", config.ServiceAccountName)
+			assert.Equal(t, "nonroot", config.SecurityContextConstraints)
+			require.Len(t, config.NetworkPolicies, 1)
+			assert.Equal(t, "deny-ingress", config.NetworkPolicies[0].Name)
+			assert.True(t, config.ResourceQuota.Hard.Pods().Equal(resource.MustParse("50")))
+		})
+	}
+}
@@ -0,0 +1,332 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+const (
+	// rbacName is the name of the Role and RoleBinding granting the permissions of Spark drivers.
+	rbacName = "spark-operator-spark"
+
+	// sccRoleBindingName is the name of the RoleBinding granting use of OpenShift SecurityContextConstraints.
+	sccRoleBindingName = "spark-operator-spark-scc"
+
+	// resourceQuotaName is the name of the default ResourceQuota.
+	resourceQuotaName = "spark-operator-default"
+
+	// sccClusterRolePrefix prefixes the OpenShift ClusterRoles granting use of SecurityContextConstraints.
+	sccClusterRolePrefix = "system:openshift:scc:"
+)
+
+var (
+	logger = ctrl.Log.WithName("")
+
+	// errNotManaged is returned when mutating an existing object that was not created by the operator.
+	errNotManaged = stderrors.New("not created by the operator")
+)
+
+// +kubebuilder:rbac:groups=,resources=namespaces,verbs=get;list;watch
+// +kubebuilder:rbac:groups=,resources=serviceaccounts,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=,resources=resourcequotas,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;create;update;delete
+// +kubebuilder:rbac:groups=,resources=events,verbs=create;update;patch
+
+// Reconciler provisions the resources needed to run Spark applications in the namespaces matching a label selector,
+// and removes them from namespaces that no longer match it. Only the resources created by the operator are managed:
+// existing resources with the same names are left unchanged and reported with an event.
+type Reconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+	selector labels.Selector
+	config   *Config
+}
+
+// Reconciler implements reconcile.Reconciler interface.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// NewReconciler creates a new Reconciler instance onboarding the namespaces matching the given selector.
+func NewReconciler(client client.Client, recorder record.EventRecorder, selector labels.Selector, config *Config) *Reconciler {
+	return &Reconciler{
+		client:   client,
+		recorder: recorder,
+		selector: selector,
+		config:   config,
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	kind := "Namespace"
+	name := "spark-namespace"
+
+	// Use a custom log constructor.
+	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		Watches(
+			&corev1.Namespace{},
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(NewEventFilter(r.selector)),
+		).
+		WithOptions(options).
+		Complete(r)
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	namespace := &corev1.Namespace{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: req.Name}, namespace); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	// Resources of terminating namespaces are deleted along with them.
+	if !namespace.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if !r.selector.Matches(labels.Set(namespace.Labels)) {
+		if err := r.offboard(ctx, namespace.Name); err != nil {
+			logger.Error(err, "Failed to offboard namespace", "namespace", namespace.Name)
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.onboard(ctx, namespace.Name); err != nil {
+		logger.Error(err, "Failed to onboard namespace", "namespace", namespace.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// onboard creates or updates the resources needed to run Spark applications in the namespace.
+func (r *Reconciler) onboard(ctx context.Context, namespace string) error {
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: r.config.ServiceAccountName, Namespace: namespace}}
+	if err := r.createOrUpdate(ctx, serviceAccount, func() error { return nil }); err != nil {
+		return err
+	}
+
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: rbacName, Namespace: namespace}}
+	if err := r.createOrUpdate(ctx, role, func() error {
+		role.Rules = util.GetDriverPolicyRules()
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := r.syncRoleBinding(ctx, namespace, rbacName, rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName,
+		Kind:     "Role",
+		Name:     rbacName,
+	}); err != nil {
+		return err
+	}
+
+	if scc := r.config.SecurityContextConstraints; scc != "" {
+		if err := r.syncRoleBinding(ctx, namespace, sccRoleBindingName, rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     sccClusterRolePrefix + scc,
+		}); err != nil {
+			return err
+		}
+	} else if err := r.deleteIfManaged(ctx, &rbacv1.RoleBinding{}, namespace, sccRoleBindingName); err != nil {
+		return err
+	}
+
+	if r.config.ResourceQuota != nil {
+		quota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName, Namespace: namespace}}
+		if err := r.createOrUpdate(ctx, quota, func() error {
+			quota.Spec = *r.config.ResourceQuota.DeepCopy()
+			return nil
+		}); err != nil {
+			return err
+		}
+	} else if err := r.deleteIfManaged(ctx, &corev1.ResourceQuota{}, namespace, resourceQuotaName); err != nil {
+		return err
+	}
+
+	desired := make(map[string]bool, len(r.config.NetworkPolicies))
+	for _, p := range r.config.NetworkPolicies {
+		desired[p.Name] = true
+		policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: p.Name, Namespace: namespace}}
+		if err := r.createOrUpdate(ctx, policy, func() error {
+			policy.Spec = *p.Spec.DeepCopy()
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return r.deleteNetworkPolicies(ctx, namespace, desired)
+}
+
+// offboard deletes the resources created by the operator in the namespace.
+func (r *Reconciler) offboard(ctx context.Context, namespace string) error {
+	for _, resource := range []struct {
+		obj  client.Object
+		name string
+	}{
+		{&rbacv1.RoleBinding{}, sccRoleBindingName},
+		{&rbacv1.RoleBinding{}, rbacName},
+		{&rbacv1.Role{}, rbacName},
+		{&corev1.ServiceAccount{}, r.config.ServiceAccountName},
+		{&corev1.ResourceQuota{}, resourceQuotaName},
+	} {
+		if err := r.deleteIfManaged(ctx, resource.obj, namespace, resource.name); err != nil {
+			return err
+		}
+	}
+	return r.deleteNetworkPolicies(ctx, namespace, nil)
+}
+
+// syncRoleBinding creates or updates the RoleBinding of the service account, recreating it if its role changed
+// since the role of a RoleBinding is immutable.
+func (r *Reconciler) syncRoleBinding(ctx context.Context, namespace, name string, roleRef rbacv1.RoleRef) error {
+	existing := &rbacv1.RoleBinding{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, existing); err == nil {
+		if existing.Labels[common.LabelCreatedBySparkOperator] != "true" {
+			r.reportNotManaged(existing)
+			return nil
+		}
+		if !equality.Semantic.DeepEqual(existing.RoleRef, roleRef) {
+			if err := r.client.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete RoleBinding %s/%s: %v", namespace, name, err)
+			}
+		}
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get RoleBinding %s/%s: %v", namespace, name, err)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	return r.createOrUpdate(ctx, roleBinding, func() error {
+		roleBinding.Subjects = []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      r.config.ServiceAccountName,
+			Namespace: namespace,
+		}}
+		roleBinding.RoleRef = roleRef
+		return nil
+	})
+}
+
+// createOrUpdate creates the object labeled as created by the operator, or updates it if it already exists and
+// carries the label. Existing objects without the label are left unchanged, so that they are never adopted and then
+// deleted when offboarding the namespace.
+func (r *Reconciler) createOrUpdate(ctx context.Context, obj client.Object, mutate func() error) error {
+	result, err := controllerutil.CreateOrUpdate(ctx, r.client, obj, func() error {
+		if obj.GetResourceVersion() != "" && obj.GetLabels()[common.LabelCreatedBySparkOperator] != "true" {
+			return errNotManaged
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[common.LabelCreatedBySparkOperator] = "true"
+		obj.SetLabels(labels)
+		return mutate()
+	})
+	if stderrors.Is(err, errNotManaged) {
+		r.reportNotManaged(obj)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create or update %T %s/%s: %v", obj, obj.GetNamespace(), obj.GetName(), err)
+	}
+	if result != controllerutil.OperationResultNone {
+		logger.Info("Onboarding resource "+string(result), "kind", fmt.Sprintf("%T", obj), "namespace", obj.GetNamespace(), "name", obj.GetName())
+	}
+	return nil
+}
+
+// reportNotManaged reports that the given existing object was not created by the operator and is left unchanged.
+func (r *Reconciler) reportNotManaged(obj client.Object) {
+	kind := fmt.Sprintf("%T", obj)
+	if gvk, err := apiutil.GVKForObject(obj, r.client.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	logger.Info("Skipping onboarding resource not created by the operator", "kind", kind, "namespace", obj.GetNamespace(), "name", obj.GetName())
+	r.recorder.Eventf(obj, corev1.EventTypeWarning, common.EventNamespaceResourceNotManaged,
+		"%s %s/%s was not created by the Spark operator and is not managed by it", kind, obj.GetNamespace(), obj.GetName())
+}
+
+// deleteIfManaged deletes the object with the given name if it was created by the operator.
+func (r *Reconciler) deleteIfManaged(ctx context.Context, obj client.Object, namespace, name string) error {
+	if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get %T %s/%s: %v", obj, namespace, name, err)
+	}
+	if obj.GetLabels()[common.LabelCreatedBySparkOperator] != "true" {
+		return nil
+	}
+	if err := r.client.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %T %s/%s: %v", obj, namespace, name, err)
+	}
+	logger.Info("Deleted onboarding resource", "kind", fmt.Sprintf("%T", obj), "namespace", namespace, "name", name)
+	return nil
+}
+
+// deleteNetworkPolicies deletes the NetworkPolicies created by the operator in the namespace that are not desired.
+func (r *Reconciler) deleteNetworkPolicies(ctx context.Context, namespace string, desired map[string]bool) error {
+	policies := &networkingv1.NetworkPolicyList{}
+	if err := r.client.List(
+		ctx,
+		policies,
+		client.InNamespace(namespace),
+		client.MatchingLabels{common.LabelCreatedBySparkOperator: "true"},
+	); err != nil {
+		return fmt.Errorf("failed to list NetworkPolicies in namespace %s: %v", namespace, err)
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if desired[policy.Name] {
+			continue
+		}
+		if err := r.client.Delete(ctx, policy); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete NetworkPolicy %s/%s: %v", namespace, policy.Name, err)
+		}
+		logger.Info("Deleted onboarding NetworkPolicy", "namespace", namespace, "name", policy.Name)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, rbacv1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	return scheme
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newScheme(t)
+	selector, err := labels.Parse("spark=enabled")
+	require.NoError(t, err)
+
+	config := &Config{
+		ServiceAccountName: "spark",
+		ResourceQuota: &corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("100")},
+		},
+		NetworkPolicies: []NetworkPolicy{{
+			Name: "deny-ingress",
+			Spec: networkingv1.NetworkPolicySpec{PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}},
+		}},
+		SecurityContextConstraints: "nonroot",
+	}
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Labels: map[string]string{"spark": "enabled"}},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: namespace.Name}}
+	// A NetworkPolicy previously created by the operator that is no longer configured.
+	stalePolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "stale",
+			Namespace: namespace.Name,
+			Labels:    map[string]string{common.LabelCreatedBySparkOperator: "true"},
+		},
+	}
+	// A NetworkPolicy created by the tenant.
+	userPolicy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "user", Namespace: namespace.Name},
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace, stalePolicy, userPolicy).Build()
+	reconciler := NewReconciler(c, record.NewFakeRecorder(10), selector, config)
+
+	_, err = reconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+
+	serviceAccount := &corev1.ServiceAccount{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "spark", Namespace: namespace.Name}, serviceAccount))
+	assert.Equal(t, "true", serviceAccount.Labels[common.LabelCreatedBySparkOperator])
+
+	role := &rbacv1.Role{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: rbacName, Namespace: namespace.Name}, role))
+	assert.Equal(t, util.GetDriverPolicyRules(), role.Rules)
+
+	roleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: rbacName, Namespace: namespace.Name}, roleBinding))
+	assert.Equal(t, rbacName, roleBinding.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "spark", Namespace: namespace.Name}}, roleBinding.Subjects)
+
+	sccRoleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: sccRoleBindingName, Namespace: namespace.Name}, sccRoleBinding))
+	assert.Equal(t, rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "system:openshift:scc:nonroot"}, sccRoleBinding.RoleRef)
+
+	quota := &corev1.ResourceQuota{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: resourceQuotaName, Namespace: namespace.Name}, quota))
+	assert.True(t, quota.Spec.Hard.Pods().Equal(resource.MustParse("100")))
+
+	policies := &networkingv1.NetworkPolicyList{}
+	require.NoError(t, c.List(ctx, policies, client.InNamespace(namespace.Name)))
+	var names []string
+	for _, policy := range policies.Items {
+		names = append(names, policy.Name)
+	}
+	assert.ElementsMatch(t, []string{"deny-ingress", "user"}, names)
+
+	// Changing the SCC recreates the RoleBinding as its role is immutable.
+	config.SecurityContextConstraints = "anyuid"
+	_, err = reconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: sccRoleBindingName, Namespace: namespace.Name}, sccRoleBinding))
+	assert.Equal(t, "system:openshift:scc:anyuid", sccRoleBinding.RoleRef.Name)
+
+	// Namespaces no longer matching the selector are offboarded.
+	namespace.Labels = nil
+	require.NoError(t, c.Update(ctx, namespace))
+	_, err = reconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+
+	for _, obj := range []client.Object{
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "spark"}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: rbacName}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: rbacName}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: sccRoleBindingName}},
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName}},
+		&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: "deny-ingress"}},
+	} {
+		err := c.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: namespace.Name}, obj)
+		assert.True(t, errors.IsNotFound(err), "%T %s should be deleted", obj, obj.GetName())
+	}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "user", Namespace: namespace.Name}, &networkingv1.NetworkPolicy{}))
+}
+
+func TestReconcileKeepsUnmanagedResources(t *testing.T) {
+	ctx := context.Background()
+	scheme := newScheme(t)
+	selector, err := labels.Parse("spark=enabled")
+	require.NoError(t, err)
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Labels: map[string]string{"spark": "enabled"}},
+	}
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: namespace.Name}}
+	// Resources created by the tenant with the names of the onboarding resources.
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "spark", Namespace: namespace.Name}}
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: rbacName, Namespace: namespace.Name},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+	}
+	quota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName, Namespace: namespace.Name}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace, serviceAccount, roleBinding, quota).Build()
+
+	recorder := record.NewFakeRecorder(10)
+	reconciler := NewReconciler(c, recorder, selector, &Config{
+		ServiceAccountName: "spark",
+		ResourceQuota: &corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("100")},
+		},
+	})
+	_, err = reconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+
+	// The existing resources are neither adopted nor modified, and are reported.
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(serviceAccount), serviceAccount))
+	assert.NotContains(t, serviceAccount.Labels, common.LabelCreatedBySparkOperator)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(roleBinding), roleBinding))
+	assert.NotContains(t, roleBinding.Labels, common.LabelCreatedBySparkOperator)
+	assert.Equal(t, "edit", roleBinding.RoleRef.Name)
+	require.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(quota), quota))
+	assert.NotContains(t, quota.Labels, common.LabelCreatedBySparkOperator)
+	assert.Empty(t, quota.Spec.Hard)
+	require.Len(t, recorder.Events, 3)
+	assert.Equal(t, "Warning NamespaceResourceNotManaged ServiceAccount tenant/spark was not created by the Spark operator and is not managed by it", <-recorder.Events)
+
+	// Resources that do not exist yet are created.
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: rbacName, Namespace: namespace.Name}, &rbacv1.Role{}))
+
+	// Offboarding only deletes the resources created by the operator.
+	namespace.Labels = nil
+	require.NoError(t, c.Update(ctx, namespace))
+	_, err = reconciler.Reconcile(ctx, request)
+	require.NoError(t, err)
+
+	for _, obj := range []client.Object{serviceAccount, roleBinding, quota} {
+		assert.NoError(t, c.Get(ctx, client.ObjectKeyFromObject(obj), obj), "%T %s should be kept", obj, obj.GetName())
+	}
+	err = c.Get(ctx, types.NamespacedName{Name: rbacName, Namespace: namespace.Name}, &rbacv1.Role{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestEventFilter(t *testing.T) {
+	selector, err := labels.Parse("spark=enabled")
+	require.NoError(t, err)
+	filter := NewEventFilter(selector)
+
+	matching := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: map[string]string{"spark": "enabled"}}}
+	other := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "b"}}
+
+	assert.True(t, filter.Create(event.CreateEvent{Object: matching}))
+	assert.False(t, filter.Create(event.CreateEvent{Object: other}))
+	assert.True(t, filter.Update(event.UpdateEvent{ObjectOld: matching, ObjectNew: other}))
+	assert.True(t, filter.Update(event.UpdateEvent{ObjectOld: other, ObjectNew: matching}))
+	assert.False(t, filter.Update(event.UpdateEvent{ObjectOld: other, ObjectNew: other}))
+	assert.False(t, filter.Delete(event.DeleteEvent{Object: matching}))
+}
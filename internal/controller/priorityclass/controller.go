@@ -0,0 +1,207 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorityclass
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var (
+	logger = ctrl.Log.WithName("")
+)
+
+// +kubebuilder:rbac:groups=scheduling.k8s.io,resources=priorityclasses,verbs=get;list;watch;create;update;delete
+
+// DefaultPriorityClasses returns the PriorityClasses managed by the operator.
+func DefaultPriorityClasses() []schedulingv1.PriorityClass {
+	return []schedulingv1.PriorityClass{
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: common.PriorityClassSparkCritical},
+			Value:       1000000,
+			Description: "Spark applications that must not be preempted by other Spark applications.",
+		},
+		{
+			ObjectMeta:  metav1.ObjectMeta{Name: common.PriorityClassSparkDefault},
+			Value:       10000,
+			Description: "Spark applications with default priority.",
+		},
+		{
+			ObjectMeta:       metav1.ObjectMeta{Name: common.PriorityClassSparkPreemptible},
+			Value:            100,
+			PreemptionPolicy: ptr.To(corev1.PreemptNever),
+			Description:      "Spark applications that may be preempted and never preempt other pods.",
+		},
+	}
+}
+
+// Reconciler creates the PriorityClasses managed by the operator and reverts any drift from their desired state.
+// PriorityClasses with the same names that were not created by the operator are left untouched.
+type Reconciler struct {
+	client   client.Client
+	recorder record.EventRecorder
+	desired  map[string]schedulingv1.PriorityClass
+}
+
+// Reconciler implements reconcile.Reconciler interface.
+var _ reconcile.Reconciler = &Reconciler{}
+
+// NewReconciler creates a new Reconciler instance managing the given PriorityClasses.
+func NewReconciler(client client.Client, recorder record.EventRecorder, priorityClasses []schedulingv1.PriorityClass) *Reconciler {
+	desired := make(map[string]schedulingv1.PriorityClass, len(priorityClasses))
+	for _, pc := range priorityClasses {
+		desired[pc.Name] = pc
+	}
+	return &Reconciler{
+		client:   client,
+		recorder: recorder,
+		desired:  desired,
+	}
+}
+
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	kind := "PriorityClass"
+	name := "spark-priorityclass"
+
+	// Use a custom log constructor.
+	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		Watches(
+			&schedulingv1.PriorityClass{},
+			NewEventHandler(),
+			builder.WithPredicates(
+				NewEventFilter(slices.Collect(maps.Keys(r.desired))),
+			),
+		).
+		// Enqueue every managed PriorityClass on start, so that missing ones get created.
+		WatchesRawSource(source.Func(func(_ context.Context, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) error {
+			for name := range r.desired {
+				queue.Add(ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+			}
+			return nil
+		})).
+		WithOptions(options).
+		Complete(r)
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	desired, ok := r.desired[req.Name]
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+	if err := r.syncPriorityClass(ctx, &desired); err != nil {
+		logger.Error(err, "Failed to sync PriorityClass", "name", req.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) syncPriorityClass(ctx context.Context, desired *schedulingv1.PriorityClass) error {
+	desired = desired.DeepCopy()
+	if desired.Labels == nil {
+		desired.Labels = map[string]string{}
+	}
+	desired.Labels[common.LabelCreatedBySparkOperator] = "true"
+	if desired.PreemptionPolicy == nil {
+		desired.PreemptionPolicy = ptr.To(corev1.PreemptLowerPriority)
+	}
+
+	existing := &schedulingv1.PriorityClass{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get PriorityClass %s: %v", desired.Name, err)
+		}
+		if err := r.client.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create PriorityClass %s: %v", desired.Name, err)
+		}
+		logger.Info("Created PriorityClass", "name", desired.Name)
+		return nil
+	}
+
+	// Cluster-scoped PriorityClasses may belong to other workloads, so only those created by the operator are managed.
+	if existing.Labels[common.LabelCreatedBySparkOperator] != "true" {
+		logger.Info("Skipping PriorityClass not created by the operator", "name", desired.Name)
+		r.recorder.Eventf(existing, corev1.EventTypeWarning, common.EventPriorityClassConflict,
+			"PriorityClass %s was not created by the Spark operator and is not managed by it", desired.Name)
+		return nil
+	}
+
+	// Value and preemption policy are immutable, so the PriorityClass is only recreated if one of them drifted, which
+	// briefly leaves it missing for the pods created meanwhile. Other drifts are reverted in place.
+	if existing.Value != desired.Value || !equality.Semantic.DeepEqual(existing.PreemptionPolicy, desired.PreemptionPolicy) {
+		return r.recreatePriorityClass(ctx, existing, desired)
+	}
+
+	if existing.Description == desired.Description && existing.GlobalDefault == desired.GlobalDefault {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Description = desired.Description
+	updated.GlobalDefault = desired.GlobalDefault
+	if err := r.client.Update(ctx, updated); err != nil {
+		return fmt.Errorf("failed to update PriorityClass %s: %v", desired.Name, err)
+	}
+	logger.Info("Updated drifted PriorityClass", "name", desired.Name)
+	return nil
+}
+
+// recreatePriorityClass replaces the existing PriorityClass with the desired one, keeping the labels and annotations
+// of the existing one. Pods which are already admitted keep the priority they were assigned.
+func (r *Reconciler) recreatePriorityClass(ctx context.Context, existing, desired *schedulingv1.PriorityClass) error {
+	recreated := desired.DeepCopy()
+	recreated.Labels = maps.Clone(existing.Labels)
+	maps.Copy(recreated.Labels, desired.Labels)
+	recreated.Annotations = maps.Clone(existing.Annotations)
+
+	logger.Info("Recreating PriorityClass with drifted immutable fields", "name", desired.Name,
+		"value", existing.Value, "preemptionPolicy", ptr.Deref(existing.PreemptionPolicy, corev1.PreemptLowerPriority))
+	if err := r.client.Delete(ctx, existing, client.Preconditions{UID: &existing.UID}); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete PriorityClass %s: %v", desired.Name, err)
+	}
+	if err := r.client.Create(ctx, recreated); err != nil {
+		return fmt.Errorf("failed to create PriorityClass %s: %v", desired.Name, err)
+	}
+	r.recorder.Eventf(recreated, corev1.EventTypeWarning, common.EventPriorityClassRecreated,
+		"PriorityClass %s was recreated to revert its value from %d to %d and its preemption policy from %s to %s",
+		desired.Name, existing.Value, desired.Value,
+		ptr.Deref(existing.PreemptionPolicy, corev1.PreemptLowerPriority), *desired.PreemptionPolicy)
+	return nil
+}
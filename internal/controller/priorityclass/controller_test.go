@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorityclass
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, schedulingv1.AddToScheme(scheme))
+
+	key := types.NamespacedName{Name: common.PriorityClassSparkPreemptible}
+	request := ctrl.Request{NamespacedName: key}
+
+	testCases := []struct {
+		name      string
+		existing  *schedulingv1.PriorityClass
+		recreated bool
+	}{
+		{
+			name: "missing priority class is created",
+		},
+		{
+			name: "drifted description is updated",
+			existing: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   key.Name,
+					Labels: map[string]string{"team": "data", common.LabelCreatedBySparkOperator: "true"},
+				},
+				Value:            100,
+				PreemptionPolicy: ptr.To(corev1.PreemptNever),
+				Description:      "changed",
+			},
+		},
+		{
+			name: "drifted value is recreated",
+			existing: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   key.Name,
+					Labels: map[string]string{"team": "data", common.LabelCreatedBySparkOperator: "true"},
+				},
+				Value:            5,
+				PreemptionPolicy: ptr.To(corev1.PreemptNever),
+			},
+			recreated: true,
+		},
+		{
+			name: "drifted preemption policy is recreated",
+			existing: &schedulingv1.PriorityClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   key.Name,
+					Labels: map[string]string{"team": "data", common.LabelCreatedBySparkOperator: "true"},
+				},
+				Value:            100,
+				PreemptionPolicy: ptr.To(corev1.PreemptLowerPriority),
+			},
+			recreated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.existing != nil {
+				builder = builder.WithObjects(tc.existing)
+			}
+			client := builder.Build()
+			recorder := record.NewFakeRecorder(1)
+			reconciler := NewReconciler(client, recorder, DefaultPriorityClasses())
+
+			_, err := reconciler.Reconcile(ctx, request)
+			require.NoError(t, err)
+
+			pc := &schedulingv1.PriorityClass{}
+			require.NoError(t, client.Get(ctx, key, pc))
+			assert.Equal(t, int32(100), pc.Value)
+			assert.Equal(t, ptr.To(corev1.PreemptNever), pc.PreemptionPolicy)
+			assert.Equal(t, "Spark applications that may be preempted and never preempt other pods.", pc.Description)
+			assert.Equal(t, "true", pc.Labels[common.LabelCreatedBySparkOperator])
+			if tc.existing != nil {
+				assert.Equal(t, "data", pc.Labels["team"])
+			}
+			if tc.recreated {
+				assert.Contains(t, <-recorder.Events, common.EventPriorityClassRecreated)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
+		})
+	}
+}
+
+func TestReconcileIgnoresUnmanagedPriorityClasses(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, schedulingv1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := NewReconciler(c, record.NewFakeRecorder(1), DefaultPriorityClasses())
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "system-cluster-critical"}})
+	require.NoError(t, err)
+
+	list := &schedulingv1.PriorityClassList{}
+	require.NoError(t, c.List(ctx, list, client.HasLabels{common.LabelCreatedBySparkOperator}))
+	assert.Empty(t, list.Items)
+}
+
+func TestReconcileLeavesPriorityClassesNotCreatedByTheOperator(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, schedulingv1.AddToScheme(scheme))
+
+	existing := &schedulingv1.PriorityClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: common.PriorityClassSparkDefault},
+		Value:       42,
+		Description: "owned by another workload",
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+	recorder := record.NewFakeRecorder(1)
+	reconciler := NewReconciler(c, recorder, DefaultPriorityClasses())
+
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: existing.Name}})
+	require.NoError(t, err)
+
+	pc := &schedulingv1.PriorityClass{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: existing.Name}, pc))
+	assert.Equal(t, int32(42), pc.Value)
+	assert.Equal(t, "owned by another workload", pc.Description)
+	assert.Empty(t, pc.Labels[common.LabelCreatedBySparkOperator])
+	assert.Contains(t, <-recorder.Events, common.EventPriorityClassConflict)
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priorityclass
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+)
+
+// EventHandler handles PriorityClass events.
+type EventHandler struct{}
+
+var _ handler.EventHandler = &EventHandler{}
+
+// NewEventHandler creates a new EventHandler instance.
+func NewEventHandler() *EventHandler {
+	return &EventHandler{}
+}
+
+// Create implements handler.EventHandler.
+func (h *EventHandler) Create(ctx context.Context, event event.CreateEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	enqueue(event.Object, queue)
+}
+
+// Update implements handler.EventHandler.
+func (h *EventHandler) Update(ctx context.Context, event event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	if event.ObjectNew.GetResourceVersion() == event.ObjectOld.GetResourceVersion() {
+		return
+	}
+	logger.Info("PriorityClass updated", "name", event.ObjectNew.GetName())
+	enqueue(event.ObjectNew, queue)
+}
+
+// Delete implements handler.EventHandler.
+func (h *EventHandler) Delete(ctx context.Context, event event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	logger.Info("PriorityClass deleted", "name", event.Object.GetName())
+	enqueue(event.Object, queue)
+}
+
+// Generic implements handler.EventHandler.
+func (h *EventHandler) Generic(ctx context.Context, event event.GenericEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	enqueue(event.Object, queue)
+}
+
+func enqueue(obj client.Object, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	queue.AddRateLimited(ctrl.Request{NamespacedName: types.NamespacedName{Name: obj.GetName()}})
+}
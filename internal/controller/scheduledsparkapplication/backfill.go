@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// maxBackfillRuns is the maximum number of runs scheduled in the window of a backfill.
+const maxBackfillRuns = 10000
+
+// isBackfillRun tells whether the given SparkApplication is a backfill run of a ScheduledSparkApplication.
+func isBackfillRun(app *v1beta2.SparkApplication) bool {
+	return app.Labels[common.LabelBackfill] == "true"
+}
+
+// getFirstBackfillTime returns the first time of the given schedule at or after the start of the backfill window.
+func getFirstBackfillTime(backfill *v1beta2.ScheduleBackfill, schedule cron.Schedule) time.Time {
+	return schedule.Next(backfill.StartTime.Add(-time.Nanosecond))
+}
+
+// countBackfillRuns returns the number of runs of the given schedule in the backfill window, up to maxBackfillRuns+1.
+func countBackfillRuns(backfill *v1beta2.ScheduleBackfill, schedule cron.Schedule) int {
+	count := 0
+	for t := getFirstBackfillTime(backfill, schedule); t.Before(backfill.EndTime.Time) && count <= maxBackfillRuns; t = schedule.Next(t) {
+		count++
+	}
+	return count
+}
+
+// validateBackfill returns an error if the backfill of the given ScheduledSparkApplication is not valid.
+func validateBackfill(scheduledApp *v1beta2.ScheduledSparkApplication, schedule cron.Schedule) error {
+	backfill := scheduledApp.Spec.Backfill
+	if backfill == nil {
+		return nil
+	}
+	if schedule == nil {
+		return fmt.Errorf("backfill requires a schedule")
+	}
+	if !backfill.EndTime.After(backfill.StartTime.Time) {
+		return fmt.Errorf("backfill end time must be after its start time")
+	}
+	if countBackfillRuns(backfill, schedule) > maxBackfillRuns {
+		return fmt.Errorf("backfill window must not contain more than %d runs", maxBackfillRuns)
+	}
+	return nil
+}
+
+// newBackfillStatus creates the status of a backfill that has not started any run yet.
+func newBackfillStatus(backfill *v1beta2.ScheduleBackfill, schedule cron.Schedule) *v1beta2.ScheduleBackfillStatus {
+	status := &v1beta2.ScheduleBackfillStatus{
+		StartTime: backfill.StartTime,
+		EndTime:   backfill.EndTime,
+		State:     v1beta2.BackfillStateRunning,
+		TotalRuns: int32(countBackfillRuns(backfill, schedule)),
+	}
+	if first := getFirstBackfillTime(backfill, schedule); first.Before(backfill.EndTime.Time) {
+		status.NextScheduledTime = metav1.NewTime(first)
+	}
+	return status
+}
+
+// countBackfillRun counts the outcome of a finished backfill run in the given backfill status.
+func countBackfillRun(status *v1beta2.ScheduleBackfillStatus, state v1beta2.ApplicationStateType) {
+	switch state {
+	case v1beta2.ApplicationStateCompleted:
+		status.SuccessfulRuns++
+	case v1beta2.ApplicationStateFailed:
+		status.FailedRuns++
+	}
+}
+
+// reconcileBackfill starts the runs of the backfill of the given ScheduledSparkApplication in the order of their
+// scheduled times, keeping at most its parallelism active, and completes the backfill once they all finished.
+// A new backfill starts whenever the window changes. Backfill runs are named after their scheduled time, so a run
+// is never started twice for the same time.
+func (r *Reconciler) reconcileBackfill(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication, schedule cron.Schedule) error {
+	backfill := scheduledApp.Spec.Backfill
+	if backfill == nil || schedule == nil {
+		return nil
+	}
+
+	changed := false
+	status := scheduledApp.Status.Backfill
+	if status == nil || !status.StartTime.Equal(&backfill.StartTime) || !status.EndTime.Equal(&backfill.EndTime) {
+		logger.Info("Starting backfill of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "startTime", backfill.StartTime, "endTime", backfill.EndTime)
+		status = newBackfillStatus(backfill, schedule)
+		scheduledApp.Status.Backfill = status
+		changed = true
+	}
+	if status.State == v1beta2.BackfillStateCompleted {
+		return nil
+	}
+
+	apps, err := r.listSparkApplications(scheduledApp)
+	if err != nil {
+		return err
+	}
+	active := 0
+	for _, app := range apps {
+		if isBackfillRun(app) && !isRunFinished(app) {
+			active++
+		}
+	}
+
+	parallelism := 1
+	if backfill.Parallelism != nil {
+		parallelism = int(*backfill.Parallelism)
+	}
+	for active < parallelism && !status.NextScheduledTime.IsZero() {
+		scheduledTime := status.NextScheduledTime.Time
+		app, err := newSparkApplication(scheduledApp, fmt.Sprintf("%s-backfill-%d", scheduledApp.Name, scheduledTime.Unix()), scheduledTime)
+		if err != nil {
+			return err
+		}
+		app.Labels[common.LabelBackfill] = "true"
+		if err := r.client.Create(ctx, app); err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to start backfill run: %v", err)
+		}
+
+		active++
+		status.StartedRuns++
+		if next := schedule.Next(scheduledTime); next.Before(backfill.EndTime.Time) {
+			status.NextScheduledTime = metav1.NewTime(next)
+		} else {
+			status.NextScheduledTime = metav1.Time{}
+		}
+		changed = true
+	}
+
+	if active == 0 && status.NextScheduledTime.IsZero() {
+		logger.Info("Completed backfill of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+		status.State = v1beta2.BackfillStateCompleted
+		status.CompletionTime = metav1.NewTime(r.clock.Now())
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return r.updateScheduledSparkApplicationStatus(ctx, scheduledApp)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestValidateBackfill(t *testing.T) {
+	hourly, err := cron.ParseStandard("CRON_TZ=UTC 0 * * * *")
+	require.NoError(t, err)
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		schedule cron.Schedule
+		backfill *v1beta2.ScheduleBackfill
+		err      string
+	}{
+		{
+			name:     "valid backfill",
+			schedule: hourly,
+			backfill: &v1beta2.ScheduleBackfill{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(start.Add(24 * time.Hour))},
+		},
+		{
+			name:     "backfill without schedule",
+			backfill: &v1beta2.ScheduleBackfill{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(start.Add(24 * time.Hour))},
+			err:      "backfill requires a schedule",
+		},
+		{
+			name:     "end time before start time",
+			schedule: hourly,
+			backfill: &v1beta2.ScheduleBackfill{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(start.Add(-time.Hour))},
+			err:      "backfill end time must be after its start time",
+		},
+		{
+			name:     "too many runs",
+			schedule: hourly,
+			backfill: &v1beta2.ScheduleBackfill{StartTime: metav1.NewTime(start), EndTime: metav1.NewTime(start.AddDate(2, 0, 0))},
+			err:      fmt.Sprintf("backfill window must not contain more than %d runs", maxBackfillRuns),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheduledApp := &v1beta2.ScheduledSparkApplication{Spec: v1beta2.ScheduledSparkApplicationSpec{Backfill: tc.backfill}}
+			err := validateBackfill(scheduledApp, tc.schedule)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestReconcileBackfill(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	now := time.Date(2026, 1, 1, 10, 0, 30, 0, time.UTC)
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	key := types.NamespacedName{Name: "test-scheduled-app", Namespace: "default"}
+	scheduledApp := &v1beta2.ScheduledSparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Spec: v1beta2.ScheduledSparkApplicationSpec{
+			Schedule:          "0 * * * *",
+			TimeZone:          "UTC",
+			ConcurrencyPolicy: v1beta2.ConcurrencyForbid,
+			Template:          v1beta2.SparkApplicationSpec{Arguments: []string{"--date={{ .ScheduledTime }}"}},
+			Backfill: &v1beta2.ScheduleBackfill{
+				StartTime:   metav1.NewTime(start.Add(-time.Minute)),
+				EndTime:     metav1.NewTime(start.Add(3 * time.Hour)),
+				Parallelism: ptr.To[int32](2),
+			},
+		},
+		Status: v1beta2.ScheduledSparkApplicationStatus{
+			ScheduleState: v1beta2.ScheduleStateScheduled,
+			NextRun:       metav1.NewTime(now.Add(30 * time.Minute)),
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(scheduledApp).
+		WithStatusSubresource(&v1beta2.ScheduledSparkApplication{}, &v1beta2.SparkApplication{}).
+		Build()
+	reconciler := NewReconciler(scheme, c, nil, clocktesting.NewFakeClock(now), Options{})
+
+	reconcile := func(t *testing.T) *v1beta2.ScheduleBackfillStatus {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		require.NoError(t, c.Get(ctx, key, scheduledApp))
+		require.NotNil(t, scheduledApp.Status.Backfill)
+		return scheduledApp.Status.Backfill
+	}
+	runName := func(hour int) string {
+		return fmt.Sprintf("%s-backfill-%d", key.Name, start.Add(time.Duration(hour)*time.Hour).Unix())
+	}
+	finishRun := func(t *testing.T, hour int, state v1beta2.ApplicationStateType) {
+		run := &v1beta2.SparkApplication{}
+		require.NoError(t, c.Get(ctx, types.NamespacedName{Name: runName(hour), Namespace: key.Namespace}, run))
+		run.Status.AppState.State = state
+		run.Status.TerminationTime = metav1.NewTime(now)
+		require.NoError(t, c.Status().Update(ctx, run))
+	}
+
+	status := reconcile(t)
+	assert.Equal(t, v1beta2.BackfillStateRunning, status.State)
+	assert.Equal(t, int32(3), status.TotalRuns)
+	assert.Equal(t, int32(2), status.StartedRuns)
+	assert.True(t, status.NextScheduledTime.Time.Equal(start.Add(2*time.Hour)))
+	run := &v1beta2.SparkApplication{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: runName(0), Namespace: key.Namespace}, run))
+	assert.Equal(t, "true", run.Labels[common.LabelBackfill])
+	assert.Equal(t, []string{"--date=2025-06-01T00:00:00Z"}, run.Spec.Arguments)
+
+	finishRun(t, 0, v1beta2.ApplicationStateCompleted)
+	status = reconcile(t)
+	assert.Equal(t, int32(3), status.StartedRuns)
+	assert.Equal(t, int32(1), status.SuccessfulRuns)
+	assert.True(t, status.NextScheduledTime.IsZero())
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: runName(2), Namespace: key.Namespace}, run))
+
+	finishRun(t, 1, v1beta2.ApplicationStateFailed)
+	finishRun(t, 2, v1beta2.ApplicationStateCompleted)
+	status = reconcile(t)
+	assert.Equal(t, v1beta2.BackfillStateCompleted, status.State)
+	assert.Equal(t, int32(2), status.SuccessfulRuns)
+	assert.Equal(t, int32(1), status.FailedRuns)
+	assert.True(t, status.CompletionTime.Time.Equal(now))
+
+	apps := &v1beta2.SparkApplicationList{}
+	require.NoError(t, c.List(ctx, apps, client.MatchingLabels{common.LabelScheduledSparkAppName: key.Name}))
+	for _, app := range apps.Items {
+		assert.Equal(t, "true", app.Labels[common.LabelBackfill], "unexpected regular run %s", app.Name)
+	}
+}
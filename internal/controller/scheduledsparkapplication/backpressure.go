@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// defaultBackpressureRetryInterval is the interval between retries of a run delayed because of the namespace load.
+const defaultBackpressureRetryInterval = time.Minute
+
+// countActiveSparkApplications returns the number of SparkApplications in the namespace of the given
+// ScheduledSparkApplication that are queued or running, i.e. neither terminated nor suspended.
+func (r *Reconciler) countActiveSparkApplications(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication) (int32, error) {
+	appList := &v1beta2.SparkApplicationList{}
+	if err := r.client.List(ctx, appList, client.InNamespace(scheduledApp.Namespace)); err != nil {
+		return 0, fmt.Errorf("failed to list SparkApplications: %v", err)
+	}
+
+	var count int32
+	for _, app := range appList.Items {
+		if util.IsTerminated(&app) || app.Status.AppState.State == v1beta2.ApplicationStateSuspended {
+			continue
+		}
+		count++
+	}
+	return count, nil
+}
+
+// checkBackpressure returns whether a due run of the given ScheduledSparkApplication must be held back because
+// its namespace already has too many active SparkApplications.
+func (r *Reconciler) checkBackpressure(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication) (bool, error) {
+	backpressure := scheduledApp.Spec.Backpressure
+	if backpressure == nil {
+		return false, nil
+	}
+
+	count, err := r.countActiveSparkApplications(ctx, scheduledApp)
+	if err != nil {
+		return false, err
+	}
+	if count < backpressure.MaxActiveApplications {
+		return false, nil
+	}
+
+	logger.Info("Namespace load is too high for next run of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace,
+		"activeApplications", count, "maxActiveApplications", backpressure.MaxActiveApplications)
+	return true, nil
+}
+
+// getBackpressureRetryTime returns the time at which a run held back because of the namespace load is retried,
+// or zero if the run is to be skipped.
+func getBackpressureRetryTime(scheduledApp *v1beta2.ScheduledSparkApplication, nextScheduledTime time.Time, now time.Time) time.Time {
+	backpressure := scheduledApp.Spec.Backpressure
+	if backpressure.Action != v1beta2.BackpressureActionDelay {
+		return time.Time{}
+	}
+
+	interval := defaultBackpressureRetryInterval
+	if backpressure.RetryInterval != nil && backpressure.RetryInterval.Duration > 0 {
+		interval = backpressure.RetryInterval.Duration
+	}
+	retryTime := now.Add(interval)
+	if !retryTime.Before(nextScheduledTime) {
+		return time.Time{}
+	}
+	return retryTime
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestReconcileBackpressure(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	now := time.Date(2026, 1, 1, 10, 0, 30, 0, time.UTC)
+	key := types.NamespacedName{Name: "test-scheduled-app", Namespace: "default"}
+	newScheduledApp := func(backpressure *v1beta2.ScheduleBackpressure) *v1beta2.ScheduledSparkApplication {
+		return &v1beta2.ScheduledSparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{
+				Schedule:          "*/5 * * * *",
+				TimeZone:          "UTC",
+				ConcurrencyPolicy: v1beta2.ConcurrencyAllow,
+				Backpressure:      backpressure,
+			},
+			Status: v1beta2.ScheduledSparkApplicationStatus{
+				ScheduleState: v1beta2.ScheduleStateScheduled,
+				NextRun:       metav1.NewTime(now.Add(-30 * time.Second)),
+			},
+		}
+	}
+	newApp := func(name string, state v1beta2.ApplicationStateType) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: key.Namespace},
+			Status:     v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+		}
+	}
+	reconcile := func(t *testing.T, objs ...client.Object) (client.Client, ctrl.Result, *v1beta2.ScheduledSparkApplication) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&v1beta2.ScheduledSparkApplication{}, &v1beta2.SparkApplication{}).
+			Build()
+		reconciler := NewReconciler(scheme, c, nil, clocktesting.NewFakeClock(now), Options{})
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		scheduledApp := &v1beta2.ScheduledSparkApplication{}
+		require.NoError(t, c.Get(ctx, key, scheduledApp))
+		return c, result, scheduledApp
+	}
+	countRuns := func(t *testing.T, c client.Client) int {
+		apps := &v1beta2.SparkApplicationList{}
+		require.NoError(t, c.List(ctx, apps, client.MatchingLabels{common.LabelScheduledSparkAppName: key.Name}))
+		return len(apps.Items)
+	}
+
+	t.Run("skip run when namespace is loaded", func(t *testing.T) {
+		c, result, scheduledApp := reconcile(t,
+			newScheduledApp(&v1beta2.ScheduleBackpressure{MaxActiveApplications: 2}),
+			newApp("running", v1beta2.ApplicationStateRunning),
+			newApp("queued", v1beta2.ApplicationStateSubmitted),
+			newApp("completed", v1beta2.ApplicationStateCompleted),
+		)
+
+		assert.Equal(t, 0, countRuns(t, c))
+		assert.Equal(t, v1beta2.ScheduleReasonSkippedDueToLoad, scheduledApp.Status.Reason)
+		assert.Equal(t, int32(1), scheduledApp.Status.SkippedRuns)
+		assert.True(t, scheduledApp.Status.LastSkippedRun.Time.Equal(now))
+		assert.True(t, scheduledApp.Status.NextRun.Time.Equal(time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC)))
+		assert.Equal(t, 4*time.Minute+30*time.Second, result.RequeueAfter)
+	})
+
+	t.Run("delay run when namespace is loaded", func(t *testing.T) {
+		c, result, scheduledApp := reconcile(t,
+			newScheduledApp(&v1beta2.ScheduleBackpressure{
+				MaxActiveApplications: 1,
+				Action:                v1beta2.BackpressureActionDelay,
+				RetryInterval:         &metav1.Duration{Duration: 2 * time.Minute},
+			}),
+			newApp("running", v1beta2.ApplicationStateRunning),
+		)
+
+		assert.Equal(t, 0, countRuns(t, c))
+		assert.Equal(t, v1beta2.ScheduleReasonSkippedDueToLoad, scheduledApp.Status.Reason)
+		assert.Equal(t, int32(0), scheduledApp.Status.SkippedRuns)
+		assert.True(t, scheduledApp.Status.NextRun.Time.Equal(now.Add(-30*time.Second)))
+		assert.Equal(t, 2*time.Minute, result.RequeueAfter)
+	})
+
+	t.Run("start run when namespace load is below the limit", func(t *testing.T) {
+		scheduledApp := newScheduledApp(&v1beta2.ScheduleBackpressure{MaxActiveApplications: 2})
+		scheduledApp.Status.Reason = v1beta2.ScheduleReasonSkippedDueToLoad
+		c, _, scheduledApp := reconcile(t,
+			scheduledApp,
+			newApp("running", v1beta2.ApplicationStateRunning),
+			newApp("suspended", v1beta2.ApplicationStateSuspended),
+		)
+
+		assert.Equal(t, 1, countRuns(t, c))
+		assert.Empty(t, scheduledApp.Status.Reason)
+		assert.NotEmpty(t, scheduledApp.Status.LastRunName)
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// updateConditions sets the Ready, Reconciling and Stalled conditions of the given ScheduledSparkApplication
+// from its schedule state, following the kstatus conventions.
+func updateConditions(scheduledApp *v1beta2.ScheduledSparkApplication) {
+	status := &scheduledApp.Status
+	switch status.ScheduleState {
+	case v1beta2.ScheduleStateScheduled:
+		setCondition(scheduledApp, v1beta2.ConditionReady, metav1.ConditionTrue, string(v1beta2.ScheduleStateScheduled), "")
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionReconciling)
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionStalled)
+	case v1beta2.ScheduleStateFailedValidation:
+		setCondition(scheduledApp, v1beta2.ConditionReady, metav1.ConditionFalse, string(v1beta2.ScheduleStateFailedValidation), status.Reason)
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionReconciling)
+		setCondition(scheduledApp, v1beta2.ConditionStalled, metav1.ConditionTrue, string(v1beta2.ScheduleStateFailedValidation), status.Reason)
+	default:
+		setCondition(scheduledApp, v1beta2.ConditionReady, metav1.ConditionFalse, v1beta2.ReasonProgressing, "")
+		setCondition(scheduledApp, v1beta2.ConditionReconciling, metav1.ConditionTrue, v1beta2.ReasonProgressing, "")
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionStalled)
+	}
+}
+
+func setCondition(scheduledApp *v1beta2.ScheduledSparkApplication, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&scheduledApp.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: scheduledApp.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
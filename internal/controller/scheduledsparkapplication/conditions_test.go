@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestUpdateConditions(t *testing.T) {
+	scheduledApp := &v1beta2.ScheduledSparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default", Generation: 3}}
+
+	updateConditions(scheduledApp)
+	assert.True(t, meta.IsStatusConditionFalse(scheduledApp.Status.Conditions, v1beta2.ConditionReady))
+	assert.True(t, meta.IsStatusConditionTrue(scheduledApp.Status.Conditions, v1beta2.ConditionReconciling))
+	assert.Nil(t, meta.FindStatusCondition(scheduledApp.Status.Conditions, v1beta2.ConditionStalled))
+
+	scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateScheduled
+	updateConditions(scheduledApp)
+	ready := meta.FindStatusCondition(scheduledApp.Status.Conditions, v1beta2.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, int64(3), ready.ObservedGeneration)
+	assert.Nil(t, meta.FindStatusCondition(scheduledApp.Status.Conditions, v1beta2.ConditionReconciling))
+
+	scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateFailedValidation
+	scheduledApp.Status.Reason = "invalid schedule"
+	updateConditions(scheduledApp)
+	stalled := meta.FindStatusCondition(scheduledApp.Status.Conditions, v1beta2.ConditionStalled)
+	require.NotNil(t, stalled)
+	assert.Equal(t, metav1.ConditionTrue, stalled.Status)
+	assert.Equal(t, "invalid schedule", stalled.Message)
+	assert.True(t, meta.IsStatusConditionFalse(scheduledApp.Status.Conditions, v1beta2.ConditionReady))
+}
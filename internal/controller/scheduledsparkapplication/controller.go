@@ -19,7 +19,9 @@ package scheduledsparkapplication
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -39,10 +41,17 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+	"github.com/kubeflow/spark-operator/v2/internal/runparams"
+	"github.com/kubeflow/spark-operator/v2/internal/sharding"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
+	"github.com/kubeflow/spark-operator/v2/internal/trigger"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
@@ -53,6 +62,14 @@ var (
 
 type Options struct {
 	Namespaces []string
+
+	// Shard restricts the controller to the namespaces owned by this operator instance. Nil disables sharding.
+	Shard *sharding.Shard
+
+	ScheduledSparkApplicationMetrics *metrics.ScheduledSparkApplicationMetrics
+
+	// EgressPolicy restricts the event sources of triggers. Nil only allows public addresses.
+	EgressPolicy *egress.Policy
 }
 
 // Reconciler reconciles a ScheduledSparkApplication object
@@ -62,6 +79,9 @@ type Reconciler struct {
 	recorder record.EventRecorder
 	clock    clock.Clock
 	options  Options
+
+	// triggerClient polls the event sources of triggers.
+	triggerClient *http.Client
 }
 
 var _ reconcile.Reconciler = &Reconciler{}
@@ -79,12 +99,15 @@ func NewReconciler(
 		recorder: recorder,
 		clock:    clock,
 		options:  options,
+
+		triggerClient: options.EgressPolicy.NewClient(trigger.RequestTimeout),
 	}
 }
 
 // +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=scheduledsparkapplications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=scheduledsparkapplications/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=scheduledsparkapplications/finalizers,verbs=update
+// +kubebuilder:rbac:groups=,resources=secrets,verbs=get
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -95,7 +118,13 @@ func NewReconciler(
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.18.2/pkg/reconcile
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	ctx, span := tracing.StartSpan(ctx, "ScheduledSparkApplication.Reconcile",
+		tracing.AttributeNamespace.String(req.Namespace),
+		tracing.AttributeName.String(req.Name),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	key := req.NamespacedName
 	oldScheduledApp, err := r.getScheduledSparkApplication(ctx, key)
 	if err != nil {
@@ -107,6 +136,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	scheduledApp := oldScheduledApp.DeepCopy()
 	logger.Info("Reconciling ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "state", scheduledApp.Status.ScheduleState)
 
+	if err := r.updateRunHistory(ctx, scheduledApp); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+
+	if err := r.reconcileManualRun(ctx, scheduledApp); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+
 	if scheduledApp.Spec.Suspend != nil && *scheduledApp.Spec.Suspend {
 		return ctrl.Result{}, nil
 	}
@@ -134,42 +171,91 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		cronSchedule = fmt.Sprintf("CRON_TZ=%s %s", timezone, cronSchedule)
 	}
 
-	schedule, parseErr := cron.ParseStandard(cronSchedule)
-	if parseErr != nil {
-		logger.Error(err, "Failed to parse schedule of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "schedule", scheduledApp.Spec.Schedule)
+	// A ScheduledSparkApplication without schedule is only run by its triggers.
+	var schedule cron.Schedule
+	if scheduledApp.Spec.Schedule != "" {
+		var parseErr error
+		schedule, parseErr = cron.ParseStandard(cronSchedule)
+		if parseErr != nil {
+			logger.Error(parseErr, "Failed to parse schedule of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "schedule", scheduledApp.Spec.Schedule)
+			scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateFailedValidation
+			scheduledApp.Status.Reason = parseErr.Error()
+			if updateErr := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); updateErr != nil {
+				return ctrl.Result{Requeue: true}, updateErr
+			}
+			return ctrl.Result{}, nil
+		}
+	}
+
+	if err := r.validateTriggers(scheduledApp); err != nil {
+		logger.Error(err, "Invalid triggers of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+		scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateFailedValidation
+		scheduledApp.Status.Reason = err.Error()
+		if updateErr := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); updateErr != nil {
+			return ctrl.Result{Requeue: true}, updateErr
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := runparams.Validate(&scheduledApp.Spec.Template); err != nil {
+		logger.Error(err, "Invalid run parameters in template of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
 		scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateFailedValidation
-		scheduledApp.Status.Reason = parseErr.Error()
+		scheduledApp.Status.Reason = err.Error()
 		if updateErr := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); updateErr != nil {
 			return ctrl.Result{Requeue: true}, updateErr
 		}
 		return ctrl.Result{}, nil
 	}
 
+	if err := validateBackfill(scheduledApp, schedule); err != nil {
+		logger.Error(err, "Invalid backfill of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+		scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateFailedValidation
+		scheduledApp.Status.Reason = err.Error()
+		if updateErr := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); updateErr != nil {
+			return ctrl.Result{Requeue: true}, updateErr
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.reconcileBackfill(ctx, scheduledApp, schedule); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+
 	switch scheduledApp.Status.ScheduleState {
 	case v1beta2.ScheduleStateNew:
 		now := r.clock.Now()
-		oldNextRunTime := scheduledApp.Status.NextRun.Time
-		nextRunTime := schedule.Next(now)
-		if oldNextRunTime.IsZero() || nextRunTime.Before(oldNextRunTime) {
-			scheduledApp.Status.NextRun = metav1.NewTime(nextRunTime)
+		var nextRunTime time.Time
+		if schedule != nil {
+			oldNextRunTime := scheduledApp.Status.NextRun.Time
+			nextRunTime = schedule.Next(now)
+			if oldNextRunTime.IsZero() || nextRunTime.Before(oldNextRunTime) {
+				scheduledApp.Status.NextRun = metav1.NewTime(nextRunTime)
+			}
 		}
 		scheduledApp.Status.ScheduleState = v1beta2.ScheduleStateScheduled
 		if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
 			return ctrl.Result{Requeue: true}, err
 		}
-		return ctrl.Result{RequeueAfter: nextRunTime.Sub(now)}, err
+		return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, nextRunTime, now)}, err
 	case v1beta2.ScheduleStateScheduled:
 		now := r.clock.Now()
+		if schedule == nil {
+			return r.reconcileTriggers(ctx, scheduledApp, time.Time{}, now)
+		}
+
 		nextRunTime := scheduledApp.Status.NextRun
 		if nextRunTime.IsZero() {
 			scheduledApp.Status.NextRun = metav1.NewTime(schedule.Next(now))
 			if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
 				return ctrl.Result{Requeue: true}, err
 			}
-			return ctrl.Result{RequeueAfter: schedule.Next(now).Sub(now)}, nil
+			return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, schedule.Next(now), now)}, nil
 		}
 
 		if nextRunTime.After(now) {
+			if len(scheduledApp.Spec.Triggers) > 0 {
+				return r.reconcileTriggers(ctx, scheduledApp, nextRunTime.Time, now)
+			}
 			return ctrl.Result{RequeueAfter: nextRunTime.Sub(now)}, nil
 		}
 
@@ -178,18 +264,44 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return ctrl.Result{Requeue: true}, err
 		}
 		if !ok {
-			return ctrl.Result{RequeueAfter: schedule.Next(now).Sub(now)}, nil
+			return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, schedule.Next(now), now)}, nil
+		}
+
+		overloaded, err := r.checkBackpressure(ctx, scheduledApp)
+		if err != nil {
+			return ctrl.Result{Requeue: true}, err
+		}
+		if overloaded {
+			scheduledApp.Status.Reason = v1beta2.ScheduleReasonSkippedDueToLoad
+			if retryTime := getBackpressureRetryTime(scheduledApp, schedule.Next(nextRunTime.Time), now); !retryTime.IsZero() {
+				if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
+					return ctrl.Result{Requeue: true}, err
+				}
+				return ctrl.Result{RequeueAfter: retryTime.Sub(now)}, nil
+			}
+
+			logger.Info("Skipping next run of ScheduledSparkApplication due to namespace load", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+			scheduledApp.Status.LastSkippedRun = metav1.NewTime(now)
+			scheduledApp.Status.SkippedRuns++
+			scheduledApp.Status.NextRun = metav1.NewTime(schedule.Next(now))
+			if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, schedule.Next(now), now)}, nil
 		}
 
 		logger.Info("Next run of ScheduledSparkApplication is due", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
-		app, err := r.startNextRun(scheduledApp, now)
+		app, err := r.startNextRun(scheduledApp, now, nextRunTime.Time)
 		if err != nil {
 			logger.Error(err, "Failed to start next run for ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
-			return ctrl.Result{RequeueAfter: schedule.Next(now).Sub(now)}, err
+			return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, schedule.Next(now), now)}, err
 		}
 
 		scheduledApp.Status.LastRun = metav1.NewTime(now)
 		scheduledApp.Status.LastRunName = app.Name
+		if scheduledApp.Status.Reason == v1beta2.ScheduleReasonSkippedDueToLoad {
+			scheduledApp.Status.Reason = ""
+		}
 		scheduledApp.Status.NextRun = metav1.NewTime(schedule.Next(now))
 		if err = r.checkAndUpdatePastRuns(ctx, scheduledApp); err != nil {
 			return ctrl.Result{Requeue: true}, err
@@ -197,7 +309,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
 			return ctrl.Result{Requeue: true}, err
 		}
-		return ctrl.Result{RequeueAfter: schedule.Next(now).Sub(now)}, nil
+		return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, schedule.Next(now), now)}, nil
 	case v1beta2.ScheduleStateFailedValidation:
 		return ctrl.Result{}, nil
 	}
@@ -220,6 +332,14 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 			NewEventHandler(),
 			builder.WithPredicates(
 				NewEventFilter(r.options.Namespaces),
+				r.options.Shard.Predicate(),
+			)).
+		Watches(
+			&v1beta2.SparkApplication{},
+			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1beta2.ScheduledSparkApplication{}),
+			builder.WithPredicates(
+				NewRunEventFilter(r.options.Namespaces),
+				r.options.Shard.Predicate(),
 			)).
 		WithOptions(options).
 		Complete(r)
@@ -236,6 +356,24 @@ func (r *Reconciler) getScheduledSparkApplication(ctx context.Context, key types
 func (r *Reconciler) createSparkApplication(
 	scheduledApp *v1beta2.ScheduledSparkApplication,
 	t time.Time,
+	scheduledTime time.Time,
+) (*v1beta2.SparkApplication, error) {
+	app, err := newSparkApplication(scheduledApp, fmt.Sprintf("%s-%d", scheduledApp.Name, t.UnixNano()), scheduledTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.client.Create(context.TODO(), app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// newSparkApplication creates the SparkApplication with the given name of a run of the given
+// ScheduledSparkApplication scheduled at the given time, with the run parameters of its template expanded.
+func newSparkApplication(
+	scheduledApp *v1beta2.ScheduledSparkApplication,
+	name string,
+	scheduledTime time.Time,
 ) (*v1beta2.SparkApplication, error) {
 	labels := map[string]string{
 		common.LabelScheduledSparkAppName: scheduledApp.Name,
@@ -245,7 +383,7 @@ func (r *Reconciler) createSparkApplication(
 	}
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-%d", scheduledApp.Name, t.UnixNano()),
+			Name:      name,
 			Namespace: scheduledApp.Namespace,
 			Labels:    labels,
 			OwnerReferences: []metav1.OwnerReference{{
@@ -258,18 +396,32 @@ func (r *Reconciler) createSparkApplication(
 		},
 		Spec: scheduledApp.Spec.Template,
 	}
-	if err := r.client.Create(context.TODO(), app); err != nil {
+	timezone := scheduledApp.Spec.TimeZone
+	if timezone == "" {
+		timezone = "Local"
+	}
+	if location, err := time.LoadLocation(timezone); err == nil {
+		scheduledTime = scheduledTime.In(location)
+	}
+	params := &runparams.Params{
+		ScheduledTime: runparams.Time{Time: scheduledTime},
+		RunID:         app.Name,
+		Name:          scheduledApp.Name,
+		Namespace:     scheduledApp.Namespace,
+	}
+	if err := runparams.Expand(&app.Spec, params); err != nil {
 		return nil, err
 	}
 	return app, nil
 }
 
-// shouldStartNextRun checks if the next run should be started.
+// shouldStartNextRun checks if the next run should be started. Backfill runs are not subject to the concurrency policy.
 func (r *Reconciler) shouldStartNextRun(scheduledApp *v1beta2.ScheduledSparkApplication) (bool, error) {
 	apps, err := r.listSparkApplications(scheduledApp)
 	if err != nil {
 		return false, err
 	}
+	apps = slices.DeleteFunc(apps, isBackfillRun)
 	if len(apps) == 0 {
 		return true, nil
 	}
@@ -291,8 +443,9 @@ func (r *Reconciler) shouldStartNextRun(scheduledApp *v1beta2.ScheduledSparkAppl
 	return false, nil
 }
 
-func (r *Reconciler) startNextRun(scheduledApp *v1beta2.ScheduledSparkApplication, now time.Time) (*v1beta2.SparkApplication, error) {
-	app, err := r.createSparkApplication(scheduledApp, now)
+// startNextRun starts a run of the given ScheduledSparkApplication that was scheduled at the given time.
+func (r *Reconciler) startNextRun(scheduledApp *v1beta2.ScheduledSparkApplication, now time.Time, scheduledTime time.Time) (*v1beta2.SparkApplication, error) {
+	app, err := r.createSparkApplication(scheduledApp, now, scheduledTime)
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +453,11 @@ func (r *Reconciler) startNextRun(scheduledApp *v1beta2.ScheduledSparkApplicatio
 }
 
 func (r *Reconciler) hasLastRunFinished(app *v1beta2.SparkApplication) bool {
+	return isRunFinished(app)
+}
+
+// isRunFinished tells whether the given run of a ScheduledSparkApplication has completed or failed.
+func isRunFinished(app *v1beta2.SparkApplication) bool {
 	return app.Status.AppState.State == v1beta2.ApplicationStateCompleted ||
 		app.Status.AppState.State == v1beta2.ApplicationStateFailed
 }
@@ -371,6 +529,8 @@ func (r *Reconciler) checkAndUpdatePastRuns(ctx context.Context, scheduledApp *v
 
 func (r *Reconciler) updateScheduledSparkApplicationStatus(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication) error {
 	// logger.Info("Updating SchedulingSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "status", scheduledApp.Status)
+	scheduledApp.Status.ObservedGeneration = scheduledApp.Generation
+	updateConditions(scheduledApp)
 	if err := r.client.Status().Update(ctx, scheduledApp); err != nil {
 		return fmt.Errorf("failed to update ScheduledSparkApplication status: %v", err)
 	}
@@ -22,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
 )
 
 // EventFilter filters out ScheduledSparkApplication events.
@@ -84,3 +85,52 @@ func (f *EventFilter) Generic(e event.GenericEvent) bool {
 func (f *EventFilter) filter(app *v1beta2.ScheduledSparkApplication) bool {
 	return f.namespaces[metav1.NamespaceAll] || f.namespaces[app.Namespace]
 }
+
+// RunEventFilter filters SparkApplication events down to the runs of ScheduledSparkApplications that just finished.
+type RunEventFilter struct {
+	filter *EventFilter
+}
+
+var _ predicate.Predicate = &RunEventFilter{}
+
+// NewRunEventFilter creates a new RunEventFilter instance.
+func NewRunEventFilter(namespaces []string) *RunEventFilter {
+	return &RunEventFilter{
+		filter: NewEventFilter(namespaces),
+	}
+}
+
+// Create implements predicate.Predicate.
+func (f *RunEventFilter) Create(_ event.CreateEvent) bool {
+	return false
+}
+
+// Update implements predicate.Predicate.
+func (f *RunEventFilter) Update(e event.UpdateEvent) bool {
+	oldApp, ok := e.ObjectOld.(*v1beta2.SparkApplication)
+	if !ok {
+		return false
+	}
+	newApp, ok := e.ObjectNew.(*v1beta2.SparkApplication)
+	if !ok {
+		return false
+	}
+	if !f.filter.namespaces[metav1.NamespaceAll] && !f.filter.namespaces[newApp.Namespace] {
+		return false
+	}
+	if newApp.Labels[common.LabelScheduledSparkAppName] == "" {
+		return false
+	}
+
+	return !isRunFinished(oldApp) && isRunFinished(newApp)
+}
+
+// Delete implements predicate.Predicate.
+func (f *RunEventFilter) Delete(_ event.DeleteEvent) bool {
+	return false
+}
+
+// Generic implements predicate.Predicate.
+func (f *RunEventFilter) Generic(_ event.GenericEvent) bool {
+	return false
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// isManualRunRequested tells whether a run of the given ScheduledSparkApplication was requested outside of its
+// schedule with the trigger-run annotation.
+func isManualRunRequested(scheduledApp *v1beta2.ScheduledSparkApplication) bool {
+	return scheduledApp.Annotations[common.AnnotationTriggerRun] == "true"
+}
+
+// reconcileManualRun starts the run requested with the trigger-run annotation, unless the concurrency policy
+// forbids it, and removes the annotation. The annotation is removed before the run is started so that a failure
+// can never start the run twice. Suspending the ScheduledSparkApplication does not prevent manual runs.
+func (r *Reconciler) reconcileManualRun(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication) error {
+	if !isManualRunRequested(scheduledApp) {
+		return nil
+	}
+
+	patch := client.MergeFrom(scheduledApp.DeepCopy())
+	delete(scheduledApp.Annotations, common.AnnotationTriggerRun)
+	if err := r.client.Patch(ctx, scheduledApp, patch); err != nil {
+		return fmt.Errorf("failed to remove annotation %s: %v", common.AnnotationTriggerRun, err)
+	}
+
+	ok, err := r.shouldStartNextRun(scheduledApp)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		logger.Info("Skipping manual run of ScheduledSparkApplication because of its concurrency policy", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "concurrencyPolicy", scheduledApp.Spec.ConcurrencyPolicy)
+		return nil
+	}
+
+	logger.Info("Starting manual run of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+	now := r.clock.Now()
+	app, err := r.startNextRun(scheduledApp, now, now)
+	if err != nil {
+		return fmt.Errorf("failed to start manual run: %v", err)
+	}
+
+	scheduledApp.Status.LastRun = metav1.NewTime(now)
+	scheduledApp.Status.LastRunName = app.Name
+	if err := r.checkAndUpdatePastRuns(ctx, scheduledApp); err != nil {
+		return err
+	}
+	return r.updateScheduledSparkApplicationStatus(ctx, scheduledApp)
+}
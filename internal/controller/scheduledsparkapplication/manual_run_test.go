@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestReconcileManualRun(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	now := time.Date(2026, 1, 1, 10, 0, 30, 0, time.UTC)
+	key := types.NamespacedName{Name: "test-scheduled-app", Namespace: "default"}
+	newScheduledApp := func(policy v1beta2.ConcurrencyPolicy) *v1beta2.ScheduledSparkApplication {
+		return &v1beta2.ScheduledSparkApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        key.Name,
+				Namespace:   key.Namespace,
+				Annotations: map[string]string{common.AnnotationTriggerRun: "true"},
+			},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{
+				Schedule:          "0 0 * * *",
+				TimeZone:          "UTC",
+				ConcurrencyPolicy: policy,
+			},
+			Status: v1beta2.ScheduledSparkApplicationStatus{
+				ScheduleState: v1beta2.ScheduleStateScheduled,
+				NextRun:       metav1.NewTime(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		}
+	}
+	newRun := func(state v1beta2.ApplicationStateType) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              key.Name + "-previous",
+				Namespace:         key.Namespace,
+				Labels:            map[string]string{common.LabelScheduledSparkAppName: key.Name},
+				CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+			},
+			Status: v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+		}
+	}
+	reconcile := func(t *testing.T, objs ...client.Object) (client.Client, *v1beta2.ScheduledSparkApplication) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&v1beta2.ScheduledSparkApplication{}, &v1beta2.SparkApplication{}).
+			Build()
+		reconciler := NewReconciler(scheme, c, nil, clocktesting.NewFakeClock(now), Options{})
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		scheduledApp := &v1beta2.ScheduledSparkApplication{}
+		require.NoError(t, c.Get(ctx, key, scheduledApp))
+		return c, scheduledApp
+	}
+	countRuns := func(t *testing.T, c client.Client) int {
+		apps := &v1beta2.SparkApplicationList{}
+		require.NoError(t, c.List(ctx, apps, client.MatchingLabels{common.LabelScheduledSparkAppName: key.Name}))
+		return len(apps.Items)
+	}
+
+	t.Run("start run outside of the schedule", func(t *testing.T) {
+		c, scheduledApp := reconcile(t, newScheduledApp(v1beta2.ConcurrencyAllow), newRun(v1beta2.ApplicationStateRunning))
+
+		assert.Equal(t, 2, countRuns(t, c))
+		assert.NotContains(t, scheduledApp.Annotations, common.AnnotationTriggerRun)
+		assert.True(t, scheduledApp.Status.LastRun.Time.Equal(now))
+		assert.NotEqual(t, key.Name+"-previous", scheduledApp.Status.LastRunName)
+		assert.True(t, scheduledApp.Status.NextRun.Time.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("skip run forbidden by the concurrency policy", func(t *testing.T) {
+		c, scheduledApp := reconcile(t, newScheduledApp(v1beta2.ConcurrencyForbid), newRun(v1beta2.ApplicationStateRunning))
+
+		assert.Equal(t, 1, countRuns(t, c))
+		assert.NotContains(t, scheduledApp.Annotations, common.AnnotationTriggerRun)
+		assert.Empty(t, scheduledApp.Status.LastRunName)
+	})
+
+	t.Run("start run of suspended application", func(t *testing.T) {
+		scheduledApp := newScheduledApp(v1beta2.ConcurrencyForbid)
+		scheduledApp.Spec.Suspend = ptr.To(true)
+		c, scheduledApp := reconcile(t, scheduledApp, newRun(v1beta2.ApplicationStateCompleted))
+
+		assert.Equal(t, 2, countRuns(t, c))
+		assert.NotContains(t, scheduledApp.Annotations, common.AnnotationTriggerRun)
+		assert.NotEmpty(t, scheduledApp.Status.LastRunName)
+	})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// defaultRunHistoryLimit is the number of finished runs kept in the run history if not specified.
+const defaultRunHistoryLimit = 10
+
+// updateRunHistory records the runs of the given ScheduledSparkApplication that finished since they were
+// last looked at, and updates its status if any was recorded.
+func (r *Reconciler) updateRunHistory(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication) error {
+	apps, err := r.listSparkApplications(scheduledApp)
+	if err != nil {
+		return err
+	}
+	if !r.recordRunHistory(scheduledApp, apps) {
+		return nil
+	}
+	return r.updateScheduledSparkApplicationStatus(ctx, scheduledApp)
+}
+
+// recordRunHistory adds the finished runs among the given SparkApplications that are not recorded yet to
+// the run history of the ScheduledSparkApplication, counts their outcomes and trims the history to its limit.
+// It returns whether the status changed.
+//
+// Runs are not deleted as soon as they leave the run history, so a run that finished no later than the
+// oldest record of a full history is assumed to have been recorded and trimmed already.
+func (r *Reconciler) recordRunHistory(scheduledApp *v1beta2.ScheduledSparkApplication, apps []*v1beta2.SparkApplication) bool {
+	limit := defaultRunHistoryLimit
+	if scheduledApp.Spec.RunHistoryLimit != nil {
+		limit = int(*scheduledApp.Spec.RunHistoryLimit)
+	}
+
+	history := scheduledApp.Status.RunHistory
+	recorded := make(map[string]bool, len(history))
+	for _, record := range history {
+		recorded[record.Name] = true
+	}
+	var oldestEndTime metav1.Time
+	if len(history) > 0 && len(history) >= limit {
+		oldestEndTime = history[len(history)-1].EndTime
+	}
+
+	var records []v1beta2.ScheduledRunRecord
+	for _, app := range apps {
+		if !isRunFinished(app) || recorded[app.Name] {
+			continue
+		}
+		record := newScheduledRunRecord(app, r.clock.Now())
+		if !oldestEndTime.IsZero() && !record.EndTime.After(oldestEndTime.Time) {
+			continue
+		}
+		records = append(records, record)
+		if isBackfillRun(app) && scheduledApp.Status.Backfill != nil {
+			countBackfillRun(scheduledApp.Status.Backfill, record.State)
+		}
+	}
+
+	for i := range records {
+		switch records[i].State {
+		case v1beta2.ApplicationStateCompleted:
+			scheduledApp.Status.SuccessfulRuns++
+		case v1beta2.ApplicationStateFailed:
+			scheduledApp.Status.FailedRuns++
+		}
+		if r.options.ScheduledSparkApplicationMetrics != nil {
+			r.options.ScheduledSparkApplicationMetrics.HandleRunRecord(scheduledApp, &records[i])
+		}
+	}
+
+	history = append(records, history...)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].EndTime.After(history[j].EndTime.Time)
+	})
+	if len(history) > limit {
+		history = history[:limit]
+	}
+	if len(records) == 0 && len(history) == len(scheduledApp.Status.RunHistory) {
+		return false
+	}
+	scheduledApp.Status.RunHistory = history
+	return true
+}
+
+// newScheduledRunRecord creates the run record of the given finished SparkApplication. The termination
+// time of runs that failed before being submitted may be missing, in which case now is used instead.
+func newScheduledRunRecord(app *v1beta2.SparkApplication, now time.Time) v1beta2.ScheduledRunRecord {
+	record := v1beta2.ScheduledRunRecord{
+		Name:      app.Name,
+		StartTime: app.CreationTimestamp,
+		EndTime:   app.Status.TerminationTime,
+		State:     app.Status.AppState.State,
+	}
+	if record.EndTime.IsZero() {
+		record.EndTime = metav1.NewTime(now)
+	}
+	if !record.StartTime.IsZero() && record.EndTime.After(record.StartTime.Time) {
+		record.Duration = metav1.Duration{Duration: record.EndTime.Sub(record.StartTime.Time)}
+	}
+	if record.State == v1beta2.ApplicationStateFailed {
+		record.FailureReason = app.Status.AppState.ErrorMessage
+	}
+	return record
+}
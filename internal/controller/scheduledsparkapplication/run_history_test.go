@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestRecordRunHistory(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	reconciler := NewReconciler(nil, nil, nil, clocktesting.NewFakeClock(now), Options{})
+	newRun := func(name string, state v1beta2.ApplicationStateType, endedMinutesAgo int) *v1beta2.SparkApplication {
+		endTime := now.Add(-time.Duration(endedMinutesAgo) * time.Minute)
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(endTime.Add(-5 * time.Minute)),
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:        v1beta2.ApplicationState{State: state, ErrorMessage: "driver " + string(state)},
+				TerminationTime: metav1.NewTime(endTime),
+			},
+		}
+	}
+
+	t.Run("record finished runs newest first", func(t *testing.T) {
+		scheduledApp := &v1beta2.ScheduledSparkApplication{}
+		changed := reconciler.recordRunHistory(scheduledApp, []*v1beta2.SparkApplication{
+			newRun("run-1", v1beta2.ApplicationStateCompleted, 30),
+			newRun("run-2", v1beta2.ApplicationStateFailed, 10),
+			newRun("run-3", v1beta2.ApplicationStateRunning, 0),
+		})
+
+		require.True(t, changed)
+		history := scheduledApp.Status.RunHistory
+		require.Len(t, history, 2)
+		assert.Equal(t, "run-2", history[0].Name)
+		assert.Equal(t, v1beta2.ApplicationStateFailed, history[0].State)
+		assert.Equal(t, "driver FAILED", history[0].FailureReason)
+		assert.Equal(t, 5*time.Minute, history[0].Duration.Duration)
+		assert.Equal(t, "run-1", history[1].Name)
+		assert.Empty(t, history[1].FailureReason)
+		assert.Equal(t, int32(1), scheduledApp.Status.SuccessfulRuns)
+		assert.Equal(t, int32(1), scheduledApp.Status.FailedRuns)
+
+		assert.False(t, reconciler.recordRunHistory(scheduledApp, []*v1beta2.SparkApplication{
+			newRun("run-1", v1beta2.ApplicationStateCompleted, 30),
+			newRun("run-2", v1beta2.ApplicationStateFailed, 10),
+		}))
+		assert.Equal(t, int32(1), scheduledApp.Status.SuccessfulRuns)
+	})
+
+	t.Run("trim history to its limit without counting trimmed runs again", func(t *testing.T) {
+		scheduledApp := &v1beta2.ScheduledSparkApplication{
+			Spec: v1beta2.ScheduledSparkApplicationSpec{RunHistoryLimit: ptr.To[int32](2)},
+		}
+		runs := []*v1beta2.SparkApplication{
+			newRun("run-1", v1beta2.ApplicationStateCompleted, 30),
+			newRun("run-2", v1beta2.ApplicationStateCompleted, 20),
+			newRun("run-3", v1beta2.ApplicationStateCompleted, 10),
+		}
+		require.True(t, reconciler.recordRunHistory(scheduledApp, runs))
+		require.Len(t, scheduledApp.Status.RunHistory, 2)
+		assert.Equal(t, "run-3", scheduledApp.Status.RunHistory[0].Name)
+		assert.Equal(t, "run-2", scheduledApp.Status.RunHistory[1].Name)
+		assert.Equal(t, int32(3), scheduledApp.Status.SuccessfulRuns)
+
+		assert.False(t, reconciler.recordRunHistory(scheduledApp, runs))
+		assert.Equal(t, int32(3), scheduledApp.Status.SuccessfulRuns)
+
+		runs = append(runs, newRun("run-4", v1beta2.ApplicationStateFailed, 0))
+		require.True(t, reconciler.recordRunHistory(scheduledApp, runs))
+		assert.Equal(t, "run-4", scheduledApp.Status.RunHistory[0].Name)
+		assert.Equal(t, "run-3", scheduledApp.Status.RunHistory[1].Name)
+		assert.Equal(t, int32(3), scheduledApp.Status.SuccessfulRuns)
+		assert.Equal(t, int32(1), scheduledApp.Status.FailedRuns)
+	})
+}
+
+func TestReconcileRecordsRunHistoryWhenSuspended(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	key := types.NamespacedName{Name: "test-scheduled-app", Namespace: "default"}
+	scheduledApp := &v1beta2.ScheduledSparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Spec: v1beta2.ScheduledSparkApplicationSpec{
+			Schedule: "*/5 * * * *",
+			Suspend:  ptr.To(true),
+		},
+	}
+	run := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name + "-1",
+			Namespace: key.Namespace,
+			Labels:    map[string]string{common.LabelScheduledSparkAppName: key.Name},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:        v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted},
+			TerminationTime: metav1.NewTime(now),
+		},
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(scheduledApp, run).
+		WithStatusSubresource(&v1beta2.ScheduledSparkApplication{}, &v1beta2.SparkApplication{}).
+		Build()
+
+	reconciler := NewReconciler(scheme, c, nil, clocktesting.NewFakeClock(now), Options{})
+	_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Get(ctx, key, scheduledApp))
+	require.Len(t, scheduledApp.Status.RunHistory, 1)
+	assert.Equal(t, run.Name, scheduledApp.Status.RunHistory[0].Name)
+	assert.Equal(t, int32(1), scheduledApp.Status.SuccessfulRuns)
+}
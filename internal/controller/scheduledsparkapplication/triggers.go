@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/trigger"
+)
+
+// defaultTriggerPollingInterval is the interval at which the event sources of triggers are polled.
+const defaultTriggerPollingInterval = 30 * time.Second
+
+func getTriggerPollingInterval(scheduledApp *v1beta2.ScheduledSparkApplication) time.Duration {
+	interval := scheduledApp.Spec.TriggerPollingInterval
+	if interval != nil && interval.Duration > 0 {
+		return interval.Duration
+	}
+	return defaultTriggerPollingInterval
+}
+
+// getNextTriggerPollTime returns the time at which the triggers of the given ScheduledSparkApplication are next
+// polled. Triggers that were never polled are due immediately.
+func getNextTriggerPollTime(scheduledApp *v1beta2.ScheduledSparkApplication) time.Time {
+	var lastPollTime time.Time
+	for _, t := range scheduledApp.Spec.Triggers {
+		status := findTriggerStatus(scheduledApp.Status.Triggers, t.Name)
+		if status == nil || status.LastPollTime.IsZero() {
+			return time.Time{}
+		}
+		if lastPollTime.IsZero() || status.LastPollTime.Time.Before(lastPollTime) {
+			lastPollTime = status.LastPollTime.Time
+		}
+	}
+	return lastPollTime.Add(getTriggerPollingInterval(scheduledApp))
+}
+
+// getRequeueDelay returns the delay until the given ScheduledSparkApplication must be reconciled again, which is the
+// earliest of its next scheduled run and its next trigger poll. A zero nextRunTime means there is no cron schedule.
+func getRequeueDelay(scheduledApp *v1beta2.ScheduledSparkApplication, nextRunTime time.Time, now time.Time) time.Duration {
+	next := nextRunTime
+	if len(scheduledApp.Spec.Triggers) > 0 {
+		nextPollTime := getNextTriggerPollTime(scheduledApp)
+		if nextPollTime.Before(now) {
+			nextPollTime = now
+		}
+		if next.IsZero() || nextPollTime.Before(next) {
+			next = nextPollTime
+		}
+	}
+	return next.Sub(now)
+}
+
+// validateTriggers returns an error if the triggers of the given ScheduledSparkApplication are not valid, including
+// if the egress policy does not allow polling their event sources, or if it has neither a schedule nor triggers to
+// start its runs.
+func (r *Reconciler) validateTriggers(scheduledApp *v1beta2.ScheduledSparkApplication) error {
+	if scheduledApp.Spec.Schedule == "" && len(scheduledApp.Spec.Triggers) == 0 {
+		return fmt.Errorf("either a schedule or triggers must be specified")
+	}
+	return trigger.Validate(scheduledApp.Spec.Triggers, r.options.EgressPolicy)
+}
+
+func findTriggerStatus(statuses []v1beta2.ScheduleTriggerStatus, name string) *v1beta2.ScheduleTriggerStatus {
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// pollTriggers polls the event sources of the triggers of the given ScheduledSparkApplication, records their
+// state in its status and returns the names of the triggers that fired.
+func (r *Reconciler) pollTriggers(ctx context.Context, scheduledApp *v1beta2.ScheduledSparkApplication, now time.Time) []string {
+	var fired []string
+	statuses := make([]v1beta2.ScheduleTriggerStatus, 0, len(scheduledApp.Spec.Triggers))
+	for i := range scheduledApp.Spec.Triggers {
+		t := &scheduledApp.Spec.Triggers[i]
+		status := v1beta2.ScheduleTriggerStatus{Name: t.Name, LastPollTime: metav1.NewTime(now)}
+		if oldStatus := findTriggerStatus(scheduledApp.Status.Triggers, t.Name); oldStatus != nil {
+			status.LastFireTime = oldStatus.LastFireTime
+		}
+
+		value, err := r.getTriggerMetric(ctx, scheduledApp.Namespace, t)
+		if err != nil {
+			logger.Error(err, "Failed to poll trigger of ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "trigger", t.Name)
+			status.Message = err.Error()
+		} else {
+			status.Value = strconv.FormatFloat(value, 'f', -1, 64)
+			status.Active = value >= trigger.GetThreshold(t)
+		}
+		if status.Active {
+			fired = append(fired, t.Name)
+		}
+		statuses = append(statuses, status)
+	}
+	scheduledApp.Status.Triggers = statuses
+	return fired
+}
+
+func (r *Reconciler) getTriggerMetric(ctx context.Context, namespace string, t *v1beta2.ScheduleTrigger) (float64, error) {
+	scaler, err := trigger.NewScaler(ctx, r.client, r.triggerClient, namespace, t)
+	if err != nil {
+		return 0, err
+	}
+	return scaler.GetMetric(ctx)
+}
+
+// reconcileTriggers polls the triggers of the given ScheduledSparkApplication when they are due and starts a run
+// if one of them fired. A run held back by the concurrency policy or the namespace load is retried at the next poll.
+func (r *Reconciler) reconcileTriggers(
+	ctx context.Context,
+	scheduledApp *v1beta2.ScheduledSparkApplication,
+	nextRunTime time.Time,
+	now time.Time,
+) (ctrl.Result, error) {
+	if getNextTriggerPollTime(scheduledApp).After(now) {
+		return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, nextRunTime, now)}, nil
+	}
+
+	if fired := r.pollTriggers(ctx, scheduledApp, now); len(fired) > 0 {
+		ok, err := r.shouldStartNextRun(scheduledApp)
+		if err != nil {
+			return ctrl.Result{Requeue: true}, err
+		}
+		if ok {
+			overloaded, err := r.checkBackpressure(ctx, scheduledApp)
+			if err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+			ok = !overloaded
+		}
+
+		if ok {
+			logger.Info("Trigger of ScheduledSparkApplication fired", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace, "triggers", fired)
+			app, err := r.startNextRun(scheduledApp, now, now)
+			if err != nil {
+				logger.Error(err, "Failed to start triggered run for ScheduledSparkApplication", "name", scheduledApp.Name, "namespace", scheduledApp.Namespace)
+				return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, nextRunTime, now)}, err
+			}
+
+			scheduledApp.Status.LastRun = metav1.NewTime(now)
+			scheduledApp.Status.LastRunName = app.Name
+			for i := range scheduledApp.Status.Triggers {
+				if slices.Contains(fired, scheduledApp.Status.Triggers[i].Name) {
+					scheduledApp.Status.Triggers[i].LastFireTime = metav1.NewTime(now)
+				}
+			}
+			if err := r.checkAndUpdatePastRuns(ctx, scheduledApp); err != nil {
+				return ctrl.Result{Requeue: true}, err
+			}
+		}
+	}
+
+	if err := r.updateScheduledSparkApplicationStatus(ctx, scheduledApp); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	return ctrl.Result{RequeueAfter: getRequeueDelay(scheduledApp, nextRunTime, now)}, nil
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scheduledsparkapplication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clocktesting "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestReconcileTriggers(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	value := "0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"` + value + `"]}]}}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 1, 1, 10, 0, 30, 0, time.UTC)
+	key := types.NamespacedName{Name: "test-scheduled-app", Namespace: "default"}
+	newScheduledApp := func(schedule string) *v1beta2.ScheduledSparkApplication {
+		return &v1beta2.ScheduledSparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{
+				Schedule:          schedule,
+				TimeZone:          "UTC",
+				ConcurrencyPolicy: v1beta2.ConcurrencyForbid,
+				Triggers: []v1beta2.ScheduleTrigger{{
+					Name:       "pending-files",
+					Prometheus: &v1beta2.PrometheusTrigger{ServerAddress: server.URL, Query: "sum(pending_files)"},
+				}},
+			},
+			Status: v1beta2.ScheduledSparkApplicationStatus{
+				ScheduleState: v1beta2.ScheduleStateScheduled,
+			},
+		}
+	}
+	// The event source is served on the loopback address, which has to be allowed by the egress policy.
+	policy := &egress.Policy{AllowedHosts: []string{"127.0.0.1"}}
+	reconcile := func(t *testing.T, objs ...client.Object) (client.Client, ctrl.Result, *v1beta2.ScheduledSparkApplication) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&v1beta2.ScheduledSparkApplication{}, &v1beta2.SparkApplication{}).
+			Build()
+		reconciler := NewReconciler(scheme, c, nil, clocktesting.NewFakeClock(now), Options{EgressPolicy: policy})
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		require.NoError(t, err)
+		scheduledApp := &v1beta2.ScheduledSparkApplication{}
+		require.NoError(t, c.Get(ctx, key, scheduledApp))
+		return c, result, scheduledApp
+	}
+	countRuns := func(t *testing.T, c client.Client) int {
+		apps := &v1beta2.SparkApplicationList{}
+		require.NoError(t, c.List(ctx, apps, client.MatchingLabels{common.LabelScheduledSparkAppName: key.Name}))
+		return len(apps.Items)
+	}
+
+	t.Run("inactive trigger", func(t *testing.T) {
+		value = "0"
+		c, result, scheduledApp := reconcile(t, newScheduledApp(""))
+
+		assert.Equal(t, 0, countRuns(t, c))
+		require.Len(t, scheduledApp.Status.Triggers, 1)
+		status := scheduledApp.Status.Triggers[0]
+		assert.Equal(t, "0", status.Value)
+		assert.False(t, status.Active)
+		assert.True(t, status.LastPollTime.Time.Equal(now))
+		assert.Equal(t, defaultTriggerPollingInterval, result.RequeueAfter)
+	})
+
+	t.Run("trigger fires", func(t *testing.T) {
+		value = "3"
+		c, result, scheduledApp := reconcile(t, newScheduledApp(""))
+
+		assert.Equal(t, 1, countRuns(t, c))
+		assert.NotEmpty(t, scheduledApp.Status.LastRunName)
+		require.Len(t, scheduledApp.Status.Triggers, 1)
+		status := scheduledApp.Status.Triggers[0]
+		assert.Equal(t, "3", status.Value)
+		assert.True(t, status.Active)
+		assert.True(t, status.LastFireTime.Time.Equal(now))
+		assert.Equal(t, defaultTriggerPollingInterval, result.RequeueAfter)
+	})
+
+	t.Run("trigger fires while last run is not finished", func(t *testing.T) {
+		value = "3"
+		running := &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "running",
+				Namespace: key.Namespace,
+				Labels:    map[string]string{common.LabelScheduledSparkAppName: key.Name},
+			},
+			Status: v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning}},
+		}
+		c, _, scheduledApp := reconcile(t, newScheduledApp(""), running)
+
+		assert.Equal(t, 1, countRuns(t, c))
+		require.Len(t, scheduledApp.Status.Triggers, 1)
+		assert.True(t, scheduledApp.Status.Triggers[0].Active)
+		assert.True(t, scheduledApp.Status.Triggers[0].LastFireTime.IsZero())
+	})
+
+	t.Run("triggers are not polled before the polling interval", func(t *testing.T) {
+		value = "3"
+		scheduledApp := newScheduledApp("*/5 * * * *")
+		scheduledApp.Status.NextRun = metav1.NewTime(time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC))
+		scheduledApp.Status.Triggers = []v1beta2.ScheduleTriggerStatus{{
+			Name:         "pending-files",
+			Value:        "0",
+			LastPollTime: metav1.NewTime(now.Add(-10 * time.Second)),
+		}}
+		c, result, scheduledApp := reconcile(t, scheduledApp)
+
+		assert.Equal(t, 0, countRuns(t, c))
+		assert.Equal(t, "0", scheduledApp.Status.Triggers[0].Value)
+		assert.Equal(t, 20*time.Second, result.RequeueAfter)
+	})
+
+	t.Run("next scheduled run is earlier than the next poll", func(t *testing.T) {
+		value = "0"
+		scheduledApp := newScheduledApp("*/5 * * * *")
+		scheduledApp.Spec.TriggerPollingInterval = &metav1.Duration{Duration: 10 * time.Minute}
+		scheduledApp.Status.NextRun = metav1.NewTime(time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC))
+		_, result, _ := reconcile(t, scheduledApp)
+
+		assert.Equal(t, 4*time.Minute+30*time.Second, result.RequeueAfter)
+	})
+
+	t.Run("neither schedule nor triggers", func(t *testing.T) {
+		scheduledApp := newScheduledApp("")
+		scheduledApp.Spec.Triggers = nil
+		_, _, scheduledApp = reconcile(t, scheduledApp)
+
+		assert.Equal(t, v1beta2.ScheduleStateFailedValidation, scheduledApp.Status.ScheduleState)
+		assert.Equal(t, "either a schedule or triggers must be specified", scheduledApp.Status.Reason)
+	})
+	t.Run("event source not allowed by the egress policy", func(t *testing.T) {
+		policy = &egress.Policy{AllowedHosts: []string{"prometheus.example.com"}}
+		defer func() { policy = &egress.Policy{AllowedHosts: []string{"127.0.0.1"}} }()
+		c, _, scheduledApp := reconcile(t, newScheduledApp(""))
+
+		assert.Equal(t, 0, countRuns(t, c))
+		assert.Equal(t, v1beta2.ScheduleStateFailedValidation, scheduledApp.Status.ScheduleState)
+		assert.Equal(t, "trigger pending-files: host 127.0.0.1 is not allowed by the egress policy", scheduledApp.Status.Reason)
+	})
+
+	t.Run("event source at a private address without allowed hosts", func(t *testing.T) {
+		value = "3"
+		policy = nil
+		defer func() { policy = &egress.Policy{AllowedHosts: []string{"127.0.0.1"}} }()
+		c, _, scheduledApp := reconcile(t, newScheduledApp(""))
+
+		assert.Equal(t, 0, countRuns(t, c))
+		require.Len(t, scheduledApp.Status.Triggers, 1)
+		assert.False(t, scheduledApp.Status.Triggers[0].Active)
+		assert.Contains(t, scheduledApp.Status.Triggers[0].Message, "address 127.0.0.1 is not public")
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// archiveSparkApplication archives the terminated application once per run and records the archive in its status.
+func (r *Reconciler) archiveSparkApplication(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if r.options.Archiver == nil || app.Status.ArchivePath != "" {
+		return nil
+	}
+
+	path, err := r.options.Archiver.Archive(ctx, app)
+	if err != nil {
+		r.recorder.Eventf(app, corev1.EventTypeWarning, common.EventSparkApplicationArchiveFailed, "Failed to archive SparkApplication: %v", err)
+		return err
+	}
+
+	log.FromContext(ctx).Info("Archived SparkApplication", "path", path)
+	app.Status.ArchivePath = path
+	r.recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkApplicationArchived, "SparkApplication archived to %s", path)
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/archive"
+)
+
+// fakeArchiveStore counts the objects written to it and fails writes if err is set.
+type fakeArchiveStore struct {
+	puts int
+	err  error
+}
+
+func (s *fakeArchiveStore) Put(_ context.Context, _ string, _ []byte, _ string) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.puts++
+	return nil
+}
+
+func (s *fakeArchiveStore) URL(key string) string {
+	return "gs://archive/" + key
+}
+
+func TestArchiveSparkApplication(t *testing.T) {
+	ctx := context.Background()
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID: "submission-1",
+			AppState:     v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted},
+		},
+	}
+	options := archive.Options{PathFormat: "{{$appNamespace}}/{{$appName}}-{{$submissionID}}"}
+
+	t.Run("archived once", func(t *testing.T) {
+		store := &fakeArchiveStore{}
+		recorder := record.NewFakeRecorder(3)
+		r := &Reconciler{
+			recorder: recorder,
+			options:  Options{Archiver: archive.NewArchiver(fake.NewSimpleClientset(), store, "", options)},
+		}
+
+		app := app.DeepCopy()
+		require.NoError(t, r.archiveSparkApplication(ctx, app))
+		assert.Equal(t, "gs://archive/default/test-app-submission-1", app.Status.ArchivePath)
+		assert.Equal(t, "Normal SparkApplicationArchived SparkApplication archived to gs://archive/default/test-app-submission-1", <-recorder.Events)
+		puts := store.puts
+
+		require.NoError(t, r.archiveSparkApplication(ctx, app))
+		assert.Equal(t, puts, store.puts)
+	})
+
+	t.Run("archive failed", func(t *testing.T) {
+		store := &fakeArchiveStore{err: fmt.Errorf("access denied")}
+		recorder := record.NewFakeRecorder(3)
+		r := &Reconciler{
+			recorder: recorder,
+			options:  Options{Archiver: archive.NewArchiver(fake.NewSimpleClientset(), store, "", options)},
+		}
+
+		app := app.DeepCopy()
+		assert.EqualError(t, r.archiveSparkApplication(ctx, app), "access denied")
+		assert.Empty(t, app.Status.ArchivePath)
+		assert.Equal(t, "Warning SparkApplicationArchiveFailed Failed to archive SparkApplication: access denied", <-recorder.Events)
+	})
+
+	t.Run("archival disabled", func(t *testing.T) {
+		r := &Reconciler{}
+		require.NoError(t, r.archiveSparkApplication(ctx, app.DeepCopy()))
+	})
+}
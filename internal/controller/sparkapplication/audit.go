@@ -0,0 +1,39 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/audit"
+)
+
+// recordAuditEntry records an action performed on the application in the audit log.
+func (r *Reconciler) recordAuditEntry(app *v1beta2.SparkApplication, action audit.Action, err error, details map[string]string) {
+	entry := audit.Entry{
+		Action: action,
+		Resource: audit.Resource{
+			APIVersion: v1beta2.SchemeGroupVersion.String(),
+			Kind:       "SparkApplication",
+			Namespace:  app.Namespace,
+			Name:       app.Name,
+		},
+		Application: app.Namespace + "/" + app.Name,
+		Details:     details,
+	}
+	entry.Outcome, entry.Error = audit.GetOutcome(err)
+	r.options.AuditLogger.Record(entry)
+}
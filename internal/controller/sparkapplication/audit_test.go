@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/audit"
+)
+
+func TestDeleteDriverPodAudit(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	logger, err := audit.NewLogger(audit.Options{Path: path, Actor: "spark-operator"})
+	require.NoError(t, err)
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "default"},
+		Status: v1beta2.SparkApplicationStatus{
+			DriverInfo: v1beta2.DriverInfo{PodName: "spark-pi-driver"},
+			AppState:   v1beta2.ApplicationState{State: v1beta2.ApplicationStateInvalidating},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "spark-pi-driver", Namespace: "default"}},
+	).Build()
+	r := &Reconciler{client: client, options: Options{AuditLogger: logger}}
+
+	require.NoError(t, r.deleteDriverPod(ctx, app))
+	// Deleting a driver pod that no longer exists kills nothing.
+	require.NoError(t, r.deleteDriverPod(ctx, app))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+
+	entry := audit.Entry{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, audit.ActionKill, entry.Action)
+	assert.Equal(t, audit.Resource{APIVersion: "sparkoperator.k8s.io/v1beta2", Kind: "SparkApplication", Namespace: "default", Name: "spark-pi"}, entry.Resource)
+	assert.Equal(t, audit.OutcomeSuccess, entry.Outcome)
+	assert.Equal(t, map[string]string{"driverPod": "spark-pi-driver", "state": "INVALIDATING"}, entry.Details)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// checkpointVolumeReleaseRequeueInterval is how often a pending rerun checks whether its checkpoint volume is released.
+const checkpointVolumeReleaseRequeueInterval = 5 * time.Second
+
+// validateCheckpointVolumeRelease returns if the PersistentVolumeClaim holding the streaming checkpoint of the
+// given SparkApplication is bound and no longer used by any pod of a previous run, so that it can be mounted
+// by the new driver. It returns true if the checkpoint is not stored on a PersistentVolumeClaim.
+func (r *Reconciler) validateCheckpointVolumeRelease(ctx context.Context, app *v1beta2.SparkApplication) bool {
+	volume := util.GetStreamingCheckpointVolume(app)
+	if volume == nil || volume.PersistentVolumeClaim == nil {
+		return true
+	}
+
+	logger := log.FromContext(ctx)
+	claimName := volume.PersistentVolumeClaim.ClaimName
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: claimName, Namespace: app.Namespace}, pvc); err != nil {
+		logger.Info("Failed to get checkpoint PersistentVolumeClaim", "claim", claimName, "error", err.Error())
+		return false
+	}
+	if pvc.DeletionTimestamp != nil || pvc.Status.Phase != corev1.ClaimBound {
+		logger.Info("Checkpoint PersistentVolumeClaim is not bound", "claim", claimName, "phase", pvc.Status.Phase)
+		return false
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.executorPodReader().List(
+		ctx,
+		pods,
+		client.InNamespace(app.Namespace),
+		client.MatchingLabels{common.LabelSparkAppName: app.Name},
+	); err != nil {
+		logger.Info("Failed to list pods of SparkApplication", "error", err.Error())
+		return false
+	}
+	for _, pod := range pods.Items {
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == claimName {
+				logger.Info("Checkpoint PersistentVolumeClaim is still used by pod", "claim", claimName, "pod", pod.Name)
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestStreamingCheckpointOption(t *testing.T) {
+	app := &v1beta2.SparkApplication{}
+	args, err := streamingCheckpointOption(app)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+
+	app.Spec.Streaming = &v1beta2.StreamingSpec{CheckpointLocation: ptr.To("s3a://bucket/checkpoints")}
+	args, err = streamingCheckpointOption(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--conf", "spark.sql.streaming.checkpointLocation=s3a://bucket/checkpoints"}, args)
+}
+
+func TestValidateCheckpointVolumeRelease(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	claimVolume := corev1.Volume{
+		Name: "checkpoints",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "checkpoints"},
+		},
+	}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Streaming: &v1beta2.StreamingSpec{CheckpointLocation: ptr.To("/checkpoints/query")},
+			Volumes:   []corev1.Volume{claimVolume},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					VolumeMounts: []corev1.VolumeMount{{Name: "checkpoints", MountPath: "/checkpoints"}},
+				},
+			},
+		},
+	}
+	newPVC := func(phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "checkpoints", Namespace: "default"},
+			Status:     corev1.PersistentVolumeClaimStatus{Phase: phase},
+		}
+	}
+	oldDriverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app-driver",
+			Namespace: "default",
+			Labels:    map[string]string{common.LabelSparkAppName: "test-app"},
+		},
+		Spec: corev1.PodSpec{Volumes: []corev1.Volume{claimVolume}},
+	}
+
+	testCases := []struct {
+		name    string
+		app     *v1beta2.SparkApplication
+		objects []client.Object
+		want    bool
+	}{
+		{
+			name: "remote checkpoint location",
+			app: func() *v1beta2.SparkApplication {
+				a := app.DeepCopy()
+				a.Spec.Streaming.CheckpointLocation = ptr.To("hdfs://namenode/checkpoints")
+				return a
+			}(),
+			want: true,
+		},
+		{
+			name: "claim not found",
+			app:  app,
+			want: false,
+		},
+		{
+			name:    "claim not bound",
+			app:     app,
+			objects: []client.Object{newPVC(corev1.ClaimPending)},
+			want:    false,
+		},
+		{
+			name:    "claim still used by previous driver",
+			app:     app,
+			objects: []client.Object{newPVC(corev1.ClaimBound), oldDriverPod},
+			want:    false,
+		},
+		{
+			name:    "claim released",
+			app:     app,
+			objects: []client.Object{newPVC(corev1.ClaimBound)},
+			want:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+			reconciler := &Reconciler{client: client}
+			assert.Equal(t, tc.want, reconciler.validateCheckpointVolumeRelease(ctx, tc.app))
+		})
+	}
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// clientModeDriverScript runs spark-submit in client mode in the driver container, so that the driver runs in the
+// container itself and executors connect to it at the IP address of the driver pod.
+const clientModeDriverScript = `exec "${SPARK_HOME:-/opt/spark}/bin/spark-submit" --conf ` + common.SparkDriverHost + `="$` + common.EnvSparkDriverBindAddress + `" "$@"`
+
+// DriverPodSubmitter submits a SparkApplication in client mode by creating its driver pod, instead of leaving the
+// driver pod to spark-submit in cluster mode.
+type DriverPodSubmitter struct {
+	client client.Client
+}
+
+// DriverPodSubmitter implements SparkApplicationSubmitter interface.
+var _ SparkApplicationSubmitter = &DriverPodSubmitter{}
+
+// NewDriverPodSubmitter creates a new DriverPodSubmitter.
+func NewDriverPodSubmitter(client client.Client) *DriverPodSubmitter {
+	return &DriverPodSubmitter{client: client}
+}
+
+// Submit implements SparkApplicationSubmitter interface.
+func (s *DriverPodSubmitter) Submit(ctx context.Context, app *v1beta2.SparkApplication) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "DriverPod.Submit")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	logger := log.FromContext(ctx)
+
+	pod, err := buildClientModeDriverPod(app)
+	if err != nil {
+		return fmt.Errorf("failed to build driver pod: %v", err)
+	}
+
+	logger.Info("Creating driver pod in client mode", "name", pod.Name)
+	span.SetAttributes(tracing.AttributePodName.String(pod.Name))
+	if err := s.client.Create(ctx, pod); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return fmt.Errorf("driver pod already exist")
+		}
+		return fmt.Errorf("failed to create driver pod: %v", err)
+	}
+	return nil
+}
+
+// buildClientModeSparkSubmitArgs builds the arguments of spark-submit running in client mode in the driver pod.
+// The driver pod is created by the operator, so the options configuring it are left out.
+func buildClientModeSparkSubmitArgs(app *v1beta2.SparkApplication) ([]string, error) {
+	optionFuncs := []sparkSubmitOptionFunc{
+		masterOption,
+		deployModeOption,
+		mainClassOption,
+		nameOption,
+		loadSparkDefaultsOption,
+		dependenciesOption,
+		namespaceOption,
+		imageOption,
+		pythonVersionOption,
+		memoryOverheadFactorOption,
+		sparkConfOption,
+		hadoopConfOption,
+		driverPodNameOption,
+		driverConfOption,
+		appIDOption,
+		executorConfOption,
+		executorEnvOption,
+		executorSecretOption,
+		executorVolumeMountsOption,
+		nodeSelectorOption,
+		dynamicAllocationOption,
+		executorDecommissionOption,
+		executorEphemeralPVCOption,
+		streamingCheckpointOption,
+		proxyUserOption,
+		mainApplicationFileOption,
+		applicationOption,
+	}
+
+	var args []string
+	for _, optionFunc := range optionFuncs {
+		option, err := optionFunc(app)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, option...)
+	}
+	return args, nil
+}
+
+// appIDOption sets the application ID, which the driver generates itself in client mode, to the one the driver
+// pod is labeled with.
+func appIDOption(app *v1beta2.SparkApplication) ([]string, error) {
+	if _, ok := app.Spec.SparkConf[common.SparkAppID]; ok {
+		return nil, nil
+	}
+	args := []string{
+		"--conf",
+		fmt.Sprintf("%s=%s", common.SparkAppID, getClientModeAppID(app)),
+	}
+	return args, nil
+}
+
+// getClientModeAppID returns the application ID of the given SparkApplication in client mode, which is derived
+// from the submission ID the same way Spark derives it from a random UUID in cluster mode.
+func getClientModeAppID(app *v1beta2.SparkApplication) string {
+	if id := app.Spec.SparkConf[common.SparkAppID]; id != "" {
+		return id
+	}
+	return "spark-" + strings.ReplaceAll(app.Status.SubmissionID, "-", "")
+}
+
+// buildClientModeDriverPod builds the driver pod of the given SparkApplication in client mode from the driver pod
+// template, with the customizations of the driver applied by the operator instead of the webhook.
+func buildClientModeDriverPod(app *v1beta2.SparkApplication) (*corev1.Pod, error) {
+	args, err := buildClientModeSparkSubmitArgs(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build spark-submit arguments: %v", err)
+	}
+	resources, err := webhook.GetDriverResources(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate driver resources: %v", err)
+	}
+
+	template := &corev1.PodTemplateSpec{}
+	if app.Spec.Driver.Template != nil {
+		template = app.Spec.Driver.Template.DeepCopy()
+	}
+
+	labels := util.GetPropagatedLabels(app, app.Spec.MetadataPropagation)
+	maps.Copy(labels, template.Labels)
+	maps.Copy(labels, app.Spec.Driver.Labels)
+	labels[common.LabelSparkAppName] = app.Name
+	labels[common.LabelSparkRole] = common.SparkRoleDriver
+	labels[common.LabelLaunchedBySparkOperator] = "true"
+	labels[common.LabelSubmissionID] = app.Status.SubmissionID
+	labels[common.LabelSparkApplicationSelector] = getClientModeAppID(app)
+	template.Labels = labels
+
+	annotations := util.GetPropagatedAnnotations(app, app.Spec.MetadataPropagation)
+	maps.Copy(annotations, template.Annotations)
+	maps.Copy(annotations, app.Spec.Driver.Annotations)
+	if len(annotations) > 0 {
+		template.Annotations = annotations
+	}
+
+	spec := &template.Spec
+	spec.RestartPolicy = corev1.RestartPolicyNever
+	if serviceAccount := util.GetServiceAccountName(app, app.Spec.Driver.ServiceAccount); serviceAccount != "" {
+		spec.ServiceAccountName = serviceAccount
+	}
+	for _, secret := range app.Spec.ImagePullSecrets {
+		spec.ImagePullSecrets = append(spec.ImagePullSecrets, corev1.LocalObjectReference{Name: secret})
+	}
+
+	i := findDriverContainer(spec)
+	if i < 0 {
+		spec.Containers = append([]corev1.Container{{Name: common.SparkDriverContainerName}}, spec.Containers...)
+		i = 0
+	}
+	container := &spec.Containers[i]
+	container.Image = ptr.Deref(app.Spec.Driver.Image, ptr.Deref(app.Spec.Image, ""))
+	if app.Spec.ImagePullPolicy != nil {
+		container.ImagePullPolicy = corev1.PullPolicy(*app.Spec.ImagePullPolicy)
+	}
+	container.Command = []string{"/bin/sh", "-c", clientModeDriverScript, "spark-submit"}
+	container.Args = make([]string, len(args))
+	for j, arg := range args {
+		// Kubernetes expands $(VAR) references in container arguments.
+		container.Args[j] = strings.ReplaceAll(arg, "$(", "$$(")
+	}
+	container.Resources = resources
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:      common.EnvSparkDriverBindAddress,
+		ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}},
+	})
+	container.Env = append(container.Env, getClientModeDriverEnv(app)...)
+
+	for _, secret := range app.Spec.Driver.Secrets {
+		// Vault secrets are added by the customizations below.
+		if secret.Type == v1beta2.SecretTypeVault {
+			continue
+		}
+		name := secret.Name + "-volume"
+		spec.Volumes = append(spec.Volumes, corev1.Volume{
+			Name:         name,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.Name}},
+		})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: name, MountPath: secret.Path})
+	}
+
+	// Local directories are left out by the customizations, as Spark mounts them itself in cluster mode.
+	localVolumes := util.GetLocalVolumes(app)
+	var localDirs []string
+	for _, volumeMount := range util.GetDriverLocalVolumeMounts(app) {
+		volume, ok := localVolumes[volumeMount.Name]
+		if !ok {
+			return nil, fmt.Errorf("volume %s not found", volumeMount.Name)
+		}
+		spec.Volumes = append(spec.Volumes, volume)
+		container.VolumeMounts = append(container.VolumeMounts, volumeMount)
+		localDirs = append(localDirs, volumeMount.MountPath)
+	}
+	if len(localDirs) > 0 {
+		container.Env = append(container.Env, corev1.EnvVar{Name: common.EnvSparkLocalDirs, Value: strings.Join(localDirs, ",")})
+	}
+
+	if err := webhook.MutateSparkPodTemplate(template, common.SparkRoleDriver, app); err != nil {
+		return nil, fmt.Errorf("failed to apply customizations to driver pod: %v", err)
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	pod.Name = util.GetDriverPodName(app)
+	pod.Namespace = app.Namespace
+	return pod, nil
+}
+
+// findDriverContainer returns the index of the driver container of the given driver pod spec, or -1 if it has none.
+func findDriverContainer(spec *corev1.PodSpec) int {
+	for i, container := range spec.Containers {
+		if container.Name == common.SparkDriverContainerName {
+			return i
+		}
+	}
+	return -1
+}
+
+// getClientModeDriverEnv returns the environment variables of the driver container in client mode, which Spark
+// sets from its configuration properties in cluster mode.
+func getClientModeDriverEnv(app *v1beta2.SparkApplication) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, key := range slices.Sorted(maps.Keys(app.Spec.Driver.EnvVars)) {
+		env = append(env, corev1.EnvVar{Name: key, Value: app.Spec.Driver.EnvVars[key]})
+	}
+	for _, key := range slices.Sorted(maps.Keys(app.Spec.Driver.EnvSecretKeyRefs)) {
+		ref := app.Spec.Driver.EnvSecretKeyRefs[key]
+		env = append(env, newSecretKeyRefEnvVar(key, ref.Name, ref.Key))
+	}
+	for _, ref := range app.Spec.Driver.EnvSecretRefs {
+		env = append(env, newSecretKeyRefEnvVar(util.GetEnvSecretRefEnvName(ref), ref.SecretName, ref.Key))
+	}
+	for _, secret := range app.Spec.Driver.Secrets {
+		switch secret.Type {
+		case v1beta2.SecretTypeGCPServiceAccount:
+			env = append(env, corev1.EnvVar{
+				Name:  common.EnvGoogleApplicationCredentials,
+				Value: filepath.Join(secret.Path, common.ServiceAccountJSONKeyFileName),
+			})
+		case v1beta2.SecretTypeHadoopDelegationToken:
+			env = append(env, corev1.EnvVar{
+				Name:  common.EnvHadoopTokenFileLocation,
+				Value: filepath.Join(secret.Path, common.HadoopDelegationTokenFileName),
+			})
+		}
+	}
+	return env
+}
+
+func newSecretKeyRefEnvVar(name, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: name,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newClientModeSparkApplication() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark-pi",
+			Namespace: "default",
+			UID:       "uid",
+			Labels:    map[string]string{"team": "data", common.KueueLabelPrefix + "queue-name": "queue"},
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Type:                v1beta2.SparkApplicationTypeScala,
+			SparkVersion:        "3.5.0",
+			Mode:                v1beta2.DeployModeClient,
+			Image:               ptr.To("spark:3.5.0"),
+			ImagePullSecrets:    []string{"registry"},
+			MainClass:           ptr.To("org.apache.spark.examples.SparkPi"),
+			MainApplicationFile: ptr.To("local:///opt/spark/examples/jars/spark-examples.jar"),
+			Arguments:           []string{"$(HOME)", "1000"},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Cores:          ptr.To[int32](1),
+					Memory:         ptr.To("1g"),
+					ServiceAccount: ptr.To("spark"),
+					Labels:         map[string]string{"role": "driver"},
+					Annotations:    map[string]string{"note": "client"},
+					EnvVars:        map[string]string{"FOO": "bar"},
+					Secrets:        []v1beta2.SecretInfo{{Name: "gcp", Path: "/mnt/gcp", Type: v1beta2.SecretTypeGCPServiceAccount}},
+				},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID: "0d4f2a9c-1b2e-4c3d-8e5f-6a7b8c9d0e1f",
+		},
+	}
+}
+
+func TestBuildClientModeDriverPod(t *testing.T) {
+	t.Setenv(common.EnvKubernetesServiceHost, "10.0.0.1")
+	t.Setenv(common.EnvKubernetesServicePort, "443")
+	app := newClientModeSparkApplication()
+
+	pod, err := buildClientModeDriverPod(app)
+	require.NoError(t, err)
+
+	assert.Equal(t, "spark-pi-driver", pod.Name)
+	assert.Equal(t, "default", pod.Namespace)
+	assert.Equal(t, map[string]string{
+		"team":                               "data",
+		"role":                               "driver",
+		common.LabelSparkAppName:             "spark-pi",
+		common.LabelSparkRole:                common.SparkRoleDriver,
+		common.LabelLaunchedBySparkOperator:  "true",
+		common.LabelSubmissionID:             app.Status.SubmissionID,
+		common.LabelSparkApplicationSelector: "spark-0d4f2a9c1b2e4c3d8e5f6a7b8c9d0e1f",
+	}, pod.Labels)
+	assert.Equal(t, map[string]string{"note": "client"}, pod.Annotations)
+	require.Len(t, pod.OwnerReferences, 1)
+	assert.Equal(t, "spark-pi", pod.OwnerReferences[0].Name)
+	assert.Equal(t, corev1.RestartPolicyNever, pod.Spec.RestartPolicy)
+	assert.Equal(t, "spark", pod.Spec.ServiceAccountName)
+	assert.Equal(t, []corev1.LocalObjectReference{{Name: "registry"}}, pod.Spec.ImagePullSecrets)
+	assert.Equal(t, []corev1.Volume{{
+		Name:         "gcp-volume",
+		VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "gcp"}},
+	}}, pod.Spec.Volumes)
+
+	require.Len(t, pod.Spec.Containers, 1)
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, common.SparkDriverContainerName, container.Name)
+	assert.Equal(t, "spark:3.5.0", container.Image)
+	assert.Equal(t, []string{"/bin/sh", "-c", clientModeDriverScript, "spark-submit"}, container.Command)
+	assert.Contains(t, container.Args, "client")
+	assert.Contains(t, container.Args, "spark.app.id=spark-0d4f2a9c1b2e4c3d8e5f6a7b8c9d0e1f")
+	assert.Contains(t, container.Args, "spark.kubernetes.driver.pod.name=spark-pi-driver")
+	assert.NotContains(t, container.Args, "spark.kubernetes.submission.waitAppCompletion=false")
+	assert.Equal(t, []string{"$$(HOME)", "1000"}, container.Args[len(container.Args)-2:])
+	assert.Equal(t, []corev1.VolumeMount{{Name: "gcp-volume", MountPath: "/mnt/gcp"}}, container.VolumeMounts)
+	assert.Equal(t, "1", container.Resources.Requests.Cpu().String())
+	assert.Equal(t, "1433Mi", container.Resources.Requests.Memory().String())
+	assert.Equal(t, "1433Mi", container.Resources.Limits.Memory().String())
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: common.EnvSparkDriverBindAddress, ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"}}},
+		{Name: "FOO", Value: "bar"},
+		{Name: common.EnvGoogleApplicationCredentials, Value: "/mnt/gcp/key.json"},
+	}, container.Env)
+}
+
+func TestBuildClientModeDriverPodFromTemplate(t *testing.T) {
+	t.Setenv(common.EnvKubernetesServiceHost, "10.0.0.1")
+	t.Setenv(common.EnvKubernetesServicePort, "443")
+	app := newClientModeSparkApplication()
+	app.Spec.SparkConf = map[string]string{common.SparkAppID: "spark-custom"}
+	app.Spec.Driver.Template = &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"template": "true"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "sidecar", Image: "busybox"}},
+		},
+	}
+
+	pod, err := buildClientModeDriverPod(app)
+	require.NoError(t, err)
+
+	assert.Equal(t, "true", pod.Labels["template"])
+	assert.Equal(t, "spark-custom", pod.Labels[common.LabelSparkApplicationSelector])
+	require.Len(t, pod.Spec.Containers, 2)
+	assert.Equal(t, common.SparkDriverContainerName, pod.Spec.Containers[0].Name)
+	assert.Equal(t, "sidecar", pod.Spec.Containers[1].Name)
+	assert.NotContains(t, pod.Spec.Containers[0].Args, "spark.app.id=spark-0d4f2a9c1b2e4c3d8e5f6a7b8c9d0e1f")
+	// The template of the application is left as is.
+	assert.Len(t, app.Spec.Driver.Template.Spec.Containers, 1)
+}
+
+func TestDriverPodSubmitterSubmit(t *testing.T) {
+	t.Setenv(common.EnvKubernetesServiceHost, "10.0.0.1")
+	t.Setenv(common.EnvKubernetesServicePort, "443")
+	ctx := context.TODO()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	app := newClientModeSparkApplication()
+	submitter := NewDriverPodSubmitter(c)
+
+	require.NoError(t, submitter.Submit(ctx, app))
+	pod := &corev1.Pod{}
+	require.NoError(t, c.Get(ctx, types.NamespacedName{Name: "spark-pi-driver", Namespace: "default"}, pod))
+	assert.Equal(t, common.SparkRoleDriver, pod.Labels[common.LabelSparkRole])
+
+	assert.EqualError(t, submitter.Submit(ctx, app), "driver pod already exist")
+}
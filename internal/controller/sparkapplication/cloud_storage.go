@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// configCloudStorage expands the cloud storage configuration of the given SparkApplication into the Hadoop
+// properties of the connectors and the Secrets their credentials are read from. Properties and environment
+// variables already set on the application are left untouched. The web identity token used to assume an IAM
+// role is projected into the pods by the webhook, as Spark cannot mount projected volumes.
+func configCloudStorage(app *v1beta2.SparkApplication) {
+	if app.Spec.HadoopConf == nil {
+		app.Spec.HadoopConf = make(map[string]string)
+	}
+	if storage := app.Spec.CloudStorage; storage != nil {
+		if storage.S3 != nil {
+			configS3Storage(app, storage.S3)
+		}
+		if storage.Azure != nil {
+			configAzureStorage(app, storage.Azure)
+		}
+	}
+	if util.UseGCS(app) {
+		configGCSStorage(app)
+	}
+	if util.UseAWSWebIdentity(app) {
+		util.SetIfNotExists(app.Spec.HadoopConf, common.HadoopS3ACredentialsProvider, getWebIdentityCredentialsProvider(app))
+	}
+	if identity := util.GetAzureWorkloadIdentity(app); identity != nil {
+		configAzureWorkloadIdentity(app, identity)
+	}
+}
+
+func configS3Storage(app *v1beta2.SparkApplication, s3 *v1beta2.S3StorageSpec) {
+	conf := app.Spec.HadoopConf
+	if s3.Endpoint != nil {
+		util.SetIfNotExists(conf, common.HadoopS3AEndpoint, *s3.Endpoint)
+	}
+	if s3.Region != nil {
+		util.SetIfNotExists(conf, common.HadoopS3AEndpointRegion, *s3.Region)
+	}
+	if s3.PathStyleAccess != nil {
+		util.SetIfNotExists(conf, common.HadoopS3APathStyleAccess, strconv.FormatBool(*s3.PathStyleAccess))
+	}
+	// Static credentials are read from the environment by the default credentials provider chain of S3A.
+	if s3.CredentialsSecret != nil {
+		for _, key := range []string{common.EnvAWSAccessKeyID, common.EnvAWSSecretAccessKey} {
+			addCloudStorageEnvSecretKeyRef(app, key, v1beta2.NameKey{Name: *s3.CredentialsSecret, Key: key})
+		}
+	}
+}
+
+// getWebIdentityCredentialsProvider returns the web identity credentials provider of the AWS SDK the S3A connector
+// of the Spark version of the given SparkApplication is built on.
+func getWebIdentityCredentialsProvider(app *v1beta2.SparkApplication) string {
+	if util.SparkVersionSupports(app.Spec.SparkVersion, util.SparkFeatureAWSSDKV2) {
+		return common.AWSWebIdentityCredentialsProviderV2
+	}
+	return common.AWSWebIdentityCredentialsProviderV1
+}
+
+// configGCSStorage configures the Cloud Storage connector for the cloud storage configuration or the GKE Workload
+// Identity of the given SparkApplication.
+func configGCSStorage(app *v1beta2.SparkApplication) {
+	conf := app.Spec.HadoopConf
+	util.SetIfNotExists(conf, common.HadoopGCSImpl, common.GCSFileSystem)
+	util.SetIfNotExists(conf, common.HadoopGCSAbstractFileSystemImpl, common.GCSAbstractFileSystem)
+	if projectID := util.GetGCPProjectID(app); projectID != "" {
+		util.SetIfNotExists(conf, common.HadoopGCSProjectID, projectID)
+	}
+	// The application default credentials are read from the key referred to by GOOGLE_APPLICATION_CREDENTIALS if
+	// a key is mounted, or from the metadata server as with GKE Workload Identity otherwise.
+	util.SetIfNotExists(conf, common.HadoopGCSAuthType, common.GCSAuthTypeApplicationDefault)
+	if app.Spec.CloudStorage == nil || app.Spec.CloudStorage.GCS == nil {
+		return
+	}
+	if gcs := app.Spec.CloudStorage.GCS; gcs.ServiceAccountKeySecret != nil {
+		addCloudStorageSecret(app, v1beta2.SecretInfo{
+			Name: *gcs.ServiceAccountKeySecret,
+			Path: common.GCSServiceAccountKeyMountPath,
+			Type: v1beta2.SecretTypeGCPServiceAccount,
+		})
+	}
+}
+
+func configAzureStorage(app *v1beta2.SparkApplication, azure *v1beta2.AzureStorageSpec) {
+	conf := app.Spec.HadoopConf
+	host := fmt.Sprintf(common.AzureStorageAccountHostTemplate, azure.StorageAccount)
+	if azure.AccountKeySecret != nil {
+		addCloudStorageEnvSecretKeyRef(app, common.EnvAzureStorageAccountKey, v1beta2.NameKey{Name: *azure.AccountKeySecret, Key: common.EnvAzureStorageAccountKey})
+		// Hadoop expands the environment variable when the property is read, so that the key is not part of the
+		// Spark configuration.
+		util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountKeyTemplate, host), fmt.Sprintf("${env.%s}", common.EnvAzureStorageAccountKey))
+		// The storage account must not fall back to the workload identity configured for every storage account.
+		if util.GetAzureWorkloadIdentity(app) != nil {
+			util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountAuthTypeTemplate, host), common.AzureAuthTypeSharedKey)
+		}
+		return
+	}
+	if identity := util.GetAzureWorkloadIdentity(app); identity != nil {
+		setAzureWorkloadIdentityConf(conf, identity, host)
+		return
+	}
+	util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountAuthTypeTemplate, host), common.AzureAuthTypeOAuth)
+	util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountOAuthProviderTypeTemplate, host), common.AzureMSITokenProvider)
+	if identity := azure.ManagedIdentity; identity != nil {
+		if identity.TenantID != nil {
+			util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountOAuthMSITenantTemplate, host), *identity.TenantID)
+		}
+		if identity.ClientID != nil {
+			util.SetIfNotExists(conf, fmt.Sprintf(common.HadoopAzureAccountOAuthClientIDTemplate, host), *identity.ClientID)
+		}
+	}
+}
+
+// configAzureWorkloadIdentity labels the driver and executors of the given SparkApplication for Microsoft Entra
+// Workload ID, and configures the ABFS connector to authenticate as the workload identity with every storage account.
+// The federated token is projected into the pods by the webhook.
+func configAzureWorkloadIdentity(app *v1beta2.SparkApplication, identity *v1beta2.AzureWorkloadIdentity) {
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		if spec.Labels == nil {
+			spec.Labels = make(map[string]string)
+		}
+		spec.Labels[common.LabelAzureWorkloadIdentityUse] = "true"
+	}
+	setAzureWorkloadIdentityConf(app.Spec.HadoopConf, identity, "")
+}
+
+// setAzureWorkloadIdentityConf sets the ABFS properties authenticating as the given workload identity with the
+// storage account of the given host, or with every storage account if no host is given.
+func setAzureWorkloadIdentityConf(conf map[string]string, identity *v1beta2.AzureWorkloadIdentity, host string) {
+	tokenFile := filepath.Join(common.AzureWorkloadIdentityTokenMountPath, common.AzureWorkloadIdentityTokenFileName)
+	util.SetIfNotExists(conf, azureAccountProperty(common.HadoopAzureAccountAuthTypeTemplate, host), common.AzureAuthTypeOAuth)
+	util.SetIfNotExists(conf, azureAccountProperty(common.HadoopAzureAccountOAuthProviderTypeTemplate, host), common.AzureWorkloadIdentityTokenProvider)
+	util.SetIfNotExists(conf, azureAccountProperty(common.HadoopAzureAccountOAuthMSITenantTemplate, host), identity.TenantID)
+	util.SetIfNotExists(conf, azureAccountProperty(common.HadoopAzureAccountOAuthClientIDTemplate, host), identity.ClientID)
+	util.SetIfNotExists(conf, azureAccountProperty(common.HadoopAzureAccountOAuthTokenFileTemplate, host), tokenFile)
+}
+
+// azureAccountProperty returns the ABFS property of the given template for the storage account of the given host,
+// or the property applying to every storage account without its own if no host is given.
+func azureAccountProperty(template, host string) string {
+	if host == "" {
+		return strings.TrimSuffix(template, ".%s")
+	}
+	return fmt.Sprintf(template, host)
+}
+
+// addCloudStorageEnvSecretKeyRef exposes the given Secret key as an environment variable of the driver and
+// executors, unless they already set it.
+func addCloudStorageEnvSecretKeyRef(app *v1beta2.SparkApplication, name string, ref v1beta2.NameKey) {
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		if spec.EnvSecretKeyRefs == nil {
+			spec.EnvSecretKeyRefs = make(map[string]v1beta2.NameKey)
+		}
+		if _, ok := spec.EnvSecretKeyRefs[name]; !ok {
+			spec.EnvSecretKeyRefs[name] = ref
+		}
+	}
+}
+
+// addCloudStorageSecret mounts the given Secret in the driver and executors, unless they already mount it.
+func addCloudStorageSecret(app *v1beta2.SparkApplication, secret v1beta2.SecretInfo) {
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		mounted := false
+		for _, s := range spec.Secrets {
+			if s.Name == secret.Name && s.Type != v1beta2.SecretTypeVault {
+				mounted = true
+				break
+			}
+		}
+		if !mounted {
+			spec.Secrets = append(spec.Secrets, secret)
+		}
+	}
+}
+
+// bindGCPServiceAccount binds the service accounts of the driver and executors of the given SparkApplication to its
+// Google service account with GKE Workload Identity, by annotating them. Service accounts already bound to another
+// Google service account are left untouched and fail the submission, as they may be used by other workloads.
+func (r *Reconciler) bindGCPServiceAccount(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.GCP == nil || app.Spec.GCP.WorkloadIdentity == nil {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	gsa := app.Spec.GCP.WorkloadIdentity.ServiceAccount
+
+	var names []string
+	for _, serviceAccount := range []*string{app.Spec.Driver.ServiceAccount, app.Spec.Executor.ServiceAccount} {
+		name := util.GetServiceAccountName(app, serviceAccount)
+		// Pods not given a service account run with the default one of their namespace.
+		if name == "" {
+			name = "default"
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	for _, name := range names {
+		serviceAccount := &corev1.ServiceAccount{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: app.Namespace}, serviceAccount); err != nil {
+			return fmt.Errorf("failed to get service account %s: %v", name, err)
+		}
+		switch bound := serviceAccount.Annotations[common.AnnotationGKEServiceAccount]; bound {
+		case gsa:
+			continue
+		case "":
+		default:
+			return fmt.Errorf("service account %s is bound to Google service account %s instead of %s", name, bound, gsa)
+		}
+		if serviceAccount.Annotations == nil {
+			serviceAccount.Annotations = make(map[string]string)
+		}
+		serviceAccount.Annotations[common.AnnotationGKEServiceAccount] = gsa
+		if err := r.client.Update(ctx, serviceAccount); err != nil {
+			return fmt.Errorf("failed to bind service account %s to Google service account %s: %v", name, gsa, err)
+		}
+		logger.Info("Bound service account to Google service account", "name", name, "googleServiceAccount", gsa)
+	}
+	return nil
+}
+
+// secretProviderClassGVK is the kind of the SecretProviderClasses of the Secrets Store CSI driver.
+var secretProviderClassGVK = schema.GroupVersionKind{Group: "secrets-store.csi.x-k8s.io", Version: "v1", Kind: "SecretProviderClass"}
+
+// buildAzureKeyVaultSecretProviderClass builds the SecretProviderClass mounting the objects of the Azure Key Vault of
+// the given SparkApplication, read by the Azure provider as its workload identity.
+func buildAzureKeyVaultSecretProviderClass(app *v1beta2.SparkApplication) (*unstructured.Unstructured, error) {
+	keyVault := app.Spec.Azure.KeyVault
+	var objects []string
+	for _, object := range keyVault.Objects {
+		fields := map[string]string{
+			"objectName": object.Name,
+			"objectType": ptr.Deref(object.Type, "secret"),
+		}
+		if object.Alias != nil {
+			fields["objectAlias"] = *object.Alias
+		}
+		data, err := yaml.Marshal(fields)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, string(data))
+	}
+	// The provider reads the objects as a YAML array of YAML documents.
+	data, err := yaml.Marshal(map[string][]string{"array": objects})
+	if err != nil {
+		return nil, err
+	}
+
+	identity := app.Spec.Azure.WorkloadIdentity
+	secretProviderClass := &unstructured.Unstructured{}
+	secretProviderClass.SetGroupVersionKind(secretProviderClassGVK)
+	secretProviderClass.SetName(util.GetAzureKeyVaultSecretProviderClassName(app))
+	secretProviderClass.SetNamespace(app.Namespace)
+	secretProviderClass.SetLabels(util.GetDependentResourceLabels(app))
+	secretProviderClass.SetOwnerReferences(util.GetDependentOwnerReferences(app))
+	secretProviderClass.Object["spec"] = map[string]any{
+		"provider": common.SecretsStoreCSIProviderAzure,
+		"parameters": map[string]any{
+			"usePodIdentity": "false",
+			"clientID":       identity.ClientID,
+			"tenantId":       identity.TenantID,
+			"keyvaultName":   keyVault.Name,
+			"objects":        string(data),
+		},
+	}
+	return secretProviderClass, nil
+}
+
+// createAzureKeyVaultSecretProviderClass creates or updates the SecretProviderClass mounting the objects of the
+// Azure Key Vault of the given SparkApplication, which the webhook mounts in the driver and executors.
+func (r *Reconciler) createAzureKeyVaultSecretProviderClass(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.Azure == nil || app.Spec.Azure.KeyVault == nil || app.Spec.Azure.WorkloadIdentity == nil {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	secretProviderClass, err := buildAzureKeyVaultSecretProviderClass(app)
+	if err != nil {
+		return fmt.Errorf("failed to build secret provider class: %v", err)
+	}
+	name := secretProviderClass.GetName()
+	if err := r.client.Create(ctx, secretProviderClass); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create secret provider class %s: %v", name, err)
+		}
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(secretProviderClassGVK)
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: app.Namespace}, existing); err != nil {
+			return fmt.Errorf("failed to get secret provider class %s: %v", name, err)
+		}
+		existing.Object["spec"] = secretProviderClass.Object["spec"]
+		if err := r.client.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update secret provider class %s: %v", name, err)
+		}
+		logger.Info("Updated secret provider class for SparkApplication", "name", name)
+		return nil
+	}
+	logger.Info("Created secret provider class for SparkApplication", "name", name)
+	return nil
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newCloudStorageApp(sparkVersion string, storage *v1beta2.CloudStorageSpec) *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkVersion: sparkVersion,
+			CloudStorage: storage,
+		},
+	}
+}
+
+func TestConfigCloudStorageS3(t *testing.T) {
+	app := newCloudStorageApp("3.5.3", &v1beta2.CloudStorageSpec{
+		S3: &v1beta2.S3StorageSpec{
+			Endpoint:          ptr.To("http://minio.minio:9000"),
+			Region:            ptr.To("us-east-1"),
+			PathStyleAccess:   ptr.To(true),
+			CredentialsSecret: ptr.To("minio-credentials"),
+		},
+	})
+	app.Spec.HadoopConf = map[string]string{common.HadoopS3AEndpoint: "http://minio.other:9000"}
+	app.Spec.Executor.EnvSecretKeyRefs = map[string]v1beta2.NameKey{
+		common.EnvAWSSecretAccessKey: {Name: "executor-credentials", Key: "secret"},
+	}
+
+	configCloudStorage(app)
+
+	assert.Equal(t, map[string]string{
+		"fs.s3a.endpoint":          "http://minio.other:9000",
+		"fs.s3a.endpoint.region":   "us-east-1",
+		"fs.s3a.path.style.access": "true",
+	}, app.Spec.HadoopConf)
+	assert.Equal(t, map[string]v1beta2.NameKey{
+		"AWS_ACCESS_KEY_ID":     {Name: "minio-credentials", Key: "AWS_ACCESS_KEY_ID"},
+		"AWS_SECRET_ACCESS_KEY": {Name: "minio-credentials", Key: "AWS_SECRET_ACCESS_KEY"},
+	}, app.Spec.Driver.EnvSecretKeyRefs)
+	assert.Equal(t, map[string]v1beta2.NameKey{
+		"AWS_ACCESS_KEY_ID":     {Name: "minio-credentials", Key: "AWS_ACCESS_KEY_ID"},
+		"AWS_SECRET_ACCESS_KEY": {Name: "executor-credentials", Key: "secret"},
+	}, app.Spec.Executor.EnvSecretKeyRefs)
+}
+
+func TestConfigCloudStorageS3RoleARN(t *testing.T) {
+	testCases := []struct {
+		sparkVersion string
+		provider     string
+	}{
+		{sparkVersion: "3.5.3", provider: "com.amazonaws.auth.WebIdentityTokenCredentialsProvider"},
+		{sparkVersion: "4.0.0", provider: "software.amazon.awssdk.auth.credentials.WebIdentityTokenFileCredentialsProvider"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.sparkVersion, func(t *testing.T) {
+			app := newCloudStorageApp(tc.sparkVersion, &v1beta2.CloudStorageSpec{
+				S3: &v1beta2.S3StorageSpec{RoleARN: ptr.To("arn:aws:iam::123456789012:role/spark")},
+			})
+
+			configCloudStorage(app)
+
+			assert.Equal(t, map[string]string{"fs.s3a.aws.credentials.provider": tc.provider}, app.Spec.HadoopConf)
+			assert.Empty(t, app.Spec.Driver.EnvSecretKeyRefs)
+		})
+	}
+}
+
+func TestConfigCloudStorageIAMRoleARN(t *testing.T) {
+	app := newCloudStorageApp("4.0.1", nil)
+	app.Spec.Executor.IAMRoleARN = ptr.To("arn:aws:iam::123456789012:role/spark")
+
+	configCloudStorage(app)
+
+	assert.Equal(t, map[string]string{
+		"fs.s3a.aws.credentials.provider": "software.amazon.awssdk.auth.credentials.WebIdentityTokenFileCredentialsProvider",
+	}, app.Spec.HadoopConf)
+}
+
+func TestConfigCloudStorageGCS(t *testing.T) {
+	app := newCloudStorageApp("3.5.3", &v1beta2.CloudStorageSpec{
+		GCS: &v1beta2.GCSStorageSpec{
+			ProjectID:               ptr.To("my-project"),
+			ServiceAccountKeySecret: ptr.To("gcs-key"),
+		},
+	})
+	app.Spec.Executor.Secrets = []v1beta2.SecretInfo{{Name: "gcs-key", Path: "/etc/gcp", Type: v1beta2.SecretTypeGCPServiceAccount}}
+
+	configCloudStorage(app)
+
+	assert.Equal(t, map[string]string{
+		"fs.gs.impl":                    "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFileSystem",
+		"fs.AbstractFileSystem.gs.impl": "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFS",
+		"fs.gs.project.id":              "my-project",
+		"fs.gs.auth.type":               "APPLICATION_DEFAULT",
+	}, app.Spec.HadoopConf)
+	assert.Equal(t, []v1beta2.SecretInfo{
+		{Name: "gcs-key", Path: "/etc/spark/cloud-storage/gcs", Type: v1beta2.SecretTypeGCPServiceAccount},
+	}, app.Spec.Driver.Secrets)
+	// The executors already mount the key.
+	assert.Equal(t, []v1beta2.SecretInfo{
+		{Name: "gcs-key", Path: "/etc/gcp", Type: v1beta2.SecretTypeGCPServiceAccount},
+	}, app.Spec.Executor.Secrets)
+}
+
+func TestConfigCloudStorageGCPWorkloadIdentity(t *testing.T) {
+	app := newCloudStorageApp("3.5.3", nil)
+	app.Spec.GCP = &v1beta2.GCPSpec{
+		WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@my-project.iam.gserviceaccount.com"},
+	}
+
+	configCloudStorage(app)
+
+	assert.Equal(t, map[string]string{
+		"fs.gs.impl":                    "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFileSystem",
+		"fs.AbstractFileSystem.gs.impl": "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFS",
+		"fs.gs.project.id":              "my-project",
+		"fs.gs.auth.type":               "APPLICATION_DEFAULT",
+	}, app.Spec.HadoopConf)
+	assert.Empty(t, app.Spec.Driver.Secrets)
+}
+
+func TestBindGCPServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	const gsa = "spark@my-project.iam.gserviceaccount.com"
+
+	newApp := func() *v1beta2.SparkApplication {
+		app := newCloudStorageApp("3.5.3", nil)
+		app.Spec.GCP = &v1beta2.GCPSpec{WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: gsa}}
+		app.Spec.Driver.ServiceAccount = ptr.To("spark-driver")
+		return app
+	}
+
+	t.Run("bind service accounts", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "spark-driver", Namespace: "default"}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name:        "default",
+				Namespace:   "default",
+				Annotations: map[string]string{"iam.gke.io/gcp-service-account": gsa},
+			}},
+		).Build()
+		reconciler := &Reconciler{client: client}
+
+		require.NoError(t, reconciler.bindGCPServiceAccount(ctx, newApp()))
+
+		// The executors run with the default service account, which is already bound.
+		for _, name := range []string{"spark-driver", "default"} {
+			serviceAccount := &corev1.ServiceAccount{}
+			require.NoError(t, client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, serviceAccount))
+			assert.Equal(t, map[string]string{"iam.gke.io/gcp-service-account": gsa}, serviceAccount.Annotations)
+		}
+	})
+
+	t.Run("service account bound to another Google service account", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name:        "spark-driver",
+				Namespace:   "default",
+				Annotations: map[string]string{"iam.gke.io/gcp-service-account": "other@my-project.iam.gserviceaccount.com"},
+			}},
+		).Build()
+		reconciler := &Reconciler{client: client}
+
+		err := reconciler.bindGCPServiceAccount(ctx, newApp())
+		require.EqualError(t, err, "service account spark-driver is bound to Google service account other@my-project.iam.gserviceaccount.com instead of "+gsa)
+	})
+
+	t.Run("missing service account", func(t *testing.T) {
+		reconciler := &Reconciler{client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+
+		err := reconciler.bindGCPServiceAccount(ctx, newApp())
+		require.ErrorContains(t, err, "failed to get service account spark-driver")
+	})
+}
+
+func TestConfigCloudStorageAzure(t *testing.T) {
+	t.Run("account key", func(t *testing.T) {
+		app := newCloudStorageApp("3.5.3", &v1beta2.CloudStorageSpec{
+			Azure: &v1beta2.AzureStorageSpec{
+				StorageAccount:   "sparkdata",
+				AccountKeySecret: ptr.To("sparkdata-key"),
+			},
+		})
+
+		configCloudStorage(app)
+
+		assert.Equal(t, map[string]string{
+			"fs.azure.account.key.sparkdata.dfs.core.windows.net": "${env.AZURE_STORAGE_ACCOUNT_KEY}",
+		}, app.Spec.HadoopConf)
+		for _, spec := range []v1beta2.SparkPodSpec{app.Spec.Driver.SparkPodSpec, app.Spec.Executor.SparkPodSpec} {
+			assert.Equal(t, map[string]v1beta2.NameKey{
+				"AZURE_STORAGE_ACCOUNT_KEY": {Name: "sparkdata-key", Key: "AZURE_STORAGE_ACCOUNT_KEY"},
+			}, spec.EnvSecretKeyRefs)
+		}
+	})
+
+	t.Run("managed identity", func(t *testing.T) {
+		app := newCloudStorageApp("3.5.3", &v1beta2.CloudStorageSpec{
+			Azure: &v1beta2.AzureStorageSpec{
+				StorageAccount: "sparkdata",
+				ManagedIdentity: &v1beta2.AzureManagedIdentity{
+					ClientID: ptr.To("00000000-0000-0000-0000-000000000001"),
+					TenantID: ptr.To("00000000-0000-0000-0000-000000000002"),
+				},
+			},
+		})
+
+		configCloudStorage(app)
+
+		assert.Equal(t, map[string]string{
+			"fs.azure.account.auth.type.sparkdata.dfs.core.windows.net":           "OAuth",
+			"fs.azure.account.oauth.provider.type.sparkdata.dfs.core.windows.net": "org.apache.hadoop.fs.azurebfs.oauth2.MsiTokenProvider",
+			"fs.azure.account.oauth2.client.id.sparkdata.dfs.core.windows.net":    "00000000-0000-0000-0000-000000000001",
+			"fs.azure.account.oauth2.msi.tenant.sparkdata.dfs.core.windows.net":   "00000000-0000-0000-0000-000000000002",
+		}, app.Spec.HadoopConf)
+		assert.Empty(t, app.Spec.Driver.EnvSecretKeyRefs)
+	})
+
+	t.Run("workload identity", func(t *testing.T) {
+		app := newCloudStorageApp("4.0.1", &v1beta2.CloudStorageSpec{
+			Azure: &v1beta2.AzureStorageSpec{StorageAccount: "sparkdata"},
+		})
+		app.Spec.Azure = &v1beta2.AzureSpec{
+			WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{
+				ClientID: "00000000-0000-0000-0000-000000000001",
+				TenantID: "00000000-0000-0000-0000-000000000002",
+			},
+		}
+
+		configCloudStorage(app)
+
+		assert.Equal(t, map[string]string{
+			"fs.azure.account.auth.type.sparkdata.dfs.core.windows.net":           "OAuth",
+			"fs.azure.account.oauth.provider.type.sparkdata.dfs.core.windows.net": "org.apache.hadoop.fs.azurebfs.oauth2.WorkloadIdentityTokenProvider",
+			"fs.azure.account.oauth2.client.id.sparkdata.dfs.core.windows.net":    "00000000-0000-0000-0000-000000000001",
+			"fs.azure.account.oauth2.msi.tenant.sparkdata.dfs.core.windows.net":   "00000000-0000-0000-0000-000000000002",
+			"fs.azure.account.oauth2.token.file.sparkdata.dfs.core.windows.net":   "/var/run/secrets/azure/tokens/azure-identity-token",
+			"fs.azure.account.auth.type":                                          "OAuth",
+			"fs.azure.account.oauth.provider.type":                                "org.apache.hadoop.fs.azurebfs.oauth2.WorkloadIdentityTokenProvider",
+			"fs.azure.account.oauth2.client.id":                                   "00000000-0000-0000-0000-000000000001",
+			"fs.azure.account.oauth2.msi.tenant":                                  "00000000-0000-0000-0000-000000000002",
+			"fs.azure.account.oauth2.token.file":                                  "/var/run/secrets/azure/tokens/azure-identity-token",
+		}, app.Spec.HadoopConf)
+		for _, spec := range []v1beta2.SparkPodSpec{app.Spec.Driver.SparkPodSpec, app.Spec.Executor.SparkPodSpec} {
+			assert.Equal(t, map[string]string{"azure.workload.identity/use": "true"}, spec.Labels)
+		}
+	})
+
+	t.Run("account key with workload identity", func(t *testing.T) {
+		app := newCloudStorageApp("4.0.1", &v1beta2.CloudStorageSpec{
+			Azure: &v1beta2.AzureStorageSpec{
+				StorageAccount:   "sparkdata",
+				AccountKeySecret: ptr.To("sparkdata-key"),
+			},
+		})
+		app.Spec.Azure = &v1beta2.AzureSpec{
+			WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{
+				ClientID: "00000000-0000-0000-0000-000000000001",
+				TenantID: "00000000-0000-0000-0000-000000000002",
+			},
+		}
+
+		configCloudStorage(app)
+
+		assert.Equal(t, "${env.AZURE_STORAGE_ACCOUNT_KEY}", app.Spec.HadoopConf["fs.azure.account.key.sparkdata.dfs.core.windows.net"])
+		assert.Equal(t, "SharedKey", app.Spec.HadoopConf["fs.azure.account.auth.type.sparkdata.dfs.core.windows.net"])
+		assert.Equal(t, "OAuth", app.Spec.HadoopConf["fs.azure.account.auth.type"])
+	})
+}
+
+func TestCreateAzureKeyVaultSecretProviderClass(t *testing.T) {
+	ctx := context.Background()
+	app := newCloudStorageApp("4.0.1", nil)
+	app.Spec.Azure = &v1beta2.AzureSpec{
+		WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{
+			ClientID: "00000000-0000-0000-0000-000000000001",
+			TenantID: "00000000-0000-0000-0000-000000000002",
+		},
+		KeyVault: &v1beta2.AzureKeyVault{
+			Name: "spark-vault",
+			Objects: []v1beta2.AzureKeyVaultObject{
+				{Name: "db-password"},
+				{Name: "tls", Type: ptr.To("cert"), Alias: ptr.To("tls.pem")},
+			},
+		},
+	}
+	client := fake.NewClientBuilder().Build()
+	reconciler := &Reconciler{client: client}
+
+	require.NoError(t, reconciler.createAzureKeyVaultSecretProviderClass(ctx, app))
+	// The SecretProviderClass is updated on resubmission.
+	app.Spec.Azure.KeyVault.Name = "spark-vault-2"
+	require.NoError(t, reconciler.createAzureKeyVaultSecretProviderClass(ctx, app))
+
+	secretProviderClass := &unstructured.Unstructured{}
+	secretProviderClass.SetGroupVersionKind(secretProviderClassGVK)
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "test-app-azure-key-vault", Namespace: "default"}, secretProviderClass))
+	assert.Equal(t, map[string]any{
+		"provider": "azure",
+		"parameters": map[string]any{
+			"usePodIdentity": "false",
+			"clientID":       "00000000-0000-0000-0000-000000000001",
+			"tenantId":       "00000000-0000-0000-0000-000000000002",
+			"keyvaultName":   "spark-vault-2",
+			"objects": `array:
+- |
+  objectName: db-password
+  objectType: secret
+- |
+  objectAlias: tls.pem
+  objectName: tls
+  objectType: cert
+`,
+		},
+	}, secretProviderClass.Object["spec"])
+}
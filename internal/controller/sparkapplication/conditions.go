@@ -0,0 +1,177 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// maxConditionMessageLength is the maximum length of the message of a metav1.Condition.
+const maxConditionMessageLength = 32768
+
+// updateConditions derives the standard conditions of the given SparkApplication from its state, so that
+// clients can wait on them instead of following the state machine.
+func updateConditions(app *v1beta2.SparkApplication) {
+	updateKStatusConditions(app)
+
+	status := &app.Status
+	state := status.AppState.State
+
+	// driverReason explains why the driver is not running.
+	driverReason := v1beta2.SparkApplicationReasonPending
+	switch state {
+	case v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing,
+		v1beta2.ApplicationStateCompleted, v1beta2.ApplicationStateFailed, v1beta2.ApplicationStateFailedSubmission,
+		v1beta2.ApplicationStatePreflightFailed:
+		driverReason = v1beta2.SparkApplicationReasonTerminated
+	}
+
+	switch state {
+	case v1beta2.ApplicationStateSubmitted, v1beta2.ApplicationStateRunning, v1beta2.ApplicationStateUnknown,
+		v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing,
+		v1beta2.ApplicationStateCompleted, v1beta2.ApplicationStateFailed:
+		setCondition(app, v1beta2.SparkApplicationConditionSubmitted, true, v1beta2.SparkApplicationReasonSubmitted, "")
+	case v1beta2.ApplicationStateFailedSubmission:
+		setCondition(app, v1beta2.SparkApplicationConditionSubmitted, false, v1beta2.SparkApplicationReasonSubmissionFailed, status.AppState.ErrorMessage)
+	case v1beta2.ApplicationStatePreflightFailed:
+		setCondition(app, v1beta2.SparkApplicationConditionSubmitted, false, v1beta2.SparkApplicationReasonPreflightFailed, status.AppState.ErrorMessage)
+	default:
+		setCondition(app, v1beta2.SparkApplicationConditionSubmitted, false, v1beta2.SparkApplicationReasonPending, "")
+	}
+
+	if state != v1beta2.ApplicationStateRunning {
+		setCondition(app, v1beta2.SparkApplicationConditionDriverReady, false, driverReason, "")
+		setCondition(app, v1beta2.SparkApplicationConditionExecutorsReady, false, driverReason, "")
+		setCondition(app, v1beta2.SparkApplicationConditionUIAvailable, false, driverReason, "")
+	} else {
+		setCondition(app, v1beta2.SparkApplicationConditionDriverReady, true, v1beta2.SparkApplicationReasonRunning,
+			fmt.Sprintf("Driver %s is running", status.DriverInfo.PodName))
+
+		updateExecutorsReadyCondition(app)
+
+		switch {
+		case status.DriverInfo.WebUIIngressAddress != "":
+			setCondition(app, v1beta2.SparkApplicationConditionUIAvailable, true, v1beta2.SparkApplicationReasonRunning, status.DriverInfo.WebUIIngressAddress)
+		case status.DriverInfo.WebUIAddress != "":
+			setCondition(app, v1beta2.SparkApplicationConditionUIAvailable, true, v1beta2.SparkApplicationReasonRunning, status.DriverInfo.WebUIAddress)
+		default:
+			setCondition(app, v1beta2.SparkApplicationConditionUIAvailable, false, v1beta2.SparkApplicationReasonUINotExposed, "")
+		}
+	}
+
+	switch state {
+	case v1beta2.ApplicationStateCompleted:
+		setCondition(app, v1beta2.SparkApplicationConditionCompleted, true, v1beta2.SparkApplicationReasonCompleted, "")
+		setCondition(app, v1beta2.SparkApplicationConditionFailed, false, v1beta2.SparkApplicationReasonCompleted, "")
+	case v1beta2.ApplicationStateFailed, v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
+		setCondition(app, v1beta2.SparkApplicationConditionCompleted, false, v1beta2.SparkApplicationReasonFailed, "")
+		setCondition(app, v1beta2.SparkApplicationConditionFailed, true, v1beta2.SparkApplicationReasonFailed, status.AppState.ErrorMessage)
+	default:
+		setCondition(app, v1beta2.SparkApplicationConditionCompleted, false, v1beta2.SparkApplicationReasonInProgress, "")
+		setCondition(app, v1beta2.SparkApplicationConditionFailed, false, v1beta2.SparkApplicationReasonInProgress, "")
+	}
+}
+
+// updateExecutorsReadyCondition derives the ExecutorsReady condition of the given running SparkApplication from
+// its executor states. It is also called on its own when only the executor states changed, so that the condition
+// is written along with them by the status batcher.
+func updateExecutorsReadyCondition(app *v1beta2.SparkApplication) {
+	if app.Status.AppState.State != v1beta2.ApplicationStateRunning {
+		return
+	}
+
+	running, pending := 0, 0
+	for _, executorState := range app.Status.ExecutorState {
+		switch executorState {
+		case v1beta2.ExecutorStateRunning:
+			running++
+		case v1beta2.ExecutorStatePending:
+			pending++
+		}
+	}
+	if running > 0 && pending == 0 {
+		setCondition(app, v1beta2.SparkApplicationConditionExecutorsReady, true, v1beta2.SparkApplicationReasonRunning,
+			fmt.Sprintf("%d executors are running", running))
+	} else {
+		setCondition(app, v1beta2.SparkApplicationConditionExecutorsReady, false, v1beta2.SparkApplicationReasonExecutorsPending,
+			fmt.Sprintf("%d executors are running, %d are pending", running, pending))
+	}
+}
+
+// updateKStatusConditions sets the Ready, Reconciling and Stalled conditions of the given SparkApplication
+// from its health.
+func updateKStatusConditions(app *v1beta2.SparkApplication) {
+	status := &app.Status
+	switch status.Health {
+	case v1beta2.ApplicationHealthHealthy:
+		reason := v1beta2.SparkApplicationReasonRunning
+		switch status.AppState.State {
+		case v1beta2.ApplicationStateCompleted:
+			reason = v1beta2.SparkApplicationReasonCompleted
+		case v1beta2.ApplicationStateSuspended:
+			reason = v1beta2.SparkApplicationReasonSuspended
+		}
+		setCondition(app, v1beta2.ConditionReady, true, reason, "")
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionReconciling)
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionStalled)
+	case v1beta2.ApplicationHealthDegraded:
+		setCondition(app, v1beta2.ConditionReady, false, v1beta2.SparkApplicationReasonFailed, status.AppState.ErrorMessage)
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionReconciling)
+		setCondition(app, v1beta2.ConditionStalled, true, v1beta2.SparkApplicationReasonFailed, status.AppState.ErrorMessage)
+	default:
+		message := fmt.Sprintf("Application is %s", status.AppState.State)
+		if status.AppState.State == v1beta2.ApplicationStateNew {
+			message = "Application has not been submitted yet"
+		}
+		setCondition(app, v1beta2.ConditionReady, false, v1beta2.ReasonProgressing, message)
+		setCondition(app, v1beta2.ConditionReconciling, true, v1beta2.ReasonProgressing, message)
+		meta.RemoveStatusCondition(&status.Conditions, v1beta2.ConditionStalled)
+	}
+}
+
+func setCondition(app *v1beta2.SparkApplication, conditionType string, status bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            truncateConditionMessage(message),
+	}
+	if status {
+		condition.Status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&app.Status.Conditions, condition)
+}
+
+// truncateConditionMessage shortens the given message, e.g. a long driver error, to fit in a condition.
+func truncateConditionMessage(message string) string {
+	if len(message) <= maxConditionMessageLength {
+		return message
+	}
+	const suffix = "..."
+	end := maxConditionMessageLength - len(suffix)
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+	return message[:end] + suffix
+}
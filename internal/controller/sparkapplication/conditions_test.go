@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestUpdateConditions(t *testing.T) {
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default", Generation: 2}}
+
+	assertCondition := func(conditionType string, status metav1.ConditionStatus, reason string) {
+		t.Helper()
+		condition := meta.FindStatusCondition(app.Status.Conditions, conditionType)
+		require.NotNil(t, condition, conditionType)
+		assert.Equal(t, status, condition.Status, conditionType)
+		assert.Equal(t, reason, condition.Reason, conditionType)
+		assert.Equal(t, int64(2), condition.ObservedGeneration, conditionType)
+	}
+
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionSubmitted, metav1.ConditionFalse, v1beta2.SparkApplicationReasonPending)
+	assertCondition(v1beta2.SparkApplicationConditionDriverReady, metav1.ConditionFalse, v1beta2.SparkApplicationReasonPending)
+	assertCondition(v1beta2.SparkApplicationConditionCompleted, metav1.ConditionFalse, v1beta2.SparkApplicationReasonInProgress)
+
+	app.Status.AppState.State = v1beta2.ApplicationStateRunning
+	app.Status.ExecutorState = map[string]v1beta2.ExecutorState{
+		"exec-1": v1beta2.ExecutorStateRunning,
+		"exec-2": v1beta2.ExecutorStatePending,
+	}
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionSubmitted, metav1.ConditionTrue, v1beta2.SparkApplicationReasonSubmitted)
+	assertCondition(v1beta2.SparkApplicationConditionDriverReady, metav1.ConditionTrue, v1beta2.SparkApplicationReasonRunning)
+	assertCondition(v1beta2.SparkApplicationConditionExecutorsReady, metav1.ConditionFalse, v1beta2.SparkApplicationReasonExecutorsPending)
+	assertCondition(v1beta2.SparkApplicationConditionUIAvailable, metav1.ConditionFalse, v1beta2.SparkApplicationReasonUINotExposed)
+
+	app.Status.ExecutorState["exec-2"] = v1beta2.ExecutorStateRunning
+	app.Status.DriverInfo.WebUIAddress = "10.0.0.1:4040"
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionExecutorsReady, metav1.ConditionTrue, v1beta2.SparkApplicationReasonRunning)
+	assertCondition(v1beta2.SparkApplicationConditionUIAvailable, metav1.ConditionTrue, v1beta2.SparkApplicationReasonRunning)
+
+	app.Status.AppState.State = v1beta2.ApplicationStateCompleted
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionDriverReady, metav1.ConditionFalse, v1beta2.SparkApplicationReasonTerminated)
+	assertCondition(v1beta2.SparkApplicationConditionCompleted, metav1.ConditionTrue, v1beta2.SparkApplicationReasonCompleted)
+	assertCondition(v1beta2.SparkApplicationConditionFailed, metav1.ConditionFalse, v1beta2.SparkApplicationReasonCompleted)
+
+	app.Status.AppState = v1beta2.ApplicationState{State: v1beta2.ApplicationStateFailedSubmission, ErrorMessage: "spark-submit failed"}
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionSubmitted, metav1.ConditionFalse, v1beta2.SparkApplicationReasonSubmissionFailed)
+	assertCondition(v1beta2.SparkApplicationConditionFailed, metav1.ConditionTrue, v1beta2.SparkApplicationReasonFailed)
+	assert.Equal(t, "spark-submit failed", meta.FindStatusCondition(app.Status.Conditions, v1beta2.SparkApplicationConditionFailed).Message)
+
+	app.Status.AppState = v1beta2.ApplicationState{State: v1beta2.ApplicationStatePreflightFailed, ErrorMessage: "pre-flight checks failed: references: configmap conf not found"}
+	updateConditions(app)
+	assertCondition(v1beta2.SparkApplicationConditionSubmitted, metav1.ConditionFalse, v1beta2.SparkApplicationReasonPreflightFailed)
+	assertCondition(v1beta2.SparkApplicationConditionFailed, metav1.ConditionTrue, v1beta2.SparkApplicationReasonFailed)
+}
+
+func TestUpdateKStatusConditions(t *testing.T) {
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+
+	app.Status.Health = v1beta2.ApplicationHealthProgressing
+	updateKStatusConditions(app)
+	assert.True(t, meta.IsStatusConditionFalse(app.Status.Conditions, v1beta2.ConditionReady))
+	assert.True(t, meta.IsStatusConditionTrue(app.Status.Conditions, v1beta2.ConditionReconciling))
+	assert.Nil(t, meta.FindStatusCondition(app.Status.Conditions, v1beta2.ConditionStalled))
+
+	app.Status.AppState.State = v1beta2.ApplicationStateCompleted
+	app.Status.Health = v1beta2.ApplicationHealthHealthy
+	updateKStatusConditions(app)
+	ready := meta.FindStatusCondition(app.Status.Conditions, v1beta2.ConditionReady)
+	require.NotNil(t, ready)
+	assert.Equal(t, metav1.ConditionTrue, ready.Status)
+	assert.Equal(t, v1beta2.SparkApplicationReasonCompleted, ready.Reason)
+	assert.Nil(t, meta.FindStatusCondition(app.Status.Conditions, v1beta2.ConditionReconciling))
+
+	app.Status.AppState = v1beta2.ApplicationState{State: v1beta2.ApplicationStateFailed, ErrorMessage: "driver failed"}
+	app.Status.Health = v1beta2.ApplicationHealthDegraded
+	updateKStatusConditions(app)
+	assert.True(t, meta.IsStatusConditionFalse(app.Status.Conditions, v1beta2.ConditionReady))
+	stalled := meta.FindStatusCondition(app.Status.Conditions, v1beta2.ConditionStalled)
+	require.NotNil(t, stalled)
+	assert.Equal(t, metav1.ConditionTrue, stalled.Status)
+	assert.Equal(t, "driver failed", stalled.Message)
+}
+
+func TestUpdateConditionsTruncatesLongMessages(t *testing.T) {
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+	app.Status.AppState.State = v1beta2.ApplicationStateFailed
+	app.Status.AppState.ErrorMessage = "é" + strings.Repeat("x", maxConditionMessageLength)
+	app.Status.Health = v1beta2.ApplicationHealthDegraded
+	updateConditions(app)
+
+	condition := meta.FindStatusCondition(app.Status.Conditions, v1beta2.SparkApplicationConditionFailed)
+	require.NotNil(t, condition)
+	assert.LessOrEqual(t, len(condition.Message), maxConditionMessageLength)
+	assert.True(t, utf8.ValidString(condition.Message))
+	assert.True(t, strings.HasPrefix(condition.Message, "éxxx"))
+	assert.True(t, strings.HasSuffix(condition.Message, "..."))
+
+	assert.Equal(t, "short", truncateConditionMessage("short"))
+	// A multi-byte character crossing the limit is dropped instead of being cut.
+	truncated := truncateConditionMessage(strings.Repeat("x", maxConditionMessageLength-4) + "日本")
+	assert.Equal(t, strings.Repeat("x", maxConditionMessageLength-4)+"...", truncated)
+}
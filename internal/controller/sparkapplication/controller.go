@@ -19,6 +19,7 @@ package sparkapplication
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,18 +40,30 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/archive"
+	"github.com/kubeflow/spark-operator/v2/internal/audit"
+	"github.com/kubeflow/spark-operator/v2/internal/cloudevents"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
 	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+	"github.com/kubeflow/spark-operator/v2/internal/preflight"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/kubescheduler"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/volcano"
 	"github.com/kubeflow/spark-operator/v2/internal/scheduler/yunikorn"
+	"github.com/kubeflow/spark-operator/v2/internal/sharding"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/features"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
 
@@ -63,14 +77,107 @@ type Options struct {
 	IngressAnnotations    map[string]string
 	DefaultBatchScheduler string
 
+	// HistoryServerURLFormat is the format of the URL of SparkApplications in the Spark History Server recorded in
+	// their status, with the same placeholders as the ingress URL format. Empty disables recording it.
+	HistoryServerURLFormat string
+
 	DriverPodCreationGracePeriod time.Duration
 
+	// StatusUpdateInterval is the minimum interval between two writes of the executor states of a running
+	// SparkApplication. Executor state changes within the interval are coalesced. Zero writes them on every change.
+	StatusUpdateInterval time.Duration
+
+	// EventPolicy decides which events are emitted for SparkApplications that do not set their own policy.
+	EventPolicy v1beta2.EventPolicy
+
+	// DisableSparkUI disables the Spark web UI of SparkApplications that do not enable it explicitly.
+	DisableSparkUI bool
+
+	// ExecutorImagePullFailureTimeout is how long an executor may fail to pull its image before the
+	// application is failed. Zero disables the check.
+	ExecutorImagePullFailureTimeout time.Duration
+
 	KubeSchedulerNames []string
 
 	SparkApplicationMetrics *metrics.SparkApplicationMetrics
 	SparkExecutorMetrics    *metrics.SparkExecutorMetrics
+	SparkTaskMetrics        *metrics.SparkTaskMetrics
+
+	// TaskMetricsEndpoint is the URL of the operator endpoint that task metrics driver plugins report to.
+	TaskMetricsEndpoint string
 
 	MaxTrackedExecutorPerApp int
+
+	// MaintenanceWindows are the periods during which new SparkApplications are queued instead of submitted.
+	MaintenanceWindows []MaintenanceWindow
+
+	// Shard restricts the controller to the namespaces owned by this operator instance. Nil disables sharding.
+	Shard *sharding.Shard
+
+	// NotificationsConfigMap is the name of the ConfigMap holding the notifications of the SparkApplications of
+	// its namespace. Empty disables namespace notifications.
+	NotificationsConfigMap string
+
+	// NotificationLogsURLFormat is the format of the link to the logs of an application included in
+	// human-readable notifications, e.g. `https://grafana.example.com/explore?app={{$appName}}`.
+	NotificationLogsURLFormat string
+
+	// SMTP configures the SMTP server email notifications are sent through. Nil disables email notifications.
+	SMTP *SMTPOptions
+
+	// CloudEvents emits the lifecycle transitions of SparkApplications as CloudEvents. Nil disables CloudEvents.
+	CloudEvents *cloudevents.Emitter
+
+	// Archiver archives terminated SparkApplications to object storage. Nil disables archival.
+	Archiver *archive.Archiver
+
+	// PodExecutor runs commands in the diagnostics collector containers of driver pods to collect their dumps to
+	// the Archiver and stop the collectors, and in the service mesh sidecars of Spark pods that are not native
+	// sidecars to shut them down. Nil leaves the collectors and sidecars running.
+	PodExecutor PodExecutor
+
+	// AuditLogger records the submissions and kills of SparkApplications. Nil disables auditing.
+	AuditLogger *audit.Logger
+
+	// EnableNetworkPolicies creates a NetworkPolicy for every SparkApplication only admitting the traffic between
+	// its pods, from the operator, and to its web UI and driver ingress ports.
+	EnableNetworkPolicies bool
+
+	// EnableDriftCorrection recreates the services, ingresses and ConfigMaps of running SparkApplications that were
+	// deleted out-of-band, and repairs those that were modified.
+	EnableDriftCorrection bool
+
+	// OperatorNamespace is the namespace of the operator pods admitted by the NetworkPolicies of SparkApplications.
+	OperatorNamespace string
+
+	// PreflightChecks are run before submitting SparkApplications, which move to the PREFLIGHT_FAILED state
+	// and are retried like failed submissions if any of them fails.
+	PreflightChecks []preflight.Interface
+
+	// EnableQuotaWait holds the submission of SparkApplications in the QUOTA_WAIT state while the ResourceQuotas
+	// of their namespace lack the headroom for their driver and minimum number of executors.
+	EnableQuotaWait bool
+	// QuotaWaitRequeueInterval is how often the quota of waiting SparkApplications is checked again.
+	QuotaWaitRequeueInterval time.Duration
+
+	// DefaultImagePullSecret is the image pull secret added to all SparkApplications. A secret with a namespace
+	// is copied into the namespaces of the applications. Empty adds none.
+	DefaultImagePullSecret types.NamespacedName
+
+	// MetadataPropagation selects the labels and annotations propagated from the SparkApplications that do not set
+	// spec.metadataPropagation to their pods, Services and executor PVCs. Nil uses util.DefaultMetadataPropagation.
+	MetadataPropagation *v1beta2.MetadataPropagation
+
+	// ServiceMeshMode makes Spark pods compatible with the sidecars of a service mesh. Only `istio` is supported.
+	// Empty disables it.
+	ServiceMeshMode string
+
+	// ExecutorPodCache caches the metadata of executor pods when the operator only watches their metadata,
+	// in which case executor pods are read from the API server. Nil watches executor pods through the manager cache.
+	ExecutorPodCache cache.Cache
+
+	// EgressPolicy restricts the destinations of HTTP hooks. Nil only allows public addresses.
+	EgressPolicy *egress.Policy
 }
 
 // Reconciler reconciles a SparkApplication object.
@@ -82,6 +189,10 @@ type Reconciler struct {
 	registry  *scheduler.Registry
 	submitter SparkApplicationSubmitter
 	options   Options
+
+	statusBatcher *statusBatcher
+	hookClient    *http.Client
+	diagnostics   *diagnosticsCollector
 }
 
 // Reconciler implements reconcile.Reconciler.
@@ -101,21 +212,35 @@ func NewReconciler(
 		manager:   manager,
 		scheme:    scheme,
 		client:    client,
-		recorder:  recorder,
+		recorder:  newEventPolicyRecorder(recorder, options.EventPolicy),
 		registry:  registry,
 		submitter: submitter,
 		options:   options,
+
+		statusBatcher: newStatusBatcher(options.StatusUpdateInterval),
+		hookClient:    options.EgressPolicy.NewClient(maxHTTPHookTimeoutSeconds * time.Second),
+		diagnostics:   newDiagnosticsCollector(),
 	}
 }
 
 // +kubebuilder:rbac:groups=,resources=pods,verbs=get;list;watch;create;update;patch;delete;deletecollection
-// +kubebuilder:rbac:groups=,resources=configmaps,verbs=get;list;create;update;patch;delete
-// +kubebuilder:rbac:groups=,resources=services,verbs=get;create;delete
-// +kubebuilder:rbac:groups=,resources=nodes,verbs=get
-// +kubebuilder:rbac:groups=,resources=events,verbs=create;update;patch
+// +kubebuilder:rbac:groups=,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=,resources=services,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=,resources=persistentvolumeclaims,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=,resources=serviceaccounts,verbs=get;create
+// +kubebuilder:rbac:groups=,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=,resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups=,resources=events,verbs=list;create;update;patch
+// +kubebuilder:rbac:groups=,resources=secrets,verbs=get;create;update
 // +kubebuilder:rbac:groups=,resources=resourcequotas,verbs=get;list;watch
 // +kubebuilder:rbac:groups=extensions,resources=ingresses,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;create;update
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;create
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;create;update
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;create
+// +kubebuilder:rbac:groups=secrets-store.csi.x-k8s.io,resources=secretproviderclasses,verbs=get;create;update
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get
 // +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=sparkoperator.k8s.io,resources=sparkapplications/status,verbs=get;update;patch
@@ -180,9 +305,19 @@ func NewReconciler(
 // |                                   +----------+      +-----------+      +------------+         +----+               |
 // |                                                                                                                    |
 // +--------------------------------------------------------------------------------------------------------------------+
-func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	ctx, span := tracing.StartSpan(ctx, "SparkApplication.Reconcile",
+		tracing.AttributeNamespace.String(req.Namespace),
+		tracing.AttributeName.String(req.Name),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+	// Requests for other shards may still be enqueued by cluster-scoped watches, e.g. on nodes.
+	if !r.options.Shard.Owns(key.Namespace) {
+		return ctrl.Result{}, nil
+	}
 	app, err := r.getSparkApplication(ctx, key)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -191,6 +326,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{Requeue: true}, err
 	}
 
+	// The log lines of states that may submit the application again are correlated with the new attempt once it is
+	// submitted instead.
+	if !mayResubmit(app.Status.AppState.State) {
+		ctx = util.WithCorrelationID(ctx, util.GetCorrelationID(app))
+		logger = log.FromContext(ctx)
+		span.SetAttributes(tracing.AttributeCorrelationID.String(util.GetCorrelationID(app)))
+	}
+	span.SetAttributes(tracing.AttributeState.String(string(app.Status.AppState.State)))
+
 	logger.Info("Reconciling SparkApplication", "state", app.Status.AppState.State)
 	defer logger.Info("Finished reconciling SparkApplication")
 
@@ -199,6 +343,32 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return r.handleSparkApplicationDeletion(ctx, req)
 	}
 
+	result, err = r.reconcileSparkApplicationState(ctx, req, app)
+	if err != nil {
+		return result, err
+	}
+
+	// Run the hooks and deliver the notifications recorded on state transitions, including the one that may just
+	// have happened.
+	if err := r.runHooks(ctx, key); err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	wait, err := r.deliverNotifications(ctx, key)
+	if err != nil {
+		return ctrl.Result{Requeue: true}, err
+	}
+	if wait > 0 && (result.RequeueAfter == 0 || wait < result.RequeueAfter) {
+		result.RequeueAfter = wait
+	}
+	return result, nil
+}
+
+// reconcileSparkApplicationState reconciles the SparkApplication according to its current state.
+func (r *Reconciler) reconcileSparkApplicationState(ctx context.Context, req ctrl.Request, app *v1beta2.SparkApplication) (ctrl.Result, error) {
+	if shouldTerminate(app) {
+		return r.transitionToCancelled(ctx, req)
+	}
+
 	if ptr.Deref(app.Spec.Suspend, false) {
 		if !util.IsTerminated(app) &&
 			app.Status.AppState.State != v1beta2.ApplicationStateSuspended &&
@@ -207,12 +377,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	if shouldRestart(app) {
+		return r.transitionToRestarting(ctx, req)
+	}
+
 	switch app.Status.AppState.State {
-	case v1beta2.ApplicationStateNew:
+	case v1beta2.ApplicationStateNew, v1beta2.ApplicationStateQuotaWait:
 		return r.reconcileNewSparkApplication(ctx, req)
 	case v1beta2.ApplicationStateSubmitted:
 		return r.reconcileSubmittedSparkApplication(ctx, req)
-	case v1beta2.ApplicationStateFailedSubmission:
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
 		return r.reconcileFailedSubmissionSparkApplication(ctx, req)
 	case v1beta2.ApplicationStateRunning:
 		return r.reconcileRunningSparkApplication(ctx, req)
@@ -240,6 +414,20 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
+// mayResubmit returns whether a SparkApplication in the given state may be submitted again when reconciled.
+func mayResubmit(state v1beta2.ApplicationStateType) bool {
+	switch state {
+	case v1beta2.ApplicationStateNew,
+		v1beta2.ApplicationStateQuotaWait,
+		v1beta2.ApplicationStateFailedSubmission,
+		v1beta2.ApplicationStatePreflightFailed,
+		v1beta2.ApplicationStatePendingRerun,
+		v1beta2.ApplicationStateResuming:
+		return true
+	}
+	return false
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
 	kind := "SparkApplication"
@@ -248,7 +436,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 	// Use a custom log constructor.
 	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		Watches(
 			&corev1.Pod{},
@@ -257,17 +445,59 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 		).
 		Watches(
 			&v1beta2.SparkApplication{},
-			NewSparkApplicationEventHandler(r.options.SparkApplicationMetrics),
+			NewSparkApplicationEventHandler(r.options.SparkApplicationMetrics, r.options.SparkTaskMetrics, r.options.CloudEvents),
 			builder.WithPredicates(
 				NewSparkApplicationEventFilter(
 					mgr.GetClient(),
-					mgr.GetEventRecorderFor("spark-application-event-handler"),
+					newEventPolicyRecorder(mgr.GetEventRecorderFor("spark-application-event-handler"), r.options.EventPolicy),
 					r.options.Namespaces,
 				),
 			),
-		).
-		WithOptions(options).
-		Complete(r)
+		)
+
+	if r.options.ExecutorPodCache != nil {
+		b = b.WatchesRawSource(r.executorPodMetadataSource())
+	}
+
+	// Watch nodes to decommission executors gracefully before their nodes are drained or reclaimed.
+	if features.Enabled(features.ExecutorDecommission) {
+		// Executor pods are looked up by node from the API server when they are not in the manager cache,
+		// which supports spec.nodeName as a field selector.
+		var reader client.Reader = mgr.GetAPIReader()
+		if r.options.ExecutorPodCache == nil {
+			if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, podNodeNameField, indexPodByNodeName); err != nil {
+				return fmt.Errorf("failed to index pods by node name: %v", err)
+			}
+			reader = mgr.GetClient()
+		}
+		b = b.Watches(&corev1.Node{}, NewSparkNodeEventHandler(reader))
+	}
+
+	// Watch SparkApplications to submit the applications depending on them once they complete.
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1beta2.SparkApplication{}, dependsOnField, indexSparkApplicationByDependsOn); err != nil {
+		return fmt.Errorf("failed to index SparkApplications by dependencies: %v", err)
+	}
+	b = b.Watches(&v1beta2.SparkApplication{}, handler.EnqueueRequestsFromMapFunc(r.mapSparkApplicationToDependents))
+
+	// Watch the SparkConfigMaps of SparkApplications to reload them on changes.
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1beta2.SparkApplication{}, sparkConfigMapField, indexSparkApplicationBySparkConfigMap); err != nil {
+		return fmt.Errorf("failed to index SparkApplications by SparkConfigMap: %v", err)
+	}
+	b = b.Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.mapSparkConfigMapToSparkApplications))
+
+	// Watch the resources owned by SparkApplications to correct their drift as soon as they are deleted or modified.
+	// Creations are ignored as they are made by the controller itself.
+	if r.options.EnableDriftCorrection {
+		ownerHandler := handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &v1beta2.SparkApplication{}, handler.OnlyControllerOwner())
+		ignoreCreate := predicate.Funcs{CreateFunc: func(event.CreateEvent) bool { return false }}
+		b = b.Watches(&corev1.Service{}, ownerHandler, builder.WithPredicates(ignoreCreate)).
+			Watches(&corev1.ConfigMap{}, ownerHandler, builder.WithPredicates(ignoreCreate))
+		if util.IngressCapabilities.Has("networking.k8s.io/v1") {
+			b = b.Watches(&networkingv1.Ingress{}, ownerHandler, builder.WithPredicates(ignoreCreate))
+		}
+	}
+
+	return b.WithEventFilter(r.options.Shard.Predicate()).WithOptions(options).Complete(r)
 }
 
 func (r *Reconciler) handleSparkApplicationDeletion(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -285,12 +515,14 @@ func (r *Reconciler) handleSparkApplicationDeletion(ctx context.Context, req ctr
 		logger.Error(err, "Failed to delete resources associated with SparkApplication")
 		return ctrl.Result{Requeue: true}, err
 	}
+	r.statusBatcher.forget(key)
 	return ctrl.Result{}, nil
 }
 
 func (r *Reconciler) reconcileNewSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+	var requeueAfter time.Duration
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -298,12 +530,70 @@ func (r *Reconciler) reconcileNewSparkApplication(ctx context.Context, req ctrl.
 			if err != nil {
 				return err
 			}
-			if old.Status.AppState.State != v1beta2.ApplicationStateNew {
+			if old.Status.AppState.State != v1beta2.ApplicationStateNew &&
+				old.Status.AppState.State != v1beta2.ApplicationStateQuotaWait {
 				return nil
 			}
 			app := old.DeepCopy()
 
-			r.submitSparkApplication(ctx, app)
+			if util.IsDryRun(app) {
+				if !shouldRenderDryRun(app) {
+					return nil
+				}
+				if err := r.renderDryRun(ctx, app); err != nil {
+					return err
+				}
+				return r.updateSparkApplicationStatus(ctx, app)
+			}
+			if err := r.clearDryRun(ctx, app); err != nil {
+				return err
+			}
+
+			resumed, err := r.resumeInFlightSubmission(ctx, app)
+			if err != nil {
+				return err
+			}
+			if !resumed {
+				waiting, err := r.waitForDependencies(ctx, app)
+				if err != nil {
+					return err
+				}
+				if waiting {
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if end, ok := getMaintenanceWindowEnd(r.options.MaintenanceWindows, time.Now()); ok {
+					logger.Info("Queueing submission of SparkApplication during maintenance window", "end", end)
+					message := fmt.Sprintf("Submission is queued until the maintenance window ends at %s", end.UTC().Format(time.RFC3339))
+					r.queueSubmission(app, v1beta2.SparkApplicationReasonMaintenanceWindow, message)
+					requeueAfter = time.Until(end)
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if waiting, requeue := r.waitForExecutionWindow(ctx, app); waiting {
+					requeueAfter = requeue
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if r.options.EnableQuotaWait {
+					failed, err := r.failForQuotaLimits(ctx, app)
+					if err != nil {
+						return err
+					}
+					if failed {
+						logger.Info("Failing submission of SparkApplication exceeding the hard limits of a ResourceQuota")
+						return r.updateSparkApplicationStatus(ctx, app)
+					}
+					waiting, err := r.waitForQuota(ctx, app)
+					if err != nil {
+						return err
+					}
+					if waiting {
+						logger.Info("Holding submission of SparkApplication until its namespace has enough quota")
+						requeueAfter = r.options.QuotaWaitRequeueInterval
+						return r.updateSparkApplicationStatus(ctx, app)
+					}
+				}
+				meta.RemoveStatusCondition(&app.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+				r.submitSparkApplication(ctx, app)
+			}
 			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
 				return err
 			}
@@ -314,12 +604,15 @@ func (r *Reconciler) reconcileNewSparkApplication(ctx context.Context, req ctrl.
 		logger.Error(retryErr, "Failed to reconcile SparkApplication")
 		return ctrl.Result{Requeue: true}, retryErr
 	}
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *Reconciler) reconcileSubmittedSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+
+	var result ctrl.Result
+
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -336,8 +629,14 @@ func (r *Reconciler) reconcileSubmittedSparkApplication(ctx context.Context, req
 				return err
 			}
 
+			requeueAfter, err := r.failOnExecutorImagePullFailure(ctx, app)
+			if err != nil {
+				return err
+			}
+			result.RequeueAfter = requeueAfter
+
 			// Create web UI service for spark applications if enabled.
-			if r.options.EnableUIService {
+			if r.options.EnableUIService && util.IsSparkUIEnabled(app, !r.options.DisableSparkUI) {
 				service, err := r.createWebUIService(ctx, app)
 				if err != nil {
 					return fmt.Errorf("failed to create web UI service: %v", err)
@@ -362,6 +661,10 @@ func (r *Reconciler) reconcileSubmittedSparkApplication(ctx context.Context, req
 				}
 			}
 
+			if err := r.createExecutorPodDisruptionBudget(ctx, app); err != nil {
+				return fmt.Errorf("failed to create executor PodDisruptionBudget: %v", err)
+			}
+
 			for _, driverIngressConfiguration := range app.Spec.DriverIngressOptions {
 				service, err := r.createDriverIngressServiceFromConfiguration(ctx, app, &driverIngressConfiguration)
 				if err != nil {
@@ -381,6 +684,24 @@ func (r *Reconciler) reconcileSubmittedSparkApplication(ctx context.Context, req
 				}
 			}
 
+			if app.Spec.Driver.Service != nil {
+				if err := r.createDriverService(ctx, app); err != nil {
+					return fmt.Errorf("failed to create driver service for SparkApplication: %v", err)
+				}
+			}
+
+			if app.Spec.Driver.HeadlessService != nil {
+				if err := r.createDriverHeadlessService(ctx, app); err != nil {
+					return fmt.Errorf("failed to create driver headless service for SparkApplication: %v", err)
+				}
+			}
+
+			if app.Spec.Interactive != nil {
+				if err := r.createInteractiveService(ctx, app); err != nil {
+					return fmt.Errorf("failed to create interactive service for SparkApplication: %v", err)
+				}
+			}
+
 			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
 				return err
 			}
@@ -390,9 +711,9 @@ func (r *Reconciler) reconcileSubmittedSparkApplication(ctx context.Context, req
 	)
 	if retryErr != nil {
 		logger.Error(retryErr, "Failed to reconcile SparkApplication")
-		return ctrl.Result{}, retryErr
+		return result, retryErr
 	}
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *Reconciler) reconcileFailedSubmissionSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -408,7 +729,8 @@ func (r *Reconciler) reconcileFailedSubmissionSparkApplication(ctx context.Conte
 			if err != nil {
 				return err
 			}
-			if old.Status.AppState.State != v1beta2.ApplicationStateFailedSubmission {
+			if old.Status.AppState.State != v1beta2.ApplicationStateFailedSubmission &&
+				old.Status.AppState.State != v1beta2.ApplicationStatePreflightFailed {
 				return nil
 			}
 			app := old.DeepCopy()
@@ -453,6 +775,9 @@ func (r *Reconciler) reconcileFailedSubmissionSparkApplication(ctx context.Conte
 func (r *Reconciler) reconcileRunningSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+
+	var result ctrl.Result
+
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -464,28 +789,145 @@ func (r *Reconciler) reconcileRunningSparkApplication(ctx context.Context, req c
 				return nil
 			}
 			app := old.DeepCopy()
+			r.statusBatcher.overlay(key, app)
 
 			if err := r.updateSparkApplicationState(ctx, app); err != nil {
 				return err
 			}
 
+			requeueAfter, err := r.failOnExecutorImagePullFailure(ctx, app)
+			if err != nil {
+				return err
+			}
+			result.RequeueAfter = requeueAfter
+
+			if features.Enabled(features.ExecutorDecommission) {
+				if err := r.decommissionExecutors(ctx, app); err != nil {
+					return err
+				}
+			}
+
+			if err := r.labelExecutorPVCs(ctx, app); err != nil {
+				return err
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				restarting, err := r.reloadSparkConfigMap(ctx, app)
+				if err != nil {
+					return err
+				}
+				if restarting {
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				if err := r.correctDrift(ctx, app); err != nil {
+					return err
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				requeueAfter, err := r.checkStreamingLiveness(ctx, app)
+				if err != nil {
+					return err
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				restarting, requeueAfter, err := r.fallBackSchedulingProfile(ctx, app)
+				if err != nil {
+					return err
+				}
+				if restarting {
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				stopped, requeueAfter, err := r.enforceExecutionWindow(ctx, app)
+				if err != nil {
+					return err
+				}
+				if stopped {
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				stopped, requeueAfter, err := r.enforceIdleTimeout(ctx, app)
+				if err != nil {
+					return err
+				}
+				if stopped {
+					return r.updateSparkApplicationStatus(ctx, app)
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				requeueAfter := r.scaleExecutorsInPlace(ctx, app)
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			if app.Status.AppState.State == v1beta2.ApplicationStateRunning {
+				requeueAfter, err := r.refreshDriverServiceEndpoints(ctx, app)
+				if err != nil {
+					return err
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+			}
+
+			// The ExecutorsReady condition is derived from the executor states, so it has to be up to date before
+			// deciding whether they changed alone and can be written by the status batcher.
+			updateExecutorsReadyCondition(app)
+			if r.statusBatcher != nil && onlyExecutorStateChanged(old, app) {
+				requeueAfter, err := r.batchExecutorStateUpdate(ctx, key, old, app)
+				if err != nil {
+					return err
+				}
+				if requeueAfter > 0 && (result.RequeueAfter == 0 || requeueAfter < result.RequeueAfter) {
+					result.RequeueAfter = requeueAfter
+				}
+				return nil
+			}
+
 			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
 				return err
 			}
+			r.statusBatcher.forget(key)
 
 			return nil
 		},
 	)
 	if retryErr != nil {
 		logger.Error(retryErr, "Failed to reconcile SparkApplication")
-		return ctrl.Result{}, retryErr
+		return result, retryErr
 	}
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *Reconciler) reconcilePendingRerunSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+
+	var result ctrl.Result
+
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -499,12 +941,16 @@ func (r *Reconciler) reconcilePendingRerunSparkApplication(ctx context.Context,
 			app := old.DeepCopy()
 
 			logger.Info("Pending rerun SparkApplication", "state", app.Status.AppState.State)
-			if r.validateSparkResourceDeletion(ctx, app) {
+			if !r.validateSparkResourceDeletion(ctx, app) {
+				logger.Info("Resources associated with SparkApplication still exist")
+			} else if !r.validateCheckpointVolumeRelease(ctx, app) {
+				// Nothing is watched that would signal the release of the claim, so check again later.
+				logger.Info("Waiting for the checkpoint volume of SparkApplication to be released")
+				result.RequeueAfter = checkpointVolumeReleaseRequeueInterval
+			} else {
 				logger.Info("Successfully deleted resources associated with SparkApplication", "state", app.Status.AppState.State)
 				r.recordSparkApplicationEvent(app)
 				r.submitSparkApplication(ctx, app)
-			} else {
-				logger.Info("Resources associated with SparkApplication still exist")
 			}
 			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
 				return err
@@ -514,9 +960,9 @@ func (r *Reconciler) reconcilePendingRerunSparkApplication(ctx context.Context,
 	)
 	if retryErr != nil {
 		logger.Error(retryErr, "Failed to reconcile SparkApplication")
-		return ctrl.Result{}, retryErr
+		return result, retryErr
 	}
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
 func (r *Reconciler) reconcileInvalidatingSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -557,6 +1003,12 @@ func (r *Reconciler) reconcileInvalidatingSparkApplication(ctx context.Context,
 func (r *Reconciler) reconcileSucceedingSparkApplication(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := log.FromContext(ctx)
 	key := req.NamespacedName
+
+	// Stop the diagnostics collector of the driver, which has nothing to collect from a successful driver.
+	if app, err := r.getSparkApplication(ctx, key); err == nil {
+		r.collectDriverDiagnostics(ctx, app)
+	}
+
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -598,6 +1050,15 @@ func (r *Reconciler) reconcileFailingSparkApplication(ctx context.Context, req c
 
 	var result ctrl.Result
 
+	// Collect the dumps of the driver before its pod is deleted to retry the application.
+	var diagnosticsURL string
+	if app, err := r.getSparkApplication(ctx, key); err == nil {
+		var done bool
+		if diagnosticsURL, done = r.collectDriverDiagnostics(ctx, app); !done {
+			return ctrl.Result{RequeueAfter: diagnosticsPollInterval}, nil
+		}
+	}
+
 	retryErr := retry.RetryOnConflict(
 		retry.DefaultRetry,
 		func() error {
@@ -609,6 +1070,9 @@ func (r *Reconciler) reconcileFailingSparkApplication(ctx context.Context, req c
 				return nil
 			}
 			app := old.DeepCopy()
+			if diagnosticsURL != "" {
+				app.Status.DiagnosticsURL = diagnosticsURL
+			}
 
 			if util.ShouldRetry(app) {
 				timeUntilNextRetryDue, err := util.TimeUntilNextRetryDue(app)
@@ -620,6 +1084,7 @@ func (r *Reconciler) reconcileFailingSparkApplication(ctx context.Context, req c
 						logger.Error(err, "failed to delete spark resources")
 						return err
 					}
+					r.increaseMemoryOnOOM(ctx, app)
 					r.resetSparkApplicationStatus(app)
 					app.Status.AppState.State = v1beta2.ApplicationStatePendingRerun
 				} else {
@@ -663,6 +1128,12 @@ func (r *Reconciler) reconcileTerminatedSparkApplication(ctx context.Context, re
 		return ctrl.Result{}, nil
 	}
 
+	// Archive the application before it may be deleted once its TTL expires.
+	if err := r.archiveSparkApplication(ctx, app); err != nil {
+		logger.Error(err, "Failed to archive SparkApplication")
+		return ctrl.Result{Requeue: true}, err
+	}
+
 	if util.IsExpired(app) {
 		logger.Info("Deleting expired SparkApplication", "state", app.Status.AppState.State)
 		if err := r.client.Delete(ctx, app); err != nil {
@@ -883,16 +1354,38 @@ func (r *Reconciler) getSparkApplication(ctx context.Context, key types.Namespac
 // submitSparkApplication creates a new submission for the given SparkApplication and submits it using spark-submit.
 // The submission result are recorded in app.Status.{AppState,ExecutionAttempts}.
 func (r *Reconciler) submitSparkApplication(ctx context.Context, app *v1beta2.SparkApplication) {
+	// SubmissionID must be set before creating any resources to ensure all the resources are labeled.
+	app.Status.SubmissionID = uuid.New().String()
+	ctx = util.WithCorrelationID(ctx, util.GetCorrelationID(app))
+	ctx, span := tracing.StartSpan(ctx, "SparkApplication.Submit",
+		tracing.AttributeCorrelationID.String(util.GetCorrelationID(app)),
+	)
 	logger := log.FromContext(ctx)
 	logger.Info("Submitting SparkApplication", "state", app.Status.AppState.State)
 
-	// SubmissionID must be set before creating any resources to ensure all the resources are labeled.
-	app.Status.SubmissionID = uuid.New().String()
 	app.Status.DriverInfo.PodName = util.GetDriverPodName(app)
 	app.Status.LastSubmissionAttemptTime = metav1.Now()
 	app.Status.SubmissionAttempts = app.Status.SubmissionAttempts + 1
+	// Any submission carries out a pending restart request.
+	app.Status.LastRestartedAt = app.Annotations[common.AnnotationRestartedAt]
+	if app.Spec.Executor.InPlaceScaling != nil {
+		app.Status.ExecutorInstances = ptr.Deref(app.Spec.Executor.Instances, 0)
+	}
+	r.recordSparkConfigMapHash(ctx, app)
+	action := audit.ActionSubmit
+	if app.Status.SubmissionAttempts > 1 || app.Status.ExecutionAttempts > 0 {
+		action = audit.ActionResubmit
+	}
 
 	var submitErr error
+	failedState := v1beta2.ApplicationStateFailedSubmission
+	defer func() { tracing.EndSpan(span, submitErr) }()
+	defer func() {
+		r.recordAuditEntry(app, action, submitErr, map[string]string{
+			"submissionID":      app.Status.SubmissionID,
+			"submissionAttempt": strconv.Itoa(int(app.Status.SubmissionAttempts)),
+		})
+	}()
 	defer func() {
 		if submitErr == nil {
 			app.Status.AppState = v1beta2.ApplicationState{
@@ -902,26 +1395,19 @@ func (r *Reconciler) submitSparkApplication(ctx context.Context, app *v1beta2.Sp
 		} else {
 			logger.Info("Failed to submit SparkApplication", "state", app.Status.AppState.State, "error", submitErr)
 			app.Status.AppState = v1beta2.ApplicationState{
-				State:        v1beta2.ApplicationStateFailedSubmission,
+				State:        failedState,
 				ErrorMessage: submitErr.Error(),
 			}
 		}
 		r.recordSparkApplicationEvent(app)
 	}()
 
-	if err := r.configWebUI(ctx, app); err != nil {
-		submitErr = fmt.Errorf("failed to configure web UI: %v", err)
+	if state, err := r.prepareSubmission(ctx, app); err != nil {
+		failedState = state
+		submitErr = err
 		return
 	}
 
-	if util.PrometheusMonitoringEnabled(app) {
-		logger.Info("Configure Prometheus monitoring for SparkApplication")
-		if err := configPrometheusMonitoring(ctx, app, r.client); err != nil {
-			submitErr = fmt.Errorf("failed to configure Prometheus monitoring: %v", err)
-			return
-		}
-	}
-
 	// Use batch scheduler to perform scheduling task before submitting (before build command arguments).
 	if needScheduling, scheduler, err := r.shouldDoBatchScheduling(ctx, app); err != nil {
 		submitErr = fmt.Errorf("failed during batch scheduler setup or check: %v", err)
@@ -940,13 +1426,125 @@ func (r *Reconciler) submitSparkApplication(ctx context.Context, app *v1beta2.Sp
 		}
 	}()
 
-	if err := r.submitter.Submit(ctx, app); err != nil {
+	submitter := r.submitter
+	if app.Spec.Mode == v1beta2.DeployModeClient {
+		submitter = NewDriverPodSubmitter(r.client)
+	}
+	if err := submitter.Submit(ctx, app); err != nil {
 		r.recordSparkApplicationEvent(app)
 		submitErr = fmt.Errorf("failed to submit spark application: %v", err)
 		return
 	}
 }
 
+// prepareSubmission provisions the resources the given SparkApplication depends on and applies the configuration
+// the operator adds to it before it is submitted. It returns the state of the application if it fails.
+func (r *Reconciler) prepareSubmission(ctx context.Context, app *v1beta2.SparkApplication) (state v1beta2.ApplicationStateType, err error) {
+	ctx, span := tracing.StartSpan(ctx, "SparkApplication.PrepareSubmission")
+	defer func() { tracing.EndSpan(span, err) }()
+
+	logger := log.FromContext(ctx)
+
+	// The diagnostics collector would keep the driver pod running if it could not be stopped.
+	if util.DriverDiagnosticsCollectionEnabled(app) && r.options.PodExecutor == nil {
+		return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("collecting driver diagnostics requires pod exec to be enabled on the operator")
+	}
+
+	if err := r.createServiceAccount(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to provision service account: %v", err)
+	}
+
+	if err := r.bindGCPServiceAccount(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, err
+	}
+
+	if err := r.createAzureKeyVaultSecretProviderClass(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, err
+	}
+
+	if err := r.createNetworkPolicy(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to create network policy: %v", err)
+	}
+
+	if err := r.configWebUI(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure web UI: %v", err)
+	}
+
+	if err := r.configImagePullSecrets(ctx, app); err != nil {
+		return v1beta2.ApplicationStateFailedSubmission, err
+	}
+
+	r.configMetadataPropagation(app)
+
+	applyMemoryAdjustments(app)
+	applySchedulingProfile(app)
+
+	if failures := preflight.Run(ctx, r.options.PreflightChecks, app); len(failures) > 0 {
+		return v1beta2.ApplicationStatePreflightFailed, fmt.Errorf("pre-flight checks failed: %s", preflight.FormatFailures(failures))
+	}
+
+	r.configServiceMesh(ctx, app)
+
+	configVaultSecrets(app)
+
+	if app.Spec.CloudStorage != nil || app.Spec.GCP != nil || app.Spec.Azure != nil || util.UseAWSWebIdentity(app) {
+		configCloudStorage(app)
+	}
+
+	if util.PrometheusMonitoringEnabled(app) {
+		logger.Info("Configure Prometheus monitoring for SparkApplication")
+		if err := configPrometheusMonitoring(ctx, app, r.client); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure Prometheus monitoring: %v", err)
+		}
+	}
+
+	if util.PrometheusServletEnabled(app) {
+		logger.Info("Configure Prometheus servlet metrics sink for SparkApplication")
+		if err := r.configPrometheusServlet(ctx, app); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure Prometheus servlet metrics sink: %v", err)
+		}
+	}
+
+	if util.JSONLoggingEnabled(app) {
+		logger.Info("Configure JSON logging for SparkApplication")
+		if err := r.configJSONLogging(ctx, app); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure JSON logging: %v", err)
+		}
+	}
+
+	if util.DriverDiagnosticsEnabled(app) {
+		configDriverDiagnostics(app)
+	}
+
+	if app.Spec.Interactive != nil {
+		configInteractive(app)
+	}
+
+	if app.Spec.Executor.InPlaceScaling != nil {
+		logger.Info("Configure in-place executor scaling for SparkApplication")
+		if err := r.configExecutorInPlaceScaling(ctx, app); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure in-place executor scaling: %v", err)
+		}
+	}
+
+	if util.TaskMetricsEnabled(app) {
+		logger.Info("Configure task metrics for SparkApplication")
+		if err := r.configTaskMetrics(ctx, app); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure task metrics: %v", err)
+		}
+	}
+
+	// The Kerberos credentials are written last, so that they are removed after the submission.
+	if app.Spec.HadoopSecurity != nil {
+		logger.Info("Configure Kerberos authentication for SparkApplication")
+		if err := r.configHadoopSecurity(ctx, app); err != nil {
+			return v1beta2.ApplicationStateFailedSubmission, fmt.Errorf("failed to configure Kerberos authentication: %v", err)
+		}
+	}
+
+	return "", nil
+}
+
 // updateDriverState finds the driver pod of the application
 // and updates the driver state based on the current phase of the pod.
 func (r *Reconciler) updateDriverState(ctx context.Context, app *v1beta2.SparkApplication) error {
@@ -971,13 +1569,17 @@ func (r *Reconciler) updateDriverState(ctx context.Context, app *v1beta2.SparkAp
 	}
 
 	app.Status.SparkApplicationID = util.GetSparkApplicationID(driverPod)
+	r.recordDriverWebUIAddress(app, driverPod)
+	r.recordDriverZone(ctx, app, driverPod)
 	driverState := util.GetDriverState(driverPod)
 	if util.IsDriverTerminated(driverState) {
 		if app.Status.TerminationTime.IsZero() {
 			app.Status.TerminationTime = metav1.Now()
 		}
+		r.stopServiceMeshSidecar(ctx, driverPod)
 		if driverState == v1beta2.DriverStateFailed {
 			if state := util.GetDriverContainerTerminatedState(driverPod); state != nil {
+				recordOOMKilled(app, common.SparkRoleDriver, state)
 				if state.ExitCode != 0 {
 					app.Status.AppState.ErrorMessage = fmt.Sprintf("driver container failed with ExitCode: %d, Reason: %s", state.ExitCode, state.Reason)
 				}
@@ -1015,11 +1617,15 @@ func (r *Reconciler) updateExecutorState(ctx context.Context, app *v1beta2.Spark
 				continue
 			}
 			newState := util.GetExecutorState(&pod)
+			if util.IsExecutorTerminated(newState) {
+				r.stopServiceMeshSidecar(ctx, &pod)
+			}
 			oldState, exists := app.Status.ExecutorState[pod.Name]
 			// Only record an executor event if the executor state is new or it has changed.
 			if !exists || newState != oldState {
 				if newState == v1beta2.ExecutorStateFailed {
 					execContainerState := util.GetExecutorContainerTerminatedState(&pod)
+					recordOOMKilled(app, common.SparkRoleExecutor, execContainerState)
 					if execContainerState != nil {
 						r.recordExecutorEvent(app, newState, pod.Name, execContainerState.ExitCode, execContainerState.Reason, pod.Status.Message)
 					} else {
@@ -1072,6 +1678,7 @@ func (r *Reconciler) updateExecutorState(ctx context.Context, app *v1beta2.Spark
 		}
 	}
 
+	updateExecutorScaleStatus(app)
 	return nil
 }
 
@@ -1079,7 +1686,7 @@ func (r *Reconciler) getExecutorPods(ctx context.Context, app *v1beta2.SparkAppl
 	matchLabels := util.GetResourceLabels(app)
 	matchLabels[common.LabelSparkRole] = common.SparkRoleExecutor
 	pods := &corev1.PodList{}
-	if err := r.client.List(ctx, pods, client.InNamespace(app.Namespace), client.MatchingLabels(matchLabels)); err != nil {
+	if err := r.executorPodReader().List(ctx, pods, client.InNamespace(app.Namespace), client.MatchingLabels(matchLabels)); err != nil {
 		return nil, fmt.Errorf("failed to get pods for SparkApplication %s/%s: %v", app.Namespace, app.Name, err)
 	}
 	return pods, nil
@@ -1109,12 +1716,18 @@ func (r *Reconciler) updateSparkApplicationState(ctx context.Context, app *v1bet
 	if err := r.updateExecutorState(ctx, app); err != nil {
 		return err
 	}
-
+	app.Status.HistoryServerURL = getHistoryServerURL(r.options.HistoryServerURLFormat, app)
 	return nil
 }
 
 // updateSparkApplicationStatus updates the status of the SparkApplication.
 func (r *Reconciler) updateSparkApplicationStatus(ctx context.Context, app *v1beta2.SparkApplication) error {
+	app.Status.Health = util.GetApplicationHealth(app.Status.AppState.State)
+	app.Status.ObservedGeneration = app.Generation
+	updateConditions(app)
+	app.Status.Phase = util.GetApplicationPhase(app)
+	recordNotifications(app, r.getNotificationSpec(ctx, app))
+	recordHooks(app)
 	if err := r.client.Status().Update(ctx, app); err != nil {
 		return err
 	}
@@ -1122,8 +1735,9 @@ func (r *Reconciler) updateSparkApplicationStatus(ctx context.Context, app *v1be
 }
 
 // Delete the resources associated with the spark application.
-func (r *Reconciler) deleteSparkResources(ctx context.Context, app *v1beta2.SparkApplication) error {
-	if err := r.deleteDriverPod(ctx, app); err != nil {
+// The given options apply to the deletion of the driver pod.
+func (r *Reconciler) deleteSparkResources(ctx context.Context, app *v1beta2.SparkApplication, opts ...client.DeleteOption) error {
+	if err := r.deleteDriverPod(ctx, app, opts...); err != nil {
 		return err
 	}
 
@@ -1135,10 +1749,14 @@ func (r *Reconciler) deleteSparkResources(ctx context.Context, app *v1beta2.Spar
 		return err
 	}
 
+	if err := r.deleteExecutorPodDisruptionBudget(ctx, app); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-func (r *Reconciler) deleteDriverPod(ctx context.Context, app *v1beta2.SparkApplication) error {
+func (r *Reconciler) deleteDriverPod(ctx context.Context, app *v1beta2.SparkApplication, opts ...client.DeleteOption) error {
 	logger := log.FromContext(ctx)
 	podName := app.Status.DriverInfo.PodName
 	// Derive the driver pod name in case the driver pod name was not recorded in the status,
@@ -1148,7 +1766,7 @@ func (r *Reconciler) deleteDriverPod(ctx context.Context, app *v1beta2.SparkAppl
 	}
 
 	logger.Info("Deleting driver pod", "pod", podName)
-	if err := r.client.Delete(
+	err := r.client.Delete(
 		ctx,
 		&corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
@@ -1156,11 +1774,16 @@ func (r *Reconciler) deleteDriverPod(ctx context.Context, app *v1beta2.SparkAppl
 				Namespace: app.Namespace,
 			},
 		},
-	); err != nil && !errors.IsNotFound(err) {
-		return err
+		opts...,
+	)
+	if errors.IsNotFound(err) {
+		return nil
 	}
-
-	return nil
+	r.recordAuditEntry(app, audit.ActionKill, err, map[string]string{
+		"driverPod": podName,
+		"state":     string(app.Status.AppState.State),
+	})
+	return err
 }
 
 func (r *Reconciler) deleteWebUIService(ctx context.Context, app *v1beta2.SparkApplication) error {
@@ -1292,6 +1915,15 @@ func (r *Reconciler) recordSparkApplicationEvent(app *v1beta2.SparkApplication)
 			app.Name,
 			app.Status.AppState.ErrorMessage,
 		)
+	case v1beta2.ApplicationStatePreflightFailed:
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeWarning,
+			common.EventSparkApplicationPreflightFailed,
+			"SparkApplication %s failed pre-flight checks: %s",
+			app.Name,
+			app.Status.AppState.ErrorMessage,
+		)
 	case v1beta2.ApplicationStateCompleted:
 		r.recorder.Eventf(
 			app,
@@ -1377,14 +2009,22 @@ func (r *Reconciler) recordExecutorEvent(app *v1beta2.SparkApplication, state v1
 func (r *Reconciler) resetSparkApplicationStatus(app *v1beta2.SparkApplication) {
 	status := &app.Status
 	switch status.AppState.State {
-	case v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing:
+	case v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing, v1beta2.ApplicationStatePendingRerun:
 		status.SparkApplicationID = ""
+		status.HistoryServerURL = ""
 		status.TerminationTime = metav1.Time{}
 		status.AppState.ErrorMessage = ""
 		status.DriverInfo = v1beta2.DriverInfo{}
 		status.ExecutorState = nil
+		status.ExecutorReplicas = 0
+		status.ExecutorInstances = 0
+		status.DecommissionedExecutors = nil
+		status.ArchivePath = ""
+		status.OOMKilled = nil
+		status.Interactive = nil
 	case v1beta2.ApplicationStateInvalidating:
 		status.SparkApplicationID = ""
+		status.HistoryServerURL = ""
 		status.SubmissionAttempts = 0
 		status.ExecutionAttempts = 0
 		status.LastSubmissionAttemptTime = metav1.Time{}
@@ -1392,11 +2032,24 @@ func (r *Reconciler) resetSparkApplicationStatus(app *v1beta2.SparkApplication)
 		status.AppState.ErrorMessage = ""
 		status.DriverInfo = v1beta2.DriverInfo{}
 		status.ExecutorState = nil
+		status.ExecutorReplicas = 0
+		status.ExecutorInstances = 0
+		status.DecommissionedExecutors = nil
+		status.Streaming = nil
+		status.Interactive = nil
+		status.ArchivePath = ""
+		status.OOMKilled = nil
+		status.MemoryAdjustments = nil
+		status.Cancellation = nil
 	case v1beta2.ApplicationStateSuspended:
 		status.SparkApplicationID = ""
+		status.HistoryServerURL = ""
 		status.AppState.ErrorMessage = ""
 		status.DriverInfo = v1beta2.DriverInfo{}
 		status.ExecutorState = nil
+		status.ExecutorReplicas = 0
+		status.ExecutorInstances = 0
+		status.DecommissionedExecutors = nil
 	}
 }
 
@@ -1452,13 +2105,16 @@ func (r *Reconciler) cleanUpOnTermination(ctx context.Context, _, newApp *v1beta
 			return err
 		}
 	}
+	if err := r.deleteExecutorPVCs(ctx, newApp); err != nil {
+		return err
+	}
 	return nil
 }
 
-// cleanUpPodTemplateFiles cleans up the driver and executor pod template files.
+// cleanUpPodTemplateFiles cleans up the driver and executor pod template files, and the Kerberos credentials.
 func (r *Reconciler) cleanUpPodTemplateFiles(ctx context.Context, app *v1beta2.SparkApplication) error {
 	logger := log.FromContext(ctx)
-	if app.Spec.Driver.Template == nil && app.Spec.Executor.Template == nil {
+	if app.Spec.Driver.Template == nil && app.Spec.Executor.Template == nil && app.Spec.HadoopSecurity == nil {
 		return nil
 	}
 	path := fmt.Sprintf("/tmp/spark/%s", app.Status.SubmissionID)
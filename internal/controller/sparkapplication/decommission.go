@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// podNodeNameField is the field index used to look up Spark pods by the node they are scheduled to.
+const podNodeNameField = "spec.nodeName"
+
+func indexPodByNodeName(obj client.Object) []string {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || pod.Spec.NodeName == "" {
+		return nil
+	}
+	return []string{pod.Spec.NodeName}
+}
+
+// SparkNodeEventHandler watches nodes and enqueues the SparkApplications whose executors
+// run on nodes that are being evicted.
+type SparkNodeEventHandler struct {
+	client client.Reader
+}
+
+// SparkNodeEventHandler implements handler.EventHandler.
+var _ handler.EventHandler = &SparkNodeEventHandler{}
+
+// NewSparkNodeEventHandler creates a new SparkNodeEventHandler instance.
+func NewSparkNodeEventHandler(client client.Reader) *SparkNodeEventHandler {
+	return &SparkNodeEventHandler{client: client}
+}
+
+// Create implements handler.EventHandler.
+func (h *SparkNodeEventHandler) Create(ctx context.Context, event event.CreateEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	node, ok := event.Object.(*corev1.Node)
+	if !ok || util.GetNodeEvictionReason(node) == "" {
+		return
+	}
+	h.enqueueSparkAppsOnNode(ctx, node, queue)
+}
+
+// Update implements handler.EventHandler.
+func (h *SparkNodeEventHandler) Update(ctx context.Context, event event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	oldNode, ok := event.ObjectOld.(*corev1.Node)
+	if !ok {
+		return
+	}
+
+	newNode, ok := event.ObjectNew.(*corev1.Node)
+	if !ok {
+		return
+	}
+
+	// Only react when the node starts being evicted.
+	if util.GetNodeEvictionReason(newNode) == "" || util.GetNodeEvictionReason(oldNode) != "" {
+		return
+	}
+	h.enqueueSparkAppsOnNode(ctx, newNode, queue)
+}
+
+// Delete implements handler.EventHandler.
+func (h *SparkNodeEventHandler) Delete(_ context.Context, _ event.DeleteEvent, _ workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+}
+
+// Generic implements handler.EventHandler.
+func (h *SparkNodeEventHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+}
+
+func (h *SparkNodeEventHandler) enqueueSparkAppsOnNode(ctx context.Context, node *corev1.Node, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
+	logger := log.FromContext(ctx)
+	pods := &corev1.PodList{}
+	if err := h.client.List(
+		ctx,
+		pods,
+		client.MatchingFields{podNodeNameField: node.Name},
+		client.MatchingLabels{common.LabelSparkRole: common.SparkRoleExecutor},
+	); err != nil {
+		logger.Error(err, "Failed to list executor pods on node", "node", node.Name)
+		return
+	}
+
+	enqueued := make(map[types.NamespacedName]bool)
+	for _, pod := range pods.Items {
+		appName := pod.Labels[common.LabelSparkAppName]
+		if appName == "" {
+			continue
+		}
+		key := types.NamespacedName{Name: appName, Namespace: pod.Namespace}
+		if enqueued[key] {
+			continue
+		}
+		enqueued[key] = true
+		logger.Info("Node is being evicted, enqueueing SparkApplication", "node", node.Name, "name", appName, "namespace", pod.Namespace)
+		queue.AddRateLimited(ctrl.Request{NamespacedName: key})
+	}
+}
+
+// decommissionExecutors gracefully deletes the executor pods running on nodes that are being evicted,
+// which triggers Spark's decommissioning through the executor preStop hook, and records them in the status.
+func (r *Reconciler) decommissionExecutors(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.Executor.DecommissionOnNodeEviction == nil || !*app.Spec.Executor.DecommissionOnNodeEviction {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	pods, err := r.getExecutorPods(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil || util.IsExecutorTerminated(util.GetExecutorState(&pod)) {
+			continue
+		}
+		if _, ok := app.Status.DecommissionedExecutors[pod.Name]; ok {
+			continue
+		}
+
+		node := &corev1.Node{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, node); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get node %s: %v", pod.Spec.NodeName, err)
+		}
+		reason := util.GetNodeEvictionReason(node)
+		if reason == "" {
+			continue
+		}
+
+		logger.Info("Decommissioning executor on evicted node", "pod", pod.Name, "node", node.Name, "reason", reason)
+		if err := r.client.Delete(ctx, &pod); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to decommission executor pod %s: %v", pod.Name, err)
+		}
+		if r.options.SparkExecutorMetrics != nil {
+			r.options.SparkExecutorMetrics.HandleSparkExecutorDecommission(&pod)
+		}
+
+		if app.Status.DecommissionedExecutors == nil {
+			app.Status.DecommissionedExecutors = make(map[string]v1beta2.ExecutorDecommission)
+		}
+		app.Status.DecommissionedExecutors[pod.Name] = v1beta2.ExecutorDecommission{
+			NodeName:         node.Name,
+			Reason:           reason,
+			DecommissionTime: metav1.Now(),
+		}
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeNormal,
+			common.EventSparkExecutorDecommissioning,
+			"Executor %s is being decommissioned as node %s is evicted: %s",
+			pod.Name,
+			node.Name,
+			reason,
+		)
+	}
+
+	return nil
+}
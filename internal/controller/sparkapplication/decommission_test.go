@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestExecutorDecommissionOption(t *testing.T) {
+	app := &v1beta2.SparkApplication{}
+	args, err := executorDecommissionOption(app)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+
+	app.Spec.Executor.DecommissionOnNodeEviction = ptr.To(true)
+	args, err = executorDecommissionOption(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--conf", fmt.Sprintf("%s=true", common.SparkDecommissionEnabled),
+		"--conf", fmt.Sprintf("%s=true", common.SparkStorageDecommissionEnabled),
+	}, args)
+
+	app.Spec.SparkConf = map[string]string{common.SparkStorageDecommissionEnabled: "false"}
+	args, err = executorDecommissionOption(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--conf", fmt.Sprintf("%s=true", common.SparkDecommissionEnabled)}, args)
+}
+
+func TestDecommissionExecutors(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Executor: v1beta2.ExecutorSpec{
+				DecommissionOnNodeEviction: ptr.To(true),
+			},
+		},
+	}
+
+	newExecutorPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					common.LabelSparkAppName: "test-app",
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			},
+			Spec:   corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	spotNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "spot-node"},
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{{Key: "cloud.google.com/impending-node-termination", Effect: corev1.TaintEffectNoSchedule}},
+		},
+	}
+	healthyNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "healthy-node"}}
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(spotNode, healthyNode, newExecutorPod("exec-1", "spot-node"), newExecutorPod("exec-2", "healthy-node")).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+
+	require.NoError(t, reconciler.decommissionExecutors(ctx, app))
+
+	err := client.Get(ctx, types.NamespacedName{Name: "exec-1", Namespace: "default"}, &corev1.Pod{})
+	assert.True(t, errors.IsNotFound(err))
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "exec-2", Namespace: "default"}, &corev1.Pod{}))
+
+	require.Len(t, app.Status.DecommissionedExecutors, 1)
+	decommission := app.Status.DecommissionedExecutors["exec-1"]
+	assert.Equal(t, "spot-node", decommission.NodeName)
+	assert.Equal(t, "NodeTainted(cloud.google.com/impending-node-termination)", decommission.Reason)
+	assert.Len(t, recorder.Events, 1)
+}
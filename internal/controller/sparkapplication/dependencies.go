@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// dependsOnField is the field index used to look up SparkApplications by the applications they depend on.
+const dependsOnField = "spec.dependsOn"
+
+func indexSparkApplicationByDependsOn(obj client.Object) []string {
+	app, ok := obj.(*v1beta2.SparkApplication)
+	if !ok {
+		return nil
+	}
+	return app.Spec.DependsOn
+}
+
+// mapSparkApplicationToDependents enqueues the new SparkApplications depending on the given application, so that
+// they are submitted as soon as their dependencies complete.
+func (r *Reconciler) mapSparkApplicationToDependents(ctx context.Context, obj client.Object) []reconcile.Request {
+	apps := &v1beta2.SparkApplicationList{}
+	if err := r.client.List(ctx, apps, client.InNamespace(obj.GetNamespace()), client.MatchingFields{dependsOnField: obj.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list dependent SparkApplications", "name", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, app := range apps.Items {
+		if app.Status.AppState.State != v1beta2.ApplicationStateNew {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name}})
+	}
+	return requests
+}
+
+// waitForDependencies holds the submission of the given SparkApplication until the applications it depends on have
+// completed, and fails it if any of them failed or they depend on each other. It returns whether the submission is held.
+func (r *Reconciler) waitForDependencies(ctx context.Context, app *v1beta2.SparkApplication) (bool, error) {
+	if len(app.Spec.DependsOn) == 0 {
+		return false, nil
+	}
+
+	var pending []string
+	for _, name := range app.Spec.DependsOn {
+		dependency := &v1beta2.SparkApplication{}
+		if err := r.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: name}, dependency); err != nil {
+			if !errors.IsNotFound(err) {
+				return false, err
+			}
+			pending = append(pending, name)
+			continue
+		}
+		switch dependency.Status.AppState.State {
+		case v1beta2.ApplicationStateCompleted:
+		case v1beta2.ApplicationStateFailed:
+			r.failDependent(app, fmt.Sprintf("dependency %s failed", name))
+			return true, nil
+		default:
+			pending = append(pending, name)
+		}
+	}
+	if len(pending) == 0 {
+		return false, nil
+	}
+
+	cycle, err := r.findDependencyCycle(ctx, app)
+	if err != nil {
+		return false, err
+	}
+	if cycle != nil {
+		r.failDependent(app, fmt.Sprintf("dependency cycle %s", strings.Join(cycle, " -> ")))
+		return true, nil
+	}
+
+	message := fmt.Sprintf("Submission is waiting for SparkApplications %s to complete", strings.Join(pending, ", "))
+	changed := meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               v1beta2.SparkApplicationConditionSubmissionQueued,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: app.Generation,
+		Reason:             v1beta2.SparkApplicationReasonDependenciesPending,
+		Message:            message,
+	})
+	if changed {
+		r.recorder.Event(app, corev1.EventTypeNormal, common.EventSparkApplicationDependenciesPending, message)
+	}
+	return true, nil
+}
+
+// failDependent fails the given SparkApplication without submitting it as its dependencies cannot complete.
+func (r *Reconciler) failDependent(app *v1beta2.SparkApplication, message string) {
+	meta.RemoveStatusCondition(&app.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+	app.Status.AppState = v1beta2.ApplicationState{
+		State:        v1beta2.ApplicationStateFailed,
+		ErrorMessage: message,
+	}
+	app.Status.TerminationTime = metav1.Now()
+	r.recordSparkApplicationEvent(app)
+}
+
+// findDependencyCycle returns the path of a cycle of pending SparkApplications depending on each other starting from
+// the given application, or nil if there is none. Terminated applications break cycles as they are not waiting.
+func (r *Reconciler) findDependencyCycle(ctx context.Context, app *v1beta2.SparkApplication) ([]string, error) {
+	path := []string{app.Name}
+	onPath := map[string]bool{app.Name: true}
+	visited := make(map[string]bool)
+
+	var visit func(names []string) ([]string, error)
+	visit = func(names []string) ([]string, error) {
+		for _, name := range names {
+			if onPath[name] {
+				return append(path, name), nil
+			}
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+
+			dependency := &v1beta2.SparkApplication{}
+			if err := r.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: name}, dependency); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if state := dependency.Status.AppState.State; state == v1beta2.ApplicationStateCompleted || state == v1beta2.ApplicationStateFailed {
+				continue
+			}
+
+			path = append(path, name)
+			onPath[name] = true
+			cycle, err := visit(dependency.Spec.DependsOn)
+			if cycle != nil || err != nil {
+				return cycle, err
+			}
+			path = path[:len(path)-1]
+			delete(onPath, name)
+		}
+		return nil, nil
+	}
+	return visit(app.Spec.DependsOn)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestWaitForDependencies(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	newApp := func(name string, state v1beta2.ApplicationStateType, dependsOn ...string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       v1beta2.SparkApplicationSpec{DependsOn: dependsOn},
+			Status:     v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		app          *v1beta2.SparkApplication
+		objs         []client.Object
+		wantWaiting  bool
+		wantState    v1beta2.ApplicationStateType
+		wantMessage  string
+		wantEventLen int
+	}{
+		{
+			name:      "no dependencies",
+			app:       newApp("load", v1beta2.ApplicationStateNew),
+			wantState: v1beta2.ApplicationStateNew,
+		},
+		{
+			name: "completed dependencies",
+			app:  newApp("load", v1beta2.ApplicationStateNew, "extract", "transform"),
+			objs: []client.Object{
+				newApp("extract", v1beta2.ApplicationStateCompleted),
+				newApp("transform", v1beta2.ApplicationStateCompleted),
+			},
+			wantState: v1beta2.ApplicationStateNew,
+		},
+		{
+			name: "pending dependencies",
+			app:  newApp("load", v1beta2.ApplicationStateNew, "extract", "transform", "missing"),
+			objs: []client.Object{
+				newApp("extract", v1beta2.ApplicationStateCompleted),
+				newApp("transform", v1beta2.ApplicationStateRunning),
+			},
+			wantWaiting:  true,
+			wantState:    v1beta2.ApplicationStateNew,
+			wantMessage:  "Submission is waiting for SparkApplications transform, missing to complete",
+			wantEventLen: 1,
+		},
+		{
+			name: "failed dependency",
+			app:  newApp("load", v1beta2.ApplicationStateNew, "extract", "transform"),
+			objs: []client.Object{
+				newApp("extract", v1beta2.ApplicationStateRunning),
+				newApp("transform", v1beta2.ApplicationStateFailed),
+			},
+			wantWaiting:  true,
+			wantState:    v1beta2.ApplicationStateFailed,
+			wantMessage:  "dependency transform failed",
+			wantEventLen: 1,
+		},
+		{
+			name: "dependency cycle",
+			app:  newApp("load", v1beta2.ApplicationStateNew, "extract"),
+			objs: []client.Object{
+				newApp("extract", v1beta2.ApplicationStateNew, "transform"),
+				newApp("transform", v1beta2.ApplicationStateNew, "load"),
+			},
+			wantWaiting:  true,
+			wantState:    v1beta2.ApplicationStateFailed,
+			wantMessage:  "dependency cycle load -> extract -> transform -> load",
+			wantEventLen: 1,
+		},
+		{
+			name: "cycle through a terminated application",
+			app:  newApp("load", v1beta2.ApplicationStateNew, "extract"),
+			objs: []client.Object{
+				newApp("extract", v1beta2.ApplicationStateNew, "transform"),
+				newApp("transform", v1beta2.ApplicationStateCompleted, "load"),
+			},
+			wantWaiting:  true,
+			wantState:    v1beta2.ApplicationStateNew,
+			wantMessage:  "Submission is waiting for SparkApplications extract to complete",
+			wantEventLen: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(3)
+			reconciler := &Reconciler{
+				client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objs...).Build(),
+				recorder: recorder,
+			}
+			app := tc.app.DeepCopy()
+
+			waiting, err := reconciler.waitForDependencies(ctx, app)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantWaiting, waiting)
+			assert.Equal(t, tc.wantState, app.Status.AppState.State)
+			assert.Len(t, recorder.Events, tc.wantEventLen)
+
+			condition := meta.FindStatusCondition(app.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+			switch {
+			case tc.wantState == v1beta2.ApplicationStateFailed:
+				assert.Nil(t, condition)
+				assert.Equal(t, tc.wantMessage, app.Status.AppState.ErrorMessage)
+			case tc.wantWaiting:
+				require.NotNil(t, condition)
+				assert.Equal(t, v1beta2.SparkApplicationReasonDependenciesPending, condition.Reason)
+				assert.Equal(t, tc.wantMessage, condition.Message)
+				assert.Contains(t, <-recorder.Events, common.EventSparkApplicationDependenciesPending)
+			default:
+				assert.Nil(t, condition)
+			}
+		})
+	}
+}
+
+func TestMapSparkApplicationToDependents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	newApp := func(name string, state v1beta2.ApplicationStateType, dependsOn ...string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       v1beta2.SparkApplicationSpec{DependsOn: dependsOn},
+			Status:     v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+		}
+	}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithIndex(&v1beta2.SparkApplication{}, dependsOnField, indexSparkApplicationByDependsOn).
+		WithObjects(
+			newApp("extract", v1beta2.ApplicationStateCompleted),
+			newApp("transform", v1beta2.ApplicationStateNew, "extract"),
+			newApp("report", v1beta2.ApplicationStateRunning, "extract"),
+			newApp("load", v1beta2.ApplicationStateNew, "transform"),
+		).
+		Build()
+	reconciler := &Reconciler{client: c}
+
+	requests := reconciler.mapSparkApplicationToDependents(context.Background(), newApp("extract", v1beta2.ApplicationStateCompleted))
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "transform"}}}, requests)
+}
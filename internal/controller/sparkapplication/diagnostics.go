@@ -0,0 +1,284 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+const (
+	// maxDiagnosticsSize bounds the size of the compressed dumps of a driver, which are streamed into memory
+	// and uploaded with a single request.
+	maxDiagnosticsSize = 64 << 20
+	// maxConcurrentDiagnosticsCollections bounds the number of dumps held in memory at once.
+	maxConcurrentDiagnosticsCollections = 2
+	// diagnosticsCollectionTimeout bounds the time to stream the dumps out of a driver pod and upload them.
+	diagnosticsCollectionTimeout = 10 * time.Minute
+	// diagnosticsPollInterval is the interval at which a failing application is requeued while the dumps of its
+	// driver are collected.
+	diagnosticsPollInterval = 5 * time.Second
+)
+
+// collectDiagnosticsScript writes a gzipped tarball of the diagnostics volume to stdout, or nothing if it is empty.
+var collectDiagnosticsScript = fmt.Sprintf(`cd %s && if [ -n "$(ls -A)" ]; then tar czf - .; fi`, common.DiagnosticsMountPath)
+
+// PodExecutor runs commands in containers of pods.
+type PodExecutor interface {
+	// Exec runs the command in the given container and writes its standard output to stdout.
+	Exec(ctx context.Context, pod *corev1.Pod, container string, command []string, stdout io.Writer) error
+}
+
+// restPodExecutor runs commands through the exec subresource of pods.
+type restPodExecutor struct {
+	config    *rest.Config
+	clientset kubernetes.Interface
+}
+
+// restPodExecutor implements PodExecutor.
+var _ PodExecutor = &restPodExecutor{}
+
+// NewPodExecutor creates a PodExecutor running commands through the API server with the given config.
+func NewPodExecutor(config *rest.Config) (PodExecutor, error) {
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &restPodExecutor{config: config, clientset: clientset}, nil
+}
+
+// Exec implements PodExecutor.
+func (e *restPodExecutor) Exec(ctx context.Context, pod *corev1.Pod, container string, command []string, stdout io.Writer) error {
+	req := e.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	executor, err := remotecommand.NewSPDYExecutor(e.config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	stderr := &bytes.Buffer{}
+	if err := executor.StreamWithContext(ctx, remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr}); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%v: %s", err, message)
+		}
+		return err
+	}
+	return nil
+}
+
+// limitedBuffer is a buffer failing writes beyond its limit.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		return 0, fmt.Errorf("dumps exceed %d bytes", b.limit)
+	}
+	return b.Buffer.Write(p)
+}
+
+// configDriverDiagnostics points the heap dumps and fatal error logs of the driver JVM to the diagnostics volume,
+// which is mounted into the driver pod by the mutating webhook.
+func configDriverDiagnostics(app *v1beta2.SparkApplication) {
+	if ptr.Deref(app.Spec.Driver.Diagnostics.HeapDumpOnOutOfMemoryError, true) {
+		app.Spec.Driver.JavaOptions = appendJavaOption(app.Spec.Driver.JavaOptions,
+			fmt.Sprintf("-XX:+HeapDumpOnOutOfMemoryError -XX:HeapDumpPath=%s", common.DiagnosticsMountPath))
+	}
+	app.Spec.Driver.JavaOptions = appendJavaOption(app.Spec.Driver.JavaOptions,
+		fmt.Sprintf("-XX:ErrorFile=%s/hs_err_pid%%p.log", common.DiagnosticsMountPath))
+}
+
+// diagnosticsCollector collects the dumps of failed drivers in the background, so that streaming them out of the
+// driver pods and uploading them does not hold up the reconcile workers.
+type diagnosticsCollector struct {
+	mutex       sync.Mutex
+	collections map[types.UID]*diagnosticsCollection
+	slots       chan struct{}
+}
+
+// diagnosticsCollection is the collection of the dumps of a driver pod.
+type diagnosticsCollection struct {
+	done     chan struct{}
+	finished time.Time
+	url      string
+	err      error
+}
+
+// newDiagnosticsCollector creates a new diagnosticsCollector.
+func newDiagnosticsCollector() *diagnosticsCollector {
+	return &diagnosticsCollector{
+		collections: make(map[types.UID]*diagnosticsCollection),
+		slots:       make(chan struct{}, maxConcurrentDiagnosticsCollections),
+	}
+}
+
+// collect starts collecting the dumps of the given driver pod with the given function unless it is already being
+// collected. It returns the collection, and whether it is done, in which case the collection is forgotten.
+func (c *diagnosticsCollector) collect(
+	ctx context.Context,
+	pod *corev1.Pod,
+	archive func(ctx context.Context) (string, error),
+) (*diagnosticsCollection, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	collection, ok := c.collections[pod.UID]
+	if !ok {
+		c.purge()
+		collection = &diagnosticsCollection{done: make(chan struct{})}
+		c.collections[pod.UID] = collection
+		// The collection outlives the reconcile that started it.
+		go c.run(context.WithoutCancel(ctx), collection, archive)
+	}
+
+	select {
+	case <-collection.done:
+		delete(c.collections, pod.UID)
+		return collection, true
+	default:
+		return collection, false
+	}
+}
+
+// run runs the given collection once one of the slots is free.
+func (c *diagnosticsCollector) run(
+	ctx context.Context,
+	collection *diagnosticsCollection,
+	archive func(ctx context.Context) (string, error),
+) {
+	ctx, cancel := context.WithTimeout(ctx, diagnosticsCollectionTimeout)
+	defer cancel()
+
+	select {
+	case c.slots <- struct{}{}:
+		collection.url, collection.err = archive(ctx)
+		<-c.slots
+	case <-ctx.Done():
+		collection.err = fmt.Errorf("timed out waiting for other collections: %v", ctx.Err())
+	}
+
+	c.mutex.Lock()
+	collection.finished = time.Now()
+	c.mutex.Unlock()
+	close(collection.done)
+}
+
+// purge forgets the collections that finished long ago, whose applications were not reconciled since, e.g.
+// because they were deleted. It must be called with the mutex held.
+func (c *diagnosticsCollector) purge() {
+	for uid, collection := range c.collections {
+		if !collection.finished.IsZero() && time.Since(collection.finished) > diagnosticsCollectionTimeout {
+			delete(c.collections, uid)
+		}
+	}
+}
+
+// collectDriverDiagnostics copies the dumps of the failed driver of the given application to the archive in the
+// background and stops the diagnostics collector once they are copied, so that the driver pod terminates. It
+// returns whether the collector is done, and the URL of the dumps, or an empty string if there are none or they
+// could not be collected, which is reported as an event. The collector is stopped either way, as a driver pod kept
+// running would hold on to the resources of the application.
+func (r *Reconciler) collectDriverDiagnostics(ctx context.Context, app *v1beta2.SparkApplication) (string, bool) {
+	logger := log.FromContext(ctx)
+
+	if !util.DriverDiagnosticsCollectionEnabled(app) || r.options.PodExecutor == nil {
+		return "", true
+	}
+	pod, err := r.getDriverPod(ctx, app)
+	if err != nil || pod == nil || !isDiagnosticsCollectorRunning(pod) {
+		return "", true
+	}
+
+	var url string
+	if app.Status.AppState.State == v1beta2.ApplicationStateFailing && r.options.Archiver != nil {
+		appCopy, podCopy := app.DeepCopy(), pod.DeepCopy()
+		collection, done := r.diagnostics.collect(ctx, pod, func(ctx context.Context) (string, error) {
+			return r.archiveDriverDiagnostics(ctx, appCopy, podCopy)
+		})
+		if !done {
+			return "", false
+		}
+		url = collection.url
+		if collection.err != nil {
+			logger.Error(collection.err, "Failed to collect driver diagnostics")
+			r.recorder.Eventf(app, corev1.EventTypeWarning, common.EventSparkApplicationDiagnosticsCollectionFailed,
+				"Failed to collect driver diagnostics: %v", collection.err)
+		} else if url != "" {
+			logger.Info("Collected driver diagnostics", "url", url)
+			r.recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkApplicationDiagnosticsCollected,
+				"Driver diagnostics collected to %s", url)
+		}
+	}
+
+	// The collector shell exits on SIGTERM, which it does not receive when running as PID 1 unless it traps it.
+	if err := r.options.PodExecutor.Exec(ctx, pod, common.DiagnosticsCollectorContainerName, []string{"kill", "1"}, io.Discard); err != nil {
+		logger.Error(err, "Failed to stop driver diagnostics collector")
+	}
+	return url, true
+}
+
+// archiveDriverDiagnostics streams the dumps out of the collector container of the driver pod and archives them.
+func (r *Reconciler) archiveDriverDiagnostics(ctx context.Context, app *v1beta2.SparkApplication, pod *corev1.Pod) (string, error) {
+	dumps := &limitedBuffer{limit: maxDiagnosticsSize}
+	command := []string{"sh", "-c", collectDiagnosticsScript}
+	if err := r.options.PodExecutor.Exec(ctx, pod, common.DiagnosticsCollectorContainerName, command, dumps); err != nil {
+		return "", err
+	}
+	if dumps.Len() == 0 {
+		return "", nil
+	}
+	return r.options.Archiver.ArchiveDiagnostics(ctx, app, dumps.Bytes())
+}
+
+// isDiagnosticsCollectorRunning returns whether the diagnostics collector of the given driver pod is running.
+func isDiagnosticsCollectorRunning(pod *corev1.Pod) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == common.DiagnosticsCollectorContainerName {
+			return status.State.Running != nil
+		}
+	}
+	return false
+}
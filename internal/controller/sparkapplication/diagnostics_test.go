@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/archive"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// fakePodExecutor records the commands run in pods and writes output to their stdout, once unblocked if blocked.
+type fakePodExecutor struct {
+	commands []string
+	output   string
+	err      error
+	blocked  chan struct{}
+}
+
+func (e *fakePodExecutor) Exec(_ context.Context, pod *corev1.Pod, container string, command []string, stdout io.Writer) error {
+	e.commands = append(e.commands, fmt.Sprintf("%s/%s: %s", pod.Name, container, strings.Join(command, " ")))
+	if e.blocked != nil {
+		<-e.blocked
+	}
+	if e.err != nil {
+		return e.err
+	}
+	_, err := io.WriteString(stdout, e.output)
+	return err
+}
+
+func TestConfigDriverDiagnostics(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				JavaOptions: ptr.To("-Xss4m"),
+				Diagnostics: &v1beta2.DriverDiagnostics{},
+			},
+		},
+	}
+	configDriverDiagnostics(app)
+	assert.Equal(t, "-Xss4m -XX:+HeapDumpOnOutOfMemoryError -XX:HeapDumpPath=/var/spark/diagnostics "+
+		"-XX:ErrorFile=/var/spark/diagnostics/hs_err_pid%p.log", *app.Spec.Driver.JavaOptions)
+
+	app.Spec.Driver.JavaOptions = nil
+	app.Spec.Driver.Diagnostics.HeapDumpOnOutOfMemoryError = ptr.To(false)
+	configDriverDiagnostics(app)
+	assert.Equal(t, "-XX:ErrorFile=/var/spark/diagnostics/hs_err_pid%p.log", *app.Spec.Driver.JavaOptions)
+}
+
+func TestCollectDriverDiagnostics(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				Diagnostics: &v1beta2.DriverDiagnostics{Collect: ptr.To(true)},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID: "submission-1",
+			AppState:     v1beta2.ApplicationState{State: v1beta2.ApplicationStateFailing},
+			DriverInfo:   v1beta2.DriverInfo{PodName: "test-app-driver"},
+		},
+	}
+	newDriverPod := func(collector corev1.ContainerState) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default", UID: "driver-uid"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: common.SparkDriverContainerName, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1}}},
+					{Name: common.DiagnosticsCollectorContainerName, State: collector},
+				},
+			},
+		}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+	options := archive.Options{PathFormat: "{{$appNamespace}}/{{$appName}}-{{$submissionID}}"}
+	newReconciler := func(executor PodExecutor, store archive.Store, objs ...runtime.Object) (*Reconciler, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(3)
+		r := &Reconciler{
+			client:   fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			recorder: recorder,
+			options:  Options{PodExecutor: executor},
+
+			diagnostics: newDiagnosticsCollector(),
+		}
+		if store != nil {
+			r.options.Archiver = archive.NewArchiver(kubefake.NewSimpleClientset(), store, "", options)
+		}
+		return r, recorder
+	}
+	// collect collects the diagnostics of the application until the collection in the background is done.
+	collect := func(t *testing.T, r *Reconciler, app *v1beta2.SparkApplication) string {
+		var url string
+		require.Eventually(t, func() bool {
+			var done bool
+			url, done = r.collectDriverDiagnostics(ctx, app)
+			return done
+		}, 5*time.Second, 10*time.Millisecond)
+		return url
+	}
+
+	t.Run("dumps of failed driver are collected", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps"}
+		store := &fakeArchiveStore{}
+		r, recorder := newReconciler(executor, store, newDriverPod(running))
+
+		url := collect(t, r, app.DeepCopy())
+		assert.Equal(t, "gs://archive/default/test-app-submission-1/diagnostics.tar.gz", url)
+		assert.Equal(t, 1, store.puts)
+		assert.Equal(t, []string{
+			"test-app-driver/spark-diagnostics-collector: sh -c " + collectDiagnosticsScript,
+			"test-app-driver/spark-diagnostics-collector: kill 1",
+		}, executor.commands)
+		assert.Equal(t, "Normal SparkApplicationDiagnosticsCollected Driver diagnostics collected to "+url, <-recorder.Events)
+	})
+
+	t.Run("dumps are collected in the background", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps", blocked: make(chan struct{})}
+		store := &fakeArchiveStore{}
+		r, _ := newReconciler(executor, store, newDriverPod(running))
+
+		url, done := r.collectDriverDiagnostics(ctx, app.DeepCopy())
+		assert.Empty(t, url)
+		assert.False(t, done)
+		url, done = r.collectDriverDiagnostics(ctx, app.DeepCopy())
+		assert.Empty(t, url)
+		assert.False(t, done)
+
+		close(executor.blocked)
+		assert.Equal(t, "gs://archive/default/test-app-submission-1/diagnostics.tar.gz", collect(t, r, app.DeepCopy()))
+		assert.Equal(t, 1, store.puts)
+		assert.Empty(t, r.diagnostics.collections)
+	})
+
+	t.Run("nothing is uploaded without dumps", func(t *testing.T) {
+		executor := &fakePodExecutor{}
+		store := &fakeArchiveStore{}
+		r, recorder := newReconciler(executor, store, newDriverPod(running))
+
+		assert.Empty(t, collect(t, r, app.DeepCopy()))
+		assert.Zero(t, store.puts)
+		assert.Len(t, executor.commands, 2)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("collector is stopped when collection fails", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps"}
+		store := &fakeArchiveStore{err: fmt.Errorf("access denied")}
+		r, recorder := newReconciler(executor, store, newDriverPod(running))
+
+		assert.Empty(t, collect(t, r, app.DeepCopy()))
+		assert.Equal(t, "test-app-driver/spark-diagnostics-collector: kill 1", executor.commands[1])
+		assert.Equal(t, "Warning SparkApplicationDiagnosticsCollectionFailed Failed to collect driver diagnostics: access denied", <-recorder.Events)
+	})
+
+	t.Run("collector of successful driver is only stopped", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps"}
+		store := &fakeArchiveStore{}
+		r, _ := newReconciler(executor, store, newDriverPod(running))
+
+		succeeding := app.DeepCopy()
+		succeeding.Status.AppState.State = v1beta2.ApplicationStateSucceeding
+		assert.Empty(t, collect(t, r, succeeding))
+		assert.Zero(t, store.puts)
+		assert.Equal(t, []string{"test-app-driver/spark-diagnostics-collector: kill 1"}, executor.commands)
+	})
+
+	t.Run("collector is stopped without archive", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps"}
+		r, _ := newReconciler(executor, nil, newDriverPod(running))
+
+		assert.Empty(t, collect(t, r, app.DeepCopy()))
+		assert.Equal(t, []string{"test-app-driver/spark-diagnostics-collector: kill 1"}, executor.commands)
+	})
+
+	t.Run("stopped collector is left alone", func(t *testing.T) {
+		executor := &fakePodExecutor{output: "dumps"}
+		store := &fakeArchiveStore{}
+		r, _ := newReconciler(executor, store, newDriverPod(corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}))
+
+		assert.Empty(t, collect(t, r, app.DeepCopy()))
+		assert.Empty(t, executor.commands)
+	})
+
+	t.Run("dumps beyond the size limit are not collected", func(t *testing.T) {
+		buffer := &limitedBuffer{limit: 4}
+		_, err := buffer.Write([]byte("dumps"))
+		assert.EqualError(t, err, "dumps exceed 4 bytes")
+	})
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// driftCorrection describes how a resource owned by a SparkApplication had drifted from its desired state.
+type driftCorrection string
+
+const (
+	driftCorrectionNone      driftCorrection = ""
+	driftCorrectionRecreated driftCorrection = "recreated"
+	driftCorrectionRepaired  driftCorrection = "repaired"
+)
+
+// correctDrift recreates the web UI and driver ingress services and ingresses, and the Prometheus and logging
+// ConfigMaps, of a running SparkApplication that were deleted out-of-band, and repairs those that were modified.
+// Corrections are reported in a single event. It is a no-op unless drift correction is enabled.
+func (r *Reconciler) correctDrift(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if !r.options.EnableDriftCorrection {
+		return nil
+	}
+
+	var corrections []string
+	record := func(kind, name string, correction driftCorrection) {
+		if correction != driftCorrectionNone {
+			corrections = append(corrections, fmt.Sprintf("%s %s (%s)", kind, name, correction))
+		}
+	}
+
+	if app.Status.DriverInfo.WebUIServiceName != "" {
+		desired, err := buildWebUIService(app)
+		if err != nil {
+			return err
+		}
+		r.propagateMetadata(app, desired)
+		service, correction, err := r.correctServiceDrift(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("failed to correct drift of web UI service: %v", err)
+		}
+		record("Service", desired.Name, correction)
+		if correction == driftCorrectionRecreated {
+			app.Status.DriverInfo.WebUIAddress = fmt.Sprintf("%s:%d", service.Spec.ClusterIP, app.Status.DriverInfo.WebUIPort)
+		}
+
+		if app.Status.DriverInfo.WebUIIngressName != "" && r.options.IngressURLFormat != "" && util.IngressCapabilities.Has("networking.k8s.io/v1") {
+			ingressURL, err := getDriverIngressURL(r.options.IngressURLFormat, app)
+			if err != nil {
+				return fmt.Errorf("failed to get ingress url: %v", err)
+			}
+			desired := buildDriverIngressV1(app, newSparkService(desired), app.Status.DriverInfo.WebUIIngressName, ingressURL,
+				r.options.IngressClassName, r.options.IngressTLS, r.options.IngressAnnotations)
+			correction, err := r.correctIngressDrift(ctx, desired)
+			if err != nil {
+				return fmt.Errorf("failed to correct drift of web UI ingress: %v", err)
+			}
+			record("Ingress", desired.Name, correction)
+		}
+	}
+
+	for _, driverIngressConfiguration := range app.Spec.DriverIngressOptions {
+		desired, err := buildDriverIngressServiceFromConfiguration(app, &driverIngressConfiguration)
+		if err != nil {
+			return err
+		}
+		r.propagateMetadata(app, desired)
+		_, correction, err := r.correctServiceDrift(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("failed to correct drift of driver ingress service: %v", err)
+		}
+		record("Service", desired.Name, correction)
+
+		if driverIngressConfiguration.IngressURLFormat == "" || !util.IngressCapabilities.Has("networking.k8s.io/v1") {
+			continue
+		}
+		ingressURL, err := getDriverIngressURL(driverIngressConfiguration.IngressURLFormat, app)
+		if err != nil {
+			return fmt.Errorf("failed to get driver ingress url: %v", err)
+		}
+		desiredIngress := buildDriverIngressV1(app, newSparkService(desired), getDriverIngressName(app, *driverIngressConfiguration.ServicePort),
+			ingressURL, r.options.IngressClassName, []networkingv1.IngressTLS{}, map[string]string{})
+		correction, err = r.correctIngressDrift(ctx, desiredIngress)
+		if err != nil {
+			return fmt.Errorf("failed to correct drift of driver ingress: %v", err)
+		}
+		record("Ingress", desiredIngress.Name, correction)
+	}
+
+	if util.PrometheusMonitoringEnabled(app) && (!util.HasMetricsPropertiesFile(app) || !util.HasPrometheusConfigFile(app)) {
+		desired := buildPrometheusConfigMap(app, util.GetPrometheusConfigMapName(app))
+		correction, err := r.correctConfigMapDrift(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("failed to correct drift of Prometheus ConfigMap: %v", err)
+		}
+		record("ConfigMap", desired.Name, correction)
+	}
+
+	if util.JSONLoggingEnabled(app) {
+		desired := buildLoggingConfigMap(app)
+		correction, err := r.correctConfigMapDrift(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("failed to correct drift of logging ConfigMap: %v", err)
+		}
+		record("ConfigMap", desired.Name, correction)
+	}
+
+	if len(corrections) > 0 {
+		log.FromContext(ctx).Info("Corrected drifted resources of SparkApplication", "resources", corrections)
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeNormal,
+			common.EventSparkApplicationDriftCorrected,
+			"SparkApplication %s had resources deleted or modified out-of-band: %s",
+			app.Name,
+			strings.Join(corrections, ", "),
+		)
+	}
+	return nil
+}
+
+// correctServiceDrift recreates the given service if it is missing, or restores its ports, selector, type,
+// labels and annotations if they were changed. Fields set by the API server or other controllers are kept.
+func (r *Reconciler) correctServiceDrift(ctx context.Context, desired *corev1.Service) (*corev1.Service, driftCorrection, error) {
+	existing := &corev1.Service{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, driftCorrectionNone, err
+		}
+		correction, err := r.recreate(ctx, desired)
+		return desired, correction, err
+	}
+
+	drifted := !containsAll(existing.Labels, desired.Labels) ||
+		!containsAll(existing.Annotations, desired.Annotations) ||
+		!equality.Semantic.DeepEqual(existing.Spec.Selector, desired.Spec.Selector) ||
+		existing.Spec.Type != desired.Spec.Type ||
+		len(existing.Spec.Ports) != len(desired.Spec.Ports)
+	for i := 0; !drifted && i < len(desired.Spec.Ports); i++ {
+		drifted = existing.Spec.Ports[i].Name != desired.Spec.Ports[i].Name ||
+			existing.Spec.Ports[i].Port != desired.Spec.Ports[i].Port ||
+			existing.Spec.Ports[i].TargetPort != desired.Spec.Ports[i].TargetPort
+	}
+	if !drifted {
+		return existing, driftCorrectionNone, nil
+	}
+
+	existing.Labels = mergeStringMaps(existing.Labels, desired.Labels)
+	existing.Annotations = mergeStringMaps(existing.Annotations, desired.Annotations)
+	existing.Spec.Selector = desired.Spec.Selector
+	existing.Spec.Type = desired.Spec.Type
+	existing.Spec.Ports = desired.Spec.Ports
+	if err := r.client.Update(ctx, existing); err != nil {
+		return nil, driftCorrectionNone, err
+	}
+	return existing, driftCorrectionRepaired, nil
+}
+
+// correctIngressDrift recreates the given ingress if it is missing, or restores its rules, TLS, class,
+// labels and annotations if they were changed.
+func (r *Reconciler) correctIngressDrift(ctx context.Context, desired *networkingv1.Ingress) (driftCorrection, error) {
+	existing := &networkingv1.Ingress{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return driftCorrectionNone, err
+		}
+		return r.recreate(ctx, desired)
+	}
+
+	// The ingress class is only compared if set, as a default class may be assigned on admission otherwise.
+	if containsAll(existing.Labels, desired.Labels) &&
+		containsAll(existing.Annotations, desired.Annotations) &&
+		equality.Semantic.DeepEqual(existing.Spec.Rules, desired.Spec.Rules) &&
+		equality.Semantic.DeepEqual(existing.Spec.TLS, desired.Spec.TLS) &&
+		(desired.Spec.IngressClassName == nil || equality.Semantic.DeepEqual(existing.Spec.IngressClassName, desired.Spec.IngressClassName)) {
+		return driftCorrectionNone, nil
+	}
+
+	existing.Labels = mergeStringMaps(existing.Labels, desired.Labels)
+	existing.Annotations = mergeStringMaps(existing.Annotations, desired.Annotations)
+	existing.Spec.Rules = desired.Spec.Rules
+	existing.Spec.TLS = desired.Spec.TLS
+	if desired.Spec.IngressClassName != nil {
+		existing.Spec.IngressClassName = desired.Spec.IngressClassName
+	}
+	if err := r.client.Update(ctx, existing); err != nil {
+		return driftCorrectionNone, err
+	}
+	return driftCorrectionRepaired, nil
+}
+
+// correctConfigMapDrift recreates the given ConfigMap if it is missing, or restores its data if it was changed.
+func (r *Reconciler) correctConfigMapDrift(ctx context.Context, desired *corev1.ConfigMap) (driftCorrection, error) {
+	existing := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return driftCorrectionNone, err
+		}
+		return r.recreate(ctx, desired)
+	}
+
+	if equality.Semantic.DeepEqual(existing.Data, desired.Data) && containsAll(existing.Labels, desired.Labels) {
+		return driftCorrectionNone, nil
+	}
+
+	existing.Labels = mergeStringMaps(existing.Labels, desired.Labels)
+	existing.Data = desired.Data
+	if err := r.client.Update(ctx, existing); err != nil {
+		return driftCorrectionNone, err
+	}
+	return driftCorrectionRepaired, nil
+}
+
+// recreate creates the given object found missing. An object that already exists was created since the
+// cache was last synced, so it is not reported as recreated.
+func (r *Reconciler) recreate(ctx context.Context, obj client.Object) (driftCorrection, error) {
+	if err := r.client.Create(ctx, obj); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return driftCorrectionNone, nil
+		}
+		return driftCorrectionNone, err
+	}
+	return driftCorrectionRecreated, nil
+}
+
+func newSparkService(service *corev1.Service) SparkService {
+	return SparkService{
+		serviceName:     service.Name,
+		serviceType:     service.Spec.Type,
+		servicePort:     service.Spec.Ports[0].Port,
+		servicePortName: service.Spec.Ports[0].Name,
+		targetPort:      service.Spec.Ports[0].TargetPort,
+	}
+}
+
+// containsAll returns whether m contains all the entries of entries.
+func containsAll(m map[string]string, entries map[string]string) bool {
+	for key, value := range entries {
+		if v, ok := m[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeStringMaps(m map[string]string, entries map[string]string) map[string]string {
+	if len(entries) == 0 {
+		return m
+	}
+	if m == nil {
+		m = make(map[string]string, len(entries))
+	}
+	for key, value := range entries {
+		m[key] = value
+	}
+	return m
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func TestCorrectDrift(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	util.IngressCapabilities = util.Capabilities{"networking.k8s.io/v1": true}
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Logging: &v1beta2.LoggingSpec{Format: v1beta2.LogFormatJSON},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			DriverInfo: v1beta2.DriverInfo{
+				WebUIServiceName: "test-app-ui-svc",
+				WebUIIngressName: "test-app-ui-ingress",
+				WebUIPort:        4040,
+			},
+		},
+	}
+	serviceKey := types.NamespacedName{Name: "test-app-ui-svc", Namespace: "default"}
+	ingressKey := types.NamespacedName{Name: "test-app-ui-ingress", Namespace: "default"}
+	configMapKey := types.NamespacedName{Name: util.GetLoggingConfigMapName(app), Namespace: "default"}
+
+	newReconciler := func(enabled bool, objs ...runtime.Object) (*Reconciler, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(3)
+		return &Reconciler{
+			client:   fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(),
+			recorder: recorder,
+			options: Options{
+				EnableDriftCorrection: enabled,
+				EnableUIService:       true,
+				IngressURLFormat:      "{{$appName}}.example.com",
+			},
+		}, recorder
+	}
+
+	desiredService, err := buildWebUIService(app)
+	require.NoError(t, err)
+	ingressURL, err := getDriverIngressURL("{{$appName}}.example.com", app)
+	require.NoError(t, err)
+	desiredIngress := buildDriverIngressV1(app, newSparkService(desiredService), "test-app-ui-ingress", ingressURL, "", nil, nil)
+	desiredConfigMap := buildLoggingConfigMap(app)
+
+	t.Run("drift correction disabled", func(t *testing.T) {
+		reconciler, recorder := newReconciler(false)
+		require.NoError(t, reconciler.correctDrift(ctx, app.DeepCopy()))
+
+		assert.Error(t, reconciler.client.Get(ctx, serviceKey, &corev1.Service{}))
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("no drift", func(t *testing.T) {
+		reconciler, recorder := newReconciler(true, desiredService.DeepCopy(), desiredIngress.DeepCopy(), desiredConfigMap.DeepCopy())
+		require.NoError(t, reconciler.correctDrift(ctx, app.DeepCopy()))
+
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("recreate deleted resources", func(t *testing.T) {
+		reconciler, recorder := newReconciler(true)
+		app := app.DeepCopy()
+		require.NoError(t, reconciler.correctDrift(ctx, app))
+
+		service := &corev1.Service{}
+		require.NoError(t, reconciler.client.Get(ctx, serviceKey, service))
+		assert.Equal(t, desiredService.Spec.Selector, service.Spec.Selector)
+		assert.Equal(t, service.Spec.ClusterIP+":4040", app.Status.DriverInfo.WebUIAddress)
+		ingress := &networkingv1.Ingress{}
+		require.NoError(t, reconciler.client.Get(ctx, ingressKey, ingress))
+		assert.Equal(t, desiredIngress.Spec.Rules, ingress.Spec.Rules)
+		configMap := &corev1.ConfigMap{}
+		require.NoError(t, reconciler.client.Get(ctx, configMapKey, configMap))
+		assert.Equal(t, desiredConfigMap.Data, configMap.Data)
+
+		require.Len(t, recorder.Events, 1)
+		event := <-recorder.Events
+		assert.Contains(t, event, common.EventSparkApplicationDriftCorrected)
+		assert.Contains(t, event, "Service test-app-ui-svc (recreated)")
+		assert.Contains(t, event, "Ingress test-app-ui-ingress (recreated)")
+		assert.Contains(t, event, "ConfigMap test-app-log4j2 (recreated)")
+	})
+
+	t.Run("repair modified resources", func(t *testing.T) {
+		service := desiredService.DeepCopy()
+		service.Spec.Selector = map[string]string{"app": "other"}
+		service.Spec.ClusterIP = "10.0.0.1"
+		service.Annotations = map[string]string{"external": "kept"}
+		ingress := desiredIngress.DeepCopy()
+		ingress.Spec.Rules[0].Host = "other.example.com"
+		configMap := desiredConfigMap.DeepCopy()
+		configMap.Data = map[string]string{common.Log4j2ConfigKey: "modified"}
+		reconciler, recorder := newReconciler(true, service, ingress, configMap)
+		app := app.DeepCopy()
+		require.NoError(t, reconciler.correctDrift(ctx, app))
+
+		require.NoError(t, reconciler.client.Get(ctx, serviceKey, service))
+		assert.Equal(t, desiredService.Spec.Selector, service.Spec.Selector)
+		assert.Equal(t, "10.0.0.1", service.Spec.ClusterIP)
+		assert.Equal(t, "kept", service.Annotations["external"])
+		assert.Empty(t, app.Status.DriverInfo.WebUIAddress)
+		require.NoError(t, reconciler.client.Get(ctx, ingressKey, ingress))
+		assert.Equal(t, "test-app.example.com", ingress.Spec.Rules[0].Host)
+		require.NoError(t, reconciler.client.Get(ctx, configMapKey, configMap))
+		assert.Equal(t, desiredConfigMap.Data, configMap.Data)
+
+		require.Len(t, recorder.Events, 1)
+		event := <-recorder.Events
+		assert.Contains(t, event, "Service test-app-ui-svc (repaired)")
+		assert.Contains(t, event, "Ingress test-app-ui-ingress (repaired)")
+		assert.Contains(t, event, "ConfigMap test-app-log4j2 (repaired)")
+	})
+}
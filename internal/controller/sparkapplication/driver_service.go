@@ -0,0 +1,356 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// driverServiceRequeueInterval is how often the external addresses of the driver service are looked up until the
+// load balancer or the Routes have been provisioned.
+const driverServiceRequeueInterval = 15 * time.Second
+
+// routeGVK is the kind of the OpenShift Routes exposing the ports of the driver service.
+var routeGVK = schema.GroupVersionKind{Group: "route.openshift.io", Version: "v1", Kind: "Route"}
+
+// buildDriverService builds the Service exposing the ports of spec.driver.service of the given SparkApplication.
+func buildDriverService(app *v1beta2.SparkApplication) *corev1.Service {
+	spec := app.Spec.Driver.Service
+	serviceType := corev1.ServiceType(spec.Type)
+	if spec.Type == "" || spec.Type == v1beta2.DriverServiceTypeRoute {
+		serviceType = corev1.ServiceTypeClusterIP
+	}
+
+	labels := util.GetDependentResourceLabels(app)
+	maps.Copy(labels, spec.Labels)
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetDriverServiceName(app),
+			Namespace:       app.Namespace,
+			Labels:          labels,
+			Annotations:     maps.Clone(spec.Annotations),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Spec: corev1.ServiceSpec{
+			Type: serviceType,
+			Selector: map[string]string{
+				common.LabelSparkAppName: app.Name,
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+		},
+	}
+	for _, port := range spec.Ports {
+		servicePort := corev1.ServicePort{
+			Name:       port.Name,
+			Port:       port.Port,
+			TargetPort: intstr.FromInt32(ptr.Deref(port.TargetPort, port.Port)),
+			Protocol:   ptr.Deref(port.Protocol, corev1.ProtocolTCP),
+		}
+		if serviceType != corev1.ServiceTypeClusterIP {
+			servicePort.NodePort = ptr.Deref(port.NodePort, 0)
+		}
+		service.Spec.Ports = append(service.Spec.Ports, servicePort)
+	}
+	return service
+}
+
+// buildDriverServiceRoute builds the OpenShift Route exposing the given port of the driver service.
+func buildDriverServiceRoute(app *v1beta2.SparkApplication, service *corev1.Service, port string) *unstructured.Unstructured {
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(routeGVK)
+	route.SetName(getDriverServiceRouteName(app, port))
+	route.SetNamespace(app.Namespace)
+	route.SetLabels(service.Labels)
+	route.SetOwnerReferences(service.OwnerReferences)
+	route.Object["spec"] = map[string]any{
+		"to": map[string]any{
+			"kind": "Service",
+			"name": service.Name,
+		},
+		"port": map[string]any{
+			"targetPort": port,
+		},
+	}
+	return route
+}
+
+func getDriverServiceRouteName(app *v1beta2.SparkApplication, port string) string {
+	return fmt.Sprintf("%s-%s", util.GetDriverServiceName(app), port)
+}
+
+// createDriverService creates or updates the Service exposing the ports of spec.driver.service of the given
+// SparkApplication, and the Routes exposing them if requested, and records their addresses in its status.
+func (r *Reconciler) createDriverService(ctx context.Context, app *v1beta2.SparkApplication) error {
+	logger := log.FromContext(ctx)
+	service := buildDriverService(app)
+	r.propagateMetadata(app, service)
+	if err := r.client.Create(ctx, service); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create driver service %s: %v", service.Name, err)
+		}
+		// Keep the addresses allocated to the existing Service, so that they are stable across submissions.
+		existing := &corev1.Service{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: service.Name, Namespace: service.Namespace}, existing); err != nil {
+			return fmt.Errorf("failed to get driver service %s: %v", service.Name, err)
+		}
+		existing.Labels = service.Labels
+		existing.Annotations = service.Annotations
+		existing.Spec.Type = service.Spec.Type
+		existing.Spec.Ports = mergeServicePorts(service.Spec.Ports, existing.Spec.Ports)
+		if err := r.client.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update driver service %s: %v", service.Name, err)
+		}
+		service = existing
+		logger.Info("Updated driver service for SparkApplication", "name", service.Name)
+	} else {
+		logger.Info("Created driver service for SparkApplication", "name", service.Name)
+	}
+
+	hosts := make(map[string]string)
+	if app.Spec.Driver.Service.Type == v1beta2.DriverServiceTypeRoute {
+		for _, port := range service.Spec.Ports {
+			route := buildDriverServiceRoute(app, service, port.Name)
+			if err := r.client.Create(ctx, route); err != nil {
+				if !errors.IsAlreadyExists(err) {
+					return fmt.Errorf("failed to create route %s: %v", route.GetName(), err)
+				}
+				if err := r.client.Get(ctx, types.NamespacedName{Name: route.GetName(), Namespace: route.GetNamespace()}, route); err != nil {
+					return fmt.Errorf("failed to get route %s: %v", route.GetName(), err)
+				}
+			} else {
+				logger.Info("Created route for SparkApplication", "name", route.GetName())
+			}
+			hosts[port.Name], _, _ = unstructured.NestedString(route.Object, "spec", "host")
+		}
+	}
+
+	setDriverServiceStatus(app, service, hosts)
+	return nil
+}
+
+// buildDriverHeadlessService builds the headless Service created by the operator for the driver of the given
+// SparkApplication, exposing the same ports as the one created by Spark.
+func buildDriverHeadlessService(app *v1beta2.SparkApplication) *corev1.Service {
+	labels := util.GetDependentResourceLabels(app)
+	maps.Copy(labels, app.Spec.Driver.ServiceLabels)
+	uiPort, _ := getWebUITargetPort(app)
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetDriverHeadlessServiceName(app),
+			Namespace:       app.Namespace,
+			Labels:          labels,
+			Annotations:     maps.Clone(app.Spec.Driver.ServiceAnnotations),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				common.LabelSparkAppName: app.Name,
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+			Ports: []corev1.ServicePort{
+				newTCPServicePort(common.SparkDriverPortName, getSparkConfPort(app, common.DefaultSparkDriverPort, common.SparkDriverPort)),
+				newTCPServicePort(common.SparkBlockManagerPortName, getSparkConfPort(app, common.DefaultSparkBlockManagerPort,
+					common.SparkDriverBlockManagerPort, common.SparkBlockManagerPort)),
+				newTCPServicePort(common.DefaultSparkWebUIPortName, uiPort),
+			},
+			PublishNotReadyAddresses: ptr.Deref(app.Spec.Driver.HeadlessService.PublishNotReadyAddresses, false),
+		},
+	}
+}
+
+func newTCPServicePort(name string, port int32) corev1.ServicePort {
+	return corev1.ServicePort{
+		Name:       name,
+		Port:       port,
+		TargetPort: intstr.FromInt32(port),
+		Protocol:   corev1.ProtocolTCP,
+	}
+}
+
+// getSparkConfPort returns the port set by the first of the given Spark configuration properties present in
+// Spec.SparkConf, or the given default port.
+func getSparkConfPort(app *v1beta2.SparkApplication, defaultPort int32, keys ...string) int32 {
+	for _, key := range keys {
+		if value, ok := app.Spec.SparkConf[key]; ok {
+			if port, err := strconv.ParseInt(value, 10, 32); err == nil && port > 0 {
+				return int32(port)
+			}
+		}
+	}
+	return defaultPort
+}
+
+// createDriverHeadlessService creates or updates the headless Service requested for the driver of the given
+// SparkApplication, and makes the headless Service created by Spark publish the address of the driver before it is
+// ready if requested.
+func (r *Reconciler) createDriverHeadlessService(ctx context.Context, app *v1beta2.SparkApplication) error {
+	logger := log.FromContext(ctx)
+	if util.GetDriverHeadlessServiceName(app) != "" {
+		service := buildDriverHeadlessService(app)
+		r.propagateMetadata(app, service)
+		if err := r.client.Create(ctx, service); err != nil {
+			if !errors.IsAlreadyExists(err) {
+				return fmt.Errorf("failed to create driver headless service %s: %v", service.Name, err)
+			}
+			existing := &corev1.Service{}
+			if err := r.client.Get(ctx, types.NamespacedName{Name: service.Name, Namespace: service.Namespace}, existing); err != nil {
+				return fmt.Errorf("failed to get driver headless service %s: %v", service.Name, err)
+			}
+			existing.Labels = service.Labels
+			existing.Annotations = service.Annotations
+			existing.Spec.Ports = service.Spec.Ports
+			existing.Spec.PublishNotReadyAddresses = service.Spec.PublishNotReadyAddresses
+			if err := r.client.Update(ctx, existing); err != nil {
+				return fmt.Errorf("failed to update driver headless service %s: %v", service.Name, err)
+			}
+			logger.Info("Updated driver headless service for SparkApplication", "name", service.Name)
+		} else {
+			logger.Info("Created driver headless service for SparkApplication", "name", service.Name)
+		}
+	}
+
+	if !ptr.Deref(app.Spec.Driver.HeadlessService.PublishNotReadyAddresses, false) || app.Status.SparkApplicationID == "" {
+		return nil
+	}
+	// The headless Service created by Spark selects the driver pod by the ID of the application.
+	services := &corev1.ServiceList{}
+	if err := r.client.List(ctx, services, client.InNamespace(app.Namespace)); err != nil {
+		return fmt.Errorf("failed to list services: %v", err)
+	}
+	for i := range services.Items {
+		service := &services.Items[i]
+		if service.Spec.ClusterIP != corev1.ClusterIPNone || service.Spec.PublishNotReadyAddresses ||
+			service.Spec.Selector[common.LabelSparkApplicationSelector] != app.Status.SparkApplicationID ||
+			service.Spec.Selector[common.LabelSparkRole] != common.SparkRoleDriver {
+			continue
+		}
+		service.Spec.PublishNotReadyAddresses = true
+		if err := r.client.Update(ctx, service); err != nil {
+			return fmt.Errorf("failed to update driver headless service %s: %v", service.Name, err)
+		}
+		logger.Info("Made driver headless service publish not ready addresses", "name", service.Name)
+	}
+	return nil
+}
+
+// mergeServicePorts returns the given ports with the node ports allocated to the existing ones with the same name,
+// unless they request another one.
+func mergeServicePorts(ports, existing []corev1.ServicePort) []corev1.ServicePort {
+	for i := range ports {
+		for _, e := range existing {
+			if ports[i].Name == e.Name && ports[i].NodePort == 0 {
+				ports[i].NodePort = e.NodePort
+			}
+		}
+	}
+	return ports
+}
+
+// refreshDriverServiceEndpoints looks up the external addresses of the driver service of the given SparkApplication
+// that were not provisioned yet, and returns how long until they should be looked up again.
+func (r *Reconciler) refreshDriverServiceEndpoints(ctx context.Context, app *v1beta2.SparkApplication) (time.Duration, error) {
+	spec := app.Spec.Driver.Service
+	if spec == nil || app.Status.DriverInfo.ServiceName == "" ||
+		(spec.Type != v1beta2.DriverServiceTypeLoadBalancer && spec.Type != v1beta2.DriverServiceTypeRoute) {
+		return 0, nil
+	}
+	pending := false
+	for _, endpoint := range app.Status.DriverInfo.ServiceEndpoints {
+		if endpoint.ExternalAddress == "" {
+			pending = true
+		}
+	}
+	if !pending {
+		return 0, nil
+	}
+
+	service := &corev1.Service{}
+	key := types.NamespacedName{Name: app.Status.DriverInfo.ServiceName, Namespace: app.Namespace}
+	if err := r.client.Get(ctx, key, service); err != nil {
+		return 0, fmt.Errorf("failed to get driver service %s: %v", key.Name, err)
+	}
+	hosts := make(map[string]string)
+	if spec.Type == v1beta2.DriverServiceTypeRoute {
+		for _, port := range service.Spec.Ports {
+			route := &unstructured.Unstructured{}
+			route.SetGroupVersionKind(routeGVK)
+			routeKey := types.NamespacedName{Name: getDriverServiceRouteName(app, port.Name), Namespace: app.Namespace}
+			if err := r.client.Get(ctx, routeKey, route); err != nil {
+				return 0, fmt.Errorf("failed to get route %s: %v", routeKey.Name, err)
+			}
+			hosts[port.Name], _, _ = unstructured.NestedString(route.Object, "spec", "host")
+		}
+	}
+
+	setDriverServiceStatus(app, service, hosts)
+	for _, endpoint := range app.Status.DriverInfo.ServiceEndpoints {
+		if endpoint.ExternalAddress == "" {
+			return driverServiceRequeueInterval, nil
+		}
+	}
+	return 0, nil
+}
+
+// setDriverServiceStatus records the addresses of the ports of the given driver service, exposed by Routes with the
+// given hosts if any, in the status of the SparkApplication.
+func setDriverServiceStatus(app *v1beta2.SparkApplication, service *corev1.Service, hosts map[string]string) {
+	var loadBalancer string
+	if ingress := service.Status.LoadBalancer.Ingress; service.Spec.Type == corev1.ServiceTypeLoadBalancer && len(ingress) > 0 {
+		loadBalancer = ingress[0].IP
+		if loadBalancer == "" {
+			loadBalancer = ingress[0].Hostname
+		}
+	}
+
+	endpoints := make([]v1beta2.DriverServiceEndpoint, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		endpoint := v1beta2.DriverServiceEndpoint{
+			Name:            port.Name,
+			Address:         net.JoinHostPort(fmt.Sprintf("%s.%s.svc", service.Name, service.Namespace), strconv.Itoa(int(port.Port))),
+			NodePort:        port.NodePort,
+			ExternalAddress: hosts[port.Name],
+		}
+		if loadBalancer != "" {
+			endpoint.ExternalAddress = net.JoinHostPort(loadBalancer, strconv.Itoa(int(port.Port)))
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+	app.Status.DriverInfo.ServiceName = service.Name
+	app.Status.DriverInfo.ServiceEndpoints = endpoints
+	recordWebUIExternalAddress(app, service, endpoints)
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newDriverServiceTestApp(serviceType v1beta2.DriverServiceType) *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "thrift-server", Namespace: "default", UID: "uid"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				Service: &v1beta2.DriverServiceSpec{
+					Type: serviceType,
+					Ports: []v1beta2.DriverServicePort{
+						{Name: "thrift", Port: 10000},
+						{Name: "metrics", Port: 8090, TargetPort: ptr.To[int32](9090)},
+					},
+					Labels:      map[string]string{"team": "analytics"},
+					Annotations: map[string]string{"example.com/annotation": "value"},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildDriverService(t *testing.T) {
+	app := newDriverServiceTestApp(v1beta2.DriverServiceTypeRoute)
+
+	service := buildDriverService(app)
+	assert.Equal(t, "thrift-server-driver", service.Name)
+	assert.Equal(t, corev1.ServiceTypeClusterIP, service.Spec.Type)
+	assert.Equal(t, "analytics", service.Labels["team"])
+	assert.Equal(t, "thrift-server", service.Labels[common.LabelSparkAppName])
+	assert.Equal(t, "value", service.Annotations["example.com/annotation"])
+	assert.Equal(t, map[string]string{
+		common.LabelSparkAppName: "thrift-server",
+		common.LabelSparkRole:    common.SparkRoleDriver,
+	}, service.Spec.Selector)
+	require.Len(t, service.Spec.Ports, 2)
+	assert.Equal(t, intstr.FromInt32(10000), service.Spec.Ports[0].TargetPort)
+	assert.Equal(t, intstr.FromInt32(9090), service.Spec.Ports[1].TargetPort)
+	assert.Equal(t, corev1.ProtocolTCP, service.Spec.Ports[1].Protocol)
+	require.Len(t, service.OwnerReferences, 1)
+	assert.Equal(t, app.Name, service.OwnerReferences[0].Name)
+}
+
+func TestCreateDriverService(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	t.Run("keeps the node ports of an existing service", func(t *testing.T) {
+		app := newDriverServiceTestApp(v1beta2.DriverServiceTypeNodePort)
+		existing := buildDriverService(app)
+		existing.Spec.Ports[0].NodePort = 30000
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing).Build()
+		r := &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+
+		require.NoError(t, r.createDriverService(ctx, app))
+
+		assert.Equal(t, "thrift-server-driver", app.Status.DriverInfo.ServiceName)
+		assert.Equal(t, []v1beta2.DriverServiceEndpoint{
+			{Name: "thrift", Address: "thrift-server-driver.default.svc:10000", NodePort: 30000},
+			{Name: "metrics", Address: "thrift-server-driver.default.svc:8090"},
+		}, app.Status.DriverInfo.ServiceEndpoints)
+	})
+
+	t.Run("creates a route per port", func(t *testing.T) {
+		app := newDriverServiceTestApp(v1beta2.DriverServiceTypeRoute)
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		r := &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+
+		require.NoError(t, r.createDriverService(ctx, app))
+
+		route := &unstructured.Unstructured{}
+		route.SetGroupVersionKind(routeGVK)
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "thrift-server-driver-thrift", Namespace: "default"}, route))
+		port, _, _ := unstructured.NestedString(route.Object, "spec", "port", "targetPort")
+		assert.Equal(t, "thrift", port)
+		require.Len(t, app.Status.DriverInfo.ServiceEndpoints, 2)
+		assert.Empty(t, app.Status.DriverInfo.ServiceEndpoints[0].ExternalAddress)
+
+		// The router admits the route of the first port and assigns it a host.
+		require.NoError(t, unstructured.SetNestedField(route.Object, "thrift.apps.example.com", "spec", "host"))
+		require.NoError(t, client.Update(ctx, route))
+
+		requeueAfter, err := r.refreshDriverServiceEndpoints(ctx, app)
+		require.NoError(t, err)
+		assert.Equal(t, driverServiceRequeueInterval, requeueAfter)
+		assert.Equal(t, "thrift.apps.example.com", app.Status.DriverInfo.ServiceEndpoints[0].ExternalAddress)
+		assert.Empty(t, app.Status.DriverInfo.ServiceEndpoints[1].ExternalAddress)
+	})
+}
+
+func TestCreateDriverHeadlessService(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "default", UID: "uid"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConf: map[string]string{common.SparkDriverPort: "7000"},
+			Driver: v1beta2.DriverSpec{
+				ServiceLabels: map[string]string{"team": "analytics"},
+				HeadlessService: &v1beta2.DriverHeadlessServiceSpec{
+					Name:                     ptr.To("spark-pi-driver-headless"),
+					PublishNotReadyAddresses: ptr.To(true),
+				},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{SparkApplicationID: "spark-0123"},
+	}
+	sparkService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi-0123-driver-svc", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				common.LabelSparkApplicationSelector: "spark-0123",
+				common.LabelSparkRole:                common.SparkRoleDriver,
+			},
+		},
+	}
+	otherService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-other-driver-svc", Namespace: "default"},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector: map[string]string{
+				common.LabelSparkApplicationSelector: "spark-4567",
+				common.LabelSparkRole:                common.SparkRoleDriver,
+			},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(sparkService, otherService).Build()
+	r := &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+
+	require.NoError(t, r.createDriverHeadlessService(ctx, app))
+
+	service := &corev1.Service{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "spark-pi-driver-headless", Namespace: "default"}, service))
+	assert.Equal(t, corev1.ClusterIPNone, service.Spec.ClusterIP)
+	assert.True(t, service.Spec.PublishNotReadyAddresses)
+	assert.Equal(t, "analytics", service.Labels["team"])
+	require.Len(t, service.Spec.Ports, 3)
+	assert.Equal(t, int32(7000), service.Spec.Ports[0].Port)
+	assert.Equal(t, int32(common.DefaultSparkBlockManagerPort), service.Spec.Ports[1].Port)
+	assert.Equal(t, common.DefaultSparkWebUIPort, service.Spec.Ports[2].Port)
+
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: sparkService.Name, Namespace: "default"}, service))
+	assert.True(t, service.Spec.PublishNotReadyAddresses)
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: otherService.Name, Namespace: "default"}, service))
+	assert.False(t, service.Spec.PublishNotReadyAddresses)
+}
+
+func TestRefreshDriverServiceEndpointsLoadBalancer(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := newDriverServiceTestApp(v1beta2.DriverServiceTypeLoadBalancer)
+	service := buildDriverService(app)
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(service).Build()
+	r := &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+	setDriverServiceStatus(app, service, nil)
+
+	requeueAfter, err := r.refreshDriverServiceEndpoints(ctx, app)
+	require.NoError(t, err)
+	assert.Equal(t, driverServiceRequeueInterval, requeueAfter)
+
+	service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "203.0.113.10"}}
+	require.NoError(t, client.Status().Update(ctx, service))
+
+	requeueAfter, err = r.refreshDriverServiceEndpoints(ctx, app)
+	require.NoError(t, err)
+	assert.Zero(t, requeueAfter)
+	assert.Equal(t, "203.0.113.10:10000", app.Status.DriverInfo.ServiceEndpoints[0].ExternalAddress)
+	assert.Equal(t, "203.0.113.10:8090", app.Status.DriverInfo.ServiceEndpoints[1].ExternalAddress)
+}
@@ -88,7 +88,7 @@ func (r *Reconciler) createDriverIngress(ctx context.Context, app *v1beta2.Spark
 	if driverIngressConfiguration.ServicePort == nil {
 		return nil, fmt.Errorf("cannot create Driver Ingress for application %s/%s due to empty ServicePort on driverIngressConfiguration", app.Namespace, app.Name)
 	}
-	ingressName := fmt.Sprintf("%s-ing-%d", app.Name, *driverIngressConfiguration.ServicePort)
+	ingressName := getDriverIngressName(app, *driverIngressConfiguration.ServicePort)
 	if util.IngressCapabilities.Has("networking.k8s.io/v1") {
 		return r.createDriverIngressV1(ctx, app, service, ingressName, ingressURL, ingressClassName, []networkingv1.IngressTLS{}, map[string]string{})
 	}
@@ -97,6 +97,36 @@ func (r *Reconciler) createDriverIngress(ctx context.Context, app *v1beta2.Spark
 
 func (r *Reconciler) createDriverIngressV1(ctx context.Context, app *v1beta2.SparkApplication, service SparkService, ingressName string, ingressURL *url.URL, ingressClassName string, defaultIngressTLS []networkingv1.IngressTLS, defaultIngressAnnotations map[string]string) (*SparkIngress, error) {
 	logger := log.FromContext(ctx)
+	ingress := buildDriverIngressV1(app, service, ingressName, ingressURL, ingressClassName, defaultIngressTLS, defaultIngressAnnotations)
+
+	if err := r.client.Create(ctx, ingress); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
+		}
+
+		if err := r.client.Update(ctx, ingress); err != nil {
+			return nil, fmt.Errorf("failed to update ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
+		}
+		logger.Info("Updated networking.v1/Ingress for SparkApplication", "ingressName", ingress.Name)
+	} else {
+		logger.Info("Created networking.v1/Ingress for SparkApplication", "ingressName", ingress.Name)
+	}
+
+	ingressTLSHosts := util.GetWebUIIngressTLS(app)
+	if len(ingressTLSHosts) == 0 && len(defaultIngressTLS) != 0 {
+		ingressTLSHosts = defaultIngressTLS
+	}
+	return &SparkIngress{
+		ingressName:      ingress.Name,
+		ingressURL:       ingressURL,
+		ingressClassName: ingressClassName,
+		annotations:      ingress.Annotations,
+		ingressTLS:       ingressTLSHosts,
+	}, nil
+}
+
+// buildDriverIngressV1 builds the networking.k8s.io/v1 Ingress routing the given URL to the given driver service.
+func buildDriverIngressV1(app *v1beta2.SparkApplication, service SparkService, ingressName string, ingressURL *url.URL, ingressClassName string, defaultIngressTLS []networkingv1.IngressTLS, defaultIngressAnnotations map[string]string) *networkingv1.Ingress {
 	ingressResourceAnnotations := util.GetWebUIIngressAnnotations(app)
 	if len(ingressResourceAnnotations) == 0 && len(defaultIngressAnnotations) != 0 {
 		ingressResourceAnnotations = defaultIngressAnnotations
@@ -161,27 +191,7 @@ func (r *Reconciler) createDriverIngressV1(ctx context.Context, app *v1beta2.Spa
 	if len(ingressClassName) != 0 {
 		ingress.Spec.IngressClassName = &ingressClassName
 	}
-
-	if err := r.client.Create(ctx, ingress); err != nil {
-		if !errors.IsAlreadyExists(err) {
-			return nil, fmt.Errorf("failed to create ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
-		}
-
-		if err := r.client.Update(ctx, ingress); err != nil {
-			return nil, fmt.Errorf("failed to update ingress %s/%s: %v", ingress.Namespace, ingress.Name, err)
-		}
-		logger.Info("Updated networking.v1/Ingress for SparkApplication", "ingressName", ingress.Name)
-	} else {
-		logger.Info("Created networking.v1/Ingress for SparkApplication", "ingressName", ingress.Name)
-	}
-
-	return &SparkIngress{
-		ingressName:      ingress.Name,
-		ingressURL:       ingressURL,
-		ingressClassName: ingressClassName,
-		annotations:      ingress.Annotations,
-		ingressTLS:       ingressTLSHosts,
-	}, nil
+	return ingress
 }
 
 func (r *Reconciler) createDriverIngressLegacy(ctx context.Context, app *v1beta2.SparkApplication, service SparkService, ingressName string, ingressURL *url.URL) (*SparkIngress, error) {
@@ -271,8 +281,37 @@ func convertIngressTLSHostsToLegacy(ingressTLSHosts []networkingv1.IngressTLS) [
 	return ingressTLSHostsLegacy
 }
 
-func (r *Reconciler) createDriverIngressService(
-	ctx context.Context,
+// createOrUpdateDriverService creates the given driver service, or updates it if it already exists.
+func (r *Reconciler) createOrUpdateDriverService(ctx context.Context, service *corev1.Service) (*SparkService, error) {
+	logger := log.FromContext(ctx)
+	if err := r.client.Create(ctx, service); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return nil, err
+		}
+
+		// Update the service if it already exists.
+		if err := r.client.Update(ctx, service); err != nil {
+			return nil, err
+		}
+		logger.Info("Updated service for SparkApplication", "name", service.Name)
+	} else {
+		logger.Info("Created service for SparkApplication", "name", service.Name)
+	}
+
+	return &SparkService{
+		serviceName:        service.Name,
+		serviceType:        service.Spec.Type,
+		servicePort:        service.Spec.Ports[0].Port,
+		servicePortName:    service.Spec.Ports[0].Name,
+		targetPort:         service.Spec.Ports[0].TargetPort,
+		serviceIP:          service.Spec.ClusterIP,
+		serviceAnnotations: service.Annotations,
+		serviceLabels:      service.Labels,
+	}, nil
+}
+
+// buildDriverIngressService builds the Service exposing the given port of the driver pod.
+func buildDriverIngressService(
 	app *v1beta2.SparkApplication,
 	portName string,
 	port int32,
@@ -281,14 +320,13 @@ func (r *Reconciler) createDriverIngressService(
 	serviceType corev1.ServiceType,
 	serviceAnnotations map[string]string,
 	serviceLabels map[string]string,
-) (*SparkService, error) {
-	logger := log.FromContext(ctx)
+) *corev1.Service {
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            serviceName,
 			Namespace:       app.Namespace,
-			Labels:          util.GetResourceLabels(app),
-			OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
 		},
 		Spec: corev1.ServiceSpec{
 			Ports: []corev1.ServicePort{
@@ -316,31 +354,7 @@ func (r *Reconciler) createDriverIngressService(
 	if len(serviceAnnotations) != 0 {
 		service.Annotations = serviceAnnotations
 	}
-
-	if err := r.client.Create(ctx, service); err != nil {
-		if !errors.IsAlreadyExists(err) {
-			return nil, err
-		}
-
-		// Update the service if it already exists.
-		if err := r.client.Update(ctx, service); err != nil {
-			return nil, err
-		}
-		logger.Info("Updated service for SparkApplication", "name", service.Name)
-	} else {
-		logger.Info("Created service for SparkApplication", "name", service.Name)
-	}
-
-	return &SparkService{
-		serviceName:        service.Name,
-		serviceType:        service.Spec.Type,
-		servicePort:        service.Spec.Ports[0].Port,
-		servicePortName:    service.Spec.Ports[0].Name,
-		targetPort:         service.Spec.Ports[0].TargetPort,
-		serviceIP:          service.Spec.ClusterIP,
-		serviceAnnotations: serviceAnnotations,
-		serviceLabels:      serviceLabels,
-	}, nil
+	return service
 }
 
 func getDriverIngressServicePort(driverIngressConfiguration *v1beta2.DriverIngressConfiguration) (int32, error) {
@@ -367,6 +381,10 @@ func getDriverIngressServiceName(app *v1beta2.SparkApplication, port int32) stri
 	return fmt.Sprintf("%s-driver-%d", app.Name, port)
 }
 
+func getDriverIngressName(app *v1beta2.SparkApplication, port int32) string {
+	return fmt.Sprintf("%s-ing-%d", app.Name, port)
+}
+
 func getDriverIngressServiceType(driverIngressConfiguration *v1beta2.DriverIngressConfiguration) corev1.ServiceType {
 	if driverIngressConfiguration.ServiceType != nil {
 		return *driverIngressConfiguration.ServiceType
@@ -399,6 +417,15 @@ func (r *Reconciler) createDriverIngressServiceFromConfiguration(
 	app *v1beta2.SparkApplication,
 	driverIngressConfiguration *v1beta2.DriverIngressConfiguration,
 ) (*SparkService, error) {
+	service, err := buildDriverIngressServiceFromConfiguration(app, driverIngressConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	r.propagateMetadata(app, service)
+	return r.createOrUpdateDriverService(ctx, service)
+}
+
+func buildDriverIngressServiceFromConfiguration(app *v1beta2.SparkApplication, driverIngressConfiguration *v1beta2.DriverIngressConfiguration) (*corev1.Service, error) {
 	portName := getDriverIngressServicePortName(driverIngressConfiguration)
 	port, err := getDriverIngressServicePort(driverIngressConfiguration)
 	if err != nil {
@@ -408,5 +435,5 @@ func (r *Reconciler) createDriverIngressServiceFromConfiguration(
 	serviceType := getDriverIngressServiceType(driverIngressConfiguration)
 	serviceAnnotations := getDriverIngressServiceAnnotations(driverIngressConfiguration)
 	serviceLabels := getDriverIngressServiceLabels(driverIngressConfiguration)
-	return r.createDriverIngressService(ctx, app, portName, port, port, serviceName, serviceType, serviceAnnotations, serviceLabels)
+	return buildDriverIngressService(app, portName, port, port, serviceName, serviceType, serviceAnnotations, serviceLabels), nil
 }
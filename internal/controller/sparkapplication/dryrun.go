@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// Keys of the dry-run ConfigMap of a SparkApplication.
+const (
+	dryRunSparkSubmitArgsKey = "spark-submit-args"
+	dryRunSparkConfKey       = "spark.conf"
+	dryRunDriverPodKey       = "driver-pod.yaml"
+	dryRunServicesKey        = "services.yaml"
+)
+
+// dryRunPodTemplateFiles are the pod template files written for spark-submit in cluster mode, which are stored in
+// the dry-run ConfigMap under their names.
+var dryRunPodTemplateFiles = []string{"driver-pod-template.yaml", "executor-pod-template.yaml"}
+
+// shouldRenderDryRun returns whether the given SparkApplication is annotated to be rendered instead of submitted
+// and its current generation has not been rendered yet.
+func shouldRenderDryRun(app *v1beta2.SparkApplication) bool {
+	if !util.IsDryRun(app) {
+		return false
+	}
+	return app.Status.DryRun == nil || app.Status.DryRun.ObservedGeneration != app.Generation
+}
+
+// renderDryRun renders the spark-submit arguments, Spark configuration, driver pod and Services the operator would
+// create to submit the given SparkApplication into its dry-run ConfigMap instead of submitting it, and records the
+// rendering in app.Status.DryRun. The resources the submission depends on are created in dry-run mode, so that they
+// are validated and mutated by the API server and its admission webhooks without being persisted.
+func (r *Reconciler) renderDryRun(ctx context.Context, app *v1beta2.SparkApplication) error {
+	logger := log.FromContext(ctx)
+	logger.Info("Rendering dry run of SparkApplication")
+
+	data, renderErr := r.renderDryRunData(ctx, app)
+	if renderErr != nil {
+		logger.Info("Dry run of SparkApplication would fail to submit it", "error", renderErr)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      util.GetDryRunConfigMapName(app),
+			Namespace: app.Namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.client, configMap, func() error {
+		configMap.Labels = util.GetDependentResourceLabels(app)
+		configMap.OwnerReferences = util.GetDependentOwnerReferences(app)
+		configMap.Data = data
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create or update dry-run ConfigMap %s: %v", configMap.Name, err)
+	}
+
+	status := &v1beta2.DryRunStatus{
+		ConfigMapName:      configMap.Name,
+		ObservedGeneration: app.Generation,
+		RenderTime:         metav1.Now(),
+	}
+	if renderErr != nil {
+		status.ErrorMessage = renderErr.Error()
+	}
+	app.Status.DryRun = status
+
+	eventType := corev1.EventTypeNormal
+	message := fmt.Sprintf("SparkApplication %s is rendered into ConfigMap %s instead of being submitted", app.Name, configMap.Name)
+	if renderErr != nil {
+		eventType = corev1.EventTypeWarning
+		message = fmt.Sprintf("%s, its submission would fail: %v", message, renderErr)
+	}
+	r.recorder.Event(app, eventType, common.EventSparkApplicationDryRun, message)
+	return nil
+}
+
+// clearDryRun deletes the dry-run ConfigMap of the given SparkApplication and clears app.Status.DryRun once the
+// application is no longer annotated to be rendered instead of submitted.
+func (r *Reconciler) clearDryRun(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Status.DryRun == nil {
+		return nil
+	}
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Status.DryRun.ConfigMapName,
+			Namespace: app.Namespace,
+		},
+	}
+	if err := r.client.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete dry-run ConfigMap %s: %v", configMap.Name, err)
+	}
+	app.Status.DryRun = nil
+	return nil
+}
+
+// renderDryRunData renders the contents of the dry-run ConfigMap of the given SparkApplication. The resources
+// rendered until the submission fails are returned along with the error it fails with.
+func (r *Reconciler) renderDryRunData(ctx context.Context, app *v1beta2.SparkApplication) (map[string]string, error) {
+	data := make(map[string]string)
+
+	// The dry-run reconciler shares everything but its client, which creates and updates resources in dry-run mode.
+	dryRun := *r
+	dryRun.client = client.NewDryRunClient(r.client)
+
+	rendered := app.DeepCopy()
+	rendered.Status.SubmissionID = uuid.New().String()
+	rendered.Status.DriverInfo.PodName = util.GetDriverPodName(rendered)
+
+	if _, err := dryRun.prepareSubmission(ctx, rendered); err != nil {
+		return data, err
+	}
+
+	var args []string
+	var err error
+	if rendered.Spec.Mode == v1beta2.DeployModeClient {
+		args, err = buildClientModeSparkSubmitArgs(rendered)
+	} else {
+		args, err = buildSparkSubmitArgs(rendered)
+		if err == nil {
+			err = readDryRunPodTemplateFiles(rendered, data)
+		}
+	}
+	if err != nil {
+		return data, fmt.Errorf("failed to build spark-submit arguments: %v", err)
+	}
+	data[dryRunSparkSubmitArgsKey] = strings.Join(args, "\n")
+	data[dryRunSparkConfKey] = getDryRunSparkConf(args)
+
+	// The driver pod is created by spark-submit from its template in cluster mode.
+	if rendered.Spec.Mode == v1beta2.DeployModeClient {
+		pod, err := buildClientModeDriverPod(rendered)
+		if err != nil {
+			return data, fmt.Errorf("failed to build driver pod: %v", err)
+		}
+		if err := dryRun.client.Create(ctx, pod); err != nil {
+			return data, fmt.Errorf("failed to create driver pod: %v", err)
+		}
+		if data[dryRunDriverPodKey], err = r.marshalDryRunObjects(pod); err != nil {
+			return data, err
+		}
+	}
+
+	services, err := r.buildDryRunServices(rendered)
+	if err != nil {
+		return data, err
+	}
+	if len(services) > 0 {
+		if data[dryRunServicesKey], err = r.marshalDryRunObjects(services...); err != nil {
+			return data, err
+		}
+	}
+	return data, nil
+}
+
+// readDryRunPodTemplateFiles reads the pod template files written for spark-submit in cluster mode into the given
+// ConfigMap data, and removes them.
+func readDryRunPodTemplateFiles(app *v1beta2.SparkApplication, data map[string]string) error {
+	dir := fmt.Sprintf("/tmp/spark/%s", app.Status.SubmissionID)
+	defer os.RemoveAll(dir)
+
+	for _, file := range dryRunPodTemplateFiles {
+		content, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("failed to read pod template file %s: %v", file, err)
+		}
+		data[file] = string(content)
+	}
+	return nil
+}
+
+// getDryRunSparkConf returns the Spark configuration properties passed to spark-submit by the given arguments in
+// the format of a Spark properties file.
+func getDryRunSparkConf(args []string) string {
+	var lines []string
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] != "--conf" {
+			continue
+		}
+		key, value, _ := strings.Cut(args[i+1], "=")
+		lines = append(lines, fmt.Sprintf("%s %s", key, value))
+		i++
+	}
+	return strings.Join(lines, "\n")
+}
+
+// buildDryRunServices builds the Services the operator would create for the driver of the given SparkApplication
+// once it is submitted.
+func (r *Reconciler) buildDryRunServices(app *v1beta2.SparkApplication) ([]client.Object, error) {
+	var services []client.Object
+	if r.options.EnableUIService && util.IsSparkUIEnabled(app, !r.options.DisableSparkUI) {
+		service, err := buildWebUIService(app)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build web UI service: %v", err)
+		}
+		services = append(services, service)
+	}
+	if app.Spec.Driver.Service != nil {
+		services = append(services, buildDriverService(app))
+	}
+	if app.Spec.Driver.HeadlessService != nil {
+		services = append(services, buildDriverHeadlessService(app))
+	}
+	if app.Spec.Interactive != nil {
+		services = append(services, buildInteractiveService(app))
+	}
+	for _, service := range services {
+		r.propagateMetadata(app, service)
+	}
+	return services, nil
+}
+
+// marshalDryRunObjects marshals the given objects with their kinds into a multi-document YAML.
+func (r *Reconciler) marshalDryRunObjects(objects ...client.Object) (string, error) {
+	documents := make([]string, 0, len(objects))
+	for _, object := range objects {
+		gvk, err := apiutil.GVKForObject(object, r.client.Scheme())
+		if err != nil {
+			return "", fmt.Errorf("failed to get kind of %s: %v", object.GetName(), err)
+		}
+		object.GetObjectKind().SetGroupVersionKind(gvk)
+		content, err := yaml.Marshal(object)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s: %v", object.GetName(), err)
+		}
+		documents = append(documents, string(content))
+	}
+	return strings.Join(documents, "---\n"), nil
+}
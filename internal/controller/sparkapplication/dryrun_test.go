@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestGetDryRunSparkConf(t *testing.T) {
+	args := []string{
+		"--master", "k8s://https://127.0.0.1:443",
+		"--conf", "spark.executor.instances=2",
+		"--conf", "spark.driver.extraJavaOptions=-Da=b -Dc=d",
+		"local:///opt/spark/examples/jars/spark-examples.jar",
+	}
+	assert.Equal(t, "spark.executor.instances 2\nspark.driver.extraJavaOptions -Da=b -Dc=d", getDryRunSparkConf(args))
+	assert.Empty(t, getDryRunSparkConf([]string{"--conf"}))
+}
+
+func TestRenderDryRun(t *testing.T) {
+	t.Setenv(common.EnvKubernetesServiceHost, "10.0.0.1")
+	t.Setenv(common.EnvKubernetesServicePort, "443")
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{client: client, recorder: recorder}
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "spark-pi",
+			Namespace:   "default",
+			UID:         "uid",
+			Generation:  2,
+			Annotations: map[string]string{common.AnnotationDryRun: "true"},
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Type:                v1beta2.SparkApplicationTypeScala,
+			Mode:                v1beta2.DeployModeClient,
+			Image:               ptr.To("spark:4.0.0"),
+			SparkVersion:        "4.0.0",
+			MainClass:           ptr.To("org.apache.spark.examples.SparkPi"),
+			MainApplicationFile: ptr.To("local:///opt/spark/examples/jars/spark-examples.jar"),
+			SparkConf:           map[string]string{"spark.executor.instances": "2"},
+			Interactive:         &v1beta2.InteractiveSpec{},
+		},
+	}
+	require.True(t, shouldRenderDryRun(app))
+	require.NoError(t, r.renderDryRun(ctx, app))
+
+	require.NotNil(t, app.Status.DryRun)
+	assert.Equal(t, "spark-pi-dry-run", app.Status.DryRun.ConfigMapName)
+	assert.Equal(t, int64(2), app.Status.DryRun.ObservedGeneration)
+	assert.Empty(t, app.Status.DryRun.ErrorMessage)
+	assert.False(t, shouldRenderDryRun(app))
+	assert.Empty(t, app.Status.SubmissionID)
+	assert.Equal(t, v1beta2.ApplicationStateType(""), app.Status.AppState.State)
+
+	configMap := &corev1.ConfigMap{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "spark-pi-dry-run", Namespace: "default"}, configMap))
+	assert.Contains(t, configMap.Data[dryRunSparkSubmitArgsKey], common.SparkConnectServerClass)
+	assert.Contains(t, configMap.Data[dryRunSparkConfKey], "spark.executor.instances 2")
+	assert.Contains(t, configMap.Data[dryRunDriverPodKey], "kind: Pod")
+	assert.Contains(t, configMap.Data[dryRunServicesKey], "name: spark-pi-interactive")
+	assert.Len(t, recorder.Events, 1)
+
+	// The driver pod and Services are not created.
+	pods := &corev1.PodList{}
+	require.NoError(t, client.List(ctx, pods))
+	assert.Empty(t, pods.Items)
+	services := &corev1.ServiceList{}
+	require.NoError(t, client.List(ctx, services))
+	assert.Empty(t, services.Items)
+
+	// The rendering is cleared once the annotation is removed.
+	delete(app.Annotations, common.AnnotationDryRun)
+	assert.False(t, shouldRenderDryRun(app))
+	require.NoError(t, r.clearDryRun(ctx, app))
+	assert.Nil(t, app.Status.DryRun)
+	err := client.Get(ctx, types.NamespacedName{Name: "spark-pi-dry-run", Namespace: "default"}, configMap)
+	assert.True(t, errors.IsNotFound(err))
+}
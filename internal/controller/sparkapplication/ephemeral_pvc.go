@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// labelExecutorPVCs labels the on-demand PersistentVolumeClaims Spark created for the executors of the given
+// SparkApplication with the application name, so that they can be tracked across runs of the application, and
+// with the labels and annotations of the application selected for propagation.
+// With the Orphan cascade delete policy, they are also released from the pods owning them, so that they outlive
+// the application.
+func (r *Reconciler) labelExecutorPVCs(ctx context.Context, app *v1beta2.SparkApplication) error {
+	orphan := util.IsOrphaningDependents(app)
+	if (app.Spec.Executor.EphemeralPVC == nil && !orphan) || app.Status.SparkApplicationID == "" {
+		return nil
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.client.List(
+		ctx,
+		pvcs,
+		client.InNamespace(app.Namespace),
+		client.MatchingLabels{common.LabelSparkApplicationSelector: app.Status.SparkApplicationID},
+	); err != nil {
+		return fmt.Errorf("failed to list executor PVCs: %v", err)
+	}
+
+	for _, pvc := range pvcs.Items {
+		original := pvc.DeepCopy()
+		r.propagateMetadata(app, &pvc)
+		pvc.Labels[common.LabelSparkAppName] = app.Name
+		if orphan {
+			pvc.Labels[common.LabelOrphaned] = "true"
+			pvc.OwnerReferences = nil
+		}
+		if equality.Semantic.DeepEqual(original, &pvc) {
+			continue
+		}
+		patch := client.MergeFrom(original)
+		if err := r.client.Patch(ctx, &pvc, patch); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to label executor PVC %s: %v", pvc.Name, err)
+		}
+	}
+	return nil
+}
+
+// deleteExecutorPVCs deletes the on-demand executor PersistentVolumeClaims left over by the given terminated
+// SparkApplication, e.g. because they are owned by a driver pod that crashed and is kept around.
+func (r *Reconciler) deleteExecutorPVCs(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.Executor.EphemeralPVC == nil || util.IsOrphaningDependents(app) {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	selectors := []client.MatchingLabels{{common.LabelSparkAppName: app.Name}}
+	if app.Status.SparkApplicationID != "" {
+		selectors = append(selectors, client.MatchingLabels{common.LabelSparkApplicationSelector: app.Status.SparkApplicationID})
+	}
+
+	deleted := make(map[string]bool)
+	for _, selector := range selectors {
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		if err := r.client.List(ctx, pvcs, client.InNamespace(app.Namespace), selector); err != nil {
+			return fmt.Errorf("failed to list executor PVCs: %v", err)
+		}
+
+		for _, pvc := range pvcs.Items {
+			if deleted[pvc.Name] || pvc.DeletionTimestamp != nil {
+				continue
+			}
+			logger.Info("Deleting leaked executor PVC", "pvc", pvc.Name)
+			if err := r.client.Delete(ctx, &pvc); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("failed to delete executor PVC %s: %v", pvc.Name, err)
+			}
+			deleted[pvc.Name] = true
+			if r.options.SparkExecutorMetrics != nil {
+				r.options.SparkExecutorMetrics.HandleSparkExecutorPVCLeak(&pvc)
+			}
+		}
+	}
+	return nil
+}
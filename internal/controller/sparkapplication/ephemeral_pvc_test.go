@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestExecutorEphemeralPVCOption(t *testing.T) {
+	app := &v1beta2.SparkApplication{}
+	args, err := executorEphemeralPVCOption(app)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+
+	app.Spec.SparkVersion = "3.5.0"
+	app.Spec.Executor.EphemeralPVC = &v1beta2.ExecutorEphemeralPVC{
+		StorageClass: ptr.To("fast"),
+		SizeLimit:    resource.MustParse("100Gi"),
+	}
+	app.Spec.SparkConf = map[string]string{common.SparkKubernetesDriverWaitToReusePersistentVolumeClaim: "false"}
+	args, err = executorEphemeralPVCOption(app)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"--conf", "spark.kubernetes.executor.volumes.persistentVolumeClaim.spark-local-dir-1.options.claimName=OnDemand",
+		"--conf", "spark.kubernetes.executor.volumes.persistentVolumeClaim.spark-local-dir-1.options.sizeLimit=100Gi",
+		"--conf", "spark.kubernetes.executor.volumes.persistentVolumeClaim.spark-local-dir-1.mount.path=/data",
+		"--conf", "spark.kubernetes.executor.volumes.persistentVolumeClaim.spark-local-dir-1.mount.readOnly=false",
+		"--conf", "spark.kubernetes.executor.volumes.persistentVolumeClaim.spark-local-dir-1.options.storageClass=fast",
+		"--conf", "spark.kubernetes.driver.ownPersistentVolumeClaim=true",
+		"--conf", "spark.kubernetes.driver.reusePersistentVolumeClaim=true",
+	}, args)
+
+	// Claim reuse is not supported before Spark 3.2.
+	app.Spec.SparkVersion = "3.1.3"
+	args, err = executorEphemeralPVCOption(app)
+	require.NoError(t, err)
+	assert.NotContains(t, args, "spark.kubernetes.driver.ownPersistentVolumeClaim=true")
+	assert.Len(t, args, 10)
+}
+
+func TestExecutorPVCLifecycle(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Executor: v1beta2.ExecutorSpec{
+				EphemeralPVC: &v1beta2.ExecutorEphemeralPVC{SizeLimit: resource.MustParse("10Gi")},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{SparkApplicationID: "spark-123"},
+	}
+	newPVC := func(name string, labels map[string]string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		}
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newPVC("current-run", map[string]string{common.LabelSparkApplicationSelector: "spark-123"}),
+		newPVC("previous-run", map[string]string{common.LabelSparkApplicationSelector: "spark-042", common.LabelSparkAppName: "test-app"}),
+		newPVC("other-app", map[string]string{common.LabelSparkApplicationSelector: "spark-999"}),
+	).Build()
+	reconciler := &Reconciler{client: client}
+
+	require.NoError(t, reconciler.labelExecutorPVCs(ctx, app))
+	pvc := &corev1.PersistentVolumeClaim{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "current-run", Namespace: "default"}, pvc))
+	assert.Equal(t, "test-app", pvc.Labels[common.LabelSparkAppName])
+
+	require.NoError(t, reconciler.deleteExecutorPVCs(ctx, app))
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	require.NoError(t, client.List(ctx, pvcs))
+	require.Len(t, pvcs.Items, 1)
+	assert.Equal(t, "other-app", pvcs.Items[0].Name)
+}
+
+func TestExecutorPVCOrphaned(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			CascadeDeletePolicy: ptr.To(v1beta2.CascadeDeletePolicyOrphan),
+			Executor: v1beta2.ExecutorSpec{
+				EphemeralPVC: &v1beta2.ExecutorEphemeralPVC{SizeLimit: resource.MustParse("10Gi")},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{SparkApplicationID: "spark-123"},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "current-run",
+				Namespace: "default",
+				Labels:    map[string]string{common.LabelSparkApplicationSelector: "spark-123"},
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "v1", Kind: "Pod", Name: "test-app-driver", UID: "driver-uid"},
+				},
+			},
+		},
+	).Build()
+	reconciler := &Reconciler{client: client}
+
+	require.NoError(t, reconciler.labelExecutorPVCs(ctx, app))
+	pvc := &corev1.PersistentVolumeClaim{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "current-run", Namespace: "default"}, pvc))
+	assert.Equal(t, "test-app", pvc.Labels[common.LabelSparkAppName])
+	assert.Equal(t, "true", pvc.Labels[common.LabelOrphaned])
+	assert.Empty(t, pvc.OwnerReferences)
+
+	// Orphaned PVCs are left for retention tooling to delete.
+	require.NoError(t, reconciler.deleteExecutorPVCs(ctx, app))
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "current-run", Namespace: "default"}, pvc))
+}
@@ -177,18 +177,26 @@ func (f *EventFilter) Update(e event.UpdateEvent) bool {
 	// This is currently best effort as we can potentially miss updates and end up in an inconsistent state.
 	if !equality.Semantic.DeepEqual(oldApp.Spec, newApp.Spec) {
 
-		// Only Spec.Suspend can be updated without any action
+		// Only Spec.Suspend and Spec.Terminate can be updated without any action
 		oldAppCopy := oldApp.DeepCopy()
 		oldAppCopy.Spec.Suspend = newApp.Spec.Suspend
+		oldAppCopy.Spec.Terminate = newApp.Spec.Terminate
 		if equality.Semantic.DeepEqual(oldAppCopy.Spec, newApp.Spec) {
 			return true
 		}
 
+		// The number of executors of a running application is changed in place by the reconciler if enabled.
+		if isInPlaceExecutorScalingChange(oldApp, newApp) {
+			return true
+		}
+
 		// Check if only webhook-patched fields changed (requires PartialRestart feature gate).
 		// These fields are applied by the mutating webhook when new pods are created,
 		// so we don't need to trigger a reconcile - the webhook cache will automatically
-		// use the new values for any newly created pods.
-		if features.Enabled(features.PartialRestart) && f.isWebhookPatchedFieldsOnlyChange(oldApp, newApp) {
+		// use the new values for any newly created pods. This does not hold when the fields are applied to the
+		// pod templates at submission instead (PodTemplateMutation feature gate).
+		if features.Enabled(features.PartialRestart) && !features.Enabled(features.PodTemplateMutation) &&
+			f.isWebhookPatchedFieldsOnlyChange(oldApp, newApp) {
 			f.logger.Info("Only webhook-patched fields changed, skipping reconcile",
 				"name", newApp.Name, "namespace", newApp.Namespace)
 			f.recorder.Eventf(
@@ -203,6 +211,11 @@ func (f *EventFilter) Update(e event.UpdateEvent) bool {
 
 		// Force-set the application status to Invalidating which handles clean-up and application re-run.
 		newApp.Status.AppState.State = v1beta2.ApplicationStateInvalidating
+		// The updated spec is first tried with the preferred scheduling profile again.
+		newApp.Status.SchedulingProfile = ""
+		newApp.Status.Health = util.GetApplicationHealth(newApp.Status.AppState.State)
+		updateConditions(newApp)
+		newApp.Status.Phase = util.GetApplicationPhase(newApp)
 		f.logger.Info("Updating SparkApplication status", "name", newApp.Name, "namespace", newApp.Namespace, " oldState", oldApp.Status.AppState.State, "newState", newApp.Status.AppState.State)
 		if err := f.client.Status().Update(context.TODO(), newApp); err != nil {
 			f.logger.Error(err, "Failed to update application status", "application", newApp.Name)
@@ -317,3 +330,22 @@ func hasExecutorWebhookFieldChanges(oldApp, newApp *v1beta2.SparkApplication) bo
 	}
 	return false
 }
+
+// isInPlaceExecutorScalingChange checks if the spec changes of a running application only involve the number of
+// executors, which is applied without rerunning the application if spec.executor.inPlaceScaling is set.
+func isInPlaceExecutorScalingChange(oldApp, newApp *v1beta2.SparkApplication) bool {
+	if newApp.Status.AppState.State != v1beta2.ApplicationStateRunning {
+		return false
+	}
+	if oldApp.Spec.Executor.InPlaceScaling == nil || newApp.Spec.Executor.InPlaceScaling == nil || newApp.Spec.Executor.Instances == nil {
+		return false
+	}
+
+	oldSpec := oldApp.Spec.DeepCopy()
+	newSpec := newApp.Spec.DeepCopy()
+	oldSpec.Executor.Instances = nil
+	newSpec.Executor.Instances = nil
+	oldSpec.Suspend = nil
+	newSpec.Suspend = nil
+	return equality.Semantic.DeepEqual(oldSpec, newSpec)
+}
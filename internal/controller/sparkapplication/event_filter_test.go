@@ -19,8 +19,10 @@ package sparkapplication
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
@@ -292,3 +294,40 @@ func TestHasExecutorWebhookFieldChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestIsInPlaceExecutorScalingChange(t *testing.T) {
+	baseApp := func() *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				Executor: v1beta2.ExecutorSpec{
+					Instances:      ptr.To(int32(2)),
+					InPlaceScaling: &v1beta2.ExecutorInPlaceScaling{},
+				},
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			},
+		}
+	}
+
+	oldApp := baseApp()
+	newApp := baseApp()
+	newApp.Spec.Executor.Instances = ptr.To(int32(5))
+	assert.True(t, isInPlaceExecutorScalingChange(oldApp, newApp))
+
+	// Applications that are not running are rerun.
+	submitted := newApp.DeepCopy()
+	submitted.Status.AppState.State = v1beta2.ApplicationStateSubmitted
+	assert.False(t, isInPlaceExecutorScalingChange(oldApp, submitted))
+
+	// Changes of other fields are not applied in place.
+	memory := newApp.DeepCopy()
+	memory.Spec.Executor.Memory = ptr.To("4g")
+	assert.False(t, isInPlaceExecutorScalingChange(oldApp, memory))
+
+	// Enabling in-place scaling along with the new number of executors reruns the application.
+	disabled := oldApp.DeepCopy()
+	disabled.Spec.Executor.InPlaceScaling = nil
+	assert.False(t, isInPlaceExecutorScalingChange(disabled, newApp))
+}
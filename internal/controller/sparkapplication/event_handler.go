@@ -19,6 +19,7 @@ package sparkapplication
 import (
 	"context"
 
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
@@ -29,7 +30,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/cloudevents"
 	"github.com/kubeflow/spark-operator/v2/internal/metrics"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
@@ -54,11 +57,14 @@ func NewSparkPodEventHandler(client client.Client, metrics *metrics.SparkExecuto
 
 // Create implements handler.EventHandler.
 func (h *SparkPodEventHandler) Create(ctx context.Context, event event.CreateEvent, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
-	logger := log.FromContext(ctx)
 	pod, ok := event.Object.(*corev1.Pod)
 	if !ok {
 		return
 	}
+	ctx = util.WithCorrelationID(ctx, util.GetPodCorrelationID(pod))
+	ctx, span := startPodEventSpan(ctx, "Create", pod)
+	defer span.End()
+	logger := log.FromContext(ctx)
 	logger.Info("Spark pod created", "name", pod.Name, "namespace", pod.Namespace, "phase", pod.Status.Phase)
 	h.enqueueSparkAppForUpdate(ctx, pod, queue)
 
@@ -83,6 +89,9 @@ func (h *SparkPodEventHandler) Update(ctx context.Context, event event.UpdateEve
 		return
 	}
 
+	ctx = util.WithCorrelationID(ctx, util.GetPodCorrelationID(newPod))
+	ctx, span := startPodEventSpan(ctx, "Update", newPod)
+	defer span.End()
 	logger := log.FromContext(ctx)
 	logger.Info("Spark pod updated", "name", newPod.Name, "namespace", newPod.Namespace, "oldPhase", oldPod.Status.Phase, "newPhase", newPod.Status.Phase)
 	h.enqueueSparkAppForUpdate(ctx, newPod, queue)
@@ -99,6 +108,9 @@ func (h *SparkPodEventHandler) Delete(ctx context.Context, event event.DeleteEve
 		return
 	}
 
+	ctx = util.WithCorrelationID(ctx, util.GetPodCorrelationID(pod))
+	ctx, span := startPodEventSpan(ctx, "Delete", pod)
+	defer span.End()
 	logger := log.FromContext(ctx, "pod", pod.Name, "phase", pod.Status.Phase)
 	logger.Info("Spark pod deleted")
 	h.enqueueSparkAppForUpdate(ctx, pod, queue)
@@ -115,11 +127,24 @@ func (h *SparkPodEventHandler) Generic(ctx context.Context, event event.GenericE
 		return
 	}
 
+	ctx = util.WithCorrelationID(ctx, util.GetPodCorrelationID(pod))
+	ctx, span := startPodEventSpan(ctx, "Generic", pod)
+	defer span.End()
 	logger := log.FromContext(ctx, "pod", pod.Name, "phase", pod.Status.Phase)
 	logger.Info("Spark pod generic event ")
 	h.enqueueSparkAppForUpdate(ctx, pod, queue)
 }
 
+// startPodEventSpan starts the span of the handling of the given event of the given Spark pod.
+func startPodEventSpan(ctx context.Context, event string, pod *corev1.Pod) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "SparkPod."+event,
+		tracing.AttributeNamespace.String(pod.Namespace),
+		tracing.AttributePodName.String(pod.Name),
+		tracing.AttributePodPhase.String(string(pod.Status.Phase)),
+		tracing.AttributeCorrelationID.String(util.GetPodCorrelationID(pod)),
+	)
+}
+
 func (h *SparkPodEventHandler) enqueueSparkAppForUpdate(ctx context.Context, pod *corev1.Pod, queue workqueue.TypedRateLimitingInterface[ctrl.Request]) {
 	name := util.GetAppName(pod)
 	if name == "" {
@@ -151,15 +176,19 @@ func (h *SparkPodEventHandler) enqueueSparkAppForUpdate(ctx context.Context, pod
 
 // EventHandler watches SparkApplication events.
 type EventHandler struct {
-	metrics *metrics.SparkApplicationMetrics
+	metrics     *metrics.SparkApplicationMetrics
+	taskMetrics *metrics.SparkTaskMetrics
+	cloudEvents *cloudevents.Emitter
 }
 
 var _ handler.EventHandler = &EventHandler{}
 
 // NewSparkApplicationEventHandler creates a new SparkApplicationEventHandler instance.
-func NewSparkApplicationEventHandler(metrics *metrics.SparkApplicationMetrics) *EventHandler {
+func NewSparkApplicationEventHandler(metrics *metrics.SparkApplicationMetrics, taskMetrics *metrics.SparkTaskMetrics, cloudEvents *cloudevents.Emitter) *EventHandler {
 	return &EventHandler{
-		metrics: metrics,
+		metrics:     metrics,
+		taskMetrics: taskMetrics,
+		cloudEvents: cloudEvents,
 	}
 }
 
@@ -177,6 +206,11 @@ func (h *EventHandler) Create(ctx context.Context, event event.CreateEvent, queu
 	if h.metrics != nil {
 		h.metrics.HandleSparkApplicationCreate(app)
 	}
+
+	// Applications that already have a state were created before the operator (re)started.
+	if app.Status.AppState.State == v1beta2.ApplicationStateNew {
+		h.cloudEvents.Emit(ctx, app, cloudevents.EventTypeCreated, "")
+	}
 }
 
 // Update implements handler.EventHandler.
@@ -191,13 +225,17 @@ func (h *EventHandler) Update(ctx context.Context, event event.UpdateEvent, queu
 		return
 	}
 
-	logger := log.FromContext(ctx)
+	logger := log.FromContext(util.WithCorrelationID(ctx, util.GetCorrelationID(newApp)))
 	logger.Info("SparkApplication updated", "name", oldApp.Name, "namespace", oldApp.Namespace, "oldState", oldApp.Status.AppState.State, "newState", newApp.Status.AppState.State)
 	queue.AddRateLimited(ctrl.Request{NamespacedName: types.NamespacedName{Name: newApp.Name, Namespace: newApp.Namespace}})
 
 	if h.metrics != nil {
 		h.metrics.HandleSparkApplicationUpdate(oldApp, newApp)
 	}
+
+	if newApp.Status.AppState.State != oldApp.Status.AppState.State {
+		h.cloudEvents.Emit(ctx, newApp, cloudevents.GetStateEventType(newApp.Status.AppState.State), oldApp.Status.AppState.State)
+	}
 }
 
 // Delete implements handler.EventHandler.
@@ -207,13 +245,19 @@ func (h *EventHandler) Delete(ctx context.Context, event event.DeleteEvent, queu
 		return
 	}
 
-	logger := log.FromContext(ctx, "name", app.Name, "namespace", app.Namespace)
+	logger := log.FromContext(util.WithCorrelationID(ctx, util.GetCorrelationID(app)), "name", app.Name, "namespace", app.Namespace)
 	logger.Info("SparkApplication deleted", "state", app.Status.AppState.State)
 	queue.AddRateLimited(ctrl.Request{NamespacedName: types.NamespacedName{Name: app.Name, Namespace: app.Namespace}})
 
 	if h.metrics != nil {
 		h.metrics.HandleSparkApplicationDelete(app)
 	}
+
+	if h.taskMetrics != nil {
+		h.taskMetrics.HandleSparkApplicationDelete(app)
+	}
+
+	h.cloudEvents.Emit(ctx, app, cloudevents.EventTypeDeleted, app.Status.AppState.State)
 }
 
 // Generic implements handler.EventHandler.
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// eventPolicyRecorder is an event recorder that drops the events not allowed by the event policy of the
+// SparkApplication they are recorded for, falling back to the operator-wide default policy.
+type eventPolicyRecorder struct {
+	record.EventRecorder
+
+	defaultPolicy v1beta2.EventPolicy
+}
+
+// eventPolicyRecorder implements record.EventRecorder.
+var _ record.EventRecorder = &eventPolicyRecorder{}
+
+// newEventPolicyRecorder wraps the given recorder to apply event policies. An empty default policy emits every event.
+func newEventPolicyRecorder(recorder record.EventRecorder, defaultPolicy v1beta2.EventPolicy) record.EventRecorder {
+	if defaultPolicy == "" {
+		defaultPolicy = v1beta2.EventPolicyAll
+	}
+	return &eventPolicyRecorder{EventRecorder: recorder, defaultPolicy: defaultPolicy}
+}
+
+// Event implements record.EventRecorder.
+func (r *eventPolicyRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.allowed(object, eventtype, reason) {
+		r.EventRecorder.Event(object, eventtype, reason, message)
+	}
+}
+
+// Eventf implements record.EventRecorder.
+func (r *eventPolicyRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	if r.allowed(object, eventtype, reason) {
+		r.EventRecorder.Eventf(object, eventtype, reason, messageFmt, args...)
+	}
+}
+
+// AnnotatedEventf implements record.EventRecorder.
+func (r *eventPolicyRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	if r.allowed(object, eventtype, reason) {
+		r.EventRecorder.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+	}
+}
+
+func (r *eventPolicyRecorder) allowed(object runtime.Object, eventtype, reason string) bool {
+	policy := r.defaultPolicy
+	if app, ok := object.(*v1beta2.SparkApplication); ok && app.Spec.EventPolicy != "" {
+		policy = app.Spec.EventPolicy
+	}
+	return isEventAllowed(policy, eventtype, reason)
+}
+
+// isEventAllowed returns whether an event of the given type and reason is emitted under the given policy.
+// Warning events are always emitted.
+func isEventAllowed(policy v1beta2.EventPolicy, eventtype, reason string) bool {
+	if eventtype == corev1.EventTypeWarning {
+		return true
+	}
+
+	switch policy {
+	case v1beta2.EventPolicyErrorsOnly:
+		return false
+	case v1beta2.EventPolicyStateChangesOnly:
+		switch reason {
+		case common.EventSparkExecutorPending, common.EventSparkExecutorRunning, common.EventSparkExecutorCompleted:
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateEventPolicy returns an error if the given event policy is unknown.
+func ValidateEventPolicy(policy v1beta2.EventPolicy) error {
+	switch policy {
+	case v1beta2.EventPolicyAll, v1beta2.EventPolicyStateChangesOnly, v1beta2.EventPolicyErrorsOnly:
+		return nil
+	}
+	return fmt.Errorf("unknown event policy %q, must be one of %s, %s or %s",
+		policy, v1beta2.EventPolicyAll, v1beta2.EventPolicyStateChangesOnly, v1beta2.EventPolicyErrorsOnly)
+}
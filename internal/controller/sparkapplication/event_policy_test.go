@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestIsEventAllowed(t *testing.T) {
+	tests := []struct {
+		policy    v1beta2.EventPolicy
+		eventtype string
+		reason    string
+		want      bool
+	}{
+		{v1beta2.EventPolicyAll, corev1.EventTypeNormal, common.EventSparkExecutorRunning, true},
+		{v1beta2.EventPolicyStateChangesOnly, corev1.EventTypeNormal, common.EventSparkExecutorRunning, false},
+		{v1beta2.EventPolicyStateChangesOnly, corev1.EventTypeNormal, common.EventSparkExecutorCompleted, false},
+		{v1beta2.EventPolicyStateChangesOnly, corev1.EventTypeNormal, common.EventSparkDriverRunning, true},
+		{v1beta2.EventPolicyStateChangesOnly, corev1.EventTypeWarning, common.EventSparkExecutorFailed, true},
+		{v1beta2.EventPolicyErrorsOnly, corev1.EventTypeNormal, common.EventSparkApplicationCompleted, false},
+		{v1beta2.EventPolicyErrorsOnly, corev1.EventTypeWarning, common.EventSparkApplicationFailed, true},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.want, isEventAllowed(tc.policy, tc.eventtype, tc.reason), "%s %s %s", tc.policy, tc.eventtype, tc.reason)
+	}
+}
+
+func TestEventPolicyRecorder(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(10)
+	recorder := newEventPolicyRecorder(fakeRecorder, v1beta2.EventPolicyStateChangesOnly)
+
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+	recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkExecutorRunning, "Executor %s is running", "exec-1")
+	recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkDriverRunning, "Driver %s is running", "driver")
+	assert.Equal(t, "Normal SparkDriverRunning Driver driver is running", <-fakeRecorder.Events)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The policy of the application overrides the default one.
+	app.Spec.EventPolicy = v1beta2.EventPolicyAll
+	recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkExecutorRunning, "Executor %s is running", "exec-1")
+	assert.Equal(t, "Normal SparkExecutorRunning Executor exec-1 is running", <-fakeRecorder.Events)
+
+	app.Spec.EventPolicy = v1beta2.EventPolicyErrorsOnly
+	recorder.Event(app, corev1.EventTypeNormal, common.EventSparkDriverRunning, "Driver is running")
+	recorder.Event(app, corev1.EventTypeWarning, common.EventSparkDriverFailed, "Driver failed")
+	assert.Equal(t, "Warning SparkDriverFailed Driver failed", <-fakeRecorder.Events)
+	assert.Empty(t, fakeRecorder.Events)
+}
+
+func TestValidateEventPolicy(t *testing.T) {
+	assert.NoError(t, ValidateEventPolicy(v1beta2.EventPolicyStateChangesOnly))
+	assert.Error(t, ValidateEventPolicy("Verbose"))
+}
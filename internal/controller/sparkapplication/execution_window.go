@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// getExecutionWindows parses the execution windows of the given SparkApplication.
+func getExecutionWindows(app *v1beta2.SparkApplication) ([]util.TimeWindow, error) {
+	if app.Spec.ExecutionWindow == nil {
+		return nil, nil
+	}
+	windows := make([]util.TimeWindow, 0, len(app.Spec.ExecutionWindow.Windows))
+	for _, s := range app.Spec.ExecutionWindow.Windows {
+		window, err := util.ParseTimeWindow(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid execution window %q: %v", s, err)
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// waitForExecutionWindow queues the submission of the given SparkApplication when it is outside of its execution
+// windows, and returns whether it is queued and how long until its next window starts. SparkApplications with
+// invalid windows are failed without being submitted.
+func (r *Reconciler) waitForExecutionWindow(ctx context.Context, app *v1beta2.SparkApplication) (bool, time.Duration) {
+	windows, err := getExecutionWindows(app)
+	if err != nil {
+		meta.RemoveStatusCondition(&app.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+		app.Status.AppState = v1beta2.ApplicationState{
+			State:        v1beta2.ApplicationStateFailed,
+			ErrorMessage: err.Error(),
+		}
+		app.Status.TerminationTime = metav1.Now()
+		r.recordSparkApplicationEvent(app)
+		return true, 0
+	}
+	if len(windows) == 0 {
+		return false, 0
+	}
+
+	now := time.Now()
+	if _, ok := util.GetTimeWindowEnd(windows, now); ok {
+		return false, 0
+	}
+	start := util.GetNextTimeWindowStart(windows, now)
+	log.FromContext(ctx).Info("Queueing submission of SparkApplication until its execution window starts", "start", start)
+	message := fmt.Sprintf("Submission is queued until the execution window starts at %s", start.UTC().Format(time.RFC3339))
+	r.queueSubmission(app, v1beta2.SparkApplicationReasonExecutionWindow, message)
+	return true, start.Sub(now)
+}
+
+// enforceExecutionWindow stops the given running SparkApplication once its execution windows have ended, unless it
+// is allowed to continue. Paused applications are queued until their next window starts, killed ones fail.
+// It returns whether the application was stopped, or otherwise how long until its current window ends.
+func (r *Reconciler) enforceExecutionWindow(ctx context.Context, app *v1beta2.SparkApplication) (bool, time.Duration, error) {
+	window := app.Spec.ExecutionWindow
+	if window == nil || window.OnWindowEnd == "" || window.OnWindowEnd == v1beta2.ExecutionWindowEndContinue {
+		return false, 0, nil
+	}
+	// Invalid windows are reported when the application is submitted.
+	windows, err := getExecutionWindows(app)
+	if err != nil || len(windows) == 0 {
+		return false, 0, nil
+	}
+
+	now := time.Now()
+	if end, ok := util.GetTimeWindowEnd(windows, now); ok {
+		return false, end.Sub(now), nil
+	}
+
+	log.FromContext(ctx).Info("Stopping SparkApplication as its execution window ended", "policy", window.OnWindowEnd)
+	if err := r.deleteSparkResources(ctx, app); err != nil {
+		return false, 0, fmt.Errorf("failed to delete spark resources: %v", err)
+	}
+	switch window.OnWindowEnd {
+	case v1beta2.ExecutionWindowEndPause:
+		r.recorder.Event(app, corev1.EventTypeNormal, common.EventSparkApplicationExecutionWindowEnded,
+			"SparkApplication is paused until its next execution window starts")
+		app.Status.AppState.State = v1beta2.ApplicationStatePendingRerun
+		r.resetSparkApplicationStatus(app)
+		app.Status.AppState = v1beta2.ApplicationState{
+			State: v1beta2.ApplicationStateNew,
+		}
+	case v1beta2.ExecutionWindowEndKill:
+		r.recorder.Event(app, corev1.EventTypeWarning, common.EventSparkApplicationExecutionWindowEnded,
+			"SparkApplication is killed as its execution window ended")
+		app.Status.AppState = v1beta2.ApplicationState{
+			State:        v1beta2.ApplicationStateFailed,
+			ErrorMessage: "killed as its execution window ended",
+		}
+		app.Status.TerminationTime = metav1.Now()
+		r.recordSparkApplicationEvent(app)
+	}
+	return true, 0, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+const (
+	// alwaysActiveWindow starts every hour and lasts one hour.
+	alwaysActiveWindow = "0 * * * *;1h"
+	// rarelyActiveWindow only lasts the first minute of the year.
+	rarelyActiveWindow = "0 0 1 1 *;1m"
+)
+
+func TestReconcileNewSparkApplicationOutsideExecutionWindow(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	testCases := []struct {
+		name          string
+		window        string
+		expectedState v1beta2.ApplicationStateType
+	}{
+		{
+			name:          "outside of the window",
+			window:        rarelyActiveWindow,
+			expectedState: v1beta2.ApplicationStateNew,
+		},
+		{
+			name:          "invalid window",
+			window:        "0 22 * * *",
+			expectedState: v1beta2.ApplicationStateFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &v1beta2.SparkApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+				Spec: v1beta2.SparkApplicationSpec{
+					ExecutionWindow: &v1beta2.ExecutionWindow{Windows: []string{tc.window}},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).WithStatusSubresource(app).Build()
+			recorder := record.NewFakeRecorder(1)
+			reconciler := &Reconciler{client: client, recorder: recorder}
+
+			key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+			result, err := reconciler.reconcileNewSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			updated := &v1beta2.SparkApplication{}
+			require.NoError(t, client.Get(ctx, key, updated))
+			assert.Equal(t, tc.expectedState, updated.Status.AppState.State)
+			condition := meta.FindStatusCondition(updated.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+			if tc.expectedState == v1beta2.ApplicationStateFailed {
+				assert.Nil(t, condition)
+				assert.Contains(t, updated.Status.AppState.ErrorMessage, "invalid execution window")
+				return
+			}
+			assert.Positive(t, result.RequeueAfter)
+			require.NotNil(t, condition)
+			assert.Equal(t, v1beta2.SparkApplicationReasonExecutionWindow, condition.Reason)
+			assert.Contains(t, <-recorder.Events, common.EventSparkApplicationSubmissionQueued)
+		})
+	}
+}
+
+func TestEnforceExecutionWindow(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	testCases := []struct {
+		name            string
+		window          string
+		policy          v1beta2.ExecutionWindowEndPolicy
+		expectedStopped bool
+		expectedState   v1beta2.ApplicationStateType
+	}{
+		{
+			name:          "within the window",
+			window:        alwaysActiveWindow,
+			policy:        v1beta2.ExecutionWindowEndKill,
+			expectedState: v1beta2.ApplicationStateRunning,
+		},
+		{
+			name:          "continue after the window",
+			window:        rarelyActiveWindow,
+			policy:        v1beta2.ExecutionWindowEndContinue,
+			expectedState: v1beta2.ApplicationStateRunning,
+		},
+		{
+			name:            "pause after the window",
+			window:          rarelyActiveWindow,
+			policy:          v1beta2.ExecutionWindowEndPause,
+			expectedStopped: true,
+			expectedState:   v1beta2.ApplicationStateNew,
+		},
+		{
+			name:            "kill after the window",
+			window:          rarelyActiveWindow,
+			policy:          v1beta2.ExecutionWindowEndKill,
+			expectedStopped: true,
+			expectedState:   v1beta2.ApplicationStateFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := &v1beta2.SparkApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+				Spec: v1beta2.SparkApplicationSpec{
+					ExecutionWindow: &v1beta2.ExecutionWindow{Windows: []string{tc.window}, OnWindowEnd: tc.policy},
+				},
+				Status: v1beta2.SparkApplicationStatus{
+					SparkApplicationID: "spark-123",
+					AppState:           v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+					DriverInfo:         v1beta2.DriverInfo{PodName: "test-app-driver"},
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			recorder := record.NewFakeRecorder(2)
+			reconciler := &Reconciler{client: client, recorder: recorder}
+
+			stopped, requeueAfter, err := reconciler.enforceExecutionWindow(ctx, app)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStopped, stopped)
+			assert.Equal(t, tc.expectedState, app.Status.AppState.State)
+			if tc.policy != v1beta2.ExecutionWindowEndContinue && !tc.expectedStopped {
+				assert.Positive(t, requeueAfter)
+				assert.LessOrEqual(t, requeueAfter, time.Hour)
+			}
+			if tc.expectedState == v1beta2.ApplicationStateNew {
+				assert.Empty(t, app.Status.SparkApplicationID)
+			}
+			if tc.expectedStopped {
+				assert.Contains(t, <-recorder.Events, common.EventSparkApplicationExecutionWindowEnded)
+			}
+		})
+	}
+}
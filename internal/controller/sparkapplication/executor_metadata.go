@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// executorPodReader returns the reader to look up executor pods with. When only the metadata of executor pods
+// is watched, they are not in the manager cache and are read from the API server instead.
+func (r *Reconciler) executorPodReader() client.Reader {
+	if r.options.ExecutorPodCache != nil {
+		return r.manager.GetAPIReader()
+	}
+	return r.client
+}
+
+// executorPodMetadataSource returns a source that enqueues the SparkApplication of an executor pod
+// whenever the metadata of the pod changes.
+func (r *Reconciler) executorPodMetadataSource() source.Source {
+	pod := &metav1.PartialObjectMetadata{}
+	pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+	return source.Kind[client.Object](
+		r.options.ExecutorPodCache,
+		pod,
+		handler.EnqueueRequestsFromMapFunc(mapExecutorPodToSparkApplication),
+		util.NewNamespacePredicate(r.options.Namespaces),
+		r.options.Shard.Predicate(),
+	)
+}
+
+func mapExecutorPodToSparkApplication(_ context.Context, obj client.Object) []ctrl.Request {
+	name := obj.GetLabels()[common.LabelSparkAppName]
+	if name == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}}}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestMapExecutorPodToSparkApplication(t *testing.T) {
+	pod := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app-exec-1",
+			Namespace: "default",
+			Labels: map[string]string{
+				common.LabelSparkAppName: "test-app",
+				common.LabelSparkRole:    common.SparkRoleExecutor,
+			},
+		},
+	}
+	assert.Equal(t,
+		[]ctrl.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "test-app"}}},
+		mapExecutorPodToSparkApplication(context.Background(), pod),
+	)
+
+	pod.Labels = nil
+	assert.Empty(t, mapExecutorPodToSparkApplication(context.Background(), pod))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// getKerberosDir returns the directory the Kerberos credentials of the current submission of the given
+// SparkApplication are written to for spark-submit. It is removed along with the pod template files.
+func getKerberosDir(app *v1beta2.SparkApplication) string {
+	return fmt.Sprintf("/tmp/spark/%s/kerberos", app.Status.SubmissionID)
+}
+
+// configHadoopSecurity writes the keytab and the Kerberos configuration of the given SparkApplication for
+// spark-submit, which logs in as its principal with the keytab in the operator and obtains the delegation tokens of
+// the driver and executors. Spark ships the keytab to the driver, which obtains new delegation tokens with it before
+// they expire, and mounts the Kerberos configuration in the driver and executors.
+func (r *Reconciler) configHadoopSecurity(ctx context.Context, app *v1beta2.SparkApplication) (err error) {
+	security := app.Spec.HadoopSecurity
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: security.KerberosSecret, Namespace: app.Namespace}, secret); err != nil {
+		return fmt.Errorf("failed to get Kerberos secret %s: %v", security.KerberosSecret, err)
+	}
+
+	dir := getKerberosDir(app)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create Kerberos directory: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+	for _, key := range []string{common.KerberosKeytabKey, common.KerberosConfKey} {
+		data, ok := secret.Data[key]
+		if !ok {
+			return fmt.Errorf("secret %s has no %s key", security.KerberosSecret, key)
+		}
+		if err := os.WriteFile(filepath.Join(dir, key), data, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %v", key, err)
+		}
+	}
+
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	conf := app.Spec.SparkConf
+	conf[common.SparkKerberosPrincipal] = security.Principal
+	conf[common.SparkKerberosKeytab] = filepath.Join(dir, common.KerberosKeytabKey)
+	conf[common.SparkKubernetesKerberosKrb5Path] = filepath.Join(dir, common.KerberosConfKey)
+	util.SetIfNotExists(conf, common.SparkKerberosRenewalCredentials, common.KerberosRenewalCredentialsKeytab)
+	if len(security.AccessHadoopFileSystems) > 0 {
+		util.SetIfNotExists(conf, common.SparkKerberosAccessHadoopFileSystems, strings.Join(security.AccessHadoopFileSystems, ","))
+	}
+
+	if app.Spec.HadoopConf == nil {
+		app.Spec.HadoopConf = make(map[string]string)
+	}
+	util.SetIfNotExists(app.Spec.HadoopConf, common.HadoopSecurityAuthentication, common.HadoopSecurityAuthenticationKerberos)
+	return nil
+}
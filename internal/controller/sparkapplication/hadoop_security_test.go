@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestConfigHadoopSecurity(t *testing.T) {
+	ctx := context.Background()
+	newApp := func(submissionID string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				HadoopSecurity: &v1beta2.HadoopSecuritySpec{
+					Principal:               "etl@EXAMPLE.COM",
+					KerberosSecret:          "etl-kerberos",
+					AccessHadoopFileSystems: []string{"hdfs://namenode1:8020", "hdfs://namenode2:8020"},
+				},
+			},
+			Status: v1beta2.SparkApplicationStatus{SubmissionID: submissionID},
+		}
+	}
+
+	t.Run("write Kerberos credentials", func(t *testing.T) {
+		app := newApp("kerberos-test-1")
+		t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(getKerberosDir(app))) })
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "etl-kerberos", Namespace: "default"},
+			Data:       map[string][]byte{"krb5.keytab": []byte("keytab"), "krb5.conf": []byte("[libdefaults]")},
+		}).Build()
+		reconciler := &Reconciler{client: client}
+
+		require.NoError(t, reconciler.configHadoopSecurity(ctx, app))
+
+		dir := "/tmp/spark/kerberos-test-1/kerberos"
+		assert.Equal(t, map[string]string{
+			"spark.kerberos.principal":                "etl@EXAMPLE.COM",
+			"spark.kerberos.keytab":                   dir + "/krb5.keytab",
+			"spark.kubernetes.kerberos.krb5.path":     dir + "/krb5.conf",
+			"spark.kerberos.renewal.credentials":      "keytab",
+			"spark.kerberos.access.hadoopFileSystems": "hdfs://namenode1:8020,hdfs://namenode2:8020",
+		}, app.Spec.SparkConf)
+		assert.Equal(t, map[string]string{"hadoop.security.authentication": "kerberos"}, app.Spec.HadoopConf)
+
+		keytab, err := os.ReadFile(dir + "/krb5.keytab")
+		require.NoError(t, err)
+		assert.Equal(t, "keytab", string(keytab))
+		info, err := os.Stat(dir + "/krb5.keytab")
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		assert.Equal(t, []string{"SPARK_SUBMIT_OPTS=-Djava.security.krb5.conf=" + dir + "/krb5.conf"}, buildSparkSubmitEnv(app))
+	})
+
+	t.Run("secret without keytab", func(t *testing.T) {
+		app := newApp("kerberos-test-2")
+		t.Cleanup(func() { _ = os.RemoveAll(filepath.Dir(getKerberosDir(app))) })
+		client := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "etl-kerberos", Namespace: "default"},
+			Data:       map[string][]byte{"krb5.conf": []byte("[libdefaults]")},
+		}).Build()
+		reconciler := &Reconciler{client: client}
+
+		err := reconciler.configHadoopSecurity(ctx, app)
+		require.EqualError(t, err, "secret etl-kerberos has no krb5.keytab key")
+		assert.NoDirExists(t, getKerberosDir(app))
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		reconciler := &Reconciler{client: fake.NewClientBuilder().Build()}
+
+		err := reconciler.configHadoopSecurity(ctx, newApp("kerberos-test-3"))
+		require.ErrorContains(t, err, "failed to get Kerberos secret etl-kerberos")
+	})
+}
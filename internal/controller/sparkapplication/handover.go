@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// resumeInFlightSubmission adopts the driver pod of a SparkApplication that was submitted by a previous
+// leader which stopped before recording the submission in the status, so that the new leader does not
+// submit a second driver. It returns whether such a driver pod was adopted.
+func (r *Reconciler) resumeInFlightSubmission(ctx context.Context, app *v1beta2.SparkApplication) (bool, error) {
+	logger := log.FromContext(ctx)
+	podName := util.GetDriverPodName(app)
+	pod := &corev1.Pod{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: podName, Namespace: app.Namespace}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get driver pod %s: %v", podName, err)
+	}
+
+	submissionID := pod.Labels[common.LabelSubmissionID]
+	if submissionID == "" || pod.DeletionTimestamp != nil || !isDriverPodOf(pod, app) {
+		return false, nil
+	}
+
+	logger.Info("Resuming in-flight submission from existing driver pod", "pod", pod.Name, "submissionID", submissionID)
+	app.Status.SubmissionID = submissionID
+	app.Status.DriverInfo.PodName = pod.Name
+	app.Status.LastSubmissionAttemptTime = pod.CreationTimestamp
+	app.Status.SubmissionAttempts = app.Status.SubmissionAttempts + 1
+	app.Status.ExecutionAttempts = app.Status.ExecutionAttempts + 1
+	app.Status.LastRestartedAt = app.Annotations[common.AnnotationRestartedAt]
+	app.Status.AppState = v1beta2.ApplicationState{
+		State: v1beta2.ApplicationStateSubmitted,
+	}
+	r.recorder.Eventf(
+		app,
+		corev1.EventTypeNormal,
+		common.EventSparkApplicationSubmissionResumed,
+		"SparkApplication %s resumed submission from existing driver pod %s",
+		app.Name,
+		pod.Name,
+	)
+	return true, nil
+}
+
+// isDriverPodOf returns whether the given pod is a driver launched by the operator for the given SparkApplication,
+// rather than a leftover of a previous SparkApplication with the same name.
+func isDriverPodOf(pod *corev1.Pod, app *v1beta2.SparkApplication) bool {
+	if pod.Labels[common.LabelSparkAppName] != app.Name ||
+		pod.Labels[common.LabelSparkRole] != common.SparkRoleDriver ||
+		pod.Labels[common.LabelLaunchedBySparkOperator] != "true" {
+		return false
+	}
+
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "SparkApplication" {
+			return ref.UID == app.UID
+		}
+	}
+	return !pod.CreationTimestamp.Before(&app.CreationTimestamp)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestResumeInFlightSubmission(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	created := metav1.NewTime(time.Now().Add(-time.Minute))
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default", UID: "test-uid", CreationTimestamp: created},
+	}
+	newDriverPod := func(mutate func(pod *corev1.Pod)) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-app-driver",
+				Namespace:         "default",
+				CreationTimestamp: metav1.Now(),
+				Labels: map[string]string{
+					common.LabelSparkAppName:            "test-app",
+					common.LabelSparkRole:               common.SparkRoleDriver,
+					common.LabelLaunchedBySparkOperator: "true",
+					common.LabelSubmissionID:            "submission-1",
+				},
+			},
+		}
+		if mutate != nil {
+			mutate(pod)
+		}
+		return pod
+	}
+
+	testCases := []struct {
+		name    string
+		pod     *corev1.Pod
+		resumed bool
+	}{
+		{
+			name: "no driver pod",
+		},
+		{
+			name:    "driver pod submitted by previous leader",
+			pod:     newDriverPod(nil),
+			resumed: true,
+		},
+		{
+			name: "driver pod owned by the application",
+			pod: newDriverPod(func(pod *corev1.Pod) {
+				pod.CreationTimestamp = metav1.NewTime(created.Add(-time.Hour))
+				pod.OwnerReferences = []metav1.OwnerReference{{Kind: "SparkApplication", Name: "test-app", UID: "test-uid"}}
+			}),
+			resumed: true,
+		},
+		{
+			name: "driver pod of a previous application with the same name",
+			pod: newDriverPod(func(pod *corev1.Pod) {
+				pod.OwnerReferences = []metav1.OwnerReference{{Kind: "SparkApplication", Name: "test-app", UID: "old-uid"}}
+			}),
+		},
+		{
+			name: "driver pod older than the application",
+			pod: newDriverPod(func(pod *corev1.Pod) {
+				pod.CreationTimestamp = metav1.NewTime(created.Add(-time.Hour))
+			}),
+		},
+		{
+			name: "pod without submission ID",
+			pod: newDriverPod(func(pod *corev1.Pod) {
+				delete(pod.Labels, common.LabelSubmissionID)
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.pod != nil {
+				builder = builder.WithObjects(tc.pod)
+			}
+			reconciler := &Reconciler{client: builder.Build(), recorder: record.NewFakeRecorder(10)}
+
+			newApp := app.DeepCopy()
+			resumed, err := reconciler.resumeInFlightSubmission(ctx, newApp)
+			require.NoError(t, err)
+			assert.Equal(t, tc.resumed, resumed)
+			if !tc.resumed {
+				assert.Equal(t, app.Status, newApp.Status)
+				return
+			}
+			assert.Equal(t, v1beta2.ApplicationStateSubmitted, newApp.Status.AppState.State)
+			assert.Equal(t, "submission-1", newApp.Status.SubmissionID)
+			assert.Equal(t, "test-app-driver", newApp.Status.DriverInfo.PodName)
+			assert.Equal(t, int32(1), newApp.Status.SubmissionAttempts)
+			assert.Equal(t, int32(1), newApp.Status.ExecutionAttempts)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+const (
+	// defaultHTTPHookMethod is the method of HTTP hooks that do not specify one.
+	defaultHTTPHookMethod = http.MethodPost
+
+	// defaultHTTPHookTimeoutSeconds is the timeout of HTTP hooks that do not specify one.
+	defaultHTTPHookTimeoutSeconds = 10
+
+	// maxHTTPHookTimeoutSeconds caps the timeout of HTTP hooks, which are sent by the reconcile workers.
+	maxHTTPHookTimeoutSeconds = 30
+)
+
+// hookPayload is the JSON body sent by HTTP hooks.
+type hookPayload struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	UID                string `json:"uid"`
+	Event              string `json:"event"`
+	State              string `json:"state"`
+	SubmissionID       string `json:"submissionID,omitempty"`
+	SparkApplicationID string `json:"sparkApplicationId,omitempty"`
+}
+
+// recordHooks records a pending run of each operator hook of the given SparkApplication registered for the event of
+// its current state. Each hook is run at most once per submission and event.
+func recordHooks(app *v1beta2.SparkApplication) {
+	if app.Spec.Hooks == nil {
+		return
+	}
+	event, ok := getHookEvent(app.Status.AppState.State)
+	if !ok {
+		return
+	}
+
+	for _, hook := range app.Spec.Hooks.Operator {
+		if !slices.Contains(hook.Events, event) {
+			continue
+		}
+		if status := findHookStatus(app, hook.Name, event); status != nil && status.SubmissionID == app.Status.SubmissionID {
+			continue
+		}
+		setHookStatus(app, v1beta2.HookStatus{
+			Name:         hook.Name,
+			Event:        event,
+			SubmissionID: app.Status.SubmissionID,
+			Phase:        v1beta2.HookPhasePending,
+			Time:         metav1.Now(),
+		})
+	}
+}
+
+// runHooks runs the pending operator hooks of the SparkApplication and records their outcome in the application
+// status. Hooks are run after the transition they were recorded on is persisted, so that they are not run again
+// when the status update conflicts. A failed hook is not retried nor does it affect the application.
+func (r *Reconciler) runHooks(ctx context.Context, key types.NamespacedName) error {
+	app, err := r.getSparkApplication(ctx, key)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	logger := log.FromContext(ctx)
+	var ran []v1beta2.HookStatus
+	for _, status := range app.Status.Hooks {
+		if status.Phase != v1beta2.HookPhasePending {
+			continue
+		}
+
+		hook := findOperatorHook(app, status.Name)
+		switch {
+		case hook == nil:
+			err = fmt.Errorf("hook is no longer configured")
+		case hook.Job != nil:
+			status.JobName, err = r.createHookJob(ctx, app, hook, status.Event)
+			if err == nil {
+				status.Message = fmt.Sprintf("Job %s created", status.JobName)
+			}
+		case hook.HTTP != nil:
+			status.Message, err = r.sendHTTPHook(ctx, app, hook.HTTP, status.Event)
+		default:
+			err = fmt.Errorf("neither job nor http is specified")
+		}
+
+		if err != nil {
+			logger.Error(err, "Failed to run hook", "hook", status.Name, "event", status.Event)
+			status.Phase = v1beta2.HookPhaseFailed
+			status.Message = err.Error()
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeWarning,
+				common.EventSparkApplicationHookFailed,
+				"Hook %s for event %s failed: %v",
+				status.Name,
+				status.Event,
+				err,
+			)
+		} else {
+			logger.Info("Ran hook", "hook", status.Name, "event", status.Event)
+			status.Phase = v1beta2.HookPhaseSucceeded
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeNormal,
+				common.EventSparkApplicationHookSucceeded,
+				"Hook %s for event %s succeeded: %s",
+				status.Name,
+				status.Event,
+				status.Message,
+			)
+		}
+		ran = append(ran, status)
+	}
+
+	if len(ran) == 0 {
+		return nil
+	}
+
+	retryErr := retry.RetryOnConflict(
+		retry.DefaultRetry,
+		func() error {
+			app, err := r.getSparkApplication(ctx, key)
+			if err != nil {
+				return err
+			}
+			for _, status := range ran {
+				// Do not overwrite a hook run recorded for a newer transition in the meantime.
+				if existing := findHookStatus(app, status.Name, status.Event); existing != nil &&
+					existing.SubmissionID == status.SubmissionID && existing.Time.Equal(&status.Time) {
+					*existing = status
+				}
+			}
+			return r.client.Status().Update(ctx, app)
+		},
+	)
+	if retryErr != nil {
+		if errors.IsNotFound(retryErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to update hook status: %v", retryErr)
+	}
+	return nil
+}
+
+// createHookJob creates the Job of the given hook and returns its name. A Job that already exists is
+// considered to be created by a previous attempt.
+func (r *Reconciler) createHookJob(ctx context.Context, app *v1beta2.SparkApplication, hook *v1beta2.OperatorHook, event v1beta2.HookEvent) (string, error) {
+	job := &batchv1.Job{
+		ObjectMeta: *hook.Job.ObjectMeta.DeepCopy(),
+		Spec:       *hook.Job.Spec.DeepCopy(),
+	}
+	job.Name = util.GetHookJobName(app, hook.Name, event)
+	job.GenerateName = ""
+	job.Namespace = app.Namespace
+	if job.Labels == nil {
+		job.Labels = make(map[string]string)
+	}
+	maps.Copy(job.Labels, util.GetResourceLabels(app))
+	job.Labels[common.LabelHookName] = hook.Name
+	job.OwnerReferences = []metav1.OwnerReference{util.GetOwnerReference(app)}
+
+	env := []corev1.EnvVar{
+		{Name: "SPARK_APPLICATION_NAME", Value: app.Name},
+		{Name: "SPARK_APPLICATION_NAMESPACE", Value: app.Namespace},
+		{Name: "SPARK_APPLICATION_STATE", Value: string(app.Status.AppState.State)},
+		{Name: "SPARK_APPLICATION_ID", Value: app.Status.SparkApplicationID},
+	}
+	for i := range job.Spec.Template.Spec.InitContainers {
+		job.Spec.Template.Spec.InitContainers[i].Env = append(job.Spec.Template.Spec.InitContainers[i].Env, env...)
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, env...)
+	}
+
+	if err := r.client.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create job %s: %v", job.Name, err)
+	}
+	return job.Name, nil
+}
+
+// sendHTTPHook sends the request of the given HTTP hook and returns a description of the response.
+func (r *Reconciler) sendHTTPHook(ctx context.Context, app *v1beta2.SparkApplication, hook *v1beta2.HTTPHook, event v1beta2.HookEvent) (string, error) {
+	body, err := json.Marshal(hookPayload{
+		Name:               app.Name,
+		Namespace:          app.Namespace,
+		UID:                string(app.UID),
+		Event:              string(event),
+		State:              string(app.Status.AppState.State),
+		SubmissionID:       app.Status.SubmissionID,
+		SparkApplicationID: app.Status.SparkApplicationID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request body: %v", err)
+	}
+
+	timeout := min(ptr.Deref(hook.TimeoutSeconds, defaultHTTPHookTimeoutSeconds), maxHTTPHookTimeoutSeconds)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	method := defaultHTTPHookMethod
+	if hook.Method != nil {
+		method = *hook.Method
+	}
+	req, err := http.NewRequestWithContext(ctx, method, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := r.hookClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("%s %s returned %s", method, hook.URL, resp.Status)
+	}
+	return fmt.Sprintf("%s %s returned %s", method, hook.URL, resp.Status), nil
+}
+
+// getHookEvent returns the hook event of the given application state, if it has one.
+func getHookEvent(state v1beta2.ApplicationStateType) (v1beta2.HookEvent, bool) {
+	switch state {
+	case v1beta2.ApplicationStateSubmitted:
+		return v1beta2.HookEventSubmitted, true
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
+		return v1beta2.HookEventFailedSubmission, true
+	case v1beta2.ApplicationStateCompleted:
+		return v1beta2.HookEventCompleted, true
+	case v1beta2.ApplicationStateFailed:
+		return v1beta2.HookEventFailed, true
+	}
+	return "", false
+}
+
+func findOperatorHook(app *v1beta2.SparkApplication, name string) *v1beta2.OperatorHook {
+	if app.Spec.Hooks == nil {
+		return nil
+	}
+	for i := range app.Spec.Hooks.Operator {
+		if app.Spec.Hooks.Operator[i].Name == name {
+			return &app.Spec.Hooks.Operator[i]
+		}
+	}
+	return nil
+}
+
+func findHookStatus(app *v1beta2.SparkApplication, name string, event v1beta2.HookEvent) *v1beta2.HookStatus {
+	for i := range app.Status.Hooks {
+		if app.Status.Hooks[i].Name == name && app.Status.Hooks[i].Event == event {
+			return &app.Status.Hooks[i]
+		}
+	}
+	return nil
+}
+
+// setHookStatus records the given hook status, replacing the previous run of the same hook and event.
+func setHookStatus(app *v1beta2.SparkApplication, status v1beta2.HookStatus) {
+	if existing := findHookStatus(app, status.Name, status.Event); existing != nil {
+		*existing = status
+		return
+	}
+	app.Status.Hooks = append(app.Status.Hooks, status)
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func newHookTestApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			SparkApplicationID: "spark-123",
+			SubmissionID:       "submission-1",
+			AppState:           v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted},
+		},
+	}
+}
+
+func TestRecordHooks(t *testing.T) {
+	app := newHookTestApp()
+	app.Status.AppState.State = v1beta2.ApplicationStateSubmitted
+	app.Spec.Hooks = &v1beta2.Hooks{
+		Operator: []v1beta2.OperatorHook{
+			{
+				Name:   "notify",
+				Events: []v1beta2.HookEvent{v1beta2.HookEventSubmitted, v1beta2.HookEventFailedSubmission},
+				HTTP:   &v1beta2.HTTPHook{URL: "https://hooks.example.com"},
+			},
+		},
+	}
+
+	recordHooks(app)
+	require.Len(t, app.Status.Hooks, 1)
+	assert.Equal(t, v1beta2.HookEventSubmitted, app.Status.Hooks[0].Event)
+	assert.Equal(t, v1beta2.HookPhasePending, app.Status.Hooks[0].Phase)
+
+	// The hook is recorded once per submission and event.
+	app.Status.Hooks[0].Phase = v1beta2.HookPhaseSucceeded
+	recordHooks(app)
+	require.Len(t, app.Status.Hooks, 1)
+	assert.Equal(t, v1beta2.HookPhaseSucceeded, app.Status.Hooks[0].Phase)
+
+	// Failed submissions and preflight checks run the FailedSubmission hooks.
+	for _, state := range []v1beta2.ApplicationStateType{v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed} {
+		app.Status.Hooks = nil
+		app.Status.AppState.State = state
+		recordHooks(app)
+		require.Len(t, app.Status.Hooks, 1, state)
+		assert.Equal(t, v1beta2.HookEventFailedSubmission, app.Status.Hooks[0].Event, state)
+	}
+
+	// States without a hook event record nothing.
+	app.Status.Hooks = nil
+	app.Status.AppState.State = v1beta2.ApplicationStateRunning
+	recordHooks(app)
+	assert.Empty(t, app.Status.Hooks)
+}
+
+func TestRunHooks_Job(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := newHookTestApp()
+	app.Spec.Hooks = &v1beta2.Hooks{
+		Operator: []v1beta2.OperatorHook{
+			{
+				Name:   "cleanup",
+				Events: []v1beta2.HookEvent{v1beta2.HookEventCompleted},
+				Job: &batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+					Spec: batchv1.JobSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers:    []corev1.Container{{Name: "cleanup", Image: "busybox"}},
+								RestartPolicy: corev1.RestartPolicyNever,
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:   "on-failure",
+				Events: []v1beta2.HookEvent{v1beta2.HookEventFailed},
+				HTTP:   &v1beta2.HTTPHook{URL: "http://127.0.0.1:0"},
+			},
+		},
+	}
+	recordHooks(app)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	require.NoError(t, reconciler.runHooks(ctx, key))
+
+	jobName := util.GetHookJobName(app, "cleanup", v1beta2.HookEventCompleted)
+	job := &batchv1.Job{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: jobName, Namespace: "default"}, job))
+	assert.Equal(t, map[string]string{
+		"team":                   "data",
+		common.LabelSparkAppName: "test-app",
+		common.LabelSubmissionID: "submission-1",
+		common.LabelHookName:     "cleanup",
+	}, job.Labels)
+	require.Len(t, job.OwnerReferences, 1)
+	assert.Equal(t, app.UID, job.OwnerReferences[0].UID)
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "SPARK_APPLICATION_STATE", Value: "COMPLETED"})
+	assert.Contains(t, job.Spec.Template.Spec.Containers[0].Env, corev1.EnvVar{Name: "SPARK_APPLICATION_ID", Value: "spark-123"})
+
+	got := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, got))
+	require.Len(t, got.Status.Hooks, 1)
+	assert.Equal(t, "cleanup", got.Status.Hooks[0].Name)
+	assert.Equal(t, v1beta2.HookEventCompleted, got.Status.Hooks[0].Event)
+	assert.Equal(t, v1beta2.HookPhaseSucceeded, got.Status.Hooks[0].Phase)
+	assert.Equal(t, jobName, got.Status.Hooks[0].JobName)
+	assert.Len(t, recorder.Events, 1)
+
+	// The hook is not run again for the same submission.
+	recordHooks(got)
+	require.NoError(t, client.Status().Update(ctx, got))
+	require.NoError(t, reconciler.runHooks(ctx, key))
+	assert.Len(t, recorder.Events, 1)
+
+	// A new submission runs the hook again with a new Job.
+	require.NoError(t, client.Get(ctx, key, got))
+	got.Status.SubmissionID = "submission-2"
+	recordHooks(got)
+	require.NoError(t, client.Status().Update(ctx, got))
+	require.NoError(t, reconciler.runHooks(ctx, key))
+	require.NoError(t, client.Get(ctx, key, got))
+	require.Len(t, got.Status.Hooks, 1)
+	assert.Equal(t, "submission-2", got.Status.Hooks[0].SubmissionID)
+	assert.Equal(t, v1beta2.HookPhaseSucceeded, got.Status.Hooks[0].Phase)
+	assert.NotEqual(t, jobName, got.Status.Hooks[0].JobName)
+}
+
+func TestRunHooks_HTTP(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	var received hookPayload
+	var header string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	app := newHookTestApp()
+	app.Status.AppState.State = v1beta2.ApplicationStateFailed
+	app.Spec.Hooks = &v1beta2.Hooks{
+		Operator: []v1beta2.OperatorHook{
+			{
+				Name:   "notify",
+				Events: []v1beta2.HookEvent{v1beta2.HookEventFailed},
+				HTTP: &v1beta2.HTTPHook{
+					URL:     server.URL,
+					Method:  ptr.To(http.MethodPut),
+					Headers: map[string]string{"Authorization": "Bearer token"},
+				},
+			},
+		},
+	}
+	recordHooks(app)
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(10)
+	// The test server listens on a loopback address, which is only reachable when allowed explicitly.
+	reconciler := &Reconciler{
+		client:     client,
+		recorder:   recorder,
+		hookClient: (&egress.Policy{AllowedHosts: []string{"127.0.0.1"}}).NewClient(time.Second),
+	}
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	require.NoError(t, reconciler.runHooks(ctx, key))
+
+	assert.Equal(t, "Bearer token", header)
+	assert.Equal(t, hookPayload{
+		Name:               "test-app",
+		Namespace:          "default",
+		UID:                "test-uid",
+		Event:              "Failed",
+		State:              "FAILED",
+		SubmissionID:       "submission-1",
+		SparkApplicationID: "spark-123",
+	}, received)
+	got := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, got))
+	require.Len(t, got.Status.Hooks, 1)
+	assert.Equal(t, v1beta2.HookPhaseSucceeded, got.Status.Hooks[0].Phase)
+
+	// A non-2xx response fails the hook.
+	status = http.StatusInternalServerError
+	got.Status.SubmissionID = "submission-2"
+	recordHooks(got)
+	require.NoError(t, client.Status().Update(ctx, got))
+	require.NoError(t, reconciler.runHooks(ctx, key))
+	require.NoError(t, client.Get(ctx, key, got))
+	require.Len(t, got.Status.Hooks, 1)
+	assert.Equal(t, v1beta2.HookPhaseFailed, got.Status.Hooks[0].Phase)
+	assert.Contains(t, got.Status.Hooks[0].Message, "500 Internal Server Error")
+	assert.Len(t, recorder.Events, 2)
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationHookSucceeded)
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationHookFailed)
+
+	// Hooks to addresses that are not allowed by the egress policy fail without sending a request.
+	received = hookPayload{}
+	reconciler.hookClient = (&egress.Policy{}).NewClient(time.Second)
+	got.Status.SubmissionID = "submission-3"
+	recordHooks(got)
+	require.NoError(t, client.Status().Update(ctx, got))
+	require.NoError(t, reconciler.runHooks(ctx, key))
+	require.NoError(t, client.Get(ctx, key, got))
+	assert.Equal(t, v1beta2.HookPhaseFailed, got.Status.Hooks[0].Phase)
+	assert.Contains(t, got.Status.Hooks[0].Message, "not allowed by the egress policy")
+	assert.Empty(t, received.Name)
+}
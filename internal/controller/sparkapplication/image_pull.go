@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// failOnExecutorImagePullFailure fails the given SparkApplication if any of its executors has been unable to pull
+// its image for longer than the configured timeout. The driver pod is deleted as well, since it would otherwise
+// keep waiting for executors that never come up. Pod events only trigger reconciles on phase changes, so it returns
+// the time until the earliest of the image pull failures within the timeout exceeds it, or zero if there are none.
+func (r *Reconciler) failOnExecutorImagePullFailure(ctx context.Context, app *v1beta2.SparkApplication) (time.Duration, error) {
+	timeout := r.options.ExecutorImagePullFailureTimeout
+	if timeout <= 0 {
+		return 0, nil
+	}
+	if app.Status.AppState.State != v1beta2.ApplicationStateSubmitted && app.Status.AppState.State != v1beta2.ApplicationStateRunning {
+		return 0, nil
+	}
+
+	pods, err := r.getExecutorPods(ctx, app)
+	if err != nil {
+		return 0, err
+	}
+
+	var requeueAfter time.Duration
+
+	now := metav1.Now()
+	for _, pod := range pods.Items {
+		status := util.GetImagePullFailure(&pod)
+		if status == nil {
+			continue
+		}
+		since := pod.CreationTimestamp
+		if pod.Status.StartTime != nil {
+			since = *pod.Status.StartTime
+		}
+		if elapsed := now.Sub(since.Time); elapsed < timeout {
+			if remaining := timeout - elapsed; requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		message := fmt.Sprintf(
+			"executor pod %s failed to pull image %q from registry %s: %s: %s",
+			pod.Name,
+			status.Image,
+			util.GetImageRegistry(status.Image),
+			status.State.Waiting.Reason,
+			status.State.Waiting.Message,
+		)
+		log.FromContext(ctx).Info("Failing SparkApplication as executor image cannot be pulled", "pod", pod.Name, "image", status.Image)
+		r.recorder.Event(app, corev1.EventTypeWarning, common.EventSparkExecutorImagePullFailed, message)
+
+		if err := r.deleteDriverPod(ctx, app); err != nil {
+			return 0, err
+		}
+		app.Status.AppState.State = v1beta2.ApplicationStateFailing
+		app.Status.AppState.ErrorMessage = message
+		app.Status.TerminationTime = now
+		return 0, nil
+	}
+
+	return requeueAfter, nil
+}
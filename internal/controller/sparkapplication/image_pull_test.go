@@ -0,0 +1,135 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestFailOnExecutorImagePullFailure(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	newApp := func() *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:   v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+				DriverInfo: v1beta2.DriverInfo{PodName: "test-app-driver"},
+			},
+		}
+	}
+	driverPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"}}
+	newExecutorPod := func(startedAgo time.Duration, reason string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-app-exec-1",
+				Namespace: "default",
+				Labels: map[string]string{
+					common.LabelSparkAppName: "test-app",
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase:     corev1.PodPending,
+				StartTime: &metav1.Time{Time: time.Now().Add(-startedAgo)},
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  common.SparkExecutorContainerName,
+					Image: "registry.example.com/spark:missing",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: "manifest unknown"},
+					},
+				}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		timeout          time.Duration
+		executorPod      *corev1.Pod
+		wantFailing      bool
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:        "check disabled",
+			executorPod: newExecutorPod(time.Hour, "ImagePullBackOff"),
+		},
+		{
+			name:             "image pull failure within timeout",
+			timeout:          5 * time.Minute,
+			executorPod:      newExecutorPod(time.Minute, "ErrImagePull"),
+			wantRequeueAfter: 4 * time.Minute,
+		},
+		{
+			name:        "executor waiting for another reason",
+			timeout:     5 * time.Minute,
+			executorPod: newExecutorPod(time.Hour, "ContainerCreating"),
+		},
+		{
+			name:        "image pull failure exceeds timeout",
+			timeout:     5 * time.Minute,
+			executorPod: newExecutorPod(time.Hour, "ImagePullBackOff"),
+			wantFailing: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(driverPod.DeepCopy(), tc.executorPod).Build()
+			reconciler := &Reconciler{
+				client:   client,
+				recorder: record.NewFakeRecorder(10),
+				options:  Options{ExecutorImagePullFailureTimeout: tc.timeout},
+			}
+			app := newApp()
+			requeueAfter, err := reconciler.failOnExecutorImagePullFailure(ctx, app)
+			require.NoError(t, err)
+			assert.InDelta(t, tc.wantRequeueAfter.Seconds(), requeueAfter.Seconds(), 5)
+
+			err = client.Get(ctx, types.NamespacedName{Name: "test-app-driver", Namespace: "default"}, &corev1.Pod{})
+			if !tc.wantFailing {
+				assert.Equal(t, v1beta2.ApplicationStateRunning, app.Status.AppState.State)
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, v1beta2.ApplicationStateFailing, app.Status.AppState.State)
+			assert.Equal(t,
+				`executor pod test-app-exec-1 failed to pull image "registry.example.com/spark:missing" from registry registry.example.com: ImagePullBackOff: manifest unknown`,
+				app.Status.AppState.ErrorMessage,
+			)
+			assert.True(t, errors.IsNotFound(err))
+		})
+	}
+}
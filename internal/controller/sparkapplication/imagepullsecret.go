@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// ParseDefaultImagePullSecret parses a default image pull secret given as `name`, referring to a secret in the
+// namespace of each SparkApplication, or as `namespace/name`, referring to a secret copied into those namespaces.
+func ParseDefaultImagePullSecret(value string) (types.NamespacedName, error) {
+	if value == "" {
+		return types.NamespacedName{}, nil
+	}
+	parts := strings.Split(value, "/")
+	switch {
+	case len(parts) == 1:
+		return types.NamespacedName{Name: parts[0]}, nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return types.NamespacedName{Namespace: parts[0], Name: parts[1]}, nil
+	default:
+		return types.NamespacedName{}, fmt.Errorf("invalid image pull secret %q, expected name or namespace/name", value)
+	}
+}
+
+// configImagePullSecrets adds the default image pull secret of the operator to the given SparkApplication and
+// checks that all its image pull secrets exist, so that a missing secret fails the submission instead of
+// leaving the driver pod unable to pull its image.
+func (r *Reconciler) configImagePullSecrets(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if r.options.DefaultImagePullSecret.Name != "" {
+		if err := r.copyDefaultImagePullSecret(ctx, app.Namespace); err != nil {
+			return err
+		}
+		if !slices.Contains(app.Spec.ImagePullSecrets, r.options.DefaultImagePullSecret.Name) {
+			app.Spec.ImagePullSecrets = append(app.Spec.ImagePullSecrets, r.options.DefaultImagePullSecret.Name)
+		}
+	}
+
+	for _, name := range app.Spec.ImagePullSecrets {
+		secret := &corev1.Secret{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: name, Namespace: app.Namespace}, secret); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("image pull secret %s not found", name)
+			}
+			return fmt.Errorf("failed to get image pull secret %s: %v", name, err)
+		}
+		if secret.Type != corev1.SecretTypeDockerConfigJson && secret.Type != corev1.SecretTypeDockercfg {
+			return fmt.Errorf("image pull secret %s has type %s instead of %s or %s", name, secret.Type, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg)
+		}
+	}
+	return nil
+}
+
+// copyDefaultImagePullSecret copies the default image pull secret into the given namespace if it lives in another
+// namespace. A secret of the same name that was not copied by the operator is left untouched.
+func (r *Reconciler) copyDefaultImagePullSecret(ctx context.Context, namespace string) error {
+	source := r.options.DefaultImagePullSecret
+	if source.Namespace == "" || source.Namespace == namespace {
+		return nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, source, secret); err != nil {
+		return fmt.Errorf("failed to get default image pull secret %s: %v", source, err)
+	}
+
+	logger := log.FromContext(ctx)
+	copied := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: namespace}}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: source.Name, Namespace: namespace}, copied); err == nil {
+		if copied.Labels[common.LabelCreatedBySparkOperator] != "true" {
+			return nil
+		}
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get image pull secret %s: %v", source.Name, err)
+	}
+
+	result, err := controllerutil.CreateOrUpdate(ctx, r.client, copied, func() error {
+		if copied.Labels == nil {
+			copied.Labels = make(map[string]string)
+		}
+		copied.Labels[common.LabelCreatedBySparkOperator] = "true"
+		copied.Type = secret.Type
+		copied.Data = maps.Clone(secret.Data)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy default image pull secret %s: %v", source, err)
+	}
+	if result != controllerutil.OperationResultNone {
+		logger.Info("Copied default image pull secret", "source", source.String(), "namespace", namespace, "operation", result)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestConfigImagePullSecrets(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	newPullSecret := func(name, namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+		}
+	}
+	newApp := func(pullSecrets ...string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec:       v1beta2.SparkApplicationSpec{ImagePullSecrets: pullSecrets},
+		}
+	}
+
+	t.Run("existing pull secret", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPullSecret("registry", "default")).Build()
+		reconciler := &Reconciler{client: client}
+		require.NoError(t, reconciler.configImagePullSecrets(ctx, newApp("registry")))
+	})
+
+	t.Run("missing pull secret", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+		err := reconciler.configImagePullSecrets(ctx, newApp("registry"))
+		require.EqualError(t, err, "image pull secret registry not found")
+	})
+
+	t.Run("pull secret of wrong type", func(t *testing.T) {
+		secret := newPullSecret("registry", "default")
+		secret.Type = corev1.SecretTypeOpaque
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+		reconciler := &Reconciler{client: client}
+		require.Error(t, reconciler.configImagePullSecrets(ctx, newApp("registry")))
+	})
+
+	t.Run("default pull secret in the application namespace", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPullSecret("default-registry", "default")).Build()
+		reconciler := &Reconciler{
+			client:  client,
+			options: Options{DefaultImagePullSecret: types.NamespacedName{Name: "default-registry"}},
+		}
+		app := newApp()
+		require.NoError(t, reconciler.configImagePullSecrets(ctx, app))
+		assert.Equal(t, []string{"default-registry"}, app.Spec.ImagePullSecrets)
+
+		require.NoError(t, reconciler.configImagePullSecrets(ctx, app))
+		assert.Equal(t, []string{"default-registry"}, app.Spec.ImagePullSecrets)
+	})
+
+	t.Run("default pull secret copied from the operator namespace", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPullSecret("default-registry", "spark-operator")).Build()
+		reconciler := &Reconciler{
+			client:  client,
+			options: Options{DefaultImagePullSecret: types.NamespacedName{Name: "default-registry", Namespace: "spark-operator"}},
+		}
+		app := newApp("registry")
+		require.NoError(t, client.Create(ctx, newPullSecret("registry", "default")))
+		require.NoError(t, reconciler.configImagePullSecrets(ctx, app))
+		assert.Equal(t, []string{"registry", "default-registry"}, app.Spec.ImagePullSecrets)
+
+		copied := &corev1.Secret{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "default-registry", Namespace: "default"}, copied))
+		assert.Equal(t, corev1.SecretTypeDockerConfigJson, copied.Type)
+		assert.Equal(t, "true", copied.Labels[common.LabelCreatedBySparkOperator])
+		assert.Equal(t, []byte(`{"auths":{}}`), copied.Data[corev1.DockerConfigJsonKey])
+	})
+
+	t.Run("unmanaged secret with the default pull secret name is kept", func(t *testing.T) {
+		existing := newPullSecret("default-registry", "default")
+		existing.Data = map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"example.com":{}}}`)}
+		client := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(newPullSecret("default-registry", "spark-operator"), existing).Build()
+		reconciler := &Reconciler{
+			client:  client,
+			options: Options{DefaultImagePullSecret: types.NamespacedName{Name: "default-registry", Namespace: "spark-operator"}},
+		}
+		require.NoError(t, reconciler.configImagePullSecrets(ctx, newApp()))
+
+		secret := &corev1.Secret{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "default-registry", Namespace: "default"}, secret))
+		assert.Equal(t, existing.Data, secret.Data)
+	})
+}
+
+func TestParseDefaultImagePullSecret(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected types.NamespacedName
+		wantErr  bool
+	}{
+		{value: "", expected: types.NamespacedName{}},
+		{value: "registry", expected: types.NamespacedName{Name: "registry"}},
+		{value: "spark-operator/registry", expected: types.NamespacedName{Namespace: "spark-operator", Name: "registry"}},
+		{value: "/registry", wantErr: true},
+		{value: "a/b/c", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			key, err := ParseDefaultImagePullSecret(tc.value)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, key)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// sparkRESTTimeLayout is the layout of the times returned by the REST API of the Spark web UI.
+const sparkRESTTimeLayout = "2006-01-02T15:04:05.000GMT"
+
+// interactiveProbeClient is the HTTP client used to look up the jobs of interactive drivers.
+var interactiveProbeClient = &http.Client{Timeout: 5 * time.Second}
+
+// sparkJob is a job listed by the REST API of the Spark web UI.
+type sparkJob struct {
+	Status         string `json:"status"`
+	SubmissionTime string `json:"submissionTime,omitempty"`
+	CompletionTime string `json:"completionTime,omitempty"`
+}
+
+// getInteractiveProtocol returns the protocol clients of the given interactive application connect with.
+func getInteractiveProtocol(app *v1beta2.SparkApplication) v1beta2.InteractiveProtocol {
+	if app.Spec.Interactive.Protocol == "" {
+		return v1beta2.InteractiveProtocolConnect
+	}
+	return app.Spec.Interactive.Protocol
+}
+
+// getInteractivePort returns the port the driver of the given interactive application serves clients on.
+func getInteractivePort(app *v1beta2.SparkApplication) int32 {
+	if app.Spec.Interactive.Port != nil {
+		return *app.Spec.Interactive.Port
+	}
+	if getInteractiveProtocol(app) == v1beta2.InteractiveProtocolThrift {
+		return common.DefaultSparkThriftServerPort
+	}
+	return common.DefaultSparkConnectPort
+}
+
+// getInteractiveEndpoint returns the URL clients connect to the given interactive application at.
+func getInteractiveEndpoint(app *v1beta2.SparkApplication) string {
+	scheme := "sc"
+	if getInteractiveProtocol(app) == v1beta2.InteractiveProtocolThrift {
+		scheme = "jdbc:hive2"
+	}
+	host := fmt.Sprintf("%s.%s.svc", util.GetInteractiveServiceName(app), app.Namespace)
+	return fmt.Sprintf("%s://%s", scheme, net.JoinHostPort(host, strconv.Itoa(int(getInteractivePort(app)))))
+}
+
+// configInteractive makes the driver of the given interactive application run the server of its protocol
+// instead of a main application file.
+func configInteractive(app *v1beta2.SparkApplication) {
+	mainClass, portKey := common.SparkConnectServerClass, common.SparkConnectGRPCBindingPort
+	if getInteractiveProtocol(app) == v1beta2.InteractiveProtocolThrift {
+		mainClass, portKey = common.SparkThriftServerClass, common.SparkHiveThriftServerPort
+	}
+	app.Spec.MainClass = ptr.To(mainClass)
+	app.Spec.MainApplicationFile = ptr.To(common.SparkInternalResource)
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	app.Spec.SparkConf[portKey] = strconv.Itoa(int(getInteractivePort(app)))
+}
+
+// buildInteractiveService builds the Service exposing the endpoint of the given interactive application.
+func buildInteractiveService(app *v1beta2.SparkApplication) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetInteractiveServiceName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			Selector: map[string]string{
+				common.LabelSparkAppName: app.Name,
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+			Ports: []corev1.ServicePort{
+				newTCPServicePort(string(getInteractiveProtocol(app)), getInteractivePort(app)),
+			},
+		},
+	}
+}
+
+// createInteractiveService creates or updates the Service exposing the endpoint of the given interactive
+// application, and records the endpoint in its status.
+func (r *Reconciler) createInteractiveService(ctx context.Context, app *v1beta2.SparkApplication) error {
+	logger := log.FromContext(ctx)
+	service := buildInteractiveService(app)
+	r.propagateMetadata(app, service)
+	if err := r.client.Create(ctx, service); err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create interactive service %s: %v", service.Name, err)
+		}
+		existing := &corev1.Service{}
+		if err := r.client.Get(ctx, types.NamespacedName{Name: service.Name, Namespace: service.Namespace}, existing); err != nil {
+			return fmt.Errorf("failed to get interactive service %s: %v", service.Name, err)
+		}
+		existing.Labels = service.Labels
+		existing.Annotations = service.Annotations
+		existing.Spec.Ports = service.Spec.Ports
+		if err := r.client.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update interactive service %s: %v", service.Name, err)
+		}
+		logger.Info("Updated interactive service for SparkApplication", "name", service.Name)
+	} else {
+		logger.Info("Created interactive service for SparkApplication", "name", service.Name)
+	}
+
+	if app.Status.Interactive == nil {
+		app.Status.Interactive = &v1beta2.InteractiveStatus{}
+	}
+	app.Status.Interactive.Endpoint = getInteractiveEndpoint(app)
+	return nil
+}
+
+// enforceIdleTimeout stops a running interactive application once its driver has run no Spark job for longer than
+// the idle timeout, in which case the application completes. It returns whether the application was stopped, and
+// otherwise the delay after which its activity should be looked up again.
+func (r *Reconciler) enforceIdleTimeout(ctx context.Context, app *v1beta2.SparkApplication) (bool, time.Duration, error) {
+	if app.Spec.Interactive == nil || app.Spec.Interactive.IdleTimeoutSeconds == nil {
+		return false, 0, nil
+	}
+
+	logger := log.FromContext(ctx)
+	timeout := time.Duration(*app.Spec.Interactive.IdleTimeoutSeconds) * time.Second
+	if app.Status.Interactive == nil {
+		app.Status.Interactive = &v1beta2.InteractiveStatus{}
+	}
+	status := app.Status.Interactive
+	now := time.Now()
+
+	lastActivity, err := r.getLastDriverActivity(ctx, app, now)
+	if err != nil {
+		// An unreachable driver is treated as idle, the same as a driver running no jobs.
+		logger.Info("Failed to get activity of interactive SparkApplication", "error", err.Error())
+	} else if lastActivity.After(status.LastActivityTime.Time) {
+		status.LastActivityTime = metav1.NewTime(lastActivity)
+	}
+	if status.LastActivityTime.IsZero() {
+		status.LastActivityTime = metav1.NewTime(now)
+	}
+
+	// Jobs completed in the meantime are listed by the driver, so activity only needs to be looked up again
+	// once the application would otherwise have been idle for too long.
+	if idle := now.Sub(status.LastActivityTime.Time); idle < timeout {
+		return false, timeout - idle, nil
+	}
+
+	logger.Info("Stopping idle interactive SparkApplication", "lastActivityTime", status.LastActivityTime)
+	if err := r.deleteSparkResources(ctx, app); err != nil {
+		return false, 0, fmt.Errorf("failed to delete spark resources: %v", err)
+	}
+	r.recorder.Eventf(
+		app,
+		corev1.EventTypeNormal,
+		common.EventSparkApplicationIdleTimeout,
+		"SparkApplication %s is stopped as it ran no job since %s",
+		app.Name,
+		status.LastActivityTime.Format(time.RFC3339),
+	)
+	app.Status.AppState = v1beta2.ApplicationState{
+		State: v1beta2.ApplicationStateCompleted,
+	}
+	app.Status.TerminationTime = metav1.Now()
+	r.recordSparkApplicationEvent(app)
+	return true, 0, nil
+}
+
+// getLastDriverActivity looks up the jobs of the driver of the given SparkApplication through the REST API of its
+// web UI and returns the last time it was observed running a job.
+func (r *Reconciler) getLastDriverActivity(ctx context.Context, app *v1beta2.SparkApplication, now time.Time) (time.Time, error) {
+	if app.Status.SparkApplicationID == "" {
+		return time.Time{}, fmt.Errorf("application ID of driver pod %s is unknown", app.Status.DriverInfo.PodName)
+	}
+	driverPod, err := r.getDriverPod(ctx, app)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if driverPod == nil || driverPod.Status.PodIP == "" {
+		return time.Time{}, fmt.Errorf("driver pod %s has no IP", app.Status.DriverInfo.PodName)
+	}
+	port, err := getWebUITargetPort(app)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	address := net.JoinHostPort(driverPod.Status.PodIP, strconv.Itoa(int(port)))
+	jobsURL := fmt.Sprintf("http://%s/api/v1/applications/%s/jobs", address, url.PathEscape(app.Status.SparkApplicationID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jobsURL, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp, err := interactiveProbeClient.Do(req)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, jobsURL)
+	}
+
+	var jobs []sparkJob
+	if err := json.NewDecoder(resp.Body).Decode(&jobs); err != nil {
+		return time.Time{}, fmt.Errorf("failed to decode jobs from %s: %v", jobsURL, err)
+	}
+	return getLastJobActivity(jobs, now), nil
+}
+
+// getLastJobActivity returns the given time if any of the given jobs is running, and otherwise the last time one
+// of them was submitted or completed.
+func getLastJobActivity(jobs []sparkJob, now time.Time) time.Time {
+	var last time.Time
+	for _, job := range jobs {
+		if job.Status == "RUNNING" {
+			return now
+		}
+		for _, value := range []string{job.SubmissionTime, job.CompletionTime} {
+			if t, err := time.Parse(sparkRESTTimeLayout, value); err == nil && t.After(last) {
+				last = t
+			}
+		}
+	}
+	return last
+}
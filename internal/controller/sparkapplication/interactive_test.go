@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestConfigInteractive(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "notebook", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Interactive: &v1beta2.InteractiveSpec{},
+		},
+	}
+	configInteractive(app)
+	assert.Equal(t, common.SparkConnectServerClass, *app.Spec.MainClass)
+	assert.Equal(t, common.SparkInternalResource, *app.Spec.MainApplicationFile)
+	assert.Equal(t, map[string]string{common.SparkConnectGRPCBindingPort: "15002"}, app.Spec.SparkConf)
+	assert.Equal(t, "sc://notebook-interactive.default.svc:15002", getInteractiveEndpoint(app))
+
+	app.Spec.Interactive = &v1beta2.InteractiveSpec{Protocol: v1beta2.InteractiveProtocolThrift, Port: ptr.To[int32](10001)}
+	app.Spec.SparkConf = nil
+	configInteractive(app)
+	assert.Equal(t, common.SparkThriftServerClass, *app.Spec.MainClass)
+	assert.Equal(t, map[string]string{common.SparkHiveThriftServerPort: "10001"}, app.Spec.SparkConf)
+	assert.Equal(t, "jdbc:hive2://notebook-interactive.default.svc:10001", getInteractiveEndpoint(app))
+}
+
+func TestCreateInteractiveService(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &Reconciler{client: client}
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "notebook", Namespace: "default", UID: "uid"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Interactive: &v1beta2.InteractiveSpec{Protocol: v1beta2.InteractiveProtocolConnect},
+		},
+	}
+	require.NoError(t, r.createInteractiveService(ctx, app))
+	require.NotNil(t, app.Status.Interactive)
+	assert.Equal(t, "sc://notebook-interactive.default.svc:15002", app.Status.Interactive.Endpoint)
+
+	service := &corev1.Service{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "notebook-interactive", Namespace: "default"}, service))
+	assert.Equal(t, map[string]string{common.LabelSparkAppName: "notebook", common.LabelSparkRole: common.SparkRoleDriver}, service.Spec.Selector)
+	require.Len(t, service.Spec.Ports, 1)
+	assert.Equal(t, "connect", service.Spec.Ports[0].Name)
+	assert.Equal(t, int32(15002), service.Spec.Ports[0].Port)
+
+	// The Service is updated when the application is submitted again with another port.
+	app.Spec.Interactive.Port = ptr.To[int32](15003)
+	require.NoError(t, r.createInteractiveService(ctx, app))
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "notebook-interactive", Namespace: "default"}, service))
+	assert.Equal(t, int32(15003), service.Spec.Ports[0].Port)
+	assert.Equal(t, "sc://notebook-interactive.default.svc:15003", app.Status.Interactive.Endpoint)
+}
+
+func TestGetLastJobActivity(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	jobs := []sparkJob{
+		{Status: "SUCCEEDED", SubmissionTime: "2025-06-01T10:00:00.000GMT", CompletionTime: "2025-06-01T10:05:00.000GMT"},
+		{Status: "FAILED", SubmissionTime: "2025-06-01T09:00:00.000GMT", CompletionTime: "2025-06-01T11:30:00.000GMT"},
+	}
+	assert.Equal(t, time.Date(2025, 6, 1, 11, 30, 0, 0, time.UTC), getLastJobActivity(jobs, now))
+	assert.Equal(t, now, getLastJobActivity(append(jobs, sparkJob{Status: "RUNNING"}), now))
+	assert.True(t, getLastJobActivity(nil, now).IsZero())
+}
+
+func TestEnforceIdleTimeout(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	var jobs []sparkJob
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/applications/spark-123/jobs" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(jobs)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "notebook-driver", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIP: "127.0.0.1"},
+	}
+	newApp := func() *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "notebook", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConf:   map[string]string{common.SparkUIPortKey: serverURL.Port()},
+				Interactive: &v1beta2.InteractiveSpec{IdleTimeoutSeconds: ptr.To[int64](600)},
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				SparkApplicationID: "spark-123",
+				AppState:           v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+				DriverInfo:         v1beta2.DriverInfo{PodName: "notebook-driver"},
+			},
+		}
+	}
+	newReconciler := func() *Reconciler {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(driverPod.DeepCopy()).Build()
+		return &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+	}
+
+	t.Run("no idle timeout", func(t *testing.T) {
+		app := newApp()
+		app.Spec.Interactive.IdleTimeoutSeconds = nil
+		stopped, requeueAfter, err := newReconciler().enforceIdleTimeout(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, stopped)
+		assert.Zero(t, requeueAfter)
+		assert.Nil(t, app.Status.Interactive)
+	})
+
+	t.Run("activity is recorded", func(t *testing.T) {
+		jobs = []sparkJob{{Status: "RUNNING"}}
+		app := newApp()
+		stopped, requeueAfter, err := newReconciler().enforceIdleTimeout(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, stopped)
+		assert.InDelta(t, float64(10*time.Minute), float64(requeueAfter), float64(time.Second))
+		require.NotNil(t, app.Status.Interactive)
+		assert.WithinDuration(t, time.Now(), app.Status.Interactive.LastActivityTime.Time, time.Second)
+	})
+
+	t.Run("recent job keeps application running", func(t *testing.T) {
+		completed := time.Now().Add(-5 * time.Minute).UTC()
+		jobs = []sparkJob{{Status: "SUCCEEDED", CompletionTime: completed.Format(sparkRESTTimeLayout)}}
+		app := newApp()
+		app.Status.Interactive = &v1beta2.InteractiveStatus{LastActivityTime: metav1.NewTime(time.Now().Add(-time.Hour))}
+		stopped, requeueAfter, err := newReconciler().enforceIdleTimeout(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, stopped)
+		assert.InDelta(t, float64(5*time.Minute), float64(requeueAfter), float64(time.Second))
+		assert.Equal(t, completed.Truncate(time.Millisecond).Unix(), app.Status.Interactive.LastActivityTime.Unix())
+	})
+
+	t.Run("idle application is stopped", func(t *testing.T) {
+		jobs = nil
+		app := newApp()
+		app.Status.Interactive = &v1beta2.InteractiveStatus{LastActivityTime: metav1.NewTime(time.Now().Add(-time.Hour))}
+		reconciler := newReconciler()
+		stopped, _, err := reconciler.enforceIdleTimeout(ctx, app)
+		require.NoError(t, err)
+		assert.True(t, stopped)
+		assert.Equal(t, v1beta2.ApplicationStateCompleted, app.Status.AppState.State)
+		assert.False(t, app.Status.TerminationTime.IsZero())
+		err = reconciler.client.Get(ctx, types.NamespacedName{Name: "notebook-driver", Namespace: "default"}, &corev1.Pod{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("unreachable driver is idle", func(t *testing.T) {
+		app := newApp()
+		app.Spec.SparkConf[common.SparkUIPortKey] = strconv.Itoa(1)
+		app.Status.Interactive = &v1beta2.InteractiveStatus{LastActivityTime: metav1.NewTime(time.Now().Add(-time.Hour))}
+		stopped, _, err := newReconciler().enforceIdleTimeout(ctx, app)
+		require.NoError(t, err)
+		assert.True(t, stopped)
+	})
+}
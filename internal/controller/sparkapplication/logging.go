@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// configJSONLogging creates or updates the log4j2 ConfigMap of the given SparkApplication and points
+// the driver and executor JVMs to it. The ConfigMap is mounted into the pods by the mutating webhook.
+func (r *Reconciler) configJSONLogging(ctx context.Context, app *v1beta2.SparkApplication) error {
+	configMap := buildLoggingConfigMap(app)
+	key := types.NamespacedName{Namespace: configMap.Namespace, Name: configMap.Name}
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		if err := r.client.Get(ctx, key, cm); err != nil {
+			if errors.IsNotFound(err) {
+				return r.client.Create(ctx, configMap)
+			}
+			return err
+		}
+		cm.Data = configMap.Data
+		return r.client.Update(ctx, cm)
+	}); err != nil {
+		return err
+	}
+
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	if util.SparkVersionSupports(app.Spec.SparkVersion, util.SparkFeatureStructuredLogging) {
+		app.Spec.SparkConf[common.SparkLogStructuredLoggingEnabled] = "true"
+	}
+
+	javaOption := fmt.Sprintf("-Dlog4j2.configurationFile=%s/%s", common.LoggingConfigMapMountPath, common.Log4j2ConfigKey)
+	app.Spec.Driver.JavaOptions = appendJavaOption(app.Spec.Driver.JavaOptions, javaOption)
+	app.Spec.Executor.JavaOptions = appendJavaOption(app.Spec.Executor.JavaOptions, javaOption)
+	return nil
+}
+
+func buildLoggingConfigMap(app *v1beta2.SparkApplication) *corev1.ConfigMap {
+	properties := common.DefaultJSONLog4j2Properties
+	if util.SparkVersionSupports(app.Spec.SparkVersion, util.SparkFeatureStructuredLogging) {
+		properties = common.DefaultStructuredLog4j2Properties
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetLoggingConfigMapName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Data: map[string]string{
+			common.Log4j2ConfigKey: properties,
+		},
+	}
+}
+
+func appendJavaOption(javaOptions *string, option string) *string {
+	if javaOptions == nil || *javaOptions == "" {
+		return &option
+	}
+	options := *javaOptions + " " + option
+	return &options
+}
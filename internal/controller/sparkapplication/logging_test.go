@@ -0,0 +1,98 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestConfigJSONLogging(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	javaOption := "-Dlog4j2.configurationFile=/etc/spark/logging/log4j2.properties"
+	key := types.NamespacedName{Name: "test-app-log4j2", Namespace: "default"}
+
+	testCases := []struct {
+		name                   string
+		sparkVersion           string
+		driverJavaOptions      *string
+		expectedProperties     string
+		expectedStructuredConf bool
+		expectedDriverOptions  string
+	}{
+		{
+			name:                  "spark 3 uses JsonLayout",
+			sparkVersion:          "3.5.1",
+			driverJavaOptions:     ptr.To("-XX:+UseG1GC"),
+			expectedProperties:    common.DefaultJSONLog4j2Properties,
+			expectedDriverOptions: "-XX:+UseG1GC " + javaOption,
+		},
+		{
+			name:                   "spark 4 uses the structured logging layout",
+			sparkVersion:           "4.0.0",
+			expectedProperties:     common.DefaultStructuredLog4j2Properties,
+			expectedStructuredConf: true,
+			expectedDriverOptions:  javaOption,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			reconciler := &Reconciler{client: client}
+			app := &v1beta2.SparkApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default", UID: "test-uid"},
+				Spec: v1beta2.SparkApplicationSpec{
+					SparkVersion: tc.sparkVersion,
+					Logging:      &v1beta2.LoggingSpec{Format: v1beta2.LogFormatJSON},
+					Driver:       v1beta2.DriverSpec{JavaOptions: tc.driverJavaOptions},
+				},
+			}
+			require.NoError(t, reconciler.configJSONLogging(ctx, app))
+
+			cm := &corev1.ConfigMap{}
+			require.NoError(t, client.Get(ctx, key, cm))
+			assert.Equal(t, tc.expectedProperties, cm.Data[common.Log4j2ConfigKey])
+			require.Len(t, cm.OwnerReferences, 1)
+			assert.Equal(t, app.UID, cm.OwnerReferences[0].UID)
+
+			_, ok := app.Spec.SparkConf[common.SparkLogStructuredLoggingEnabled]
+			assert.Equal(t, tc.expectedStructuredConf, ok)
+			assert.Equal(t, tc.expectedDriverOptions, *app.Spec.Driver.JavaOptions)
+			assert.Equal(t, javaOption, *app.Spec.Executor.JavaOptions)
+
+			// Re-configuring must update the existing ConfigMap rather than fail.
+			require.NoError(t, reconciler.configJSONLogging(ctx, app))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// MaintenanceWindow is a recurring period of time during which new SparkApplications are not submitted.
+type MaintenanceWindow = util.TimeWindow
+
+// ParseMaintenanceWindow parses a maintenance window in the format `<cron schedule>;<duration>`,
+// e.g. `0 2 * * SAT;4h` for every Saturday from 02:00 to 06:00. The schedule may start with
+// `CRON_TZ=<timezone>` and defaults to the local time of the operator otherwise.
+func ParseMaintenanceWindow(s string) (MaintenanceWindow, error) {
+	window, err := util.ParseTimeWindow(s)
+	if err != nil {
+		return MaintenanceWindow{}, fmt.Errorf("invalid maintenance window %q: %v", s, err)
+	}
+	return window, nil
+}
+
+// getMaintenanceWindowEnd returns when the maintenance windows active at the given time end, if any.
+func getMaintenanceWindowEnd(windows []MaintenanceWindow, now time.Time) (time.Time, bool) {
+	return util.GetTimeWindowEnd(windows, now)
+}
+
+// queueSubmission records on the given SparkApplication that its submission is queued for the given reason.
+func (r *Reconciler) queueSubmission(app *v1beta2.SparkApplication, reason, message string) {
+	changed := meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               v1beta2.SparkApplicationConditionSubmissionQueued,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: app.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if changed {
+		r.recorder.Event(app, corev1.EventTypeNormal, common.EventSparkApplicationSubmissionQueued, message)
+	}
+}
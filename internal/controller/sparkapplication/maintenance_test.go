@@ -0,0 +1,123 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	window, err := ParseMaintenanceWindow("CRON_TZ=UTC 0 2 * * SAT; 4h")
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Hour, window.Duration)
+
+	for _, s := range []string{"0 2 * * SAT", "0 2 * * MON-SUN-X;4h", "0 2 * * SAT;4", "0 2 * * SAT;-1h"} {
+		_, err := ParseMaintenanceWindow(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestGetMaintenanceWindowEnd(t *testing.T) {
+	saturday, err := ParseMaintenanceWindow("CRON_TZ=UTC 0 2 * * SAT;4h")
+	require.NoError(t, err)
+	daily, err := ParseMaintenanceWindow("CRON_TZ=UTC 0 5 * * *;2h")
+	require.NoError(t, err)
+	windows := []MaintenanceWindow{saturday, daily}
+
+	testCases := []struct {
+		name     string
+		now      time.Time
+		expected time.Time
+	}{
+		{
+			name: "outside any window",
+			now:  time.Date(2026, 10, 17, 1, 59, 0, 0, time.UTC),
+		},
+		{
+			name:     "start of window",
+			now:      time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC),
+			expected: time.Date(2026, 10, 17, 6, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "overlapping windows",
+			now:      time.Date(2026, 10, 17, 5, 30, 0, 0, time.UTC),
+			expected: time.Date(2026, 10, 17, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "end of window",
+			now:  time.Date(2026, 10, 16, 7, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			end, ok := getMaintenanceWindowEnd(windows, tc.now)
+			assert.Equal(t, !tc.expected.IsZero(), ok)
+			assert.True(t, tc.expected.Equal(end), "expected %v, got %v", tc.expected, end)
+		})
+	}
+}
+
+func TestReconcileNewSparkApplicationDuringMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(1)
+	// The window starts every hour and lasts one hour, so it is always active.
+	window, err := ParseMaintenanceWindow("0 * * * *;1h")
+	require.NoError(t, err)
+	reconciler := &Reconciler{
+		client:   client,
+		recorder: recorder,
+		options:  Options{MaintenanceWindows: []MaintenanceWindow{window}},
+	}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	result, err := reconciler.reconcileNewSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Positive(t, result.RequeueAfter)
+	assert.LessOrEqual(t, result.RequeueAfter, time.Hour)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStateNew, updated.Status.AppState.State)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, v1beta2.SparkApplicationReasonMaintenanceWindow, condition.Reason)
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationSubmissionQueued)
+}
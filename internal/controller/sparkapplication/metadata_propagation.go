@@ -0,0 +1,62 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"maps"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// getMetadataPropagation returns the propagation of the labels and annotations of the given SparkApplication,
+// which defaults to the propagation policy of the operator.
+func (r *Reconciler) getMetadataPropagation(app *v1beta2.SparkApplication) *v1beta2.MetadataPropagation {
+	if app.Spec.MetadataPropagation != nil {
+		return app.Spec.MetadataPropagation
+	}
+	if r.options.MetadataPropagation != nil {
+		return r.options.MetadataPropagation
+	}
+	return &util.DefaultMetadataPropagation
+}
+
+// configMetadataPropagation sets the propagation policy of the operator in the spec of the given SparkApplication
+// before it is submitted, so that the labels and annotations it selects are passed to the driver and executor pods.
+func (r *Reconciler) configMetadataPropagation(app *v1beta2.SparkApplication) {
+	app.Spec.MetadataPropagation = r.getMetadataPropagation(app).DeepCopy()
+}
+
+// propagateMetadata adds the labels and annotations of the given SparkApplication selected for propagation to the
+// given object created for it. Labels and annotations already set on the object take precedence.
+func (r *Reconciler) propagateMetadata(app *v1beta2.SparkApplication, obj metav1.Object) {
+	propagation := r.getMetadataPropagation(app)
+
+	labels := util.GetPropagatedLabels(app, propagation)
+	maps.Copy(labels, obj.GetLabels())
+	if len(labels) > 0 {
+		obj.SetLabels(labels)
+	}
+
+	annotations := util.GetPropagatedAnnotations(app, propagation)
+	maps.Copy(annotations, obj.GetAnnotations())
+	if len(annotations) > 0 {
+		obj.SetAnnotations(annotations)
+	}
+}
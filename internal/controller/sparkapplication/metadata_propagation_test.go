@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newMetadataPropagationTestApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark-pi",
+			Namespace: "default",
+			Labels: map[string]string{
+				"team":                       "data",
+				"cost-center.example.com/id": "42",
+			},
+			Annotations: map[string]string{
+				"owner.example.com/contact": "data@example.com",
+			},
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Type:                v1beta2.SparkApplicationTypeScala,
+			Mode:                v1beta2.DeployModeCluster,
+			MainApplicationFile: ptr.To("local:///opt/spark/examples/jars/spark-examples.jar"),
+		},
+	}
+}
+
+func TestPropagateMetadata(t *testing.T) {
+	app := newMetadataPropagationTestApp()
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels:      map[string]string{common.LabelSparkAppName: "spark-pi", "team": "platform"},
+			Annotations: map[string]string{"owner.example.com/contact": "platform@example.com"},
+		},
+	}
+
+	// By default, all labels and no annotations are propagated, and those set on the object take precedence.
+	r := &Reconciler{}
+	r.propagateMetadata(app, service)
+	assert.Equal(t, map[string]string{
+		common.LabelSparkAppName:     "spark-pi",
+		"team":                       "platform",
+		"cost-center.example.com/id": "42",
+	}, service.Labels)
+	assert.Equal(t, map[string]string{"owner.example.com/contact": "platform@example.com"}, service.Annotations)
+
+	// The propagation policy of the operator applies to applications that do not set their own.
+	r = &Reconciler{options: Options{MetadataPropagation: &v1beta2.MetadataPropagation{
+		Labels:      []string{"cost-center.example.com/*"},
+		Annotations: []string{"owner.example.com/*"},
+	}}}
+	pvc := &corev1.PersistentVolumeClaim{}
+	r.propagateMetadata(app, pvc)
+	assert.Equal(t, map[string]string{"cost-center.example.com/id": "42"}, pvc.Labels)
+	assert.Equal(t, map[string]string{"owner.example.com/contact": "data@example.com"}, pvc.Annotations)
+
+	// The propagation of the application takes precedence over the policy of the operator.
+	app.Spec.MetadataPropagation = &v1beta2.MetadataPropagation{Labels: []string{"team"}}
+	pvc = &corev1.PersistentVolumeClaim{}
+	r.propagateMetadata(app, pvc)
+	assert.Equal(t, map[string]string{"team": "data"}, pvc.Labels)
+	assert.Nil(t, pvc.Annotations)
+}
+
+func TestBuildSparkSubmitArgsWithMetadataPropagation(t *testing.T) {
+	t.Setenv(common.EnvKubernetesServiceHost, "10.0.0.1")
+	t.Setenv(common.EnvKubernetesServicePort, "443")
+	app := newMetadataPropagationTestApp()
+	r := &Reconciler{options: Options{MetadataPropagation: &v1beta2.MetadataPropagation{
+		Labels:      []string{"team"},
+		Annotations: []string{"owner.example.com/contact"},
+	}}}
+	r.configMetadataPropagation(app)
+
+	args, err := buildSparkSubmitArgs(app)
+	require.NoError(t, err)
+	assert.Contains(t, args, "spark.kubernetes.driver.label.team=data")
+	assert.Contains(t, args, "spark.kubernetes.executor.label.team=data")
+	assert.Contains(t, args, "spark.kubernetes.driver.annotation.owner.example.com/contact=data@example.com")
+	assert.Contains(t, args, "spark.kubernetes.executor.annotation.owner.example.com/contact=data@example.com")
+	assert.NotContains(t, args, "spark.kubernetes.driver.label.cost-center.example.com/id=42")
+	assert.NotContains(t, args, "spark.kubernetes.executor.label.cost-center.example.com/id=42")
+}
@@ -19,6 +19,8 @@ package sparkapplication
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -27,6 +29,7 @@ import (
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
@@ -122,6 +125,47 @@ func configPrometheusMonitoring(ctx context.Context, app *v1beta2.SparkApplicati
 	return nil
 }
 
+// configTaskMetrics registers the task metrics driver plugin and tells it where to report the metrics to.
+func (r *Reconciler) configTaskMetrics(_ context.Context, app *v1beta2.SparkApplication) error {
+	taskMetrics := app.Spec.Monitoring.TaskMetrics
+	sink := common.TaskMetricsSinkOperator
+	endpoint := r.options.TaskMetricsEndpoint
+	if taskMetrics.PushgatewayURL != nil && *taskMetrics.PushgatewayURL != "" {
+		sink = common.TaskMetricsSinkPushgateway
+		endpoint = *taskMetrics.PushgatewayURL
+	}
+	if endpoint == "" {
+		return fmt.Errorf("neither pushgatewayURL nor the operator task metrics endpoint is configured")
+	}
+
+	interval := int32(common.DefaultTaskMetricsIntervalSeconds)
+	if taskMetrics.IntervalSeconds != nil {
+		interval = *taskMetrics.IntervalSeconds
+	}
+
+	addSparkPlugin(app, taskMetrics.PluginClass)
+	app.Spec.SparkConf[common.SparkTaskMetricsSink] = sink
+	app.Spec.SparkConf[common.SparkTaskMetricsEndpoint] = endpoint
+	app.Spec.SparkConf[common.SparkTaskMetricsInterval] = fmt.Sprintf("%d", interval)
+	app.Spec.SparkConf[common.SparkTaskMetricsNamespace] = app.Namespace
+	app.Spec.SparkConf[common.SparkTaskMetricsAppName] = app.Name
+	return nil
+}
+
+// addSparkPlugin appends the given plugin class to `spark.plugins` unless it is already listed.
+func addSparkPlugin(app *v1beta2.SparkApplication, pluginClass string) {
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	plugins := app.Spec.SparkConf[common.SparkPlugins]
+	if plugins == "" {
+		plugins = pluginClass
+	} else if !slices.Contains(strings.Split(plugins, ","), pluginClass) {
+		plugins = plugins + "," + pluginClass
+	}
+	app.Spec.SparkConf[common.SparkPlugins] = plugins
+}
+
 func buildPrometheusConfigMap(app *v1beta2.SparkApplication, prometheusConfigMapName string) *corev1.ConfigMap {
 	configMapData := make(map[string]string)
 
@@ -145,8 +189,113 @@ func buildPrometheusConfigMap(app *v1beta2.SparkApplication, prometheusConfigMap
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            prometheusConfigMapName,
 			Namespace:       app.Namespace,
-			OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
 		},
 		Data: configMapData,
 	}
 }
+
+// configPrometheusServlet configures the PrometheusServlet metrics sink of Spark, adds the scrape annotations of
+// the driver metrics to the driver and, if the metrics are pushed to a Prometheus remote-write endpoint, creates
+// or updates the ConfigMap of the Prometheus agent, which is added to the driver pod by the mutating webhook.
+func (r *Reconciler) configPrometheusServlet(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if util.PrometheusRemoteWriteEnabled(app) {
+		configMap, err := buildPrometheusAgentConfigMap(app)
+		if err != nil {
+			return err
+		}
+		key := types.NamespacedName{Namespace: configMap.Namespace, Name: configMap.Name}
+		if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			cm := &corev1.ConfigMap{}
+			if err := r.client.Get(ctx, key, cm); err != nil {
+				if errors.IsNotFound(err) {
+					return r.client.Create(ctx, configMap)
+				}
+				return err
+			}
+			cm.Data = configMap.Data
+			return r.client.Update(ctx, cm)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	path := util.GetPrometheusServletPath(app)
+	app.Spec.SparkConf[common.SparkPrometheusServletSinkClass] = common.PrometheusServletSinkClass
+	app.Spec.SparkConf[common.SparkPrometheusServletSinkPath] = path
+	util.SetIfNotExists(app.Spec.SparkConf, common.SparkMetricsNamespace, fmt.Sprintf("%s.%s", app.Namespace, app.Name))
+	if app.Spec.Monitoring.ExposeExecutorMetrics {
+		app.Spec.SparkConf[common.SparkUIPrometheusEnabled] = "true"
+	}
+
+	if app.Spec.Monitoring.ExposeDriverMetrics {
+		if app.Spec.Driver.Annotations == nil {
+			app.Spec.Driver.Annotations = make(map[string]string)
+		}
+		app.Spec.Driver.Annotations[common.PrometheusScrapeAnnotation] = "true"
+		app.Spec.Driver.Annotations[common.PrometheusPortAnnotation] = fmt.Sprintf("%d", util.GetSparkUIPort(app))
+		app.Spec.Driver.Annotations[common.PrometheusPathAnnotation] = path
+	}
+	return nil
+}
+
+// buildPrometheusAgentConfigMap builds the ConfigMap of the Prometheus agent which scrapes the driver and executor
+// metrics served by the Spark UI of the driver and pushes them to the remote-write endpoint of the application.
+func buildPrometheusAgentConfigMap(app *v1beta2.SparkApplication) (*corev1.ConfigMap, error) {
+	remoteWrite := app.Spec.Monitoring.PrometheusServlet.RemoteWrite
+	interval := int32(common.DefaultPrometheusAgentIntervalSeconds)
+	if remoteWrite.IntervalSeconds != nil {
+		interval = *remoteWrite.IntervalSeconds
+	}
+	target := fmt.Sprintf("localhost:%d", util.GetSparkUIPort(app))
+
+	newScrapeConfig := func(job string, path string) map[string]any {
+		return map[string]any{
+			"job_name":       job,
+			"metrics_path":   path,
+			"static_configs": []map[string]any{{"targets": []string{target}}},
+		}
+	}
+	scrapeConfigs := []map[string]any{newScrapeConfig("spark-driver", util.GetPrometheusServletPath(app))}
+	if app.Spec.Monitoring.ExposeExecutorMetrics {
+		scrapeConfigs = append(scrapeConfigs, newScrapeConfig("spark-executors", common.PrometheusServletExecutorMetricsPath))
+	}
+
+	endpoint := map[string]any{"url": remoteWrite.URL}
+	if remoteWrite.BearerTokenSecret != nil {
+		endpoint["authorization"] = map[string]any{
+			"credentials_file": fmt.Sprintf("%s/%s", common.PrometheusAgentTokenMountPath, common.PrometheusAgentTokenKey),
+		}
+	}
+
+	config, err := yaml.Marshal(map[string]any{
+		"global": map[string]any{
+			"scrape_interval": fmt.Sprintf("%ds", interval),
+			"external_labels": map[string]string{
+				"namespace":      app.Namespace,
+				"spark_app_name": app.Name,
+			},
+		},
+		"scrape_configs": scrapeConfigs,
+		"remote_write":   []map[string]any{endpoint},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Prometheus agent configuration: %v", err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetPrometheusAgentConfigMapName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Data: map[string]string{
+			common.PrometheusAgentConfigKey: string(config),
+		},
+	}, nil
+}
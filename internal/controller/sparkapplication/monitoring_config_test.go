@@ -342,3 +342,114 @@ func TestConfigPrometheusMonitoring(t *testing.T) {
 		testFn(test, t)
 	}
 }
+
+func TestConfigTaskMetrics(t *testing.T) {
+	newApp := func(taskMetrics *v1beta2.TaskMetricsSpec, sparkConf map[string]string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "app1", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConf:  sparkConf,
+				Monitoring: &v1beta2.MonitoringSpec{TaskMetrics: taskMetrics},
+			},
+		}
+	}
+
+	reconciler := &Reconciler{options: Options{TaskMetricsEndpoint: "http://operator:8080/task-metrics"}}
+	app := newApp(&v1beta2.TaskMetricsSpec{PluginClass: "org.example.TaskMetricsPlugin"}, map[string]string{
+		common.SparkPlugins: "org.example.OtherPlugin",
+	})
+	assert.NoError(t, reconciler.configTaskMetrics(context.TODO(), app))
+	assert.Equal(t, "org.example.OtherPlugin,org.example.TaskMetricsPlugin", app.Spec.SparkConf[common.SparkPlugins])
+	assert.Equal(t, common.TaskMetricsSinkOperator, app.Spec.SparkConf[common.SparkTaskMetricsSink])
+	assert.Equal(t, "http://operator:8080/task-metrics", app.Spec.SparkConf[common.SparkTaskMetricsEndpoint])
+	assert.Equal(t, "30", app.Spec.SparkConf[common.SparkTaskMetricsInterval])
+	assert.Equal(t, "default", app.Spec.SparkConf[common.SparkTaskMetricsNamespace])
+	assert.Equal(t, "app1", app.Spec.SparkConf[common.SparkTaskMetricsAppName])
+
+	app = newApp(&v1beta2.TaskMetricsSpec{
+		PluginClass:     "org.example.TaskMetricsPlugin",
+		PushgatewayURL:  ptr.To("http://pushgateway:9091"),
+		IntervalSeconds: ptr.To[int32](10),
+	}, nil)
+	assert.NoError(t, reconciler.configTaskMetrics(context.TODO(), app))
+	assert.Equal(t, "org.example.TaskMetricsPlugin", app.Spec.SparkConf[common.SparkPlugins])
+	assert.Equal(t, common.TaskMetricsSinkPushgateway, app.Spec.SparkConf[common.SparkTaskMetricsSink])
+	assert.Equal(t, "http://pushgateway:9091", app.Spec.SparkConf[common.SparkTaskMetricsEndpoint])
+	assert.Equal(t, "10", app.Spec.SparkConf[common.SparkTaskMetricsInterval])
+
+	reconciler = &Reconciler{}
+	app = newApp(&v1beta2.TaskMetricsSpec{PluginClass: "org.example.TaskMetricsPlugin"}, nil)
+	assert.Error(t, reconciler.configTaskMetrics(context.TODO(), app))
+}
+
+func TestConfigPrometheusServlet(t *testing.T) {
+	newApp := func(servlet *v1beta2.PrometheusServletSpec, sparkConf map[string]string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "app1", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConf: sparkConf,
+				Monitoring: &v1beta2.MonitoringSpec{
+					ExposeDriverMetrics:   true,
+					ExposeExecutorMetrics: true,
+					PrometheusServlet:     servlet,
+				},
+			},
+		}
+	}
+
+	fakeClient := fake.NewFakeClient()
+	reconciler := &Reconciler{client: fakeClient}
+
+	app := newApp(&v1beta2.PrometheusServletSpec{}, map[string]string{common.SparkUIPortKey: "4041"})
+	assert.NoError(t, reconciler.configPrometheusServlet(context.TODO(), app))
+	assert.Equal(t, common.PrometheusServletSinkClass, app.Spec.SparkConf[common.SparkPrometheusServletSinkClass])
+	assert.Equal(t, common.DefaultPrometheusServletPath, app.Spec.SparkConf[common.SparkPrometheusServletSinkPath])
+	assert.Equal(t, "default.app1", app.Spec.SparkConf[common.SparkMetricsNamespace])
+	assert.Equal(t, "true", app.Spec.SparkConf[common.SparkUIPrometheusEnabled])
+	assert.Equal(t, map[string]string{
+		common.PrometheusScrapeAnnotation: "true",
+		common.PrometheusPortAnnotation:   "4041",
+		common.PrometheusPathAnnotation:   common.DefaultPrometheusServletPath,
+	}, app.Spec.Driver.Annotations)
+	assert.Nil(t, app.Spec.Executor.Annotations)
+
+	configMap := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: "default", Name: util.GetPrometheusAgentConfigMapName(app)}
+	assert.Error(t, fakeClient.Get(context.TODO(), key, configMap), "Prometheus agent ConfigMap should not be created without remoteWrite")
+
+	app = newApp(&v1beta2.PrometheusServletSpec{
+		Path: ptr.To("/metrics/driver"),
+		RemoteWrite: &v1beta2.PrometheusRemoteWriteSpec{
+			URL:               "https://prometheus.example.com/api/v1/write",
+			BearerTokenSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "remote-write"}, Key: "token"},
+			IntervalSeconds:   ptr.To[int32](15),
+		},
+	}, nil)
+	assert.NoError(t, reconciler.configPrometheusServlet(context.TODO(), app))
+	assert.Equal(t, "/metrics/driver", app.Spec.SparkConf[common.SparkPrometheusServletSinkPath])
+	assert.Equal(t, "/metrics/driver", app.Spec.Driver.Annotations[common.PrometheusPathAnnotation])
+	assert.Equal(t, "4040", app.Spec.Driver.Annotations[common.PrometheusPortAnnotation])
+
+	assert.NoError(t, fakeClient.Get(context.TODO(), key, configMap))
+	assert.Equal(t, `global:
+  external_labels:
+    namespace: default
+    spark_app_name: app1
+  scrape_interval: 15s
+remote_write:
+- authorization:
+    credentials_file: /var/run/secrets/prometheus-agent/token
+  url: https://prometheus.example.com/api/v1/write
+scrape_configs:
+- job_name: spark-driver
+  metrics_path: /metrics/driver
+  static_configs:
+  - targets:
+    - localhost:4040
+- job_name: spark-executors
+  metrics_path: /metrics/executors/prometheus
+  static_configs:
+  - targets:
+    - localhost:4040
+`, configMap.Data[common.PrometheusAgentConfigKey])
+}
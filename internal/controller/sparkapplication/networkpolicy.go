@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// createNetworkPolicy creates or updates the NetworkPolicy locking down the pods of the given SparkApplication.
+// It is a no-op unless network policies are enabled.
+func (r *Reconciler) createNetworkPolicy(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if !r.options.EnableNetworkPolicies {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	desired, err := buildNetworkPolicy(app, r.options.OperatorNamespace, r.options.EnableUIService && util.IsSparkUIEnabled(app, !r.options.DisableSparkUI))
+	if err != nil {
+		return err
+	}
+	existing := &networkingv1.NetworkPolicy{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(ctx, desired); err != nil {
+			return err
+		}
+		logger.Info("Created NetworkPolicy for SparkApplication", "name", desired.Name)
+		return nil
+	}
+
+	existing.Labels = desired.Labels
+	existing.OwnerReferences = desired.OwnerReferences
+	existing.Spec = desired.Spec
+	if err := r.client.Update(ctx, existing); err != nil {
+		return err
+	}
+	logger.Info("Updated NetworkPolicy for SparkApplication", "name", existing.Name)
+	return nil
+}
+
+// buildNetworkPolicy builds the NetworkPolicy of the given SparkApplication. It admits the traffic between the
+// driver and executors, from the operator pods, and to the web UI and driver ingress ports from anywhere so that
+// services and ingresses keep working. Egress is not restricted.
+func buildNetworkPolicy(app *v1beta2.SparkApplication, operatorNamespace string, uiEnabled bool) (*networkingv1.NetworkPolicy, error) {
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{
+			From: []networkingv1.NetworkPolicyPeer{{
+				PodSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{common.LabelSparkAppName: app.Name},
+				},
+			}},
+		},
+	}
+	if operatorNamespace != "" {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From: []networkingv1.NetworkPolicyPeer{{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{corev1.LabelMetadataName: operatorNamespace},
+				},
+			}},
+		})
+	}
+
+	var ports []networkingv1.NetworkPolicyPort
+	if uiEnabled {
+		port, err := getWebUITargetPort(app)
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, newNetworkPolicyPort(port))
+	}
+	for _, config := range app.Spec.DriverIngressOptions {
+		if config.ServicePort != nil {
+			ports = append(ports, newNetworkPolicyPort(*config.ServicePort))
+		}
+	}
+	if util.PrometheusMonitoringEnabled(app) {
+		port := common.DefaultPrometheusJavaAgentPort
+		if app.Spec.Monitoring.Prometheus != nil && app.Spec.Monitoring.Prometheus.Port != nil {
+			port = *app.Spec.Monitoring.Prometheus.Port
+		}
+		ports = append(ports, newNetworkPolicyPort(port))
+	}
+	if len(ports) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{Ports: ports})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetNetworkPolicyName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetResourceLabels(app),
+			OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{common.LabelSparkAppName: app.Name},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}, nil
+}
+
+func newNetworkPolicyPort(port int32) networkingv1.NetworkPolicyPort {
+	protocol := corev1.ProtocolTCP
+	target := intstr.FromInt32(port)
+	return networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &target}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestCreateNetworkPolicy(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, networkingv1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConf: map[string]string{common.SparkUIPortKey: "4041"},
+			DriverIngressOptions: []v1beta2.DriverIngressConfiguration{
+				{ServicePort: ptr.To[int32](15002)},
+			},
+		},
+	}
+	key := types.NamespacedName{Name: "test-app-network-policy", Namespace: "default"}
+
+	t.Run("network policies disabled", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+		require.NoError(t, reconciler.createNetworkPolicy(ctx, app))
+
+		err := client.Get(ctx, key, &networkingv1.NetworkPolicy{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("create and update network policy", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{
+			client: client,
+			options: Options{
+				EnableNetworkPolicies: true,
+				EnableUIService:       true,
+				OperatorNamespace:     "spark-operator",
+			},
+		}
+		require.NoError(t, reconciler.createNetworkPolicy(ctx, app))
+
+		policy := &networkingv1.NetworkPolicy{}
+		require.NoError(t, client.Get(ctx, key, policy))
+		require.Len(t, policy.OwnerReferences, 1)
+		assert.Equal(t, app.UID, policy.OwnerReferences[0].UID)
+		assert.Equal(t, map[string]string{common.LabelSparkAppName: "test-app"}, policy.Spec.PodSelector.MatchLabels)
+		assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, policy.Spec.PolicyTypes)
+		require.Len(t, policy.Spec.Ingress, 3)
+		assert.Equal(t, map[string]string{common.LabelSparkAppName: "test-app"}, policy.Spec.Ingress[0].From[0].PodSelector.MatchLabels)
+		assert.Equal(t, map[string]string{corev1.LabelMetadataName: "spark-operator"}, policy.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels)
+		assert.Empty(t, policy.Spec.Ingress[2].From)
+		require.Len(t, policy.Spec.Ingress[2].Ports, 2)
+		assert.Equal(t, intstr.FromInt32(4041), *policy.Spec.Ingress[2].Ports[0].Port)
+		assert.Equal(t, intstr.FromInt32(15002), *policy.Spec.Ingress[2].Ports[1].Port)
+
+		// Disabling the web UI service closes its port.
+		reconciler.options.EnableUIService = false
+		require.NoError(t, reconciler.createNetworkPolicy(ctx, app))
+
+		require.NoError(t, client.Get(ctx, key, policy))
+		require.Len(t, policy.Spec.Ingress, 3)
+		require.Len(t, policy.Spec.Ingress[2].Ports, 1)
+		assert.Equal(t, intstr.FromInt32(15002), *policy.Spec.Ingress[2].Ports[0].Port)
+	})
+}
@@ -0,0 +1,264 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// smtpTimeout bounds the time spent sending an email notification.
+const smtpTimeout = 30 * time.Second
+
+// SMTPOptions configures the SMTP server email notifications are sent through.
+type SMTPOptions struct {
+	// Address is the host:port of the SMTP server.
+	Address string
+	// From is the sender address of the emails.
+	From string
+	// Username and Password authenticate to the SMTP server with the PLAIN mechanism if Username is set.
+	Username string
+	Password string
+}
+
+// notificationFact is a named detail of the application included in human-readable notifications.
+type notificationFact struct {
+	Name  string
+	Value string
+}
+
+// notificationLink is a link included in human-readable notifications.
+type notificationLink struct {
+	Name string
+	URL  string
+}
+
+// notificationSummary is the human-readable description of an application state transition sent to chat
+// services and by email.
+type notificationSummary struct {
+	Title string
+	Facts []notificationFact
+	Links []notificationLink
+}
+
+// newNotificationSummary describes the state transition of the given notification, including the duration and
+// failure reason of terminated applications and links to their web UI and logs.
+func (r *Reconciler) newNotificationSummary(app *v1beta2.SparkApplication, status *v1beta2.NotificationStatus) notificationSummary {
+	name := fmt.Sprintf("%s/%s", app.Namespace, app.Name)
+	summary := notificationSummary{}
+	switch status.Event {
+	case v1beta2.NotificationEventSubmitted:
+		summary.Title = fmt.Sprintf("SparkApplication %s was submitted", name)
+	case v1beta2.NotificationEventRunning:
+		summary.Title = fmt.Sprintf("SparkApplication %s is running", name)
+	case v1beta2.NotificationEventCompleted:
+		summary.Title = fmt.Sprintf("SparkApplication %s completed", name)
+	default:
+		summary.Title = fmt.Sprintf("SparkApplication %s failed", name)
+	}
+
+	summary.Facts = append(summary.Facts, notificationFact{Name: "State", Value: string(app.Status.AppState.State)})
+	if app.Status.SparkApplicationID != "" {
+		summary.Facts = append(summary.Facts, notificationFact{Name: "Spark application ID", Value: app.Status.SparkApplicationID})
+	}
+	if !app.Status.LastSubmissionAttemptTime.IsZero() && !app.Status.TerminationTime.IsZero() {
+		duration := app.Status.TerminationTime.Sub(app.Status.LastSubmissionAttemptTime.Time).Round(time.Second)
+		summary.Facts = append(summary.Facts, notificationFact{Name: "Duration", Value: duration.String()})
+	}
+	if status.Event == v1beta2.NotificationEventFailed && app.Status.AppState.ErrorMessage != "" {
+		summary.Facts = append(summary.Facts, notificationFact{Name: "Failure reason", Value: app.Status.AppState.ErrorMessage})
+	}
+
+	if address := app.Status.DriverInfo.WebUIIngressAddress; address != "" {
+		summary.Links = append(summary.Links, notificationLink{Name: "Spark UI", URL: address})
+	}
+	if r.options.NotificationLogsURLFormat != "" {
+		if logsURL, err := getDriverIngressURL(r.options.NotificationLogsURLFormat, app); err == nil {
+			summary.Links = append(summary.Links, notificationLink{Name: "Logs", URL: logsURL.String()})
+		}
+	}
+	return summary
+}
+
+// slackMessage is the body of a message POSTed to a Slack incoming webhook.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+func (r *Reconciler) newSlackMessage(app *v1beta2.SparkApplication, status *v1beta2.NotificationStatus) slackMessage {
+	summary := r.newNotificationSummary(app, status)
+	var text strings.Builder
+	fmt.Fprintf(&text, "*%s*", summary.Title)
+	for _, fact := range summary.Facts {
+		fmt.Fprintf(&text, "\n*%s:* %s", fact.Name, fact.Value)
+	}
+	for _, link := range summary.Links {
+		fmt.Fprintf(&text, "\n<%s|%s>", link.URL, link.Name)
+	}
+	return slackMessage{Text: text.String()}
+}
+
+// teamsMessage is the body of a message card POSTed to a Microsoft Teams incoming webhook.
+type teamsMessage struct {
+	Type            string         `json:"@type"`
+	Context         string         `json:"@context"`
+	Summary         string         `json:"summary"`
+	ThemeColor      string         `json:"themeColor,omitempty"`
+	Title           string         `json:"title"`
+	Sections        []teamsSection `json:"sections,omitempty"`
+	PotentialAction []teamsAction  `json:"potentialAction,omitempty"`
+}
+
+type teamsSection struct {
+	Facts []teamsFact `json:"facts"`
+}
+
+type teamsFact struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type teamsAction struct {
+	Type    string        `json:"@type"`
+	Name    string        `json:"name"`
+	Targets []teamsTarget `json:"targets"`
+}
+
+type teamsTarget struct {
+	OS  string `json:"os"`
+	URI string `json:"uri"`
+}
+
+func (r *Reconciler) newTeamsMessage(app *v1beta2.SparkApplication, status *v1beta2.NotificationStatus) teamsMessage {
+	summary := r.newNotificationSummary(app, status)
+	message := teamsMessage{
+		Type:    "MessageCard",
+		Context: "https://schema.org/extensions",
+		Summary: summary.Title,
+		Title:   summary.Title,
+	}
+	switch status.Event {
+	case v1beta2.NotificationEventCompleted:
+		message.ThemeColor = "2EB886"
+	case v1beta2.NotificationEventFailed:
+		message.ThemeColor = "D00000"
+	}
+	section := teamsSection{}
+	for _, fact := range summary.Facts {
+		section.Facts = append(section.Facts, teamsFact{Name: fact.Name, Value: fact.Value})
+	}
+	message.Sections = append(message.Sections, section)
+	for _, link := range summary.Links {
+		message.PotentialAction = append(message.PotentialAction, teamsAction{
+			Type:    "OpenUri",
+			Name:    link.Name,
+			Targets: []teamsTarget{{OS: "default", URI: link.URL}},
+		})
+	}
+	return message
+}
+
+// sendEmailNotification emails the given notification to the recipients and returns a description of the outcome.
+func (r *Reconciler) sendEmailNotification(app *v1beta2.SparkApplication, email *v1beta2.NotificationEmail, status *v1beta2.NotificationStatus) (string, error) {
+	options := r.options.SMTP
+	if options == nil {
+		return "", fmt.Errorf("no SMTP server is configured for email notifications")
+	}
+
+	summary := r.newNotificationSummary(app, status)
+	var body bytes.Buffer
+	fmt.Fprintf(&body, "From: %s\r\n", options.From)
+	fmt.Fprintf(&body, "To: %s\r\n", strings.Join(email.To, ", "))
+	fmt.Fprintf(&body, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", summary.Title))
+	fmt.Fprintf(&body, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	body.WriteString("MIME-Version: 1.0\r\n")
+	body.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&body, "%s\r\n\r\n", summary.Title)
+	for _, fact := range summary.Facts {
+		fmt.Fprintf(&body, "%s: %s\r\n", fact.Name, fact.Value)
+	}
+	for _, link := range summary.Links {
+		fmt.Fprintf(&body, "%s: %s\r\n", link.Name, link.URL)
+	}
+
+	if err := sendMail(options, email.To, body.Bytes()); err != nil {
+		return "", fmt.Errorf("failed to send email: %v", err)
+	}
+	return fmt.Sprintf("email sent to %s", strings.Join(email.To, ", ")), nil
+}
+
+// sendMail sends the given message through the SMTP server, upgrading the connection to TLS when the server
+// supports it.
+func sendMail(options *SMTPOptions, to []string, message []byte) error {
+	host, _, err := net.SplitHostPort(options.Address)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout("tcp", options.Address, smtpTimeout)
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(smtpTimeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if options.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", options.Username, options.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(options.From); err != nil {
+		return err
+	}
+	for _, recipient := range to {
+		if err := client.Rcpt(recipient); err != nil {
+			return err
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := writer.Write(message); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
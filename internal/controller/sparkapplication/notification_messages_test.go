@@ -0,0 +1,249 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newNotificationTestApp() *v1beta2.SparkApplication {
+	app := newHookTestApp()
+	app.Status.AppState = v1beta2.ApplicationState{State: v1beta2.ApplicationStateFailed, ErrorMessage: "driver container failed with ExitCode: 1"}
+	app.Status.LastSubmissionAttemptTime = metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	app.Status.TerminationTime = metav1.NewTime(time.Date(2025, 1, 1, 10, 12, 30, 0, time.UTC))
+	app.Status.DriverInfo.WebUIIngressAddress = "https://spark.example.com/default/test-app"
+	return app
+}
+
+func TestNewNotificationSummary(t *testing.T) {
+	reconciler := &Reconciler{options: Options{NotificationLogsURLFormat: "https://grafana.example.com/explore?app={{$appNamespace}}/{{$appName}}"}}
+	app := newNotificationTestApp()
+
+	summary := reconciler.newNotificationSummary(app, &v1beta2.NotificationStatus{Event: v1beta2.NotificationEventFailed})
+	assert.Equal(t, "SparkApplication default/test-app failed", summary.Title)
+	assert.Equal(t, []notificationFact{
+		{Name: "State", Value: "FAILED"},
+		{Name: "Spark application ID", Value: "spark-123"},
+		{Name: "Duration", Value: "12m30s"},
+		{Name: "Failure reason", Value: "driver container failed with ExitCode: 1"},
+	}, summary.Facts)
+	assert.Equal(t, []notificationLink{
+		{Name: "Spark UI", URL: "https://spark.example.com/default/test-app"},
+		{Name: "Logs", URL: "https://grafana.example.com/explore?app=default/test-app"},
+	}, summary.Links)
+
+	slack := reconciler.newSlackMessage(app, &v1beta2.NotificationStatus{Event: v1beta2.NotificationEventFailed})
+	assert.True(t, strings.HasPrefix(slack.Text, "*SparkApplication default/test-app failed*\n"))
+	assert.Contains(t, slack.Text, "\n*Duration:* 12m30s")
+	assert.Contains(t, slack.Text, "\n<https://grafana.example.com/explore?app=default/test-app|Logs>")
+
+	teams := reconciler.newTeamsMessage(app, &v1beta2.NotificationStatus{Event: v1beta2.NotificationEventFailed})
+	assert.Equal(t, "MessageCard", teams.Type)
+	assert.Equal(t, "D00000", teams.ThemeColor)
+	require.Len(t, teams.Sections, 1)
+	assert.Contains(t, teams.Sections[0].Facts, teamsFact{Name: "Failure reason", Value: "driver container failed with ExitCode: 1"})
+	require.Len(t, teams.PotentialAction, 2)
+	assert.Equal(t, "https://spark.example.com/default/test-app", teams.PotentialAction[0].Targets[0].URI)
+}
+
+func TestDeliverNotifications_Slack(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	var messages []slackMessage
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var message slackMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		messages = append(messages, message)
+	}))
+	defer server.Close()
+
+	app := newNotificationTestApp()
+	app.Spec.Notifications = &v1beta2.NotificationSpec{
+		Webhooks: []v1beta2.NotificationWebhook{
+			{
+				Name:      "slack",
+				Type:      v1beta2.NotificationWebhookTypeSlack,
+				URLSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "slack-webhook"}, Key: "url"},
+			},
+		},
+	}
+
+	// Slack webhooks are only notified of terminal states by default.
+	app.Status.AppState.State = v1beta2.ApplicationStateRunning
+	recordNotifications(app, app.Spec.Notifications)
+	assert.Empty(t, app.Status.Notifications)
+	app.Status.AppState.State = v1beta2.ApplicationStateFailed
+	recordNotifications(app, app.Spec.Notifications)
+	require.Len(t, app.Status.Notifications, 1)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "slack-webhook", Namespace: "default"},
+		Data:       map[string][]byte{"url": []byte(server.URL)},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, secret).WithStatusSubresource(app).Build()
+	reconciler := &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	wait, err := reconciler.deliverNotifications(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0].Text, "*Failure reason:* driver container failed with ExitCode: 1")
+
+	got := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, got))
+	assert.Equal(t, v1beta2.NotificationPhaseDelivered, got.Status.Notifications[0].Phase)
+	// The URL held by the Secret is not recorded in the status.
+	assert.NotContains(t, got.Status.Notifications[0].Message, server.URL)
+}
+
+func TestGetNotificationSpec(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-notifications", Namespace: "default"},
+		Data: map[string]string{
+			common.NotificationsConfigKey: `
+webhooks:
+- name: team-channel
+  type: Slack
+  url: https://hooks.slack.com/services/namespace
+emails:
+- name: oncall
+  to:
+  - oncall@example.com
+`,
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap).Build()
+
+	app := newNotificationTestApp()
+	app.Spec.Notifications = &v1beta2.NotificationSpec{
+		Webhooks: []v1beta2.NotificationWebhook{{Name: "team-channel", Type: v1beta2.NotificationWebhookTypeTeams, URL: "https://example.webhook.office.com/app"}},
+	}
+
+	reconciler := &Reconciler{client: client}
+	assert.Equal(t, app.Spec.Notifications, reconciler.getNotificationSpec(ctx, app))
+
+	reconciler.options.NotificationsConfigMap = "spark-notifications"
+	notifications := reconciler.getNotificationSpec(ctx, app)
+	require.Len(t, notifications.Webhooks, 1)
+	assert.Equal(t, v1beta2.NotificationWebhookTypeTeams, notifications.Webhooks[0].Type)
+	require.Len(t, notifications.Emails, 1)
+	assert.Equal(t, []string{"oncall@example.com"}, notifications.Emails[0].To)
+	// The application spec is left untouched.
+	assert.Empty(t, app.Spec.Notifications.Emails)
+
+	// Applications in namespaces without the ConfigMap only get their own notifications.
+	app.Namespace = "other"
+	assert.Equal(t, app.Spec.Notifications, reconciler.getNotificationSpec(ctx, app))
+}
+
+func TestSendEmailNotification(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go serveTestSMTP(listener, received)
+
+	reconciler := &Reconciler{options: Options{SMTP: &SMTPOptions{Address: listener.Addr().String(), From: "spark-operator@example.com"}}}
+	app := newNotificationTestApp()
+	email := &v1beta2.NotificationEmail{Name: "oncall", To: []string{"oncall@example.com", "data@example.com"}}
+
+	message, err := reconciler.sendEmailNotification(app, email, &v1beta2.NotificationStatus{Event: v1beta2.NotificationEventFailed})
+	require.NoError(t, err)
+	assert.Equal(t, "email sent to oncall@example.com, data@example.com", message)
+
+	select {
+	case data := <-received:
+		assert.Contains(t, data, "RCPT TO:<oncall@example.com>")
+		assert.Contains(t, data, "RCPT TO:<data@example.com>")
+		assert.Contains(t, data, "Subject: SparkApplication default/test-app failed")
+		assert.Contains(t, data, "Failure reason: driver container failed with ExitCode: 1")
+	case <-time.After(5 * time.Second):
+		t.Fatal("email not received")
+	}
+
+	reconciler.options.SMTP = nil
+	_, err = reconciler.sendEmailNotification(app, email, &v1beta2.NotificationStatus{Event: v1beta2.NotificationEventFailed})
+	assert.EqualError(t, err, "no SMTP server is configured for email notifications")
+}
+
+// serveTestSMTP accepts a single SMTP session and sends the commands and data it received.
+func serveTestSMTP(listener net.Listener, received chan<- string) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var session strings.Builder
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+	reply("220 localhost ESMTP")
+	inData := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		session.WriteString(line)
+		switch {
+		case inData && line == ".\r\n":
+			inData = false
+			reply("250 OK")
+		case inData:
+		case strings.HasPrefix(line, "EHLO"), strings.HasPrefix(line, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(line, "DATA"):
+			inData = true
+			reply("354 Start mail input")
+		case strings.HasPrefix(line, "QUIT"):
+			reply("221 Bye")
+			received <- session.String()
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+const (
+	// defaultNotificationMaxRetries is the number of retries of notifications that do not specify one.
+	defaultNotificationMaxRetries = 3
+
+	// defaultNotificationTimeoutSeconds is the timeout of notification webhooks that do not specify one.
+	defaultNotificationTimeoutSeconds = 10
+
+	// notificationInitialBackoff is the delay before the first retry of a failed notification, doubled on every retry.
+	notificationInitialBackoff = 10 * time.Second
+
+	// notificationMaxBackoff caps the delay between two retries of a failed notification.
+	notificationMaxBackoff = 5 * time.Minute
+)
+
+// terminalNotificationEvents are the events chat and email notifications are sent on by default.
+var terminalNotificationEvents = []v1beta2.NotificationEvent{
+	v1beta2.NotificationEventCompleted,
+	v1beta2.NotificationEventFailed,
+}
+
+// notificationPayload is the JSON body POSTed to generic notification webhooks.
+type notificationPayload struct {
+	Name               string    `json:"name"`
+	Namespace          string    `json:"namespace"`
+	UID                string    `json:"uid"`
+	Event              string    `json:"event"`
+	State              string    `json:"state"`
+	SubmissionID       string    `json:"submissionID,omitempty"`
+	SparkApplicationID string    `json:"sparkApplicationId,omitempty"`
+	ErrorMessage       string    `json:"errorMessage,omitempty"`
+	EventTime          time.Time `json:"eventTime"`
+}
+
+// notificationTarget is a webhook or email notified of the state transitions of an application.
+type notificationTarget struct {
+	webhook *v1beta2.NotificationWebhook
+	email   *v1beta2.NotificationEmail
+}
+
+func (t notificationTarget) name() string {
+	if t.webhook != nil {
+		return t.webhook.Name
+	}
+	return t.email.Name
+}
+
+// events returns the events the target is notified of, defaulting them according to its type.
+func (t notificationTarget) events() []v1beta2.NotificationEvent {
+	switch {
+	case t.webhook != nil && len(t.webhook.Events) > 0:
+		return t.webhook.Events
+	case t.webhook != nil && (t.webhook.Type == "" || t.webhook.Type == v1beta2.NotificationWebhookTypeGeneric):
+		return []v1beta2.NotificationEvent{
+			v1beta2.NotificationEventSubmitted,
+			v1beta2.NotificationEventRunning,
+			v1beta2.NotificationEventCompleted,
+			v1beta2.NotificationEventFailed,
+		}
+	case t.email != nil && len(t.email.Events) > 0:
+		return t.email.Events
+	}
+	return terminalNotificationEvents
+}
+
+func (t notificationTarget) maxRetries() int32 {
+	if t.webhook != nil {
+		return ptr.Deref(t.webhook.MaxRetries, defaultNotificationMaxRetries)
+	}
+	return ptr.Deref(t.email.MaxRetries, defaultNotificationMaxRetries)
+}
+
+// getNotificationTargets returns the webhooks and emails of the given notifications.
+func getNotificationTargets(notifications *v1beta2.NotificationSpec) []notificationTarget {
+	if notifications == nil {
+		return nil
+	}
+	targets := make([]notificationTarget, 0, len(notifications.Webhooks)+len(notifications.Emails))
+	for i := range notifications.Webhooks {
+		targets = append(targets, notificationTarget{webhook: &notifications.Webhooks[i]})
+	}
+	for i := range notifications.Emails {
+		targets = append(targets, notificationTarget{email: &notifications.Emails[i]})
+	}
+	return targets
+}
+
+func findNotificationTarget(notifications *v1beta2.NotificationSpec, name string) *notificationTarget {
+	for _, target := range getNotificationTargets(notifications) {
+		if target.name() == name {
+			return &target
+		}
+	}
+	return nil
+}
+
+// getNotificationSpec returns the notifications of the given SparkApplication merged with the notifications
+// configured for its namespace, if any. Notifications of the application take precedence over the ones of
+// its namespace with the same name.
+func (r *Reconciler) getNotificationSpec(ctx context.Context, app *v1beta2.SparkApplication) *v1beta2.NotificationSpec {
+	if r.options.NotificationsConfigMap == "" {
+		return app.Spec.Notifications
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: r.options.NotificationsConfigMap, Namespace: app.Namespace}, configMap); err != nil {
+		if !errors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to get namespace notifications", "configMap", r.options.NotificationsConfigMap)
+		}
+		return app.Spec.Notifications
+	}
+	namespaceNotifications, err := parseNotificationSpec(configMap)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to parse namespace notifications", "configMap", r.options.NotificationsConfigMap)
+		return app.Spec.Notifications
+	}
+	return mergeNotificationSpecs(app.Spec.Notifications, namespaceNotifications)
+}
+
+// parseNotificationSpec parses the notifications held by the given ConfigMap.
+func parseNotificationSpec(configMap *corev1.ConfigMap) (*v1beta2.NotificationSpec, error) {
+	data, ok := configMap.Data[common.NotificationsConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in config map %s", common.NotificationsConfigKey, configMap.Name)
+	}
+	notifications := &v1beta2.NotificationSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), notifications); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", common.NotificationsConfigKey, err)
+	}
+	return notifications, nil
+}
+
+// mergeNotificationSpecs adds the webhooks and emails of the namespace to the ones of the application
+// that do not have the same name.
+func mergeNotificationSpecs(app, namespace *v1beta2.NotificationSpec) *v1beta2.NotificationSpec {
+	merged := &v1beta2.NotificationSpec{}
+	if app != nil {
+		merged = app.DeepCopy()
+	}
+	for _, webhook := range namespace.Webhooks {
+		if findNotificationTarget(merged, webhook.Name) == nil {
+			merged.Webhooks = append(merged.Webhooks, webhook)
+		}
+	}
+	for _, email := range namespace.Emails {
+		if findNotificationTarget(merged, email.Name) == nil {
+			merged.Emails = append(merged.Emails, email)
+		}
+	}
+	return merged
+}
+
+// recordNotifications records a pending notification for each webhook and email of the given notifications
+// subscribed to the current state of the SparkApplication. Each target is notified at most once per submission
+// and event.
+func recordNotifications(app *v1beta2.SparkApplication, notifications *v1beta2.NotificationSpec) {
+	event, ok := getNotificationEvent(app.Status.AppState.State)
+	if !ok {
+		return
+	}
+
+	for _, target := range getNotificationTargets(notifications) {
+		if !slices.Contains(target.events(), event) {
+			continue
+		}
+		if status := findNotificationStatus(app, target.name(), event); status != nil && status.SubmissionID == app.Status.SubmissionID {
+			continue
+		}
+		setNotificationStatus(app, v1beta2.NotificationStatus{
+			Name:         target.name(),
+			Event:        event,
+			SubmissionID: app.Status.SubmissionID,
+			Phase:        v1beta2.NotificationPhasePending,
+			EventTime:    metav1.Now(),
+		})
+	}
+}
+
+// deliverNotifications makes a delivery attempt for each pending notification of the SparkApplication that is due,
+// records the outcome in the application status, and returns how long to wait before the next retry, if any.
+func (r *Reconciler) deliverNotifications(ctx context.Context, key types.NamespacedName) (time.Duration, error) {
+	app, err := r.getSparkApplication(ctx, key)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if !slices.ContainsFunc(app.Status.Notifications, func(status v1beta2.NotificationStatus) bool {
+		return status.Phase == v1beta2.NotificationPhasePending
+	}) {
+		return 0, nil
+	}
+
+	logger := log.FromContext(ctx)
+	notifications := r.getNotificationSpec(ctx, app)
+	now := time.Now()
+	var wait time.Duration
+	var attempted []v1beta2.NotificationStatus
+	for _, status := range app.Status.Notifications {
+		if status.Phase != v1beta2.NotificationPhasePending {
+			continue
+		}
+
+		if status.Attempts > 0 {
+			next := status.LastAttemptTime.Add(getNotificationBackoff(status.Attempts))
+			if now.Before(next) {
+				wait = minWait(wait, next.Sub(now))
+				continue
+			}
+		}
+
+		status.Attempts++
+		status.LastAttemptTime = metav1.NewTime(now)
+		target := findNotificationTarget(notifications, status.Name)
+		if target == nil {
+			status.Phase = v1beta2.NotificationPhaseFailed
+			status.Message = "notification is no longer configured"
+			attempted = append(attempted, status)
+			continue
+		}
+
+		var message string
+		if target.webhook != nil {
+			message, err = r.sendNotification(ctx, app, target.webhook, &status)
+		} else {
+			message, err = r.sendEmailNotification(app, target.email, &status)
+		}
+		switch {
+		case err == nil:
+			logger.Info("Delivered notification", "name", status.Name, "event", status.Event)
+			status.Phase = v1beta2.NotificationPhaseDelivered
+			status.Message = message
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeNormal,
+				common.EventSparkApplicationNotificationDelivered,
+				"Notification %s of event %s delivered: %s",
+				status.Name,
+				status.Event,
+				message,
+			)
+		case status.Attempts > target.maxRetries():
+			logger.Error(err, "Failed to deliver notification", "name", status.Name, "event", status.Event, "attempts", status.Attempts)
+			status.Phase = v1beta2.NotificationPhaseFailed
+			status.Message = err.Error()
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeWarning,
+				common.EventSparkApplicationNotificationFailed,
+				"Notification %s of event %s could not be delivered after %d attempts: %v",
+				status.Name,
+				status.Event,
+				status.Attempts,
+				err,
+			)
+		default:
+			logger.Info("Failed to deliver notification, will retry", "name", status.Name, "event", status.Event, "attempts", status.Attempts, "error", err.Error())
+			status.Message = err.Error()
+			wait = minWait(wait, getNotificationBackoff(status.Attempts))
+		}
+		attempted = append(attempted, status)
+	}
+
+	if len(attempted) == 0 {
+		return wait, nil
+	}
+
+	retryErr := retry.RetryOnConflict(
+		retry.DefaultRetry,
+		func() error {
+			app, err := r.getSparkApplication(ctx, key)
+			if err != nil {
+				return err
+			}
+			for _, status := range attempted {
+				// Do not overwrite a notification recorded for a newer transition in the meantime.
+				if existing := findNotificationStatus(app, status.Name, status.Event); existing != nil &&
+					existing.SubmissionID == status.SubmissionID && existing.EventTime.Equal(&status.EventTime) {
+					*existing = status
+				}
+			}
+			return r.client.Status().Update(ctx, app)
+		},
+	)
+	if retryErr != nil {
+		if errors.IsNotFound(retryErr) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to update notification status: %v", retryErr)
+	}
+	return wait, nil
+}
+
+// sendNotification POSTs the given notification to the webhook and returns a description of the response.
+func (r *Reconciler) sendNotification(ctx context.Context, app *v1beta2.SparkApplication, webhook *v1beta2.NotificationWebhook, status *v1beta2.NotificationStatus) (string, error) {
+	var body []byte
+	var err error
+	switch webhook.Type {
+	case v1beta2.NotificationWebhookTypeSlack:
+		body, err = json.Marshal(r.newSlackMessage(app, status))
+	case v1beta2.NotificationWebhookTypeTeams:
+		body, err = json.Marshal(r.newTeamsMessage(app, status))
+	default:
+		body, err = json.Marshal(notificationPayload{
+			Name:               app.Name,
+			Namespace:          app.Namespace,
+			UID:                string(app.UID),
+			Event:              string(status.Event),
+			State:              string(app.Status.AppState.State),
+			SubmissionID:       status.SubmissionID,
+			SparkApplicationID: app.Status.SparkApplicationID,
+			ErrorMessage:       app.Status.AppState.ErrorMessage,
+			EventTime:          status.EventTime.UTC(),
+		})
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request body: %v", err)
+	}
+
+	url := webhook.URL
+	if webhook.URLSecret != nil {
+		// The URL is not included in the messages below as it may embed a credential.
+		if url, err = r.getSecretValue(ctx, app.Namespace, webhook.URLSecret); err != nil {
+			return "", err
+		}
+		if url == "" {
+			return "", fmt.Errorf("secret %s holds no url", webhook.URLSecret.Name)
+		}
+	}
+	authorization := ""
+	if webhook.AuthSecret != nil {
+		if authorization, err = r.getSecretValue(ctx, app.Namespace, webhook.AuthSecret); err != nil {
+			return "", err
+		}
+	}
+
+	timeout := ptr.Deref(webhook.TimeoutSeconds, defaultNotificationTimeoutSeconds)
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request for webhook %s", webhook.Name)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		if webhook.URLSecret != nil {
+			return "", fmt.Errorf("failed to send request to webhook %s", webhook.Name)
+		}
+		return "", fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("webhook %s returned %s", webhook.Name, resp.Status)
+	}
+	return fmt.Sprintf("webhook %s returned %s", webhook.Name, resp.Status), nil
+}
+
+// getSecretValue returns the value of the given key of a Secret in the given namespace. An empty value is
+// returned for missing optional keys.
+func (r *Reconciler) getSecretValue(ctx context.Context, namespace string, selector *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: namespace}, secret); err != nil {
+		if errors.IsNotFound(err) && ptr.Deref(selector.Optional, false) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get secret %s: %v", selector.Name, err)
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		if ptr.Deref(selector.Optional, false) {
+			return "", nil
+		}
+		return "", fmt.Errorf("key %s not found in secret %s", selector.Key, selector.Name)
+	}
+	return string(bytes.TrimSpace(value)), nil
+}
+
+// getNotificationEvent returns the notification event of the given application state, if it has one.
+func getNotificationEvent(state v1beta2.ApplicationStateType) (v1beta2.NotificationEvent, bool) {
+	switch state {
+	case v1beta2.ApplicationStateSubmitted:
+		return v1beta2.NotificationEventSubmitted, true
+	case v1beta2.ApplicationStateRunning:
+		return v1beta2.NotificationEventRunning, true
+	case v1beta2.ApplicationStateCompleted:
+		return v1beta2.NotificationEventCompleted, true
+	case v1beta2.ApplicationStateFailed:
+		return v1beta2.NotificationEventFailed, true
+	}
+	return "", false
+}
+
+// getNotificationBackoff returns the delay before retrying a notification after the given number of attempts.
+func getNotificationBackoff(attempts int32) time.Duration {
+	backoff := notificationInitialBackoff
+	for i := int32(1); i < attempts && backoff < notificationMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, notificationMaxBackoff)
+}
+
+// minWait returns the shortest of the given non-zero durations.
+func minWait(wait, other time.Duration) time.Duration {
+	if wait == 0 || other < wait {
+		return other
+	}
+	return wait
+}
+
+func findNotificationStatus(app *v1beta2.SparkApplication, name string, event v1beta2.NotificationEvent) *v1beta2.NotificationStatus {
+	for i := range app.Status.Notifications {
+		if app.Status.Notifications[i].Name == name && app.Status.Notifications[i].Event == event {
+			return &app.Status.Notifications[i]
+		}
+	}
+	return nil
+}
+
+// setNotificationStatus records the given notification status, replacing the previous notification of the same
+// webhook or email and event.
+func setNotificationStatus(app *v1beta2.SparkApplication, status v1beta2.NotificationStatus) {
+	if existing := findNotificationStatus(app, status.Name, status.Event); existing != nil {
+		*existing = status
+		return
+	}
+	app.Status.Notifications = append(app.Status.Notifications, status)
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestRecordNotifications(t *testing.T) {
+	app := newHookTestApp()
+	app.Status.AppState.State = v1beta2.ApplicationStateRunning
+	app.Spec.Notifications = &v1beta2.NotificationSpec{
+		Webhooks: []v1beta2.NotificationWebhook{
+			{Name: "all", URL: "http://example.com/all"},
+			{Name: "terminal", URL: "http://example.com/terminal", Events: []v1beta2.NotificationEvent{v1beta2.NotificationEventCompleted}},
+		},
+	}
+
+	recordNotifications(app, app.Spec.Notifications)
+	require.Len(t, app.Status.Notifications, 1)
+	assert.Equal(t, "all", app.Status.Notifications[0].Name)
+	assert.Equal(t, v1beta2.NotificationEventRunning, app.Status.Notifications[0].Event)
+	assert.Equal(t, v1beta2.NotificationPhasePending, app.Status.Notifications[0].Phase)
+
+	// The same transition of the same submission is notified only once.
+	app.Status.Notifications[0].Phase = v1beta2.NotificationPhaseDelivered
+	recordNotifications(app, app.Spec.Notifications)
+	require.Len(t, app.Status.Notifications, 1)
+	assert.Equal(t, v1beta2.NotificationPhaseDelivered, app.Status.Notifications[0].Phase)
+
+	app.Status.AppState.State = v1beta2.ApplicationStateCompleted
+	recordNotifications(app, app.Spec.Notifications)
+	assert.Len(t, app.Status.Notifications, 3)
+
+	// A new submission is notified again.
+	app.Status.SubmissionID = "submission-2"
+	app.Status.AppState.State = v1beta2.ApplicationStateRunning
+	recordNotifications(app, app.Spec.Notifications)
+	require.Len(t, app.Status.Notifications, 3)
+	assert.Equal(t, "submission-2", app.Status.Notifications[0].SubmissionID)
+	assert.Equal(t, v1beta2.NotificationPhasePending, app.Status.Notifications[0].Phase)
+
+	// States without a notification event are not notified.
+	app.Status.AppState.State = v1beta2.ApplicationStateFailing
+	recordNotifications(app, app.Spec.Notifications)
+	assert.Len(t, app.Status.Notifications, 3)
+}
+
+func TestDeliverNotifications(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	var payloads []notificationPayload
+	var authorizations []string
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload notificationPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if fail {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	app := newHookTestApp()
+	app.Spec.Notifications = &v1beta2.NotificationSpec{
+		Webhooks: []v1beta2.NotificationWebhook{
+			{
+				Name:       "airflow",
+				URL:        server.URL,
+				AuthSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "airflow-token"}, Key: "token"},
+				MaxRetries: ptr.To[int32](1),
+			},
+		},
+	}
+	recordNotifications(app, app.Spec.Notifications)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "airflow-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("Bearer s3cr3t\n")},
+	}
+
+	client := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(app, secret).
+		WithStatusSubresource(app).
+		Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+
+	// The first attempt fails and is retried after a backoff.
+	wait, err := reconciler.deliverNotifications(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, notificationInitialBackoff, wait)
+	require.Len(t, payloads, 1)
+	assert.Equal(t, "Bearer s3cr3t", authorizations[0])
+	assert.Equal(t, "COMPLETED", payloads[0].Event)
+	assert.Equal(t, "spark-123", payloads[0].SparkApplicationID)
+	assert.Equal(t, "submission-1", payloads[0].SubmissionID)
+
+	got := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, got))
+	require.Len(t, got.Status.Notifications, 1)
+	assert.Equal(t, v1beta2.NotificationPhasePending, got.Status.Notifications[0].Phase)
+	assert.Equal(t, int32(1), got.Status.Notifications[0].Attempts)
+	assert.Contains(t, got.Status.Notifications[0].Message, "503")
+
+	// Nothing is sent before the backoff elapses.
+	wait, err = reconciler.deliverNotifications(ctx, key)
+	require.NoError(t, err)
+	assert.Positive(t, wait)
+	assert.Len(t, payloads, 1)
+
+	// The retry succeeds once the backoff elapsed.
+	fail = false
+	got.Status.Notifications[0].LastAttemptTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	require.NoError(t, client.Status().Update(ctx, got))
+	wait, err = reconciler.deliverNotifications(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	assert.Len(t, payloads, 2)
+
+	require.NoError(t, client.Get(ctx, key, got))
+	assert.Equal(t, v1beta2.NotificationPhaseDelivered, got.Status.Notifications[0].Phase)
+	assert.Equal(t, int32(2), got.Status.Notifications[0].Attempts)
+	assert.Len(t, recorder.Events, 1)
+
+	// A notification that exhausted its retries fails.
+	fail = true
+	got.Status.AppState.State = v1beta2.ApplicationStateRunning
+	recordNotifications(got, got.Spec.Notifications)
+	for i := range got.Status.Notifications {
+		if got.Status.Notifications[i].Event == v1beta2.NotificationEventRunning {
+			got.Status.Notifications[i].Attempts = 1
+			got.Status.Notifications[i].LastAttemptTime = metav1.NewTime(time.Now().Add(-time.Minute))
+		}
+	}
+	require.NoError(t, client.Status().Update(ctx, got))
+	wait, err = reconciler.deliverNotifications(ctx, key)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	require.NoError(t, client.Get(ctx, key, got))
+	status := findNotificationStatus(got, "airflow", v1beta2.NotificationEventRunning)
+	require.NotNil(t, status)
+	assert.Equal(t, v1beta2.NotificationPhaseFailed, status.Phase)
+	assert.Equal(t, int32(2), status.Attempts)
+	assert.Len(t, recorder.Events, 2)
+}
+
+func TestGetNotificationBackoff(t *testing.T) {
+	assert.Equal(t, 10*time.Second, getNotificationBackoff(1))
+	assert.Equal(t, 20*time.Second, getNotificationBackoff(2))
+	assert.Equal(t, 160*time.Second, getNotificationBackoff(5))
+	assert.Equal(t, notificationMaxBackoff, getNotificationBackoff(6))
+	assert.Equal(t, notificationMaxBackoff, getNotificationBackoff(100))
+}
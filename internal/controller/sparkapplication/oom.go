@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// oomKilledReason is the reason of the terminated state of containers killed for exceeding their memory limit.
+const oomKilledReason = "OOMKilled"
+
+// defaultSparkMemory is the memory Spark gives the driver and executors if none is configured.
+const defaultSparkMemory = "1g"
+
+// recordOOMKilled records that a container of the given role was OOMKilled during the current attempt.
+func recordOOMKilled(app *v1beta2.SparkApplication, role string, state *corev1.ContainerStateTerminated) {
+	if !util.OOMRemediationEnabled(app) || state == nil || state.Reason != oomKilledReason {
+		return
+	}
+	if !slices.Contains(app.Status.OOMKilled, role) {
+		app.Status.OOMKilled = append(app.Status.OOMKilled, role)
+	}
+}
+
+// increaseMemoryOnOOM increases the memory of the roles OOMKilled during the failed attempt before it is retried.
+func (r *Reconciler) increaseMemoryOnOOM(ctx context.Context, app *v1beta2.SparkApplication) {
+	logger := log.FromContext(ctx)
+
+	roles := app.Status.OOMKilled
+	app.Status.OOMKilled = nil
+	if !util.OOMRemediationEnabled(app) {
+		return
+	}
+
+	for _, role := range roles {
+		adjustment, err := nextMemoryAdjustment(app, role)
+		if err != nil {
+			logger.Error(err, "Failed to increase memory after OOMKilled", "role", role)
+			continue
+		}
+		if adjustment == nil {
+			logger.Info("Memory already reached the maximum after OOMKilled", "role", role, "maxMemory", *app.Spec.RestartPolicy.OnOOMMaxMemory)
+			continue
+		}
+		setMemoryAdjustment(app, *adjustment)
+		r.recorder.Eventf(app, corev1.EventTypeNormal, common.EventSparkApplicationMemoryIncreased,
+			"Increased the memory of the %s to %s after it was OOMKilled", role, adjustment.Memory)
+	}
+}
+
+// nextMemoryAdjustment returns the memory of the given role increased by the configured percentage, starting from
+// the previous adjustment if any, or nil if the memory already reached the configured maximum.
+func nextMemoryAdjustment(app *v1beta2.SparkApplication, role string) (*v1beta2.MemoryAdjustment, error) {
+	podSpec, memoryKey, _ := getRoleMemorySpec(app, role)
+	if podSpec == nil {
+		return nil, fmt.Errorf("unknown role %q", role)
+	}
+
+	adjustment := v1beta2.MemoryAdjustment{
+		Role:           role,
+		MemoryOverhead: podSpec.MemoryOverhead,
+		MemoryLimit:    podSpec.MemoryLimit,
+	}
+	memory := defaultSparkMemory
+	if podSpec.Memory != nil {
+		memory = *podSpec.Memory
+	} else if value, ok := app.Spec.SparkConf[memoryKey]; ok {
+		memory = value
+	}
+	if previous := util.GetMemoryAdjustment(app, role); previous != nil {
+		adjustment = *previous.DeepCopy()
+		memory = previous.Memory
+	}
+
+	current, err := parseMemory(memory)
+	if err != nil {
+		return nil, err
+	}
+	increased := current * (100 + int64(*app.Spec.RestartPolicy.OnOOMIncreaseMemoryPercent)) / 100
+	if maxMemory := app.Spec.RestartPolicy.OnOOMMaxMemory; maxMemory != nil {
+		limit, err := parseMemory(*maxMemory)
+		if err != nil {
+			return nil, err
+		}
+		if current >= limit {
+			return nil, nil
+		}
+		increased = min(increased, limit)
+	}
+	// Spark sizes the heap in whole MiB.
+	increased = increased >> 20 << 20
+	if increased <= current {
+		return nil, nil
+	}
+	ratio := float64(increased) / float64(current)
+
+	adjustment.Memory = fmt.Sprintf("%dm", increased>>20)
+	if adjustment.MemoryOverhead != nil {
+		overhead, err := parseMemory(*adjustment.MemoryOverhead)
+		if err != nil {
+			return nil, err
+		}
+		adjustment.MemoryOverhead = ptr.To(fmt.Sprintf("%dm", int64(float64(overhead)*ratio)>>20))
+	}
+	if adjustment.MemoryLimit != nil {
+		limit, err := parseMemory(*adjustment.MemoryLimit)
+		if err != nil {
+			return nil, err
+		}
+		// Round up to whole MiB so the limit grows at least as much as the heap and memory overhead.
+		increasedLimit := (int64(float64(limit)*ratio) + 1<<20 - 1) >> 20 << 20
+		adjustment.MemoryLimit = ptr.To(resource.NewQuantity(increasedLimit, resource.BinarySI).String())
+	}
+	adjustment.Adjustments++
+	adjustment.LastAdjustmentTime = metav1.Now()
+	return &adjustment, nil
+}
+
+// setMemoryAdjustment replaces the memory adjustment of the same role in the status of the given SparkApplication.
+func setMemoryAdjustment(app *v1beta2.SparkApplication, adjustment v1beta2.MemoryAdjustment) {
+	if previous := util.GetMemoryAdjustment(app, adjustment.Role); previous != nil {
+		*previous = adjustment
+		return
+	}
+	app.Status.MemoryAdjustments = append(app.Status.MemoryAdjustments, adjustment)
+}
+
+// applyMemoryAdjustments submits the driver and executors with the memory increased after they were OOMKilled.
+func applyMemoryAdjustments(app *v1beta2.SparkApplication) {
+	for _, adjustment := range app.Status.MemoryAdjustments {
+		podSpec, memoryKey, memoryOverheadKey := getRoleMemorySpec(app, adjustment.Role)
+		if podSpec == nil {
+			continue
+		}
+		podSpec.Memory = ptr.To(adjustment.Memory)
+		delete(app.Spec.SparkConf, memoryKey)
+		if adjustment.MemoryOverhead != nil {
+			podSpec.MemoryOverhead = adjustment.MemoryOverhead
+			delete(app.Spec.SparkConf, memoryOverheadKey)
+		}
+		if adjustment.MemoryLimit != nil {
+			podSpec.MemoryLimit = adjustment.MemoryLimit
+		}
+	}
+}
+
+// getRoleMemorySpec returns the pod spec and the Spark configuration keys of the memory and memory overhead of the
+// given role, driver or executor.
+func getRoleMemorySpec(app *v1beta2.SparkApplication, role string) (*v1beta2.SparkPodSpec, string, string) {
+	switch role {
+	case common.SparkRoleDriver:
+		return &app.Spec.Driver.SparkPodSpec, common.SparkDriverMemory, common.SparkDriverMemoryOverhead
+	case common.SparkRoleExecutor:
+		return &app.Spec.Executor.SparkPodSpec, common.SparkExecutorMemory, common.SparkExecutorMemoryOverhead
+	}
+	return nil, "", ""
+}
+
+// parseMemory parses a JVM memory string, e.g. 1g, or a Kubernetes quantity, e.g. 1Gi, into bytes.
+func parseMemory(memory string) (int64, error) {
+	quantity, err := resource.ParseQuantity(util.ConvertJavaMemoryStringToK8sMemoryString(memory))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse memory %q: %v", memory, err)
+	}
+	return quantity.Value(), nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newOOMRemediationApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConf: map[string]string{common.SparkExecutorMemory: "2g"},
+			RestartPolicy: v1beta2.RestartPolicy{
+				Type:                       v1beta2.RestartPolicyOnFailure,
+				OnFailureRetries:           ptr.To[int32](3),
+				OnOOMIncreaseMemoryPercent: ptr.To[int32](50),
+				OnOOMMaxMemory:             ptr.To("2g"),
+			},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Memory:         ptr.To("1g"),
+					MemoryOverhead: ptr.To("512m"),
+					MemoryLimit:    ptr.To("2Gi"),
+				},
+			},
+		},
+	}
+}
+
+func TestRecordOOMKilled(t *testing.T) {
+	app := newOOMRemediationApp()
+	oomKilled := &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}
+
+	recordOOMKilled(app, common.SparkRoleExecutor, &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"})
+	recordOOMKilled(app, common.SparkRoleExecutor, nil)
+	assert.Empty(t, app.Status.OOMKilled)
+
+	recordOOMKilled(app, common.SparkRoleExecutor, oomKilled)
+	recordOOMKilled(app, common.SparkRoleExecutor, oomKilled)
+	assert.Equal(t, []string{common.SparkRoleExecutor}, app.Status.OOMKilled)
+
+	app = newOOMRemediationApp()
+	app.Spec.RestartPolicy.OnOOMIncreaseMemoryPercent = nil
+	recordOOMKilled(app, common.SparkRoleDriver, oomKilled)
+	assert.Empty(t, app.Status.OOMKilled)
+}
+
+func TestIncreaseMemoryOnOOM(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{recorder: recorder}
+	app := newOOMRemediationApp()
+
+	app.Status.OOMKilled = []string{common.SparkRoleDriver, common.SparkRoleExecutor}
+	r.increaseMemoryOnOOM(context.Background(), app)
+	assert.Empty(t, app.Status.OOMKilled)
+	// The executors already have the maximum memory.
+	require.Len(t, app.Status.MemoryAdjustments, 1)
+	adjustment := app.Status.MemoryAdjustments[0]
+	assert.Equal(t, common.SparkRoleDriver, adjustment.Role)
+	assert.Equal(t, "1536m", adjustment.Memory)
+	assert.Equal(t, "768m", *adjustment.MemoryOverhead)
+	assert.Equal(t, "3Gi", *adjustment.MemoryLimit)
+	assert.Equal(t, int32(1), adjustment.Adjustments)
+	assert.Contains(t, <-recorder.Events, "Increased the memory of the driver to 1536m after it was OOMKilled")
+
+	// The next increase starts from the previous one and stops at the maximum.
+	app.Status.OOMKilled = []string{common.SparkRoleDriver}
+	r.increaseMemoryOnOOM(context.Background(), app)
+	require.Len(t, app.Status.MemoryAdjustments, 1)
+	adjustment = app.Status.MemoryAdjustments[0]
+	assert.Equal(t, "2048m", adjustment.Memory)
+	assert.Equal(t, "1024m", *adjustment.MemoryOverhead)
+	assert.Equal(t, "4Gi", *adjustment.MemoryLimit)
+	assert.Equal(t, int32(2), adjustment.Adjustments)
+
+	app.Status.OOMKilled = []string{common.SparkRoleDriver}
+	r.increaseMemoryOnOOM(context.Background(), app)
+	assert.Equal(t, int32(2), app.Status.MemoryAdjustments[0].Adjustments)
+	assert.Len(t, recorder.Events, 1)
+}
+
+func TestApplyMemoryAdjustments(t *testing.T) {
+	app := newOOMRemediationApp()
+	app.Status.MemoryAdjustments = []v1beta2.MemoryAdjustment{
+		{Role: common.SparkRoleDriver, Memory: "1536m", MemoryOverhead: ptr.To("768m"), MemoryLimit: ptr.To("3Gi"), Adjustments: 1},
+		{Role: common.SparkRoleExecutor, Memory: "3072m", Adjustments: 1},
+	}
+
+	applyMemoryAdjustments(app)
+	assert.Equal(t, "1536m", *app.Spec.Driver.Memory)
+	assert.Equal(t, "768m", *app.Spec.Driver.MemoryOverhead)
+	assert.Equal(t, "3Gi", *app.Spec.Driver.MemoryLimit)
+	assert.Equal(t, "3072m", *app.Spec.Executor.Memory)
+	assert.Nil(t, app.Spec.Executor.MemoryOverhead)
+	assert.NotContains(t, app.Spec.SparkConf, common.SparkExecutorMemory)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// createExecutorPodDisruptionBudget creates or updates the PodDisruptionBudget protecting the executor pods
+// of the given SparkApplication. It is a no-op if the application does not request one.
+func (r *Reconciler) createExecutorPodDisruptionBudget(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.Executor.PodDisruptionBudget == nil {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	desired := buildExecutorPodDisruptionBudget(app)
+	existing := &policyv1.PodDisruptionBudget{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(ctx, desired); err != nil {
+			return err
+		}
+		logger.Info("Created executor PodDisruptionBudget for SparkApplication", "name", desired.Name)
+		return nil
+	}
+
+	existing.Labels = desired.Labels
+	existing.OwnerReferences = desired.OwnerReferences
+	existing.Spec = desired.Spec
+	if err := r.client.Update(ctx, existing); err != nil {
+		return err
+	}
+	logger.Info("Updated executor PodDisruptionBudget for SparkApplication", "name", existing.Name)
+	return nil
+}
+
+// deleteExecutorPodDisruptionBudget deletes the executor PodDisruptionBudget of the given SparkApplication if any.
+func (r *Reconciler) deleteExecutorPodDisruptionBudget(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if app.Spec.Executor.PodDisruptionBudget == nil {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	name := util.GetExecutorPodDisruptionBudgetName(app)
+	logger.Info("Deleting executor PodDisruptionBudget", "name", name)
+	if err := r.client.Delete(
+		ctx,
+		&policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: app.Namespace,
+			},
+		},
+	); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func buildExecutorPodDisruptionBudget(app *v1beta2.SparkApplication) *policyv1.PodDisruptionBudget {
+	budget := app.Spec.Executor.PodDisruptionBudget
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetExecutorPodDisruptionBudgetName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetResourceLabels(app),
+			OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   budget.MinAvailable,
+			MaxUnavailable: budget.MaxUnavailable,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					common.LabelSparkAppName: app.Name,
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			},
+		},
+	}
+}
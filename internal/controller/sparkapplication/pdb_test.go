@@ -0,0 +1,93 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestCreateExecutorPodDisruptionBudget(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, policyv1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+	}
+	key := types.NamespacedName{Name: "test-app-executor-pdb", Namespace: "default"}
+
+	t.Run("no budget requested", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+		require.NoError(t, reconciler.createExecutorPodDisruptionBudget(ctx, app))
+
+		err := client.Get(ctx, key, &policyv1.PodDisruptionBudget{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("create and update budget", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+
+		withBudget := app.DeepCopy()
+		minAvailable := intstr.FromInt32(2)
+		withBudget.Spec.Executor.PodDisruptionBudget = &v1beta2.ExecutorPodDisruptionBudget{MinAvailable: &minAvailable}
+		require.NoError(t, reconciler.createExecutorPodDisruptionBudget(ctx, withBudget))
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		require.NoError(t, client.Get(ctx, key, pdb))
+		assert.Equal(t, &minAvailable, pdb.Spec.MinAvailable)
+		assert.Nil(t, pdb.Spec.MaxUnavailable)
+		assert.Equal(t, map[string]string{
+			common.LabelSparkAppName: "test-app",
+			common.LabelSparkRole:    common.SparkRoleExecutor,
+		}, pdb.Spec.Selector.MatchLabels)
+		require.Len(t, pdb.OwnerReferences, 1)
+		assert.Equal(t, app.UID, pdb.OwnerReferences[0].UID)
+
+		maxUnavailable := intstr.FromString("50%")
+		withBudget.Spec.Executor.PodDisruptionBudget = &v1beta2.ExecutorPodDisruptionBudget{MaxUnavailable: &maxUnavailable}
+		require.NoError(t, reconciler.createExecutorPodDisruptionBudget(ctx, withBudget))
+
+		require.NoError(t, client.Get(ctx, key, pdb))
+		assert.Nil(t, pdb.Spec.MinAvailable)
+		assert.Equal(t, &maxUnavailable, pdb.Spec.MaxUnavailable)
+
+		require.NoError(t, reconciler.deleteExecutorPodDisruptionBudget(ctx, withBudget))
+		err := client.Get(ctx, key, pdb)
+		assert.True(t, errors.IsNotFound(err))
+	})
+}
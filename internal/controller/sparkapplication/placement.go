@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// recordDriverZone records the zone of the node the driver pod is scheduled on in the status of the given
+// SparkApplication, which the webhook uses to schedule the executor pods in the same zone.
+func (r *Reconciler) recordDriverZone(ctx context.Context, app *v1beta2.SparkApplication, driverPod *corev1.Pod) {
+	if app.Spec.Placement == nil || app.Spec.Placement.ColocateInZone == nil {
+		return
+	}
+	if app.Status.DriverInfo.Zone != "" || driverPod.Spec.NodeName == "" {
+		return
+	}
+
+	logger := log.FromContext(ctx)
+	node := &corev1.Node{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: driverPod.Spec.NodeName}, node); err != nil {
+		logger.Error(err, "Failed to get node of driver pod", "pod", driverPod.Name, "node", driverPod.Spec.NodeName)
+		return
+	}
+	zone := node.Labels[corev1.LabelTopologyZone]
+	if zone == "" {
+		logger.V(1).Info("Node of driver pod has no zone label, executors fall back to pod affinity", "node", node.Name, "label", corev1.LabelTopologyZone)
+		return
+	}
+	app.Status.DriverInfo.Zone = zone
+}
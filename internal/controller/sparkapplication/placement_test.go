@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestRecordDriverZone(t *testing.T) {
+	zonedNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "zoned-node",
+			Labels: map[string]string{corev1.LabelTopologyZone: "us-east-1a"},
+		},
+	}
+	unzonedNode := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "unzoned-node"}}
+	client := fake.NewClientBuilder().WithObjects(zonedNode, unzonedNode).Build()
+	reconciler := &Reconciler{client: client}
+
+	newApp := func(colocation *v1beta2.ZoneColocation) *v1beta2.SparkApplication {
+		app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"}}
+		if colocation != nil {
+			app.Spec.Placement = &v1beta2.PlacementSpec{ColocateInZone: colocation}
+		}
+		return app
+	}
+	newDriverPod := func(nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	app := newApp(ptr.To(v1beta2.ZoneColocationRequired))
+	reconciler.recordDriverZone(context.Background(), app, newDriverPod("zoned-node"))
+	assert.Equal(t, "us-east-1a", app.Status.DriverInfo.Zone)
+
+	// The zone is not recorded unless colocation is enabled.
+	app = newApp(nil)
+	reconciler.recordDriverZone(context.Background(), app, newDriverPod("zoned-node"))
+	assert.Empty(t, app.Status.DriverInfo.Zone)
+
+	// Unscheduled drivers, nodes without a zone label and missing nodes leave the zone empty.
+	for _, nodeName := range []string{"", "unzoned-node", "missing-node"} {
+		app = newApp(ptr.To(v1beta2.ZoneColocationPreferred))
+		reconciler.recordDriverZone(context.Background(), app, newDriverPod(nodeName))
+		assert.Empty(t, app.Status.DriverInfo.Zone, nodeName)
+	}
+
+	// A recorded zone is kept.
+	app = newApp(ptr.To(v1beta2.ZoneColocationPreferred))
+	app.Status.DriverInfo.Zone = "us-east-1b"
+	reconciler.recordDriverZone(context.Background(), app, newDriverPod("zoned-node"))
+	assert.Equal(t, "us-east-1b", app.Status.DriverInfo.Zone)
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// getQuotaFootprint returns a copy of the given SparkApplication only requesting its minimum number of executors,
+// whose resources must fit in the ResourceQuotas of its namespace for the application to make progress.
+func getQuotaFootprint(app *v1beta2.SparkApplication) *v1beta2.SparkApplication {
+	executors := ptr.Deref(app.Spec.Executor.Instances, 1)
+	if app.Spec.DynamicAllocation != nil && app.Spec.DynamicAllocation.Enabled {
+		executors = ptr.Deref(app.Spec.DynamicAllocation.MinExecutors, 0)
+	}
+
+	footprint := app.DeepCopy()
+	footprint.Spec.Executor.Instances = &executors
+	return footprint
+}
+
+// failForQuotaLimits moves the given SparkApplication to the SUBMISSION_FAILED state if its driver and minimum number
+// of executors request more than the hard limits of a ResourceQuota of its namespace, as it would otherwise wait for
+// quota forever, and returns whether it failed.
+func (r *Reconciler) failForQuotaLimits(ctx context.Context, app *v1beta2.SparkApplication) (bool, error) {
+	resourceQuota, exceeded, err := webhook.FindResourceQuotaWithExceededLimits(ctx, r.client, getQuotaFootprint(app))
+	if err != nil {
+		return false, err
+	}
+	if resourceQuota == nil {
+		return false, nil
+	}
+
+	meta.RemoveStatusCondition(&app.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+	app.Status.AppState = v1beta2.ApplicationState{
+		State: v1beta2.ApplicationStateFailedSubmission,
+		ErrorMessage: fmt.Sprintf("driver and minimum executors request more %s than the hard limits of ResourceQuota %s allow",
+			strings.Join(exceeded, ", "), resourceQuota.Name),
+	}
+	r.recordSparkApplicationEvent(app)
+	return true, nil
+}
+
+// waitForQuota moves the given SparkApplication to the QUOTA_WAIT state if the ResourceQuotas of its namespace lack
+// the headroom for its driver and minimum number of executors, and returns whether it has to wait.
+func (r *Reconciler) waitForQuota(ctx context.Context, app *v1beta2.SparkApplication) (bool, error) {
+	resourceQuota, exceeded, err := webhook.FindExceededResourceQuota(ctx, r.client, getQuotaFootprint(app))
+	if err != nil {
+		return false, err
+	}
+	if resourceQuota == nil {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("Submission is waiting for ResourceQuota %s to have enough %s", resourceQuota.Name, strings.Join(exceeded, ", "))
+	app.Status.AppState = v1beta2.ApplicationState{State: v1beta2.ApplicationStateQuotaWait}
+	changed := meta.SetStatusCondition(&app.Status.Conditions, metav1.Condition{
+		Type:               v1beta2.SparkApplicationConditionSubmissionQueued,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: app.Generation,
+		Reason:             v1beta2.SparkApplicationReasonQuotaWait,
+		Message:            message,
+	})
+	if changed {
+		r.recorder.Event(app, corev1.EventTypeNormal, common.EventSparkApplicationQuotaWait, message)
+	}
+	return true, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestGetQuotaFootprint(t *testing.T) {
+	app := &v1beta2.SparkApplication{}
+	assert.Equal(t, int32(1), *getQuotaFootprint(app).Spec.Executor.Instances)
+
+	app.Spec.Executor.Instances = ptr.To[int32](10)
+	assert.Equal(t, int32(10), *getQuotaFootprint(app).Spec.Executor.Instances)
+
+	app.Spec.DynamicAllocation = &v1beta2.DynamicAllocation{Enabled: true, MinExecutors: ptr.To[int32](2)}
+	assert.Equal(t, int32(2), *getQuotaFootprint(app).Spec.Executor.Instances)
+	assert.Equal(t, int32(10), *app.Spec.Executor.Instances)
+
+	app.Spec.DynamicAllocation.MinExecutors = nil
+	assert.Equal(t, int32(0), *getQuotaFootprint(app).Spec.Executor.Instances)
+}
+
+func TestReconcileNewSparkApplicationWaitingForQuota(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver:   v1beta2.DriverSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}},
+			Executor: v1beta2.ExecutorSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}, Instances: ptr.To[int32](2)},
+		},
+	}
+	hard := corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+		Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: hard,
+			Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, quota).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(1)
+	reconciler := &Reconciler{
+		client:   client,
+		recorder: recorder,
+		options:  Options{EnableQuotaWait: true, QuotaWaitRequeueInterval: time.Minute},
+	}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	result, err := reconciler.reconcileNewSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, result.RequeueAfter)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStateQuotaWait, updated.Status.AppState.State)
+	condition := meta.FindStatusCondition(updated.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued)
+	require.NotNil(t, condition)
+	assert.Equal(t, v1beta2.SparkApplicationReasonQuotaWait, condition.Reason)
+	assert.Equal(t, "Submission is waiting for ResourceQuota compute to have enough requests.cpu", condition.Message)
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationQuotaWait)
+
+	// Checking again while the quota still lacks headroom does not emit another event.
+	_, err = reconciler.reconcileNewSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestReconcileNewSparkApplicationExceedingQuotaLimits(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	// The driver and the minimum number of executors request 5 CPUs, more than the quota allows even when unused.
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver:            v1beta2.DriverSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}},
+			Executor:          v1beta2.ExecutorSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}, Instances: ptr.To[int32](10)},
+			DynamicAllocation: &v1beta2.DynamicAllocation{Enabled: true, MinExecutors: ptr.To[int32](4)},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateQuotaWait},
+		},
+	}
+	hard := corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")}
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+		Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+		Status:     corev1.ResourceQuotaStatus{Hard: hard},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, quota).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(1)
+	reconciler := &Reconciler{
+		client:   client,
+		recorder: recorder,
+		options:  Options{EnableQuotaWait: true, QuotaWaitRequeueInterval: time.Minute},
+	}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	result, err := reconciler.reconcileNewSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	assert.Zero(t, result.RequeueAfter)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStateFailedSubmission, updated.Status.AppState.State)
+	assert.Equal(t, "driver and minimum executors request more requests.cpu than the hard limits of ResourceQuota compute allow",
+		updated.Status.AppState.ErrorMessage)
+	assert.Nil(t, meta.FindStatusCondition(updated.Status.Conditions, v1beta2.SparkApplicationConditionSubmissionQueued))
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationSubmissionFailed)
+}
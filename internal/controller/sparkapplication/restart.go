@@ -0,0 +1,103 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// shouldRestart returns whether the given SparkApplication has a pending restart request that can be carried
+// out in its current state. Applications that are about to be submitted anyway carry out the request with their
+// next submission, and the other ones are restarted according to their restart request policy.
+func shouldRestart(app *v1beta2.SparkApplication) bool {
+	if !util.IsRestartRequested(app) {
+		return false
+	}
+
+	switch app.Status.AppState.State {
+	case v1beta2.ApplicationStateCompleted,
+		v1beta2.ApplicationStateFailed,
+		v1beta2.ApplicationStateFailedSubmission,
+		v1beta2.ApplicationStatePreflightFailed:
+		return true
+	case v1beta2.ApplicationStateSubmitted,
+		v1beta2.ApplicationStateRunning,
+		v1beta2.ApplicationStateUnknown:
+		return app.Spec.RestartPolicy.OnRestartRequest == v1beta2.RestartRequestPolicyAlways
+	}
+	return false
+}
+
+// transitionToRestarting submits the SparkApplication again upon a restart request. Completed and failed
+// applications are invalidated, which starts over their submission and execution attempts. Active applications
+// are killed and resubmitted instead, which keeps their attempts like a retry.
+func (r *Reconciler) transitionToRestarting(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	key := req.NamespacedName
+	retryErr := retry.RetryOnConflict(
+		retry.DefaultRetry,
+		func() error {
+			old, err := r.getSparkApplication(ctx, key)
+			if err != nil {
+				return err
+			}
+			if !shouldRestart(old) {
+				return nil
+			}
+			app := old.DeepCopy()
+
+			restartedAt := app.Annotations[common.AnnotationRestartedAt]
+			logger.Info("Restarting SparkApplication upon request", "state", app.Status.AppState.State, "restartedAt", restartedAt)
+			switch app.Status.AppState.State {
+			case v1beta2.ApplicationStateSubmitted, v1beta2.ApplicationStateRunning, v1beta2.ApplicationStateUnknown:
+				if err := r.deleteSparkResources(ctx, app); err != nil {
+					return err
+				}
+				app.Status.AppState.State = v1beta2.ApplicationStatePendingRerun
+				r.resetSparkApplicationStatus(app)
+			default:
+				app.Status.AppState.State = v1beta2.ApplicationStateInvalidating
+			}
+			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
+				return err
+			}
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeNormal,
+				common.EventSparkApplicationRestartRequested,
+				"SparkApplication %s is restarted as requested at %s",
+				app.Name,
+				restartedAt,
+			)
+			return nil
+		},
+	)
+	if retryErr != nil {
+		logger.Error(retryErr, "Failed to reconcile SparkApplication")
+		return ctrl.Result{Requeue: true}, retryErr
+	}
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestShouldRestart(t *testing.T) {
+	newApp := func(state v1beta2.ApplicationStateType, restartedAt, lastRestartedAt string, policy v1beta2.RestartRequestPolicy) *v1beta2.SparkApplication {
+		app := &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				RestartPolicy: v1beta2.RestartPolicy{OnRestartRequest: policy},
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:        v1beta2.ApplicationState{State: state},
+				LastRestartedAt: lastRestartedAt,
+			},
+		}
+		if restartedAt != "" {
+			app.Annotations = map[string]string{common.AnnotationRestartedAt: restartedAt}
+		}
+		return app
+	}
+
+	testCases := []struct {
+		name     string
+		app      *v1beta2.SparkApplication
+		expected bool
+	}{
+		{
+			name:     "no annotation",
+			app:      newApp(v1beta2.ApplicationStateCompleted, "", "", ""),
+			expected: false,
+		},
+		{
+			name:     "restart already carried out",
+			app:      newApp(v1beta2.ApplicationStateCompleted, "2026-01-01T00:00:00Z", "2026-01-01T00:00:00Z", ""),
+			expected: false,
+		},
+		{
+			name:     "completed application",
+			app:      newApp(v1beta2.ApplicationStateCompleted, "2026-01-02T00:00:00Z", "2026-01-01T00:00:00Z", ""),
+			expected: true,
+		},
+		{
+			name:     "failed application",
+			app:      newApp(v1beta2.ApplicationStateFailed, "2026-01-02T00:00:00Z", "", ""),
+			expected: true,
+		},
+		{
+			name:     "running application is restarted once terminated by default",
+			app:      newApp(v1beta2.ApplicationStateRunning, "2026-01-02T00:00:00Z", "", ""),
+			expected: false,
+		},
+		{
+			name:     "running application with Always policy",
+			app:      newApp(v1beta2.ApplicationStateRunning, "2026-01-02T00:00:00Z", "", v1beta2.RestartRequestPolicyAlways),
+			expected: true,
+		},
+		{
+			name:     "application about to be submitted",
+			app:      newApp(v1beta2.ApplicationStatePendingRerun, "2026-01-02T00:00:00Z", "", v1beta2.RestartRequestPolicyAlways),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, shouldRestart(tc.app))
+		})
+	}
+}
+
+func TestTransitionToRestarting(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-app",
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationRestartedAt: "2026-01-02T00:00:00Z"},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:        v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted},
+			LastRestartedAt: "2026-01-01T00:00:00Z",
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(1)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	_, err := reconciler.transitionToRestarting(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStateInvalidating, updated.Status.AppState.State)
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationRestartRequested)
+}
+
+func TestTransitionToRestartingActiveApplication(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-app",
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationRestartedAt: "2026-01-02T00:00:00Z"},
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			RestartPolicy: v1beta2.RestartPolicy{OnRestartRequest: v1beta2.RestartRequestPolicyAlways},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:           v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			DriverInfo:         v1beta2.DriverInfo{PodName: "test-app-driver"},
+			SparkApplicationID: "spark-123",
+			SubmissionAttempts: 2,
+			ExecutionAttempts:  2,
+		},
+	}
+	driverPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"}}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, driverPod).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(1)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	_, err := reconciler.transitionToRestarting(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStatePendingRerun, updated.Status.AppState.State)
+	assert.Empty(t, updated.Status.SparkApplicationID)
+	assert.Equal(t, int32(2), updated.Status.SubmissionAttempts)
+	assert.Equal(t, int32(2), updated.Status.ExecutionAttempts)
+	err = client.Get(ctx, types.NamespacedName{Name: driverPod.Name, Namespace: driverPod.Namespace}, &corev1.Pod{})
+	assert.True(t, errors.IsNotFound(err))
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationRestartRequested)
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// updateExecutorScaleStatus sets the replicas and the selector read through the scale subresource of the given
+// SparkApplication from the states of its executors. Scaling the executors of a running application through
+// the scale subresource changes its spec, which reruns it with the new number of executors unless
+// spec.executor.inPlaceScaling is set.
+func updateExecutorScaleStatus(app *v1beta2.SparkApplication) {
+	var replicas int32
+	for _, state := range app.Status.ExecutorState {
+		if state == v1beta2.ExecutorStatePending || state == v1beta2.ExecutorStateRunning {
+			replicas++
+		}
+	}
+	app.Status.ExecutorReplicas = replicas
+
+	selector := util.GetResourceLabels(app)
+	selector[common.LabelSparkRole] = common.SparkRoleExecutor
+	app.Status.ExecutorSelector = labels.SelectorFromSet(selector).String()
+}
+
+// configExecutorInPlaceScaling registers the executor scaling driver plugin and tells it where to serve the
+// endpoint the operator calls to scale the executors of the application in place. Every submission gets a new
+// token, which the plugin requires on the requests and reads from the executor scaling Secret.
+func (r *Reconciler) configExecutorInPlaceScaling(ctx context.Context, app *v1beta2.SparkApplication) error {
+	token, err := newExecutorScalingToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate executor scaling token: %v", err)
+	}
+	secret := buildExecutorScalingSecret(app, token)
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		existing := &corev1.Secret{}
+		if err := r.client.Get(ctx, key, existing); err != nil {
+			if errors.IsNotFound(err) {
+				return r.client.Create(ctx, secret)
+			}
+			return err
+		}
+		existing.Labels = secret.Labels
+		existing.Data = secret.Data
+		return r.client.Update(ctx, existing)
+	}); err != nil {
+		return err
+	}
+
+	scaling := app.Spec.Executor.InPlaceScaling
+	addSparkPlugin(app, scaling.PluginClass)
+	app.Spec.SparkConf[common.SparkExecutorScalingPath] = getExecutorScalingPath(scaling)
+	app.Spec.SparkConf[common.SparkExecutorScalingPort] = strconv.Itoa(int(getExecutorScalingPort(scaling)))
+	app.Spec.SparkConf[common.SparkExecutorScalingMaxExecutors] = strconv.Itoa(int(util.GetExecutorInPlaceScalingMaxInstances(app)))
+	if app.Spec.Driver.EnvSecretKeyRefs == nil {
+		app.Spec.Driver.EnvSecretKeyRefs = make(map[string]v1beta2.NameKey)
+	}
+	app.Spec.Driver.EnvSecretKeyRefs[common.EnvExecutorScalingToken] = v1beta2.NameKey{
+		Name: secret.Name,
+		Key:  common.ExecutorScalingTokenKey,
+	}
+	return nil
+}
+
+// newExecutorScalingToken returns a random token for the executor scaling plugin.
+func newExecutorScalingToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(token), nil
+}
+
+// buildExecutorScalingSecret builds the Secret holding the given executor scaling token of the given application.
+func buildExecutorScalingSecret(app *v1beta2.SparkApplication, token string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.GetExecutorScalingSecretName(app),
+			Namespace:       app.Namespace,
+			Labels:          util.GetDependentResourceLabels(app),
+			OwnerReferences: util.GetDependentOwnerReferences(app),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{common.ExecutorScalingTokenKey: []byte(token)},
+	}
+}
+
+// getExecutorScalingPath returns the path the executor scaling plugin serves.
+func getExecutorScalingPath(scaling *v1beta2.ExecutorInPlaceScaling) string {
+	if scaling.Path != nil {
+		return *scaling.Path
+	}
+	return common.DefaultExecutorInPlaceScalingPath
+}
+
+// getExecutorScalingPort returns the port the executor scaling plugin listens on.
+func getExecutorScalingPort(scaling *v1beta2.ExecutorInPlaceScaling) int32 {
+	if scaling.Port != nil {
+		return *scaling.Port
+	}
+	return common.DefaultExecutorInPlaceScalingPort
+}
+
+// executorScalingClient is the HTTP client used to ask drivers to scale their executors.
+var executorScalingClient = &http.Client{Timeout: 5 * time.Second}
+
+// executorScalingRetryInterval is the delay after which failed in-place scaling is retried.
+const executorScalingRetryInterval = 15 * time.Second
+
+// scaleExecutorsInPlace asks the driver of a running SparkApplication to run the number of executors of its spec,
+// capped to the maximum of in-place scaling, if it differs from the number the current run was submitted or last
+// scaled with. It returns the delay after which the request should be retried, or zero if it succeeded or was not
+// needed.
+func (r *Reconciler) scaleExecutorsInPlace(ctx context.Context, app *v1beta2.SparkApplication) time.Duration {
+	if app.Spec.Executor.InPlaceScaling == nil || app.Spec.Executor.Instances == nil {
+		return 0
+	}
+	instances := min(*app.Spec.Executor.Instances, util.GetExecutorInPlaceScalingMaxInstances(app))
+	if instances == app.Status.ExecutorInstances {
+		return 0
+	}
+
+	logger := log.FromContext(ctx)
+	if err := r.requestExecutorInstances(ctx, app, instances); err != nil {
+		logger.Info("Failed to scale executors of SparkApplication in place", "instances", instances, "error", err.Error())
+		return executorScalingRetryInterval
+	}
+
+	logger.Info("Scaled executors of SparkApplication in place", "from", app.Status.ExecutorInstances, "to", instances)
+	r.recorder.Eventf(
+		app,
+		corev1.EventTypeNormal,
+		common.EventSparkApplicationExecutorsScaled,
+		"SparkApplication %s is scaled from %d to %d executors",
+		app.Name,
+		app.Status.ExecutorInstances,
+		instances,
+	)
+	app.Status.ExecutorInstances = instances
+	return 0
+}
+
+// requestExecutorInstances POSTs the given number of executors to the endpoint the executor scaling plugin serves on
+// the driver of the given SparkApplication, authenticated with the executor scaling token of the submission.
+func (r *Reconciler) requestExecutorInstances(ctx context.Context, app *v1beta2.SparkApplication, instances int32) error {
+	scaling := app.Spec.Executor.InPlaceScaling
+	driverPod, err := r.getDriverPod(ctx, app)
+	if err != nil {
+		return err
+	}
+	if driverPod == nil || driverPod.Status.PodIP == "" {
+		return fmt.Errorf("driver pod %s has no IP", app.Status.DriverInfo.PodName)
+	}
+	secret := &corev1.Secret{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: util.GetExecutorScalingSecretName(app)}, secret); err != nil {
+		return fmt.Errorf("failed to get executor scaling token: %v", err)
+	}
+
+	body, err := json.Marshal(map[string]int32{"totalExecutors": instances})
+	if err != nil {
+		return err
+	}
+	port := strconv.Itoa(int(getExecutorScalingPort(scaling)))
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(driverPod.Status.PodIP, port), getExecutorScalingPath(scaling))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+string(secret.Data[common.ExecutorScalingTokenKey]))
+	resp, err := executorScalingClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+	return nil
+}
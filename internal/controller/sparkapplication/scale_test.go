@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestUpdateExecutorScaleStatus(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID: "test-submission-id",
+			ExecutorState: map[string]v1beta2.ExecutorState{
+				"test-app-exec-1": v1beta2.ExecutorStateRunning,
+				"test-app-exec-2": v1beta2.ExecutorStatePending,
+				"test-app-exec-3": v1beta2.ExecutorStateFailed,
+				"test-app-exec-4": v1beta2.ExecutorStateCompleted,
+			},
+		},
+	}
+
+	updateExecutorScaleStatus(app)
+	assert.Equal(t, int32(2), app.Status.ExecutorReplicas)
+	assert.Equal(t,
+		"spark-role=executor,sparkoperator.k8s.io/app-name=test-app,sparkoperator.k8s.io/submission-id=test-submission-id",
+		app.Status.ExecutorSelector,
+	)
+}
+
+func TestOnlyExecutorStateChangedIgnoresExecutorReplicas(t *testing.T) {
+	old := &v1beta2.SparkApplication{}
+	app := old.DeepCopy()
+	app.Status.ExecutorState = map[string]v1beta2.ExecutorState{"test-app-exec-1": v1beta2.ExecutorStateRunning}
+	app.Status.ExecutorReplicas = 1
+	assert.True(t, onlyExecutorStateChanged(old, app))
+
+	app.Status.ExecutorSelector = "spark-role=executor"
+	assert.False(t, onlyExecutorStateChanged(old, app))
+}
+
+func TestConfigExecutorInPlaceScaling(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).Build()
+	reconciler := &Reconciler{client: client}
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConf: map[string]string{common.SparkPlugins: "org.example.OtherPlugin"},
+			Executor: v1beta2.ExecutorSpec{
+				InPlaceScaling: &v1beta2.ExecutorInPlaceScaling{PluginClass: "org.example.ScalingPlugin"},
+			},
+		},
+	}
+	require.NoError(t, reconciler.configExecutorInPlaceScaling(ctx, app))
+	assert.Equal(t, map[string]string{
+		common.SparkPlugins:                     "org.example.OtherPlugin,org.example.ScalingPlugin",
+		common.SparkExecutorScalingPath:         "/executors/scale",
+		common.SparkExecutorScalingPort:         "4045",
+		common.SparkExecutorScalingMaxExecutors: "100",
+	}, app.Spec.SparkConf)
+	assert.Equal(t, map[string]v1beta2.NameKey{
+		common.EnvExecutorScalingToken: {Name: "test-app-executor-scaling", Key: common.ExecutorScalingTokenKey},
+	}, app.Spec.Driver.EnvSecretKeyRefs)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-app-executor-scaling"}, secret))
+	token := string(secret.Data[common.ExecutorScalingTokenKey])
+	assert.Len(t, token, 64)
+
+	// Every submission gets a new token.
+	app.Spec.Executor.InPlaceScaling.Path = ptr.To("/scale")
+	app.Spec.Executor.InPlaceScaling.Port = ptr.To(int32(8090))
+	app.Spec.Executor.InPlaceScaling.MaxInstances = ptr.To(int32(10))
+	require.NoError(t, reconciler.configExecutorInPlaceScaling(ctx, app))
+	assert.Equal(t, map[string]string{
+		common.SparkPlugins:                     "org.example.OtherPlugin,org.example.ScalingPlugin",
+		common.SparkExecutorScalingPath:         "/scale",
+		common.SparkExecutorScalingPort:         "8090",
+		common.SparkExecutorScalingMaxExecutors: "10",
+	}, app.Spec.SparkConf)
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-app-executor-scaling"}, secret))
+	assert.NotEqual(t, token, string(secret.Data[common.ExecutorScalingTokenKey]))
+}
+
+func TestScaleExecutorsInPlace(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	const token = "scaling-token"
+	var requested []int32
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer "+token, r.Header.Get("Authorization"))
+		assert.Equal(t, common.DefaultExecutorInPlaceScalingPath, r.URL.Path)
+		var body map[string]int32
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requested = append(requested, body["totalExecutors"])
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIP: "127.0.0.1"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app-executor-scaling", Namespace: "default"},
+		Data:       map[string][]byte{common.ExecutorScalingTokenKey: []byte(token)},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(driverPod, secret).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Executor: v1beta2.ExecutorSpec{
+				Instances:      ptr.To(int32(4)),
+				InPlaceScaling: &v1beta2.ExecutorInPlaceScaling{PluginClass: "org.example.ScalingPlugin", Port: ptr.To(int32(port))},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			DriverInfo:        v1beta2.DriverInfo{PodName: "test-app-driver"},
+			ExecutorInstances: 2,
+		},
+	}
+
+	// Failed requests are retried.
+	status = http.StatusServiceUnavailable
+	assert.Equal(t, executorScalingRetryInterval, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Equal(t, int32(2), app.Status.ExecutorInstances)
+
+	status = http.StatusOK
+	assert.Zero(t, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Equal(t, int32(4), app.Status.ExecutorInstances)
+	assert.Equal(t, []int32{4, 4}, requested)
+	assert.Len(t, recorder.Events, 1)
+
+	// The driver is not called again once it runs the number of executors of the spec.
+	assert.Zero(t, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Len(t, requested, 2)
+
+	// The driver is not asked for more executors than the maximum.
+	app.Spec.Executor.Instances = ptr.To(int32(8))
+	app.Spec.Executor.InPlaceScaling.MaxInstances = ptr.To(int32(6))
+	assert.Zero(t, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Equal(t, int32(6), app.Status.ExecutorInstances)
+	assert.Equal(t, []int32{4, 4, 6}, requested)
+	assert.Zero(t, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Len(t, requested, 3)
+
+	// The driver is not called unless in-place scaling is enabled.
+	app.Spec.Executor.Instances = ptr.To(int32(2))
+	app.Spec.Executor.InPlaceScaling = nil
+	assert.Zero(t, reconciler.scaleExecutorsInPlace(ctx, app))
+	assert.Len(t, requested, 3)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// defaultSchedulingProfilePendingTimeout is how long executors may stay unschedulable with a scheduling profile
+// that does not set its own pending timeout.
+const defaultSchedulingProfilePendingTimeout = 5 * time.Minute
+
+// applySchedulingProfile records the scheduling profile the application is submitted with and adds it to the
+// executor spec used for the submission.
+func applySchedulingProfile(app *v1beta2.SparkApplication) {
+	profile := util.GetSchedulingProfile(app)
+	if profile == nil {
+		app.Status.SchedulingProfile = ""
+		return
+	}
+	app.Status.SchedulingProfile = profile.Name
+	util.ApplySchedulingProfile(app)
+}
+
+// getNextSchedulingProfile returns the scheduling profile following the current one, or nil if it is the last one.
+func getNextSchedulingProfile(app *v1beta2.SparkApplication) *v1beta2.SchedulingProfile {
+	current := util.GetSchedulingProfile(app)
+	if current == nil {
+		return nil
+	}
+	for i := range app.Spec.SchedulingProfiles {
+		if app.Spec.SchedulingProfiles[i].Name == current.Name && i+1 < len(app.Spec.SchedulingProfiles) {
+			return &app.Spec.SchedulingProfiles[i+1]
+		}
+	}
+	return nil
+}
+
+// fallBackSchedulingProfile moves the given SparkApplication to the INVALIDATING state to be re-submitted with the
+// next scheduling profile if any of its executors has been unschedulable for longer than the pending timeout of the
+// current one. Otherwise, it returns how long to wait before checking the executors again.
+func (r *Reconciler) fallBackSchedulingProfile(ctx context.Context, app *v1beta2.SparkApplication) (bool, time.Duration, error) {
+	next := getNextSchedulingProfile(app)
+	if next == nil {
+		return false, 0, nil
+	}
+	current := util.GetSchedulingProfile(app)
+	timeout := defaultSchedulingProfilePendingTimeout
+	if current.PendingTimeoutSeconds != nil {
+		timeout = time.Duration(*current.PendingTimeoutSeconds) * time.Second
+	}
+
+	pods, err := r.getExecutorPods(ctx, app)
+	if err != nil {
+		return false, 0, err
+	}
+
+	var requeueAfter time.Duration
+	for _, pod := range pods.Items {
+		since, unschedulable := getUnschedulableSince(&pod)
+		if !unschedulable {
+			continue
+		}
+		if remaining := timeout - time.Since(since); remaining > 0 {
+			if requeueAfter == 0 || remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+			continue
+		}
+
+		log.FromContext(ctx).Info("Re-submitting SparkApplication with the next scheduling profile as an executor is unschedulable",
+			"pod", pod.Name, "profile", current.Name, "nextProfile", next.Name)
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeWarning,
+			common.EventSparkApplicationSchedulingProfileFallback,
+			"Executor pod %s is unschedulable with scheduling profile %s, SparkApplication %s is re-submitted with scheduling profile %s",
+			pod.Name,
+			current.Name,
+			app.Name,
+			next.Name,
+		)
+		app.Status.SchedulingProfile = next.Name
+		app.Status.AppState.State = v1beta2.ApplicationStateInvalidating
+		return true, 0, nil
+	}
+	return false, requeueAfter, nil
+}
+
+// getUnschedulableSince returns since when the given pod could not be scheduled, if it is pending for that reason.
+func getUnschedulableSince(pod *corev1.Pod) (time.Time, bool) {
+	if pod.Status.Phase != corev1.PodPending {
+		return time.Time{}, false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != corev1.PodScheduled || condition.Status != corev1.ConditionFalse {
+			continue
+		}
+		if condition.LastTransitionTime.IsZero() {
+			return pod.CreationTimestamp.Time, true
+		}
+		return condition.LastTransitionTime.Time, true
+	}
+	return time.Time{}, false
+}
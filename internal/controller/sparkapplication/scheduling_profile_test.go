@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newSchedulingProfileApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SchedulingProfiles: []v1beta2.SchedulingProfile{
+				{
+					Name:                  "spot",
+					NodeSelector:          map[string]string{"node.kubernetes.io/lifecycle": "spot"},
+					Tolerations:           []corev1.Toleration{{Key: "spot", Operator: corev1.TolerationOpExists}},
+					PendingTimeoutSeconds: ptr.To[int64](600),
+				},
+				{
+					Name:         "on-demand",
+					NodeSelector: map[string]string{"node.kubernetes.io/lifecycle": "on-demand"},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					NodeSelector: map[string]string{"team": "data"},
+				},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+		},
+	}
+}
+
+func TestApplySchedulingProfile(t *testing.T) {
+	app := newSchedulingProfileApp()
+	applySchedulingProfile(app)
+	assert.Equal(t, "spot", app.Status.SchedulingProfile)
+	assert.Equal(t, map[string]string{"team": "data", "node.kubernetes.io/lifecycle": "spot"}, app.Spec.Executor.NodeSelector)
+	assert.Len(t, app.Spec.Executor.Tolerations, 1)
+
+	app = newSchedulingProfileApp()
+	app.Status.SchedulingProfile = "on-demand"
+	applySchedulingProfile(app)
+	assert.Equal(t, "on-demand", app.Status.SchedulingProfile)
+	assert.Equal(t, map[string]string{"team": "data", "node.kubernetes.io/lifecycle": "on-demand"}, app.Spec.Executor.NodeSelector)
+	assert.Empty(t, app.Spec.Executor.Tolerations)
+
+	app = newSchedulingProfileApp()
+	app.Spec.SchedulingProfiles = nil
+	app.Status.SchedulingProfile = "spot"
+	applySchedulingProfile(app)
+	assert.Empty(t, app.Status.SchedulingProfile)
+}
+
+func TestFallBackSchedulingProfile(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	newExecutorPod := func(unschedulableFor time.Duration) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-app-exec-1",
+				Namespace: "default",
+				Labels: map[string]string{
+					common.LabelSparkAppName: "test-app",
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodPending,
+				Conditions: []corev1.PodCondition{{
+					Type:               corev1.PodScheduled,
+					Status:             corev1.ConditionFalse,
+					Reason:             corev1.PodReasonUnschedulable,
+					LastTransitionTime: metav1.NewTime(time.Now().Add(-unschedulableFor)),
+				}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		profile         string
+		executorPod     *corev1.Pod
+		wantRestarting  bool
+		wantRequeue     bool
+		wantNextProfile string
+	}{
+		{
+			name:        "executor unschedulable within timeout",
+			executorPod: newExecutorPod(time.Minute),
+			wantRequeue: true,
+		},
+		{
+			name:            "executor unschedulable past timeout",
+			executorPod:     newExecutorPod(time.Hour),
+			wantRestarting:  true,
+			wantNextProfile: "on-demand",
+		},
+		{
+			name:        "last profile",
+			profile:     "on-demand",
+			executorPod: newExecutorPod(time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(10)
+			reconciler := &Reconciler{
+				client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.executorPod).Build(),
+				recorder: recorder,
+			}
+			app := newSchedulingProfileApp()
+			app.Status.SchedulingProfile = tc.profile
+
+			restarting, requeueAfter, err := reconciler.fallBackSchedulingProfile(ctx, app)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRestarting, restarting)
+			if tc.wantRequeue {
+				assert.InDelta(t, 9*time.Minute, requeueAfter, float64(time.Second))
+			} else {
+				assert.Zero(t, requeueAfter)
+			}
+			if !tc.wantRestarting {
+				assert.Equal(t, v1beta2.ApplicationStateRunning, app.Status.AppState.State)
+				assert.Equal(t, tc.profile, app.Status.SchedulingProfile)
+				return
+			}
+			assert.Equal(t, v1beta2.ApplicationStateInvalidating, app.Status.AppState.State)
+			assert.Equal(t, tc.wantNextProfile, app.Status.SchedulingProfile)
+			assert.Contains(t, <-recorder.Events, "is re-submitted with scheduling profile on-demand")
+		})
+	}
+}
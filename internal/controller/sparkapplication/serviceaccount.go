@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// createServiceAccount creates or updates the service account, role and role binding of the given SparkApplication
+// if its driver or executors use the `auto` service account. They are owned by the application, and thus deleted
+// along with it. Existing ones with the same names that are not owned by the application, e.g. the service account
+// shared by the applications of the namespace, are left unchanged and fail the submission.
+func (r *Reconciler) createServiceAccount(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if !util.UseAutoServiceAccount(app) {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	name := util.GetAutoServiceAccountName(app)
+	objectMeta := metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       app.Namespace,
+		Labels:          util.GetResourceLabels(app),
+		OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+	}
+	key := types.NamespacedName{Name: name, Namespace: app.Namespace}
+
+	annotations := getAutoServiceAccountAnnotations(app)
+	serviceAccount := &corev1.ServiceAccount{}
+	if err := r.client.Get(ctx, key, serviceAccount); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		serviceAccount = &corev1.ServiceAccount{ObjectMeta: *objectMeta.DeepCopy()}
+		if len(annotations) > 0 {
+			serviceAccount.Annotations = annotations
+		}
+		if err := r.client.Create(ctx, serviceAccount); err != nil {
+			return fmt.Errorf("failed to create service account %s: %v", name, err)
+		}
+		logger.Info("Created service account for SparkApplication", "name", name)
+	} else if !metav1.IsControlledBy(serviceAccount, app) {
+		return r.reportNotOwned(app, serviceAccount)
+	} else if updateAutoServiceAccountAnnotations(serviceAccount, annotations) {
+		if err := r.client.Update(ctx, serviceAccount); err != nil {
+			return fmt.Errorf("failed to update service account %s: %v", name, err)
+		}
+		logger.Info("Updated service account for SparkApplication", "name", name)
+	}
+
+	role := &rbacv1.Role{}
+	if err := r.client.Get(ctx, key, role); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(ctx, &rbacv1.Role{ObjectMeta: objectMeta, Rules: util.GetDriverPolicyRules()}); err != nil {
+			return fmt.Errorf("failed to create role %s: %v", name, err)
+		}
+		logger.Info("Created role for SparkApplication", "name", name)
+	} else if !metav1.IsControlledBy(role, app) {
+		return r.reportNotOwned(app, role)
+	} else if !equality.Semantic.DeepEqual(role.Rules, util.GetDriverPolicyRules()) {
+		role.Rules = util.GetDriverPolicyRules()
+		if err := r.client.Update(ctx, role); err != nil {
+			return fmt.Errorf("failed to update role %s: %v", name, err)
+		}
+		logger.Info("Updated role for SparkApplication", "name", name)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{}
+	if err := r.client.Get(ctx, key, roleBinding); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(ctx, &rbacv1.RoleBinding{
+			ObjectMeta: objectMeta,
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      name,
+				Namespace: app.Namespace,
+			}},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     name,
+			},
+		}); err != nil {
+			return fmt.Errorf("failed to create role binding %s: %v", name, err)
+		}
+		logger.Info("Created role binding for SparkApplication", "name", name)
+	} else if !metav1.IsControlledBy(roleBinding, app) {
+		return r.reportNotOwned(app, roleBinding)
+	}
+
+	return nil
+}
+
+// reportNotOwned reports that the given existing object is not owned by the given SparkApplication and is left
+// unchanged, and returns the error failing its submission.
+func (r *Reconciler) reportNotOwned(app *v1beta2.SparkApplication, obj client.Object) error {
+	kind := fmt.Sprintf("%T", obj)
+	if gvk, err := apiutil.GVKForObject(obj, r.client.Scheme()); err == nil {
+		kind = gvk.Kind
+	}
+	r.recorder.Eventf(obj, corev1.EventTypeWarning, common.EventSparkApplicationResourceNotManaged,
+		"%s %s/%s is not owned by SparkApplication %s and is not managed by it", kind, obj.GetNamespace(), obj.GetName(), app.Name)
+	return fmt.Errorf("%s %s/%s already exists and is not owned by the SparkApplication", kind, obj.GetNamespace(), obj.GetName())
+}
+
+// autoServiceAccountAnnotations are the annotations of the service account provisioned for a SparkApplication
+// managed by the operator.
+var autoServiceAccountAnnotations = []string{
+	common.AnnotationEKSRoleARN,
+	common.AnnotationAzureWorkloadIdentityClientID,
+	common.AnnotationAzureWorkloadIdentityTenantID,
+}
+
+// getAutoServiceAccountAnnotations returns the annotations binding the service account provisioned for the given
+// SparkApplication to the cloud identities of its driver and executors.
+func getAutoServiceAccountAnnotations(app *v1beta2.SparkApplication) map[string]string {
+	annotations := make(map[string]string)
+	if roleARN := getAutoServiceAccountRoleARN(app); roleARN != "" {
+		annotations[common.AnnotationEKSRoleARN] = roleARN
+	}
+	if identity := util.GetAzureWorkloadIdentity(app); identity != nil {
+		annotations[common.AnnotationAzureWorkloadIdentityClientID] = identity.ClientID
+		annotations[common.AnnotationAzureWorkloadIdentityTenantID] = identity.TenantID
+	}
+	return annotations
+}
+
+// updateAutoServiceAccountAnnotations sets the annotations managed by the operator on the given service account to
+// the given ones, and returns whether it changed.
+func updateAutoServiceAccountAnnotations(serviceAccount *corev1.ServiceAccount, annotations map[string]string) bool {
+	updated := false
+	for _, key := range autoServiceAccountAnnotations {
+		value, ok := annotations[key]
+		if serviceAccount.Annotations[key] == value {
+			continue
+		}
+		if !ok {
+			delete(serviceAccount.Annotations, key)
+		} else {
+			if serviceAccount.Annotations == nil {
+				serviceAccount.Annotations = make(map[string]string)
+			}
+			serviceAccount.Annotations[key] = value
+		}
+		updated = true
+	}
+	return updated
+}
+
+// getAutoServiceAccountRoleARN returns the AWS IAM role assumed by the driver or executors running with the
+// service account provisioned for the given SparkApplication, which the service account is annotated with for the
+// EKS Pod Identity Webhook. The validating webhook ensures they assume the same role.
+func getAutoServiceAccountRoleARN(app *v1beta2.SparkApplication) string {
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		if ptr.Deref(spec.ServiceAccount, "") != common.ServiceAccountAuto {
+			continue
+		}
+		if roleARN := util.GetAWSRoleARN(app, spec); roleARN != "" {
+			return roleARN
+		}
+	}
+	return ""
+}
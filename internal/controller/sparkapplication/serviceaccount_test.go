@@ -0,0 +1,190 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func TestCreateServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, rbacv1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "default",
+			UID:       "test-uid",
+		},
+	}
+	key := types.NamespacedName{Name: "test-app-spark", Namespace: "default"}
+
+	t.Run("service account not requested", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+
+		withServiceAccount := app.DeepCopy()
+		withServiceAccount.Spec.Driver.ServiceAccount = ptr.To("spark")
+		require.NoError(t, reconciler.createServiceAccount(ctx, withServiceAccount))
+
+		err := client.Get(ctx, key, &corev1.ServiceAccount{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("provision service account", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			// A role whose rules drifted is reconciled.
+			&rbacv1.Role{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test-app-spark",
+					Namespace:       "default",
+					OwnerReferences: []metav1.OwnerReference{util.GetOwnerReference(app)},
+				},
+				Rules: []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get"}}},
+			},
+		).Build()
+		reconciler := &Reconciler{client: client}
+
+		withAuto := app.DeepCopy()
+		withAuto.Spec.Driver.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+		require.NoError(t, reconciler.createServiceAccount(ctx, withAuto))
+		// Provisioning is idempotent.
+		require.NoError(t, reconciler.createServiceAccount(ctx, withAuto))
+
+		serviceAccount := &corev1.ServiceAccount{}
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		require.Len(t, serviceAccount.OwnerReferences, 1)
+		assert.Equal(t, app.UID, serviceAccount.OwnerReferences[0].UID)
+		assert.Equal(t, "test-app", serviceAccount.Labels[common.LabelSparkAppName])
+
+		role := &rbacv1.Role{}
+		require.NoError(t, client.Get(ctx, key, role))
+		assert.Equal(t, util.GetDriverPolicyRules(), role.Rules)
+
+		roleBinding := &rbacv1.RoleBinding{}
+		require.NoError(t, client.Get(ctx, key, roleBinding))
+		assert.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Name: "test-app-spark", Namespace: "default"}}, roleBinding.Subjects)
+		assert.Equal(t, rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: "test-app-spark"}, roleBinding.RoleRef)
+		require.Len(t, roleBinding.OwnerReferences, 1)
+		assert.Equal(t, app.UID, roleBinding.OwnerReferences[0].UID)
+	})
+	t.Run("service account and role not owned by the application", func(t *testing.T) {
+		sharedRules := []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"*"}}}
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "test-app-spark", Namespace: "default"}},
+			&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: "test-app-spark", Namespace: "default"}, Rules: sharedRules},
+		).Build()
+		recorder := record.NewFakeRecorder(10)
+		reconciler := &Reconciler{client: client, recorder: recorder}
+
+		withRole := app.DeepCopy()
+		withRole.Spec.Driver.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+		withRole.Spec.Driver.IAMRoleARN = ptr.To("arn:aws:iam::123456789012:role/driver")
+		err := reconciler.createServiceAccount(ctx, withRole)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "ServiceAccount default/test-app-spark already exists")
+		assert.Contains(t, <-recorder.Events, common.EventSparkApplicationResourceNotManaged)
+
+		serviceAccount := &corev1.ServiceAccount{}
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		assert.Empty(t, serviceAccount.Annotations)
+		assert.Empty(t, serviceAccount.OwnerReferences)
+
+		// The role is left unchanged as well once the service account is owned by the application.
+		serviceAccount.OwnerReferences = []metav1.OwnerReference{util.GetOwnerReference(app)}
+		require.NoError(t, client.Update(ctx, serviceAccount))
+		err = reconciler.createServiceAccount(ctx, withRole)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Role default/test-app-spark already exists")
+
+		role := &rbacv1.Role{}
+		require.NoError(t, client.Get(ctx, key, role))
+		assert.Equal(t, sharedRules, role.Rules)
+		err = client.Get(ctx, key, &rbacv1.RoleBinding{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+	t.Run("annotate service account with IAM role", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+
+		withRole := app.DeepCopy()
+		withRole.Spec.Driver.ServiceAccount = ptr.To("spark")
+		withRole.Spec.Driver.IAMRoleARN = ptr.To("arn:aws:iam::123456789012:role/driver")
+		withRole.Spec.Executor.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+		withRole.Spec.Executor.IAMRoleARN = ptr.To("arn:aws:iam::123456789012:role/executor")
+		require.NoError(t, reconciler.createServiceAccount(ctx, withRole))
+
+		serviceAccount := &corev1.ServiceAccount{}
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		assert.Equal(t, map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/executor"}, serviceAccount.Annotations)
+
+		// The annotation is removed along with the role.
+		withRole.Spec.Executor.IAMRoleARN = nil
+		require.NoError(t, reconciler.createServiceAccount(ctx, withRole))
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		assert.Empty(t, serviceAccount.Annotations)
+	})
+	t.Run("annotate service account with Azure workload identity", func(t *testing.T) {
+		client := fake.NewClientBuilder().WithScheme(scheme).Build()
+		reconciler := &Reconciler{client: client}
+
+		withIdentity := app.DeepCopy()
+		withIdentity.Spec.Driver.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+		withIdentity.Spec.Azure = &v1beta2.AzureSpec{
+			WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{
+				ClientID: "00000000-0000-0000-0000-000000000001",
+				TenantID: "00000000-0000-0000-0000-000000000002",
+			},
+		}
+		require.NoError(t, reconciler.createServiceAccount(ctx, withIdentity))
+
+		serviceAccount := &corev1.ServiceAccount{}
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		assert.Equal(t, map[string]string{
+			"azure.workload.identity/client-id": "00000000-0000-0000-0000-000000000001",
+			"azure.workload.identity/tenant-id": "00000000-0000-0000-0000-000000000002",
+		}, serviceAccount.Annotations)
+
+		// Annotations not managed by the operator are kept.
+		serviceAccount.Annotations["example.com/owner"] = "data"
+		require.NoError(t, client.Update(ctx, serviceAccount))
+		withIdentity.Spec.Azure = nil
+		require.NoError(t, reconciler.createServiceAccount(ctx, withIdentity))
+		require.NoError(t, client.Get(ctx, key, serviceAccount))
+		assert.Equal(t, map[string]string{"example.com/owner": "data"}, serviceAccount.Annotations)
+	})
+}
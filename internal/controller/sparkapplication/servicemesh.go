@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// sidecarShutdownTimeout bounds the shutdown of a service mesh sidecar.
+const sidecarShutdownTimeout = 10 * time.Second
+
+// istioSidecarShutdownCommand makes the Istio agent shut the sidecar down. The agent only accepts shutdown requests
+// from the pod itself, so the request is sent by the agent inside the sidecar container.
+var istioSidecarShutdownCommand = []string{"pilot-agent", "request", "POST", "quitquitquit"}
+
+// ValidateServiceMeshMode returns an error if the given service mesh mode is not supported.
+func ValidateServiceMeshMode(mode string) error {
+	switch mode {
+	case "", common.ServiceMeshModeIstio:
+		return nil
+	}
+	return fmt.Errorf("unknown service mesh mode %q, must be empty or %s", mode, common.ServiceMeshModeIstio)
+}
+
+// configServiceMesh sets the Spark ports and pod annotations making the given SparkApplication run with the
+// sidecars injected by the configured service mesh.
+func (r *Reconciler) configServiceMesh(_ context.Context, app *v1beta2.SparkApplication) {
+	if r.options.ServiceMeshMode != common.ServiceMeshModeIstio {
+		return
+	}
+
+	// Executors use a random block manager port unless one is set, which could not be excluded from interception.
+	if app.Spec.SparkConf == nil {
+		app.Spec.SparkConf = make(map[string]string)
+	}
+	util.SetIfNotExists(app.Spec.SparkConf, common.SparkDriverPort, strconv.Itoa(common.DefaultSparkDriverPort))
+	util.SetIfNotExists(app.Spec.SparkConf, common.SparkBlockManagerPort, strconv.Itoa(common.DefaultSparkBlockManagerPort))
+	ports := app.Spec.SparkConf[common.SparkDriverPort] + "," + app.Spec.SparkConf[common.SparkBlockManagerPort]
+
+	annotations := map[string]string{
+		common.IstioAnnotationExcludeInboundPorts:  ports,
+		common.IstioAnnotationExcludeOutboundPorts: ports,
+		common.IstioAnnotationProxyConfig:          common.IstioProxyConfigHoldApplication,
+		common.IstioAnnotationNativeSidecar:        "true",
+	}
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		if spec.Annotations == nil {
+			spec.Annotations = make(map[string]string)
+		}
+		for key, value := range annotations {
+			util.SetIfNotExists(spec.Annotations, key, value)
+		}
+	}
+}
+
+// stopServiceMeshSidecar shuts down the Istio sidecar still running in the given Spark pod after its Spark
+// container terminated, which would otherwise keep the pod running. Sidecars injected as native sidecars
+// terminate on their own and are left untouched, so this only applies to clusters or pods not using them.
+func (r *Reconciler) stopServiceMeshSidecar(ctx context.Context, pod *corev1.Pod) {
+	if r.options.ServiceMeshMode != common.ServiceMeshModeIstio || r.options.PodExecutor == nil || pod.Status.Phase != corev1.PodRunning {
+		return
+	}
+	if !isContainerRunning(pod, common.IstioProxyContainerName) {
+		return
+	}
+
+	logger := log.FromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, sidecarShutdownTimeout)
+	defer cancel()
+	if err := r.options.PodExecutor.Exec(ctx, pod, common.IstioProxyContainerName, istioSidecarShutdownCommand, io.Discard); err != nil {
+		logger.Info("Failed to shut down service mesh sidecar", "pod", pod.Name, "error", err.Error())
+		return
+	}
+	logger.Info("Shut down service mesh sidecar", "pod", pod.Name)
+}
+
+// isContainerRunning returns whether the container with the given name of the given pod is running.
+func isContainerRunning(pod *corev1.Pod, name string) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == name {
+			return status.State.Running != nil
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestValidateServiceMeshMode(t *testing.T) {
+	assert.NoError(t, ValidateServiceMeshMode(""))
+	assert.NoError(t, ValidateServiceMeshMode(common.ServiceMeshModeIstio))
+	assert.EqualError(t, ValidateServiceMeshMode("linkerd"), `unknown service mesh mode "linkerd", must be empty or istio`)
+}
+
+func TestConfigServiceMesh(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConf: map[string]string{common.SparkDriverPort: "7000"},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Annotations: map[string]string{common.IstioAnnotationNativeSidecar: "false"},
+				},
+			},
+		},
+	}
+
+	reconciler := &Reconciler{}
+	disabled := app.DeepCopy()
+	reconciler.configServiceMesh(context.Background(), disabled)
+	assert.Equal(t, app, disabled)
+
+	reconciler.options.ServiceMeshMode = common.ServiceMeshModeIstio
+	reconciler.configServiceMesh(context.Background(), app)
+
+	assert.Equal(t, "7000", app.Spec.SparkConf[common.SparkDriverPort])
+	assert.Equal(t, "7079", app.Spec.SparkConf[common.SparkBlockManagerPort])
+	assert.Equal(t, map[string]string{
+		common.IstioAnnotationExcludeInboundPorts:  "7000,7079",
+		common.IstioAnnotationExcludeOutboundPorts: "7000,7079",
+		common.IstioAnnotationProxyConfig:          common.IstioProxyConfigHoldApplication,
+		common.IstioAnnotationNativeSidecar:        "true",
+	}, app.Spec.Driver.Annotations)
+	// Annotations set by the user take precedence.
+	assert.Equal(t, "false", app.Spec.Executor.Annotations[common.IstioAnnotationNativeSidecar])
+	assert.Equal(t, "7000,7079", app.Spec.Executor.Annotations[common.IstioAnnotationExcludeOutboundPorts])
+}
+
+func TestStopServiceMeshSidecar(t *testing.T) {
+	newPod := func(sidecarState corev1.ContainerState) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				PodIP: "10.0.0.1",
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name:  common.SparkDriverContainerName,
+						State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}},
+					},
+					{
+						Name:  common.IstioProxyContainerName,
+						State: sidecarState,
+					},
+				},
+			},
+		}
+	}
+	running := corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}
+
+	executor := &fakePodExecutor{}
+	reconciler := &Reconciler{}
+	reconciler.options.PodExecutor = executor
+	reconciler.stopServiceMeshSidecar(context.Background(), newPod(running))
+	assert.Empty(t, executor.commands)
+
+	reconciler.options.ServiceMeshMode = common.ServiceMeshModeIstio
+	reconciler.stopServiceMeshSidecar(context.Background(), newPod(corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{}}))
+	assert.Empty(t, executor.commands)
+
+	// Native sidecars are reported as init containers and terminate on their own.
+	native := newPod(corev1.ContainerState{})
+	native.Status.ContainerStatuses = native.Status.ContainerStatuses[:1]
+	native.Status.InitContainerStatuses = []corev1.ContainerStatus{{Name: common.IstioProxyContainerName, State: running}}
+	reconciler.stopServiceMeshSidecar(context.Background(), native)
+	assert.Empty(t, executor.commands)
+
+	// The Istio agent rejects shutdown requests from outside the pod, so it is asked to shut down from the sidecar.
+	reconciler.stopServiceMeshSidecar(context.Background(), newPod(running))
+	assert.Equal(t, []string{"test-app-driver/istio-proxy: pilot-agent request POST quitquitquit"}, executor.commands)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// sparkConfigMapField is the field index used to look up SparkApplications by their SparkConfigMap.
+const sparkConfigMapField = "spec.sparkConfigMap"
+
+func indexSparkApplicationBySparkConfigMap(obj client.Object) []string {
+	app, ok := obj.(*v1beta2.SparkApplication)
+	if !ok || !sparkConfigMapReloadEnabled(app) {
+		return nil
+	}
+	return []string{*app.Spec.SparkConfigMap}
+}
+
+// mapSparkConfigMapToSparkApplications enqueues the SparkApplications reloading the given ConfigMap upon changes.
+func (r *Reconciler) mapSparkConfigMapToSparkApplications(ctx context.Context, obj client.Object) []reconcile.Request {
+	apps := &v1beta2.SparkApplicationList{}
+	if err := r.client.List(ctx, apps, client.InNamespace(obj.GetNamespace()), client.MatchingFields{sparkConfigMapField: obj.GetName()}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list SparkApplications referencing ConfigMap", "configMap", obj.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(apps.Items))
+	for _, app := range apps.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: app.Namespace, Name: app.Name}})
+	}
+	return requests
+}
+
+// sparkConfigMapReloadEnabled returns whether changes to the SparkConfigMap of the given SparkApplication are
+// carried out on its running driver.
+func sparkConfigMapReloadEnabled(app *v1beta2.SparkApplication) bool {
+	if app.Spec.SparkConfigMap == nil || *app.Spec.SparkConfigMap == "" {
+		return false
+	}
+	switch app.Spec.SparkConfigMapReloadPolicy {
+	case v1beta2.SparkConfigMapReloadPolicyRestart, v1beta2.SparkConfigMapReloadPolicySignal:
+		return true
+	}
+	return false
+}
+
+// getSparkConfigMapHash returns the hash of the data of the SparkConfigMap of the given SparkApplication,
+// or an empty string if the ConfigMap does not exist.
+func (r *Reconciler) getSparkConfigMapHash(ctx context.Context, app *v1beta2.SparkApplication) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: *app.Spec.SparkConfigMap}, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	// Maps are marshaled with sorted keys, which makes the hash independent of the order of the entries.
+	data, err := json.Marshal([]any{configMap.Data, configMap.BinaryData})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// recordSparkConfigMapHash records the hash of the SparkConfigMap a SparkApplication is submitted with.
+func (r *Reconciler) recordSparkConfigMapHash(ctx context.Context, app *v1beta2.SparkApplication) {
+	app.Status.SparkConfigMapHash = ""
+	if !sparkConfigMapReloadEnabled(app) {
+		return
+	}
+	hash, err := r.getSparkConfigMapHash(ctx, app)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to hash SparkConfigMap", "configMap", *app.Spec.SparkConfigMap)
+		return
+	}
+	app.Status.SparkConfigMapHash = hash
+}
+
+// reloadSparkConfigMap carries out a change of the SparkConfigMap of a running SparkApplication according to its
+// reload policy. It returns whether the application was moved to the INVALIDATING state to be re-submitted.
+func (r *Reconciler) reloadSparkConfigMap(ctx context.Context, app *v1beta2.SparkApplication) (bool, error) {
+	if !sparkConfigMapReloadEnabled(app) {
+		return false, nil
+	}
+
+	hash, err := r.getSparkConfigMapHash(ctx, app)
+	if err != nil {
+		return false, fmt.Errorf("failed to hash SparkConfigMap: %v", err)
+	}
+	if hash == "" || hash == app.Status.SparkConfigMapHash {
+		return false, nil
+	}
+	// Applications submitted before the ConfigMap was created, or before the policy was set, adopt its current data.
+	if app.Status.SparkConfigMapHash == "" {
+		app.Status.SparkConfigMapHash = hash
+		return false, nil
+	}
+
+	logger := log.FromContext(ctx)
+	configMapName := *app.Spec.SparkConfigMap
+	switch app.Spec.SparkConfigMapReloadPolicy {
+	case v1beta2.SparkConfigMapReloadPolicyRestart:
+		logger.Info("Restarting SparkApplication as its SparkConfigMap changed", "configMap", configMapName)
+		app.Status.AppState.State = v1beta2.ApplicationStateInvalidating
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeNormal,
+			common.EventSparkApplicationSparkConfigMapReloaded,
+			"SparkApplication %s is restarted as its SparkConfigMap %s changed",
+			app.Name,
+			configMapName,
+		)
+		return true, nil
+	case v1beta2.SparkConfigMapReloadPolicySignal:
+		if err := r.signalDriverPod(ctx, app, hash); err != nil {
+			return false, fmt.Errorf("failed to signal driver pod: %v", err)
+		}
+		logger.Info("Signaled driver pod as the SparkConfigMap changed", "configMap", configMapName, "hash", hash)
+		r.recorder.Eventf(
+			app,
+			corev1.EventTypeNormal,
+			common.EventSparkApplicationSparkConfigMapReloaded,
+			"Driver pod of SparkApplication %s is signaled as its SparkConfigMap %s changed",
+			app.Name,
+			configMapName,
+		)
+	}
+	app.Status.SparkConfigMapHash = hash
+	return false, nil
+}
+
+// signalDriverPod sets the hash of the SparkConfigMap in the annotation of the driver pod. The mounted files are
+// updated by the kubelet on their own, so the annotation only tells the driver when to reload them.
+func (r *Reconciler) signalDriverPod(ctx context.Context, app *v1beta2.SparkApplication, hash string) error {
+	pod := &corev1.Pod{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: app.Status.DriverInfo.PodName}, pod); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	pod.Annotations[common.AnnotationSparkConfigMapHash] = hash
+	return r.client.Patch(ctx, pod, patch)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestReloadSparkConfigMap(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-conf", Namespace: "default"},
+		Data:       map[string]string{"spark-defaults.conf": "spark.sql.shuffle.partitions 200"},
+	}
+	driver := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"},
+	}
+	newApp := func(policy v1beta2.SparkConfigMapReloadPolicy) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConfigMap:             ptr.To("spark-conf"),
+				SparkConfigMapReloadPolicy: policy,
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:   v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+				DriverInfo: v1beta2.DriverInfo{PodName: "test-app-driver"},
+			},
+		}
+	}
+	newReconciler := func() (*Reconciler, *record.FakeRecorder) {
+		recorder := record.NewFakeRecorder(3)
+		return &Reconciler{
+			client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(configMap.DeepCopy(), driver.DeepCopy()).Build(),
+			recorder: recorder,
+		}, recorder
+	}
+	changeConfigMap := func(t *testing.T, reconciler *Reconciler) {
+		cm := configMap.DeepCopy()
+		cm.Data["spark-defaults.conf"] = "spark.sql.shuffle.partitions 400"
+		require.NoError(t, reconciler.client.Update(ctx, cm))
+	}
+
+	t.Run("reload disabled", func(t *testing.T) {
+		reconciler, recorder := newReconciler()
+		app := newApp(v1beta2.SparkConfigMapReloadPolicyNone)
+		reconciler.recordSparkConfigMapHash(ctx, app)
+		assert.Empty(t, app.Status.SparkConfigMapHash)
+
+		restarting, err := reconciler.reloadSparkConfigMap(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, restarting)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("unchanged config map", func(t *testing.T) {
+		reconciler, recorder := newReconciler()
+		app := newApp(v1beta2.SparkConfigMapReloadPolicyRestart)
+		reconciler.recordSparkConfigMapHash(ctx, app)
+		assert.Len(t, app.Status.SparkConfigMapHash, 16)
+
+		restarting, err := reconciler.reloadSparkConfigMap(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, restarting)
+		assert.Equal(t, v1beta2.ApplicationStateRunning, app.Status.AppState.State)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("adopt config map of application submitted without hash", func(t *testing.T) {
+		reconciler, recorder := newReconciler()
+		app := newApp(v1beta2.SparkConfigMapReloadPolicyRestart)
+
+		restarting, err := reconciler.reloadSparkConfigMap(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, restarting)
+		assert.NotEmpty(t, app.Status.SparkConfigMapHash)
+		assert.Empty(t, recorder.Events)
+	})
+
+	t.Run("restart upon change", func(t *testing.T) {
+		reconciler, recorder := newReconciler()
+		app := newApp(v1beta2.SparkConfigMapReloadPolicyRestart)
+		reconciler.recordSparkConfigMapHash(ctx, app)
+		changeConfigMap(t, reconciler)
+
+		restarting, err := reconciler.reloadSparkConfigMap(ctx, app)
+		require.NoError(t, err)
+		assert.True(t, restarting)
+		assert.Equal(t, v1beta2.ApplicationStateInvalidating, app.Status.AppState.State)
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, common.EventSparkApplicationSparkConfigMapReloaded)
+	})
+
+	t.Run("signal driver upon change", func(t *testing.T) {
+		reconciler, recorder := newReconciler()
+		app := newApp(v1beta2.SparkConfigMapReloadPolicySignal)
+		reconciler.recordSparkConfigMapHash(ctx, app)
+		oldHash := app.Status.SparkConfigMapHash
+		changeConfigMap(t, reconciler)
+
+		restarting, err := reconciler.reloadSparkConfigMap(ctx, app)
+		require.NoError(t, err)
+		assert.False(t, restarting)
+		assert.Equal(t, v1beta2.ApplicationStateRunning, app.Status.AppState.State)
+		assert.NotEqual(t, oldHash, app.Status.SparkConfigMapHash)
+
+		pod := &corev1.Pod{}
+		require.NoError(t, reconciler.client.Get(ctx, types.NamespacedName{Namespace: "default", Name: "test-app-driver"}, pod))
+		assert.Equal(t, app.Status.SparkConfigMapHash, pod.Annotations[common.AnnotationSparkConfigMapHash])
+		require.Len(t, recorder.Events, 1)
+		assert.Contains(t, <-recorder.Events, common.EventSparkApplicationSparkConfigMapReloaded)
+	})
+}
+
+func TestMapSparkConfigMapToSparkApplications(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	apps := []*v1beta2.SparkApplication{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "restart", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConfigMap:             ptr.To("spark-conf"),
+				SparkConfigMapReloadPolicy: v1beta2.SparkConfigMapReloadPolicyRestart,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-reload", Namespace: "default"},
+			Spec:       v1beta2.SparkApplicationSpec{SparkConfigMap: ptr.To("spark-conf")},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "other"},
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConfigMap:             ptr.To("spark-conf"),
+				SparkConfigMapReloadPolicy: v1beta2.SparkConfigMapReloadPolicySignal,
+			},
+		},
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithIndex(&v1beta2.SparkApplication{}, sparkConfigMapField, indexSparkApplicationBySparkConfigMap)
+	for _, app := range apps {
+		builder = builder.WithObjects(app)
+	}
+	reconciler := &Reconciler{client: builder.Build()}
+
+	requests := reconciler.mapSparkConfigMapToSparkApplications(context.Background(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-conf", Namespace: "default"},
+	})
+	assert.Equal(t, []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: "default", Name: "restart"}}}, requests)
+}
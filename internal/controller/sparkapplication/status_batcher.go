@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// statusBatcher coalesces the executor state changes of running SparkApplications, so that the status is
+// written at most once per interval instead of on every executor pod event. Executor states are derived from
+// the executor pods on every reconcile, so held back changes are simply written by a later reconcile.
+// A nil statusBatcher disables batching.
+type statusBatcher struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	apps map[types.NamespacedName]*batchedStatus
+}
+
+type batchedStatus struct {
+	lastFlush time.Time
+	// executorState holds the executor states whose events have been recorded but that are not written yet.
+	executorState map[string]v1beta2.ExecutorState
+}
+
+// newStatusBatcher creates a new statusBatcher, or returns nil if the interval is not positive.
+func newStatusBatcher(interval time.Duration) *statusBatcher {
+	if interval <= 0 {
+		return nil
+	}
+	return &statusBatcher{
+		interval: interval,
+		apps:     make(map[types.NamespacedName]*batchedStatus),
+	}
+}
+
+// overlay applies the executor states held back for the given application to its status, so that the
+// executor events are not recorded again for changes that have not been written yet.
+func (b *statusBatcher) overlay(key types.NamespacedName, app *v1beta2.SparkApplication) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batched, ok := b.apps[key]
+	if !ok || len(batched.executorState) == 0 {
+		return
+	}
+	if app.Status.ExecutorState == nil {
+		app.Status.ExecutorState = make(map[string]v1beta2.ExecutorState)
+	}
+	maps.Copy(app.Status.ExecutorState, batched.executorState)
+}
+
+// hold holds back the given executor states if the status of the application was written less than an
+// interval ago, and returns how long to wait before writing them. It returns zero if they are due now.
+func (b *statusBatcher) hold(key types.NamespacedName, executorState map[string]v1beta2.ExecutorState) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	batched, ok := b.apps[key]
+	if !ok {
+		return 0
+	}
+	wait := b.interval - time.Since(batched.lastFlush)
+	if wait <= 0 {
+		return 0
+	}
+	batched.executorState = maps.Clone(executorState)
+	return wait
+}
+
+// flushed records that the executor states of the application have just been written.
+func (b *statusBatcher) flushed(key types.NamespacedName) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.apps[key] = &batchedStatus{lastFlush: time.Now()}
+}
+
+// forget drops the batching state of the given application, e.g. after its whole status has been written.
+func (b *statusBatcher) forget(key types.NamespacedName) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.apps, key)
+}
+
+// onlyExecutorStateChanged returns whether the executor states, and the executor replicas and ExecutorsReady
+// condition derived from them, are the only difference between the statuses of the given applications.
+func onlyExecutorStateChanged(old, app *v1beta2.SparkApplication) bool {
+	oldStatus := old.Status.DeepCopy()
+	newStatus := app.Status.DeepCopy()
+	oldStatus.ExecutorState = nil
+	newStatus.ExecutorState = nil
+	oldStatus.ExecutorReplicas = 0
+	newStatus.ExecutorReplicas = 0
+	meta.RemoveStatusCondition(&oldStatus.Conditions, v1beta2.SparkApplicationConditionExecutorsReady)
+	meta.RemoveStatusCondition(&newStatus.Conditions, v1beta2.SparkApplicationConditionExecutorsReady)
+	return equality.Semantic.DeepEqual(oldStatus, newStatus)
+}
+
+// batchExecutorStateUpdate writes the executor states of the application unless they are held back by the
+// status batcher, in which case it returns how long to wait before reconciling the application again.
+func (r *Reconciler) batchExecutorStateUpdate(ctx context.Context, key types.NamespacedName, old, app *v1beta2.SparkApplication) (time.Duration, error) {
+	if equality.Semantic.DeepEqual(old.Status.ExecutorState, app.Status.ExecutorState) {
+		return 0, nil
+	}
+	if wait := r.statusBatcher.hold(key, app.Status.ExecutorState); wait > 0 {
+		return wait, nil
+	}
+	if err := r.applyExecutorState(ctx, app); err != nil {
+		return 0, err
+	}
+	r.statusBatcher.flushed(key)
+	return 0, nil
+}
+
+// applyExecutorState writes the executor states of the application, along with the ExecutorsReady condition,
+// with server-side apply, which neither sends the rest of the status nor conflicts with concurrent writes of
+// other status fields.
+func (r *Reconciler) applyExecutorState(ctx context.Context, app *v1beta2.SparkApplication) error {
+	executorState := make(map[string]interface{}, len(app.Status.ExecutorState))
+	for name, state := range app.Status.ExecutorState {
+		executorState[name] = string(state)
+	}
+
+	patch := &unstructured.Unstructured{}
+	patch.SetGroupVersionKind(v1beta2.SchemeGroupVersion.WithKind("SparkApplication"))
+	patch.SetNamespace(app.Namespace)
+	patch.SetName(app.Name)
+	if err := unstructured.SetNestedField(patch.Object, executorState, "status", "executorState"); err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(patch.Object, int64(app.Status.ExecutorReplicas), "status", "executorReplicas"); err != nil {
+		return err
+	}
+	if condition := meta.FindStatusCondition(app.Status.Conditions, v1beta2.SparkApplicationConditionExecutorsReady); condition != nil {
+		conditionObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(condition)
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedSlice(patch.Object, []interface{}{conditionObject}, "status", "conditions"); err != nil {
+			return err
+		}
+	}
+	return r.client.Status().Patch(ctx, patch, client.Apply, client.FieldOwner(common.StatusFieldManager), client.ForceOwnership)
+}
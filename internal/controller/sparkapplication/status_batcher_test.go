@@ -0,0 +1,205 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func TestNewStatusBatcher(t *testing.T) {
+	assert.Nil(t, newStatusBatcher(0))
+	assert.NotNil(t, newStatusBatcher(2*time.Second))
+
+	// A nil batcher must be usable without batching anything.
+	var batcher *statusBatcher
+	app := &v1beta2.SparkApplication{}
+	batcher.overlay(types.NamespacedName{Name: "test-app"}, app)
+	batcher.forget(types.NamespacedName{Name: "test-app"})
+	assert.Nil(t, app.Status.ExecutorState)
+}
+
+func TestOnlyExecutorStateChanged(t *testing.T) {
+	old := &v1beta2.SparkApplication{
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:      v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			ExecutorState: map[string]v1beta2.ExecutorState{"exec-1": v1beta2.ExecutorStatePending},
+		},
+	}
+
+	app := old.DeepCopy()
+	app.Status.ExecutorState["exec-1"] = v1beta2.ExecutorStateRunning
+	app.Status.ExecutorState["exec-2"] = v1beta2.ExecutorStatePending
+	assert.True(t, onlyExecutorStateChanged(old, app))
+
+	app.Status.AppState.State = v1beta2.ApplicationStateFailing
+	assert.False(t, onlyExecutorStateChanged(old, app))
+}
+
+func TestBatchExecutorStateUpdate(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	var patches []*unstructured.Unstructured
+	client := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		SubResourcePatch: func(_ context.Context, _ client.Client, subResource string, obj client.Object, patch client.Patch, _ ...client.SubResourcePatchOption) error {
+			assert.Equal(t, "status", subResource)
+			assert.Equal(t, types.ApplyPatchType, patch.Type())
+			patches = append(patches, obj.(*unstructured.Unstructured))
+			return nil
+		},
+	}).Build()
+	reconciler := &Reconciler{client: client, statusBatcher: newStatusBatcher(time.Hour)}
+
+	key := types.NamespacedName{Name: "test-app", Namespace: "default"}
+	old := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:      v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			ExecutorState: map[string]v1beta2.ExecutorState{"exec-1": v1beta2.ExecutorStatePending},
+		},
+	}
+
+	// Nothing is written if the executor states did not change.
+	wait, err := reconciler.batchExecutorStateUpdate(ctx, key, old, old.DeepCopy())
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	assert.Empty(t, patches)
+
+	// The first change is written right away.
+	app := old.DeepCopy()
+	app.Status.ExecutorState["exec-1"] = v1beta2.ExecutorStateRunning
+	wait, err = reconciler.batchExecutorStateUpdate(ctx, key, old, app)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+	require.Len(t, patches, 1)
+	executorState, found, err := unstructured.NestedStringMap(patches[0].Object, "status", "executorState")
+	require.NoError(t, err)
+	require.True(t, found)
+	assert.Equal(t, map[string]string{"exec-1": "RUNNING"}, executorState)
+
+	// Further changes within the interval are held back and overlaid on the next reconcile.
+	old = app.DeepCopy()
+	app.Status.ExecutorState["exec-2"] = v1beta2.ExecutorStatePending
+	wait, err = reconciler.batchExecutorStateUpdate(ctx, key, old, app)
+	require.NoError(t, err)
+	assert.Greater(t, wait, time.Duration(0))
+	assert.Len(t, patches, 1)
+
+	next := old.DeepCopy()
+	reconciler.statusBatcher.overlay(key, next)
+	assert.Equal(t, v1beta2.ExecutorStatePending, next.Status.ExecutorState["exec-2"])
+
+	// Once forgotten, e.g. after a full status update, nothing is overlaid anymore.
+	reconciler.statusBatcher.forget(key)
+	next = old.DeepCopy()
+	reconciler.statusBatcher.overlay(key, next)
+	assert.NotContains(t, next.Status.ExecutorState, "exec-2")
+}
+
+func TestReconcileRunningSparkApplicationWithStatusBatcher(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	key := types.NamespacedName{Name: "test-app", Namespace: "default"}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:      v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			DriverInfo:    v1beta2.DriverInfo{PodName: "test-app-driver"},
+			ExecutorState: map[string]v1beta2.ExecutorState{"test-app-exec-1": v1beta2.ExecutorStatePending},
+			Health:        v1beta2.ApplicationHealthHealthy,
+		},
+	}
+	updateExecutorScaleStatus(app)
+	updateConditions(app)
+	app.Status.Phase = util.GetApplicationPhase(app)
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app-driver",
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				common.LabelSparkAppName: key.Name,
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app-exec-1",
+			Namespace: key.Namespace,
+			Labels: map[string]string{
+				common.LabelSparkAppName:    key.Name,
+				common.LabelSparkRole:       common.SparkRoleExecutor,
+				common.LabelSparkExecutorID: "1",
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	var patches []*unstructured.Unstructured
+	client := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(app, driverPod, executorPod).
+		WithStatusSubresource(app).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(_ context.Context, _ client.Client, _ string, obj client.Object, _ client.Patch, _ ...client.SubResourcePatchOption) error {
+				patches = append(patches, obj.(*unstructured.Unstructured))
+				return nil
+			},
+		}).Build()
+	reconciler := &Reconciler{
+		client:        client,
+		recorder:      record.NewFakeRecorder(10),
+		options:       Options{MaxTrackedExecutorPerApp: 1000},
+		statusBatcher: newStatusBatcher(time.Hour),
+	}
+
+	// The executor becoming ready only changes the executor states, which are written with the ExecutorsReady
+	// condition by the status batcher.
+	_, err := reconciler.reconcileRunningSparkApplication(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.Len(t, patches, 1)
+	conditions, found, err := unstructured.NestedSlice(patches[0].Object, "status", "conditions")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Len(t, conditions, 1)
+	condition := conditions[0].(map[string]interface{})
+	assert.Equal(t, v1beta2.SparkApplicationConditionExecutorsReady, condition["type"])
+	assert.Equal(t, string(metav1.ConditionTrue), condition["status"])
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// streamingProbeClient is the HTTP client used to scrape the progress metric from drivers.
+var streamingProbeClient = &http.Client{Timeout: 5 * time.Second}
+
+// checkStreamingLiveness scrapes the progress metric of a running streaming application and restarts the
+// application if the metric has not changed for longer than the configured maximum batch delay. It returns
+// the delay after which the check should be performed again, or zero if no liveness check is configured.
+func (r *Reconciler) checkStreamingLiveness(ctx context.Context, app *v1beta2.SparkApplication) (time.Duration, error) {
+	if app.Spec.Streaming == nil || app.Spec.Streaming.LivenessCheck == nil {
+		return 0, nil
+	}
+
+	logger := log.FromContext(ctx)
+	check := app.Spec.Streaming.LivenessCheck
+	period := time.Duration(common.DefaultStreamingLivenessCheckPeriodSeconds) * time.Second
+	if check.PeriodSeconds != nil {
+		period = time.Duration(*check.PeriodSeconds) * time.Second
+	}
+
+	if app.Status.Streaming == nil {
+		app.Status.Streaming = &v1beta2.StreamingStatus{}
+	}
+	status := app.Status.Streaming
+	now := metav1.Now()
+
+	value, err := r.getStreamingProgress(ctx, app)
+	if err != nil {
+		// An unreachable driver is treated as making no progress once progress has been observed.
+		logger.Info("Failed to get streaming progress of SparkApplication", "error", err.Error())
+	} else if status.LastProgressTime.IsZero() || value != status.LastProgressValue {
+		status.LastProgressValue = value
+		status.LastProgressTime = now
+	}
+
+	if status.LastProgressTime.IsZero() || now.Sub(status.LastProgressTime.Time) <= check.MaxBatchDelay.Duration {
+		return period, nil
+	}
+
+	maxRestarts := int32(common.DefaultStreamingLivenessCheckMaxRestarts)
+	if check.MaxRestarts != nil {
+		maxRestarts = *check.MaxRestarts
+	}
+	message := fmt.Sprintf("streaming queries made no progress since %s", status.LastProgressTime.Format(time.RFC3339))
+	if status.StallRestarts >= maxRestarts {
+		logger.Info("Streaming SparkApplication stalled and exhausted liveness check restarts", "restarts", status.StallRestarts)
+		app.Status.AppState.State = v1beta2.ApplicationStateFailing
+		app.Status.AppState.ErrorMessage = fmt.Sprintf("%s after %d restarts", message, status.StallRestarts)
+		return 0, nil
+	}
+
+	logger.Info("Restarting stalled streaming SparkApplication", "lastProgressTime", status.LastProgressTime)
+	if err := r.deleteSparkResources(ctx, app); err != nil {
+		return 0, err
+	}
+	app.Status.AppState.State = v1beta2.ApplicationStatePendingRerun
+	r.resetSparkApplicationStatus(app)
+	status.StallRestarts++
+	status.LastStallRestartTime = now
+	status.LastProgressValue = ""
+	status.LastProgressTime = metav1.Time{}
+	r.recorder.Eventf(
+		app,
+		corev1.EventTypeWarning,
+		common.EventSparkApplicationStreamingStalled,
+		"SparkApplication %s is restarted as its %s",
+		app.Name,
+		message,
+	)
+	return 0, nil
+}
+
+// getStreamingProgress scrapes the driver of the given SparkApplication and returns the current value of the
+// configured progress metric.
+func (r *Reconciler) getStreamingProgress(ctx context.Context, app *v1beta2.SparkApplication) (string, error) {
+	check := app.Spec.Streaming.LivenessCheck
+	driverPod, err := r.getDriverPod(ctx, app)
+	if err != nil {
+		return "", err
+	}
+	if driverPod == nil || driverPod.Status.PodIP == "" {
+		return "", fmt.Errorf("driver pod %s has no IP", app.Status.DriverInfo.PodName)
+	}
+
+	path := common.DefaultStreamingLivenessCheckPath
+	if check.Path != nil {
+		path = *check.Path
+	}
+	var port int32
+	if check.Port != nil {
+		port = *check.Port
+	} else if port, err = getWebUITargetPort(app); err != nil {
+		return "", err
+	}
+
+	url := fmt.Sprintf("http://%s%s", net.JoinHostPort(driverPod.Status.PodIP, strconv.Itoa(int(port))), path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := streamingProbeClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+
+	value, found, err := sumPrometheusMetric(resp.Body, check.ProgressMetric)
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", fmt.Errorf("metric %s not found at %s", check.ProgressMetric, url)
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64), nil
+}
+
+// sumPrometheusMetric sums up the values of all series of the given metric in the Prometheus text exposition format.
+func sumPrometheusMetric(r io.Reader, name string) (float64, bool, error) {
+	var sum float64
+	found := false
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || !strings.HasPrefix(line, name) {
+			continue
+		}
+
+		rest := line[len(name):]
+		switch {
+		case strings.HasPrefix(rest, "{"):
+			end := strings.LastIndex(rest, "}")
+			if end < 0 {
+				continue
+			}
+			rest = rest[end+1:]
+		case strings.HasPrefix(rest, " "), strings.HasPrefix(rest, "\t"):
+		default:
+			// A different metric sharing the same prefix.
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to parse value of metric %s: %v", name, err)
+		}
+		sum += value
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, false, err
+	}
+	return sum, found, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestSumPrometheusMetric(t *testing.T) {
+	text := `# HELP metrics_app_streaming_processedRows processed rows
+# TYPE metrics_app_streaming_processedRows counter
+metrics_app_streaming_processedRows{query="a"} 10
+metrics_app_streaming_processedRows{query="b"} 5.5 1700000000000
+metrics_app_streaming_processedRowsTotal 100
+metrics_app_streaming_latency 3
+`
+	value, found, err := sumPrometheusMetric(strings.NewReader(text), "metrics_app_streaming_processedRows")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 15.5, value)
+
+	value, found, err = sumPrometheusMetric(strings.NewReader(text), "metrics_app_streaming_latency")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 3.0, value)
+
+	_, found, err = sumPrometheusMetric(strings.NewReader(text), "missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestCheckStreamingLiveness(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	progress := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics/prometheus" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, "batches{query=\"q\"} %d\n", progress)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(serverURL.Port())
+	require.NoError(t, err)
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"},
+		Status:     corev1.PodStatus{PodIP: "127.0.0.1"},
+	}
+	newApp := func() *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				Streaming: &v1beta2.StreamingSpec{
+					LivenessCheck: &v1beta2.StreamingLivenessCheck{
+						Port:           ptr.To(int32(port)),
+						ProgressMetric: "batches",
+						MaxBatchDelay:  metav1.Duration{Duration: time.Minute},
+						MaxRestarts:    ptr.To(int32(1)),
+					},
+				},
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:   v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+				DriverInfo: v1beta2.DriverInfo{PodName: "test-app-driver"},
+			},
+		}
+	}
+	newReconciler := func() *Reconciler {
+		client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(driverPod.DeepCopy()).Build()
+		return &Reconciler{client: client, recorder: record.NewFakeRecorder(10)}
+	}
+
+	t.Run("no liveness check", func(t *testing.T) {
+		app := newApp()
+		app.Spec.Streaming = nil
+		requeueAfter, err := newReconciler().checkStreamingLiveness(ctx, app)
+		require.NoError(t, err)
+		assert.Zero(t, requeueAfter)
+		assert.Nil(t, app.Status.Streaming)
+	})
+
+	t.Run("progress is recorded", func(t *testing.T) {
+		app := newApp()
+		reconciler := newReconciler()
+		requeueAfter, err := reconciler.checkStreamingLiveness(ctx, app)
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Second, requeueAfter)
+		require.NotNil(t, app.Status.Streaming)
+		assert.Equal(t, "1", app.Status.Streaming.LastProgressValue)
+		assert.False(t, app.Status.Streaming.LastProgressTime.IsZero())
+
+		progress = 2
+		defer func() { progress = 1 }()
+		app.Status.Streaming.LastProgressTime = metav1.NewTime(time.Now().Add(-time.Hour))
+		_, err = reconciler.checkStreamingLiveness(ctx, app)
+		require.NoError(t, err)
+		assert.Equal(t, v1beta2.ApplicationStateRunning, app.Status.AppState.State)
+		assert.Equal(t, "2", app.Status.Streaming.LastProgressValue)
+		assert.WithinDuration(t, time.Now(), app.Status.Streaming.LastProgressTime.Time, time.Minute)
+	})
+
+	t.Run("stalled application is restarted", func(t *testing.T) {
+		app := newApp()
+		app.Status.Streaming = &v1beta2.StreamingStatus{
+			LastProgressValue: "1",
+			LastProgressTime:  metav1.NewTime(time.Now().Add(-time.Hour)),
+		}
+		reconciler := newReconciler()
+		_, err := reconciler.checkStreamingLiveness(ctx, app)
+		require.NoError(t, err)
+		assert.Equal(t, v1beta2.ApplicationStatePendingRerun, app.Status.AppState.State)
+		assert.Equal(t, int32(1), app.Status.Streaming.StallRestarts)
+		assert.False(t, app.Status.Streaming.LastStallRestartTime.IsZero())
+		assert.True(t, app.Status.Streaming.LastProgressTime.IsZero())
+		assert.Empty(t, app.Status.DriverInfo.PodName)
+
+		err = reconciler.client.Get(ctx, types.NamespacedName{Name: "test-app-driver", Namespace: "default"}, &corev1.Pod{})
+		assert.True(t, errors.IsNotFound(err))
+	})
+
+	t.Run("stalled application exhausted restarts", func(t *testing.T) {
+		app := newApp()
+		app.Status.Streaming = &v1beta2.StreamingStatus{
+			LastProgressValue: "1",
+			LastProgressTime:  metav1.NewTime(time.Now().Add(-time.Hour)),
+			StallRestarts:     1,
+		}
+		_, err := newReconciler().checkStreamingLiveness(ctx, app)
+		require.NoError(t, err)
+		assert.Equal(t, v1beta2.ApplicationStateFailing, app.Status.AppState.State)
+		assert.Contains(t, app.Status.AppState.ErrorMessage, "no progress")
+	})
+}
@@ -32,6 +32,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/tracing"
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	"github.com/kubeflow/spark-operator/v2/pkg/features"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
@@ -54,27 +56,35 @@ var _ SparkApplicationSubmitter = &SparkSubmitter{}
 func (*SparkSubmitter) Submit(ctx context.Context, app *v1beta2.SparkApplication) error {
 	logger := log.FromContext(ctx)
 
+	_, span := tracing.StartSpan(ctx, "SparkSubmit.BuildArgs")
 	args, err := buildSparkSubmitArgs(app)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return fmt.Errorf("failed to build spark-submit arguments: %v", err)
 	}
 
 	// Try submitting the application by running spark-submit.
 	logger.Info("Running spark-submit", "arguments", args)
-	if err := runSparkSubmit(args); err != nil {
+	_, span = tracing.StartSpan(ctx, "SparkSubmit.Exec")
+	err = runSparkSubmit(args, buildSparkSubmitEnv(app))
+	tracing.EndSpan(span, err)
+	if err != nil {
 		return fmt.Errorf("failed to run spark-submit: %v", err)
 	}
 
 	return nil
 }
 
-func runSparkSubmit(args []string) error {
+func runSparkSubmit(args []string, env []string) error {
 	sparkHome, present := os.LookupEnv(common.EnvSparkHome)
 	if !present {
 		return fmt.Errorf("env %s is not specified", common.EnvSparkHome)
 	}
 	command := filepath.Join(sparkHome, "bin", "spark-submit")
 	cmd := exec.Command(command, args...)
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	_, err := cmd.Output()
 	if err != nil {
 		var errorMsg string
@@ -93,6 +103,20 @@ func runSparkSubmit(args []string) error {
 	return nil
 }
 
+// buildSparkSubmitEnv builds the environment variables of spark-submit overriding the ones of the operator.
+func buildSparkSubmitEnv(app *v1beta2.SparkApplication) []string {
+	var env []string
+	if app.Spec.HadoopSecurity != nil {
+		// The JVM of spark-submit reads the Kerberos configuration when logging in with the keytab.
+		opts := fmt.Sprintf("-D%s=%s", common.JavaSecurityKrb5Conf, filepath.Join(getKerberosDir(app), common.KerberosConfKey))
+		if existing := os.Getenv(common.EnvSparkSubmitOpts); existing != "" {
+			opts = existing + " " + opts
+		}
+		env = append(env, fmt.Sprintf("%s=%s", common.EnvSparkSubmitOpts, opts))
+	}
+	return env
+}
+
 // buildSparkSubmitArgs builds the arguments for spark-submit.
 func buildSparkSubmitArgs(app *v1beta2.SparkApplication) ([]string, error) {
 	optionFuncs := []sparkSubmitOptionFunc{
@@ -122,6 +146,9 @@ func buildSparkSubmitArgs(app *v1beta2.SparkApplication) ([]string, error) {
 		executorVolumeMountsOption,
 		nodeSelectorOption,
 		dynamicAllocationOption,
+		executorDecommissionOption,
+		executorEphemeralPVCOption,
+		streamingCheckpointOption,
 		proxyUserOption,
 		mainApplicationFileOption,
 		applicationOption,
@@ -331,8 +358,7 @@ func driverConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 	property = fmt.Sprintf(common.SparkKubernetesDriverLabelTemplate, common.LabelLaunchedBySparkOperator)
 	args = append(args, "--conf", fmt.Sprintf("%s=%s", property, "true"))
 
-	// If Spark version is less than 3.0.0 or driver pod template is not defined, then the driver pod needs to be mutated by the webhook.
-	if util.CompareSemanticVersion(app.Spec.SparkVersion, "3.0.0") < 0 || app.Spec.Driver.Template == nil {
+	if isMutatedByWebhook(app, app.Spec.Driver.Template) {
 		property = fmt.Sprintf(common.SparkKubernetesDriverLabelTemplate, common.LabelMutatedBySparkOperator)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, "true"))
 	}
@@ -376,7 +402,7 @@ func driverConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 	if app.Spec.Driver.ServiceAccount != nil {
 		args = append(args, "--conf",
 			fmt.Sprintf("%s=%s",
-				common.SparkKubernetesAuthenticateDriverServiceAccountName, *app.Spec.Driver.ServiceAccount),
+				common.SparkKubernetesAuthenticateDriverServiceAccountName, util.GetServiceAccountName(app, app.Spec.Driver.ServiceAccount)),
 		)
 	}
 
@@ -390,19 +416,17 @@ func driverConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 			fmt.Sprintf("%s=%s", common.SparkKubernetesDriverMaster, *app.Spec.Driver.KubernetesMaster))
 	}
 
-	// Populate SparkApplication labels to driver pod
-	for key, value := range app.Labels {
-		// Don't propagate Kueue labels to driver pod.
-		// This is a quick workaround to avoid issues in Kueue integration.
-		// Community may consider a better label propagation control mechanisms in the future.
-		// ref: https://github.com/kubeflow/spark-operator/issues/2669#issuecomment-3500165528
-		if strings.HasPrefix(key, common.KueueLabelPrefix) {
-			continue
-		}
+	// Populate SparkApplication labels and annotations selected by spec.metadataPropagation to driver pod
+	for key, value := range util.GetPropagatedLabels(app, app.Spec.MetadataPropagation) {
 		property = fmt.Sprintf(common.SparkKubernetesDriverLabelTemplate, key)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
 	}
 
+	for key, value := range util.GetPropagatedAnnotations(app, app.Spec.MetadataPropagation) {
+		property = fmt.Sprintf(common.SparkKubernetesDriverAnnotationTemplate, key)
+		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
+	}
+
 	for key, value := range app.Spec.Driver.Labels {
 		property = fmt.Sprintf(common.SparkKubernetesDriverLabelTemplate, key)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
@@ -428,6 +452,11 @@ func driverConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 		args = append(args, "--conf", fmt.Sprintf("%s=%s:%s", property, value.Name, value.Key))
 	}
 
+	for _, ref := range app.Spec.Driver.EnvSecretRefs {
+		property = fmt.Sprintf(common.SparkKubernetesDriverSecretKeyRefTemplate, util.GetEnvSecretRefEnvName(ref))
+		args = append(args, "--conf", fmt.Sprintf("%s=%s:%s", property, ref.SecretName, ref.Key))
+	}
+
 	return args, nil
 }
 
@@ -435,6 +464,10 @@ func driverConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 func driverSecretOption(app *v1beta2.SparkApplication) ([]string, error) {
 	var args []string
 	for _, secret := range app.Spec.Driver.Secrets {
+		// Vault secrets are injected by Vault instead of mounted from Kubernetes Secrets.
+		if secret.Type == v1beta2.SecretTypeVault {
+			continue
+		}
 		property := fmt.Sprintf(common.SparkKubernetesDriverSecretsTemplate, secret.Name)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, secret.Path))
 		switch secret.Type {
@@ -688,8 +721,7 @@ func executorConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 	property = fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelLaunchedBySparkOperator)
 	args = append(args, "--conf", fmt.Sprintf("%s=%s", property, "true"))
 
-	// If Spark version is less than 3.0.0 or executor pod template is not defined, then the executor pods need to be mutated by the webhook.
-	if util.CompareSemanticVersion(app.Spec.SparkVersion, "3.0.0") < 0 || app.Spec.Executor.Template == nil {
+	if isMutatedByWebhook(app, app.Spec.Executor.Template) {
 		property = fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelMutatedBySparkOperator)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, "true"))
 	}
@@ -734,7 +766,7 @@ func executorConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 
 	if app.Spec.Executor.ServiceAccount != nil {
 		args = append(args, "--conf",
-			fmt.Sprintf("%s=%s", common.SparkKubernetesAuthenticateExecutorServiceAccountName, *app.Spec.Executor.ServiceAccount))
+			fmt.Sprintf("%s=%s", common.SparkKubernetesAuthenticateExecutorServiceAccountName, util.GetServiceAccountName(app, app.Spec.Executor.ServiceAccount)))
 	}
 
 	if app.Spec.Executor.DeleteOnTermination != nil {
@@ -742,18 +774,17 @@ func executorConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 			fmt.Sprintf("%s=%t", common.SparkKubernetesExecutorDeleteOnTermination, *app.Spec.Executor.DeleteOnTermination))
 	}
 
-	// Populate SparkApplication labels to executor pod
-	for key, value := range app.Labels {
-		// Don't propagate Kueue labels to driver pod.
-		// This is a quick workaround to avoid issues in Kueue integration.
-		// Community may consider a better label propagation control mechanisms in the future.
-		// ref: https://github.com/kubeflow/spark-operator/issues/2669#issuecomment-3500165528
-		if strings.HasPrefix(key, common.KueueLabelPrefix) {
-			continue
-		}
+	// Populate SparkApplication labels and annotations selected by spec.metadataPropagation to executor pod
+	for key, value := range util.GetPropagatedLabels(app, app.Spec.MetadataPropagation) {
 		property := fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, key)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
 	}
+
+	for key, value := range util.GetPropagatedAnnotations(app, app.Spec.MetadataPropagation) {
+		property := fmt.Sprintf(common.SparkKubernetesExecutorAnnotationTemplate, key)
+		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
+	}
+
 	for key, value := range app.Spec.Executor.Labels {
 		property := fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, key)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, value))
@@ -769,6 +800,11 @@ func executorConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 		args = append(args, "--conf", fmt.Sprintf("%s=%s:%s", property, value.Name, value.Key))
 	}
 
+	for _, ref := range app.Spec.Executor.EnvSecretRefs {
+		property := fmt.Sprintf(common.SparkKubernetesExecutorSecretKeyRefTemplate, util.GetEnvSecretRefEnvName(ref))
+		args = append(args, "--conf", fmt.Sprintf("%s=%s:%s", property, ref.SecretName, ref.Key))
+	}
+
 	if app.Spec.Executor.JavaOptions != nil {
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", common.SparkExecutorExtraJavaOptions, *app.Spec.Executor.JavaOptions))
 	}
@@ -779,6 +815,9 @@ func executorConfOption(app *v1beta2.SparkApplication) ([]string, error) {
 func executorSecretOption(app *v1beta2.SparkApplication) ([]string, error) {
 	var args []string
 	for _, secret := range app.Spec.Executor.Secrets {
+		if secret.Type == v1beta2.SecretTypeVault {
+			continue
+		}
 		property := fmt.Sprintf(common.SparkKubernetesExecutorSecretsTemplate, secret.Name)
 		args = append(args, "--conf", fmt.Sprintf("%s=%s", property, secret.Path))
 		switch secret.Type {
@@ -1056,6 +1095,84 @@ func dynamicAllocationOption(app *v1beta2.SparkApplication) ([]string, error) {
 	return args, nil
 }
 
+func executorDecommissionOption(app *v1beta2.SparkApplication) ([]string, error) {
+	if app.Spec.Executor.DecommissionOnNodeEviction == nil || !*app.Spec.Executor.DecommissionOnNodeEviction {
+		return nil, nil
+	}
+
+	var args []string
+	// Do not override the decommission settings given explicitly in spark conf.
+	for _, key := range []string{common.SparkDecommissionEnabled, common.SparkStorageDecommissionEnabled} {
+		if _, ok := app.Spec.SparkConf[key]; ok {
+			continue
+		}
+		args = append(args, "--conf", fmt.Sprintf("%s=true", key))
+	}
+	return args, nil
+}
+
+func executorEphemeralPVCOption(app *v1beta2.SparkApplication) ([]string, error) {
+	pvc := app.Spec.Executor.EphemeralPVC
+	if pvc == nil {
+		return nil, nil
+	}
+
+	volumeName := common.DefaultEphemeralPVCVolumeName
+	if pvc.VolumeName != nil {
+		volumeName = *pvc.VolumeName
+	}
+	mountPath := common.DefaultEphemeralPVCMountPath
+	if pvc.MountPath != nil {
+		mountPath = *pvc.MountPath
+	}
+
+	volumeType := common.VolumeTypePersistentVolumeClaim
+	args := []string{
+		"--conf",
+		fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorVolumesOptionsTemplate, volumeType, volumeName, "claimName"), common.SparkOnDemandClaimName),
+		"--conf",
+		fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorVolumesOptionsTemplate, volumeType, volumeName, "sizeLimit"), pvc.SizeLimit.String()),
+		"--conf",
+		fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorVolumesMountPathTemplate, volumeType, volumeName), mountPath),
+		"--conf",
+		fmt.Sprintf("%s=false", fmt.Sprintf(common.SparkKubernetesExecutorVolumesMountReadOnlyTemplate, volumeType, volumeName)),
+	}
+	if pvc.StorageClass != nil {
+		args = append(args, "--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorVolumesOptionsTemplate, volumeType, volumeName, "storageClass"), *pvc.StorageClass))
+	}
+
+	// Only pass the claim reuse settings known to the Spark version of the application.
+	var keys []string
+	if util.SparkVersionSupports(app.Spec.SparkVersion, util.SparkFeaturePVCReuse) {
+		keys = append(keys, common.SparkKubernetesDriverOwnPersistentVolumeClaim, common.SparkKubernetesDriverReusePersistentVolumeClaim)
+	}
+	if util.SparkVersionSupports(app.Spec.SparkVersion, util.SparkFeaturePVCWaitToReuse) {
+		keys = append(keys, common.SparkKubernetesDriverWaitToReusePersistentVolumeClaim)
+	}
+	reuse := pvc.ReuseClaims == nil || *pvc.ReuseClaims
+	for _, key := range keys {
+		// Do not override the claim reuse settings given explicitly in spark conf.
+		if _, ok := app.Spec.SparkConf[key]; ok {
+			continue
+		}
+		args = append(args, "--conf", fmt.Sprintf("%s=%t", key, reuse))
+	}
+	return args, nil
+}
+
+func streamingCheckpointOption(app *v1beta2.SparkApplication) ([]string, error) {
+	location := util.GetStreamingCheckpointLocation(app)
+	if location == "" {
+		return nil, nil
+	}
+	// Always pass the checkpoint location so that every run of the application resumes from the same checkpoint.
+	args := []string{
+		"--conf",
+		fmt.Sprintf("%s=%s", common.SparkSQLStreamingCheckpointLocation, location),
+	}
+	return args, nil
+}
+
 func proxyUserOption(app *v1beta2.SparkApplication) ([]string, error) {
 	if app.Spec.ProxyUser == nil || *app.Spec.ProxyUser == "" {
 		return nil, nil
@@ -1094,6 +1211,13 @@ func driverPodTemplateOption(app *v1beta2.SparkApplication) ([]string, error) {
 		}
 	}
 
+	if isMutatedByPodTemplate(app) {
+		template = template.DeepCopy()
+		if err := webhook.MutateSparkPodTemplate(template, common.SparkRoleDriver, app); err != nil {
+			return []string{}, fmt.Errorf("failed to apply customizations to driver pod template: %v", err)
+		}
+	}
+
 	ownerReference := util.GetOwnerReference(app)
 	if !slices.ContainsFunc(template.OwnerReferences, func(r metav1.OwnerReference) bool {
 		return reflect.DeepEqual(r, ownerReference)
@@ -1130,6 +1254,13 @@ func executorPodTemplateOption(app *v1beta2.SparkApplication) ([]string, error)
 		}
 	}
 
+	if isMutatedByPodTemplate(app) {
+		template = template.DeepCopy()
+		if err := webhook.MutateSparkPodTemplate(template, common.SparkRoleExecutor, app); err != nil {
+			return []string{}, fmt.Errorf("failed to apply customizations to executor pod template: %v", err)
+		}
+	}
+
 	// we put non-controller owner reference so that
 	// other controller (e.g. Kueue) can recognize the executor pods
 	// are the children of the SparkApplication
@@ -1156,6 +1287,26 @@ func executorPodTemplateOption(app *v1beta2.SparkApplication) ([]string, error)
 	return args, nil
 }
 
+// isMutatedByPodTemplate returns whether the customizations of the given SparkApplication are applied to the
+// pod templates instead of by the webhook, which requires feature gate `PodTemplateMutation` and Spark 3.0.0+.
+func isMutatedByPodTemplate(app *v1beta2.SparkApplication) bool {
+	return features.Enabled(features.PodTemplateMutation) && util.CompareSemanticVersion(app.Spec.SparkVersion, "3.0.0") >= 0
+}
+
+// isMutatedByWebhook returns whether the Spark pods created from the given pod template need to be mutated by
+// the webhook, which is the case in client mode, if Spark version is less than 3.0.0 or if the pod template is not
+// defined, unless the customizations are applied to the pod template by the controller.
+func isMutatedByWebhook(app *v1beta2.SparkApplication, template *corev1.PodTemplateSpec) bool {
+	// Pod template files are not available to spark-submit running in the driver pod in client mode.
+	if app.Spec.Mode == v1beta2.DeployModeClient {
+		return true
+	}
+	if util.CompareSemanticVersion(app.Spec.SparkVersion, "3.0.0") < 0 {
+		return true
+	}
+	return template == nil && !features.Enabled(features.PodTemplateMutation)
+}
+
 // loadSparkDefaultsOption adds `--load-spark-defaults` flag to the command when feature gate `LoadSparkDefaults` is enabled.
 func loadSparkDefaultsOption(_ *v1beta2.SparkApplication) ([]string, error) {
 	args := []string{}
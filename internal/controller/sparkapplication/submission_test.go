@@ -34,6 +34,7 @@ import (
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/features"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
 
@@ -102,6 +103,35 @@ func TestExecutorConfOption(t *testing.T) {
 				"--conf", fmt.Sprintf("%s=%t", common.SparkKubernetesExecutorDeleteOnTermination, true),
 			},
 		},
+		{
+			name: "executor env from secret refs",
+			app: &v1beta2.SparkApplication{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "spark-secrets",
+				},
+				Status: v1beta2.SparkApplicationStatus{
+					SubmissionID: "secrets-123",
+				},
+				Spec: v1beta2.SparkApplicationSpec{
+					Executor: v1beta2.ExecutorSpec{
+						SparkPodSpec: v1beta2.SparkPodSpec{
+							EnvSecretRefs: []v1beta2.EnvSecretRef{
+								{SecretName: "db-credentials", Key: "password", EnvName: ptr.To("DB_PASSWORD")},
+								{SecretName: "api", Key: "TOKEN"},
+							},
+						},
+					},
+				},
+			},
+			expected: []string{
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelSparkAppName), "spark-secrets"),
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelLaunchedBySparkOperator), "true"),
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelMutatedBySparkOperator), "true"),
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelSubmissionID), "secrets-123"),
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorSecretKeyRefTemplate, "DB_PASSWORD"), "db-credentials:password"),
+				"--conf", fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorSecretKeyRefTemplate, "TOKEN"), "api:TOKEN"),
+			},
+		},
 		{
 			name: "kueue labels on SparkApplication should not be propagated to executor conf",
 			app: &v1beta2.SparkApplication{
@@ -1290,3 +1320,86 @@ func TestExecutorPodTemplateContents(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestPodTemplateMutation(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.PodTemplateMutation, true)
+
+	toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "spark", Effect: corev1.TaintEffectNoSchedule}
+	securityContext := &corev1.SecurityContext{RunAsNonRoot: ptr.To(true)}
+	template := &corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "data"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: common.Spark3DefaultExecutorContainerName, Image: "image"}},
+		},
+	}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+			UID:  types.UID(uuid.New().String()),
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkVersion: "3.5.0",
+			Volumes: []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}},
+			},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Env:             []corev1.EnvVar{{Name: "ROLE", Value: "driver"}},
+					SecurityContext: securityContext,
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Template:     template.DeepCopy(),
+					Tolerations:  []corev1.Toleration{toleration},
+					VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data"}},
+				},
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			SubmissionID: "TestPodTemplateMutation-" + uuid.New().String(),
+		},
+	}
+	defer func() { _ = os.RemoveAll(fmt.Sprintf("/tmp/spark/%s", app.Status.SubmissionID)) }()
+
+	readTemplate := func(role string) *corev1.PodTemplateSpec {
+		data, err := os.ReadFile(fmt.Sprintf("/tmp/spark/%s/%s-pod-template.yaml", app.Status.SubmissionID, role))
+		assert.NoError(t, err)
+		template := &corev1.PodTemplateSpec{}
+		assert.NoError(t, yaml.Unmarshal(data, template))
+		return template
+	}
+
+	_, err := driverPodTemplateOption(app)
+	assert.NoError(t, err)
+	driverTemplate := readTemplate("driver")
+	assert.Len(t, driverTemplate.Spec.Containers, 1)
+	assert.Contains(t, driverTemplate.Spec.Containers[0].Env, corev1.EnvVar{Name: "ROLE", Value: "driver"})
+	assert.Equal(t, securityContext, driverTemplate.Spec.Containers[0].SecurityContext)
+	assert.NotContains(t, driverTemplate.Labels, common.LabelSparkRole)
+
+	_, err = executorPodTemplateOption(app)
+	assert.NoError(t, err)
+	executorTemplate := readTemplate("executor")
+	assert.Equal(t, map[string]string{"team": "data"}, executorTemplate.Labels)
+	assert.Equal(t, []corev1.Toleration{toleration}, executorTemplate.Spec.Tolerations)
+	assert.Equal(t, app.Spec.Volumes, executorTemplate.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}, executorTemplate.Spec.Containers[0].VolumeMounts)
+	// The application spec is left untouched.
+	assert.Equal(t, template, app.Spec.Executor.Template)
+
+	// Spark pods are no longer labeled for mutation by the webhook.
+	for _, option := range []sparkSubmitOptionFunc{driverConfOption, executorConfOption} {
+		args, err := option(app)
+		assert.NoError(t, err)
+		for _, arg := range args {
+			assert.NotContains(t, arg, common.LabelMutatedBySparkOperator)
+		}
+	}
+
+	// Spark versions without pod template support are still mutated by the webhook.
+	app.Spec.SparkVersion = "2.4.8"
+	args, err := executorConfOption(app)
+	assert.NoError(t, err)
+	assert.Contains(t, args, fmt.Sprintf("%s=%s", fmt.Sprintf(common.SparkKubernetesExecutorLabelTemplate, common.LabelMutatedBySparkOperator), "true"))
+}
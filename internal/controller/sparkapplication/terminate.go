@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// shouldTerminate returns whether the given SparkApplication is requested to be cancelled and has not terminated yet.
+func shouldTerminate(app *v1beta2.SparkApplication) bool {
+	return app.Spec.Terminate != nil && !util.IsTerminated(app)
+}
+
+// getCancellationMessage returns the error message of a SparkApplication cancelled upon the given request.
+func getCancellationMessage(terminate *v1beta2.TerminateSpec) string {
+	message := "cancelled"
+	if terminate.RequestedBy != "" {
+		message = fmt.Sprintf("%s by %s", message, terminate.RequestedBy)
+	}
+	if terminate.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, terminate.Reason)
+	}
+	return message
+}
+
+// transitionToCancelled cancels the SparkApplication upon a termination request. Its driver pod is deleted with the
+// requested grace period, during which the kubelet sends SIGTERM to the driver, and the application is failed.
+// The executors are garbage collected along with the driver pod.
+func (r *Reconciler) transitionToCancelled(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	key := req.NamespacedName
+	retryErr := retry.RetryOnConflict(
+		retry.DefaultRetry,
+		func() error {
+			old, err := r.getSparkApplication(ctx, key)
+			if err != nil {
+				return err
+			}
+			if !shouldTerminate(old) {
+				return nil
+			}
+			app := old.DeepCopy()
+
+			terminate := app.Spec.Terminate
+			logger.Info("Cancelling SparkApplication upon request", "state", app.Status.AppState.State, "requestedBy", terminate.RequestedBy, "reason", terminate.Reason)
+			var opts []client.DeleteOption
+			if terminate.GracePeriodSeconds != nil {
+				opts = append(opts, client.GracePeriodSeconds(*terminate.GracePeriodSeconds))
+			}
+			if err := r.deleteSparkResources(ctx, app, opts...); err != nil {
+				return fmt.Errorf("failed to delete spark resources: %v", err)
+			}
+
+			now := metav1.Now()
+			message := getCancellationMessage(terminate)
+			app.Status.Cancellation = &v1beta2.CancellationStatus{
+				CancelledBy:      terminate.RequestedBy,
+				Reason:           terminate.Reason,
+				CancellationTime: now,
+			}
+			app.Status.AppState = v1beta2.ApplicationState{
+				State:        v1beta2.ApplicationStateFailed,
+				ErrorMessage: message,
+			}
+			app.Status.TerminationTime = now
+			if err := r.updateSparkApplicationStatus(ctx, app); err != nil {
+				return err
+			}
+			r.recorder.Eventf(
+				app,
+				corev1.EventTypeNormal,
+				common.EventSparkApplicationCancelled,
+				"SparkApplication %s is %s",
+				app.Name,
+				message,
+			)
+			r.recordSparkApplicationEvent(app)
+			return nil
+		},
+	)
+	if retryErr != nil {
+		logger.Error(retryErr, "Failed to reconcile SparkApplication")
+		return ctrl.Result{Requeue: true}, retryErr
+	}
+	return ctrl.Result{}, nil
+}
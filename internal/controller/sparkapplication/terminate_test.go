@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestShouldTerminate(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		Status: v1beta2.SparkApplicationStatus{
+			AppState: v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+		},
+	}
+	assert.False(t, shouldTerminate(app))
+
+	app.Spec.Terminate = &v1beta2.TerminateSpec{}
+	assert.True(t, shouldTerminate(app))
+
+	app.Status.AppState.State = v1beta2.ApplicationStateFailed
+	assert.False(t, shouldTerminate(app))
+}
+
+func TestGetCancellationMessage(t *testing.T) {
+	assert.Equal(t, "cancelled", getCancellationMessage(&v1beta2.TerminateSpec{}))
+	assert.Equal(t, "cancelled by alice: wrong input", getCancellationMessage(&v1beta2.TerminateSpec{
+		RequestedBy: "alice",
+		Reason:      "wrong input",
+	}))
+}
+
+func TestTransitionToCancelled(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Terminate: &v1beta2.TerminateSpec{
+				Reason:             "wrong input",
+				RequestedBy:        "alice",
+				GracePeriodSeconds: ptr.To[int64](300),
+			},
+		},
+		Status: v1beta2.SparkApplicationStatus{
+			AppState:   v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning},
+			DriverInfo: v1beta2.DriverInfo{PodName: "test-app-driver"},
+		},
+	}
+	driverPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-app-driver", Namespace: "default"}}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(app, driverPod).WithStatusSubresource(app).Build()
+	recorder := record.NewFakeRecorder(10)
+	reconciler := &Reconciler{client: client, recorder: recorder}
+
+	key := types.NamespacedName{Name: app.Name, Namespace: app.Namespace}
+	_, err := reconciler.transitionToCancelled(ctx, ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	updated := &v1beta2.SparkApplication{}
+	require.NoError(t, client.Get(ctx, key, updated))
+	assert.Equal(t, v1beta2.ApplicationStateFailed, updated.Status.AppState.State)
+	assert.Equal(t, "cancelled by alice: wrong input", updated.Status.AppState.ErrorMessage)
+	require.NotNil(t, updated.Status.Cancellation)
+	assert.Equal(t, "alice", updated.Status.Cancellation.CancelledBy)
+	assert.Equal(t, "wrong input", updated.Status.Cancellation.Reason)
+	assert.False(t, updated.Status.Cancellation.CancellationTime.IsZero())
+	assert.False(t, updated.Status.TerminationTime.IsZero())
+	err = client.Get(ctx, types.NamespacedName{Name: driverPod.Name, Namespace: driverPod.Namespace}, &corev1.Pod{})
+	assert.True(t, errors.IsNotFound(err))
+	assert.Contains(t, <-recorder.Events, common.EventSparkApplicationCancelled)
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// configVaultSecrets sets the pod annotations making the Vault Agent Injector render the Vault secrets of the
+// given SparkApplication. They are passed to Spark rather than added by the webhook so that they are on the pods
+// whatever the order in which the mutating webhooks are called.
+func configVaultSecrets(app *v1beta2.SparkApplication) {
+	for _, spec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		annotations := util.GetVaultAgentAnnotations(spec.Secrets)
+		if len(annotations) == 0 {
+			continue
+		}
+		if spec.Annotations == nil {
+			spec.Annotations = make(map[string]string)
+		}
+		for key, value := range annotations {
+			util.SetIfNotExists(spec.Annotations, key, value)
+		}
+	}
+}
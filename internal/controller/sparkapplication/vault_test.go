@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sparkapplication
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestConfigVaultSecrets(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Annotations: map[string]string{common.VaultAnnotationAgentPrePopulateOnly: "false"},
+					Secrets: []v1beta2.SecretInfo{
+						{
+							Name:  "secret/data/spark/s3",
+							Path:  "/etc/s3",
+							Type:  v1beta2.SecretTypeVault,
+							Vault: &v1beta2.VaultSecret{Role: "spark"},
+						},
+						{
+							Name: "secret/data/spark/jdbc",
+							Path: "/etc/jdbc",
+							Type: v1beta2.SecretTypeVault,
+							Vault: &v1beta2.VaultSecret{
+								Role:     "spark",
+								FileName: "jdbc.properties",
+								Template: `{{ with secret "secret/data/spark/jdbc" }}password={{ .Data.data.password }}{{ end }}`,
+							},
+						},
+						{
+							Name:  "jdbc",
+							Path:  "/etc/csi",
+							Type:  v1beta2.SecretTypeVault,
+							Vault: &v1beta2.VaultSecret{Injector: v1beta2.VaultInjectorCSI, SecretProviderClass: "vault-jdbc"},
+						},
+					},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Secrets: []v1beta2.SecretInfo{{Name: "gcp", Path: "/etc/gcp", Type: v1beta2.SecretTypeGCPServiceAccount}},
+				},
+			},
+		},
+	}
+
+	configVaultSecrets(app)
+
+	assert.Equal(t, map[string]string{
+		"vault.hashicorp.com/agent-inject":                          "true",
+		"vault.hashicorp.com/agent-pre-populate-only":               "false",
+		"vault.hashicorp.com/role":                                  "spark",
+		"vault.hashicorp.com/agent-inject-secret-s3":                "secret/data/spark/s3",
+		"vault.hashicorp.com/secret-volume-path-s3":                 "/etc/s3",
+		"vault.hashicorp.com/agent-inject-secret-jdbc.properties":   "secret/data/spark/jdbc",
+		"vault.hashicorp.com/secret-volume-path-jdbc.properties":    "/etc/jdbc",
+		"vault.hashicorp.com/agent-inject-template-jdbc.properties": app.Spec.Driver.Secrets[1].Vault.Template,
+	}, app.Spec.Driver.Annotations)
+	assert.Nil(t, app.Spec.Executor.Annotations)
+
+	// Vault secrets are not mounted from Kubernetes Secrets.
+	args, err := driverSecretOption(app)
+	require.NoError(t, err)
+	assert.Empty(t, args)
+}
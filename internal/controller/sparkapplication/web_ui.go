@@ -20,8 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"strconv"
 
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
@@ -30,6 +30,14 @@ import (
 )
 
 func (r *Reconciler) configWebUI(_ context.Context, app *v1beta2.SparkApplication) error {
+	if !util.IsSparkUIEnabled(app, !r.options.DisableSparkUI) {
+		if app.Spec.SparkConf == nil {
+			app.Spec.SparkConf = make(map[string]string)
+		}
+		app.Spec.SparkConf[common.SparkUIEnabled] = "false"
+		return nil
+	}
+
 	if !r.options.EnableUIService || r.options.IngressURLFormat == "" {
 		return nil
 	}
@@ -51,6 +59,15 @@ func (r *Reconciler) configWebUI(_ context.Context, app *v1beta2.SparkApplicatio
 }
 
 func (r *Reconciler) createWebUIService(ctx context.Context, app *v1beta2.SparkApplication) (*SparkService, error) {
+	service, err := buildWebUIService(app)
+	if err != nil {
+		return nil, err
+	}
+	r.propagateMetadata(app, service)
+	return r.createOrUpdateDriverService(ctx, service)
+}
+
+func buildWebUIService(app *v1beta2.SparkApplication) (*corev1.Service, error) {
 	portName := getWebUIServicePortName(app)
 	port, err := getWebUIServicePort(app)
 	if err != nil {
@@ -67,7 +84,7 @@ func (r *Reconciler) createWebUIService(ctx context.Context, app *v1beta2.SparkA
 	serviceLabels := util.GetWebUIServiceLabels(app)
 	serviceAnnotations := util.GetWebUIServiceAnnotations(app)
 
-	return r.createDriverIngressService(ctx, app, portName, port, targetPort, serviceName, serviceType, serviceAnnotations, serviceLabels)
+	return buildDriverIngressService(app, portName, port, targetPort, serviceName, serviceType, serviceAnnotations, serviceLabels), nil
 }
 
 func (r *Reconciler) createWebUIIngress(ctx context.Context, app *v1beta2.SparkApplication, service SparkService, ingressURL *url.URL, ingressClassName string, defaultIngressTLS []networkingv1.IngressTLS, defaultIngressAnnotations map[string]string) (*SparkIngress, error) {
@@ -100,17 +117,45 @@ func getWebUIServicePort(app *v1beta2.SparkApplication) (int32, error) {
 	return common.DefaultSparkWebUIPort, nil
 }
 
+// recordDriverWebUIAddress records the address of the web UI on the given driver pod if no UI service was created
+// for the SparkApplication, so that the UI can be reached from within the cluster whatever the submission mode.
+func (r *Reconciler) recordDriverWebUIAddress(app *v1beta2.SparkApplication, driverPod *corev1.Pod) {
+	if app.Status.DriverInfo.WebUIServiceName != "" || driverPod.Status.PodIP == "" || !util.IsSparkUIEnabled(app, !r.options.DisableSparkUI) {
+		return
+	}
+	app.Status.DriverInfo.WebUIPort = util.GetSparkUIPort(app)
+	app.Status.DriverInfo.WebUIAddress = fmt.Sprintf("%s:%d", driverPod.Status.PodIP, app.Status.DriverInfo.WebUIPort)
+}
+
+// recordWebUIExternalAddress records the external address of the port of the given driver service targeting the
+// web UI as the UI ingress address, unless an Ingress was created for the UI.
+func recordWebUIExternalAddress(app *v1beta2.SparkApplication, service *corev1.Service, endpoints []v1beta2.DriverServiceEndpoint) {
+	if app.Status.DriverInfo.WebUIIngressName != "" {
+		return
+	}
+	uiPort := int(util.GetSparkUIPort(app))
+	for i, port := range service.Spec.Ports {
+		if port.TargetPort.IntValue() == uiPort && endpoints[i].ExternalAddress != "" {
+			app.Status.DriverInfo.WebUIIngressAddress = "http://" + endpoints[i].ExternalAddress
+			return
+		}
+	}
+}
+
+// getHistoryServerURL returns the URL of the given SparkApplication in the Spark History Server built from the given
+// format, or an empty string if no format is given or the Spark application ID is not known yet.
+func getHistoryServerURL(historyServerURLFormat string, app *v1beta2.SparkApplication) string {
+	if historyServerURLFormat == "" || app.Status.SparkApplicationID == "" {
+		return ""
+	}
+	historyServerURL := ingressAppNameURLRegex.ReplaceAllString(historyServerURLFormat, app.Name)
+	historyServerURL = ingressAppNamespaceURLRegex.ReplaceAllString(historyServerURL, app.Namespace)
+	return ingressAppIdURLRegex.ReplaceAllString(historyServerURL, app.Status.SparkApplicationID)
+}
+
 // getWebUITargetPort attempts to get the Spark web UI port from configuration property spark.ui.port
 // in Spec.SparkConf if it is present, otherwise the default port is returned.
 // Note that we don't attempt to get the port from Spec.SparkConfigMap.
 func getWebUITargetPort(app *v1beta2.SparkApplication) (int32, error) {
-	portStr, ok := app.Spec.SparkConf[common.SparkUIPortKey]
-	if !ok {
-		return common.DefaultSparkWebUIPort, nil
-	}
-	port, err := strconv.ParseInt(portStr, 10, 32)
-	if err != nil {
-		return common.DefaultSparkWebUIPort, nil
-	}
-	return int32(port), nil
+	return util.GetSparkUIPort(app), nil
 }
@@ -26,6 +26,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
@@ -59,6 +61,49 @@ func TestConfigWebUI(t *testing.T) {
 			app:      appBase.DeepCopy(),
 			wantConf: nil,
 		},
+		{
+			name: "spark ui disabled by default",
+			options: Options{
+				EnableUIService:  true,
+				DisableSparkUI:   true,
+				IngressURLFormat: "ingress.example.com/{{ $appNamespace }}/{{ $appName }}",
+			},
+			app: appBase.DeepCopy(),
+			wantConf: map[string]string{
+				common.SparkUIEnabled: "false",
+			},
+		},
+		{
+			name: "spark ui disabled for the application",
+			options: Options{
+				EnableUIService: true,
+			},
+			app: func() *v1beta2.SparkApplication {
+				app := appBase.DeepCopy()
+				app.Spec.Driver.UI = &v1beta2.DriverUISpec{Enabled: ptr.To(false)}
+				return app
+			}(),
+			wantConf: map[string]string{
+				common.SparkUIEnabled: "false",
+			},
+		},
+		{
+			name: "spark ui enabled for the application",
+			options: Options{
+				EnableUIService:  true,
+				DisableSparkUI:   true,
+				IngressURLFormat: "ingress.example.com/{{ $appNamespace }}/{{ $appName }}",
+			},
+			app: func() *v1beta2.SparkApplication {
+				app := appBase.DeepCopy()
+				app.Spec.Driver.UI = &v1beta2.DriverUISpec{Enabled: ptr.To(true)}
+				return app
+			}(),
+			wantConf: map[string]string{
+				common.SparkUIProxyBase:        "/default/test-app",
+				common.SparkUIProxyRedirectURI: "/",
+			},
+		},
 		{
 			name: "ingress format without path",
 			options: Options{
@@ -258,6 +303,146 @@ func TestGetWebUITargetPort(t *testing.T) {
 	}
 }
 
+func TestRecordDriverWebUIAddress(t *testing.T) {
+	driverPod := &corev1.Pod{Status: corev1.PodStatus{PodIP: "10.1.2.3"}}
+
+	tests := []struct {
+		name        string
+		options     Options
+		app         *v1beta2.SparkApplication
+		driverPod   *corev1.Pod
+		wantAddress string
+		wantPort    int32
+	}{
+		{
+			name:        "driver pod address without ui service",
+			app:         &v1beta2.SparkApplication{},
+			driverPod:   driverPod,
+			wantAddress: "10.1.2.3:4040",
+			wantPort:    common.DefaultSparkWebUIPort,
+		},
+		{
+			name: "driver pod address with custom ui port",
+			app: &v1beta2.SparkApplication{
+				Spec: v1beta2.SparkApplicationSpec{SparkConf: map[string]string{common.SparkUIPortKey: "4045"}},
+			},
+			driverPod:   driverPod,
+			wantAddress: "10.1.2.3:4045",
+			wantPort:    4045,
+		},
+		{
+			name: "ui service address is kept",
+			app: &v1beta2.SparkApplication{
+				Status: v1beta2.SparkApplicationStatus{
+					DriverInfo: v1beta2.DriverInfo{WebUIServiceName: "test-app-ui-svc", WebUIAddress: "10.96.0.10:4040", WebUIPort: 4040},
+				},
+			},
+			driverPod:   driverPod,
+			wantAddress: "10.96.0.10:4040",
+			wantPort:    4040,
+		},
+		{
+			name:      "driver pod without ip",
+			app:       &v1beta2.SparkApplication{},
+			driverPod: &corev1.Pod{},
+		},
+		{
+			name:      "ui disabled",
+			options:   Options{DisableSparkUI: true},
+			app:       &v1beta2.SparkApplication{},
+			driverPod: driverPod,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{options: tc.options}
+			r.recordDriverWebUIAddress(tc.app, tc.driverPod)
+			assert.Equal(t, tc.wantAddress, tc.app.Status.DriverInfo.WebUIAddress)
+			assert.Equal(t, tc.wantPort, tc.app.Status.DriverInfo.WebUIPort)
+		})
+	}
+}
+
+func TestRecordWebUIExternalAddress(t *testing.T) {
+	service := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "jdbc", Port: 10000, TargetPort: intstr.FromInt32(10000)},
+				{Name: "ui", Port: 80, TargetPort: intstr.FromInt32(common.DefaultSparkWebUIPort)},
+			},
+		},
+	}
+	endpoints := []v1beta2.DriverServiceEndpoint{
+		{Name: "jdbc", ExternalAddress: "test-app-jdbc.apps.example.com"},
+		{Name: "ui", ExternalAddress: "test-app-ui.apps.example.com"},
+	}
+
+	t.Run("external address of the port targeting the ui", func(t *testing.T) {
+		app := &v1beta2.SparkApplication{}
+		recordWebUIExternalAddress(app, service, endpoints)
+		assert.Equal(t, "http://test-app-ui.apps.example.com", app.Status.DriverInfo.WebUIIngressAddress)
+	})
+
+	t.Run("ui ingress address is kept", func(t *testing.T) {
+		app := &v1beta2.SparkApplication{
+			Status: v1beta2.SparkApplicationStatus{
+				DriverInfo: v1beta2.DriverInfo{WebUIIngressName: "test-app-ui-ingress", WebUIIngressAddress: "https://ingress.example.com/test-app"},
+			},
+		}
+		recordWebUIExternalAddress(app, service, endpoints)
+		assert.Equal(t, "https://ingress.example.com/test-app", app.Status.DriverInfo.WebUIIngressAddress)
+	})
+
+	t.Run("external address not provisioned yet", func(t *testing.T) {
+		app := &v1beta2.SparkApplication{}
+		recordWebUIExternalAddress(app, service, []v1beta2.DriverServiceEndpoint{{Name: "jdbc"}, {Name: "ui"}})
+		assert.Empty(t, app.Status.DriverInfo.WebUIIngressAddress)
+	})
+}
+
+func TestGetHistoryServerURL(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "test-ns"},
+		Status:     v1beta2.SparkApplicationStatus{SparkApplicationID: "spark-1234"},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		app    *v1beta2.SparkApplication
+		want   string
+	}{
+		{
+			name:   "application id placeholder",
+			format: "https://spark-history.example.com/history/{{$appId}}/jobs/",
+			app:    app,
+			want:   "https://spark-history.example.com/history/spark-1234/jobs/",
+		},
+		{
+			name:   "namespace and name placeholders",
+			format: "https://{{ $appNamespace }}-history.example.com/history/{{ $appId }}/?app={{ $appName }}",
+			app:    app,
+			want:   "https://test-ns-history.example.com/history/spark-1234/?app=test-app",
+		},
+		{
+			name: "no format",
+			app:  app,
+		},
+		{
+			name:   "application id not known yet",
+			format: "https://spark-history.example.com/history/{{$appId}}/jobs/",
+			app:    &v1beta2.SparkApplication{ObjectMeta: app.ObjectMeta},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, getHistoryServerURL(tc.format, tc.app))
+		})
+	}
+}
+
 func TestCreateWebUIService(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, corev1.AddToScheme(scheme))
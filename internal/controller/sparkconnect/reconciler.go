@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -40,9 +41,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 	"sigs.k8s.io/yaml"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
+	"github.com/kubeflow/spark-operator/v2/internal/sharding"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
@@ -55,6 +58,13 @@ const (
 type Options struct {
 	// A list of namespaces that should be watched.
 	Namespaces []string
+
+	// Shard restricts the controller to the namespaces owned by this operator instance. Nil disables sharding.
+	Shard *sharding.Shard
+
+	// ExecutorPodCache caches the metadata of executor pods when the operator only watches their metadata,
+	// in which case executor pods are listed from the API server. Nil watches executor pods through the manager cache.
+	ExecutorPodCache cache.Cache
 }
 
 // Reconciler reconciles a SparkConnect object.
@@ -93,7 +103,7 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 	// Use a custom log constructor.
 	options.LogConstructor = util.NewLogConstructor(mgr.GetLogger(), kind)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.SparkConnect{}).
 		Owns(
 			&corev1.ConfigMap{},
@@ -166,8 +176,21 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, options controller.Optio
 			),
 		).
 		WithEventFilter(util.NewNamespacePredicate(r.options.Namespaces)).
-		WithOptions(options).
-		Complete(r)
+		WithEventFilter(r.options.Shard.Predicate())
+
+	if r.options.ExecutorPodCache != nil {
+		pod := &metav1.PartialObjectMetadata{}
+		pod.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Pod"))
+		b = b.WatchesRawSource(source.Kind[client.Object](
+			r.options.ExecutorPodCache,
+			pod,
+			handler.EnqueueRequestsFromMapFunc(mapExecutorPodToSparkConnect),
+			util.NewNamespacePredicate(r.options.Namespaces),
+			r.options.Shard.Predicate(),
+		))
+	}
+
+	return b.WithOptions(options).Complete(r)
 }
 
 // +kubebuilder:rbac:groups=,resources=events,verbs=create;update;patch
@@ -585,8 +608,13 @@ func (r *Reconciler) listExecutorPods(ctx context.Context, conn *v1alpha1.SparkC
 		common.LabelSparkConnectName:        conn.Name,
 		common.LabelSparkRole:               common.SparkRoleExecutor,
 	}
+	// Executor pods are not in the manager cache when only their metadata is watched.
+	var reader client.Reader = r.client
+	if r.options.ExecutorPodCache != nil {
+		reader = r.manager.GetAPIReader()
+	}
 	pods := &corev1.PodList{}
-	if err := r.client.List(ctx,
+	if err := reader.List(ctx,
 		pods,
 		client.InNamespace(conn.Namespace),
 		client.MatchingLabels(labels),
@@ -595,3 +623,11 @@ func (r *Reconciler) listExecutorPods(ctx context.Context, conn *v1alpha1.SparkC
 	}
 	return pods, nil
 }
+
+func mapExecutorPodToSparkConnect(_ context.Context, obj client.Object) []reconcile.Request {
+	name := obj.GetLabels()[common.LabelSparkConnectName]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}}}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package diagnostics serves runtime diagnostics of the operator for live debugging of performance incidents:
+// pprof profiles, workqueue depths, informer cache sizes and the number of SparkApplications per namespace.
+package diagnostics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const (
+	// Path is the path prefix under which diagnostics are served.
+	Path = "/debug/"
+	// SnapshotPath is the path under which the Snapshot of the operator is served.
+	SnapshotPath = Path + "diagnostics"
+	// PprofPath is the path prefix under which the pprof profiles of the operator are served.
+	PprofPath = Path + "pprof/"
+)
+
+// workqueueDepthMetric is the name of the metric controller-runtime exports the depth of each workqueue with.
+const workqueueDepthMetric = "workqueue_depth"
+
+// Snapshot is the state of the operator at a point in time.
+type Snapshot struct {
+	// Time is when the snapshot was taken.
+	Time metav1.Time `json:"time"`
+	// Runtime describes the Go runtime of the operator.
+	Runtime RuntimeStats `json:"runtime"`
+	// WorkqueueDepths maps the name of each controller workqueue to the number of items waiting in it.
+	WorkqueueDepths map[string]int `json:"workqueueDepths"`
+	// CacheSizes maps each cached kind to the number of objects in the informer cache.
+	CacheSizes map[string]int `json:"cacheSizes"`
+	// Applications maps each namespace to the number of SparkApplications in it by application state.
+	Applications map[string]map[v1beta2.ApplicationStateType]int `json:"applications"`
+}
+
+// RuntimeStats describes the Go runtime of the operator.
+type RuntimeStats struct {
+	GoVersion      string `json:"goVersion"`
+	GOMAXPROCS     int    `json:"gomaxprocs"`
+	Goroutines     int    `json:"goroutines"`
+	HeapAllocBytes uint64 `json:"heapAllocBytes"`
+	HeapObjects    uint64 `json:"heapObjects"`
+	NumGC          uint32 `json:"numGC"`
+}
+
+// Handler serves the diagnostics of the operator under Path.
+type Handler struct {
+	mux      *http.ServeMux
+	reader   client.Reader
+	gatherer prometheus.Gatherer
+	lists    map[string]func() client.ObjectList
+}
+
+// Handler implements http.Handler.
+var _ http.Handler = &Handler{}
+
+// NewHandler creates a new Handler reading the cached objects from the given reader and the workqueue metrics from
+// the given gatherer. The cache sizes are reported for each kind of the given lists, which must only contain kinds
+// the operator already watches, since listing other kinds from the cache starts informers for them.
+func NewHandler(reader client.Reader, gatherer prometheus.Gatherer, lists map[string]func() client.ObjectList) *Handler {
+	h := &Handler{
+		mux:      http.NewServeMux(),
+		reader:   reader,
+		gatherer: gatherer,
+		lists:    lists,
+	}
+	h.mux.HandleFunc(SnapshotPath, h.serveSnapshot)
+	h.mux.HandleFunc(PprofPath, pprof.Index)
+	h.mux.HandleFunc(PprofPath+"cmdline", pprof.Cmdline)
+	h.mux.HandleFunc(PprofPath+"profile", pprof.Profile)
+	h.mux.HandleFunc(PprofPath+"symbol", pprof.Symbol)
+	h.mux.HandleFunc(PprofPath+"trace", pprof.Trace)
+	return h
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	h.mux.ServeHTTP(w, req)
+}
+
+func (h *Handler) serveSnapshot(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	snapshot, err := h.GetSnapshot(req.Context())
+	if err != nil {
+		log.FromContext(req.Context()).Error(err, "Failed to take diagnostics snapshot")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(snapshot)
+}
+
+// GetSnapshot takes a Snapshot of the operator.
+func (h *Handler) GetSnapshot(ctx context.Context) (*Snapshot, error) {
+	snapshot := &Snapshot{
+		Time:            metav1.NewTime(time.Now()),
+		Runtime:         getRuntimeStats(),
+		WorkqueueDepths: make(map[string]int),
+		CacheSizes:      make(map[string]int),
+		Applications:    make(map[string]map[v1beta2.ApplicationStateType]int),
+	}
+
+	families, err := h.gatherer.Gather()
+	if err != nil {
+		return nil, err
+	}
+	for _, family := range families {
+		if family.GetName() != workqueueDepthMetric {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" {
+					snapshot.WorkqueueDepths[label.GetValue()] = int(metric.GetGauge().GetValue())
+				}
+			}
+		}
+	}
+
+	for kind, newList := range h.lists {
+		list := newList()
+		if err := h.reader.List(ctx, list); err != nil {
+			return nil, err
+		}
+		snapshot.CacheSizes[kind] = meta.LenList(list)
+
+		apps, ok := list.(*v1beta2.SparkApplicationList)
+		if !ok {
+			continue
+		}
+		for _, app := range apps.Items {
+			states, ok := snapshot.Applications[app.Namespace]
+			if !ok {
+				states = make(map[v1beta2.ApplicationStateType]int)
+				snapshot.Applications[app.Namespace] = states
+			}
+			states[app.Status.AppState.State]++
+		}
+	}
+	return snapshot, nil
+}
+
+func getRuntimeStats() RuntimeStats {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	return RuntimeStats{
+		GoVersion:      runtime.Version(),
+		GOMAXPROCS:     runtime.GOMAXPROCS(0),
+		Goroutines:     runtime.NumGoroutine(),
+		HeapAllocBytes: memStats.HeapAlloc,
+		HeapObjects:    memStats.HeapObjects,
+		NumGC:          memStats.NumGC,
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func newTestApp(namespace, name string, state v1beta2.ApplicationStateType) *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status:     v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+	}
+}
+
+func newTestHandler(t *testing.T) *Handler {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newTestApp("team-a", "app-1", v1beta2.ApplicationStateRunning),
+		newTestApp("team-a", "app-2", v1beta2.ApplicationStateRunning),
+		newTestApp("team-a", "app-3", v1beta2.ApplicationStateSubmitted),
+		newTestApp("team-b", "app-1", v1beta2.ApplicationStateCompleted),
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "app-1-driver"}},
+	).Build()
+
+	registry := prometheus.NewRegistry()
+	depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueDepthMetric}, []string{"controller", "name"})
+	registry.MustRegister(depth)
+	depth.WithLabelValues("spark-application-controller", "spark-application-controller").Set(42)
+
+	return NewHandler(reader, registry, map[string]func() client.ObjectList{
+		"Pod":              func() client.ObjectList { return &corev1.PodList{} },
+		"SparkApplication": func() client.ObjectList { return &v1beta2.SparkApplicationList{} },
+	})
+}
+
+func TestServeSnapshot(t *testing.T) {
+	handler := newTestHandler(t)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, SnapshotPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	var snapshot Snapshot
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &snapshot))
+	assert.Equal(t, map[string]int{"spark-application-controller": 42}, snapshot.WorkqueueDepths)
+	assert.Equal(t, map[string]int{"Pod": 1, "SparkApplication": 4}, snapshot.CacheSizes)
+	assert.Equal(t, map[string]map[v1beta2.ApplicationStateType]int{
+		"team-a": {v1beta2.ApplicationStateRunning: 2, v1beta2.ApplicationStateSubmitted: 1},
+		"team-b": {v1beta2.ApplicationStateCompleted: 1},
+	}, snapshot.Applications)
+	assert.Positive(t, snapshot.Runtime.Goroutines)
+}
+
+func TestServeSnapshotMethodNotAllowed(t *testing.T) {
+	handler := newTestHandler(t)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, SnapshotPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
+
+func TestServePprof(t *testing.T) {
+	handler := newTestHandler(t)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PprofPath+"goroutine?debug=1", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "goroutine profile")
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package egress restricts the destinations of the HTTP requests the operator sends to URLs supplied by users,
+// e.g. HTTP hooks and the CloudEvents sinks of namespaces, which would otherwise reach any endpoint from the network
+// position of the operator, such as cloud metadata services or the Kubernetes API server.
+package egress
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// maxRedirects is the number of redirects followed by the clients of a Policy.
+const maxRedirects = 3
+
+// nonPublicPrefixes are the address ranges that are not reachable on the public internet, on top of the loopback,
+// private, link-local, multicast and unspecified addresses.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+}
+
+// Policy restricts the hosts requests may be sent to.
+type Policy struct {
+	// AllowedHosts are the host names, IP addresses or wildcard domains like *.example.com requests may be sent to.
+	// If empty, requests may be sent to any host that only resolves to public addresses.
+	AllowedHosts []string
+}
+
+// NewClient returns an HTTP client only sending requests allowed by the policy, including the ones of redirects,
+// with the given timeout. Proxies configured by the environment are not used, as they would be dialed instead of
+// the hosts the policy is enforced on.
+func (p *Policy) NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if p == nil || len(p.AllowedHosts) == 0 {
+		// Addresses are checked once resolved, so that host names cannot be pointed at internal addresses.
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			return checkAddress(address)
+		}
+	}
+	transport := &http.Transport{
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   timeout,
+		ResponseHeaderTimeout: timeout,
+		MaxIdleConnsPerHost:   2,
+		IdleConnTimeout:       90 * time.Second,
+	}
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &roundTripper{policy: p, next: transport},
+		CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
+// CheckURL returns an error if requests may not be sent to the given URL.
+func (p *Policy) CheckURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme of %s must be http or https", u.Redacted())
+	}
+	if p == nil || len(p.AllowedHosts) == 0 {
+		return nil
+	}
+	host := strings.ToLower(u.Hostname())
+	if slices.ContainsFunc(p.AllowedHosts, func(allowed string) bool { return matchHost(strings.ToLower(allowed), host) }) {
+		return nil
+	}
+	return fmt.Errorf("host %s is not allowed by the egress policy", host)
+}
+
+// matchHost returns whether the given host matches the given allowed host, which may be a wildcard domain.
+func matchHost(allowed, host string) bool {
+	if domain, ok := strings.CutPrefix(allowed, "*."); ok {
+		return strings.HasSuffix(host, "."+domain)
+	}
+	return allowed == host
+}
+
+// checkAddress returns an error if the given resolved address is not a public address.
+func checkAddress(address string) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	addr = addr.Unmap()
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() ||
+		slices.ContainsFunc(nonPublicPrefixes, func(prefix netip.Prefix) bool { return prefix.Contains(addr) }) {
+		return fmt.Errorf("address %s is not public and not allowed by the egress policy", addr)
+	}
+	return nil
+}
+
+// roundTripper checks the URL of every request, including the ones of redirects, against the policy.
+type roundTripper struct {
+	policy *Policy
+	next   http.RoundTripper
+}
+
+func (t *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.policy.CheckURL(req.URL); err != nil {
+		return nil, err
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package egress
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckURL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		policy  *Policy
+		url     string
+		wantErr string
+	}{
+		{
+			name: "any host without allowed hosts",
+			url:  "https://hooks.example.com/run",
+		},
+		{
+			name:    "unsupported scheme",
+			url:     "file:///etc/passwd",
+			wantErr: "scheme of file:///etc/passwd must be http or https",
+		},
+		{
+			name:   "allowed host",
+			policy: &Policy{AllowedHosts: []string{"hooks.example.com"}},
+			url:    "https://HOOKS.example.com:8443/run",
+		},
+		{
+			name:   "host matching a wildcard domain",
+			policy: &Policy{AllowedHosts: []string{"*.example.com"}},
+			url:    "https://ci.hooks.example.com/run",
+		},
+		{
+			name:    "wildcard domain does not match the domain itself",
+			policy:  &Policy{AllowedHosts: []string{"*.example.com"}},
+			url:     "https://example.com/run",
+			wantErr: "host example.com is not allowed by the egress policy",
+		},
+		{
+			name:    "host not allowed",
+			policy:  &Policy{AllowedHosts: []string{"hooks.example.com"}},
+			url:     "http://169.254.169.254/latest/meta-data",
+			wantErr: "host 169.254.169.254 is not allowed by the egress policy",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := url.Parse(tc.url)
+			require.NoError(t, err)
+			err = tc.policy.CheckURL(u)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCheckAddress(t *testing.T) {
+	for _, address := range []string{"8.8.8.8:443", "[2001:4860:4860::8888]:443"} {
+		assert.NoError(t, checkAddress(address), address)
+	}
+	for _, address := range []string{
+		"127.0.0.1:80",
+		"10.96.0.1:443",
+		"169.254.169.254:80",
+		"100.64.0.10:80",
+		"0.0.0.0:80",
+		"[::1]:80",
+		"[fd00::1]:80",
+		"[::ffff:127.0.0.1]:80",
+	} {
+		assert.Error(t, checkAddress(address), address)
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "http://metadata.internal/", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	// The test server listens on a loopback address, which is only reachable when allowed explicitly.
+	_, err := (&Policy{}).NewClient(time.Second).Get(server.URL)
+	assert.ErrorContains(t, err, "is not public and not allowed by the egress policy")
+
+	client := (&Policy{AllowedHosts: []string{"127.0.0.1"}}).NewClient(time.Second)
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = client.Get(server.URL + "/redirect")
+	assert.ErrorContains(t, err, "host metadata.internal is not allowed by the egress policy")
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// UserInfo describes the user a request was authenticated as.
+type UserInfo struct {
+	Username string
+	UID      string
+	Groups   []string
+	Extra    map[string][]string
+}
+
+// GetName returns the name of the user, or an empty string if the request was not authenticated.
+func (u *UserInfo) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Username
+}
+
+// Authenticator authenticates requests with the bearer token they carry and authorizes them against the RBAC
+// permissions of the user. Tokens are reviewed by the Kubernetes API server, so any token it accepts can be
+// used, i.e. ServiceAccount tokens as well as OIDC tokens if the API server is configured with an OIDC issuer.
+type Authenticator struct {
+	clientset kubernetes.Interface
+	audiences []string
+}
+
+// NewAuthenticator creates a new Authenticator. If audiences is not empty, tokens must be issued for at least
+// one of them, otherwise tokens must be issued for the API server.
+func NewAuthenticator(clientset kubernetes.Interface, audiences []string) *Authenticator {
+	return &Authenticator{
+		clientset: clientset,
+		audiences: audiences,
+	}
+}
+
+// Authenticate returns the user the given request is authenticated as with a TokenReview.
+func (a *Authenticator) Authenticate(r *http.Request) (*UserInfo, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || strings.TrimSpace(token) == "" {
+		return nil, errors.NewUnauthorized("missing bearer token")
+	}
+
+	review, err := a.clientset.AuthenticationV1().TokenReviews().Create(r.Context(), &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token:     strings.TrimSpace(token),
+			Audiences: a.audiences,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("failed to review token: %v", err))
+	}
+	if !review.Status.Authenticated {
+		message := "invalid bearer token"
+		if review.Status.Error != "" {
+			message = fmt.Sprintf("%s: %s", message, review.Status.Error)
+		}
+		return nil, errors.NewUnauthorized(message)
+	}
+
+	user := &UserInfo{
+		Username: review.Status.User.Username,
+		UID:      review.Status.User.UID,
+		Groups:   review.Status.User.Groups,
+	}
+	if len(review.Status.User.Extra) > 0 {
+		user.Extra = make(map[string][]string, len(review.Status.User.Extra))
+		for key, value := range review.Status.User.Extra {
+			user.Extra[key] = value
+		}
+	}
+	return user, nil
+}
+
+// Authorize returns an error unless the given user is allowed to access the given resource according to a
+// SubjectAccessReview.
+func (a *Authenticator) Authorize(ctx context.Context, user *UserInfo, attributes authorizationv1.ResourceAttributes) error {
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: &attributes,
+			User:               user.Username,
+			UID:                user.UID,
+			Groups:             user.Groups,
+		},
+	}
+	if len(user.Extra) > 0 {
+		review.Spec.Extra = make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+		for key, value := range user.Extra {
+			review.Spec.Extra[key] = value
+		}
+	}
+
+	result, err := a.clientset.AuthorizationV1().SubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("failed to review access: %v", err))
+	}
+	if !result.Status.Allowed || result.Status.Denied {
+		resource := schema.GroupResource{Group: attributes.Group, Resource: attributes.Resource}
+		if attributes.Subresource != "" {
+			resource.Resource += "/" + attributes.Subresource
+		}
+		return errors.NewForbidden(resource, attributes.Name, fmt.Errorf("user %q cannot %s it in namespace %q", user.Username, attributes.Verb, attributes.Namespace))
+	}
+	return nil
+}
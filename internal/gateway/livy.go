@@ -0,0 +1,480 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+const (
+	// LivyPrefix is the path prefix of the Livy batch API served by the gateway. Livy clients are pointed at
+	// the namespace, e.g. http://spark-operator-gateway-svc:8080/livy/namespaces/spark.
+	LivyPrefix = "/livy/namespaces/{namespace}/batches"
+
+	// livyMaxCreateAttempts is how many batch IDs are tried when concurrent submissions race for the same one.
+	livyMaxCreateAttempts = 5
+
+	// livyDefaultLogSize is the number of log lines returned if the size query parameter is not set.
+	livyDefaultLogSize = 100
+
+	// livyMaxLogSize is the maximum number of log lines returned at once.
+	livyMaxLogSize = 10000
+)
+
+// LivyOptions configures the Livy batch API of the gateway. Livy batches do not describe the container image
+// and Spark version they run with, so they are taken from the options.
+type LivyOptions struct {
+	// Image is the container image of the driver and executors.
+	Image string
+	// SparkVersion is the version of Spark in the image.
+	SparkVersion string
+	// ServiceAccount is the name of the service account of the driver.
+	ServiceAccount string
+}
+
+// livyBatchRequest is the body of a Livy batch submission.
+// See https://livy.apache.org/docs/latest/rest-api.html#post-batches.
+type livyBatchRequest struct {
+	File           string            `json:"file"`
+	ProxyUser      *string           `json:"proxyUser,omitempty"`
+	ClassName      *string           `json:"className,omitempty"`
+	Args           []string          `json:"args,omitempty"`
+	Jars           []string          `json:"jars,omitempty"`
+	PyFiles        []string          `json:"pyFiles,omitempty"`
+	Files          []string          `json:"files,omitempty"`
+	DriverMemory   *string           `json:"driverMemory,omitempty"`
+	DriverCores    *int32            `json:"driverCores,omitempty"`
+	ExecutorMemory *string           `json:"executorMemory,omitempty"`
+	ExecutorCores  *int32            `json:"executorCores,omitempty"`
+	NumExecutors   *int32            `json:"numExecutors,omitempty"`
+	Archives       []string          `json:"archives,omitempty"`
+	Queue          *string           `json:"queue,omitempty"`
+	Name           *string           `json:"name,omitempty"`
+	Conf           map[string]string `json:"conf,omitempty"`
+}
+
+// livyBatch is the Livy representation of a batch.
+// See https://livy.apache.org/docs/latest/rest-api.html#batch.
+type livyBatch struct {
+	ID        int                `json:"id"`
+	Name      *string            `json:"name"`
+	Owner     *string            `json:"owner"`
+	ProxyUser *string            `json:"proxyUser"`
+	State     string             `json:"state"`
+	AppID     *string            `json:"appId"`
+	AppInfo   map[string]*string `json:"appInfo"`
+	Log       []string           `json:"log"`
+}
+
+// livyBatchList is the response of a Livy batch listing.
+type livyBatchList struct {
+	From     int         `json:"from"`
+	Total    int         `json:"total"`
+	Sessions []livyBatch `json:"sessions"`
+}
+
+// livyBatchState is the response of a Livy batch state request.
+type livyBatchState struct {
+	ID    int    `json:"id"`
+	State string `json:"state"`
+}
+
+// livyBatchLog is the response of a Livy batch log request.
+type livyBatchLog struct {
+	ID    int      `json:"id"`
+	From  int      `json:"from"`
+	Total int      `json:"total"`
+	Log   []string `json:"log"`
+}
+
+func (s *Server) registerLivyHandlers(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+LivyPrefix, s.handle("list", "", s.listLivyBatches))
+	mux.HandleFunc("POST "+LivyPrefix, s.handle("create", "", s.createLivyBatch))
+	mux.HandleFunc("GET "+LivyPrefix+"/{batchId}", s.handle("get", "", s.getLivyBatch))
+	mux.HandleFunc("GET "+LivyPrefix+"/{batchId}/state", s.handle("get", "", s.getLivyBatchState))
+	mux.HandleFunc("DELETE "+LivyPrefix+"/{batchId}", s.handle("delete", "", s.deleteLivyBatch))
+	mux.HandleFunc("GET "+LivyPrefix+"/{batchId}/log", s.handle("get", "log", s.getLivyBatchLog))
+}
+
+func (s *Server) listLivyBatches(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	from, err := getIntQueryParameter(r, "from", 0)
+	if err != nil {
+		return err
+	}
+	size, err := getIntQueryParameter(r, "size", livyDefaultLogSize)
+	if err != nil {
+		return err
+	}
+
+	apps, err := s.listLivyApps(r.Context(), namespace)
+	if err != nil {
+		return err
+	}
+
+	list := livyBatchList{From: from, Total: len(apps), Sessions: []livyBatch{}}
+	for _, app := range window(apps, from, size) {
+		list.Sessions = append(list.Sessions, newLivyBatch(&app))
+	}
+	writeJSON(w, http.StatusOK, list)
+	return nil
+}
+
+// createLivyBatch submits a Livy batch as a SparkApplication named after the batch ID, which is one more than
+// the highest batch ID in the namespace.
+func (s *Server) createLivyBatch(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error {
+	request := &livyBatchRequest{}
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestBodySize)).Decode(request); err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("invalid batch: %v", err))
+	}
+	if request.File == "" {
+		return errors.NewBadRequest("file of the batch must be set")
+	}
+
+	for attempt := 0; attempt < livyMaxCreateAttempts; attempt++ {
+		apps, err := s.listLivyApps(r.Context(), namespace)
+		if err != nil {
+			return err
+		}
+		id := 0
+		if len(apps) > 0 {
+			id = getLivyBatchID(&apps[len(apps)-1]) + 1
+		}
+
+		app := s.newLivyApp(request, namespace, id, user)
+		if err := s.client.Create(r.Context(), app); err != nil {
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			return err
+		}
+		logger.Info("Submitted Livy batch", "id", id, "name", app.Name, "namespace", app.Namespace, "user", user.GetName())
+		writeJSON(w, http.StatusCreated, newLivyBatch(app))
+		return nil
+	}
+	return errors.NewConflict(v1beta2.Resource("sparkapplications"), "", fmt.Errorf("failed to allocate a batch ID after %d attempts", livyMaxCreateAttempts))
+}
+
+func (s *Server) getLivyBatch(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	app, err := s.getLivyApp(r.Context(), namespace, r.PathValue("batchId"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, newLivyBatch(app))
+	return nil
+}
+
+func (s *Server) getLivyBatchState(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	app, err := s.getLivyApp(r.Context(), namespace, r.PathValue("batchId"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, livyBatchState{ID: getLivyBatchID(app), State: getLivyState(app)})
+	return nil
+}
+
+func (s *Server) deleteLivyBatch(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error {
+	app, err := s.getLivyApp(r.Context(), namespace, r.PathValue("batchId"))
+	if err != nil {
+		return err
+	}
+	if err := s.client.Delete(r.Context(), app, client.Preconditions{UID: &app.UID}); err != nil {
+		return err
+	}
+	logger.Info("Killed Livy batch", "id", getLivyBatchID(app), "name", app.Name, "namespace", app.Namespace, "user", user.GetName())
+	writeJSON(w, http.StatusOK, map[string]string{"msg": "deleted"})
+	return nil
+}
+
+// getLivyBatchLog returns the driver log lines of a batch. Like Livy, the last lines are returned if the from
+// query parameter is not set.
+func (s *Server) getLivyBatchLog(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	size, err := getIntQueryParameter(r, "size", livyDefaultLogSize)
+	if err != nil {
+		return err
+	}
+
+	app, err := s.getLivyApp(r.Context(), namespace, r.PathValue("batchId"))
+	if err != nil {
+		return err
+	}
+
+	// Without from, the last size lines are returned.
+	from := -1
+	if r.URL.Query().Has("from") {
+		if from, err = getIntQueryParameter(r, "from", 0); err != nil {
+			return err
+		}
+	}
+
+	var lines []string
+	var total int
+	stream, err := s.streamLogs(r.Context(), app, "", &corev1.PodLogOptions{})
+	switch {
+	case errors.IsNotFound(err) || errors.IsBadRequest(err):
+		// The driver is not running yet, or its container is still being created.
+	case err != nil:
+		return err
+	default:
+		defer stream.Close()
+		if from, lines, total, err = readLivyLog(stream, from, min(size, livyMaxLogSize)); err != nil {
+			return err
+		}
+	}
+
+	writeJSON(w, http.StatusOK, livyBatchLog{
+		ID:    getLivyBatchID(app),
+		From:  max(from, 0),
+		Total: total,
+		Log:   append([]string{}, lines...),
+	})
+	return nil
+}
+
+// readLivyLog reads the given log and returns the size lines starting at the line from, or the last size lines
+// if from is negative, along with the index of the first returned line and the total number of lines. The whole
+// log is read to count its lines, but only the returned lines are kept in memory.
+func readLivyLog(log io.Reader, from int, size int) (int, []string, int, error) {
+	var lines []string
+	total := 0
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		switch {
+		case size <= 0:
+		case from < 0:
+			if len(lines) == size {
+				lines = lines[1:]
+			}
+			lines = append(lines, scanner.Text())
+		case total >= from && total < from+size:
+			lines = append(lines, scanner.Text())
+		}
+		total++
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, nil, 0, err
+	}
+	if from < 0 {
+		from = max(total-size, 0)
+	}
+	return from, lines, total, nil
+}
+
+// listLivyApps returns the SparkApplications submitted as Livy batches in the given namespace, sorted by batch ID.
+func (s *Server) listLivyApps(ctx context.Context, namespace string) ([]v1beta2.SparkApplication, error) {
+	apps := &v1beta2.SparkApplicationList{}
+	if err := s.client.List(ctx, apps, client.InNamespace(namespace), client.HasLabels{common.LabelLivyBatchID}); err != nil {
+		return nil, err
+	}
+	items := slices.DeleteFunc(apps.Items, func(app v1beta2.SparkApplication) bool {
+		return getLivyBatchID(&app) < 0
+	})
+	slices.SortFunc(items, func(a, b v1beta2.SparkApplication) int {
+		return getLivyBatchID(&a) - getLivyBatchID(&b)
+	})
+	return items, nil
+}
+
+func (s *Server) getLivyApp(ctx context.Context, namespace string, batchID string) (*v1beta2.SparkApplication, error) {
+	id, err := strconv.Atoi(batchID)
+	if err != nil || id < 0 {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid batch ID %q", batchID))
+	}
+	app, err := s.getApp(ctx, namespace, getLivyAppName(id))
+	if err != nil {
+		return nil, err
+	}
+	if getLivyBatchID(app) != id {
+		return nil, errors.NewNotFound(v1beta2.Resource("sparkapplications"), app.Name)
+	}
+	return app, nil
+}
+
+// newLivyApp returns the SparkApplication running the given Livy batch.
+func (s *Server) newLivyApp(request *livyBatchRequest, namespace string, id int, user *UserInfo) *v1beta2.SparkApplication {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getLivyAppName(id),
+			Namespace: namespace,
+			Labels: map[string]string{
+				common.LabelLivyBatchID: strconv.Itoa(id),
+			},
+			Annotations: map[string]string{},
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Type:                getLivyApplicationType(request.File),
+			SparkVersion:        s.livy.SparkVersion,
+			Mode:                v1beta2.DeployModeCluster,
+			ProxyUser:           request.ProxyUser,
+			MainClass:           request.ClassName,
+			MainApplicationFile: ptr.To(request.File),
+			Arguments:           request.Args,
+			SparkConf:           request.Conf,
+			Deps: v1beta2.Dependencies{
+				Jars:     request.Jars,
+				Files:    request.Files,
+				PyFiles:  request.PyFiles,
+				Archives: request.Archives,
+			},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Cores:  request.DriverCores,
+					Memory: request.DriverMemory,
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Cores:  request.ExecutorCores,
+					Memory: request.ExecutorMemory,
+				},
+				Instances: request.NumExecutors,
+			},
+		},
+	}
+	if s.livy.Image != "" {
+		app.Spec.Image = ptr.To(s.livy.Image)
+	}
+	if s.livy.ServiceAccount != "" {
+		app.Spec.Driver.ServiceAccount = ptr.To(s.livy.ServiceAccount)
+	}
+	if request.Queue != nil {
+		app.Spec.BatchSchedulerOptions = &v1beta2.BatchSchedulerConfiguration{Queue: request.Queue}
+	}
+	if request.Name != nil {
+		app.Annotations[common.AnnotationLivyBatchName] = *request.Name
+	}
+	if user != nil {
+		app.Annotations[common.AnnotationSubmittedBy] = user.Username
+	}
+	return app
+}
+
+// newLivyBatch returns the Livy representation of the given SparkApplication.
+func newLivyBatch(app *v1beta2.SparkApplication) livyBatch {
+	batch := livyBatch{
+		ID:        getLivyBatchID(app),
+		ProxyUser: app.Spec.ProxyUser,
+		State:     getLivyState(app),
+		AppInfo: map[string]*string{
+			"driverLogUrl": nil,
+			"sparkUiUrl":   nil,
+		},
+		Log: []string{},
+	}
+	if name, ok := app.Annotations[common.AnnotationLivyBatchName]; ok {
+		batch.Name = ptr.To(name)
+	}
+	if owner, ok := app.Annotations[common.AnnotationSubmittedBy]; ok {
+		batch.Owner = ptr.To(owner)
+	}
+	if app.Status.SparkApplicationID != "" {
+		batch.AppID = ptr.To(app.Status.SparkApplicationID)
+	}
+	if app.Status.DriverInfo.WebUIIngressAddress != "" {
+		batch.AppInfo["sparkUiUrl"] = ptr.To(app.Status.DriverInfo.WebUIIngressAddress)
+	}
+	if app.Status.AppState.ErrorMessage != "" {
+		batch.Log = append(batch.Log, app.Status.AppState.ErrorMessage)
+	}
+	return batch
+}
+
+// getLivyState returns the Livy batch state corresponding to the state of the given SparkApplication.
+// See https://livy.apache.org/docs/latest/rest-api.html#session-state.
+func getLivyState(app *v1beta2.SparkApplication) string {
+	switch app.Status.AppState.State {
+	case v1beta2.ApplicationStateNew, v1beta2.ApplicationStateQuotaWait:
+		return "not_started"
+	case v1beta2.ApplicationStateSubmitted, v1beta2.ApplicationStatePendingRerun, v1beta2.ApplicationStateInvalidating,
+		v1beta2.ApplicationStateResuming:
+		return "starting"
+	case v1beta2.ApplicationStateRunning:
+		return "running"
+	case v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing, v1beta2.ApplicationStateSuspending:
+		return "shutting_down"
+	case v1beta2.ApplicationStateSuspended:
+		return "idle"
+	case v1beta2.ApplicationStateCompleted:
+		return "success"
+	case v1beta2.ApplicationStateFailed, v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
+		return "dead"
+	default:
+		return "error"
+	}
+}
+
+func getLivyApplicationType(file string) v1beta2.SparkApplicationType {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".py":
+		return v1beta2.SparkApplicationTypePython
+	case ".r":
+		return v1beta2.SparkApplicationTypeR
+	default:
+		return v1beta2.SparkApplicationTypeScala
+	}
+}
+
+func getLivyAppName(id int) string {
+	return fmt.Sprintf("livy-batch-%d", id)
+}
+
+// getLivyBatchID returns the Livy batch ID of the given SparkApplication, or -1 if it is not a Livy batch.
+func getLivyBatchID(app *v1beta2.SparkApplication) int {
+	id, err := strconv.Atoi(app.Labels[common.LabelLivyBatchID])
+	if err != nil || id < 0 {
+		return -1
+	}
+	return id
+}
+
+func getIntQueryParameter(r *http.Request, name string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil || i < 0 {
+		return 0, errors.NewBadRequest(fmt.Sprintf("invalid %s %q", name, value))
+	}
+	return i, nil
+}
+
+// window returns at most size items of the given slice starting at from.
+func window[T any](items []T, from int, size int) []T {
+	if from >= len(items) {
+		return nil
+	}
+	return items[from:min(from+size, len(items))]
+}
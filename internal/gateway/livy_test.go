@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestLivy(t *testing.T) {
+	driver := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "livy-batch-1-driver", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: common.SparkDriverContainerName}}},
+	}
+	server, _ := newTestServer(t, false, nil, &LivyOptions{Image: "spark:3.5.5", SparkVersion: "3.5.5"}, driver)
+	url := server.URL + "/livy/namespaces/default/batches"
+
+	response, body := doRequest(t, http.MethodGet, server.URL+"/livy/namespaces/default/batches", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.JSONEq(t, `{"from":0,"total":0,"sessions":[]}`, body)
+
+	response, body = doRequest(t, http.MethodPost, url, "", `{"file":"local:///opt/spark/examples/jars/spark-examples.jar","className":"org.apache.spark.examples.SparkPi","numExecutors":2}`)
+	require.Equal(t, http.StatusCreated, response.StatusCode, body)
+	batch := &livyBatch{}
+	require.NoError(t, json.Unmarshal([]byte(body), batch))
+	assert.Equal(t, 0, batch.ID)
+	assert.Equal(t, "not_started", batch.State)
+
+	response, body = doRequest(t, http.MethodPost, url, "", `{"file":"s3a://bucket/pi.py","name":"pi","args":["10"],"conf":{"spark.eventLog.enabled":"true"}}`)
+	require.Equal(t, http.StatusCreated, response.StatusCode, body)
+	require.NoError(t, json.Unmarshal([]byte(body), batch))
+	assert.Equal(t, 1, batch.ID)
+	assert.Equal(t, ptr.To("pi"), batch.Name)
+
+	response, body = doRequest(t, http.MethodPost, url, "", `{"className":"org.apache.spark.examples.SparkPi"}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"?from=1", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	list := &livyBatchList{}
+	require.NoError(t, json.Unmarshal([]byte(body), list))
+	assert.Equal(t, 2, list.Total)
+	require.Len(t, list.Sessions, 1)
+	assert.Equal(t, 1, list.Sessions[0].ID)
+
+	response, body = doRequest(t, http.MethodGet, url+"/1/state", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.JSONEq(t, `{"id":1,"state":"not_started"}`, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/1/log", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.JSONEq(t, `{"id":1,"from":0,"total":1,"log":["fake logs"]}`, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/0/log", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.JSONEq(t, `{"id":0,"from":0,"total":0,"log":[]}`, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/x", "", "")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodDelete, url+"/0", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.JSONEq(t, `{"msg":"deleted"}`, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/0", "", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode, body)
+}
+
+func TestNewLivyApp(t *testing.T) {
+	server := NewServer(nil, nil, nil, nil, &LivyOptions{Image: "spark:3.5.5", SparkVersion: "3.5.5", ServiceAccount: "spark"})
+	app := server.newLivyApp(&livyBatchRequest{
+		File:           "s3a://bucket/pi.py",
+		PyFiles:        []string{"s3a://bucket/deps.zip"},
+		DriverMemory:   ptr.To("1g"),
+		ExecutorMemory: ptr.To("2g"),
+		ExecutorCores:  ptr.To[int32](2),
+		NumExecutors:   ptr.To[int32](3),
+		Queue:          ptr.To("batch"),
+	}, "spark", 7, &UserInfo{Username: "alice"})
+
+	assert.Equal(t, "livy-batch-7", app.Name)
+	assert.Equal(t, "7", app.Labels[common.LabelLivyBatchID])
+	assert.Equal(t, "alice", app.Annotations[common.AnnotationSubmittedBy])
+	assert.Equal(t, v1beta2.SparkApplicationTypePython, app.Spec.Type)
+	assert.Equal(t, ptr.To("spark:3.5.5"), app.Spec.Image)
+	assert.Equal(t, "3.5.5", app.Spec.SparkVersion)
+	assert.Equal(t, ptr.To("spark"), app.Spec.Driver.ServiceAccount)
+	assert.Equal(t, ptr.To("1g"), app.Spec.Driver.Memory)
+	assert.Equal(t, ptr.To("2g"), app.Spec.Executor.Memory)
+	assert.Equal(t, ptr.To[int32](2), app.Spec.Executor.Cores)
+	assert.Equal(t, ptr.To[int32](3), app.Spec.Executor.Instances)
+	assert.Equal(t, []string{"s3a://bucket/deps.zip"}, app.Spec.Deps.PyFiles)
+	assert.Equal(t, ptr.To("batch"), app.Spec.BatchSchedulerOptions.Queue)
+}
+
+func TestGetLivyState(t *testing.T) {
+	testCases := []struct {
+		state    v1beta2.ApplicationStateType
+		expected string
+	}{
+		{state: v1beta2.ApplicationStateNew, expected: "not_started"},
+		{state: v1beta2.ApplicationStateSubmitted, expected: "starting"},
+		{state: v1beta2.ApplicationStateRunning, expected: "running"},
+		{state: v1beta2.ApplicationStateSucceeding, expected: "shutting_down"},
+		{state: v1beta2.ApplicationStateCompleted, expected: "success"},
+		{state: v1beta2.ApplicationStateFailedSubmission, expected: "dead"},
+		{state: v1beta2.ApplicationStateUnknown, expected: "error"},
+	}
+
+	for _, tc := range testCases {
+		app := &v1beta2.SparkApplication{Status: v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: tc.state}}}
+		assert.Equal(t, tc.expected, getLivyState(app), tc.state)
+	}
+}
+
+func TestReadLivyLog(t *testing.T) {
+	var log strings.Builder
+	for i := range 10 {
+		fmt.Fprintf(&log, "line %d\n", i)
+	}
+
+	testCases := []struct {
+		name      string
+		from      int
+		size      int
+		wantFrom  int
+		wantLines []string
+	}{
+		{
+			name:      "last lines",
+			from:      -1,
+			size:      3,
+			wantFrom:  7,
+			wantLines: []string{"line 7", "line 8", "line 9"},
+		},
+		{
+			name:      "lines from",
+			from:      2,
+			size:      3,
+			wantFrom:  2,
+			wantLines: []string{"line 2", "line 3", "line 4"},
+		},
+		{
+			name:     "lines from beyond the log",
+			from:     20,
+			size:     3,
+			wantFrom: 20,
+		},
+		{
+			name:      "window larger than the log",
+			from:      -1,
+			size:      100,
+			wantFrom:  0,
+			wantLines: strings.Split(strings.TrimSuffix(log.String(), "\n"), "\n"),
+		},
+		{
+			name:     "empty window",
+			from:     -1,
+			size:     0,
+			wantFrom: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			from, lines, total, err := readLivyLog(strings.NewReader(log.String()), tc.from, tc.size)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantFrom, from)
+			assert.Equal(t, tc.wantLines, lines)
+			assert.Equal(t, 10, total)
+			assert.LessOrEqual(t, cap(lines), 2*tc.size)
+		})
+	}
+}
@@ -0,0 +1,397 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var (
+	logger = ctrl.Log.WithName("gateway")
+)
+
+const (
+	// APIPrefix is the path prefix of the SparkApplication resources served by the gateway.
+	APIPrefix = "/v1/namespaces/{namespace}/sparkapplications"
+
+	// maxRequestBodySize is the maximum size of a submitted SparkApplication manifest.
+	maxRequestBodySize = 3 * 1024 * 1024
+)
+
+// Server serves a REST API to submit, list, kill and fetch the status and logs of SparkApplications,
+// for clients that cannot talk to the Kubernetes API directly, e.g. Livy-style workflows. Requests are
+// authenticated with bearer tokens and authorized against the RBAC permissions of their user.
+type Server struct {
+	client     client.Client
+	clientset  kubernetes.Interface
+	auth       *Authenticator
+	namespaces []string
+	livy       *LivyOptions
+}
+
+// NewServer creates a new Server. The client is used to manage SparkApplications and the clientset to stream
+// pod logs. If namespaces is empty or contains the empty string, SparkApplications of every namespace are served.
+// Requests are not authenticated if auth is nil. The Livy batch API is only served if livy is not nil.
+func NewServer(client client.Client, clientset kubernetes.Interface, auth *Authenticator, namespaces []string, livy *LivyOptions) *Server {
+	if slices.Contains(namespaces, "") {
+		namespaces = nil
+	}
+	return &Server{
+		client:     client,
+		clientset:  clientset,
+		auth:       auth,
+		namespaces: namespaces,
+		livy:       livy,
+	}
+}
+
+// Handler returns the http.Handler serving the gateway API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+APIPrefix, s.handle("list", "", s.listSparkApplications))
+	mux.HandleFunc("POST "+APIPrefix, s.handle("create", "", s.createSparkApplication))
+	mux.HandleFunc("GET "+APIPrefix+"/{name}", s.handle("get", "", s.getSparkApplication))
+	mux.HandleFunc("GET "+APIPrefix+"/{name}/status", s.handle("get", "", s.getSparkApplicationStatus))
+	mux.HandleFunc("DELETE "+APIPrefix+"/{name}", s.handle("delete", "", s.deleteSparkApplication))
+	mux.HandleFunc("POST "+APIPrefix+"/{name}/terminate", s.handle("patch", "", s.terminateSparkApplication))
+	mux.HandleFunc("GET "+APIPrefix+"/{name}/logs", s.handle("get", "log", s.getSparkApplicationLogs))
+	if s.livy != nil {
+		s.registerLivyHandlers(mux)
+	}
+	return mux
+}
+
+// handlerFunc handles an authorized request for the SparkApplications in the given namespace.
+type handlerFunc func(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error
+
+// handle wraps the given handler with the namespace, authentication and authorization checks of the request.
+// The user must be allowed to perform the given verb on SparkApplications, or on the pods/log subresource
+// if subresource is log.
+func (s *Server) handle(verb string, subresource string, handler handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		namespace := r.PathValue("namespace")
+		if s.namespaces != nil && !slices.Contains(s.namespaces, namespace) {
+			writeError(w, errors.NewForbidden(v1beta2.Resource("sparkapplications"), r.PathValue("name"),
+				fmt.Errorf("namespace %s is not managed by the gateway", namespace)))
+			return
+		}
+
+		var user *UserInfo
+		if s.auth != nil {
+			var err error
+			user, err = s.auth.Authenticate(r)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+
+			resource := "sparkapplications"
+			group := v1beta2.GroupVersion.Group
+			name := r.PathValue("name")
+			// Logs are read from the driver or executor pods, whose names are only known later on.
+			if subresource == "log" {
+				resource = "pods"
+				group = ""
+				name = ""
+			}
+			if err := s.auth.Authorize(r.Context(), user, authorizationv1.ResourceAttributes{
+				Namespace:   namespace,
+				Verb:        verb,
+				Group:       group,
+				Resource:    resource,
+				Subresource: subresource,
+				Name:        name,
+			}); err != nil {
+				writeError(w, err)
+				return
+			}
+		}
+
+		if err := handler(w, r, namespace, user); err != nil {
+			writeError(w, err)
+		}
+	}
+}
+
+func (s *Server) listSparkApplications(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err))
+	}
+
+	apps := &v1beta2.SparkApplicationList{}
+	if err := s.client.List(r.Context(), apps, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, apps)
+	return nil
+}
+
+func (s *Server) createSparkApplication(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error {
+	app := &v1beta2.SparkApplication{}
+	decoder := yaml.NewYAMLOrJSONDecoder(io.LimitReader(r.Body, maxRequestBodySize), 4096)
+	if err := decoder.Decode(app); err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("invalid SparkApplication: %v", err))
+	}
+	if app.Namespace != "" && app.Namespace != namespace {
+		return errors.NewBadRequest(fmt.Sprintf("namespace %s of the SparkApplication does not match namespace %s of the request", app.Namespace, namespace))
+	}
+	app.Namespace = namespace
+	app.ResourceVersion = ""
+
+	// The SparkApplication is created by the gateway on behalf of the user, so record who submitted it.
+	if user != nil {
+		if app.Annotations == nil {
+			app.Annotations = make(map[string]string)
+		}
+		app.Annotations[common.AnnotationSubmittedBy] = user.Username
+	}
+
+	if err := s.client.Create(r.Context(), app); err != nil {
+		return err
+	}
+	logger.Info("Submitted SparkApplication", "name", app.Name, "namespace", app.Namespace, "user", user.GetName())
+	writeJSON(w, http.StatusCreated, app)
+	return nil
+}
+
+func (s *Server) getSparkApplication(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	app, err := s.getApp(r.Context(), namespace, r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, app)
+	return nil
+}
+
+func (s *Server) getSparkApplicationStatus(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	app, err := s.getApp(r.Context(), namespace, r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, app.Status)
+	return nil
+}
+
+// deleteSparkApplication kills a SparkApplication. Deleting it makes the controller delete its driver and executors.
+func (s *Server) deleteSparkApplication(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error {
+	app, err := s.getApp(r.Context(), namespace, r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+	if err := s.client.Delete(r.Context(), app, client.Preconditions{UID: &app.UID}); err != nil {
+		return err
+	}
+	logger.Info("Killed SparkApplication", "name", app.Name, "namespace", app.Namespace, "user", user.GetName())
+	writeJSON(w, http.StatusOK, app.Status)
+	return nil
+}
+
+// terminateRequest is the body of a request to cancel a SparkApplication.
+type terminateRequest struct {
+	Reason             string `json:"reason,omitempty"`
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+}
+
+// terminateSparkApplication cancels a SparkApplication with the reason and grace period of the request. Unlike
+// deleting it, the application is kept along with why it was cancelled. The user who cancelled it is logged.
+func (s *Server) terminateSparkApplication(w http.ResponseWriter, r *http.Request, namespace string, user *UserInfo) error {
+	request := &terminateRequest{}
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestBodySize)).Decode(request); err != nil {
+			return errors.NewBadRequest(fmt.Sprintf("invalid terminate request: %v", err))
+		}
+	}
+	if request.GracePeriodSeconds != nil && *request.GracePeriodSeconds < 0 {
+		return errors.NewBadRequest(fmt.Sprintf("invalid gracePeriodSeconds %d", *request.GracePeriodSeconds))
+	}
+
+	app, err := s.getApp(r.Context(), namespace, r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+	if app.Spec.Terminate != nil {
+		return errors.NewConflict(v1beta2.Resource("sparkapplications"), app.Name, fmt.Errorf("cancellation already requested"))
+	}
+	patch := client.MergeFromWithOptions(app.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	// The requester of the cancellation is recorded by the webhook as the user of the gateway.
+	app.Spec.Terminate = &v1beta2.TerminateSpec{
+		Reason:             request.Reason,
+		GracePeriodSeconds: request.GracePeriodSeconds,
+	}
+	if err := s.client.Patch(r.Context(), app, patch); err != nil {
+		return err
+	}
+	logger.Info("Cancelled SparkApplication", "name", app.Name, "namespace", app.Namespace, "user", user.GetName(), "reason", request.Reason)
+	writeJSON(w, http.StatusAccepted, app.Status)
+	return nil
+}
+
+// getSparkApplicationLogs streams the logs of the driver, or of the executor given by the executorId query
+// parameter. The follow and tailLines query parameters behave like the ones of `kubectl logs`.
+func (s *Server) getSparkApplicationLogs(w http.ResponseWriter, r *http.Request, namespace string, _ *UserInfo) error {
+	query := r.URL.Query()
+	options := &corev1.PodLogOptions{}
+	if follow := query.Get("follow"); follow != "" {
+		value, err := strconv.ParseBool(follow)
+		if err != nil {
+			return errors.NewBadRequest(fmt.Sprintf("invalid follow %q: %v", follow, err))
+		}
+		options.Follow = value
+	}
+	if tailLines := query.Get("tailLines"); tailLines != "" {
+		value, err := strconv.ParseInt(tailLines, 10, 64)
+		if err != nil || value < 0 {
+			return errors.NewBadRequest(fmt.Sprintf("invalid tailLines %q", tailLines))
+		}
+		options.TailLines = &value
+	}
+
+	app, err := s.getApp(r.Context(), namespace, r.PathValue("name"))
+	if err != nil {
+		return err
+	}
+
+	stream, err := s.streamLogs(r.Context(), app, query.Get("executorId"), options)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(&flushWriter{w: w}, stream)
+	return nil
+}
+
+func (s *Server) getApp(ctx context.Context, namespace string, name string) (*v1beta2.SparkApplication, error) {
+	app := &v1beta2.SparkApplication{}
+	if err := s.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, app); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// streamLogs returns the logs of the Spark container of the driver, or of the executor with the given ID if set.
+func (s *Server) streamLogs(ctx context.Context, app *v1beta2.SparkApplication, executorID string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	var pod *corev1.Pod
+	var err error
+	if executorID != "" {
+		pod, err = s.getExecutorPod(ctx, app, executorID)
+	} else {
+		pod, err = s.getDriverPod(ctx, app)
+	}
+	if err != nil {
+		return nil, err
+	}
+	options.Container = getSparkContainerName(pod)
+	return s.clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).Stream(ctx)
+}
+
+func (s *Server) getDriverPod(ctx context.Context, app *v1beta2.SparkApplication) (*corev1.Pod, error) {
+	name := app.Status.DriverInfo.PodName
+	if name == "" {
+		name = util.GetDriverPodName(app)
+	}
+	pod := &corev1.Pod{}
+	if err := s.client.Get(ctx, types.NamespacedName{Namespace: app.Namespace, Name: name}, pod); err != nil {
+		return nil, err
+	}
+	return pod, nil
+}
+
+func (s *Server) getExecutorPod(ctx context.Context, app *v1beta2.SparkApplication, executorID string) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := s.client.List(ctx, pods, client.InNamespace(app.Namespace), client.MatchingLabels{
+		common.LabelSparkAppName:    app.Name,
+		common.LabelSparkRole:       common.SparkRoleExecutor,
+		common.LabelSparkExecutorID: executorID,
+	}); err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, errors.NewNotFound(corev1.Resource("pods"), fmt.Sprintf("%s executor %s", app.Name, executorID))
+	}
+	return &pods.Items[0], nil
+}
+
+// getSparkContainerName returns the name of the container running Spark in the given driver or executor pod.
+func getSparkContainerName(pod *corev1.Pod) string {
+	for _, container := range pod.Spec.Containers {
+		switch container.Name {
+		case common.SparkDriverContainerName, common.SparkExecutorContainerName, common.Spark3DefaultExecutorContainerName:
+			return container.Name
+		}
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+// flushWriter flushes every write, so that followed logs reach the client as they are produced.
+type flushWriter struct {
+	w http.ResponseWriter
+}
+
+func (f *flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	if flusher, ok := f.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// errorResponse is the body of the responses of failed requests.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeError writes the given error with the status code of the Kubernetes API error it wraps, if any.
+func writeError(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	if status, ok := err.(errors.APIStatus); ok && status.Status().Code != 0 {
+		code = int(status.Status().Code)
+	}
+	writeJSON(w, code, errorResponse{Error: err.Error()})
+}
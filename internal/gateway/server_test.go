@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	ktesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+const testApp = `
+apiVersion: sparkoperator.k8s.io/v1beta2
+kind: SparkApplication
+metadata:
+  name: spark-pi
+spec:
+  type: Scala
+  mode: cluster
+  image: spark:3.5.5
+  mainClass: org.apache.spark.examples.SparkPi
+  mainApplicationFile: local:///opt/spark/examples/jars/spark-examples.jar
+  sparkVersion: 3.5.5
+`
+
+func newTestServer(t *testing.T, auth bool, namespaces []string, livy *LivyOptions, objects ...runtime.Object) (*httptest.Server, *kubefake.Clientset) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build()
+
+	clientset := kubefake.NewClientset()
+	var authenticator *Authenticator
+	if auth {
+		authenticator = NewAuthenticator(clientset, nil)
+	}
+
+	server := httptest.NewServer(NewServer(client, clientset, authenticator, namespaces, livy).Handler())
+	t.Cleanup(server.Close)
+	return server, clientset
+}
+
+func doRequest(t *testing.T, method string, url string, token string, body string) (*http.Response, string) {
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return response, string(data)
+}
+
+func TestServer(t *testing.T) {
+	driver := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi-driver", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "sidecar"},
+			{Name: common.SparkDriverContainerName},
+		}},
+	}
+	executor := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark-pi-exec-1",
+			Namespace: "default",
+			Labels: map[string]string{
+				common.LabelSparkAppName:    "spark-pi",
+				common.LabelSparkRole:       common.SparkRoleExecutor,
+				common.LabelSparkExecutorID: "1",
+			},
+		},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: common.Spark3DefaultExecutorContainerName}}},
+	}
+	server, _ := newTestServer(t, false, nil, nil, driver, executor)
+	url := server.URL + "/v1/namespaces/default/sparkapplications"
+
+	response, body := doRequest(t, http.MethodPost, url, "", testApp)
+	require.Equal(t, http.StatusCreated, response.StatusCode, body)
+	app := &v1beta2.SparkApplication{}
+	require.NoError(t, json.Unmarshal([]byte(body), app))
+	assert.Equal(t, "default", app.Namespace)
+	assert.NotContains(t, app.Annotations, common.AnnotationSubmittedBy)
+
+	response, body = doRequest(t, http.MethodPost, url, "", strings.Replace(testApp, "metadata:", "metadata:\n  namespace: other", 1))
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"?labelSelector=app=other", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	apps := &v1beta2.SparkApplicationList{}
+	require.NoError(t, json.Unmarshal([]byte(body), apps))
+	assert.Empty(t, apps.Items)
+
+	response, body = doRequest(t, http.MethodGet, url, "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	require.NoError(t, json.Unmarshal([]byte(body), apps))
+	assert.Len(t, apps.Items, 1)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/status", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/logs?tailLines=10", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode, body)
+	assert.Equal(t, "fake logs", body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/logs?executorId=1&follow=true", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/logs?executorId=2", "", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/logs?tailLines=-1", "", "")
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodPost, url+"/spark-pi/terminate", "", `{"gracePeriodSeconds": -1}`)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodPost, url+"/spark-pi/terminate", "", `{"reason": "wrong input", "gracePeriodSeconds": 120}`)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi", "", "")
+	require.Equal(t, http.StatusOK, response.StatusCode, body)
+	require.NoError(t, json.Unmarshal([]byte(body), app))
+	assert.Equal(t, &v1beta2.TerminateSpec{Reason: "wrong input", GracePeriodSeconds: ptr.To[int64](120)}, app.Spec.Terminate)
+
+	response, body = doRequest(t, http.MethodPost, url+"/spark-pi/terminate", "", "")
+	assert.Equal(t, http.StatusConflict, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodDelete, url+"/spark-pi", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi", "", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode, body)
+}
+
+func TestServerNamespaces(t *testing.T) {
+	server, _ := newTestServer(t, false, []string{"spark"}, nil)
+
+	response, body := doRequest(t, http.MethodGet, server.URL+"/v1/namespaces/default/sparkapplications", "", "")
+	assert.Equal(t, http.StatusForbidden, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, server.URL+"/v1/namespaces/spark/sparkapplications", "", "")
+	assert.Equal(t, http.StatusOK, response.StatusCode, body)
+}
+
+func TestServerAuthentication(t *testing.T) {
+	server, clientset := newTestServer(t, true, nil, nil)
+	url := server.URL + "/v1/namespaces/default/sparkapplications"
+
+	var attributes *authorizationv1.ResourceAttributes
+	clientset.PrependReactor("create", "tokenreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+		review := action.(ktesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "valid" {
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"data"}}
+		}
+		return true, review, nil
+	})
+	clientset.PrependReactor("create", "subjectaccessreviews", func(action ktesting.Action) (bool, runtime.Object, error) {
+		review := action.(ktesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attributes = review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "alice" && attributes.Verb != "delete"
+		return true, review, nil
+	})
+
+	response, body := doRequest(t, http.MethodGet, url, "", "")
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodGet, url, "invalid", "")
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode, body)
+
+	response, body = doRequest(t, http.MethodPost, url, "valid", testApp)
+	require.Equal(t, http.StatusCreated, response.StatusCode, body)
+	assert.Equal(t, &authorizationv1.ResourceAttributes{
+		Namespace: "default",
+		Verb:      "create",
+		Group:     v1beta2.GroupVersion.Group,
+		Resource:  "sparkapplications",
+	}, attributes)
+	app := &v1beta2.SparkApplication{}
+	require.NoError(t, json.Unmarshal([]byte(body), app))
+	assert.Equal(t, "alice", app.Annotations[common.AnnotationSubmittedBy])
+
+	response, body = doRequest(t, http.MethodDelete, url+"/spark-pi", "valid", "")
+	assert.Equal(t, http.StatusForbidden, response.StatusCode, body)
+	assert.Equal(t, "spark-pi", attributes.Name)
+
+	response, body = doRequest(t, http.MethodGet, url+"/spark-pi/logs", "valid", "")
+	assert.Equal(t, http.StatusNotFound, response.StatusCode, body)
+	assert.Equal(t, "pods", attributes.Resource)
+	assert.Equal(t, "log", attributes.Subresource)
+}
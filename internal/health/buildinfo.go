@@ -0,0 +1,94 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+
+	sparkoperator "github.com/kubeflow/spark-operator/v2"
+	"github.com/kubeflow/spark-operator/v2/pkg/features"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+const (
+	// BuildInfoPath is the path under which the build information and capabilities of the operator are served.
+	BuildInfoPath = "/buildinfo"
+)
+
+// BuildInfo describes a running operator component, so that client tooling can adapt its behavior
+// to the capabilities of the installed operator.
+type BuildInfo struct {
+	// Component is the operator component serving the build information, i.e. controller or webhook.
+	Component string `json:"component"`
+	// Version is the version information of the component binary.
+	Version sparkoperator.VersionInfo `json:"version"`
+	// FeatureGates tells whether each of the operator feature gates is enabled.
+	FeatureGates map[string]bool `json:"featureGates"`
+	// SparkVersions describes the Spark versions supported by the operator.
+	SparkVersions SparkVersionSupport `json:"sparkVersions"`
+	// Configuration is the active configuration of the component.
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+// SparkVersionSupport describes the Spark versions supported by the operator.
+type SparkVersionSupport struct {
+	// MinVersion is the oldest supported Spark version.
+	MinVersion string `json:"minVersion"`
+	// MaxMajorVersion is the newest supported Spark major version.
+	MaxMajorVersion string `json:"maxMajorVersion"`
+	// Features maps the Spark features the operator relies on to the first Spark version supporting them.
+	Features map[string]string `json:"features"`
+}
+
+// GetBuildInfo returns the build information of the given component running with the given configuration.
+func GetBuildInfo(component string, configuration map[string]string) BuildInfo {
+	gates := make(map[string]bool)
+	for f, enabled := range features.GetAll() {
+		gates[string(f)] = enabled
+	}
+
+	sparkFeatures := make(map[string]string)
+	for f, version := range util.GetSparkFeatureMinVersions() {
+		sparkFeatures[string(f)] = version
+	}
+
+	return BuildInfo{
+		Component:    component,
+		Version:      sparkoperator.GetVersion(),
+		FeatureGates: gates,
+		SparkVersions: SparkVersionSupport{
+			MinVersion:      util.MinSupportedSparkVersion,
+			MaxMajorVersion: util.MaxSupportedSparkMajorVersion,
+			Features:        sparkFeatures,
+		},
+		Configuration: configuration,
+	}
+}
+
+// NewBuildInfoHandler returns an http.Handler serving the build information of the given component as JSON.
+// Feature gates are read on every request, so the handler may be created before flags are parsed.
+func NewBuildInfoHandler(component string, configuration map[string]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(GetBuildInfo(component, configuration))
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/features"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+func TestBuildInfoHandler(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.ExecutorDecommission, true)
+	handler := NewBuildInfoHandler("controller", map[string]string{"eventPolicy": "All"})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, BuildInfoPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+
+	info := BuildInfo{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &info))
+	assert.Equal(t, "controller", info.Component)
+	assert.NotEmpty(t, info.Version.Version)
+	assert.True(t, info.FeatureGates[string(features.ExecutorDecommission)])
+	assert.Contains(t, info.FeatureGates, string(features.PartialRestart))
+	assert.Equal(t, util.MinSupportedSparkVersion, info.SparkVersions.MinVersion)
+	assert.Equal(t, "3.4.0", info.SparkVersions.Features[string(util.SparkFeatureConnect)])
+	assert.Equal(t, map[string]string{"eventPolicy": "All"}, info.Configuration)
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, BuildInfoPath, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+}
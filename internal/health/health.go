@@ -0,0 +1,46 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+const (
+	// ArgoCDSparkApplicationHealthPath is the path under which the Argo CD health check
+	// script for SparkApplication is served.
+	ArgoCDSparkApplicationHealthPath = "/health/argocd/sparkapplication.lua"
+)
+
+// ArgoCDSparkApplicationHealthScript is the Lua health check script that lets Argo CD (and OpenShift GitOps)
+// assess SparkApplication resources from the status.health field computed by the controller.
+//
+//go:embed sparkapplication.lua
+var ArgoCDSparkApplicationHealthScript string
+
+// NewArgoCDSparkApplicationHealthHandler returns an http.Handler serving the Argo CD health check script.
+func NewArgoCDSparkApplicationHealthHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/x-lua; charset=utf-8")
+		_, _ = w.Write([]byte(ArgoCDSparkApplicationHealthScript))
+	})
+}
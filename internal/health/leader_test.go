@@ -0,0 +1,48 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+)
+
+func TestLeaderChecker(t *testing.T) {
+	elected := make(chan struct{})
+	checker := NewLeaderChecker(elected)
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	assert.Error(t, checker(req))
+	close(elected)
+	assert.NoError(t, checker(req))
+}
+
+func TestCacheSyncChecker(t *testing.T) {
+	informers := &informertest.FakeInformers{Synced: ptr.To(false)}
+	checker := NewCacheSyncChecker(informers, 10*time.Millisecond)
+	req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+
+	assert.Error(t, checker(req))
+	informers.Synced = ptr.To(true)
+	assert.NoError(t, checker(req))
+}
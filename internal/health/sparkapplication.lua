@@ -0,0 +1,17 @@
+-- Argo CD health check for sparkoperator.k8s.io/SparkApplication.
+-- The Spark operator computes status.health, so the script only needs to relay it.
+local hs = {}
+if obj.status ~= nil and obj.status.health ~= nil then
+  hs.status = obj.status.health
+  hs.message = ""
+  if obj.status.applicationState ~= nil then
+    hs.message = obj.status.applicationState.state or ""
+    if obj.status.applicationState.errorMessage ~= nil and obj.status.applicationState.errorMessage ~= "" then
+      hs.message = hs.message .. ": " .. obj.status.applicationState.errorMessage
+    end
+  end
+  return hs
+end
+hs.status = "Progressing"
+hs.message = "Waiting for the Spark operator to report the application health"
+return hs
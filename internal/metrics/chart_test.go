@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+const (
+	// chartDir is the directory of the Helm chart shipping the dashboards and alerts built on the operator metrics.
+	chartDir = "../../charts/spark-operator-chart"
+)
+
+var (
+	dashboardMetricPattern      = regexp.MustCompile(`__METRIC_PREFIX__([a-zA-Z0-9_:]+)`)
+	prometheusRuleMetricPattern = regexp.MustCompile(`\{\{ \$prefix \}\}([a-zA-Z0-9_:]+)`)
+)
+
+// exportedMetrics returns the names of the metrics exported by the operator, without prefix.
+func exportedMetrics() map[string]bool {
+	exported := map[string]bool{}
+	for _, name := range []string{
+		common.MetricSparkApplicationCount,
+		common.MetricSparkApplicationSubmitCount,
+		common.MetricSparkApplicationFailedSubmissionCount,
+		common.MetricSparkApplicationRunningCount,
+		common.MetricSparkApplicationSubmittedTimestampSeconds,
+		common.MetricSparkApplicationSuccessCount,
+		common.MetricSparkApplicationFailureCount,
+		common.MetricSparkApplicationSuccessExecutionTimeSeconds,
+		common.MetricSparkApplicationFailureExecutionTimeSeconds,
+		common.MetricSparkApplicationStartLatencySeconds,
+		common.MetricSparkApplicationStartLatencySecondsHistogram,
+		common.MetricScheduledSparkApplicationRunSuccessCount,
+		common.MetricScheduledSparkApplicationRunFailureCount,
+		common.MetricSparkExecutorRunningCount,
+		common.MetricSparkExecutorSuccessCount,
+		common.MetricSparkExecutorFailureCount,
+		common.MetricSparkExecutorLeakedPVCCount,
+		common.MetricSparkExecutorTerminationCount,
+		common.MetricSparkApplicationTaskCompletedCount,
+		common.MetricSparkApplicationTaskFailedCount,
+		common.MetricSparkApplicationShuffleReadBytes,
+		common.MetricSparkApplicationShuffleWriteBytes,
+		common.MetricSparkApplicationMemorySpilledBytes,
+		common.MetricSparkApplicationDiskSpilledBytes,
+	} {
+		exported[name] = true
+	}
+	return exported
+}
+
+// assertMetricsExported asserts that the metrics matched by pattern in data are exported by the operator,
+// so renaming a metric fails here rather than silently emptying a panel or an alert.
+func assertMetricsExported(t *testing.T, pattern *regexp.Regexp, data []byte) {
+	exported := exportedMetrics()
+	matches := pattern.FindAllStringSubmatch(string(data), -1)
+	assert.NotEmpty(t, matches)
+	for _, match := range matches {
+		name := match[1]
+		if exported[name] {
+			continue
+		}
+		// Histograms and summaries are exported as several series sharing the metric name.
+		base := name
+		for _, suffix := range []string{"_bucket", "_sum", "_count"} {
+			base = strings.TrimSuffix(base, suffix)
+		}
+		assert.True(t, exported[base], "metric %q is not exported by the operator", name)
+	}
+}
+
+func TestDashboardsReferenceExportedMetrics(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(chartDir, "dashboards", "*.json"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			data, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			var dashboard map[string]interface{}
+			require.NoError(t, json.Unmarshal(data, &dashboard))
+			assert.NotEmpty(t, dashboard["uid"])
+
+			assertMetricsExported(t, dashboardMetricPattern, data)
+		})
+	}
+}
+
+func TestPrometheusRuleReferencesExportedMetrics(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join(chartDir, "templates", "prometheus", "prometheusrule.yaml"))
+	require.NoError(t, err)
+
+	assertMetricsExported(t, prometheusRuleMetricPattern, data)
+}
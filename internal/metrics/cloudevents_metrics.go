@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var cloudEventsMetricLabels = []string{"namespace", "reason"}
+
+// CloudEventsMetrics exposes the CloudEvents of SparkApplications that were not delivered to their sink.
+type CloudEventsMetrics struct {
+	prefix string
+
+	droppedCount *prometheus.CounterVec
+}
+
+func NewCloudEventsMetrics(prefix string) *CloudEventsMetrics {
+	return &CloudEventsMetrics{
+		prefix: prefix,
+
+		droppedCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricCloudEventsDroppedCount),
+				Help: "Total number of CloudEvents that were not delivered to their sink",
+			},
+			cloudEventsMetricLabels,
+		),
+	}
+}
+
+func (m *CloudEventsMetrics) Register() {
+	if err := metrics.Registry.Register(m.droppedCount); err != nil {
+		logger.Error(err, "Failed to register cloudevents metric", "name", common.MetricCloudEventsDroppedCount)
+	}
+}
+
+// HandleDroppedEvent counts a CloudEvent of a SparkApplication of the given namespace that was dropped for the
+// given reason. A nil CloudEventsMetrics counts nothing.
+func (m *CloudEventsMetrics) HandleDroppedEvent(namespace, reason string) {
+	if m == nil {
+		return
+	}
+	m.droppedCount.With(prometheus.Labels{"namespace": namespace, "reason": reason}).Inc()
+}
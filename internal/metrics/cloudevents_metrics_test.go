@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudEventsMetricsDroppedCount(t *testing.T) {
+	m := NewCloudEventsMetrics("")
+	m.HandleDroppedEvent("team-a", "queue_full")
+	m.HandleDroppedEvent("team-a", "queue_full")
+	m.HandleDroppedEvent("team-b", "send_failed")
+
+	assert.Equal(t, 2, testutil.CollectAndCount(m.droppedCount))
+	assert.Equal(t, float64(2), testutil.ToFloat64(m.droppedCount.With(prometheus.Labels{"namespace": "team-a", "reason": "queue_full"})))
+	assert.Equal(t, float64(1), testutil.ToFloat64(m.droppedCount.With(prometheus.Labels{"namespace": "team-b", "reason": "send_failed"})))
+
+	// A nil CloudEventsMetrics counts nothing.
+	var nilMetrics *CloudEventsMetrics
+	nilMetrics.HandleDroppedEvent("team-a", "queue_full")
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var scheduledSparkApplicationMetricLabels = []string{"namespace", "name"}
+
+// ScheduledSparkApplicationMetrics exposes the outcomes of the runs of ScheduledSparkApplications.
+type ScheduledSparkApplicationMetrics struct {
+	prefix string
+
+	runSuccessCount *prometheus.CounterVec
+	runFailureCount *prometheus.CounterVec
+}
+
+func NewScheduledSparkApplicationMetrics(prefix string) *ScheduledSparkApplicationMetrics {
+	return &ScheduledSparkApplicationMetrics{
+		prefix: prefix,
+
+		runSuccessCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricScheduledSparkApplicationRunSuccessCount),
+				Help: "Total number of successful runs of ScheduledSparkApplication",
+			},
+			scheduledSparkApplicationMetricLabels,
+		),
+		runFailureCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricScheduledSparkApplicationRunFailureCount),
+				Help: "Total number of failed runs of ScheduledSparkApplication",
+			},
+			scheduledSparkApplicationMetricLabels,
+		),
+	}
+}
+
+func (m *ScheduledSparkApplicationMetrics) Register() {
+	if err := metrics.Registry.Register(m.runSuccessCount); err != nil {
+		logger.Error(err, "Failed to register scheduled spark application metric", "name", common.MetricScheduledSparkApplicationRunSuccessCount)
+	}
+	if err := metrics.Registry.Register(m.runFailureCount); err != nil {
+		logger.Error(err, "Failed to register scheduled spark application metric", "name", common.MetricScheduledSparkApplicationRunFailureCount)
+	}
+}
+
+// HandleRunRecord counts the finished run of the given ScheduledSparkApplication.
+func (m *ScheduledSparkApplicationMetrics) HandleRunRecord(scheduledApp *v1beta2.ScheduledSparkApplication, record *v1beta2.ScheduledRunRecord) {
+	labels := prometheus.Labels{"namespace": scheduledApp.Namespace, "name": scheduledApp.Name}
+	switch record.State {
+	case v1beta2.ApplicationStateCompleted:
+		m.runSuccessCount.With(labels).Inc()
+	case v1beta2.ApplicationStateFailed:
+		m.runFailureCount.With(labels).Inc()
+	}
+}
@@ -45,8 +45,14 @@ type SparkApplicationMetrics struct {
 
 	startLatencySeconds          *prometheus.SummaryVec
 	startLatencySecondsHistogram *prometheus.HistogramVec
+
+	submittedTimestampSeconds *prometheus.GaugeVec
 }
 
+// sparkApplicationSubmittedMetricLabels are the labels of the submitted timestamp metric, which has a series
+// per SparkApplication in the SUBMITTED state so that alerts can name the stuck application.
+var sparkApplicationSubmittedMetricLabels = []string{"namespace", "name"}
+
 func NewSparkApplicationMetrics(prefix string, labels []string, jobStartLatencyBuckets []float64) *SparkApplicationMetrics {
 	validLabels := make([]string, 0, len(labels))
 	for _, label := range labels {
@@ -128,6 +134,13 @@ func NewSparkApplicationMetrics(prefix string, labels []string, jobStartLatencyB
 			},
 			validLabels,
 		),
+		submittedTimestampSeconds: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricSparkApplicationSubmittedTimestampSeconds),
+				Help: "Unix time at which Spark applications currently in the SUBMITTED state were submitted",
+			},
+			sparkApplicationSubmittedMetricLabels,
+		),
 	}
 }
 
@@ -162,6 +175,9 @@ func (m *SparkApplicationMetrics) Register() {
 	if err := metrics.Registry.Register(m.startLatencySecondsHistogram); err != nil {
 		logger.Error(err, "Failed to register spark application metric", "name", common.MetricSparkApplicationStartLatencySecondsHistogram)
 	}
+	if err := metrics.Registry.Register(m.submittedTimestampSeconds); err != nil {
+		logger.Error(err, "Failed to register spark application metric", "name", common.MetricSparkApplicationSubmittedTimestampSeconds)
+	}
 }
 
 func (m *SparkApplicationMetrics) HandleSparkApplicationCreate(app *v1beta2.SparkApplication) {
@@ -172,7 +188,8 @@ func (m *SparkApplicationMetrics) HandleSparkApplicationCreate(app *v1beta2.Spar
 		m.incCount(app)
 	case v1beta2.ApplicationStateSubmitted:
 		m.incSubmitCount(app)
-	case v1beta2.ApplicationStateFailedSubmission:
+		m.setSubmittedTimestampSeconds(app)
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
 		m.incFailedSubmissionCount(app)
 	case v1beta2.ApplicationStateRunning:
 		m.incRunningCount(app)
@@ -191,6 +208,8 @@ func (m *SparkApplicationMetrics) HandleSparkApplicationUpdate(oldApp *v1beta2.S
 	}
 
 	switch oldState {
+	case v1beta2.ApplicationStateSubmitted:
+		m.deleteSubmittedTimestampSeconds(oldApp)
 	case v1beta2.ApplicationStateRunning:
 		m.decRunningCount(oldApp)
 	}
@@ -200,7 +219,8 @@ func (m *SparkApplicationMetrics) HandleSparkApplicationUpdate(oldApp *v1beta2.S
 		m.incCount(newApp)
 	case v1beta2.ApplicationStateSubmitted:
 		m.incSubmitCount(newApp)
-	case v1beta2.ApplicationStateFailedSubmission:
+		m.setSubmittedTimestampSeconds(newApp)
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
 		m.incFailedSubmissionCount(newApp)
 	case v1beta2.ApplicationStateRunning:
 		m.incRunningCount(newApp)
@@ -218,6 +238,8 @@ func (m *SparkApplicationMetrics) HandleSparkApplicationDelete(app *v1beta2.Spar
 	state := util.GetApplicationState(app)
 
 	switch state {
+	case v1beta2.ApplicationStateSubmitted:
+		m.deleteSubmittedTimestampSeconds(app)
 	case v1beta2.ApplicationStateRunning:
 		m.decRunningCount(app)
 	}
@@ -283,6 +305,30 @@ func (m *SparkApplicationMetrics) decRunningCount(app *v1beta2.SparkApplication)
 	logger.V(1).Info("Decreased SparkApplication running count", "name", app.Name, "namespace", app.Namespace, "metric", common.MetricSparkApplicationRunningCount, "labels", labels)
 }
 
+func (m *SparkApplicationMetrics) setSubmittedTimestampSeconds(app *v1beta2.SparkApplication) {
+	submitted := app.Status.LastSubmissionAttemptTime.Time
+	if submitted.IsZero() {
+		submitted = time.Now()
+	}
+
+	labels := prometheus.Labels{"namespace": app.Namespace, "name": app.Name}
+	gauge, err := m.submittedTimestampSeconds.GetMetricWith(labels)
+	if err != nil {
+		logger.Error(err, "Failed to collect metric for SparkApplication", "name", app.Name, "namespace", app.Namespace, "metric", common.MetricSparkApplicationSubmittedTimestampSeconds, "labels", labels)
+		return
+	}
+
+	gauge.Set(float64(submitted.Unix()))
+	logger.V(1).Info("Set spark application submitted timestamp seconds", "name", app.Name, "namespace", app.Namespace, "metric", common.MetricSparkApplicationSubmittedTimestampSeconds, "labels", labels)
+}
+
+func (m *SparkApplicationMetrics) deleteSubmittedTimestampSeconds(app *v1beta2.SparkApplication) {
+	labels := prometheus.Labels{"namespace": app.Namespace, "name": app.Name}
+	if m.submittedTimestampSeconds.Delete(labels) {
+		logger.V(1).Info("Deleted spark application submitted timestamp seconds", "name", app.Name, "namespace", app.Namespace, "metric", common.MetricSparkApplicationSubmittedTimestampSeconds, "labels", labels)
+	}
+}
+
 func (m *SparkApplicationMetrics) incSuccessCount(app *v1beta2.SparkApplication) {
 	labels := m.getMetricLabels(app)
 	counter, err := m.successCount.GetMetricWith(labels)
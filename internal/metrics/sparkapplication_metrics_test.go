@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestSparkApplicationMetricsSubmittedTimestampSeconds(t *testing.T) {
+	submitted := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	newApp := func(state v1beta2.ApplicationStateType) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "test-ns"},
+			Status: v1beta2.SparkApplicationStatus{
+				AppState:                  v1beta2.ApplicationState{State: state},
+				LastSubmissionAttemptTime: metav1.NewTime(submitted),
+			},
+		}
+	}
+	labels := prometheus.Labels{"namespace": "test-ns", "name": "test-app"}
+
+	t.Run("set when the application is submitted and deleted when it starts running", func(t *testing.T) {
+		m := NewSparkApplicationMetrics("", []string{"app_type"}, []float64{30})
+
+		m.HandleSparkApplicationUpdate(newApp(v1beta2.ApplicationStateNew), newApp(v1beta2.ApplicationStateSubmitted))
+		assert.Equal(t, float64(submitted.Unix()), testutil.ToFloat64(m.submittedTimestampSeconds.With(labels)))
+
+		m.HandleSparkApplicationUpdate(newApp(v1beta2.ApplicationStateSubmitted), newApp(v1beta2.ApplicationStateRunning))
+		assert.Equal(t, 0, testutil.CollectAndCount(m.submittedTimestampSeconds))
+	})
+
+	t.Run("restored from the informer cache and deleted with the application", func(t *testing.T) {
+		m := NewSparkApplicationMetrics("", []string{"app_type"}, []float64{30})
+
+		m.HandleSparkApplicationCreate(newApp(v1beta2.ApplicationStateSubmitted))
+		assert.Equal(t, 1, testutil.CollectAndCount(m.submittedTimestampSeconds))
+
+		m.HandleSparkApplicationDelete(newApp(v1beta2.ApplicationStateSubmitted))
+		assert.Equal(t, 0, testutil.CollectAndCount(m.submittedTimestampSeconds))
+	})
+
+	t.Run("not set for applications in other states", func(t *testing.T) {
+		m := NewSparkApplicationMetrics("", []string{"app_type"}, []float64{30})
+
+		m.HandleSparkApplicationCreate(newApp(v1beta2.ApplicationStateRunning))
+		m.HandleSparkApplicationUpdate(newApp(v1beta2.ApplicationStateRunning), newApp(v1beta2.ApplicationStateCompleted))
+		assert.Equal(t, 0, testutil.CollectAndCount(m.submittedTimestampSeconds))
+	})
+}
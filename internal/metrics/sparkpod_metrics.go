@@ -17,8 +17,12 @@ limitations under the License.
 package metrics
 
 import (
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
@@ -33,8 +37,19 @@ type SparkExecutorMetrics struct {
 	runningCount *prometheus.GaugeVec
 	successCount *prometheus.CounterVec
 	failureCount *prometheus.CounterVec
+
+	leakedPVCCount *prometheus.CounterVec
+
+	terminationCount *prometheus.CounterVec
+
+	mu sync.Mutex
+	// decommissioned holds the UIDs of the executor pods decommissioned by the operator that have not terminated yet.
+	decommissioned map[types.UID]bool
 }
 
+// sparkExecutorTerminationMetricLabels are the labels of the executor termination metric.
+var sparkExecutorTerminationMetricLabels = []string{"namespace", "app_name", "reason"}
+
 func NewSparkExecutorMetrics(prefix string, labels []string) *SparkExecutorMetrics {
 	validLabels := make([]string, 0, len(labels))
 	for _, label := range labels {
@@ -67,6 +82,21 @@ func NewSparkExecutorMetrics(prefix string, labels []string) *SparkExecutorMetri
 			},
 			validLabels,
 		),
+		leakedPVCCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricSparkExecutorLeakedPVCCount),
+				Help: "Total number of executor PersistentVolumeClaims left over by terminated Spark applications",
+			},
+			validLabels,
+		),
+		terminationCount: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, common.MetricSparkExecutorTerminationCount),
+				Help: "Total number of terminated Spark executors by application and termination reason",
+			},
+			sparkExecutorTerminationMetricLabels,
+		),
+		decommissioned: make(map[types.UID]bool),
 	}
 }
 
@@ -80,6 +110,12 @@ func (m *SparkExecutorMetrics) Register() {
 	if err := metrics.Registry.Register(m.failureCount); err != nil {
 		logger.Error(err, "Failed to register spark executor metric", "name", common.MetricSparkExecutorFailureCount)
 	}
+	if err := metrics.Registry.Register(m.leakedPVCCount); err != nil {
+		logger.Error(err, "Failed to register spark executor metric", "name", common.MetricSparkExecutorLeakedPVCCount)
+	}
+	if err := metrics.Registry.Register(m.terminationCount); err != nil {
+		logger.Error(err, "Failed to register spark executor metric", "name", common.MetricSparkExecutorTerminationCount)
+	}
 }
 
 func (m *SparkExecutorMetrics) HandleSparkExecutorCreate(pod *corev1.Pod) {
@@ -107,9 +143,32 @@ func (m *SparkExecutorMetrics) HandleSparkExecutorUpdate(oldPod, newPod *corev1.
 		m.incRunningCount(newPod)
 	case v1beta2.ExecutorStateCompleted:
 		m.incSuccessCount(newPod)
+		m.incTerminationCount(newPod)
 	case v1beta2.ExecutorStateFailed:
 		m.incFailureCount(newPod)
+		m.incTerminationCount(newPod)
+	}
+}
+
+// HandleSparkExecutorDecommission records an executor pod decommissioned by the operator, so that its
+// termination is counted as decommissioned rather than by the exit status of the executor.
+func (m *SparkExecutorMetrics) HandleSparkExecutorDecommission(pod *corev1.Pod) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.decommissioned[pod.UID] = true
+}
+
+// HandleSparkExecutorPVCLeak records an executor PersistentVolumeClaim left over by a terminated application.
+func (m *SparkExecutorMetrics) HandleSparkExecutorPVCLeak(pvc *corev1.PersistentVolumeClaim) {
+	labels := m.getMetricLabels(pvc)
+	leakedPVCCount, err := m.leakedPVCCount.GetMetricWith(labels)
+	if err != nil {
+		logger.Error(err, "Failed to collect metric for Spark executor PVC", "name", pvc.Name, "namespace", pvc.Namespace, "metric", common.MetricSparkExecutorLeakedPVCCount, "labels", labels)
+		return
 	}
+
+	leakedPVCCount.Inc()
+	logger.V(1).Info("Increased Spark executor leaked PVC count", "name", pvc.Name, "namespace", pvc.Namespace, "metric", common.MetricSparkExecutorLeakedPVCCount, "labels", labels)
 }
 
 func (m *SparkExecutorMetrics) HandleSparkExecutorDelete(pod *corev1.Pod) {
@@ -119,6 +178,10 @@ func (m *SparkExecutorMetrics) HandleSparkExecutorDelete(pod *corev1.Pod) {
 	case v1beta2.ExecutorStateRunning:
 		m.decRunningCount(pod)
 	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.decommissioned, pod.UID)
 }
 
 func (m *SparkExecutorMetrics) incRunningCount(pod *corev1.Pod) {
@@ -169,10 +232,62 @@ func (m *SparkExecutorMetrics) incFailureCount(pod *corev1.Pod) {
 	logger.V(1).Info("Increased Spark executor running count", "name", pod.Name, "namespace", pod.Namespace, "metric", common.MetricSparkExecutorFailureCount, "labels", labels)
 }
 
-func (m *SparkExecutorMetrics) getMetricLabels(pod *corev1.Pod) map[string]string {
-	// Convert pod metricLabels to valid metric metricLabels.
+func (m *SparkExecutorMetrics) incTerminationCount(pod *corev1.Pod) {
+	labels := prometheus.Labels{
+		"namespace": pod.Namespace,
+		"app_name":  pod.Labels[common.LabelSparkAppName],
+		"reason":    m.getTerminationReason(pod),
+	}
+	terminationCount, err := m.terminationCount.GetMetricWith(labels)
+	if err != nil {
+		logger.Error(err, "Failed to collect metric for Spark executor", "name", pod.Name, "namespace", pod.Namespace, "metric", common.MetricSparkExecutorTerminationCount, "labels", labels)
+		return
+	}
+
+	terminationCount.Inc()
+	logger.V(1).Info("Increased Spark executor termination count", "name", pod.Name, "namespace", pod.Namespace, "metric", common.MetricSparkExecutorTerminationCount, "labels", labels)
+}
+
+// getTerminationReason returns the reason the executor pod terminated for. Decommissioning and evictions take
+// precedence over the exit status of the executor container, which only reflects how the executor was stopped.
+func (m *SparkExecutorMetrics) getTerminationReason(pod *corev1.Pod) string {
+	m.mu.Lock()
+	decommissioned := m.decommissioned[pod.UID]
+	m.mu.Unlock()
+	if decommissioned {
+		return common.ExecutorTerminationReasonDecommissioned
+	}
+
+	if pod.Status.Reason == common.ExecutorTerminationReasonEvicted {
+		return common.ExecutorTerminationReasonEvicted
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.DisruptionTarget && condition.Status == corev1.ConditionTrue {
+			return common.ExecutorTerminationReasonEvicted
+		}
+	}
+
+	if state := util.GetExecutorContainerTerminatedState(pod); state != nil {
+		switch {
+		case state.Reason == common.ExecutorTerminationReasonOOMKilled:
+			return common.ExecutorTerminationReasonOOMKilled
+		case state.ExitCode == 0:
+			return common.ExecutorTerminationReasonCompleted
+		default:
+			return common.ExecutorTerminationReasonError
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodSucceeded {
+		return common.ExecutorTerminationReasonCompleted
+	}
+	return common.ExecutorTerminationReasonError
+}
+
+func (m *SparkExecutorMetrics) getMetricLabels(obj metav1.Object) map[string]string {
+	// Convert object metricLabels to valid metric metricLabels.
 	validLabels := make(map[string]string)
-	for key, val := range pod.Labels {
+	for key, val := range obj.GetLabels() {
 		newKey := util.CreateValidMetricNameLabel("", key)
 		validLabels[newKey] = val
 	}
@@ -182,7 +297,7 @@ func (m *SparkExecutorMetrics) getMetricLabels(pod *corev1.Pod) map[string]strin
 		if _, ok := validLabels[label]; ok {
 			metricLabels[label] = validLabels[label]
 		} else if label == "namespace" {
-			metricLabels[label] = pod.Namespace
+			metricLabels[label] = obj.GetNamespace()
 		} else {
 			metricLabels[label] = "Unknown"
 		}
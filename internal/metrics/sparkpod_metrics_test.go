@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestSparkExecutorMetricsTerminationCount(t *testing.T) {
+	newPod := func(phase corev1.PodPhase, mutate func(pod *corev1.Pod)) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-app-exec-1",
+				Namespace: "test-ns",
+				UID:       "test-uid",
+				Labels: map[string]string{
+					common.LabelSparkAppName: "test-app",
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+		if mutate != nil {
+			mutate(pod)
+		}
+		return pod
+	}
+	terminated := func(reason string, exitCode int32) func(pod *corev1.Pod) {
+		return func(pod *corev1.Pod) {
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{
+					Name: common.SparkExecutorContainerName,
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Reason: reason, ExitCode: exitCode},
+					},
+				},
+			}
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		pod          *corev1.Pod
+		decommission bool
+		expected     string
+	}{
+		{
+			name:     "completed executor",
+			pod:      newPod(corev1.PodSucceeded, terminated("Completed", 0)),
+			expected: common.ExecutorTerminationReasonCompleted,
+		},
+		{
+			name:     "failed executor",
+			pod:      newPod(corev1.PodFailed, terminated("Error", 1)),
+			expected: common.ExecutorTerminationReasonError,
+		},
+		{
+			name:     "failed executor without container status",
+			pod:      newPod(corev1.PodFailed, nil),
+			expected: common.ExecutorTerminationReasonError,
+		},
+		{
+			name:     "executor killed for exceeding its memory limit",
+			pod:      newPod(corev1.PodFailed, terminated("OOMKilled", 137)),
+			expected: common.ExecutorTerminationReasonOOMKilled,
+		},
+		{
+			name: "executor evicted by the kubelet",
+			pod: newPod(corev1.PodFailed, func(pod *corev1.Pod) {
+				pod.Status.Reason = "Evicted"
+			}),
+			expected: common.ExecutorTerminationReasonEvicted,
+		},
+		{
+			name: "executor preempted",
+			pod: newPod(corev1.PodFailed, func(pod *corev1.Pod) {
+				terminated("Error", 143)(pod)
+				pod.Status.Conditions = []corev1.PodCondition{
+					{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue, Reason: "PreemptionByScheduler"},
+				}
+			}),
+			expected: common.ExecutorTerminationReasonEvicted,
+		},
+		{
+			name:         "executor decommissioned by the operator",
+			pod:          newPod(corev1.PodSucceeded, terminated("Completed", 0)),
+			decommission: true,
+			expected:     common.ExecutorTerminationReasonDecommissioned,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewSparkExecutorMetrics("", []string{"app_type"})
+			running := newPod(corev1.PodRunning, nil)
+			if tc.decommission {
+				m.HandleSparkExecutorDecommission(running)
+			}
+
+			m.HandleSparkExecutorUpdate(running, tc.pod)
+
+			assert.Equal(t, 1, testutil.CollectAndCount(m.terminationCount))
+			labels := prometheus.Labels{"namespace": "test-ns", "app_name": "test-app", "reason": tc.expected}
+			assert.Equal(t, float64(1), testutil.ToFloat64(m.terminationCount.With(labels)))
+		})
+	}
+
+	t.Run("decommissioned executors are forgotten once deleted", func(t *testing.T) {
+		m := NewSparkExecutorMetrics("", []string{"app_type"})
+		pod := newPod(corev1.PodRunning, nil)
+
+		m.HandleSparkExecutorDecommission(pod)
+		m.HandleSparkExecutorDelete(pod)
+
+		assert.Empty(t, m.decommissioned)
+	})
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// maxTaskMetricsReportSize is the maximum size in bytes of a task metrics report.
+const maxTaskMetricsReportSize = 1 << 20
+
+var sparkTaskMetricLabels = []string{"namespace", "app_name"}
+
+// SparkTaskMetricsReport is the payload sent by the task metrics driver plugin. All values are
+// cumulative totals since the start of the application.
+type SparkTaskMetricsReport struct {
+	Namespace          string `json:"namespace"`
+	AppName            string `json:"appName"`
+	CompletedTasks     int64  `json:"completedTasks"`
+	FailedTasks        int64  `json:"failedTasks"`
+	ShuffleReadBytes   int64  `json:"shuffleReadBytes"`
+	ShuffleWriteBytes  int64  `json:"shuffleWriteBytes"`
+	MemoryBytesSpilled int64  `json:"memoryBytesSpilled"`
+	DiskBytesSpilled   int64  `json:"diskBytesSpilled"`
+}
+
+// SparkTaskMetrics exposes the task metrics reported by the task metrics driver plugin.
+type SparkTaskMetrics struct {
+	prefix string
+
+	completedTasks     *prometheus.GaugeVec
+	failedTasks        *prometheus.GaugeVec
+	shuffleReadBytes   *prometheus.GaugeVec
+	shuffleWriteBytes  *prometheus.GaugeVec
+	memorySpilledBytes *prometheus.GaugeVec
+	diskSpilledBytes   *prometheus.GaugeVec
+}
+
+func NewSparkTaskMetrics(prefix string) *SparkTaskMetrics {
+	newGaugeVec := func(name, help string) *prometheus.GaugeVec {
+		return prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: util.CreateValidMetricNameLabel(prefix, name),
+				Help: help,
+			},
+			sparkTaskMetricLabels,
+		)
+	}
+
+	return &SparkTaskMetrics{
+		prefix: prefix,
+
+		completedTasks:     newGaugeVec(common.MetricSparkApplicationTaskCompletedCount, "Total number of completed Spark tasks"),
+		failedTasks:        newGaugeVec(common.MetricSparkApplicationTaskFailedCount, "Total number of failed Spark tasks"),
+		shuffleReadBytes:   newGaugeVec(common.MetricSparkApplicationShuffleReadBytes, "Total number of bytes read by Spark shuffles"),
+		shuffleWriteBytes:  newGaugeVec(common.MetricSparkApplicationShuffleWriteBytes, "Total number of bytes written by Spark shuffles"),
+		memorySpilledBytes: newGaugeVec(common.MetricSparkApplicationMemorySpilledBytes, "Total number of bytes spilled in memory by Spark tasks"),
+		diskSpilledBytes:   newGaugeVec(common.MetricSparkApplicationDiskSpilledBytes, "Total number of bytes spilled to disk by Spark tasks"),
+	}
+}
+
+func (m *SparkTaskMetrics) Register() {
+	for name, collector := range m.collectors() {
+		if err := metrics.Registry.Register(collector); err != nil {
+			logger.Error(err, "Failed to register spark task metric", "name", name)
+		}
+	}
+}
+
+// sparkTaskMetricsHandler records the task metrics reports of the SparkApplications that have task metrics enabled.
+type sparkTaskMetricsHandler struct {
+	metrics       *SparkTaskMetrics
+	reader        client.Reader
+	authenticator authenticator.Request
+}
+
+// NewHandler returns an http.Handler recording the task metrics reports sent by the task metrics driver plugin.
+// Reports are only accepted from the service accounts of the namespace of the reported SparkApplication, as
+// authenticated by the given authenticator, so that drivers cannot report the metrics of other namespaces. They
+// are also only accepted for SparkApplications that can be read with the given reader and have task metrics
+// enabled, which bounds the series to the applications known to the operator.
+func (m *SparkTaskMetrics) NewHandler(reader client.Reader, authenticator authenticator.Request) http.Handler {
+	return &sparkTaskMetricsHandler{metrics: m, reader: reader, authenticator: authenticator}
+}
+
+// ServeHTTP records a task metrics report sent by the task metrics driver plugin.
+func (h *sparkTaskMetricsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	report := &SparkTaskMetricsReport{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxTaskMetricsReportSize)).Decode(report); err != nil {
+		http.Error(w, "invalid task metrics report", http.StatusBadRequest)
+		return
+	}
+	if report.Namespace == "" || report.AppName == "" {
+		http.Error(w, "task metrics report must have namespace and appName", http.StatusBadRequest)
+		return
+	}
+
+	res, ok, err := h.authenticator.AuthenticateRequest(r)
+	if err != nil || !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if namespace, _, err := serviceaccount.SplitUsername(res.User.GetName()); err != nil || namespace != report.Namespace {
+		http.Error(w, "task metrics reports must be sent by a service account of the namespace of the SparkApplication", http.StatusForbidden)
+		return
+	}
+
+	app := &v1beta2.SparkApplication{}
+	if err := h.reader.Get(r.Context(), types.NamespacedName{Namespace: report.Namespace, Name: report.AppName}, app); err != nil {
+		if errors.IsNotFound(err) {
+			http.Error(w, "unknown SparkApplication", http.StatusNotFound)
+			return
+		}
+		logger.Error(err, "Failed to get SparkApplication of task metrics report", "name", report.AppName, "namespace", report.Namespace)
+		http.Error(w, "failed to get SparkApplication", http.StatusInternalServerError)
+		return
+	}
+	if !util.TaskMetricsEnabled(app) {
+		http.Error(w, "task metrics are not enabled for the SparkApplication", http.StatusNotFound)
+		return
+	}
+
+	h.metrics.HandleSparkTaskMetricsReport(report)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (m *SparkTaskMetrics) HandleSparkTaskMetricsReport(report *SparkTaskMetricsReport) {
+	labels := prometheus.Labels{"namespace": report.Namespace, "app_name": report.AppName}
+	m.completedTasks.With(labels).Set(float64(report.CompletedTasks))
+	m.failedTasks.With(labels).Set(float64(report.FailedTasks))
+	m.shuffleReadBytes.With(labels).Set(float64(report.ShuffleReadBytes))
+	m.shuffleWriteBytes.With(labels).Set(float64(report.ShuffleWriteBytes))
+	m.memorySpilledBytes.With(labels).Set(float64(report.MemoryBytesSpilled))
+	m.diskSpilledBytes.With(labels).Set(float64(report.DiskBytesSpilled))
+	logger.V(1).Info("Recorded Spark task metrics", "name", report.AppName, "namespace", report.Namespace)
+}
+
+// HandleSparkApplicationDelete drops the task metrics of a deleted SparkApplication.
+func (m *SparkTaskMetrics) HandleSparkApplicationDelete(app *v1beta2.SparkApplication) {
+	labels := prometheus.Labels{"namespace": app.Namespace, "app_name": app.Name}
+	for _, collector := range m.collectors() {
+		collector.Delete(labels)
+	}
+}
+
+func (m *SparkTaskMetrics) collectors() map[string]*prometheus.GaugeVec {
+	return map[string]*prometheus.GaugeVec{
+		common.MetricSparkApplicationTaskCompletedCount: m.completedTasks,
+		common.MetricSparkApplicationTaskFailedCount:    m.failedTasks,
+		common.MetricSparkApplicationShuffleReadBytes:   m.shuffleReadBytes,
+		common.MetricSparkApplicationShuffleWriteBytes:  m.shuffleWriteBytes,
+		common.MetricSparkApplicationMemorySpilledBytes: m.memorySpilledBytes,
+		common.MetricSparkApplicationDiskSpilledBytes:   m.diskSpilledBytes,
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestSparkTaskMetricsHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "with-task-metrics", Namespace: "test-ns"},
+			Spec: v1beta2.SparkApplicationSpec{
+				Monitoring: &v1beta2.MonitoringSpec{TaskMetrics: &v1beta2.TaskMetricsSpec{PluginClass: "org.example.TaskMetricsPlugin"}},
+			},
+		},
+		&v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "without-task-metrics", Namespace: "test-ns"},
+		},
+	).Build()
+	// The test authenticator authenticates requests as the user of their Authorization header.
+	auth := authenticator.RequestFunc(func(r *http.Request) (*authenticator.Response, bool, error) {
+		name := r.Header.Get("Authorization")
+		if name == "" {
+			return nil, false, nil
+		}
+		return &authenticator.Response{User: &user.DefaultInfo{Name: name}}, true, nil
+	})
+	driver := "system:serviceaccount:test-ns:spark"
+
+	testCases := []struct {
+		name         string
+		method       string
+		user         string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "report of an application with task metrics",
+			method:       http.MethodPost,
+			user:         driver,
+			body:         `{"namespace":"test-ns","appName":"with-task-metrics","completedTasks":10}`,
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name:         "report of an application without task metrics",
+			method:       http.MethodPost,
+			user:         driver,
+			body:         `{"namespace":"test-ns","appName":"without-task-metrics","completedTasks":10}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "report of an unknown application",
+			method:       http.MethodPost,
+			user:         driver,
+			body:         `{"namespace":"test-ns","appName":"unknown","completedTasks":10}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "report of an application of another namespace",
+			method:       http.MethodPost,
+			user:         "system:serviceaccount:other-ns:spark",
+			body:         `{"namespace":"test-ns","appName":"with-task-metrics","completedTasks":10}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "report of a user that is not a service account",
+			method:       http.MethodPost,
+			user:         "test-ns",
+			body:         `{"namespace":"test-ns","appName":"with-task-metrics","completedTasks":10}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "unauthenticated report",
+			method:       http.MethodPost,
+			body:         `{"namespace":"test-ns","appName":"with-task-metrics","completedTasks":10}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "report without application",
+			method:       http.MethodPost,
+			user:         driver,
+			body:         `{"completedTasks":10}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "get request",
+			method:       http.MethodGet,
+			expectedCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewSparkTaskMetrics("")
+			recorder := httptest.NewRecorder()
+			req := httptest.NewRequest(tc.method, "/task-metrics", strings.NewReader(tc.body))
+			if tc.user != "" {
+				req.Header.Set("Authorization", tc.user)
+			}
+			m.NewHandler(reader, auth).ServeHTTP(recorder, req)
+
+			assert.Equal(t, tc.expectedCode, recorder.Code)
+			expectedSeries := 0
+			if tc.expectedCode == http.StatusNoContent {
+				expectedSeries = 1
+			}
+			assert.Equal(t, expectedSeries, testutil.CollectAndCount(m.completedTasks))
+		})
+	}
+}
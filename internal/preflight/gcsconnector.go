@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// GCSConnectorCheckName is the name of the check verifying that the Cloud Storage connector is on the classpath of
+// the applications accessing Google Cloud Storage.
+const GCSConnectorCheckName = "gcs-connector"
+
+// GCSConnectorCheck verifies that the Cloud Storage connector configured by the operator for a SparkApplication
+// accessing Google Cloud Storage is on its classpath, either as a dependency of the application or in its images.
+// The connector is looked for in the labels and the layer history of the image configs, as found in the registry.
+// Registries the operator cannot reach are not reported, as with the image check.
+type GCSConnectorCheck struct {
+	registry *ImageCheck
+}
+
+// NewGCSConnectorCheck creates a new GCSConnectorCheck.
+func NewGCSConnectorCheck(reader client.Reader, _ Config) (Interface, error) {
+	return &GCSConnectorCheck{
+		registry: &ImageCheck{
+			reader:     reader,
+			httpClient: &http.Client{Timeout: 10 * time.Second},
+			scheme:     "https",
+		},
+	}, nil
+}
+
+// Name implements Interface.
+func (c *GCSConnectorCheck) Name() string {
+	return GCSConnectorCheckName
+}
+
+// Check implements Interface.
+func (c *GCSConnectorCheck) Check(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if !util.UseGCS(app) || declaresGCSConnector(app) {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+
+	var images []string
+	for _, image := range []*string{app.Spec.Driver.Image, app.Spec.Executor.Image} {
+		// Pods not overriding the image use the image of the application.
+		if image == nil {
+			image = app.Spec.Image
+		}
+		if image != nil && *image != "" && !slices.Contains(images, *image) {
+			images = append(images, *image)
+		}
+	}
+
+	for _, image := range images {
+		ref, err := parseImageReference(image)
+		if err != nil {
+			return err
+		}
+		auth, err := c.registry.getRegistryAuth(ctx, app, ref.registry)
+		if err != nil {
+			return err
+		}
+		status, manifest, err := c.registry.requestRegistry(ctx, http.MethodGet, fmt.Sprintf("manifests/%s", ref.reference), ref, auth)
+		if err != nil || status != http.StatusOK {
+			logger.Info("Skipping Cloud Storage connector check as the image manifest could not be read", "image", image, "status", status, "error", err)
+			continue
+		}
+		config, err := c.registry.getImageConfig(ctx, ref, auth, manifest)
+		if err != nil {
+			logger.Info("Skipping Cloud Storage connector check as the image config could not be read", "image", image, "error", err.Error())
+			continue
+		}
+		found, err := imageConfigMentionsGCSConnector(config)
+		if err != nil {
+			logger.Info("Skipping Cloud Storage connector check as the image config could not be decoded", "image", image, "error", err.Error())
+			continue
+		}
+		if !found {
+			return fmt.Errorf("%s not found on the classpath of image %s, add it to the image or to spec.deps.packages", common.GCSConnectorArtifact, image)
+		}
+	}
+	return nil
+}
+
+// declaresGCSConnector returns whether the Cloud Storage connector is among the dependencies of the given
+// SparkApplication or added to its classpath by its Spark configuration.
+func declaresGCSConnector(app *v1beta2.SparkApplication) bool {
+	for _, dep := range slices.Concat(app.Spec.Deps.Jars, app.Spec.Deps.Packages) {
+		if strings.Contains(dep, common.GCSConnectorArtifact) {
+			return true
+		}
+	}
+	for _, key := range []string{common.SparkJars, common.SparkJarsPackages, common.SparkDriverExtraClassPath, common.SparkExecutorExtraClassPath} {
+		if strings.Contains(app.Spec.SparkConf[key], common.GCSConnectorArtifact) {
+			return true
+		}
+	}
+	return false
+}
+
+// imageConfigMentionsGCSConnector returns whether the labels or the commands creating the layers of the image with
+// the given config mention the Cloud Storage connector.
+func imageConfigMentionsGCSConnector(data []byte) (bool, error) {
+	var config struct {
+		Config struct {
+			Labels map[string]string `json:"Labels"`
+		} `json:"config"`
+		History []struct {
+			CreatedBy string `json:"created_by"`
+		} `json:"history"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return false, err
+	}
+	for key, value := range config.Config.Labels {
+		if strings.Contains(key, common.GCSConnectorArtifact) || strings.Contains(value, common.GCSConnectorArtifact) {
+			return true, nil
+		}
+	}
+	for _, history := range config.History {
+		if strings.Contains(history.CreatedBy, common.GCSConnectorArtifact) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
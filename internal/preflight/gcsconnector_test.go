@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestGCSConnectorCheck(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/spark/gcs/manifests/3.5.0":
+			_, _ = fmt.Fprint(w, `{"manifests":[{"digest":"sha256:arm64","platform":{"architecture":"arm64","os":"linux"}}]}`)
+		case "/v2/spark/gcs/manifests/sha256:arm64":
+			_, _ = fmt.Fprint(w, `{"config":{"digest":"sha256:gcs"}}`)
+		case "/v2/spark/gcs/blobs/sha256:gcs":
+			_, _ = fmt.Fprint(w, `{"history":[{"created_by":"/bin/sh -c #(nop) ADD gcs-connector-3.0.4-shaded.jar /opt/spark/jars/"}]}`)
+		case "/v2/spark/labeled/manifests/3.5.0":
+			_, _ = fmt.Fprint(w, `{"config":{"digest":"sha256:labeled"}}`)
+		case "/v2/spark/labeled/blobs/sha256:labeled":
+			_, _ = fmt.Fprint(w, `{"config":{"Labels":{"org.example.jars":"hadoop-aws,gcs-connector"}}}`)
+		case "/v2/spark/plain/manifests/3.5.0":
+			_, _ = fmt.Fprint(w, `{"config":{"digest":"sha256:plain"}}`)
+		case "/v2/spark/plain/blobs/sha256:plain":
+			_, _ = fmt.Fprint(w, `{"config":{"Labels":{"maintainer":"spark"}},"history":[{"created_by":"COPY jars /opt/spark/jars"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "http://")
+
+	check := &GCSConnectorCheck{
+		registry: &ImageCheck{reader: fake.NewClientBuilder().Build(), httpClient: server.Client(), scheme: "http"},
+	}
+
+	newApp := func(image string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				Image: ptr.To(registry + "/" + image),
+				GCP: &v1beta2.GCPSpec{
+					WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@my-project.iam.gserviceaccount.com"},
+				},
+			},
+		}
+	}
+
+	t.Run("application not accessing Cloud Storage", func(t *testing.T) {
+		app := newApp("spark/plain:3.5.0")
+		app.Spec.GCP = nil
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("connector in the layer history of a multi-platform image", func(t *testing.T) {
+		assert.NoError(t, check.Check(context.Background(), newApp("spark/gcs:3.5.0")))
+	})
+
+	t.Run("connector in the labels of the image", func(t *testing.T) {
+		assert.NoError(t, check.Check(context.Background(), newApp("spark/labeled:3.5.0")))
+	})
+
+	t.Run("connector missing from the image", func(t *testing.T) {
+		err := check.Check(context.Background(), newApp("spark/plain:3.5.0"))
+		require.EqualError(t, err, fmt.Sprintf("gcs-connector not found on the classpath of image %s/spark/plain:3.5.0, add it to the image or to spec.deps.packages", registry))
+	})
+
+	t.Run("connector declared as a dependency", func(t *testing.T) {
+		app := newApp("spark/plain:3.5.0")
+		app.Spec.Deps.Packages = []string{"com.google.cloud.bigdataoss:gcs-connector:3.0.4"}
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("connector added by the Spark configuration", func(t *testing.T) {
+		app := newApp("spark/plain:3.5.0")
+		app.Spec.SparkConf = map[string]string{"spark.driver.extraClassPath": "/opt/jars/gcs-connector.jar", "spark.executor.extraClassPath": "/opt/jars/gcs-connector.jar"}
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("image not found", func(t *testing.T) {
+		assert.NoError(t, check.Check(context.Background(), newApp("spark/missing:3.5.0")))
+	})
+}
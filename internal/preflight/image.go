@@ -0,0 +1,532 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// ImageCheckName is the name of the check verifying that the images exist in their registries.
+const ImageCheckName = "image"
+
+const (
+	dockerHubRegistry     = "docker.io"
+	dockerHubRegistryHost = "registry-1.docker.io"
+	dockerHubAuthKey      = "https://index.docker.io/v1/"
+	dockerHubTokenHost    = "auth.docker.io"
+
+	// maxResponseSize is the maximum size of the manifests and image configs read from registries.
+	maxResponseSize = 4 << 20
+)
+
+// manifestMediaTypes are the media types of the image manifests and indexes accepted from registries.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// ImageCheck verifies that the driver and executor images of a SparkApplication exist by sending a HEAD request
+// for their manifests to the registry API, authenticated with the image pull secrets of the application.
+// When the driver or executors are pinned to a CPU architecture, their manifests are fetched instead to verify
+// that the images are built for it.
+// Registries the operator cannot reach, including the ones the egress policy does not allow, are not reported, as
+// the nodes may still be able to pull from them.
+type ImageCheck struct {
+	reader     client.Reader
+	httpClient *http.Client
+	scheme     string
+}
+
+// NewImageCheck creates a new ImageCheck sending requests to registries under the egress policy of the config.
+func NewImageCheck(reader client.Reader, config Config) (Interface, error) {
+	return &ImageCheck{
+		reader:     reader,
+		httpClient: config.EgressPolicy.NewClient(10 * time.Second),
+		scheme:     "https",
+	}, nil
+}
+
+// Name implements Interface.
+func (c *ImageCheck) Name() string {
+	return ImageCheckName
+}
+
+// Check implements Interface.
+func (c *ImageCheck) Check(ctx context.Context, app *v1beta2.SparkApplication) error {
+	logger := log.FromContext(ctx)
+
+	var images []string
+	// archs are the CPU architectures each image must be built for.
+	archs := make(map[string][]string)
+	for _, pod := range []struct {
+		image *string
+		arch  v1beta2.Arch
+	}{
+		{app.Spec.Driver.Image, util.GetDriverArch(app)},
+		{app.Spec.Executor.Image, util.GetExecutorArch(app)},
+	} {
+		image := pod.image
+		// Pods not overriding the image use the image of the application.
+		if image == nil {
+			image = app.Spec.Image
+		}
+		if image == nil || *image == "" {
+			continue
+		}
+		if !slices.Contains(images, *image) {
+			images = append(images, *image)
+		}
+		if pod.arch != "" && !slices.Contains(archs[*image], string(pod.arch)) {
+			archs[*image] = append(archs[*image], string(pod.arch))
+		}
+	}
+
+	for _, image := range images {
+		ref, err := parseImageReference(image)
+		if err != nil {
+			return err
+		}
+		auth, err := c.getRegistryAuth(ctx, app, ref.registry)
+		if err != nil {
+			return err
+		}
+
+		method := http.MethodHead
+		if len(archs[image]) > 0 {
+			method = http.MethodGet
+		}
+		status, manifest, err := c.requestRegistry(ctx, method, fmt.Sprintf("manifests/%s", ref.reference), ref, auth)
+		if err != nil {
+			logger.Info("Skipping image check as the registry is unreachable", "image", image, "error", err.Error())
+			continue
+		}
+		switch status {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			return fmt.Errorf("image %s not found", image)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return fmt.Errorf("not authorized to pull image %s", image)
+		default:
+			logger.Info("Skipping image check as the registry returned an unexpected status", "image", image, "status", status)
+			continue
+		}
+		if len(archs[image]) == 0 {
+			continue
+		}
+
+		platforms, err := c.getImageArchs(ctx, ref, auth, manifest)
+		if err != nil {
+			logger.Info("Skipping image architecture check as the image config could not be read", "image", image, "error", err.Error())
+			continue
+		}
+		for _, arch := range archs[image] {
+			if !slices.Contains(platforms, arch) {
+				return fmt.Errorf("image %s does not support architecture %s, only %s", image, arch, strings.Join(platforms, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// imageManifest holds the fields of an image index or manifest describing the platforms of an image.
+type imageManifest struct {
+	// Manifests are the manifests of the platforms of an image index.
+	Manifests []struct {
+		Digest   string `json:"digest"`
+		Platform *struct {
+			Architecture string `json:"architecture"`
+			OS           string `json:"os"`
+		} `json:"platform,omitempty"`
+	} `json:"manifests,omitempty"`
+	// Config is the config of a single-platform image manifest.
+	Config *struct {
+		Digest string `json:"digest"`
+	} `json:"config,omitempty"`
+}
+
+// getImageArchs returns the CPU architectures of the Linux platforms of the image with the given manifest. The
+// architecture of a single-platform image is read from its config.
+func (c *ImageCheck) getImageArchs(ctx context.Context, ref imageReference, auth *registryAuth, body []byte) ([]string, error) {
+	manifest := imageManifest{}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %v", err)
+	}
+
+	var archs []string
+	if len(manifest.Manifests) > 0 {
+		for _, m := range manifest.Manifests {
+			// Attestation manifests have an unknown platform.
+			if m.Platform != nil && m.Platform.OS == "linux" && !slices.Contains(archs, m.Platform.Architecture) {
+				archs = append(archs, m.Platform.Architecture)
+			}
+		}
+		return archs, nil
+	}
+	if manifest.Config == nil || manifest.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest has neither platforms nor config")
+	}
+
+	status, body, err := c.requestRegistry(ctx, http.MethodGet, fmt.Sprintf("blobs/%s", manifest.Config.Digest), ref, auth)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("registry returned status %d for the image config", status)
+	}
+	var config struct {
+		Architecture string `json:"architecture"`
+	}
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("failed to decode image config: %v", err)
+	}
+	return []string{config.Architecture}, nil
+}
+
+// getImageConfig returns the config of the image with the given manifest. The manifest of the first Linux platform
+// of an image index is fetched to read its config.
+func (c *ImageCheck) getImageConfig(ctx context.Context, ref imageReference, auth *registryAuth, body []byte) ([]byte, error) {
+	manifest := imageManifest{}
+	if err := json.Unmarshal(body, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %v", err)
+	}
+
+	if len(manifest.Manifests) > 0 {
+		digest := ""
+		for _, m := range manifest.Manifests {
+			if m.Platform != nil && m.Platform.OS == "linux" {
+				digest = m.Digest
+				break
+			}
+		}
+		if digest == "" {
+			return nil, fmt.Errorf("image index has no Linux platform")
+		}
+		status, body, err := c.requestRegistry(ctx, http.MethodGet, fmt.Sprintf("manifests/%s", digest), ref, auth)
+		if err != nil {
+			return nil, err
+		}
+		if status != http.StatusOK {
+			return nil, fmt.Errorf("registry returned status %d for the platform manifest", status)
+		}
+		manifest = imageManifest{}
+		if err := json.Unmarshal(body, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to decode platform manifest: %v", err)
+		}
+	}
+	if manifest.Config == nil || manifest.Config.Digest == "" {
+		return nil, fmt.Errorf("manifest has no config")
+	}
+
+	status, config, err := c.requestRegistry(ctx, http.MethodGet, fmt.Sprintf("blobs/%s", manifest.Config.Digest), ref, auth)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("registry returned status %d for the image config", status)
+	}
+	return config, nil
+}
+
+// imageReference is a parsed image reference.
+type imageReference struct {
+	registry   string
+	repository string
+	// reference is either a tag or a digest.
+	reference string
+}
+
+// parseImageReference parses an image reference such as `ghcr.io/org/spark:3.5.0` or `spark@sha256:...`,
+// applying the Docker Hub defaults to references without a registry.
+func parseImageReference(image string) (imageReference, error) {
+	ref := imageReference{}
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.reference = name[:i], name[i+1:]
+	} else {
+		ref.reference = "latest"
+	}
+
+	if i := strings.Index(name, "/"); i >= 0 && (strings.ContainsAny(name[:i], ".:") || name[:i] == "localhost") {
+		ref.registry, ref.repository = name[:i], name[i+1:]
+	} else {
+		ref.registry, ref.repository = dockerHubRegistry, name
+		if !strings.Contains(name, "/") {
+			ref.repository = "library/" + name
+		}
+	}
+
+	if ref.repository == "" || ref.reference == "" {
+		return imageReference{}, fmt.Errorf("invalid image %s", image)
+	}
+	return ref, nil
+}
+
+// registryHost returns the host serving the registry API of the given registry.
+func registryHost(registry string) string {
+	if registry == dockerHubRegistry {
+		return dockerHubRegistryHost
+	}
+	return registry
+}
+
+// registryAuth holds the credentials of a registry.
+type registryAuth struct {
+	// server is the server of the docker config entry holding the credentials.
+	server   string
+	username string
+	password string
+}
+
+// dockerConfig is the content of a dockerconfigjson image pull secret.
+type dockerConfig struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// getRegistryAuth returns the credentials of the given registry found in the image pull secrets of the
+// SparkApplication, or nil if there are none.
+func (c *ImageCheck) getRegistryAuth(ctx context.Context, app *v1beta2.SparkApplication, registry string) (*registryAuth, error) {
+	for _, name := range app.Spec.ImagePullSecrets {
+		secret := &corev1.Secret{}
+		if err := c.reader.Get(ctx, types.NamespacedName{Name: name, Namespace: app.Namespace}, secret); err != nil {
+			return nil, fmt.Errorf("failed to get image pull secret %s: %v", name, err)
+		}
+
+		config := dockerConfig{}
+		switch secret.Type {
+		case corev1.SecretTypeDockerConfigJson:
+			if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+				return nil, fmt.Errorf("failed to parse image pull secret %s: %v", name, err)
+			}
+		case corev1.SecretTypeDockercfg:
+			if err := json.Unmarshal(secret.Data[corev1.DockerConfigKey], &config.Auths); err != nil {
+				return nil, fmt.Errorf("failed to parse image pull secret %s: %v", name, err)
+			}
+		default:
+			continue
+		}
+
+		for server, entry := range config.Auths {
+			if !matchesRegistry(server, registry) {
+				continue
+			}
+			auth := &registryAuth{server: server, username: entry.Username, password: entry.Password}
+			if entry.Auth != "" {
+				decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode credentials of %s in image pull secret %s: %v", server, name, err)
+				}
+				auth.username, auth.password, _ = strings.Cut(string(decoded), ":")
+			}
+			return auth, nil
+		}
+	}
+	return nil, nil
+}
+
+// matchesRegistry returns whether the server of a docker config entry, which may be a URL, refers to the registry.
+func matchesRegistry(server, registry string) bool {
+	if registry == dockerHubRegistry {
+		switch server {
+		case dockerHubAuthKey, "index.docker.io", dockerHubRegistry, dockerHubRegistryHost:
+			return true
+		}
+	}
+	return serverHost(server) == registry
+}
+
+// serverHost returns the host of the server of a docker config entry, which may be a URL.
+func serverHost(server string) string {
+	host := server
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	host, _, _ = strings.Cut(host, "/")
+	return host
+}
+
+// isTrustedTokenRealm returns whether the credentials of the given registry may be sent to the given token realm,
+// which is sent by the registry and thus only trusted over https on the host of the registry, or of the docker
+// config entry holding the credentials.
+func isTrustedTokenRealm(realm *url.URL, ref imageReference, auth *registryAuth) bool {
+	if realm.Scheme != "https" {
+		return false
+	}
+	switch realm.Host {
+	case registryHost(ref.registry), ref.registry, serverHost(auth.server):
+		return true
+	case dockerHubTokenHost:
+		return ref.registry == dockerHubRegistry
+	}
+	return false
+}
+
+// requestRegistry sends a request for the given path under the repository of the image, such as its manifest, and
+// returns the status code and body of the response, answering the bearer token or basic authentication challenge
+// of the registry if needed.
+func (c *ImageCheck) requestRegistry(ctx context.Context, method, path string, ref imageReference, auth *registryAuth) (int, []byte, error) {
+	requestURL := fmt.Sprintf("%s://%s/v2/%s/%s", c.scheme, registryHost(ref.registry), ref.repository, path)
+	resp, body, err := c.do(ctx, method, requestURL, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return resp.StatusCode, body, nil
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	scheme, params := parseChallenge(challenge)
+	var header string
+	switch scheme {
+	case "bearer":
+		token, err := c.getToken(ctx, params, ref, auth)
+		if err != nil {
+			return 0, nil, err
+		}
+		if token == "" {
+			return http.StatusUnauthorized, nil, nil
+		}
+		header = "Bearer " + token
+	case "basic":
+		if auth == nil {
+			return http.StatusUnauthorized, nil, nil
+		}
+		header = "Basic " + base64.StdEncoding.EncodeToString([]byte(auth.username+":"+auth.password))
+	default:
+		return http.StatusUnauthorized, nil, nil
+	}
+
+	resp, body, err = c.do(ctx, method, requestURL, map[string]string{"Authorization": header})
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, body, nil
+}
+
+// getToken requests a pull token for the repository from the token service of the registry. The credentials of the
+// registry are only sent to trusted token services, and anonymous tokens are requested from the other ones.
+func (c *ImageCheck) getToken(ctx context.Context, params map[string]string, ref imageReference, auth *registryAuth) (string, error) {
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme == "" {
+		return "", fmt.Errorf("invalid token realm %q", params["realm"])
+	}
+	query := realm.Query()
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.repository))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if auth != nil && isTrustedTokenRealm(realm, ref, auth) {
+		req.SetBasicAuth(auth.username, auth.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token service returned status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode token: %v", err)
+	}
+	if body.Token != "" {
+		return body.Token, nil
+	}
+	return body.AccessToken, nil
+}
+
+func (c *ImageCheck) do(ctx context.Context, method, url string, headers map[string]string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, body, nil
+}
+
+// parseChallenge parses a WWW-Authenticate header such as `Bearer realm="https://auth.example.com/token",service="registry"`
+// into its lower-cased scheme and its parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(rest, "=")
+		key = strings.ToLower(strings.TrimSpace(strings.TrimLeft(key, ", ")))
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[key] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestParseImageReference(t *testing.T) {
+	testCases := []struct {
+		image    string
+		expected imageReference
+	}{
+		{image: "spark", expected: imageReference{registry: "docker.io", repository: "library/spark", reference: "latest"}},
+		{image: "apache/spark:3.5.0", expected: imageReference{registry: "docker.io", repository: "apache/spark", reference: "3.5.0"}},
+		{image: "ghcr.io/org/team/spark:v1", expected: imageReference{registry: "ghcr.io", repository: "org/team/spark", reference: "v1"}},
+		{image: "localhost:5000/spark", expected: imageReference{registry: "localhost:5000", repository: "spark", reference: "latest"}},
+		{image: "quay.io/org/spark@sha256:abc", expected: imageReference{registry: "quay.io", repository: "org/spark", reference: "sha256:abc"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.image, func(t *testing.T) {
+			ref, err := parseImageReference(tc.image)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, ref)
+		})
+	}
+
+	_, err := parseImageReference("spark:")
+	require.Error(t, err)
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:spark:pull"`)
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:spark:pull",
+	}, params)
+}
+
+func TestImageCheck(t *testing.T) {
+	const token = "pull-token"
+	// The token services over http or of another host must not receive the credentials of the registry.
+	var untrustedRealmRequests int
+	untrustedRealmHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		untrustedRealmRequests++
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok, "credentials sent to an untrusted token realm")
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	insecureRealm := httptest.NewServer(untrustedRealmHandler)
+	defer insecureRealm.Close()
+	foreignRealm := httptest.NewTLSServer(untrustedRealmHandler)
+	defer foreignRealm.Close()
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			username, password, ok := r.BasicAuth()
+			if !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			assert.Equal(t, "repository:spark/private:pull", r.URL.Query().Get("scope"))
+			_, _ = fmt.Fprintf(w, `{"token":%q}`, token)
+		case r.URL.Path == "/v2/spark/public/manifests/3.5.0":
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/v2/spark/multiarch/manifests/3.5.0":
+			_, _ = fmt.Fprint(w, `{"manifests":[{"platform":{"architecture":"amd64","os":"linux"}},`+
+				`{"platform":{"architecture":"arm64","os":"linux"}},{"platform":{"architecture":"unknown","os":"unknown"}}]}`)
+		case r.URL.Path == "/v2/spark/amd64/manifests/3.5.0":
+			_, _ = fmt.Fprint(w, `{"config":{"digest":"sha256:config"}}`)
+		case r.URL.Path == "/v2/spark/amd64/blobs/sha256:config":
+			_, _ = fmt.Fprint(w, `{"architecture":"amd64","os":"linux"}`)
+		case r.URL.Path == "/v2/spark/private/manifests/3.5.0":
+			if r.Header.Get("Authorization") != "Bearer "+token {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/v2/spark/insecure-realm/manifests/3.5.0":
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, insecureRealm.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/spark/foreign-realm/manifests/3.5.0":
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, foreignRealm.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	registry := strings.TrimPrefix(server.URL, "https://")
+
+	auth := base64.StdEncoding.EncodeToString([]byte("user:pass"))
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "registry", Namespace: "default"},
+		Type:       corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(fmt.Sprintf(`{"auths":{"%s":{"auth":%q}}}`, registry, auth)),
+		},
+	}
+	reader := fake.NewClientBuilder().WithObjects(pullSecret).Build()
+	check := &ImageCheck{reader: reader, httpClient: server.Client(), scheme: "https"}
+
+	newApp := func(image string, pullSecrets ...string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+			Spec: v1beta2.SparkApplicationSpec{
+				Image:            ptr.To(registry + "/" + image),
+				ImagePullSecrets: pullSecrets,
+			},
+		}
+	}
+
+	t.Run("public image", func(t *testing.T) {
+		assert.NoError(t, check.Check(context.Background(), newApp("spark/public:3.5.0")))
+	})
+
+	t.Run("private image with credentials", func(t *testing.T) {
+		assert.NoError(t, check.Check(context.Background(), newApp("spark/private:3.5.0", "registry")))
+	})
+
+	t.Run("private image without credentials", func(t *testing.T) {
+		err := check.Check(context.Background(), newApp("spark/private:3.5.0"))
+		require.EqualError(t, err, fmt.Sprintf("not authorized to pull image %s/spark/private:3.5.0", registry))
+	})
+
+	t.Run("credentials are not sent to an insecure token realm", func(t *testing.T) {
+		untrustedRealmRequests = 0
+		err := check.Check(context.Background(), newApp("spark/insecure-realm:3.5.0", "registry"))
+		require.EqualError(t, err, fmt.Sprintf("not authorized to pull image %s/spark/insecure-realm:3.5.0", registry))
+		assert.Equal(t, 1, untrustedRealmRequests)
+	})
+
+	t.Run("credentials are not sent to the token realm of another host", func(t *testing.T) {
+		untrustedRealmRequests = 0
+		err := check.Check(context.Background(), newApp("spark/foreign-realm:3.5.0", "registry"))
+		require.EqualError(t, err, fmt.Sprintf("not authorized to pull image %s/spark/foreign-realm:3.5.0", registry))
+		assert.Equal(t, 1, untrustedRealmRequests)
+	})
+
+	t.Run("missing image", func(t *testing.T) {
+		err := check.Check(context.Background(), newApp("spark/public:4.0.0"))
+		require.EqualError(t, err, fmt.Sprintf("image %s/spark/public:4.0.0 not found", registry))
+	})
+
+	t.Run("executor image overriding the application image", func(t *testing.T) {
+		app := newApp("spark/public:3.5.0")
+		app.Spec.Executor.Image = ptr.To(registry + "/spark/executor:3.5.0")
+		err := check.Check(context.Background(), app)
+		require.EqualError(t, err, fmt.Sprintf("image %s/spark/executor:3.5.0 not found", registry))
+	})
+
+	t.Run("multi-arch image", func(t *testing.T) {
+		app := newApp("spark/multiarch:3.5.0")
+		app.Spec.Arch = ptr.To(v1beta2.ArchAMD64)
+		app.Spec.Executor.Arch = ptr.To(v1beta2.ArchARM64)
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("single-arch image", func(t *testing.T) {
+		app := newApp("spark/amd64:3.5.0")
+		app.Spec.Driver.Arch = ptr.To(v1beta2.ArchAMD64)
+		assert.NoError(t, check.Check(context.Background(), app))
+
+		app.Spec.Executor.Arch = ptr.To(v1beta2.ArchARM64)
+		err := check.Check(context.Background(), app)
+		require.EqualError(t, err, fmt.Sprintf("image %s/spark/amd64:3.5.0 does not support architecture arm64, only amd64", registry))
+	})
+
+	t.Run("unreachable registry", func(t *testing.T) {
+		app := newApp("spark/public:3.5.0")
+		app.Spec.Image = ptr.To("127.0.0.1:1/spark:3.5.0")
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+}
+
+func TestIsTrustedTokenRealm(t *testing.T) {
+	testCases := []struct {
+		name     string
+		realm    string
+		registry string
+		server   string
+		expected bool
+	}{
+		{name: "registry host", realm: "https://registry.example.com/token", registry: "registry.example.com", expected: true},
+		{name: "docker config entry host", realm: "https://auth.example.com/token", registry: "registry.example.com",
+			server: "https://auth.example.com", expected: true},
+		{name: "docker hub token service", realm: "https://auth.docker.io/token", registry: "docker.io", server: "https://index.docker.io/v1/", expected: true},
+		{name: "http", realm: "http://registry.example.com/token", registry: "registry.example.com"},
+		{name: "other host", realm: "https://attacker.example.com/token", registry: "registry.example.com"},
+		{name: "docker hub token service for another registry", realm: "https://auth.docker.io/token", registry: "registry.example.com"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			realm, err := url.Parse(tc.realm)
+			require.NoError(t, err)
+			auth := &registryAuth{username: "user", password: "pass", server: tc.server}
+			assert.Equal(t, tc.expected, isTrustedTokenRealm(realm, imageReference{registry: tc.registry}, auth))
+		})
+	}
+}
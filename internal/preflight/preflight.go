@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package preflight implements the checks run against a SparkApplication before it is submitted, so that
+// problems such as a missing image or ConfigMap are reported before any Spark pod is created.
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+)
+
+// Interface defines the interface of a pre-flight check.
+type Interface interface {
+	Name() string
+	// Check returns an error describing why the given SparkApplication cannot be submitted, or nil if it can.
+	Check(ctx context.Context, app *v1beta2.SparkApplication) error
+}
+
+// Config configures the pre-flight checks.
+type Config struct {
+	// EgressPolicy restricts the destinations of the requests sent by the checks, e.g. to image registries.
+	// Nil only allows public addresses.
+	EgressPolicy *egress.Policy
+}
+
+// Factory defines the factory of a pre-flight check.
+type Factory func(reader client.Reader, config Config) (Interface, error)
+
+// Failure is the failure of a pre-flight check.
+type Failure struct {
+	Check  string
+	Reason string
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("%s: %s", f.Check, f.Reason)
+}
+
+// Run runs the given checks against the SparkApplication and returns their failures.
+func Run(ctx context.Context, checks []Interface, app *v1beta2.SparkApplication) []Failure {
+	logger := log.FromContext(ctx)
+	var failures []Failure
+	for _, check := range checks {
+		if err := check.Check(ctx, app); err != nil {
+			logger.Info("SparkApplication failed pre-flight check", "check", check.Name(), "reason", err.Error())
+			failures = append(failures, Failure{Check: check.Name(), Reason: err.Error()})
+		}
+	}
+	return failures
+}
+
+// FormatFailures formats the given failures into a single message.
+func FormatFailures(failures []Failure) string {
+	messages := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		messages = append(messages, failure.String())
+	}
+	return strings.Join(messages, "; ")
+}
+
+var registry *Registry
+
+// Registry is a registry of pre-flight check factories.
+type Registry struct {
+	factories map[string]Factory
+
+	mu sync.Mutex
+}
+
+// GetRegistry returns the registry of pre-flight checks, with the built-in checks registered.
+func GetRegistry() *Registry {
+	if registry == nil {
+		registry = &Registry{
+			factories: map[string]Factory{
+				ImageCheckName:        NewImageCheck,
+				ReferencesCheckName:   NewReferencesCheck,
+				QuotaCheckName:        NewQuotaCheck,
+				GCSConnectorCheckName: NewGCSConnectorCheck,
+			},
+		}
+	}
+	return registry
+}
+
+// Register registers a pre-flight check factory.
+func (r *Registry) Register(name string, factory Factory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.factories[name]; ok {
+		return fmt.Errorf("pre-flight check %s is already registered", name)
+	}
+
+	r.factories[name] = factory
+	return nil
+}
+
+// GetChecks creates the pre-flight checks with the given names.
+func (r *Registry) GetChecks(names []string, reader client.Reader, config Config) ([]Interface, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var checks []Interface
+	for _, name := range names {
+		factory, ok := r.factories[name]
+		if !ok {
+			return nil, fmt.Errorf("pre-flight check %s not found", name)
+		}
+		check, err := factory(reader, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pre-flight check %s: %v", name, err)
+		}
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// GetRegisteredCheckNames gets the registered pre-flight check names.
+func (r *Registry) GetRegisteredCheckNames() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
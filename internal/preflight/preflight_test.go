@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+type fakeCheck struct {
+	name string
+	err  error
+}
+
+func (c *fakeCheck) Name() string {
+	return c.name
+}
+
+func (c *fakeCheck) Check(_ context.Context, _ *v1beta2.SparkApplication) error {
+	return c.err
+}
+
+func TestRun(t *testing.T) {
+	app := &v1beta2.SparkApplication{}
+	checks := []Interface{
+		&fakeCheck{name: "passing"},
+		&fakeCheck{name: "image", err: fmt.Errorf("image spark:3.5.0 not found")},
+		&fakeCheck{name: "references", err: fmt.Errorf("configmap conf not found")},
+	}
+
+	assert.Empty(t, Run(context.Background(), nil, app))
+
+	failures := Run(context.Background(), checks, app)
+	assert.Equal(t, []Failure{
+		{Check: "image", Reason: "image spark:3.5.0 not found"},
+		{Check: "references", Reason: "configmap conf not found"},
+	}, failures)
+	assert.Equal(t, "image: image spark:3.5.0 not found; references: configmap conf not found", FormatFailures(failures))
+}
+
+func TestRegistry(t *testing.T) {
+	registry := &Registry{factories: map[string]Factory{ReferencesCheckName: NewReferencesCheck}}
+	reader := fake.NewClientBuilder().Build()
+
+	require.NoError(t, registry.Register("custom", func(_ client.Reader, _ Config) (Interface, error) {
+		return &fakeCheck{name: "custom"}, nil
+	}))
+	require.Error(t, registry.Register("custom", nil))
+	assert.Equal(t, []string{"custom", ReferencesCheckName}, registry.GetRegisteredCheckNames())
+
+	checks, err := registry.GetChecks([]string{ReferencesCheckName, "custom"}, reader, Config{})
+	require.NoError(t, err)
+	require.Len(t, checks, 2)
+	assert.Equal(t, ReferencesCheckName, checks[0].Name())
+	assert.Equal(t, "custom", checks[1].Name())
+
+	_, err = registry.GetChecks([]string{"unknown"}, reader, Config{})
+	require.EqualError(t, err, "pre-flight check unknown not found")
+}
+
+func TestGetRegistry(t *testing.T) {
+	assert.Equal(t, []string{GCSConnectorCheckName, ImageCheckName, QuotaCheckName, ReferencesCheckName}, GetRegistry().GetRegisteredCheckNames())
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/webhook"
+)
+
+// QuotaCheckName is the name of the check verifying that the ResourceQuotas have enough headroom.
+const QuotaCheckName = "quota"
+
+// QuotaCheck verifies that the resources requested by the driver and executors of a SparkApplication fit
+// in the headroom left by the ResourceQuotas of its namespace.
+type QuotaCheck struct {
+	reader client.Reader
+}
+
+// NewQuotaCheck creates a new QuotaCheck.
+func NewQuotaCheck(reader client.Reader, _ Config) (Interface, error) {
+	return &QuotaCheck{reader: reader}, nil
+}
+
+// Name implements Interface.
+func (c *QuotaCheck) Name() string {
+	return QuotaCheckName
+}
+
+// Check implements Interface.
+func (c *QuotaCheck) Check(ctx context.Context, app *v1beta2.SparkApplication) error {
+	return webhook.ValidateResourceQuotas(ctx, c.reader, app)
+}
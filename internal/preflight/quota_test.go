@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestQuotaCheck(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver:   v1beta2.DriverSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}},
+			Executor: v1beta2.ExecutorSpec{SparkPodSpec: v1beta2.SparkPodSpec{Cores: ptr.To[int32](1), Memory: ptr.To("1g")}, Instances: ptr.To[int32](2)},
+		},
+	}
+	newQuota := func(usedCPU string) *corev1.ResourceQuota {
+		hard := corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")}
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+			Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: hard,
+				Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse(usedCPU)},
+			},
+		}
+	}
+
+	t.Run("enough headroom", func(t *testing.T) {
+		check, err := NewQuotaCheck(fake.NewClientBuilder().WithObjects(newQuota("1")).Build(), Config{})
+		require.NoError(t, err)
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("not enough headroom", func(t *testing.T) {
+		check, err := NewQuotaCheck(fake.NewClientBuilder().WithObjects(newQuota("2")).Build(), Config{})
+		require.NoError(t, err)
+		err = check.Check(context.Background(), app)
+		require.EqualError(t, err, `failed to validate resource quota "default/compute": exceeded requests.cpu`)
+	})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// ReferencesCheckName is the name of the check verifying that referenced ConfigMaps, Secrets and
+// PersistentVolumeClaims exist.
+const ReferencesCheckName = "references"
+
+// onDemandClaimName is the claim name asking Spark to create a PersistentVolumeClaim for each pod.
+const onDemandClaimName = "OnDemand"
+
+// ReferencesCheck verifies that the ConfigMaps, Secrets and PersistentVolumeClaims referenced by
+// a SparkApplication exist in its namespace. Optional references are not checked.
+type ReferencesCheck struct {
+	reader client.Reader
+}
+
+// NewReferencesCheck creates a new ReferencesCheck.
+func NewReferencesCheck(reader client.Reader, _ Config) (Interface, error) {
+	return &ReferencesCheck{reader: reader}, nil
+}
+
+// Name implements Interface.
+func (c *ReferencesCheck) Name() string {
+	return ReferencesCheckName
+}
+
+// Check implements Interface.
+func (c *ReferencesCheck) Check(ctx context.Context, app *v1beta2.SparkApplication) error {
+	configMaps, secrets, claims := getReferences(app)
+
+	var missing []string
+	for _, ref := range []struct {
+		kind  string
+		names []string
+		obj   func() client.Object
+	}{
+		{kind: "configmap", names: configMaps, obj: func() client.Object { return &corev1.ConfigMap{} }},
+		{kind: "secret", names: secrets, obj: func() client.Object { return &corev1.Secret{} }},
+		{kind: "persistentvolumeclaim", names: claims, obj: func() client.Object { return &corev1.PersistentVolumeClaim{} }},
+	} {
+		for _, name := range ref.names {
+			err := c.reader.Get(ctx, types.NamespacedName{Name: name, Namespace: app.Namespace}, ref.obj())
+			if errors.IsNotFound(err) {
+				missing = append(missing, fmt.Sprintf("%s %s not found", ref.kind, name))
+			} else if err != nil {
+				return fmt.Errorf("failed to get %s %s: %v", ref.kind, name, err)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// getReferences returns the names of the ConfigMaps, Secrets and PersistentVolumeClaims the given
+// SparkApplication requires, without duplicates.
+func getReferences(app *v1beta2.SparkApplication) (configMaps, secrets, claims []string) {
+	add := func(names *[]string, name string) {
+		if name != "" && !slices.Contains(*names, name) {
+			*names = append(*names, name)
+		}
+	}
+
+	if app.Spec.SparkConfigMap != nil {
+		add(&configMaps, *app.Spec.SparkConfigMap)
+	}
+	if app.Spec.HadoopConfigMap != nil {
+		add(&configMaps, *app.Spec.HadoopConfigMap)
+	}
+
+	for _, volume := range app.Spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil && !isOptional(volume.ConfigMap.Optional):
+			add(&configMaps, volume.ConfigMap.Name)
+		case volume.Secret != nil && !isOptional(volume.Secret.Optional):
+			add(&secrets, volume.Secret.SecretName)
+		case volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName != onDemandClaimName:
+			add(&claims, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+
+	for _, podSpec := range []*v1beta2.SparkPodSpec{&app.Spec.Driver.SparkPodSpec, &app.Spec.Executor.SparkPodSpec} {
+		for _, configMap := range podSpec.ConfigMaps {
+			add(&configMaps, configMap.Name)
+		}
+		for _, secret := range podSpec.Secrets {
+			if secret.Type != v1beta2.SecretTypeVault {
+				add(&secrets, secret.Name)
+			}
+		}
+		for _, ref := range podSpec.EnvSecretKeyRefs {
+			add(&secrets, ref.Name)
+		}
+		for _, ref := range podSpec.EnvSecretRefs {
+			add(&secrets, ref.SecretName)
+		}
+		for _, env := range podSpec.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil && !isOptional(ref.Optional) {
+				add(&configMaps, ref.Name)
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil && !isOptional(ref.Optional) {
+				add(&secrets, ref.Name)
+			}
+		}
+		for _, envFrom := range podSpec.EnvFrom {
+			if ref := envFrom.ConfigMapRef; ref != nil && !isOptional(ref.Optional) {
+				add(&configMaps, ref.Name)
+			}
+			if ref := envFrom.SecretRef; ref != nil && !isOptional(ref.Optional) {
+				add(&secrets, ref.Name)
+			}
+		}
+	}
+
+	return configMaps, secrets, claims
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package preflight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestReferencesCheck(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConfigMap: ptr.To("spark-conf"),
+			Volumes: []corev1.Volume{
+				{Name: "data", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}},
+				{Name: "scratch", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "OnDemand"}}},
+				{Name: "extra", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "extra"},
+					Optional:             ptr.To(true),
+				}}},
+			},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Secrets: []v1beta2.SecretInfo{
+						{Name: "gcp-key", Path: "/etc/gcp", Type: v1beta2.SecretTypeGCPServiceAccount},
+						{Name: "secret/data/db", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+					},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					EnvFrom: []corev1.EnvFromSource{
+						{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "db"}}},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("all references exist", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithObjects(
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "spark-conf", Namespace: "default"}},
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gcp-key", Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}},
+		).Build()
+		check, err := NewReferencesCheck(reader, Config{})
+		require.NoError(t, err)
+		assert.NoError(t, check.Check(context.Background(), app))
+	})
+
+	t.Run("missing references", func(t *testing.T) {
+		reader := fake.NewClientBuilder().WithObjects(
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "gcp-key", Namespace: "default"}},
+		).Build()
+		check, err := NewReferencesCheck(reader, Config{})
+		require.NoError(t, err)
+		err = check.Check(context.Background(), app)
+		require.EqualError(t, err, "configmap spark-conf not found, secret db not found, persistentvolumeclaim data not found")
+	})
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// Names of the results written by Result.WriteTo, matching the results declared by the Tekton Task.
+const (
+	ResultAppID         = "app-id"
+	ResultDuration      = "duration"
+	ResultFailureReason = "failure-reason"
+	ResultState         = "state"
+)
+
+// Result describes how a SparkApplication run by Run has terminated.
+type Result struct {
+	// Name is the name of the SparkApplication, which is generated if the manifest only sets metadata.generateName.
+	Name string
+	// AppID is the Spark application ID reported by the driver.
+	AppID string
+	// State is the final state of the SparkApplication.
+	State v1beta2.ApplicationStateType
+	// Duration is the time between the last submission attempt and the termination of the SparkApplication.
+	Duration time.Duration
+	// FailureReason is the error message of the SparkApplication if it has failed.
+	FailureReason string
+}
+
+// Succeeded returns whether the SparkApplication has completed successfully.
+func (r *Result) Succeeded() bool {
+	return r.State == v1beta2.ApplicationStateCompleted
+}
+
+// WriteTo writes every field of the result to a file named after the result in the given directory, which is
+// how Tekton steps report results when the directory is /tekton/results.
+func (r *Result) WriteTo(dir string) error {
+	results := map[string]string{
+		ResultAppID:         r.AppID,
+		ResultDuration:      strconv.FormatInt(int64(r.Duration.Seconds()), 10),
+		ResultFailureReason: r.FailureReason,
+		ResultState:         string(r.State),
+	}
+	for name, value := range results {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0644); err != nil {
+			return fmt.Errorf("failed to write result %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// Run creates the given SparkApplication and blocks until it has completed or failed, watching the application
+// rather than polling for it. The watch is established again whenever the API server closes it. Run returns an
+// error if the application could not be created, was deleted before terminating or the context is done.
+func Run(ctx context.Context, c client.WithWatch, app *v1beta2.SparkApplication) (*Result, error) {
+	logger := log.FromContext(ctx)
+
+	if err := c.Create(ctx, app); err != nil {
+		return nil, fmt.Errorf("failed to create SparkApplication: %v", err)
+	}
+	logger.Info("Created SparkApplication", "name", app.Name, "namespace", app.Namespace)
+
+	key := client.ObjectKeyFromObject(app)
+	for {
+		// Get the latest state before each watch, so that updates made while no watch was established are not missed.
+		if err := c.Get(ctx, key, app); err != nil {
+			return nil, fmt.Errorf("failed to get SparkApplication: %v", err)
+		}
+		if util.IsTerminated(app) {
+			return newResult(app), nil
+		}
+
+		watcher, err := c.Watch(ctx, &v1beta2.SparkApplicationList{},
+			client.InNamespace(app.Namespace),
+			&client.ListOptions{
+				FieldSelector: fields.OneTermEqualSelector("metadata.name", app.Name),
+				Raw:           &metav1.ListOptions{ResourceVersion: app.ResourceVersion},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to watch SparkApplication: %v", err)
+		}
+		terminated, err := waitForTermination(ctx, watcher, app)
+		watcher.Stop()
+		if err != nil {
+			return nil, err
+		}
+		if terminated {
+			return newResult(app), nil
+		}
+	}
+}
+
+// waitForTermination consumes the events of the given watcher, keeping app up to date, until the application has
+// terminated or the watch is closed, in which case false is returned.
+func waitForTermination(ctx context.Context, watcher watch.Interface, app *v1beta2.SparkApplication) (bool, error) {
+	logger := log.FromContext(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, fmt.Errorf("stopped waiting for SparkApplication %s/%s: %v", app.Namespace, app.Name, ctx.Err())
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return false, nil
+			}
+			switch event.Type {
+			case watch.Error:
+				logger.Info("Watch of SparkApplication failed, establishing it again", "error", event.Object)
+				return false, nil
+			case watch.Bookmark:
+				continue
+			}
+
+			current, ok := event.Object.(*v1beta2.SparkApplication)
+			if !ok || current.Name != app.Name {
+				continue
+			}
+			if event.Type == watch.Deleted {
+				return false, fmt.Errorf("SparkApplication %s/%s was deleted before terminating", app.Namespace, app.Name)
+			}
+
+			if current.Status.AppState.State != app.Status.AppState.State {
+				logger.Info("SparkApplication state changed", "name", app.Name, "state", current.Status.AppState.State)
+			}
+			current.DeepCopyInto(app)
+			if util.IsTerminated(app) {
+				return true, nil
+			}
+		}
+	}
+}
+
+// newResult returns the result of the given terminated SparkApplication.
+func newResult(app *v1beta2.SparkApplication) *Result {
+	result := &Result{
+		Name:  app.Name,
+		AppID: app.Status.SparkApplicationID,
+		State: app.Status.AppState.State,
+	}
+	if app.Status.AppState.State == v1beta2.ApplicationStateFailed {
+		result.FailureReason = app.Status.AppState.ErrorMessage
+	}
+	if !app.Status.LastSubmissionAttemptTime.IsZero() && !app.Status.TerminationTime.IsZero() {
+		result.Duration = app.Status.TerminationTime.Sub(app.Status.LastSubmissionAttemptTime.Time)
+	}
+	return result
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runner
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// newTestClient returns a fake client and a channel receiving a value every time Run establishes a watch.
+func newTestClient(t *testing.T) (client.WithWatch, chan struct{}) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	watches := make(chan struct{}, 10)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Watch: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+			watcher, err := c.Watch(ctx, list, opts...)
+			watches <- struct{}{}
+			return watcher, err
+		},
+	}).Build()
+	return c, watches
+}
+
+func newTestApp() *v1beta2.SparkApplication {
+	return &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "default"},
+	}
+}
+
+// runAsync runs the given application in the background and returns a channel receiving the outcome.
+func runAsync(ctx context.Context, c client.WithWatch, app *v1beta2.SparkApplication) (chan *Result, chan error) {
+	results := make(chan *Result, 1)
+	errs := make(chan error, 1)
+	go func() {
+		result, err := Run(ctx, c, app.DeepCopy())
+		results <- result
+		errs <- err
+	}()
+	return results, errs
+}
+
+func updateState(t *testing.T, c client.Client, state v1beta2.ApplicationState, mutate func(*v1beta2.SparkApplication)) {
+	app := &v1beta2.SparkApplication{}
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "spark-pi"}, app))
+	app.Status.AppState = state
+	if mutate != nil {
+		mutate(app)
+	}
+	require.NoError(t, c.Update(context.Background(), app))
+}
+
+func waitForWatch(t *testing.T, watches chan struct{}) {
+	select {
+	case <-watches:
+	case <-time.After(10 * time.Second):
+		t.Fatal("watch was not established")
+	}
+}
+
+func TestRunCompleted(t *testing.T) {
+	c, watches := newTestClient(t)
+	results, errs := runAsync(context.Background(), c, newTestApp())
+	waitForWatch(t, watches)
+
+	submitted := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	updateState(t, c, v1beta2.ApplicationState{State: v1beta2.ApplicationStateRunning}, func(app *v1beta2.SparkApplication) {
+		app.Status.SparkApplicationID = "spark-0123"
+		app.Status.LastSubmissionAttemptTime = submitted
+	})
+	updateState(t, c, v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted}, func(app *v1beta2.SparkApplication) {
+		app.Status.TerminationTime = metav1.NewTime(submitted.Add(90 * time.Second))
+	})
+
+	result := <-results
+	require.NoError(t, <-errs)
+	assert.Equal(t, &Result{
+		Name:     "spark-pi",
+		AppID:    "spark-0123",
+		State:    v1beta2.ApplicationStateCompleted,
+		Duration: 90 * time.Second,
+	}, result)
+	assert.True(t, result.Succeeded())
+
+	dir := t.TempDir()
+	require.NoError(t, result.WriteTo(dir))
+	for name, expected := range map[string]string{
+		ResultAppID:         "spark-0123",
+		ResultDuration:      "90",
+		ResultFailureReason: "",
+		ResultState:         "COMPLETED",
+	} {
+		value, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(value), name)
+	}
+}
+
+func TestRunFailed(t *testing.T) {
+	c, watches := newTestClient(t)
+	results, errs := runAsync(context.Background(), c, newTestApp())
+	waitForWatch(t, watches)
+
+	updateState(t, c, v1beta2.ApplicationState{
+		State:        v1beta2.ApplicationStateFailed,
+		ErrorMessage: "driver container failed with ExitCode: 1",
+	}, nil)
+
+	result := <-results
+	require.NoError(t, <-errs)
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, "driver container failed with ExitCode: 1", result.FailureReason)
+	assert.Zero(t, result.Duration)
+}
+
+func TestRunAlreadyExists(t *testing.T) {
+	c, _ := newTestClient(t)
+	require.NoError(t, c.Create(context.Background(), newTestApp()))
+
+	_, err := Run(context.Background(), c, newTestApp())
+	assert.ErrorContains(t, err, "failed to create SparkApplication")
+}
+
+func TestRunDeleted(t *testing.T) {
+	c, watches := newTestClient(t)
+	_, errs := runAsync(context.Background(), c, newTestApp())
+	waitForWatch(t, watches)
+
+	require.NoError(t, c.Delete(context.Background(), newTestApp()))
+	assert.EqualError(t, <-errs, "SparkApplication default/spark-pi was deleted before terminating")
+}
+
+func TestRunContextDone(t *testing.T) {
+	c, watches := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	_, errs := runAsync(ctx, c, newTestApp())
+	waitForWatch(t, watches)
+
+	cancel()
+	assert.ErrorContains(t, <-errs, "stopped waiting for SparkApplication default/spark-pi")
+}
+
+func TestRunWatchClosed(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1beta2.AddToScheme(scheme))
+
+	closed := watch.NewFake()
+	established := make(chan struct{})
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Watch: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+			select {
+			case <-established:
+				return c.Watch(ctx, list, opts...)
+			default:
+				close(established)
+				return closed, nil
+			}
+		},
+	}).Build()
+	results, errs := runAsync(context.Background(), c, newTestApp())
+	<-established
+
+	// The update is not sent to the first watch, so it is only observed once the watch has been established again.
+	updateState(t, c, v1beta2.ApplicationState{State: v1beta2.ApplicationStateCompleted}, nil)
+	closed.Stop()
+
+	result := <-results
+	require.NoError(t, <-errs)
+	assert.True(t, result.Succeeded())
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runparams expands the per-run parameters of ScheduledSparkApplications, written as Go templates such as
+// {{ .ScheduledTime.Format "2006-01-02" }} or {{ .RunID }}, into the arguments and Spark configuration of the
+// SparkApplication of each run.
+package runparams
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// Time is a time that is rendered in RFC 3339 format by templates, which can still call its methods, e.g. Format.
+type Time struct {
+	time.Time
+}
+
+// String implements fmt.Stringer.
+func (t Time) String() string {
+	return t.Format(time.RFC3339)
+}
+
+// Params are the parameters of a run that can be referenced by templates.
+type Params struct {
+	// ScheduledTime is the time at which the run was scheduled, in the time zone of the schedule. Runs started by
+	// triggers or manually are scheduled at the time they start.
+	ScheduledTime Time
+	// RunID is the name of the SparkApplication of the run.
+	RunID string
+	// Name is the name of the ScheduledSparkApplication.
+	Name string
+	// Namespace is the namespace of the ScheduledSparkApplication.
+	Namespace string
+}
+
+// Validate returns an error if the arguments or Spark configuration of the given template contain an invalid
+// template or reference an unknown parameter.
+func Validate(spec *v1beta2.SparkApplicationSpec) error {
+	// Expand a copy so that the given spec is left unchanged.
+	spec = &v1beta2.SparkApplicationSpec{Arguments: spec.Arguments, SparkConf: spec.SparkConf}
+	return forEachParameterized(spec, func(s string) (string, error) {
+		return expand(s, &Params{})
+	})
+}
+
+// Expand replaces the templates in the arguments and Spark configuration of the given spec with the values of
+// the given parameters.
+func Expand(spec *v1beta2.SparkApplicationSpec, params *Params) error {
+	return forEachParameterized(spec, func(s string) (string, error) {
+		return expand(s, params)
+	})
+}
+
+// forEachParameterized calls fn for the arguments and Spark configuration values of the given spec that contain
+// a template and replaces them with its result.
+func forEachParameterized(spec *v1beta2.SparkApplicationSpec, fn func(string) (string, error)) error {
+	if len(spec.Arguments) > 0 {
+		arguments := make([]string, len(spec.Arguments))
+		for i, arg := range spec.Arguments {
+			value, err := expandIfParameterized(arg, fn)
+			if err != nil {
+				return fmt.Errorf("invalid template in argument %d: %v", i, err)
+			}
+			arguments[i] = value
+		}
+		spec.Arguments = arguments
+	}
+
+	if len(spec.SparkConf) > 0 {
+		sparkConf := make(map[string]string, len(spec.SparkConf))
+		for key, conf := range spec.SparkConf {
+			value, err := expandIfParameterized(conf, fn)
+			if err != nil {
+				return fmt.Errorf("invalid template in Spark configuration %s: %v", key, err)
+			}
+			sparkConf[key] = value
+		}
+		spec.SparkConf = sparkConf
+	}
+	return nil
+}
+
+func expandIfParameterized(s string, fn func(string) (string, error)) (string, error) {
+	if !strings.Contains(s, "{{") {
+		return s, nil
+	}
+	return fn(s)
+}
+
+func expand(s string, params *Params) (string, error) {
+	tmpl, err := template.New("").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, params); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
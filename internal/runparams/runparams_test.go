@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runparams
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestExpand(t *testing.T) {
+	spec := &v1beta2.SparkApplicationSpec{
+		Arguments: []string{"--date", `{{ .ScheduledTime.Format "2006-01-02" }}`, "--run={{ .RunID }}"},
+		SparkConf: map[string]string{
+			"spark.app.scheduledTime":   "{{ .ScheduledTime }}",
+			"spark.eventLog.dir":        "s3a://logs/{{ .Namespace }}/{{ .Name }}",
+			"spark.executor.extraFlags": "-Dplain=value",
+		},
+	}
+	params := &Params{
+		ScheduledTime: Time{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+		RunID:         "nightly-1767323045000000000",
+		Name:          "nightly",
+		Namespace:     "etl",
+	}
+
+	require.NoError(t, Expand(spec, params))
+	assert.Equal(t, []string{"--date", "2026-01-02", "--run=nightly-1767323045000000000"}, spec.Arguments)
+	assert.Equal(t, map[string]string{
+		"spark.app.scheduledTime":   "2026-01-02T03:04:05Z",
+		"spark.eventLog.dir":        "s3a://logs/etl/nightly",
+		"spark.executor.extraFlags": "-Dplain=value",
+	}, spec.SparkConf)
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *v1beta2.SparkApplicationSpec
+		err  string
+	}{
+		{
+			name: "valid templates",
+			spec: &v1beta2.SparkApplicationSpec{
+				Arguments: []string{"--since={{ .ScheduledTime.Unix }}"},
+				SparkConf: map[string]string{"spark.app.runId": "{{ .RunID }}"},
+			},
+		},
+		{
+			name: "syntax error in argument",
+			spec: &v1beta2.SparkApplicationSpec{Arguments: []string{"--run", "{{ .RunID"}},
+			err:  "invalid template in argument 1",
+		},
+		{
+			name: "unknown parameter in Spark configuration",
+			spec: &v1beta2.SparkApplicationSpec{SparkConf: map[string]string{"spark.app.date": "{{ .LogicalDate }}"}},
+			err:  "invalid template in Spark configuration spark.app.date",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			arguments := append([]string(nil), tc.spec.Arguments...)
+			err := Validate(tc.spec)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+			assert.Equal(t, arguments, tc.spec.Arguments)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding splits the namespaces managed by the operator between several operator
+// instances, each owning the namespaces that a consistent hash ring assigns to it.
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ConfigMapShardsKey is the key of the ConfigMap data listing the IDs of all shards.
+const ConfigMapShardsKey = "shards"
+
+// virtualNodesPerShard is the number of points each shard has on the hash ring, which evens out
+// the distribution of namespaces between shards.
+const virtualNodesPerShard = 128
+
+// HashRing assigns namespaces to shards by consistent hashing, so that adding or removing a shard
+// only moves the namespaces of that shard.
+type HashRing struct {
+	points []uint64
+	owners map[uint64]string
+}
+
+// NewHashRing creates a new HashRing with the given shards.
+func NewHashRing(shards []string) *HashRing {
+	ring := &HashRing{owners: make(map[uint64]string)}
+	for _, shard := range shards {
+		for i := 0; i < virtualNodesPerShard; i++ {
+			point := hash(shard + "#" + strconv.Itoa(i))
+			if _, ok := ring.owners[point]; ok {
+				continue
+			}
+			ring.owners[point] = shard
+			ring.points = append(ring.points, point)
+		}
+	}
+	slices.Sort(ring.points)
+	return ring
+}
+
+// Owner returns the shard owning the given namespace.
+func (r *HashRing) Owner(namespace string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	point := hash(namespace)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= point })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// Shard is an operator instance owning part of the namespaces. A nil Shard owns all namespaces.
+type Shard struct {
+	id   string
+	ring *HashRing
+}
+
+// NewShard creates a new Shard with the given ID among the given shard IDs.
+func NewShard(id string, shards []string) (*Shard, error) {
+	for _, shard := range shards {
+		if errs := validation.IsDNS1123Label(shard); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid shard ID %q: %s", shard, strings.Join(errs, ", "))
+		}
+	}
+	if !slices.Contains(shards, id) {
+		return nil, fmt.Errorf("shard %q is not one of the shards %s", id, strings.Join(shards, ","))
+	}
+	return &Shard{id: id, ring: NewHashRing(shards)}, nil
+}
+
+// ParseShards parses a list of shard IDs separated by commas or new lines, as found in flags and ConfigMaps.
+func ParseShards(value string) []string {
+	var shards []string
+	for _, field := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' }) {
+		if shard := strings.TrimSpace(field); shard != "" && !slices.Contains(shards, shard) {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+// ID returns the ID of the shard.
+func (s *Shard) ID() string {
+	if s == nil {
+		return ""
+	}
+	return s.id
+}
+
+// Owns returns whether the shard owns the given namespace. Cluster-scoped objects, whose namespace is empty,
+// are owned by every shard.
+func (s *Shard) Owns(namespace string) bool {
+	return s == nil || namespace == "" || s.ring.Owner(namespace) == s.id
+}
+
+// Predicate returns a predicate filtering out events of objects in namespaces owned by other shards.
+func (s *Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return s.Owns(obj.GetNamespace())
+	})
+}
+
+// hash returns the position of the given key on the ring. FNV-1a alone spreads similar keys such as
+// "namespace-1" and "namespace-2" poorly, so its result is mixed with the MurmurHash3 finalizer.
+func hash(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
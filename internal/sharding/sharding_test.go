@@ -0,0 +1,83 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestHashRing(t *testing.T) {
+	ring := NewHashRing([]string{"shard-0", "shard-1", "shard-2"})
+	grown := NewHashRing([]string{"shard-0", "shard-1", "shard-2", "shard-3"})
+
+	counts := make(map[string]int)
+	for i := 0; i < 3000; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		owner := ring.Owner(namespace)
+		assert.Equal(t, owner, ring.Owner(namespace), "assignment must be deterministic")
+		counts[owner]++
+
+		// Adding a shard only moves namespaces to the new shard.
+		if newOwner := grown.Owner(namespace); newOwner != owner {
+			assert.Equal(t, "shard-3", newOwner)
+		}
+	}
+
+	require.Len(t, counts, 3)
+	for shard, count := range counts {
+		assert.Greater(t, count, 500, "shard %s owns too few namespaces", shard)
+	}
+	assert.Empty(t, NewHashRing(nil).Owner("default"))
+}
+
+func TestShard(t *testing.T) {
+	_, err := NewShard("shard-3", []string{"shard-0", "shard-1"})
+	assert.Error(t, err)
+	_, err = NewShard("Shard_0", []string{"Shard_0"})
+	assert.Error(t, err)
+
+	shards := ParseShards("shard-0, shard-1\nshard-1\n")
+	assert.Equal(t, []string{"shard-0", "shard-1"}, shards)
+
+	shard0, err := NewShard("shard-0", shards)
+	require.NoError(t, err)
+	shard1, err := NewShard("shard-1", shards)
+	require.NoError(t, err)
+	assert.Equal(t, "shard-0", shard0.ID())
+
+	for i := 0; i < 100; i++ {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		assert.NotEqual(t, shard0.Owns(namespace), shard1.Owns(namespace), "namespace %s must be owned by exactly one shard", namespace)
+
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: namespace}}
+		assert.Equal(t, shard0.Owns(namespace), shard0.Predicate().Create(event.CreateEvent{Object: pod}))
+	}
+
+	assert.True(t, shard0.Owns(""), "cluster-scoped objects are owned by every shard")
+	assert.True(t, shard1.Owns(""), "cluster-scoped objects are owned by every shard")
+
+	var disabled *Shard
+	assert.True(t, disabled.Owns("default"))
+	assert.Empty(t, disabled.ID())
+}
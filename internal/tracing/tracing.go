@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing traces the reconciliation and submission of Spark applications with OpenTelemetry. Spans are
+// created with the global tracer provider, which drops them unless a Provider exporting them is installed.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// TracerName is the name of the tracer creating the spans of the operator.
+const TracerName = "github.com/kubeflow/spark-operator"
+
+// Attribute keys of the spans of the operator.
+const (
+	AttributeNamespace     = attribute.Key("k8s.namespace.name")
+	AttributeName          = attribute.Key("sparkoperator.name")
+	AttributeState         = attribute.Key("sparkoperator.state")
+	AttributeCorrelationID = attribute.Key("sparkoperator.correlation_id")
+	AttributePodName       = attribute.Key("k8s.pod.name")
+	AttributePodPhase      = attribute.Key("k8s.pod.phase")
+)
+
+// shutdownTimeout is how long the spans still buffered when the operator stops are exported for.
+const shutdownTimeout = 5 * time.Second
+
+// Options configures the export of spans.
+type Options struct {
+	// Endpoint is the host:port of the OTLP gRPC receiver spans are exported to.
+	Endpoint string
+	// Insecure exports spans without TLS.
+	Insecure bool
+	// SamplingRatio is the ratio of the traces started by the operator that are sampled, between 0 and 1.
+	SamplingRatio float64
+	// ServiceName and ServiceVersion identify the operator component in the exported spans.
+	ServiceName    string
+	ServiceVersion string
+}
+
+// Provider exports the spans of the operator over OTLP in batches.
+type Provider struct {
+	provider *sdktrace.TracerProvider
+}
+
+// Provider implements manager.Runnable so that the buffered spans are flushed when the manager stops.
+var _ manager.Runnable = &Provider{}
+
+// NewProvider creates a new Provider with the given options and installs it as the global tracer provider.
+func NewProvider(ctx context.Context, options Options) (*Provider, error) {
+	if options.SamplingRatio < 0 || options.SamplingRatio > 1 {
+		return nil, fmt.Errorf("tracing sampling ratio %v is not between 0 and 1", options.SamplingRatio)
+	}
+
+	exporterOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(options.Endpoint)}
+	if options.Insecure {
+		exporterOptions = append(exporterOptions, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %v", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(options.ServiceName),
+		semconv.ServiceVersion(options.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracing resource: %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(options.SamplingRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return &Provider{provider: provider}, nil
+}
+
+// Start implements manager.Runnable. It flushes the buffered spans and stops exporting once the context is done.
+func (p *Provider) Start(ctx context.Context) error {
+	<-ctx.Done()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return p.provider.Shutdown(shutdownCtx)
+}
+
+// Tracer returns the tracer creating the spans of the operator.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span with the given name and attributes as a child of the span of the given context.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends the given span, marking it as failed with the given error if it is not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
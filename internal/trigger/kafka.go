@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// kafkaScaler reads the total lag of a consumer group from the lag summary of a Kafka REST proxy v3 API.
+type kafkaScaler struct {
+	url           string
+	authorization string
+	httpClient    *http.Client
+}
+
+// kafkaLagSummary is the part of the lag summary of a consumer group used by the scaler.
+type kafkaLagSummary struct {
+	TotalLag int64 `json:"total_lag"`
+}
+
+func newKafkaScaler(trigger *v1beta2.KafkaTrigger, authorization string, httpClient *http.Client) *kafkaScaler {
+	return &kafkaScaler{
+		url: fmt.Sprintf(
+			"%s/v3/clusters/%s/consumer-groups/%s/lag-summary",
+			strings.TrimSuffix(trigger.RESTProxyURL, "/"),
+			url.PathEscape(trigger.ClusterID),
+			url.PathEscape(trigger.ConsumerGroup),
+		),
+		authorization: authorization,
+		httpClient:    httpClient,
+	}
+}
+
+// GetMetric implements Scaler.
+func (s *kafkaScaler) GetMetric(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if s.authorization != "" {
+		req.Header.Set("Authorization", s.authorization)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get consumer group lag: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("kafka REST proxy returned %s", resp.Status)
+	}
+
+	summary := &kafkaLagSummary{}
+	if err := json.NewDecoder(resp.Body).Decode(summary); err != nil {
+		return 0, fmt.Errorf("failed to decode consumer group lag: %v", err)
+	}
+	return float64(summary.TotalLag), nil
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestKafkaScaler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3/clusters/cluster-1/consumer-groups/ingest/lag-summary":
+			assert.Equal(t, "Basic dXNlcjpwYXNz", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"kind":"KafkaConsumerGroupLagSummary","cluster_id":"cluster-1","consumer_group_id":"ingest","max_lag":30,"total_lag":42}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	scaler := newKafkaScaler(&v1beta2.KafkaTrigger{
+		RESTProxyURL:  server.URL + "/",
+		ClusterID:     "cluster-1",
+		ConsumerGroup: "ingest",
+	}, "Basic dXNlcjpwYXNz", server.Client())
+	value, err := scaler.GetMetric(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, value)
+
+	scaler = newKafkaScaler(&v1beta2.KafkaTrigger{
+		RESTProxyURL:  server.URL,
+		ClusterID:     "cluster-1",
+		ConsumerGroup: "unknown",
+	}, "", server.Client())
+	_, err = scaler.GetMetric(context.Background())
+	assert.EqualError(t, err, "kafka REST proxy returned 404 Not Found")
+}
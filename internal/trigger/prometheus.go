@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// prometheusScaler reads the value of an instant Prometheus query.
+type prometheusScaler struct {
+	api   promv1.API
+	query string
+}
+
+// authorizationRoundTripper sets the Authorization header of the requests it sends.
+type authorizationRoundTripper struct {
+	authorization string
+	next          http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authorizationRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", t.authorization)
+	return t.next.RoundTrip(req)
+}
+
+func newPrometheusScaler(trigger *v1beta2.PrometheusTrigger, authorization string, httpClient *http.Client) (*prometheusScaler, error) {
+	var roundTripper http.RoundTripper = api.DefaultRoundTripper
+	if httpClient.Transport != nil {
+		roundTripper = httpClient.Transport
+	}
+	if authorization != "" {
+		roundTripper = &authorizationRoundTripper{authorization: authorization, next: roundTripper}
+	}
+	client, err := api.NewClient(api.Config{
+		Address: trigger.ServerAddress,
+		Client:  &http.Client{Transport: roundTripper, Timeout: httpClient.Timeout},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Prometheus client: %v", err)
+	}
+	return &prometheusScaler{api: promv1.NewAPI(client), query: trigger.Query}, nil
+}
+
+// GetMetric implements Scaler.
+func (s *prometheusScaler) GetMetric(ctx context.Context) (float64, error) {
+	result, _, err := s.api.Query(ctx, s.query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to query Prometheus: %v", err)
+	}
+
+	var value float64
+	switch result := result.(type) {
+	case model.Vector:
+		if len(result) == 0 {
+			return 0, nil
+		}
+		if len(result) > 1 {
+			return 0, fmt.Errorf("query returned %d series, expected at most one", len(result))
+		}
+		value = float64(result[0].Value)
+	case *model.Scalar:
+		value = float64(result.Value)
+	default:
+		return 0, fmt.Errorf("query returned a %s, expected a scalar or a vector", result.Type())
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("query returned %v", value)
+	}
+	return value, nil
+}
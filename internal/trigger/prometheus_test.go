@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestPrometheusScaler(t *testing.T) {
+	responses := map[string]string{
+		"pending_files":     `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1700000000,"12.5"]}]}}`,
+		"absent_metric":     `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+		"scalar(vector(3))": `{"status":"success","data":{"resultType":"scalar","result":[1700000000,"3"]}}`,
+		"up": `{"status":"success","data":{"resultType":"vector","result":[` +
+			`{"metric":{"job":"a"},"value":[1700000000,"1"]},{"metric":{"job":"b"},"value":[1700000000,"1"]}]}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.NoError(t, r.ParseForm())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(responses[r.Form.Get("query")]))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		query string
+		value float64
+		err   string
+	}{
+		{query: "pending_files", value: 12.5},
+		{query: "absent_metric", value: 0},
+		{query: "scalar(vector(3))", value: 3},
+		{query: "up", err: "query returned 2 series, expected at most one"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			scaler, err := newPrometheusScaler(&v1beta2.PrometheusTrigger{ServerAddress: server.URL, Query: tc.query}, "Bearer token", server.Client())
+			require.NoError(t, err)
+			value, err := scaler.GetMetric(context.Background())
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+}
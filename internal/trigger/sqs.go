@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const (
+	sqsService           = "sqs"
+	sqsAPIVersion        = "2012-11-05"
+	sqsMessagesAttribute = "ApproximateNumberOfMessages"
+)
+
+// awsCredentials are the credentials requests to AWS are signed with.
+type awsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+}
+
+// sqsScaler reads the approximate number of visible messages of an SQS queue with the GetQueueAttributes action
+// of the SQS query API.
+type sqsScaler struct {
+	queueURL    string
+	region      string
+	credentials awsCredentials
+	httpClient  *http.Client
+}
+
+// sqsGetQueueAttributesResponse is the part of the response to GetQueueAttributes used by the scaler.
+type sqsGetQueueAttributesResponse struct {
+	Attributes []struct {
+		Name  string `xml:"Name"`
+		Value string `xml:"Value"`
+	} `xml:"GetQueueAttributesResult>Attribute"`
+}
+
+func newSQSScaler(trigger *v1beta2.SQSTrigger, credentials awsCredentials, httpClient *http.Client) (*sqsScaler, error) {
+	region, err := getSQSRegion(trigger)
+	if err != nil {
+		return nil, err
+	}
+	return &sqsScaler{
+		queueURL:    trigger.QueueURL,
+		region:      region,
+		credentials: credentials,
+		httpClient:  httpClient,
+	}, nil
+}
+
+// getSQSRegion returns the region of the queue of the given trigger, which defaults to the region in the host of
+// the queue URL, e.g. `sqs.us-east-1.amazonaws.com` or the legacy `us-east-1.queue.amazonaws.com`.
+func getSQSRegion(trigger *v1beta2.SQSTrigger) (string, error) {
+	if trigger.Region != "" {
+		return trigger.Region, nil
+	}
+	queueURL, err := url.Parse(trigger.QueueURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid queue URL %s: %v", trigger.QueueURL, err)
+	}
+	labels := strings.Split(queueURL.Hostname(), ".")
+	if len(labels) >= 4 && labels[0] == sqsService {
+		return labels[1], nil
+	}
+	if len(labels) >= 4 && labels[1] == "queue" {
+		return labels[0], nil
+	}
+	return "", fmt.Errorf("unable to determine the region of queue %s, region must be specified", trigger.QueueURL)
+}
+
+func getAWSCredentials(ctx context.Context, reader client.Reader, namespace string, trigger *v1beta2.SQSTrigger) (awsCredentials, error) {
+	var credentials awsCredentials
+	var err error
+	if credentials.accessKeyID, err = getSecretValue(ctx, reader, namespace, trigger.AccessKeyIDSecret); err != nil {
+		return credentials, err
+	}
+	if credentials.secretAccessKey, err = getSecretValue(ctx, reader, namespace, trigger.SecretAccessKeySecret); err != nil {
+		return credentials, err
+	}
+	if credentials.sessionToken, err = getOptionalSecretValue(ctx, reader, namespace, trigger.SessionTokenSecret); err != nil {
+		return credentials, err
+	}
+	return credentials, nil
+}
+
+// GetMetric implements Scaler.
+func (s *sqsScaler) GetMetric(ctx context.Context) (float64, error) {
+	form := url.Values{
+		"Action":          {"GetQueueAttributes"},
+		"AttributeName.1": {sqsMessagesAttribute},
+		"Version":         {sqsAPIVersion},
+	}
+	body := []byte(form.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.queueURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signAWSRequest(req, body, s.credentials, s.region, sqsService, time.Now())
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get queue attributes: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("SQS returned %s", resp.Status)
+	}
+
+	result := &sqsGetQueueAttributesResponse{}
+	if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("failed to decode queue attributes: %v", err)
+	}
+	for _, attribute := range result.Attributes {
+		if attribute.Name == sqsMessagesAttribute {
+			value, err := strconv.ParseFloat(attribute.Value, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %s %q: %v", sqsMessagesAttribute, attribute.Value, err)
+			}
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("attribute %s not found in response", sqsMessagesAttribute)
+}
+
+// signAWSRequest signs the given request with AWS Signature Version 4.
+func signAWSRequest(req *http.Request, body []byte, credentials awsCredentials, region string, service string, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	if credentials.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+		canonicalHeaders.String(),
+		signedHeaders,
+		sha256Hex(body),
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+credentials.secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		credentials.accessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestSignAWSRequest(t *testing.T) {
+	// Example request of the AWS Signature Version 4 documentation.
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	credentials := awsCredentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+
+	signAWSRequest(req, nil, credentials, "us-east-1", "iam", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t,
+		"AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, "+
+			"SignedHeaders=content-type;host;x-amz-date, "+
+			"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7",
+		req.Header.Get("Authorization"),
+	)
+}
+
+func TestSQSScaler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/123456789012/events", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Authorization"), "Credential=AKIDEXAMPLE/")
+		assert.Contains(t, r.Header.Get("Authorization"), "/eu-west-1/sqs/aws4_request")
+		assert.Equal(t, "token", r.Header.Get("X-Amz-Security-Token"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "Action=GetQueueAttributes&AttributeName.1=ApproximateNumberOfMessages&Version=2012-11-05", string(body))
+		_, _ = w.Write([]byte(`<GetQueueAttributesResponse>
+  <GetQueueAttributesResult>
+    <Attribute><Name>ApproximateNumberOfMessages</Name><Value>7</Value></Attribute>
+  </GetQueueAttributesResult>
+  <ResponseMetadata><RequestId>b5293cb5-d306-4a17-9048-b263635abe42</RequestId></ResponseMetadata>
+</GetQueueAttributesResponse>`))
+	}))
+	defer server.Close()
+
+	scaler, err := newSQSScaler(&v1beta2.SQSTrigger{
+		QueueURL: server.URL + "/123456789012/events",
+		Region:   "eu-west-1",
+	}, awsCredentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "secret", sessionToken: "token"}, server.Client())
+	require.NoError(t, err)
+	value, err := scaler.GetMetric(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 7.0, value)
+}
+
+func TestGetSQSRegion(t *testing.T) {
+	region, err := getSQSRegion(&v1beta2.SQSTrigger{QueueURL: "https://sqs.ap-south-1.amazonaws.com/123456789012/events"})
+	require.NoError(t, err)
+	assert.Equal(t, "ap-south-1", region)
+
+	region, err = getSQSRegion(&v1beta2.SQSTrigger{QueueURL: "https://us-west-2.queue.amazonaws.com/123456789012/events"})
+	require.NoError(t, err)
+	assert.Equal(t, "us-west-2", region)
+
+	region, err = getSQSRegion(&v1beta2.SQSTrigger{QueueURL: "http://localstack:4566/000000000000/events", Region: "us-east-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", region)
+}
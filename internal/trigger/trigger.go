@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package trigger implements the event sources polled to start runs of ScheduledSparkApplications. In the manner
+// of KEDA scalers, each source reports a metric, such as the lag of a Kafka consumer group, and its trigger fires
+// once the metric reaches a threshold.
+package trigger
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+)
+
+// RequestTimeout is the timeout of the requests sent to the event sources.
+const RequestTimeout = 10 * time.Second
+
+// Scaler reads the metric of an event source.
+type Scaler interface {
+	// GetMetric returns the current value of the metric.
+	GetMetric(ctx context.Context) (float64, error)
+}
+
+// NewScaler creates the scaler of the given trigger, reading its credentials from Secrets in the given namespace and
+// polling its event source with the given HTTP client, which is expected to enforce the egress policy of the operator.
+func NewScaler(ctx context.Context, reader client.Reader, httpClient *http.Client, namespace string, trigger *v1beta2.ScheduleTrigger) (Scaler, error) {
+	switch {
+	case trigger.Kafka != nil:
+		authorization, err := getOptionalSecretValue(ctx, reader, namespace, trigger.Kafka.AuthSecret)
+		if err != nil {
+			return nil, err
+		}
+		return newKafkaScaler(trigger.Kafka, authorization, httpClient), nil
+	case trigger.SQS != nil:
+		credentials, err := getAWSCredentials(ctx, reader, namespace, trigger.SQS)
+		if err != nil {
+			return nil, err
+		}
+		return newSQSScaler(trigger.SQS, credentials, httpClient)
+	case trigger.Prometheus != nil:
+		authorization, err := getOptionalSecretValue(ctx, reader, namespace, trigger.Prometheus.AuthSecret)
+		if err != nil {
+			return nil, err
+		}
+		return newPrometheusScaler(trigger.Prometheus, authorization, httpClient)
+	}
+	return nil, fmt.Errorf("trigger %s has no event source", trigger.Name)
+}
+
+// GetThreshold returns the metric value at or above which the given trigger fires.
+func GetThreshold(trigger *v1beta2.ScheduleTrigger) float64 {
+	if trigger.Threshold == nil {
+		return 1
+	}
+	return trigger.Threshold.AsApproximateFloat64()
+}
+
+// Validate returns an error if the given triggers are not valid, including if their event sources may not be polled
+// under the given egress policy. A nil policy only checks the schemes of the URLs of the event sources.
+func Validate(triggers []v1beta2.ScheduleTrigger, policy *egress.Policy) error {
+	names := make(map[string]bool)
+	for _, trigger := range triggers {
+		if trigger.Name == "" {
+			return fmt.Errorf("trigger name must not be empty")
+		}
+		if names[trigger.Name] {
+			return fmt.Errorf("duplicate trigger name %s", trigger.Name)
+		}
+		names[trigger.Name] = true
+
+		sources := 0
+		if trigger.Kafka != nil {
+			sources++
+			if trigger.Kafka.RESTProxyURL == "" || trigger.Kafka.ClusterID == "" || trigger.Kafka.ConsumerGroup == "" {
+				return fmt.Errorf("kafka trigger %s must specify restProxyURL, clusterID and consumerGroup", trigger.Name)
+			}
+		}
+		if trigger.SQS != nil {
+			sources++
+			if trigger.SQS.QueueURL == "" || trigger.SQS.AccessKeyIDSecret == nil || trigger.SQS.SecretAccessKeySecret == nil {
+				return fmt.Errorf("sqs trigger %s must specify queueURL, accessKeyIDSecret and secretAccessKeySecret", trigger.Name)
+			}
+			if _, err := getSQSRegion(trigger.SQS); err != nil {
+				return fmt.Errorf("sqs trigger %s: %v", trigger.Name, err)
+			}
+		}
+		if trigger.Prometheus != nil {
+			sources++
+			if trigger.Prometheus.ServerAddress == "" || trigger.Prometheus.Query == "" {
+				return fmt.Errorf("prometheus trigger %s must specify serverAddress and query", trigger.Name)
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("trigger %s must specify exactly one of kafka, sqs and prometheus", trigger.Name)
+		}
+		if err := checkEventSourceURL(&trigger, policy); err != nil {
+			return fmt.Errorf("trigger %s: %v", trigger.Name, err)
+		}
+		if trigger.Threshold != nil && trigger.Threshold.Sign() < 0 {
+			return fmt.Errorf("threshold of trigger %s must not be negative", trigger.Name)
+		}
+	}
+	return nil
+}
+
+// checkEventSourceURL returns an error if the event source of the given trigger may not be polled under the given
+// egress policy.
+func checkEventSourceURL(trigger *v1beta2.ScheduleTrigger, policy *egress.Policy) error {
+	var rawURL string
+	switch {
+	case trigger.Kafka != nil:
+		rawURL = trigger.Kafka.RESTProxyURL
+	case trigger.SQS != nil:
+		rawURL = trigger.SQS.QueueURL
+	case trigger.Prometheus != nil:
+		rawURL = trigger.Prometheus.ServerAddress
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL %s: %v", rawURL, err)
+	}
+	return policy.CheckURL(u)
+}
+
+// getSecretValue returns the value of the given key of a Secret in the given namespace. An empty value is
+// returned for missing optional keys.
+func getSecretValue(ctx context.Context, reader client.Reader, namespace string, selector *corev1.SecretKeySelector) (string, error) {
+	secret := &corev1.Secret{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: selector.Name, Namespace: namespace}, secret); err != nil {
+		if errors.IsNotFound(err) && ptr.Deref(selector.Optional, false) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to get secret %s: %v", selector.Name, err)
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		if ptr.Deref(selector.Optional, false) {
+			return "", nil
+		}
+		return "", fmt.Errorf("key %s not found in secret %s", selector.Key, selector.Name)
+	}
+	return string(bytes.TrimSpace(value)), nil
+}
+
+// getOptionalSecretValue is like getSecretValue, but returns an empty value for a nil selector.
+func getOptionalSecretValue(ctx context.Context, reader client.Reader, namespace string, selector *corev1.SecretKeySelector) (string, error) {
+	if selector == nil {
+		return "", nil
+	}
+	return getSecretValue(ctx, reader, namespace, selector)
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/egress"
+)
+
+func TestValidate(t *testing.T) {
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws"}, Key: "key"}
+	kafka := &v1beta2.KafkaTrigger{RESTProxyURL: "http://kafka-rest:8082", ClusterID: "cluster", ConsumerGroup: "group"}
+	prometheus := &v1beta2.PrometheusTrigger{ServerAddress: "http://prometheus:9090", Query: "sum(pending_files)"}
+
+	testCases := []struct {
+		name     string
+		triggers []v1beta2.ScheduleTrigger
+		policy   *egress.Policy
+		err      string
+	}{
+		{
+			name: "valid triggers",
+			triggers: []v1beta2.ScheduleTrigger{
+				{Name: "lag", Kafka: kafka},
+				{Name: "files", Prometheus: prometheus, Threshold: ptr.To(resource.MustParse("0.5"))},
+				{Name: "queue", SQS: &v1beta2.SQSTrigger{
+					QueueURL:              "https://sqs.eu-west-1.amazonaws.com/123456789012/events",
+					AccessKeyIDSecret:     secret,
+					SecretAccessKeySecret: secret,
+				}},
+			},
+		},
+		{
+			name:     "duplicate name",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka}, {Name: "lag", Prometheus: prometheus}},
+			err:      "duplicate trigger name lag",
+		},
+		{
+			name:     "no event source",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag"}},
+			err:      "trigger lag must specify exactly one of kafka, sqs and prometheus",
+		},
+		{
+			name:     "several event sources",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka, Prometheus: prometheus}},
+			err:      "trigger lag must specify exactly one of kafka, sqs and prometheus",
+		},
+		{
+			name:     "incomplete kafka trigger",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: &v1beta2.KafkaTrigger{RESTProxyURL: "http://kafka-rest:8082"}}},
+			err:      "kafka trigger lag must specify restProxyURL, clusterID and consumerGroup",
+		},
+		{
+			name: "sqs queue without region",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "queue", SQS: &v1beta2.SQSTrigger{
+				QueueURL:              "http://localstack:4566/000000000000/events",
+				AccessKeyIDSecret:     secret,
+				SecretAccessKeySecret: secret,
+			}}},
+			err: "sqs trigger queue: unable to determine the region of queue http://localstack:4566/000000000000/events, region must be specified",
+		},
+		{
+			name:     "event source allowed by the egress policy",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka}, {Name: "files", Prometheus: prometheus}},
+			policy:   &egress.Policy{AllowedHosts: []string{"kafka-rest", "prometheus"}},
+		},
+		{
+			name:     "event source not allowed by the egress policy",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka}, {Name: "files", Prometheus: prometheus}},
+			policy:   &egress.Policy{AllowedHosts: []string{"kafka-rest"}},
+			err:      "trigger files: host prometheus is not allowed by the egress policy",
+		},
+		{
+			name: "event source with unsupported scheme",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "files", Prometheus: &v1beta2.PrometheusTrigger{
+				ServerAddress: "file:///etc/passwd",
+				Query:         "sum(pending_files)",
+			}}},
+			err: "trigger files: scheme of file:///etc/passwd must be http or https",
+		},
+		{
+			name:     "negative threshold",
+			triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka, Threshold: ptr.To(resource.MustParse("-1"))}},
+			err:      "threshold of trigger lag must not be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.triggers, tc.policy)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestGetThreshold(t *testing.T) {
+	assert.Equal(t, 1.0, GetThreshold(&v1beta2.ScheduleTrigger{}))
+	assert.Equal(t, 0.5, GetThreshold(&v1beta2.ScheduleTrigger{Threshold: ptr.To(resource.MustParse("500m"))}))
+	assert.Equal(t, 100.0, GetThreshold(&v1beta2.ScheduleTrigger{Threshold: ptr.To(resource.MustParse("100"))}))
+}
+
+func TestNewScalerReadsCredentials(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws", Namespace: "default"},
+		Data: map[string][]byte{
+			"access-key-id":     []byte("AKIDEXAMPLE"),
+			"secret-access-key": []byte("secret\n"),
+		},
+	}).Build()
+	selector := func(key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "aws"}, Key: key}
+	}
+
+	trigger := &v1beta2.ScheduleTrigger{Name: "queue", SQS: &v1beta2.SQSTrigger{
+		QueueURL:              "https://sqs.us-east-1.amazonaws.com/123456789012/events",
+		AccessKeyIDSecret:     selector("access-key-id"),
+		SecretAccessKeySecret: selector("secret-access-key"),
+	}}
+	scaler, err := NewScaler(context.Background(), reader, http.DefaultClient, "default", trigger)
+	require.NoError(t, err)
+	sqs := scaler.(*sqsScaler)
+	assert.Equal(t, "us-east-1", sqs.region)
+	assert.Equal(t, awsCredentials{accessKeyID: "AKIDEXAMPLE", secretAccessKey: "secret"}, sqs.credentials)
+
+	trigger.SQS.SessionTokenSecret = selector("session-token")
+	_, err = NewScaler(context.Background(), reader, http.DefaultClient, "default", trigger)
+	assert.EqualError(t, err, "key session-token not found in secret aws")
+
+	trigger.SQS.SessionTokenSecret.Optional = ptr.To(true)
+	_, err = NewScaler(context.Background(), reader, http.DefaultClient, "default", trigger)
+	assert.NoError(t, err)
+}
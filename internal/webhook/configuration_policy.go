@@ -0,0 +1,348 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// ConfigurationPolicy configures how the API server calls the webhooks of the operator. It is applied to the
+// webhooks of the mutating and validating webhook configurations at runtime, so that cluster admins can change
+// it without editing the configurations by hand.
+type ConfigurationPolicy struct {
+	// FailurePolicy, if set, overrides the failure policy of every webhook.
+	FailurePolicy *admissionregistrationv1.FailurePolicyType
+	// NamespaceSelector, if set, replaces the namespace selector of every webhook.
+	NamespaceSelector *metav1.LabelSelector
+	// ObjectSelector, if set, is added to the object selector of every webhook.
+	ObjectSelector *metav1.LabelSelector
+	// ExcludedNamespaces lists the namespaces the webhooks are not called for. Glob patterns such as
+	// `openshift-*` are matched against the existing namespaces.
+	ExcludedNamespaces []string
+}
+
+// NewConfigurationPolicy creates a ConfigurationPolicy from its flag values. Empty values leave the
+// corresponding settings of the webhooks untouched.
+func NewConfigurationPolicy(failurePolicy, namespaceSelector, objectSelector string, excludedNamespaces []string) (*ConfigurationPolicy, error) {
+	policy := &ConfigurationPolicy{}
+
+	switch admissionregistrationv1.FailurePolicyType(failurePolicy) {
+	case "":
+	case admissionregistrationv1.Fail, admissionregistrationv1.Ignore:
+		policy.FailurePolicy = (*admissionregistrationv1.FailurePolicyType)(&failurePolicy)
+	default:
+		return nil, fmt.Errorf("invalid failure policy %q, must be one of %s, %s", failurePolicy, admissionregistrationv1.Fail, admissionregistrationv1.Ignore)
+	}
+
+	if namespaceSelector != "" {
+		selector, err := metav1.ParseToLabelSelector(namespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespace selector %q: %v", namespaceSelector, err)
+		}
+		policy.NamespaceSelector = selector
+	}
+
+	if objectSelector != "" {
+		selector, err := metav1.ParseToLabelSelector(objectSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object selector %q: %v", objectSelector, err)
+		}
+		policy.ObjectSelector = selector
+	}
+
+	for _, pattern := range excludedNamespaces {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid excluded namespace %q: %v", pattern, err)
+		}
+		if pattern != "" {
+			policy.ExcludedNamespaces = append(policy.ExcludedNamespaces, pattern)
+		}
+	}
+
+	return policy, nil
+}
+
+// configurationPolicyConfig is the representation of a ConfigurationPolicy in its ConfigMap, with the same
+// values as the flags of the webhook server.
+type configurationPolicyConfig struct {
+	FailurePolicy      string   `json:"failurePolicy,omitempty"`
+	NamespaceSelector  string   `json:"namespaceSelector,omitempty"`
+	ObjectSelector     string   `json:"objectSelector,omitempty"`
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+// ParseConfigurationPolicy parses the ConfigurationPolicy held by the given ConfigMap.
+func ParseConfigurationPolicy(configMap *corev1.ConfigMap) (*ConfigurationPolicy, error) {
+	data, ok := configMap.Data[common.WebhookConfigurationPolicyKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s not found in config map %s", common.WebhookConfigurationPolicyKey, configMap.Name)
+	}
+	config := &configurationPolicyConfig{}
+	if err := yaml.UnmarshalStrict([]byte(data), config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", common.WebhookConfigurationPolicyKey, err)
+	}
+	return NewConfigurationPolicy(config.FailurePolicy, config.NamespaceSelector, config.ObjectSelector, config.ExcludedNamespaces)
+}
+
+// GetConfigurationPolicy reads the ConfigurationPolicy from the ConfigMap with the given key. A missing ConfigMap
+// yields an empty policy, which reverts the webhooks to their own settings.
+func GetConfigurationPolicy(ctx context.Context, reader client.Reader, key types.NamespacedName) (*ConfigurationPolicy, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, key, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return &ConfigurationPolicy{}, nil
+		}
+		return nil, fmt.Errorf("failed to get webhook configuration policy %v: %v", key, err)
+	}
+	return ParseConfigurationPolicy(configMap)
+}
+
+// IsEmpty returns whether the policy leaves the webhooks untouched.
+func (p *ConfigurationPolicy) IsEmpty() bool {
+	return p == nil || (p.FailurePolicy == nil && p.NamespaceSelector == nil && p.ObjectSelector == nil && len(p.ExcludedNamespaces) == 0)
+}
+
+// HasNamespacePatterns returns whether the excluded namespaces contain patterns, which are resolved against the
+// existing namespaces and must thus be re-applied whenever a namespace is created.
+func (p *ConfigurationPolicy) HasNamespacePatterns() bool {
+	if p == nil {
+		return false
+	}
+	return slices.ContainsFunc(p.ExcludedNamespaces, isNamespacePattern)
+}
+
+// Apply updates the given webhook settings according to the policy and returns whether any of them changed.
+// The names of the existing namespaces are used to resolve the excluded namespace patterns.
+func (p *ConfigurationPolicy) Apply(
+	failurePolicy **admissionregistrationv1.FailurePolicyType,
+	namespaceSelector **metav1.LabelSelector,
+	objectSelector **metav1.LabelSelector,
+	namespaces []string,
+) bool {
+	if p.IsEmpty() {
+		return false
+	}
+
+	changed := false
+	if p.FailurePolicy != nil && (*failurePolicy == nil || **failurePolicy != *p.FailurePolicy) {
+		value := *p.FailurePolicy
+		*failurePolicy = &value
+		changed = true
+	}
+
+	if selector := p.namespaceSelector(*namespaceSelector, namespaces); !equality.Semantic.DeepEqual(selector, *namespaceSelector) {
+		*namespaceSelector = selector
+		changed = true
+	}
+
+	if selector := p.objectSelector(*objectSelector); !equality.Semantic.DeepEqual(selector, *objectSelector) {
+		*objectSelector = selector
+		changed = true
+	}
+
+	return changed
+}
+
+// WebhookSettings points at the settings of a webhook of a webhook configuration the policy applies to.
+type WebhookSettings struct {
+	Name              string
+	FailurePolicy     **admissionregistrationv1.FailurePolicyType
+	NamespaceSelector **metav1.LabelSelector
+	ObjectSelector    **metav1.LabelSelector
+}
+
+// webhookSettingsState is a copy of the settings of a webhook recorded in the policy state of its configuration.
+type webhookSettingsState struct {
+	FailurePolicy     *admissionregistrationv1.FailurePolicyType `json:"failurePolicy,omitempty"`
+	NamespaceSelector *metav1.LabelSelector                      `json:"namespaceSelector,omitempty"`
+	ObjectSelector    *metav1.LabelSelector                      `json:"objectSelector,omitempty"`
+}
+
+// webhookPolicyState records the settings of a webhook before and after the policy was last applied.
+type webhookPolicyState struct {
+	Defaults webhookSettingsState `json:"defaults"`
+	Applied  webhookSettingsState `json:"applied"`
+}
+
+func newWebhookSettingsState(w WebhookSettings) webhookSettingsState {
+	state := webhookSettingsState{
+		NamespaceSelector: (*w.NamespaceSelector).DeepCopy(),
+		ObjectSelector:    (*w.ObjectSelector).DeepCopy(),
+	}
+	if *w.FailurePolicy != nil {
+		value := **w.FailurePolicy
+		state.FailurePolicy = &value
+	}
+	return state
+}
+
+func (s webhookSettingsState) restore(w WebhookSettings) {
+	*w.FailurePolicy = nil
+	if s.FailurePolicy != nil {
+		value := *s.FailurePolicy
+		*w.FailurePolicy = &value
+	}
+	*w.NamespaceSelector = s.NamespaceSelector.DeepCopy()
+	*w.ObjectSelector = s.ObjectSelector.DeepCopy()
+}
+
+// Reconcile sets the given webhooks of the webhook configuration with the given metadata to their own settings
+// with the policy applied, and records them in the policy state annotation of the configuration. The own settings
+// of a webhook are the ones it had before the policy was first applied, or the ones it was given since, e.g. by a
+// Helm upgrade. Settings required by a previous policy but not by the current one are thus reverted.
+func (p *ConfigurationPolicy) Reconcile(configuration metav1.Object, webhooks []WebhookSettings, namespaces []string) error {
+	states := map[string]webhookPolicyState{}
+	if data, ok := configuration.GetAnnotations()[common.AnnotationWebhookPolicyState]; ok {
+		if err := json.Unmarshal([]byte(data), &states); err != nil {
+			return fmt.Errorf("failed to parse annotation %s: %v", common.AnnotationWebhookPolicyState, err)
+		}
+	}
+
+	newStates := make(map[string]webhookPolicyState, len(webhooks))
+	for _, w := range webhooks {
+		defaults := newWebhookSettingsState(w)
+		if state, ok := states[w.Name]; ok {
+			// Settings that were not changed since the policy was last applied revert to their own value.
+			if equality.Semantic.DeepEqual(defaults.FailurePolicy, state.Applied.FailurePolicy) {
+				defaults.FailurePolicy = state.Defaults.FailurePolicy
+			}
+			if equality.Semantic.DeepEqual(defaults.NamespaceSelector, state.Applied.NamespaceSelector) {
+				defaults.NamespaceSelector = state.Defaults.NamespaceSelector
+			}
+			if equality.Semantic.DeepEqual(defaults.ObjectSelector, state.Applied.ObjectSelector) {
+				defaults.ObjectSelector = state.Defaults.ObjectSelector
+			}
+		}
+		defaults.restore(w)
+		p.Apply(w.FailurePolicy, w.NamespaceSelector, w.ObjectSelector, namespaces)
+		newStates[w.Name] = webhookPolicyState{Defaults: defaults, Applied: newWebhookSettingsState(w)}
+	}
+
+	annotations := configuration.GetAnnotations()
+	if p.IsEmpty() {
+		delete(annotations, common.AnnotationWebhookPolicyState)
+		configuration.SetAnnotations(annotations)
+		return nil
+	}
+	data, err := json.Marshal(newStates)
+	if err != nil {
+		return fmt.Errorf("failed to marshal annotation %s: %v", common.AnnotationWebhookPolicyState, err)
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[common.AnnotationWebhookPolicyState] = string(data)
+	configuration.SetAnnotations(annotations)
+	return nil
+}
+
+// namespaceSelector returns the namespace selector of a webhook with the given current one. The excluded
+// namespaces are expressed as a single requirement on the namespace name label, which replaces the one added
+// by a previous application of the policy.
+func (p *ConfigurationPolicy) namespaceSelector(current *metav1.LabelSelector, namespaces []string) *metav1.LabelSelector {
+	selector := current.DeepCopy()
+	if p.NamespaceSelector != nil {
+		selector = p.NamespaceSelector.DeepCopy()
+	}
+	if len(p.ExcludedNamespaces) == 0 {
+		return selector
+	}
+
+	if selector == nil {
+		selector = &metav1.LabelSelector{}
+	}
+	selector.MatchExpressions = slices.DeleteFunc(selector.MatchExpressions, isExcludedNamespacesRequirement)
+
+	var excluded []string
+	for _, name := range slices.Concat(p.ExcludedNamespaces, namespaces) {
+		if !slices.Contains(excluded, name) && p.isExcludedNamespace(name) {
+			excluded = append(excluded, name)
+		}
+	}
+	if len(excluded) == 0 {
+		return selector
+	}
+	slices.Sort(excluded)
+	selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   excluded,
+	})
+	return selector
+}
+
+// objectSelector returns the object selector of a webhook with the given current one.
+func (p *ConfigurationPolicy) objectSelector(current *metav1.LabelSelector) *metav1.LabelSelector {
+	if p.ObjectSelector == nil {
+		return current
+	}
+
+	selector := current.DeepCopy()
+	if selector == nil {
+		selector = &metav1.LabelSelector{}
+	}
+	for key, value := range p.ObjectSelector.MatchLabels {
+		if selector.MatchLabels == nil {
+			selector.MatchLabels = make(map[string]string)
+		}
+		selector.MatchLabels[key] = value
+	}
+	for _, requirement := range p.ObjectSelector.MatchExpressions {
+		if !slices.ContainsFunc(selector.MatchExpressions, func(r metav1.LabelSelectorRequirement) bool {
+			return equality.Semantic.DeepEqual(r, requirement)
+		}) {
+			selector.MatchExpressions = append(selector.MatchExpressions, requirement)
+		}
+	}
+	return selector
+}
+
+// isExcludedNamespace returns whether the namespace with the given name is excluded by the policy. Patterns
+// themselves are not namespace names and are never excluded.
+func (p *ConfigurationPolicy) isExcludedNamespace(name string) bool {
+	if isNamespacePattern(name) {
+		return false
+	}
+	return slices.ContainsFunc(p.ExcludedNamespaces, func(pattern string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	})
+}
+
+func isNamespacePattern(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+func isExcludedNamespacesRequirement(r metav1.LabelSelectorRequirement) bool {
+	return r.Key == corev1.LabelMetadataName && r.Operator == metav1.LabelSelectorOpNotIn
+}
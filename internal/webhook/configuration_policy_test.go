@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestNewConfigurationPolicy(t *testing.T) {
+	policy, err := NewConfigurationPolicy("", "", "", nil)
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+
+	_, err = NewConfigurationPolicy("Sometimes", "", "", nil)
+	assert.ErrorContains(t, err, "invalid failure policy")
+
+	_, err = NewConfigurationPolicy("", "env in (dev", "", nil)
+	assert.ErrorContains(t, err, "invalid namespace selector")
+
+	_, err = NewConfigurationPolicy("", "", "team in (data", nil)
+	assert.ErrorContains(t, err, "invalid object selector")
+
+	_, err = NewConfigurationPolicy("", "", "", []string{"openshift-["})
+	assert.ErrorContains(t, err, "invalid excluded namespace")
+
+	policy, err = NewConfigurationPolicy("Ignore", "env in (dev,prod)", "team=data", []string{"kube-system", "openshift-*"})
+	require.NoError(t, err)
+	assert.False(t, policy.IsEmpty())
+	assert.True(t, policy.HasNamespacePatterns())
+	assert.Equal(t, ptr.To(admissionregistrationv1.Ignore), policy.FailurePolicy)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"dev", "prod"}},
+	}, policy.NamespaceSelector.MatchExpressions)
+	assert.Equal(t, map[string]string{"team": "data"}, policy.ObjectSelector.MatchLabels)
+}
+
+func TestConfigurationPolicyApply(t *testing.T) {
+	namespaces := []string{"default", "kube-system", "openshift-monitoring", "openshift-sdn", "spark"}
+
+	t.Run("empty policy", func(t *testing.T) {
+		var policy *ConfigurationPolicy
+		failurePolicy := ptr.To(admissionregistrationv1.Fail)
+		var namespaceSelector, objectSelector *metav1.LabelSelector
+		assert.False(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, namespaces))
+		assert.Equal(t, admissionregistrationv1.Fail, *failurePolicy)
+		assert.Nil(t, namespaceSelector)
+		assert.Nil(t, objectSelector)
+	})
+
+	t.Run("excluded namespaces", func(t *testing.T) {
+		policy, err := NewConfigurationPolicy("Ignore", "", "", []string{"kube-system", "openshift-*"})
+		require.NoError(t, err)
+
+		failurePolicy := ptr.To(admissionregistrationv1.Fail)
+		namespaceSelector := &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"default", "spark"}},
+			},
+		}
+		var objectSelector *metav1.LabelSelector
+		require.True(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, namespaces))
+		assert.Equal(t, admissionregistrationv1.Ignore, *failurePolicy)
+		assert.Equal(t, []metav1.LabelSelectorRequirement{
+			{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"default", "spark"}},
+			{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system", "openshift-monitoring", "openshift-sdn"}},
+		}, namespaceSelector.MatchExpressions)
+		assert.Nil(t, objectSelector)
+
+		// Re-applying the policy with the same namespaces is a no-op.
+		assert.False(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, namespaces))
+
+		// A new namespace matching a pattern replaces the previous requirement.
+		require.True(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, append(namespaces, "openshift-console")))
+		assert.Len(t, namespaceSelector.MatchExpressions, 2)
+		assert.Equal(t, []string{"kube-system", "openshift-console", "openshift-monitoring", "openshift-sdn"}, namespaceSelector.MatchExpressions[1].Values)
+	})
+
+	t.Run("namespace and object selectors", func(t *testing.T) {
+		policy, err := NewConfigurationPolicy("", "env=prod", "team=data,tier in (batch)", nil)
+		require.NoError(t, err)
+
+		var failurePolicy *admissionregistrationv1.FailurePolicyType
+		namespaceSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}
+		objectSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"sparkoperator.k8s.io/launched-by-spark-operator": "true"}}
+		require.True(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, nil))
+		assert.Nil(t, failurePolicy)
+		assert.Equal(t, map[string]string{"env": "prod"}, namespaceSelector.MatchLabels)
+		assert.Equal(t, map[string]string{
+			"sparkoperator.k8s.io/launched-by-spark-operator": "true",
+			"team": "data",
+		}, objectSelector.MatchLabels)
+		assert.Equal(t, []metav1.LabelSelectorRequirement{
+			{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"batch"}},
+		}, objectSelector.MatchExpressions)
+
+		assert.False(t, policy.Apply(&failurePolicy, &namespaceSelector, &objectSelector, nil))
+	})
+}
+
+func TestGetConfigurationPolicy(t *testing.T) {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "webhook-policy", Namespace: "spark-operator"}
+
+	// A missing ConfigMap yields an empty policy.
+	policy, err := GetConfigurationPolicy(ctx, fake.NewClientBuilder().Build(), key)
+	require.NoError(t, err)
+	assert.True(t, policy.IsEmpty())
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		Data: map[string]string{
+			common.WebhookConfigurationPolicyKey: "failurePolicy: Ignore\nobjectSelector: team=data\nexcludedNamespaces: [kube-system, openshift-*]\n",
+		},
+	}
+	policy, err = GetConfigurationPolicy(ctx, fake.NewClientBuilder().WithObjects(configMap).Build(), key)
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To(admissionregistrationv1.Ignore), policy.FailurePolicy)
+	assert.Nil(t, policy.NamespaceSelector)
+	assert.Equal(t, map[string]string{"team": "data"}, policy.ObjectSelector.MatchLabels)
+	assert.Equal(t, []string{"kube-system", "openshift-*"}, policy.ExcludedNamespaces)
+
+	configMap.Data[common.WebhookConfigurationPolicyKey] = "failurePolicy: Sometimes\n"
+	_, err = GetConfigurationPolicy(ctx, fake.NewClientBuilder().WithObjects(configMap).Build(), key)
+	assert.ErrorContains(t, err, "invalid failure policy")
+
+	configMap.Data[common.WebhookConfigurationPolicyKey] = "excludedNamespace: [kube-system]\n"
+	_, err = GetConfigurationPolicy(ctx, fake.NewClientBuilder().WithObjects(configMap).Build(), key)
+	assert.ErrorContains(t, err, "failed to parse "+common.WebhookConfigurationPolicyKey)
+}
+
+func TestConfigurationPolicyReconcile(t *testing.T) {
+	namespaces := []string{"default", "kube-system", "openshift-sdn", "spark"}
+	jobNamespaces := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpIn, Values: []string{"default", "spark"}},
+		},
+	}
+	launchedByOperator := &metav1.LabelSelector{MatchLabels: map[string]string{"sparkoperator.k8s.io/launched-by-spark-operator": "true"}}
+	configuration := &admissionregistrationv1.MutatingWebhookConfiguration{
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:              "mutate--v1-pod.sparkoperator.k8s.io",
+				FailurePolicy:     ptr.To(admissionregistrationv1.Fail),
+				NamespaceSelector: jobNamespaces.DeepCopy(),
+				ObjectSelector:    launchedByOperator.DeepCopy(),
+			},
+		},
+	}
+	reconcile := func(policy *ConfigurationPolicy) *admissionregistrationv1.MutatingWebhook {
+		w := &configuration.Webhooks[0]
+		require.NoError(t, policy.Reconcile(configuration, []WebhookSettings{
+			{Name: w.Name, FailurePolicy: &w.FailurePolicy, NamespaceSelector: &w.NamespaceSelector, ObjectSelector: &w.ObjectSelector},
+		}, namespaces))
+		return w
+	}
+
+	policy, err := NewConfigurationPolicy("Ignore", "", "team=data", []string{"kube-system", "openshift-*"})
+	require.NoError(t, err)
+	w := reconcile(policy)
+	assert.Equal(t, admissionregistrationv1.Ignore, *w.FailurePolicy)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		jobNamespaces.MatchExpressions[0],
+		{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system", "openshift-sdn"}},
+	}, w.NamespaceSelector.MatchExpressions)
+	assert.Equal(t, map[string]string{"sparkoperator.k8s.io/launched-by-spark-operator": "true", "team": "data"}, w.ObjectSelector.MatchLabels)
+	assert.Contains(t, configuration.Annotations, common.AnnotationWebhookPolicyState)
+
+	// Settings no longer required by the policy are reverted.
+	policy, err = NewConfigurationPolicy("", "", "tier=batch", []string{"kube-system"})
+	require.NoError(t, err)
+	w = reconcile(policy)
+	assert.Equal(t, admissionregistrationv1.Fail, *w.FailurePolicy)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		jobNamespaces.MatchExpressions[0],
+		{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}},
+	}, w.NamespaceSelector.MatchExpressions)
+	assert.Equal(t, map[string]string{"sparkoperator.k8s.io/launched-by-spark-operator": "true", "tier": "batch"}, w.ObjectSelector.MatchLabels)
+
+	// Settings given to the webhook since the policy was applied, e.g. by a Helm upgrade, become its own settings.
+	w.NamespaceSelector = nil
+	w = reconcile(policy)
+	assert.Equal(t, []metav1.LabelSelectorRequirement{
+		{Key: corev1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"kube-system"}},
+	}, w.NamespaceSelector.MatchExpressions)
+
+	// An empty policy reverts the webhook to its own settings.
+	w = reconcile(&ConfigurationPolicy{})
+	assert.Equal(t, admissionregistrationv1.Fail, *w.FailurePolicy)
+	assert.Nil(t, w.NamespaceSelector)
+	assert.Equal(t, launchedByOperator, w.ObjectSelector)
+	assert.NotContains(t, configuration.Annotations, common.AnnotationWebhookPolicyState)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// applyLabelPolicies adds the labels, annotations and environment variables of the LabelPolicies selecting the given
+// Spark pod that it does not define already. Policies are applied in the order of their names, so that the first
+// policy defining a label, annotation or environment variable takes precedence.
+func applyLabelPolicies(ctx context.Context, reader client.Reader, pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	policies := &v1alpha1.LabelPolicyList{}
+	if err := reader.List(ctx, policies); err != nil {
+		return fmt.Errorf("failed to list LabelPolicies: %v", err)
+	}
+	slices.SortFunc(policies.Items, func(a, b v1alpha1.LabelPolicy) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	// The namespace is only read if a policy selects namespaces by their labels.
+	var namespace *corev1.Namespace
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.Spec.NamespaceSelector != nil && namespace == nil {
+			namespace = &corev1.Namespace{}
+			if err := reader.Get(ctx, types.NamespacedName{Name: pod.Namespace}, namespace); err != nil {
+				return fmt.Errorf("failed to get namespace %s: %v", pod.Namespace, err)
+			}
+		}
+		selected, err := labelPolicySelects(policy, namespace, pod, app)
+		if err != nil {
+			return fmt.Errorf("invalid LabelPolicy %s: %v", policy.Name, err)
+		}
+		if selected {
+			applyLabelPolicy(policy, pod)
+		}
+	}
+	return nil
+}
+
+// labelPolicySelects returns whether the given LabelPolicy selects the given Spark pod of the given SparkApplication.
+// The namespace of the pod must be given if the policy has a namespace selector.
+func labelPolicySelects(policy *v1alpha1.LabelPolicy, namespace *corev1.Namespace, pod *corev1.Pod, app *v1beta2.SparkApplication) (bool, error) {
+	role := v1alpha1.LabelPolicyRole(pod.Labels[common.LabelSparkRole])
+	if len(policy.Spec.Roles) > 0 && !slices.Contains(policy.Spec.Roles, role) {
+		return false, nil
+	}
+	if policy.Spec.NamespaceSelector != nil {
+		matched, err := matchesLabelSelector(policy.Spec.NamespaceSelector, namespace.Labels)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	if policy.Spec.ApplicationSelector != nil {
+		matched, err := matchesLabelSelector(policy.Spec.ApplicationSelector, app.Labels)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// matchesLabelSelector returns whether the given label selector matches the given labels.
+func matchesLabelSelector(labelSelector *metav1.LabelSelector, objectLabels map[string]string) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(objectLabels)), nil
+}
+
+// applyLabelPolicy adds the labels, annotations and environment variables of the given LabelPolicy to the given
+// Spark pod, unless it defines them already.
+func applyLabelPolicy(policy *v1alpha1.LabelPolicy, pod *corev1.Pod) {
+	for key, value := range policy.Spec.Labels {
+		if _, ok := pod.Labels[key]; ok {
+			continue
+		}
+		if pod.Labels == nil {
+			pod.Labels = make(map[string]string)
+		}
+		pod.Labels[key] = value
+	}
+
+	for key, value := range policy.Spec.Annotations {
+		if _, ok := pod.Annotations[key]; ok {
+			continue
+		}
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations[key] = value
+	}
+
+	i := findContainer(pod)
+	if i < 0 {
+		return
+	}
+	container := &pod.Spec.Containers[i]
+	for _, env := range policy.Spec.Env {
+		if !slices.ContainsFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == env.Name }) {
+			container.Env = append(container.Env, *env.DeepCopy())
+		}
+	}
+}
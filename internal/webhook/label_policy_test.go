@@ -0,0 +1,189 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func newTestLabelPolicyClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add corev1 to scheme: %v", err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1alpha1 to scheme: %v", err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func newTestLabelPolicyPod(role string) *corev1.Pod {
+	containerName := common.SparkDriverContainerName
+	if role == common.SparkRoleExecutor {
+		containerName = common.SparkExecutorContainerName
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark-pi-" + role,
+			Namespace: "team-a",
+			Labels: map[string]string{
+				common.LabelSparkRole:    role,
+				common.LabelSparkAppName: "spark-pi",
+				"cost-center":            "app",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: containerName,
+				Env:  []corev1.EnvVar{{Name: "TEAM", Value: "app"}},
+			}},
+		},
+	}
+}
+
+func TestApplyLabelPolicies(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tenant": "a"}},
+	}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "team-a", Labels: map[string]string{"tier": "batch"}},
+	}
+	policies := []client.Object{
+		&v1alpha1.LabelPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-tenant"},
+			Spec: v1alpha1.LabelPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}},
+				Labels:            map[string]string{"cost-center": "1234", "tenant": "a"},
+				Annotations:       map[string]string{"owner": "team-a"},
+				Env:               []corev1.EnvVar{{Name: "TEAM", Value: "a"}, {Name: "TENANT", Value: "a"}},
+			},
+		},
+		&v1alpha1.LabelPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-batch-executors"},
+			Spec: v1alpha1.LabelPolicySpec{
+				ApplicationSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "batch"}},
+				Roles:               []v1alpha1.LabelPolicyRole{v1alpha1.LabelPolicyRoleExecutor},
+				Labels:              map[string]string{"tenant": "b", "tier": "batch"},
+			},
+		},
+		&v1alpha1.LabelPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "c-other-tenant"},
+			Spec: v1alpha1.LabelPolicySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "c"}},
+				Labels:            map[string]string{"tenant": "c"},
+			},
+		},
+	}
+	reader := newTestLabelPolicyClient(t, append(policies, namespace)...)
+
+	testCases := []struct {
+		name            string
+		role            string
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+		wantEnv         []corev1.EnvVar
+	}{
+		{
+			name: "driver pod is only selected by namespace policy",
+			role: common.SparkRoleDriver,
+			wantLabels: map[string]string{
+				common.LabelSparkRole:    common.SparkRoleDriver,
+				common.LabelSparkAppName: "spark-pi",
+				"cost-center":            "app",
+				"tenant":                 "a",
+			},
+			wantAnnotations: map[string]string{"owner": "team-a"},
+			wantEnv:         []corev1.EnvVar{{Name: "TEAM", Value: "app"}, {Name: "TENANT", Value: "a"}},
+		},
+		{
+			name: "executor pod is selected by namespace and application policies in name order",
+			role: common.SparkRoleExecutor,
+			wantLabels: map[string]string{
+				common.LabelSparkRole:    common.SparkRoleExecutor,
+				common.LabelSparkAppName: "spark-pi",
+				"cost-center":            "app",
+				"tenant":                 "a",
+				"tier":                   "batch",
+			},
+			wantAnnotations: map[string]string{"owner": "team-a"},
+			wantEnv:         []corev1.EnvVar{{Name: "TEAM", Value: "app"}, {Name: "TENANT", Value: "a"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := newTestLabelPolicyPod(tc.role)
+			if err := applyLabelPolicies(context.TODO(), reader, pod, app); err != nil {
+				t.Fatalf("applyLabelPolicies() error = %v", err)
+			}
+			if !reflect.DeepEqual(pod.Labels, tc.wantLabels) {
+				t.Errorf("labels = %v, want %v", pod.Labels, tc.wantLabels)
+			}
+			if !reflect.DeepEqual(pod.Annotations, tc.wantAnnotations) {
+				t.Errorf("annotations = %v, want %v", pod.Annotations, tc.wantAnnotations)
+			}
+			if !reflect.DeepEqual(pod.Spec.Containers[0].Env, tc.wantEnv) {
+				t.Errorf("env = %v, want %v", pod.Spec.Containers[0].Env, tc.wantEnv)
+			}
+		})
+	}
+}
+
+func TestApplyLabelPoliciesWithoutPolicies(t *testing.T) {
+	pod := newTestLabelPolicyPod(common.SparkRoleDriver)
+	want := pod.DeepCopy()
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "team-a"}}
+
+	// The namespace is not read, as no policy selects namespaces.
+	if err := applyLabelPolicies(context.TODO(), newTestLabelPolicyClient(t), pod, app); err != nil {
+		t.Fatalf("applyLabelPolicies() error = %v", err)
+	}
+	if !reflect.DeepEqual(pod, want) {
+		t.Errorf("pod = %v, want %v", pod, want)
+	}
+}
+
+func TestApplyLabelPoliciesWithInvalidSelector(t *testing.T) {
+	policy := &v1alpha1.LabelPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec: v1alpha1.LabelPolicySpec{
+			ApplicationSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Unknown"}},
+			},
+		},
+	}
+	pod := newTestLabelPolicyPod(common.SparkRoleDriver)
+	app := &v1beta2.SparkApplication{ObjectMeta: metav1.ObjectMeta{Name: "spark-pi", Namespace: "team-a"}}
+
+	if err := applyLabelPolicies(context.TODO(), newTestLabelPolicyClient(t, policy), pod, app); err == nil {
+		t.Error("applyLabelPolicies() expected an error for an invalid selector")
+	}
+}
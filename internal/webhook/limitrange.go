@@ -0,0 +1,282 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// LimitRangeValidationMode determines how driver and executor resources violating the LimitRanges of
+// the application namespace are handled at admission.
+type LimitRangeValidationMode string
+
+const (
+	// LimitRangeValidationEnforce rejects SparkApplications whose driver or executor resources violate a LimitRange.
+	LimitRangeValidationEnforce LimitRangeValidationMode = "enforce"
+	// LimitRangeValidationClamp lowers driver and executor resources above the maximum of a LimitRange to
+	// that maximum, and rejects SparkApplications whose resources still violate a LimitRange.
+	LimitRangeValidationClamp LimitRangeValidationMode = "clamp"
+	// LimitRangeValidationDisabled skips looking up LimitRanges.
+	LimitRangeValidationDisabled LimitRangeValidationMode = "disabled"
+)
+
+// sparkPodResources are the resources of the Spark container of the driver or executor pods.
+type sparkPodResources struct {
+	// field is the path of the driver or executor spec the resources are derived from.
+	field         string
+	cpuRequest    resource.Quantity
+	cpuLimit      *resource.Quantity
+	memoryRequest resource.Quantity
+	memoryLimit   resource.Quantity
+}
+
+// getSparkPodResources returns the resources of the driver and executor containers of the given SparkApplication.
+func getSparkPodResources(app *v1beta2.SparkApplication) ([]sparkPodResources, error) {
+	memoryOverheadFactor, err := getMemoryOverheadFactor(app)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := getSparkPodResource("spec.driver", &app.Spec.Driver.SparkPodSpec, app.Spec.Driver.CoreRequest, memoryOverheadFactor)
+	if err != nil {
+		return nil, err
+	}
+	executor, err := getSparkPodResource("spec.executor", &app.Spec.Executor.SparkPodSpec, app.Spec.Executor.CoreRequest, memoryOverheadFactor)
+	if err != nil {
+		return nil, err
+	}
+	return []sparkPodResources{driver, executor}, nil
+}
+
+// GetDriverResources returns the cpu and memory requests and limits Spark sets on the driver container of the
+// given SparkApplication.
+func GetDriverResources(app *v1beta2.SparkApplication) (corev1.ResourceRequirements, error) {
+	memoryOverheadFactor, err := getMemoryOverheadFactor(app)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+	driver, err := getSparkPodResource("spec.driver", &app.Spec.Driver.SparkPodSpec, app.Spec.Driver.CoreRequest, memoryOverheadFactor)
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    driver.cpuRequest,
+			corev1.ResourceMemory: driver.memoryRequest,
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: driver.memoryLimit,
+		},
+	}
+	if driver.cpuLimit != nil {
+		resources.Limits[corev1.ResourceCPU] = *driver.cpuLimit
+	}
+	return resources, nil
+}
+
+func getSparkPodResource(field string, podSpec *v1beta2.SparkPodSpec, coreRequest *string, memoryOverheadFactor float64) (sparkPodResources, error) {
+	resources := sparkPodResources{field: field}
+
+	switch {
+	case coreRequest != nil:
+		quantity, err := resource.ParseQuantity(*coreRequest)
+		if err != nil {
+			return resources, fmt.Errorf("%s.coreRequest: %v", field, err)
+		}
+		resources.cpuRequest = quantity
+	case podSpec.Cores != nil:
+		resources.cpuRequest = *resource.NewQuantity(int64(*podSpec.Cores), resource.DecimalSI)
+	default:
+		resources.cpuRequest = *resource.NewMilliQuantity(common.DefaultCPUMilliCores, resource.DecimalSI)
+	}
+
+	if podSpec.CoreLimit != nil {
+		quantity, err := resource.ParseQuantity(*podSpec.CoreLimit)
+		if err != nil {
+			return resources, fmt.Errorf("%s.coreLimit: %v", field, err)
+		}
+		resources.cpuLimit = &quantity
+	}
+
+	memory, err := getSparkPodMemoryRequests(podSpec, memoryOverheadFactor, 1)
+	if err != nil {
+		return resources, fmt.Errorf("%s: %v", field, err)
+	}
+	// Spark requests memory in whole MiB.
+	total := memory[corev1.ResourceMemory]
+	resources.memoryRequest = *resource.NewQuantity(total.Value()>>20<<20, resource.BinarySI)
+	resources.memoryLimit = resources.memoryRequest
+	if podSpec.MemoryLimit != nil {
+		quantity, err := resource.ParseQuantity(*podSpec.MemoryLimit)
+		if err != nil {
+			return resources, fmt.Errorf("%s.memoryLimit: %v", field, err)
+		}
+		resources.memoryLimit = quantity
+	}
+	return resources, nil
+}
+
+// listLimitRanges lists the LimitRanges of the given namespace.
+func listLimitRanges(ctx context.Context, c client.Client, namespace string) ([]corev1.LimitRange, error) {
+	limitRanges := &corev1.LimitRangeList{}
+	if err := c.List(ctx, limitRanges, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list limit ranges: %v", err)
+	}
+	return limitRanges.Items, nil
+}
+
+// validateLimitRanges checks the driver and executor resources of the given SparkApplication against
+// the minimum and maximum of the Container and Pod LimitRanges. Sidecars and init containers are not
+// accounted for.
+func validateLimitRanges(app *v1beta2.SparkApplication, limitRanges []corev1.LimitRange) error {
+	pods, err := getSparkPodResources(app)
+	if err != nil {
+		return fmt.Errorf("failed to calculate driver and executor resources: %v", err)
+	}
+
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer && item.Type != corev1.LimitTypePod {
+				continue
+			}
+			for _, pod := range pods {
+				checks := []struct {
+					name         string
+					resourceName corev1.ResourceName
+					quantity     *resource.Quantity
+				}{
+					{"cpu request", corev1.ResourceCPU, &pod.cpuRequest},
+					{"cpu limit", corev1.ResourceCPU, pod.cpuLimit},
+					{"memory request", corev1.ResourceMemory, &pod.memoryRequest},
+					{"memory limit", corev1.ResourceMemory, &pod.memoryLimit},
+				}
+				for _, check := range checks {
+					if check.quantity == nil {
+						continue
+					}
+					if maxQuantity, ok := item.Max[check.resourceName]; ok && check.quantity.Cmp(maxQuantity) > 0 {
+						return fmt.Errorf("%s: %s %s is above the maximum %s per %s of LimitRange %s/%s",
+							pod.field, check.name, check.quantity.String(), maxQuantity.String(), item.Type, limitRange.Namespace, limitRange.Name)
+					}
+					if minQuantity, ok := item.Min[check.resourceName]; ok && check.quantity.Cmp(minQuantity) < 0 {
+						return fmt.Errorf("%s: %s %s is below the minimum %s per %s of LimitRange %s/%s",
+							pod.field, check.name, check.quantity.String(), minQuantity.String(), item.Type, limitRange.Namespace, limitRange.Name)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// clampToLimitRanges lowers the driver and executor resources of the given SparkApplication that are above
+// the maximum of the Container and Pod LimitRanges to that maximum. It returns the fields that were changed.
+func clampToLimitRanges(app *v1beta2.SparkApplication, limitRanges []corev1.LimitRange) ([]string, error) {
+	maxCPU, hasMaxCPU := getLimitRangeMax(limitRanges, corev1.ResourceCPU)
+	maxMemory, hasMaxMemory := getLimitRangeMax(limitRanges, corev1.ResourceMemory)
+	if !hasMaxCPU && !hasMaxMemory {
+		return nil, nil
+	}
+
+	pods, err := getSparkPodResources(app)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate driver and executor resources: %v", err)
+	}
+
+	var clamped []string
+	for _, pod := range pods {
+		podSpec, coreRequest := &app.Spec.Driver.SparkPodSpec, &app.Spec.Driver.CoreRequest
+		if pod.field == "spec.executor" {
+			podSpec, coreRequest = &app.Spec.Executor.SparkPodSpec, &app.Spec.Executor.CoreRequest
+		}
+		resources := podSpec.Resources
+		if resources == nil {
+			resources = &corev1.ResourceRequirements{}
+		}
+
+		if hasMaxCPU {
+			if pod.cpuRequest.Cmp(maxCPU) > 0 {
+				*coreRequest = ptr.To(maxCPU.String())
+				clampResource(resources.Requests, corev1.ResourceCPU, maxCPU)
+				clamped = append(clamped, pod.field+".coreRequest")
+			}
+			if pod.cpuLimit != nil && pod.cpuLimit.Cmp(maxCPU) > 0 {
+				podSpec.CoreLimit = ptr.To(maxCPU.String())
+				clampResource(resources.Limits, corev1.ResourceCPU, maxCPU)
+				clamped = append(clamped, pod.field+".coreLimit")
+			}
+		}
+
+		if hasMaxMemory {
+			if podSpec.MemoryLimit != nil && pod.memoryLimit.Cmp(maxMemory) > 0 {
+				podSpec.MemoryLimit = ptr.To(maxMemory.String())
+				clampResource(resources.Limits, corev1.ResourceMemory, maxMemory)
+				clamped = append(clamped, pod.field+".memoryLimit")
+			}
+			// The memory request is the sum of the heap and the overhead, so the heap is lowered by the excess.
+			excess := pod.memoryRequest.Value() - maxMemory.Value()
+			if excess > 0 && podSpec.Memory != nil {
+				memory, err := parseJavaMemoryString(*podSpec.Memory)
+				if err != nil {
+					return nil, err
+				}
+				if memory-excess >= 1<<20 {
+					podSpec.Memory = ptr.To(fmt.Sprintf("%dm", (memory-excess)>>20))
+					clampResource(resources.Requests, corev1.ResourceMemory, *resource.NewQuantity((memory-excess)>>20<<20, resource.BinarySI))
+					clamped = append(clamped, pod.field+".memory")
+				}
+			}
+		}
+	}
+	return clamped, nil
+}
+
+// clampResource sets a structured resource that is set to its clamped value, keeping it consistent with the
+// string-based field that was clamped.
+func clampResource(resourceList corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) {
+	if _, ok := resourceList[name]; ok {
+		resourceList[name] = quantity
+	}
+}
+
+// getLimitRangeMax returns the lowest maximum of the given resource across the Container and Pod LimitRanges.
+func getLimitRangeMax(limitRanges []corev1.LimitRange, resourceName corev1.ResourceName) (resource.Quantity, bool) {
+	var lowest resource.Quantity
+	found := false
+	for _, limitRange := range limitRanges {
+		for _, item := range limitRange.Spec.Limits {
+			if item.Type != corev1.LimitTypeContainer && item.Type != corev1.LimitTypePod {
+				continue
+			}
+			if maxQuantity, ok := item.Max[resourceName]; ok && (!found || maxQuantity.Cmp(lowest) < 0) {
+				lowest = maxQuantity
+				found = true
+			}
+		}
+	}
+	return lowest, found
+}
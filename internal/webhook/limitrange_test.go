@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func newLimitRange(limitType corev1.LimitType, minimum, maximum corev1.ResourceList) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{Name: "limits", Namespace: "default"},
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{Type: limitType, Min: minimum, Max: maximum}},
+		},
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_LimitRanges(t *testing.T) {
+	tests := []struct {
+		name       string
+		limitRange *corev1.LimitRange
+		mode       LimitRangeValidationMode
+		wantErr    string
+	}{
+		{
+			name: "within limits",
+			limitRange: newLimitRange(corev1.LimitTypeContainer,
+				corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("2Gi")}),
+			mode: LimitRangeValidationEnforce,
+		},
+		{
+			name: "memory above maximum",
+			limitRange: newLimitRange(corev1.LimitTypeContainer, nil,
+				corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}),
+			mode:    LimitRangeValidationEnforce,
+			wantErr: "spec.driver: memory request 1433Mi is above the maximum 1Gi per Container of LimitRange default/limits",
+		},
+		{
+			name: "cpu below minimum",
+			limitRange: newLimitRange(corev1.LimitTypePod,
+				corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}, nil),
+			mode:    LimitRangeValidationEnforce,
+			wantErr: "spec.driver: cpu request 1 is below the minimum 2 per Pod of LimitRange default/limits",
+		},
+		{
+			name: "violation ignored when disabled",
+			limitRange: newLimitRange(corev1.LimitTypeContainer, nil,
+				corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")}),
+			mode: LimitRangeValidationDisabled,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(t, false, tc.limitRange)
+			validator.limitRangeValidation = tc.mode
+
+			_, err := validator.ValidateCreate(context.Background(), newSparkApplication())
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestClampToLimitRanges(t *testing.T) {
+	limitRanges := []corev1.LimitRange{
+		*newLimitRange(corev1.LimitTypeContainer, nil, corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+			corev1.ResourceMemory: resource.MustParse("1Gi"),
+		}),
+	}
+
+	app := newSparkApplication()
+	app.Spec.Executor.CoreLimit = ptr.To("2")
+	app.Spec.Executor.Resources = &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+	}
+	app.Spec.Driver.Memory = ptr.To("512m")
+	clamped, err := clampToLimitRanges(app, limitRanges)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"spec.driver.coreRequest", "spec.executor.coreRequest", "spec.executor.coreLimit", "spec.executor.memory"}
+	if strings.Join(clamped, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected clamped fields %v, got %v", want, clamped)
+	}
+	if *app.Spec.Executor.CoreRequest != "500m" || *app.Spec.Executor.CoreLimit != "500m" {
+		t.Fatalf("expected executor cpu to be clamped to 500m, got request %s and limit %s", *app.Spec.Executor.CoreRequest, *app.Spec.Executor.CoreLimit)
+	}
+	if limit := app.Spec.Executor.Resources.Limits[corev1.ResourceCPU]; limit.String() != "500m" {
+		t.Fatalf("expected executor resources.limits.cpu to be clamped to 500m, got %s", limit.String())
+	}
+	if *app.Spec.Driver.Memory != "512m" {
+		t.Fatalf("expected driver memory to be unchanged, got %s", *app.Spec.Driver.Memory)
+	}
+	if err := validateLimitRanges(app, limitRanges); err != nil {
+		t.Fatalf("expected clamped application to satisfy the limit ranges, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"math"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+// sparkPodMemory is the memory configuration of the driver or executor pods, in bytes.
+type sparkPodMemory struct {
+	heap     *int64
+	overhead *int64
+	pyspark  int64
+	limit    *int64
+}
+
+// getSparkPodMemory returns the memory configuration of the driver or executor pods. The heap and memory overhead
+// are taken from the pod spec, falling back to the given Spark configuration keys.
+func getSparkPodMemory(
+	podSpec *v1beta2.SparkPodSpec,
+	sparkConf map[string]string,
+	heapKey string,
+	overheadKey string,
+	pysparkMemory int64,
+) (sparkPodMemory, error) {
+	memory := sparkPodMemory{pyspark: pysparkMemory}
+
+	heap := podSpec.Memory
+	if heap == nil {
+		if value, ok := sparkConf[heapKey]; ok {
+			heap = &value
+		}
+	}
+	if heap != nil {
+		parsed, err := parseJavaMemoryString(*heap)
+		if err != nil {
+			return memory, fmt.Errorf("memory: %v", err)
+		}
+		memory.heap = &parsed
+	} else if podSpec.Resources != nil {
+		if quantity, ok := podSpec.Resources.Requests[corev1.ResourceMemory]; ok {
+			memory.heap = ptr.To(quantity.Value())
+		}
+	}
+
+	overhead := podSpec.MemoryOverhead
+	if overhead == nil {
+		if value, ok := sparkConf[overheadKey]; ok {
+			overhead = &value
+		}
+	}
+	if overhead != nil {
+		parsed, err := parseJavaMemoryString(*overhead)
+		if err != nil {
+			return memory, fmt.Errorf("memoryOverhead: %v", err)
+		}
+		memory.overhead = &parsed
+	}
+
+	if podSpec.MemoryLimit != nil {
+		quantity, err := resource.ParseQuantity(util.ConvertJavaMemoryStringToK8sMemoryString(*podSpec.MemoryLimit))
+		if err != nil {
+			return memory, fmt.Errorf("memoryLimit: %v", err)
+		}
+		memory.limit = ptr.To(quantity.Value())
+	} else if podSpec.Resources != nil {
+		if quantity, ok := podSpec.Resources.Limits[corev1.ResourceMemory]; ok {
+			memory.limit = ptr.To(quantity.Value())
+		}
+	}
+	return memory, nil
+}
+
+// getOverhead returns the explicit memory overhead, or the one Spark derives from the heap and overhead factor.
+func (m sparkPodMemory) getOverhead(memoryOverheadFactor float64) int64 {
+	if m.overhead != nil {
+		return *m.overhead
+	}
+	var heap int64
+	if m.heap != nil {
+		heap = *m.heap
+	}
+	// Spark sizes the memory overhead in whole MiB.
+	return int64(math.Max(float64(heap)*memoryOverheadFactor, common.MinMemoryOverhead)) >> 20 << 20
+}
+
+// getPySparkMemory returns the memory of the Python workers of each executor, which Spark adds to the executor pod
+// memory of Python applications.
+func getPySparkMemory(app *v1beta2.SparkApplication) (int64, error) {
+	value, ok := app.Spec.SparkConf[common.SparkExecutorPySparkMemory]
+	if !ok || app.Spec.Type != v1beta2.SparkApplicationTypePython {
+		return 0, nil
+	}
+	parsed, err := parseJavaMemoryString(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", common.SparkExecutorPySparkMemory, err)
+	}
+	return parsed, nil
+}
+
+// getApplicationMemory returns the memory configuration of the driver and executors of the given SparkApplication.
+func getApplicationMemory(app *v1beta2.SparkApplication) (driver sparkPodMemory, executor sparkPodMemory, err error) {
+	pysparkMemory, err := getPySparkMemory(app)
+	if err != nil {
+		return driver, executor, err
+	}
+	driver, err = getSparkPodMemory(&app.Spec.Driver.SparkPodSpec, app.Spec.SparkConf,
+		common.SparkDriverMemory, common.SparkDriverMemoryOverhead, 0)
+	if err != nil {
+		return driver, executor, fmt.Errorf("spec.driver.%v", err)
+	}
+	executor, err = getSparkPodMemory(&app.Spec.Executor.SparkPodSpec, app.Spec.SparkConf,
+		common.SparkExecutorMemory, common.SparkExecutorMemoryOverhead, pysparkMemory)
+	if err != nil {
+		return driver, executor, fmt.Errorf("spec.executor.%v", err)
+	}
+	return driver, executor, nil
+}
+
+// tuneMemory derives the heap and memory overhead of the driver and executors from their memory limit, or their
+// memory limit from the heap, memory overhead and PySpark memory, when only one side is set.
+// It must run before the scheme defaults, which set a heap of 1g.
+func tuneMemory(app *v1beta2.SparkApplication) error {
+	memoryOverheadFactor, err := getMemoryOverheadFactor(app)
+	if err != nil {
+		return err
+	}
+	driver, executor, err := getApplicationMemory(app)
+	if err != nil {
+		return err
+	}
+	if err := tuneSparkPodMemory("driver", &app.Spec.Driver.SparkPodSpec, driver, memoryOverheadFactor); err != nil {
+		return err
+	}
+	return tuneSparkPodMemory("executor", &app.Spec.Executor.SparkPodSpec, executor, memoryOverheadFactor)
+}
+
+func tuneSparkPodMemory(role string, podSpec *v1beta2.SparkPodSpec, memory sparkPodMemory, memoryOverheadFactor float64) error {
+	switch {
+	case memory.limit != nil && memory.heap == nil:
+		available := *memory.limit - memory.pyspark
+		var heap int64
+		if memory.overhead != nil {
+			heap = available - *memory.overhead
+		} else {
+			heap = int64(float64(available) / (1 + memoryOverheadFactor))
+			if float64(heap)*memoryOverheadFactor < common.MinMemoryOverhead {
+				heap = available - common.MinMemoryOverhead
+			}
+		}
+		// Spark sizes the heap in whole MiB.
+		heap = heap >> 20 << 20
+		if heap <= 0 {
+			return fmt.Errorf("%s memory limit %s leaves no memory for the heap after the memory overhead and PySpark memory",
+				role, resource.NewQuantity(*memory.limit, resource.BinarySI))
+		}
+		podSpec.Memory = ptr.To(fmt.Sprintf("%dm", heap>>20))
+		if memory.overhead == nil {
+			podSpec.MemoryOverhead = ptr.To(fmt.Sprintf("%dm", (available-heap)>>20))
+		}
+	case memory.heap != nil && memory.limit == nil:
+		required := *memory.heap + memory.getOverhead(memoryOverheadFactor) + memory.pyspark
+		// Round up to whole MiB so the limit is never lower than the pod memory request.
+		required = (required + 1<<20 - 1) >> 20 << 20
+		podSpec.MemoryLimit = ptr.To(resource.NewQuantity(required, resource.BinarySI).String())
+	}
+	return nil
+}
+
+// validateMemoryLimits ensures the memory limit of the driver and executors fits their heap, memory overhead and
+// PySpark memory, which would otherwise get the pods OOMKilled.
+func validateMemoryLimits(app *v1beta2.SparkApplication) error {
+	memoryOverheadFactor, err := getMemoryOverheadFactor(app)
+	if err != nil {
+		return err
+	}
+	driver, executor, err := getApplicationMemory(app)
+	if err != nil {
+		return err
+	}
+	if err := validateSparkPodMemoryLimit("driver", driver, memoryOverheadFactor); err != nil {
+		return err
+	}
+	return validateSparkPodMemoryLimit("executor", executor, memoryOverheadFactor)
+}
+
+func validateSparkPodMemoryLimit(role string, memory sparkPodMemory, memoryOverheadFactor float64) error {
+	if memory.limit == nil || memory.heap == nil {
+		return nil
+	}
+	required := *memory.heap + memory.getOverhead(memoryOverheadFactor) + memory.pyspark
+	if *memory.limit < required {
+		return fmt.Errorf("%s memory limit %s is lower than the %s required by its heap, memory overhead and PySpark memory",
+			role, resource.NewQuantity(*memory.limit, resource.BinarySI), resource.NewQuantity(required, resource.BinarySI))
+	}
+	return nil
+}
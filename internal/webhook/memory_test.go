@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestTuneMemory(t *testing.T) {
+	tests := []struct {
+		name            string
+		mutate          func(app *v1beta2.SparkApplication)
+		pod             func(app *v1beta2.SparkApplication) *v1beta2.SparkPodSpec
+		wantMemory      *string
+		wantOverhead    *string
+		wantMemoryLimit *string
+		wantErr         string
+	}{
+		{
+			name: "heap and overhead derived from limit",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.Memory = nil
+				app.Spec.Driver.MemoryLimit = ptr.To("4Gi")
+			},
+			pod:             driverPodSpec,
+			wantMemory:      ptr.To("2925m"),
+			wantOverhead:    ptr.To("1171m"),
+			wantMemoryLimit: ptr.To("4Gi"),
+		},
+		{
+			name: "minimum overhead applied",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Type = v1beta2.SparkApplicationTypeJava
+				app.Spec.Driver.Memory = nil
+				app.Spec.Driver.MemoryLimit = ptr.To("1g")
+			},
+			pod:             driverPodSpec,
+			wantMemory:      ptr.To("640m"),
+			wantOverhead:    ptr.To("384m"),
+			wantMemoryLimit: ptr.To("1g"),
+		},
+		{
+			name: "explicit overhead kept",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Executor.Memory = nil
+				app.Spec.Executor.MemoryOverhead = ptr.To("512m")
+				app.Spec.Executor.MemoryLimit = ptr.To("2Gi")
+			},
+			pod:             executorPodSpec,
+			wantMemory:      ptr.To("1536m"),
+			wantOverhead:    ptr.To("512m"),
+			wantMemoryLimit: ptr.To("2Gi"),
+		},
+		{
+			name: "pyspark memory reserved on executors",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Type = v1beta2.SparkApplicationTypePython
+				app.Spec.SparkConf = map[string]string{common.SparkExecutorPySparkMemory: "512m"}
+				app.Spec.Executor.Memory = nil
+				app.Spec.Executor.MemoryLimit = ptr.To("2Gi")
+			},
+			pod:             executorPodSpec,
+			wantMemory:      ptr.To("1097m"),
+			wantOverhead:    ptr.To("439m"),
+			wantMemoryLimit: ptr.To("2Gi"),
+		},
+		{
+			name: "limit from structured resources",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.Memory = nil
+				app.Spec.Driver.Resources = &corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				}
+			},
+			pod:          driverPodSpec,
+			wantMemory:   ptr.To("1462m"),
+			wantOverhead: ptr.To("586m"),
+		},
+		{
+			name: "limit derived from heap",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Type = v1beta2.SparkApplicationTypePython
+				app.Spec.SparkConf = map[string]string{common.SparkExecutorPySparkMemory: "1g"}
+			},
+			pod:             executorPodSpec,
+			wantMemory:      ptr.To("1g"),
+			wantMemoryLimit: ptr.To("2457Mi"),
+		},
+		{
+			name: "heap and limit left unchanged",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.MemoryLimit = ptr.To("1Gi")
+			},
+			pod:             driverPodSpec,
+			wantMemory:      ptr.To("1g"),
+			wantMemoryLimit: ptr.To("1Gi"),
+		},
+		{
+			name: "limit too low for the overhead",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.Memory = nil
+				app.Spec.Driver.MemoryLimit = ptr.To("256Mi")
+			},
+			pod:     driverPodSpec,
+			wantErr: "driver memory limit 256Mi leaves no memory for the heap",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			tc.mutate(app)
+
+			err := tuneMemory(app)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			podSpec := tc.pod(app)
+			assertStringPtr(t, "memory", tc.wantMemory, podSpec.Memory)
+			assertStringPtr(t, "memoryOverhead", tc.wantOverhead, podSpec.MemoryOverhead)
+			assertStringPtr(t, "memoryLimit", tc.wantMemoryLimit, podSpec.MemoryLimit)
+		})
+	}
+}
+
+func TestSparkApplicationDefaulterDefault_MemoryTuning(t *testing.T) {
+	defaulter := NewSparkApplicationDefaulter(fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build(), LimitRangeValidationDisabled, true)
+
+	app := newSparkApplication()
+	app.Spec.Driver.Memory = nil
+	app.Spec.Driver.MemoryLimit = ptr.To("2g")
+	app.Spec.Executor.Memory = nil
+	if err := defaulter.Default(context.Background(), app); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	assertStringPtr(t, "driver memory", ptr.To("1462m"), app.Spec.Driver.Memory)
+	assertStringPtr(t, "driver memoryOverhead", ptr.To("586m"), app.Spec.Driver.MemoryOverhead)
+	// The executor has neither heap nor limit and gets the default heap.
+	assertStringPtr(t, "executor memory", ptr.To("1g"), app.Spec.Executor.Memory)
+	assertStringPtr(t, "executor memoryLimit", nil, app.Spec.Executor.MemoryLimit)
+}
+
+func TestSparkApplicationValidatorValidateCreate_MemoryLimits(t *testing.T) {
+	tests := []struct {
+		name    string
+		tuning  bool
+		mutate  func(app *v1beta2.SparkApplication)
+		wantErr string
+	}{
+		{
+			name:   "limit fits heap and overhead",
+			tuning: true,
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.MemoryLimit = ptr.To("1433Mi")
+			},
+		},
+		{
+			name:   "limit below heap and overhead",
+			tuning: true,
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.MemoryLimit = ptr.To("1Gi")
+			},
+			wantErr: "driver memory limit 1Gi is lower than the 1433Mi required by its heap, memory overhead and PySpark memory",
+		},
+		{
+			name:   "limit below heap, overhead and pyspark memory",
+			tuning: true,
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Type = v1beta2.SparkApplicationTypePython
+				app.Spec.SparkConf = map[string]string{common.SparkExecutorPySparkMemory: "1g"}
+				app.Spec.Executor.MemoryLimit = ptr.To("2Gi")
+			},
+			wantErr: "executor memory limit 2Gi is lower than the 2457Mi required",
+		},
+		{
+			name: "violation ignored when disabled",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.MemoryLimit = ptr.To("1Gi")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(t, false)
+			validator.enableMemoryTuning = tc.tuning
+
+			app := newSparkApplication()
+			tc.mutate(app)
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func driverPodSpec(app *v1beta2.SparkApplication) *v1beta2.SparkPodSpec {
+	return &app.Spec.Driver.SparkPodSpec
+}
+
+func executorPodSpec(app *v1beta2.SparkApplication) *v1beta2.SparkPodSpec {
+	return &app.Spec.Executor.SparkPodSpec
+}
+
+func assertStringPtr(t *testing.T, field string, want, got *string) {
+	t.Helper()
+
+	if want == nil {
+		if got != nil {
+			t.Fatalf("expected %s to be unset, got %s", field, *got)
+		}
+		return
+	}
+	if got == nil || *got != *want {
+		t.Fatalf("expected %s %s, got %v", field, *want, ptr.Deref(got, "<unset>"))
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// OfflineOptions configures the webhook checks that DefaultAndValidate runs without a cluster.
+type OfflineOptions struct {
+	// EnableMemoryTuning derives the memory settings of the driver and executors like the webhook flag of the
+	// same name.
+	EnableMemoryTuning bool
+	// VolumePolicy restricts the volume types the SparkApplication may declare. Nil admits every volume.
+	VolumePolicy *VolumePolicy
+}
+
+// DefaultAndValidate runs the defaulting and validation of the SparkApplication webhooks on the given
+// SparkApplication in place, without a cluster to talk to. The checks that depend on objects of the cluster,
+// i.e. ResourceQuotas, LimitRanges and the Secrets referenced by envSecretRefs, are skipped, and
+// SparkApplications referencing a SparkApplicationTemplate are rejected as the template cannot be resolved.
+func DefaultAndValidate(ctx context.Context, app *v1beta2.SparkApplication, options OfflineOptions) (admission.Warnings, error) {
+	if app.Spec.TemplateRef != nil && *app.Spec.TemplateRef != "" {
+		return nil, fmt.Errorf("SparkApplicationTemplate %s cannot be resolved without a cluster", *app.Spec.TemplateRef)
+	}
+
+	defaulter := NewSparkApplicationDefaulter(nil, LimitRangeValidationDisabled, options.EnableMemoryTuning)
+	if err := defaulter.Default(ctx, app); err != nil {
+		return nil, err
+	}
+
+	validator := NewSparkApplicationValidator(nil, false, EnvSecretRefValidationDisabled, options.VolumePolicy, LimitRangeValidationDisabled, options.EnableMemoryTuning)
+	return validator.ValidateCreate(ctx, app)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestDefaultAndValidate(t *testing.T) {
+	app := newSparkApplication()
+	if _, err := DefaultAndValidate(context.Background(), app, OfflineOptions{}); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+	if app.Spec.RestartPolicy.Type != v1beta2.RestartPolicyNever {
+		t.Fatalf("expected restart policy to be defaulted, got %q", app.Spec.RestartPolicy.Type)
+	}
+
+	app = newSparkApplication()
+	app.Spec.SparkVersion = "2.4.0"
+	app.Spec.Interactive = &v1beta2.InteractiveSpec{}
+	app.Spec.MainApplicationFile = nil
+	if _, err := DefaultAndValidate(context.Background(), app, OfflineOptions{}); err == nil || !strings.Contains(err.Error(), "Spark Connect") {
+		t.Fatalf("expected Spark Connect version error, got %v", err)
+	}
+
+	app = newSparkApplication()
+	app.Spec.Volumes = []corev1.Volume{{Name: "host", VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/"}}}}
+	options := OfflineOptions{VolumePolicy: &VolumePolicy{DeniedTypes: []string{"hostPath"}}}
+	if _, err := DefaultAndValidate(context.Background(), app, options); err == nil || !strings.Contains(err.Error(), "hostPath") {
+		t.Fatalf("expected volume policy error, got %v", err)
+	}
+
+	app = newSparkApplication()
+	app.Spec.TemplateRef = ptr.To("defaults")
+	if _, err := DefaultAndValidate(context.Background(), app, OfflineOptions{}); err == nil || !strings.Contains(err.Error(), "cannot be resolved") {
+		t.Fatalf("expected template error, got %v", err)
+	}
+}
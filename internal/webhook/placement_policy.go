@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// PlacementPolicy injects the default tolerations, node selector and topology spread constraints defined by
+// cluster admins into the Spark pods of the namespaces it selects, e.g. to steer them to dedicated node pools.
+type PlacementPolicy struct {
+	// Rules are the placement defaults of the namespaces they select. The first rule selecting a pod applies to it.
+	Rules []PlacementRule `json:"rules"`
+}
+
+// PlacementRule defines the placement defaults of the Spark pods of the namespaces it selects.
+type PlacementRule struct {
+	// Namespaces lists the names of the namespaces the rule applies to, or glob patterns such as `team-*`.
+	// Empty selects every namespace.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Roles lists the Spark roles, driver or executor, of the pods the rule applies to. Empty selects both.
+	Roles []string `json:"roles,omitempty"`
+	// Tolerations are added to the pods, unless they already tolerate taints with the same key and effect.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// NodeSelector is added to the node selector of the pods, unless they already select the same labels.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// TopologySpreadConstraints are added to the pods, unless they already spread over the same topology keys.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// LoadPlacementPolicy loads the placement policy from the given YAML file, or returns nil if the path is empty.
+func LoadPlacementPolicy(path string) (*PlacementPolicy, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read placement policy: %v", err)
+	}
+	policy := &PlacementPolicy{}
+	if err := yaml.UnmarshalStrict(data, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse placement policy: %v", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid placement policy: %v", err)
+	}
+	return policy, nil
+}
+
+// Validate checks that the namespace patterns and roles of the rules of the policy are valid.
+func (p *PlacementPolicy) Validate() error {
+	for i, rule := range p.Rules {
+		for _, pattern := range rule.Namespaces {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("rule %d: invalid namespace %q: %v", i, pattern, err)
+			}
+		}
+		for _, role := range rule.Roles {
+			if role != common.SparkRoleDriver && role != common.SparkRoleExecutor {
+				return fmt.Errorf("rule %d: invalid role %q, must be %s or %s", i, role, common.SparkRoleDriver, common.SparkRoleExecutor)
+			}
+		}
+	}
+	return nil
+}
+
+// getRule returns the first rule of the policy selecting the pods of the given namespace and role, or nil.
+func (p *PlacementPolicy) getRule(namespace, role string) *PlacementRule {
+	for i, rule := range p.Rules {
+		if len(rule.Roles) > 0 && !slices.Contains(rule.Roles, role) {
+			continue
+		}
+		if len(rule.Namespaces) == 0 || slices.ContainsFunc(rule.Namespaces, func(pattern string) bool {
+			matched, _ := path.Match(pattern, namespace)
+			return matched
+		}) {
+			return &p.Rules[i]
+		}
+	}
+	return nil
+}
+
+// apply adds the placement defaults of the given Spark pod that it does not override already, unless its
+// SparkApplication opts out with the AnnotationPlacementDefaults annotation. A nil policy leaves the pod untouched.
+func (p *PlacementPolicy) apply(pod *corev1.Pod, app *v1beta2.SparkApplication) {
+	if p == nil || app.Annotations[common.AnnotationPlacementDefaults] == "false" {
+		return
+	}
+	rule := p.getRule(pod.Namespace, pod.Labels[common.LabelSparkRole])
+	if rule == nil {
+		return
+	}
+
+	for key, value := range rule.NodeSelector {
+		if _, ok := pod.Spec.NodeSelector[key]; ok {
+			continue
+		}
+		if pod.Spec.NodeSelector == nil {
+			pod.Spec.NodeSelector = make(map[string]string)
+		}
+		pod.Spec.NodeSelector[key] = value
+	}
+
+	for _, toleration := range rule.Tolerations {
+		if !slices.ContainsFunc(pod.Spec.Tolerations, func(t corev1.Toleration) bool {
+			return t.Key == toleration.Key && t.Effect == toleration.Effect
+		}) {
+			pod.Spec.Tolerations = append(pod.Spec.Tolerations, toleration)
+		}
+	}
+
+	for _, constraint := range rule.TopologySpreadConstraints {
+		if !slices.ContainsFunc(pod.Spec.TopologySpreadConstraints, func(c corev1.TopologySpreadConstraint) bool {
+			return c.TopologyKey == constraint.TopologyKey
+		}) {
+			pod.Spec.TopologySpreadConstraints = append(pod.Spec.TopologySpreadConstraints, constraint)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+func TestLoadPlacementPolicy(t *testing.T) {
+	policy, err := LoadPlacementPolicy("")
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	dir := t.TempDir()
+	write := func(data string) string {
+		file := filepath.Join(dir, "policy.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(data), 0o600))
+		return file
+	}
+
+	policy, err = LoadPlacementPolicy(write(`
+rules:
+- namespaces:
+  - team-*
+  roles:
+  - executor
+  nodeSelector:
+    node-pool: spark
+`))
+	require.NoError(t, err)
+	require.Len(t, policy.Rules, 1)
+	assert.Equal(t, map[string]string{"node-pool": "spark"}, policy.Rules[0].NodeSelector)
+
+	_, err = LoadPlacementPolicy(write("rules:\n- nodeSelectors: {}\n"))
+	assert.ErrorContains(t, err, "failed to parse placement policy")
+
+	_, err = LoadPlacementPolicy(write("rules:\n- roles: [worker]\n"))
+	assert.ErrorContains(t, err, `invalid role "worker"`)
+
+	_, err = LoadPlacementPolicy(write("rules:\n- namespaces: [\"team-[\"]\n"))
+	assert.ErrorContains(t, err, "invalid namespace")
+}
+
+func TestPlacementPolicyApply(t *testing.T) {
+	policy := &PlacementPolicy{
+		Rules: []PlacementRule{
+			{
+				Namespaces: []string{"team-*"},
+				Roles:      []string{common.SparkRoleExecutor},
+				NodeSelector: map[string]string{
+					"node-pool": "spark-spot",
+					"zone":      "a",
+				},
+				Tolerations: []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "spark", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				},
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{MaxSkew: 1, TopologyKey: corev1.LabelTopologyZone, WhenUnsatisfiable: corev1.ScheduleAnyway},
+				},
+			},
+			{
+				Namespaces:   []string{"team-*"},
+				NodeSelector: map[string]string{"node-pool": "spark"},
+			},
+		},
+	}
+	newPod := func(namespace, role string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Labels:    map[string]string{common.LabelSparkRole: role},
+			},
+			Spec: corev1.PodSpec{
+				NodeSelector: map[string]string{"zone": "b"},
+				Tolerations: []corev1.Toleration{
+					{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+		}
+	}
+	app := &v1beta2.SparkApplication{}
+
+	// Values set by the application take precedence over the defaults.
+	pod := newPod("team-a", common.SparkRoleExecutor)
+	policy.apply(pod, app)
+	assert.Equal(t, map[string]string{"node-pool": "spark-spot", "zone": "b"}, pod.Spec.NodeSelector)
+	assert.Equal(t, []corev1.Toleration{
+		{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+		{Key: "spot", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	}, pod.Spec.Tolerations)
+	assert.Len(t, pod.Spec.TopologySpreadConstraints, 1)
+
+	// The first rule selecting the pod applies.
+	pod = newPod("team-a", common.SparkRoleDriver)
+	policy.apply(pod, app)
+	assert.Equal(t, map[string]string{"node-pool": "spark", "zone": "b"}, pod.Spec.NodeSelector)
+	assert.Len(t, pod.Spec.Tolerations, 1)
+
+	pod = newPod("default", common.SparkRoleExecutor)
+	policy.apply(pod, app)
+	assert.Equal(t, map[string]string{"zone": "b"}, pod.Spec.NodeSelector)
+
+	app.Annotations = map[string]string{common.AnnotationPlacementDefaults: "false"}
+	pod = newPod("team-a", common.SparkRoleExecutor)
+	policy.apply(pod, app)
+	assert.Equal(t, map[string]string{"zone": "b"}, pod.Spec.NodeSelector)
+}
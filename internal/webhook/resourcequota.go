@@ -17,14 +17,17 @@ limitations under the License.
 package webhook
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
@@ -75,6 +78,7 @@ func getResourceList(app *v1beta2.SparkApplication) (corev1.ResourceList, error)
 		coresLimits,
 		memoryRequests,
 		memoryLimits,
+		getOtherResources(app),
 	})
 
 	return resourceList, nil
@@ -154,18 +158,9 @@ func getSparkPodCoresLimits(podSpec *v1beta2.SparkPodSpec, replicas int64) (core
 }
 
 func getMemoryRequests(app *v1beta2.SparkApplication) (corev1.ResourceList, error) {
-	// If memory overhead factor is set, use it. Otherwise, use the default value.
-	var memoryOverheadFactor float64
-	if app.Spec.MemoryOverheadFactor != nil {
-		parsed, err := strconv.ParseFloat(*app.Spec.MemoryOverheadFactor, 64)
-		if err != nil {
-			return nil, err
-		}
-		memoryOverheadFactor = parsed
-	} else if app.Spec.Type == v1beta2.SparkApplicationTypeJava {
-		memoryOverheadFactor = common.DefaultJVMMemoryOverheadFactor
-	} else {
-		memoryOverheadFactor = common.DefaultNonJVMMemoryOverheadFactor
+	memoryOverheadFactor, err := getMemoryOverheadFactor(app)
+	if err != nil {
+		return nil, err
 	}
 
 	// Calculate driver pod memory requests.
@@ -187,6 +182,18 @@ func getMemoryRequests(app *v1beta2.SparkApplication) (corev1.ResourceList, erro
 	return util.SumResourceList([]corev1.ResourceList{driverResourceList, executorResourceList}), nil
 }
 
+// getMemoryOverheadFactor returns the memory overhead factor of the given SparkApplication if set,
+// or the default one for its type otherwise.
+func getMemoryOverheadFactor(app *v1beta2.SparkApplication) (float64, error) {
+	if app.Spec.MemoryOverheadFactor != nil {
+		return strconv.ParseFloat(*app.Spec.MemoryOverheadFactor, 64)
+	}
+	if app.Spec.Type == v1beta2.SparkApplicationTypeJava {
+		return common.DefaultJVMMemoryOverheadFactor, nil
+	}
+	return common.DefaultNonJVMMemoryOverheadFactor, nil
+}
+
 func getSparkPodMemoryRequests(podSpec *v1beta2.SparkPodSpec, memoryOverheadFactor float64, replicas int64) (corev1.ResourceList, error) {
 	var memoryBytes, memoryOverheadBytes int64
 	if podSpec.Memory != nil {
@@ -219,6 +226,54 @@ func getMemoryLimits(app *v1beta2.SparkApplication) (corev1.ResourceList, error)
 	return getMemoryRequests(app)
 }
 
+// getOtherResources returns the resources other than cpu and memory requested through the structured resources
+// of the driver and executors, keyed by their resource quota names.
+func getOtherResources(app *v1beta2.SparkApplication) corev1.ResourceList {
+	var replicas int64 = 1
+	if app.Spec.Executor.Instances != nil {
+		replicas = int64(*app.Spec.Executor.Instances)
+	}
+	return util.SumResourceList([]corev1.ResourceList{
+		getSparkPodOtherResources(app.Spec.Driver.Resources, 1),
+		getSparkPodOtherResources(app.Spec.Executor.Resources, replicas),
+	})
+}
+
+func getSparkPodOtherResources(resources *corev1.ResourceRequirements, replicas int64) corev1.ResourceList {
+	resourceList := corev1.ResourceList{}
+	if resources == nil {
+		return resourceList
+	}
+
+	total := func(quantity resource.Quantity) resource.Quantity {
+		return *resource.NewMilliQuantity(quantity.MilliValue()*replicas, quantity.Format)
+	}
+	for name, quantity := range resources.Requests {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		resourceList[corev1.ResourceName("requests."+name)] = total(quantity)
+		// Quotas on extended resources are only expressed on requests.
+		if !strings.Contains(string(name), "/") {
+			resourceList[name] = total(quantity)
+		}
+	}
+	for name, quantity := range resources.Limits {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		if strings.Contains(string(name), "/") {
+			// Extended resources cannot be overcommitted, their requests default to their limits.
+			if _, ok := resources.Requests[name]; !ok {
+				resourceList[corev1.ResourceName("requests."+name)] = total(quantity)
+			}
+			continue
+		}
+		resourceList[corev1.ResourceName("limits."+name)] = total(quantity)
+	}
+	return resourceList
+}
+
 // Logic copied from https://github.com/apache/spark/blob/5264164a67df498b73facae207eda12ee133be7d/common/network-common/src/main/java/org/apache/spark/network/util/JavaUtils.java#L276
 func parseJavaMemoryString(s string) (int64, error) {
 	lower := strings.ToLower(s)
@@ -244,16 +299,76 @@ func parseJavaMemoryString(s string) (int64, error) {
 	return 0, fmt.Errorf("could not parse string '%s' as a Java-style memory value. Examples: 100kb, 1.5mb, 1g", s)
 }
 
-// Check whether the resource list will satisfy the resource quota.
-func validateResourceQuota(resourceList corev1.ResourceList, resourceQuota corev1.ResourceQuota) bool {
+// ValidateResourceQuotas checks that the resources requested by the given SparkApplication fit in the remaining
+// headroom of the ResourceQuotas of its namespace.
+func ValidateResourceQuotas(ctx context.Context, c client.Reader, app *v1beta2.SparkApplication) error {
+	resourceQuota, exceeded, err := FindExceededResourceQuota(ctx, c, app)
+	if err != nil {
+		return err
+	}
+	if resourceQuota != nil {
+		return fmt.Errorf("failed to validate resource quota \"%s/%s\": exceeded %s", resourceQuota.Namespace, resourceQuota.Name, strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// FindExceededResourceQuota returns the first ResourceQuota of the namespace of the given SparkApplication lacking
+// the headroom for the resources it requests, along with the names of the exceeded resources, or nil if none does.
+func FindExceededResourceQuota(ctx context.Context, c client.Reader, app *v1beta2.SparkApplication) (*corev1.ResourceQuota, []string, error) {
+	return findExceededResourceQuota(ctx, c, app, true)
+}
+
+// FindResourceQuotaWithExceededLimits returns the first ResourceQuota of the namespace of the given SparkApplication
+// whose hard limits are lower than the resources it requests, which therefore never fit in it whatever the usage of
+// the quota, along with the names of the exceeded resources, or nil if none is.
+func FindResourceQuotaWithExceededLimits(ctx context.Context, c client.Reader, app *v1beta2.SparkApplication) (*corev1.ResourceQuota, []string, error) {
+	return findExceededResourceQuota(ctx, c, app, false)
+}
+
+// findExceededResourceQuota returns the first ResourceQuota of the namespace of the given SparkApplication the
+// resources it requests do not fit in, on top of the usage of the quota if includeUsed is true.
+func findExceededResourceQuota(ctx context.Context, c client.Reader, app *v1beta2.SparkApplication, includeUsed bool) (*corev1.ResourceQuota, []string, error) {
+	requests, err := getResourceList(app)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to calculate resource quests: %v", err)
+	}
+
+	resourceQuotaList := &corev1.ResourceQuotaList{}
+	if err := c.List(ctx, resourceQuotaList, client.InNamespace(app.Namespace)); err != nil {
+		return nil, nil, fmt.Errorf("failed to list resource quotas: %v", err)
+	}
+
+	for i := range resourceQuotaList.Items {
+		resourceQuota := &resourceQuotaList.Items[i]
+		// Scope selectors not currently supported, ignore any ResourceQuota that does not match everything.
+		// TODO: Add support for scope selectors.
+		if resourceQuota.Spec.ScopeSelector != nil || len(resourceQuota.Spec.Scopes) > 0 {
+			continue
+		}
+
+		if exceeded := getExceededResources(requests, *resourceQuota, includeUsed); len(exceeded) > 0 {
+			return resourceQuota, exceeded, nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// getExceededResources returns the sorted names of the resources of the resource list that do not fit in the
+// resource quota, on top of its usage if includeUsed is true.
+func getExceededResources(resourceList corev1.ResourceList, resourceQuota corev1.ResourceQuota, includeUsed bool) []string {
+	var exceeded []string
 	for key, quantity := range resourceList {
 		if _, ok := resourceQuota.Status.Hard[key]; !ok {
 			continue
 		}
-		quantity.Add(resourceQuota.Status.Used[key])
+		if includeUsed {
+			quantity.Add(resourceQuota.Status.Used[key])
+		}
 		if quantity.Cmp(resourceQuota.Spec.Hard[key]) > 0 {
-			return false
+			exceeded = append(exceeded, string(key))
 		}
 	}
-	return true
+	slices.Sort(exceeded)
+	return exceeded
 }
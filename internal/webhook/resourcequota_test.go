@@ -18,6 +18,13 @@ package webhook
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 )
 
 func assertMemory(memoryString string, expectedBytes int64, t *testing.T) {
@@ -39,3 +46,47 @@ func TestJavaMemoryString(t *testing.T) {
 	assertMemory("10TB", 10*1024*1024*1024*1024, t)
 	assertMemory("10PB", 10*1024*1024*1024*1024*1024, t)
 }
+
+func TestGetOtherResources(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceMemory:           resource.MustParse("4Gi"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("1Gi"),
+						},
+					},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				Instances: ptr.To[int32](3),
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceEphemeralStorage: resource.MustParse("2Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceEphemeralStorage: resource.MustParse("4Gi"),
+							"nvidia.com/gpu":                resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[corev1.ResourceName]string{
+		corev1.ResourceEphemeralStorage:         "7Gi",
+		corev1.ResourceRequestsEphemeralStorage: "7Gi",
+		corev1.ResourceLimitsEphemeralStorage:   "12Gi",
+		"requests.nvidia.com/gpu":               "3",
+	}
+	resourceList := getOtherResources(app)
+	assert.Len(t, resourceList, len(expected))
+	for name, quantity := range expected {
+		actual := resourceList[name]
+		assert.True(t, resource.MustParse(quantity).Equal(actual), "%s: expected %s, got %s", name, quantity, actual.String())
+	}
+}
@@ -27,17 +27,23 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/runparams"
+	"github.com/kubeflow/spark-operator/v2/internal/trigger"
 )
 
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
 // +kubebuilder:webhook:admissionReviewVersions=v1,failurePolicy=fail,groups=sparkoperator.k8s.io,matchPolicy=Exact,mutating=false,name=validate-scheduledsparkapplication.sparkoperator.k8s.io,path=/validate-sparkoperator-k8s-io-v1beta2-scheduledsparkapplication,reinvocationPolicy=Never,resources=scheduledsparkapplications,sideEffects=None,verbs=create;update,versions=v1beta2,webhookVersions=v1
 
-type ScheduledSparkApplicationValidator struct{}
+type ScheduledSparkApplicationValidator struct {
+	volumePolicy *VolumePolicy
+}
 
 // NewScheduledSparkApplicationValidator creates a new ScheduledSparkApplicationValidator instance.
-func NewScheduledSparkApplicationValidator() *ScheduledSparkApplicationValidator {
-	return &ScheduledSparkApplicationValidator{}
+func NewScheduledSparkApplicationValidator(volumePolicy *VolumePolicy) *ScheduledSparkApplicationValidator {
+	return &ScheduledSparkApplicationValidator{
+		volumePolicy: volumePolicy,
+	}
 }
 
 var _ admission.CustomValidator = &ScheduledSparkApplicationValidator{}
@@ -88,9 +94,19 @@ func (v *ScheduledSparkApplicationValidator) ValidateDelete(ctx context.Context,
 	return nil, nil
 }
 
-func (v *ScheduledSparkApplicationValidator) validate(_ *v1beta2.ScheduledSparkApplication) error {
-	// TODO: implement validate logic
-	return nil
+func (v *ScheduledSparkApplicationValidator) validate(app *v1beta2.ScheduledSparkApplication) error {
+	if app.Spec.Schedule == "" && len(app.Spec.Triggers) == 0 {
+		return fmt.Errorf("either a schedule or triggers must be specified")
+	}
+	if err := trigger.Validate(app.Spec.Triggers, nil); err != nil {
+		return err
+	}
+	if err := runparams.Validate(&app.Spec.Template); err != nil {
+		return err
+	}
+
+	// Reject templates early rather than failing every scheduled run.
+	return v.volumePolicy.validateSpec(app.Namespace, &app.Spec.Template)
 }
 
 // validateName ensures the ScheduledSparkApplication metadata.name, when combined with suffixes,
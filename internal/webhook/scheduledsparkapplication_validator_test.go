@@ -27,7 +27,7 @@ import (
 )
 
 func TestScheduledSparkApplicationValidatorValidateCreate(t *testing.T) {
-	validator := NewScheduledSparkApplicationValidator()
+	validator := NewScheduledSparkApplicationValidator(nil)
 
 	t.Run("returns nil for unrelated object types", func(t *testing.T) {
 		warnings, err := validator.ValidateCreate(context.Background(), &v1beta2.SparkApplication{})
@@ -45,6 +45,7 @@ func TestScheduledSparkApplicationValidatorValidateCreate(t *testing.T) {
 				Name:      "test-app",
 				Namespace: "default",
 			},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{Schedule: "@every 10m"},
 		}
 		warnings, err := validator.ValidateCreate(context.Background(), app)
 		if err != nil {
@@ -56,8 +57,53 @@ func TestScheduledSparkApplicationValidatorValidateCreate(t *testing.T) {
 	})
 }
 
+func TestScheduledSparkApplicationValidatorValidateTriggers(t *testing.T) {
+	validator := NewScheduledSparkApplicationValidator(nil)
+	kafka := &v1beta2.KafkaTrigger{RESTProxyURL: "http://kafka-rest:8082", ClusterID: "cluster", ConsumerGroup: "ingest"}
+
+	tests := []struct {
+		name      string
+		spec      v1beta2.ScheduledSparkApplicationSpec
+		wantError string
+	}{
+		{
+			name: "triggers without schedule",
+			spec: v1beta2.ScheduledSparkApplicationSpec{Triggers: []v1beta2.ScheduleTrigger{{Name: "lag", Kafka: kafka}}},
+		},
+		{
+			name:      "neither schedule nor triggers",
+			spec:      v1beta2.ScheduledSparkApplicationSpec{},
+			wantError: "either a schedule or triggers must be specified",
+		},
+		{
+			name:      "trigger without event source",
+			spec:      v1beta2.ScheduledSparkApplicationSpec{Schedule: "@every 10m", Triggers: []v1beta2.ScheduleTrigger{{Name: "lag"}}},
+			wantError: "trigger lag must specify exactly one of kafka, sqs and prometheus",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &v1beta2.ScheduledSparkApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tt.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantError {
+				t.Fatalf("expected error %q, got %v", tt.wantError, err)
+			}
+		})
+	}
+}
+
 func TestScheduledSparkApplicationValidatorValidateUpdate(t *testing.T) {
-	validator := NewScheduledSparkApplicationValidator()
+	validator := NewScheduledSparkApplicationValidator(nil)
 
 	t.Run("returns nil for unrelated object types", func(t *testing.T) {
 		warnings, err := validator.ValidateUpdate(
@@ -79,12 +125,14 @@ func TestScheduledSparkApplicationValidatorValidateUpdate(t *testing.T) {
 				Name:      "test-app",
 				Namespace: "default",
 			},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{Schedule: "@every 10m"},
 		}
 		newApp := &v1beta2.ScheduledSparkApplication{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "test-app",
 				Namespace: "default",
 			},
+			Spec: v1beta2.ScheduledSparkApplicationSpec{Schedule: "@every 10m"},
 		}
 		warnings, err := validator.ValidateUpdate(context.Background(), oldApp, newApp)
 		if err != nil {
@@ -97,7 +145,7 @@ func TestScheduledSparkApplicationValidatorValidateUpdate(t *testing.T) {
 }
 
 func TestScheduledSparkApplicationValidatorValidateDelete(t *testing.T) {
-	validator := NewScheduledSparkApplicationValidator()
+	validator := NewScheduledSparkApplicationValidator(nil)
 
 	t.Run("returns nil for unrelated object types", func(t *testing.T) {
 		warnings, err := validator.ValidateDelete(context.Background(), &v1beta2.SparkApplication{})
@@ -121,7 +169,7 @@ func TestScheduledSparkApplicationValidatorValidateDelete(t *testing.T) {
 }
 
 func TestScheduledSparkApplicationValidatorValidateName(t *testing.T) {
-	validator := NewScheduledSparkApplicationValidator()
+	validator := NewScheduledSparkApplicationValidator(nil)
 
 	tests := []struct {
 		name      string
@@ -159,6 +207,7 @@ func TestScheduledSparkApplicationValidatorValidateName(t *testing.T) {
 					Name:      tt.appName,
 					Namespace: "default",
 				},
+				Spec: v1beta2.ScheduledSparkApplicationSpec{Schedule: "@every 10m"},
 			}
 
 			_, err := validator.ValidateCreate(context.Background(), app)
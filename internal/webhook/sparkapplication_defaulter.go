@@ -18,8 +18,12 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -31,11 +35,21 @@ import (
 // +kubebuilder:webhook:admissionReviewVersions=v1,failurePolicy=fail,groups=sparkoperator.k8s.io,matchPolicy=Exact,mutating=true,name=mutate-sparkapplication.sparkoperator.k8s.io,path=/mutate-sparkoperator-k8s-io-v1beta2-sparkapplication,reinvocationPolicy=Never,resources=sparkapplications,sideEffects=None,verbs=create;update,versions=v1beta2,webhookVersions=v1
 
 // SparkApplicationDefaulter sets default values for a SparkApplication.
-type SparkApplicationDefaulter struct{}
+type SparkApplicationDefaulter struct {
+	client client.Client
+
+	limitRangeValidation LimitRangeValidationMode
+	enableMemoryTuning   bool
+}
 
 // NewSparkApplicationValidator creates a new SparkApplicationValidator instance.
-func NewSparkApplicationDefaulter() *SparkApplicationDefaulter {
-	return &SparkApplicationDefaulter{}
+func NewSparkApplicationDefaulter(client client.Client, limitRangeValidation LimitRangeValidationMode, enableMemoryTuning bool) *SparkApplicationDefaulter {
+	return &SparkApplicationDefaulter{
+		client: client,
+
+		limitRangeValidation: limitRangeValidation,
+		enableMemoryTuning:   enableMemoryTuning,
+	}
 }
 
 // SparkApplicationDefaulter implements admission.CustomDefaulter.
@@ -48,6 +62,14 @@ func (d *SparkApplicationDefaulter) Default(ctx context.Context, obj runtime.Obj
 		return nil
 	}
 
+	// Cancellations are requested while the application is active, so their requester is recorded in any state. It
+	// is taken from the request rather than the object, so that it cannot be set to another user.
+	if terminate := app.Spec.Terminate; terminate != nil {
+		if req, err := admission.RequestFromContext(ctx); err == nil {
+			terminate.RequestedBy = getCancellationRequester(req, terminate)
+		}
+	}
+
 	// Only set the default values for spark applications with new state or invalidating state.
 	state := util.GetApplicationState(app)
 	if state != v1beta2.ApplicationStateNew && state != v1beta2.ApplicationStateInvalidating {
@@ -56,6 +78,42 @@ func (d *SparkApplicationDefaulter) Default(ctx context.Context, obj runtime.Obj
 
 	logger := log.FromContext(ctx)
 	logger.Info("Mutating SparkApplication", "state", util.GetApplicationState(app))
+	if err := applySparkApplicationTemplate(ctx, d.client, app); err != nil {
+		return err
+	}
+	if d.enableMemoryTuning {
+		if err := tuneMemory(app); err != nil {
+			return err
+		}
+	}
 	operatorscheme.WebhookScheme.Default(app)
+
+	if d.limitRangeValidation == LimitRangeValidationClamp {
+		limitRanges, err := listLimitRanges(ctx, d.client, app.Namespace)
+		if err != nil {
+			return err
+		}
+		clamped, err := clampToLimitRanges(app, limitRanges)
+		if err != nil {
+			return err
+		}
+		if len(clamped) > 0 {
+			logger.Info("Clamped SparkApplication resources to the LimitRanges of its namespace", "fields", clamped)
+		}
+	}
 	return nil
 }
+
+// getCancellationRequester returns the user who requested the given cancellation, which is the user of the request
+// unless the request is an update leaving an existing cancellation unchanged.
+func getCancellationRequester(req admission.Request, terminate *v1beta2.TerminateSpec) string {
+	if req.Operation == admissionv1.Update {
+		old := &v1beta2.SparkApplication{}
+		if err := json.Unmarshal(req.OldObject.Raw, old); err == nil && old.Spec.Terminate != nil &&
+			old.Spec.Terminate.Reason == terminate.Reason &&
+			ptr.Equal(old.Spec.Terminate.GracePeriodSeconds, terminate.GracePeriodSeconds) {
+			return old.Spec.Terminate.RequestedBy
+		}
+	}
+	return req.UserInfo.Username
+}
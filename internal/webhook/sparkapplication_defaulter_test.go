@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+func TestSparkApplicationDefaulterDefault_CancellationRequester(t *testing.T) {
+	defaulter := NewSparkApplicationDefaulter(nil, LimitRangeValidationDisabled, false)
+
+	newApp := func(terminate *v1beta2.TerminateSpec) *v1beta2.SparkApplication {
+		app := newSparkApplication()
+		app.Status.AppState.State = v1beta2.ApplicationStateRunning
+		app.Spec.Terminate = terminate
+		return app
+	}
+	requestedBy := func(operation admissionv1.Operation, old, app *v1beta2.SparkApplication) string {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			UserInfo:  authenticationv1.UserInfo{Username: "bob"},
+		}}
+		if old != nil {
+			raw, err := json.Marshal(old)
+			if err != nil {
+				t.Fatalf("failed to marshal old application: %v", err)
+			}
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		if err := defaulter.Default(admission.NewContextWithRequest(context.Background(), req), app); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return app.Spec.Terminate.RequestedBy
+	}
+
+	// The requester set by the user is replaced with the user of the request.
+	app := newApp(&v1beta2.TerminateSpec{Reason: "wrong input", RequestedBy: "alice"})
+	if got := requestedBy(admissionv1.Update, newApp(nil), app); got != "bob" {
+		t.Errorf("expected requester bob, got %q", got)
+	}
+
+	// Updates leaving the cancellation unchanged keep its requester.
+	old := newApp(&v1beta2.TerminateSpec{Reason: "wrong input", RequestedBy: "alice"})
+	app = newApp(&v1beta2.TerminateSpec{Reason: "wrong input", RequestedBy: "bob"})
+	if got := requestedBy(admissionv1.Update, old, app); got != "alice" {
+		t.Errorf("expected requester alice, got %q", got)
+	}
+
+	// Updates changing the cancellation record the user of the request.
+	app = newApp(&v1beta2.TerminateSpec{Reason: "other input", RequestedBy: "alice"})
+	if got := requestedBy(admissionv1.Update, old, app); got != "bob" {
+		t.Errorf("expected requester bob, got %q", got)
+	}
+
+	app = newApp(&v1beta2.TerminateSpec{RequestedBy: "alice"})
+	if got := requestedBy(admissionv1.Create, nil, app); got != "bob" {
+		t.Errorf("expected requester bob, got %q", got)
+	}
+}
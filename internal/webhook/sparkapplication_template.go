@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// applySparkApplicationTemplate merges the SparkApplicationTemplate referenced by the given SparkApplication
+// under its spec.
+func applySparkApplicationTemplate(ctx context.Context, reader client.Reader, app *v1beta2.SparkApplication) error {
+	if app.Spec.TemplateRef == nil || *app.Spec.TemplateRef == "" {
+		return nil
+	}
+
+	name := *app.Spec.TemplateRef
+	template := &v1alpha1.SparkApplicationTemplate{}
+	if err := reader.Get(ctx, types.NamespacedName{Name: name}, template); err != nil {
+		return fmt.Errorf("failed to get SparkApplicationTemplate %s: %v", name, err)
+	}
+
+	spec, err := mergeSparkApplicationTemplate(template.Spec.Template.Raw, &app.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to apply SparkApplicationTemplate %s: %v", name, err)
+	}
+	app.Spec = *spec
+	return nil
+}
+
+// mergeSparkApplicationTemplate returns the given spec merged over the given template. Unset and empty fields of
+// the spec are left to the template, objects are merged recursively, and any other value of the spec replaces
+// that of the template.
+func mergeSparkApplicationTemplate(template []byte, spec *v1beta2.SparkApplicationSpec) (*v1beta2.SparkApplicationSpec, error) {
+	base := map[string]any{}
+	if len(template) > 0 {
+		if err := unmarshalJSONObject(template, &base); err != nil {
+			return nil, fmt.Errorf("invalid template: %v", err)
+		}
+	}
+	// Templates cannot reference other templates.
+	delete(base, "templateRef")
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	overlay := map[string]any{}
+	if err := unmarshalJSONObject(data, &overlay); err != nil {
+		return nil, err
+	}
+	pruneEmptyValues(overlay)
+
+	merged, err := json.Marshal(mergeJSONObjects(base, overlay))
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	result := &v1beta2.SparkApplicationSpec{}
+	if err := decoder.Decode(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// unmarshalJSONObject unmarshals a JSON object, keeping numbers as json.Number so that they are not rounded.
+func unmarshalJSONObject(data []byte, object *map[string]any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(object)
+}
+
+// pruneEmptyValues removes the null values, empty strings and empty objects from the given object.
+func pruneEmptyValues(object map[string]any) {
+	for key, value := range object {
+		switch value := value.(type) {
+		case nil:
+			delete(object, key)
+		case string:
+			if value == "" {
+				delete(object, key)
+			}
+		case map[string]any:
+			pruneEmptyValues(value)
+			if len(value) == 0 {
+				delete(object, key)
+			}
+		}
+	}
+}
+
+// mergeJSONObjects merges the overlay object into the base object, recursing into the objects present in both.
+func mergeJSONObjects(base map[string]any, overlay map[string]any) map[string]any {
+	for key, value := range overlay {
+		baseObject, baseIsObject := base[key].(map[string]any)
+		overlayObject, overlayIsObject := value.(map[string]any)
+		if baseIsObject && overlayIsObject {
+			base[key] = mergeJSONObjects(baseObject, overlayObject)
+		} else {
+			base[key] = value
+		}
+	}
+	return base
+}
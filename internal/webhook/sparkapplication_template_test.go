@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+const testSparkApplicationTemplate = `{
+  "type": "Scala",
+  "sparkVersion": "4.0.1",
+  "image": "registry.example.com/spark:4.0.1-hardened",
+  "sparkConf": {"spark.eventLog.enabled": "true", "spark.ui.enabled": "false"},
+  "driver": {
+    "serviceAccount": "spark",
+    "securityContext": {"runAsNonRoot": true, "runAsUser": 185},
+    "tolerations": [{"key": "spark", "operator": "Exists"}]
+  },
+  "executor": {
+    "instances": 2,
+    "tolerations": [{"key": "spark", "operator": "Exists"}]
+  }
+}`
+
+func newTestTemplateDefaulter(t *testing.T, template string) *SparkApplicationDefaulter {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1alpha1 to scheme: %v", err)
+	}
+	if err := v1beta2.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add v1beta2 to scheme: %v", err)
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.SparkApplicationTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "hardened"},
+		Spec:       v1alpha1.SparkApplicationTemplateSpec{Template: runtime.RawExtension{Raw: []byte(template)}},
+	}).Build()
+	return NewSparkApplicationDefaulter(client, LimitRangeValidationDisabled, false)
+}
+
+func TestSparkApplicationDefaulterDefault_Template(t *testing.T) {
+	defaulter := newTestTemplateDefaulter(t, testSparkApplicationTemplate)
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+		Spec: v1beta2.SparkApplicationSpec{
+			TemplateRef:         ptr.To("hardened"),
+			MainClass:           ptr.To("org.apache.spark.examples.SparkPi"),
+			MainApplicationFile: ptr.To("local:///opt/spark/examples/jars/spark-examples.jar"),
+			SparkConf:           map[string]string{"spark.ui.enabled": "true"},
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{Memory: ptr.To("1g")},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				Instances: ptr.To[int32](5),
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Tolerations: []corev1.Toleration{{Key: "gpu", Operator: corev1.TolerationOpExists}},
+				},
+			},
+		},
+	}
+	if err := defaulter.Default(context.Background(), app); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	spec := app.Spec
+	if spec.Type != v1beta2.SparkApplicationTypeScala || spec.SparkVersion != "4.0.1" {
+		t.Errorf("expected type and Spark version from the template, got %q and %q", spec.Type, spec.SparkVersion)
+	}
+	if ptr.Deref(spec.Image, "") != "registry.example.com/spark:4.0.1-hardened" {
+		t.Errorf("expected image from the template, got %v", spec.Image)
+	}
+	if ptr.Deref(spec.MainClass, "") != "org.apache.spark.examples.SparkPi" {
+		t.Errorf("expected main class from the application, got %v", spec.MainClass)
+	}
+	if want := map[string]string{"spark.eventLog.enabled": "true", "spark.ui.enabled": "true"}; !reflect.DeepEqual(spec.SparkConf, want) {
+		t.Errorf("expected merged Spark configuration %v, got %v", want, spec.SparkConf)
+	}
+	if ptr.Deref(spec.Driver.ServiceAccount, "") != "spark" || ptr.Deref(spec.Driver.Memory, "") != "1g" {
+		t.Errorf("expected driver service account from the template and memory from the application, got %v and %v", spec.Driver.ServiceAccount, spec.Driver.Memory)
+	}
+	if spec.Driver.SecurityContext == nil || !ptr.Deref(spec.Driver.SecurityContext.RunAsNonRoot, false) {
+		t.Errorf("expected driver security context from the template, got %v", spec.Driver.SecurityContext)
+	}
+	if len(spec.Driver.Tolerations) != 1 || spec.Driver.Tolerations[0].Key != "spark" {
+		t.Errorf("expected driver tolerations from the template, got %v", spec.Driver.Tolerations)
+	}
+	if ptr.Deref(spec.Executor.Instances, 0) != 5 {
+		t.Errorf("expected executor instances from the application, got %v", spec.Executor.Instances)
+	}
+	if len(spec.Executor.Tolerations) != 1 || spec.Executor.Tolerations[0].Key != "gpu" {
+		t.Errorf("expected executor tolerations replaced by the application, got %v", spec.Executor.Tolerations)
+	}
+	if ptr.Deref(spec.TemplateRef, "") != "hardened" {
+		t.Errorf("expected template reference to be kept, got %v", spec.TemplateRef)
+	}
+}
+
+func TestSparkApplicationDefaulterDefault_TemplateErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		templateRef string
+		wantErr     string
+	}{
+		{
+			name:        "missing template",
+			template:    testSparkApplicationTemplate,
+			templateRef: "unknown",
+			wantErr:     "failed to get SparkApplicationTemplate unknown",
+		},
+		{
+			name:        "unknown field in template",
+			template:    `{"sparkVersion": "4.0.1", "driver": {"serviceAcount": "spark"}}`,
+			templateRef: "hardened",
+			wantErr:     `failed to apply SparkApplicationTemplate hardened: json: unknown field "serviceAcount"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defaulter := newTestTemplateDefaulter(t, tt.template)
+			app := &v1beta2.SparkApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "default"},
+				Spec:       v1beta2.SparkApplicationSpec{TemplateRef: ptr.To(tt.templateRef)},
+			}
+			err := defaulter.Default(context.Background(), app)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error starting with %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
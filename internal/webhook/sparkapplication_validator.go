@@ -19,17 +19,27 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"net/mail"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
 	"github.com/kubeflow/spark-operator/v2/pkg/util"
 )
 
@@ -41,14 +51,41 @@ type SparkApplicationValidator struct {
 	client client.Client
 
 	enableResourceQuotaEnforcement bool
+	envSecretRefValidation         EnvSecretRefValidationMode
+	volumePolicy                   *VolumePolicy
+	limitRangeValidation           LimitRangeValidationMode
+	enableMemoryTuning             bool
 }
 
+// EnvSecretRefValidationMode determines how envSecretRefs pointing to missing Secret keys are handled at admission.
+type EnvSecretRefValidationMode string
+
+const (
+	// EnvSecretRefValidationEnforce rejects SparkApplications referencing missing Secret keys.
+	EnvSecretRefValidationEnforce EnvSecretRefValidationMode = "enforce"
+	// EnvSecretRefValidationWarn admits SparkApplications referencing missing Secret keys with a warning.
+	EnvSecretRefValidationWarn EnvSecretRefValidationMode = "warn"
+	// EnvSecretRefValidationDisabled skips looking up the referenced Secrets.
+	EnvSecretRefValidationDisabled EnvSecretRefValidationMode = "disabled"
+)
+
 // NewSparkApplicationValidator creates a new SparkApplicationValidator instance.
-func NewSparkApplicationValidator(client client.Client, enableResourceQuotaEnforcement bool) *SparkApplicationValidator {
+func NewSparkApplicationValidator(
+	client client.Client,
+	enableResourceQuotaEnforcement bool,
+	envSecretRefValidation EnvSecretRefValidationMode,
+	volumePolicy *VolumePolicy,
+	limitRangeValidation LimitRangeValidationMode,
+	enableMemoryTuning bool,
+) *SparkApplicationValidator {
 	return &SparkApplicationValidator{
 		client: client,
 
 		enableResourceQuotaEnforcement: enableResourceQuotaEnforcement,
+		envSecretRefValidation:         envSecretRefValidation,
+		volumePolicy:                   volumePolicy,
+		limitRangeValidation:           limitRangeValidation,
+		enableMemoryTuning:             enableMemoryTuning,
 	}
 }
 
@@ -77,7 +114,16 @@ func (v *SparkApplicationValidator) ValidateCreate(ctx context.Context, obj runt
 		}
 	}
 
-	return nil, nil
+	if err := v.validateLimitRangeUsage(ctx, app); err != nil {
+		return nil, err
+	}
+
+	warnings, err = v.validateEnvSecretRefKeys(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(warnings, v.sparkVersionWarnings(app)...), nil
 }
 
 // ValidateUpdate implements admission.CustomValidator.
@@ -105,6 +151,10 @@ func (v *SparkApplicationValidator) ValidateUpdate(ctx context.Context, oldObj r
 		return nil, nil
 	}
 
+	if err := validateImmutableFields(oldApp, newApp); err != nil {
+		return nil, err
+	}
+
 	if err := v.validateSpec(ctx, newApp); err != nil {
 		return nil, err
 	}
@@ -116,7 +166,16 @@ func (v *SparkApplicationValidator) ValidateUpdate(ctx context.Context, oldObj r
 		}
 	}
 
-	return nil, nil
+	if err := v.validateLimitRangeUsage(ctx, newApp); err != nil {
+		return nil, err
+	}
+
+	warnings, err = v.validateEnvSecretRefKeys(ctx, newApp)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(warnings, v.sparkVersionWarnings(newApp)...), nil
 }
 
 // ValidateDelete implements admission.CustomValidator.
@@ -130,6 +189,41 @@ func (v *SparkApplicationValidator) ValidateDelete(ctx context.Context, obj runt
 	return nil, nil
 }
 
+// validateImmutableFields rejects updates of the fields a running SparkApplication cannot change without
+// corrupting the state of its driver and executors.
+func validateImmutableFields(oldApp, newApp *v1beta2.SparkApplication) error {
+	switch util.GetApplicationState(oldApp) {
+	case v1beta2.ApplicationStateSubmitted, v1beta2.ApplicationStateRunning, v1beta2.ApplicationStateUnknown:
+	default:
+		return nil
+	}
+
+	oldSpec, newSpec := &oldApp.Spec, &newApp.Spec
+	var paths []string
+	if oldSpec.Type != newSpec.Type {
+		paths = append(paths, "spec.type")
+	}
+	if oldSpec.Mode != newSpec.Mode {
+		paths = append(paths, "spec.mode")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.MainApplicationFile, newSpec.MainApplicationFile) {
+		paths = append(paths, "spec.mainApplicationFile")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Image, newSpec.Image) {
+		paths = append(paths, "spec.image")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Driver.Image, newSpec.Driver.Image) {
+		paths = append(paths, "spec.driver.image")
+	}
+	if !equality.Semantic.DeepEqual(oldSpec.Executor.Image, newSpec.Executor.Image) {
+		paths = append(paths, "spec.executor.image")
+	}
+	if len(paths) > 0 {
+		return fmt.Errorf("cannot update immutable fields of %s SparkApplication: %s", util.GetApplicationState(oldApp), strings.Join(paths, ", "))
+	}
+	return nil
+}
+
 func (v *SparkApplicationValidator) validateSpec(ctx context.Context, app *v1beta2.SparkApplication) error {
 	if err := v.validateSparkVersion(app); err != nil {
 		return err
@@ -159,6 +253,717 @@ func (v *SparkApplicationValidator) validateSpec(ctx context.Context, app *v1bet
 		ingressURLFormats[item.IngressURLFormat] = true
 	}
 
+	if service := app.Spec.Driver.Service; service != nil {
+		ports := make(map[int32]bool)
+		for _, port := range service.Ports {
+			if ports[port.Port] {
+				return fmt.Errorf("driver service has duplicate port: %d", port.Port)
+			}
+			ports[port.Port] = true
+			if port.NodePort != nil && service.Type != v1beta2.DriverServiceTypeNodePort && service.Type != v1beta2.DriverServiceTypeLoadBalancer {
+				return fmt.Errorf("driver service port %s cannot set nodePort with service type %q", port.Name, service.Type)
+			}
+		}
+	}
+
+	if err := v.volumePolicy.validateSpec(app.Namespace, &app.Spec); err != nil {
+		return err
+	}
+
+	if window := app.Spec.ExecutionWindow; window != nil {
+		for _, s := range window.Windows {
+			if _, err := util.ParseTimeWindow(s); err != nil {
+				return fmt.Errorf("invalid execution window %q: %v", s, err)
+			}
+		}
+	}
+
+	if pdb := app.Spec.Executor.PodDisruptionBudget; pdb != nil {
+		if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+			return fmt.Errorf("executor podDisruptionBudget cannot specify both minAvailable and maxUnavailable")
+		}
+	}
+
+	if err := validateInteractive(app); err != nil {
+		return err
+	}
+
+	if app.Spec.Mode == v1beta2.DeployModeClient && app.Spec.Executor.Template != nil {
+		return fmt.Errorf("executor template is not supported in client mode")
+	}
+
+	if scaling := app.Spec.Executor.InPlaceScaling; scaling != nil {
+		if scaling.PluginClass == "" {
+			return fmt.Errorf("executor inPlaceScaling requires the pluginClass of the driver plugin serving the scaling endpoint")
+		}
+		if app.Spec.DynamicAllocation != nil && app.Spec.DynamicAllocation.Enabled {
+			return fmt.Errorf("executor inPlaceScaling cannot be used with dynamic allocation")
+		}
+		maxInstances := util.GetExecutorInPlaceScalingMaxInstances(app)
+		if maxInstances < 1 {
+			return fmt.Errorf("executor inPlaceScaling maxInstances must be positive")
+		}
+		if instances := ptr.Deref(app.Spec.Executor.Instances, 0); instances > maxInstances {
+			return fmt.Errorf("executor instances %d exceed the inPlaceScaling maxInstances %d", instances, maxInstances)
+		}
+	}
+
+	if err := v.validateStreamingCheckpointLocation(app); err != nil {
+		return err
+	}
+
+	if ui := app.Spec.Driver.UI; ui != nil && ui.Enabled != nil {
+		if conf, ok := app.Spec.SparkConf[common.SparkUIEnabled]; ok && conf != strconv.FormatBool(*ui.Enabled) {
+			return fmt.Errorf("driver ui.enabled %t conflicts with spark conf %s=%q", *ui.Enabled, common.SparkUIEnabled, conf)
+		}
+	}
+
+	if err := validateEnvSecretRefs("driver", app.Spec.Driver.SparkPodSpec); err != nil {
+		return err
+	}
+	if err := validateEnvSecretRefs("executor", app.Spec.Executor.SparkPodSpec); err != nil {
+		return err
+	}
+
+	if err := validateVaultSecrets("driver", app.Spec.Driver.SparkPodSpec); err != nil {
+		return err
+	}
+	if err := validateVaultSecrets("executor", app.Spec.Executor.SparkPodSpec); err != nil {
+		return err
+	}
+
+	if err := validatePodResources("driver", app.Spec.Driver.SparkPodSpec, app.Spec.Driver.CoreRequest); err != nil {
+		return err
+	}
+	if err := validatePodResources("executor", app.Spec.Executor.SparkPodSpec, app.Spec.Executor.CoreRequest); err != nil {
+		return err
+	}
+
+	if v.enableMemoryTuning {
+		if err := validateMemoryLimits(app); err != nil {
+			return err
+		}
+	}
+
+	if maxMemory := app.Spec.RestartPolicy.OnOOMMaxMemory; maxMemory != nil {
+		if _, err := resource.ParseQuantity(util.ConvertJavaMemoryStringToK8sMemoryString(*maxMemory)); err != nil {
+			return fmt.Errorf("invalid restartPolicy.onOOMMaxMemory %q: %v", *maxMemory, err)
+		}
+	}
+
+	if err := validateHooks(&app.Spec); err != nil {
+		return err
+	}
+
+	if err := validateNotifications(app.Spec.Notifications); err != nil {
+		return err
+	}
+
+	if err := validateDependsOn(app); err != nil {
+		return err
+	}
+
+	if err := validateDriverDiagnostics(app.Spec.Driver.Diagnostics); err != nil {
+		return err
+	}
+
+	if err := validatePrometheusServlet(app); err != nil {
+		return err
+	}
+
+	if err := validateCloudStorage(app.Spec.CloudStorage); err != nil {
+		return err
+	}
+
+	if err := validateIAMRoles(app); err != nil {
+		return err
+	}
+
+	if err := validateGCP(app); err != nil {
+		return err
+	}
+
+	if err := validateAzure(app); err != nil {
+		return err
+	}
+
+	if err := validateHadoopSecurity(app); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var (
+	iamRoleARNRegex           = regexp.MustCompile(`^arn:aws[a-z-]*:iam::[0-9]{12}:role/.+$`)
+	azureStorageAccountRegex  = regexp.MustCompile(`^[a-z0-9]{3,24}$`)
+	googleServiceAccountRegex = regexp.MustCompile(`^[a-z0-9-]+@([a-z0-9-]+\.iam|developer)\.gserviceaccount\.com$`)
+	azureIDRegex              = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	azureKeyVaultRegex        = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`)
+	kerberosPrincipalRegex    = regexp.MustCompile(`^[^/@\s]+(/[^/@\s]+)?@[^/@\s]+$`)
+)
+
+// validateCloudStorage ensures each cloud storage connector is given a single kind of credentials, and that the
+// IAM role and the storage account are well-formed.
+func validateCloudStorage(storage *v1beta2.CloudStorageSpec) error {
+	if storage == nil {
+		return nil
+	}
+	if s3 := storage.S3; s3 != nil {
+		if s3.CredentialsSecret != nil && s3.RoleARN != nil {
+			return fmt.Errorf("cloudStorage s3 credentialsSecret and roleArn cannot both be set")
+		}
+		if s3.RoleARN != nil && !iamRoleARNRegex.MatchString(*s3.RoleARN) {
+			return fmt.Errorf("invalid cloudStorage s3 roleArn %q", *s3.RoleARN)
+		}
+	}
+	if azure := storage.Azure; azure != nil {
+		if !azureStorageAccountRegex.MatchString(azure.StorageAccount) {
+			return fmt.Errorf("invalid cloudStorage azure storageAccount %q", azure.StorageAccount)
+		}
+		if azure.AccountKeySecret != nil && azure.ManagedIdentity != nil {
+			return fmt.Errorf("cloudStorage azure accountKeySecret and managedIdentity cannot both be set")
+		}
+	}
+	return nil
+}
+
+// validateGCP ensures the Google service account of the GKE Workload Identity is well-formed and not combined with a
+// service account key.
+func validateGCP(app *v1beta2.SparkApplication) error {
+	if app.Spec.GCP == nil || app.Spec.GCP.WorkloadIdentity == nil {
+		return nil
+	}
+	gsa := app.Spec.GCP.WorkloadIdentity.ServiceAccount
+	if !googleServiceAccountRegex.MatchString(gsa) {
+		return fmt.Errorf("invalid gcp workloadIdentity serviceAccount %q", gsa)
+	}
+	if storage := app.Spec.CloudStorage; storage != nil && storage.GCS != nil && storage.GCS.ServiceAccountKeySecret != nil {
+		return fmt.Errorf("gcp workloadIdentity cannot be used with cloudStorage gcs serviceAccountKeySecret")
+	}
+	return nil
+}
+
+// validateAzure ensures the Microsoft Entra workload identity is well-formed and not combined with a managed
+// identity, and that the objects of the Azure Key Vault are read as the workload identity into distinct files.
+func validateAzure(app *v1beta2.SparkApplication) error {
+	if app.Spec.Azure == nil {
+		return nil
+	}
+	if identity := app.Spec.Azure.WorkloadIdentity; identity != nil {
+		if !azureIDRegex.MatchString(identity.ClientID) {
+			return fmt.Errorf("invalid azure workloadIdentity clientId %q", identity.ClientID)
+		}
+		if !azureIDRegex.MatchString(identity.TenantID) {
+			return fmt.Errorf("invalid azure workloadIdentity tenantId %q", identity.TenantID)
+		}
+		if storage := app.Spec.CloudStorage; storage != nil && storage.Azure != nil && storage.Azure.ManagedIdentity != nil {
+			return fmt.Errorf("azure workloadIdentity cannot be used with cloudStorage azure managedIdentity")
+		}
+	}
+	keyVault := app.Spec.Azure.KeyVault
+	if keyVault == nil {
+		return nil
+	}
+	if app.Spec.Azure.WorkloadIdentity == nil {
+		return fmt.Errorf("azure keyVault requires azure workloadIdentity")
+	}
+	if !azureKeyVaultRegex.MatchString(keyVault.Name) {
+		return fmt.Errorf("invalid azure keyVault name %q", keyVault.Name)
+	}
+	fileNames := make(map[string]bool)
+	for _, object := range keyVault.Objects {
+		fileName := ptr.Deref(object.Alias, object.Name)
+		if fileNames[fileName] {
+			return fmt.Errorf("azure keyVault objects are mounted as the same file %q", fileName)
+		}
+		fileNames[fileName] = true
+	}
+	return nil
+}
+
+// validateHadoopSecurity ensures the Kerberos principal is well-formed, that the application is submitted with
+// spark-submit in the operator, which logs in with the keytab, and that it does not impersonate another user, as
+// Spark does not support logging in with a keytab along with a proxy user.
+func validateHadoopSecurity(app *v1beta2.SparkApplication) error {
+	security := app.Spec.HadoopSecurity
+	if security == nil {
+		return nil
+	}
+	if !kerberosPrincipalRegex.MatchString(security.Principal) {
+		return fmt.Errorf("invalid hadoopSecurity principal %q", security.Principal)
+	}
+	if app.Spec.Mode == v1beta2.DeployModeClient {
+		return fmt.Errorf("hadoopSecurity cannot be used in client mode")
+	}
+	if ptr.Deref(app.Spec.ProxyUser, "") != "" {
+		return fmt.Errorf("hadoopSecurity cannot be used with proxyUser")
+	}
+	for _, fs := range security.AccessHadoopFileSystems {
+		if u, err := url.Parse(fs); err != nil || u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("invalid hadoopSecurity accessHadoopFileSystems %q", fs)
+		}
+	}
+	return nil
+}
+
+// validateIAMRoles ensures the IAM roles of the driver and executors are well-formed, that they are not combined with
+// static S3 credentials, and that they match if both run with the service account provisioned by the operator, which
+// is annotated with a single role.
+func validateIAMRoles(app *v1beta2.SparkApplication) error {
+	autoRoleARN := ""
+	for _, role := range []struct {
+		name string
+		spec *v1beta2.SparkPodSpec
+	}{
+		{name: "driver", spec: &app.Spec.Driver.SparkPodSpec},
+		{name: "executor", spec: &app.Spec.Executor.SparkPodSpec},
+	} {
+		if role.spec.IAMRoleARN != nil && !iamRoleARNRegex.MatchString(*role.spec.IAMRoleARN) {
+			return fmt.Errorf("invalid %s iamRoleArn %q", role.name, *role.spec.IAMRoleARN)
+		}
+		roleARN := util.GetAWSRoleARN(app, role.spec)
+		if roleARN == "" {
+			continue
+		}
+		if storage := app.Spec.CloudStorage; storage != nil && storage.S3 != nil && storage.S3.CredentialsSecret != nil {
+			return fmt.Errorf("%s iamRoleArn cannot be used with cloudStorage s3 credentialsSecret", role.name)
+		}
+		if ptr.Deref(role.spec.ServiceAccount, "") != common.ServiceAccountAuto {
+			continue
+		}
+		if autoRoleARN != "" && roleARN != autoRoleARN {
+			return fmt.Errorf("driver and executor running with the %s service account must use the same iamRoleArn, got %q and %q", common.ServiceAccountAuto, autoRoleARN, roleARN)
+		}
+		autoRoleARN = roleARN
+	}
+	return nil
+}
+
+// validatePrometheusServlet ensures the PrometheusServlet sink is served by the Spark UI, does not conflict with the
+// Prometheus JMX exporter, and that the Spark UI and Prometheus agent ports do not conflict with the other ports of
+// the driver.
+func validatePrometheusServlet(app *v1beta2.SparkApplication) error {
+	if !util.PrometheusServletEnabled(app) {
+		return nil
+	}
+	if app.Spec.Monitoring.Prometheus != nil {
+		return fmt.Errorf("monitoring prometheus and prometheusServlet cannot both be set")
+	}
+	if !util.IsSparkUIEnabled(app, true) {
+		return fmt.Errorf("monitoring prometheusServlet requires the Spark UI to be enabled")
+	}
+	servletPath := util.GetPrometheusServletPath(app)
+	if !strings.HasPrefix(servletPath, "/") || servletPath == common.PrometheusServletExecutorMetricsPath {
+		return fmt.Errorf("invalid monitoring prometheusServlet path %q", servletPath)
+	}
+
+	ports := map[int32]string{}
+	addPort := func(name string, port int32) error {
+		if other, ok := ports[port]; ok {
+			return fmt.Errorf("monitoring prometheusServlet: %s port %d conflicts with %s port", name, port, other)
+		}
+		ports[port] = name
+		return nil
+	}
+	ports[util.GetSparkUIPort(app)] = "Spark UI"
+	for _, port := range []struct {
+		name         string
+		keys         []string
+		defaultValue int32
+	}{
+		{name: "driver", keys: []string{common.SparkDriverPort}, defaultValue: common.DefaultSparkDriverPort},
+		{name: "block manager", keys: []string{common.SparkDriverBlockManagerPort, common.SparkBlockManagerPort}, defaultValue: common.DefaultSparkBlockManagerPort},
+	} {
+		value := port.defaultValue
+		for _, key := range port.keys {
+			if parsed, err := strconv.ParseInt(app.Spec.SparkConf[key], 10, 32); err == nil {
+				value = int32(parsed)
+				break
+			}
+		}
+		if err := addPort(port.name, value); err != nil {
+			return err
+		}
+	}
+	if remoteWrite := app.Spec.Monitoring.PrometheusServlet.RemoteWrite; remoteWrite != nil {
+		if u, err := url.Parse(remoteWrite.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid monitoring prometheusServlet remoteWrite url %q", remoteWrite.URL)
+		}
+		if err := addPort("Prometheus agent", common.PrometheusAgentPort); err != nil {
+			return err
+		}
+	}
+	for _, port := range app.Spec.Driver.Ports {
+		if err := addPort(fmt.Sprintf("driver %s", port.Name), port.ContainerPort); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDriverDiagnostics ensures the diagnostics volume is either a PersistentVolumeClaim or a bounded emptyDir.
+func validateDriverDiagnostics(diagnostics *v1beta2.DriverDiagnostics) error {
+	if diagnostics == nil {
+		return nil
+	}
+	if diagnostics.ClaimName != nil && diagnostics.SizeLimit != nil {
+		return fmt.Errorf("driver diagnostics sizeLimit cannot be set along with claimName")
+	}
+	if diagnostics.ClaimName != nil {
+		if errs := validation.IsDNS1123Subdomain(*diagnostics.ClaimName); len(errs) > 0 {
+			return fmt.Errorf("invalid driver diagnostics claimName %q: %s", *diagnostics.ClaimName, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// validateDependsOn ensures the SparkApplications the given application depends on are valid names other than its own.
+func validateDependsOn(app *v1beta2.SparkApplication) error {
+	for _, name := range app.Spec.DependsOn {
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("invalid dependsOn name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if name == app.Name {
+			return fmt.Errorf("SparkApplication cannot depend on itself")
+		}
+	}
+	return nil
+}
+
+// validateHooks ensures the lifecycle hooks do not conflict with the driver and executor lifecycles and
+// that each operator hook is well-formed.
+func validateHooks(spec *v1beta2.SparkApplicationSpec) error {
+	hooks := spec.Hooks
+	if hooks == nil {
+		return nil
+	}
+	if hooks.Driver != nil && spec.Driver.Lifecycle != nil {
+		return fmt.Errorf("hooks.driver cannot be defined together with driver lifecycle")
+	}
+	if hooks.Executor != nil && spec.Executor.Lifecycle != nil {
+		return fmt.Errorf("hooks.executor cannot be defined together with executor lifecycle")
+	}
+
+	names := make(map[string]bool)
+	for _, hook := range hooks.Operator {
+		if errs := validation.IsDNS1123Label(hook.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid hook name %q: %s", hook.Name, strings.Join(errs, ", "))
+		}
+		if names[hook.Name] {
+			return fmt.Errorf("duplicate hook name %q", hook.Name)
+		}
+		names[hook.Name] = true
+
+		if len(hook.Events) == 0 {
+			return fmt.Errorf("hook %q has no events", hook.Name)
+		}
+		for _, event := range hook.Events {
+			switch event {
+			case v1beta2.HookEventSubmitted, v1beta2.HookEventFailedSubmission, v1beta2.HookEventCompleted, v1beta2.HookEventFailed:
+			default:
+				return fmt.Errorf("hook %q has invalid event %q", hook.Name, event)
+			}
+		}
+
+		if (hook.Job == nil) == (hook.HTTP == nil) {
+			return fmt.Errorf("hook %q must specify exactly one of job and http", hook.Name)
+		}
+		if hook.Job != nil && len(hook.Job.Spec.Template.Spec.Containers) == 0 {
+			return fmt.Errorf("hook %q job has no containers", hook.Name)
+		}
+		if hook.HTTP != nil {
+			u, err := url.Parse(hook.HTTP.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("hook %q has invalid http url %q", hook.Name, hook.HTTP.URL)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNotifications ensures each notification webhook and email is well-formed.
+func validateNotifications(notifications *v1beta2.NotificationSpec) error {
+	if notifications == nil {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	validateName := func(name string) error {
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return fmt.Errorf("invalid notification name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate notification name %q", name)
+		}
+		names[name] = true
+		return nil
+	}
+	validateEvents := func(name string, events []v1beta2.NotificationEvent) error {
+		for _, event := range events {
+			switch event {
+			case v1beta2.NotificationEventSubmitted, v1beta2.NotificationEventRunning,
+				v1beta2.NotificationEventCompleted, v1beta2.NotificationEventFailed:
+			default:
+				return fmt.Errorf("notification %q has invalid event %q", name, event)
+			}
+		}
+		return nil
+	}
+
+	for _, webhook := range notifications.Webhooks {
+		if err := validateName(webhook.Name); err != nil {
+			return err
+		}
+
+		switch webhook.Type {
+		case "", v1beta2.NotificationWebhookTypeGeneric, v1beta2.NotificationWebhookTypeSlack, v1beta2.NotificationWebhookTypeTeams:
+		default:
+			return fmt.Errorf("notification webhook %q has invalid type %q", webhook.Name, webhook.Type)
+		}
+		if (webhook.URL == "") == (webhook.URLSecret == nil) {
+			return fmt.Errorf("notification webhook %q must specify exactly one of url and urlSecret", webhook.Name)
+		}
+		if webhook.URL != "" {
+			u, err := url.Parse(webhook.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("notification webhook %q has invalid url %q", webhook.Name, webhook.URL)
+			}
+		}
+		if webhook.URLSecret != nil && (webhook.URLSecret.Name == "" || webhook.URLSecret.Key == "") {
+			return fmt.Errorf("notification webhook %q urlSecret must specify both name and key", webhook.Name)
+		}
+		if webhook.AuthSecret != nil && (webhook.AuthSecret.Name == "" || webhook.AuthSecret.Key == "") {
+			return fmt.Errorf("notification webhook %q authSecret must specify both name and key", webhook.Name)
+		}
+		if err := validateEvents(webhook.Name, webhook.Events); err != nil {
+			return err
+		}
+	}
+
+	for _, email := range notifications.Emails {
+		if err := validateName(email.Name); err != nil {
+			return err
+		}
+		if len(email.To) == 0 {
+			return fmt.Errorf("notification email %q has no recipients", email.Name)
+		}
+		for _, to := range email.To {
+			if _, err := mail.ParseAddress(to); err != nil {
+				return fmt.Errorf("notification email %q has invalid recipient %q", email.Name, to)
+			}
+		}
+		if err := validateEvents(email.Name, email.Events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePodResources ensures the structured resources of a driver or executor agree with its string-based
+// resource fields, which are defaulted from the former but may also be set explicitly.
+func validatePodResources(role string, spec v1beta2.SparkPodSpec, coreRequest *string) error {
+	resources := spec.Resources
+	if resources == nil {
+		return nil
+	}
+	if len(resources.Claims) > 0 {
+		return fmt.Errorf("%s resources.claims is not supported", role)
+	}
+
+	quantityFields := []struct {
+		list  corev1.ResourceList
+		name  corev1.ResourceName
+		path  string
+		field string
+		value *string
+	}{
+		{resources.Requests, corev1.ResourceCPU, "requests.cpu", "coreRequest", coreRequest},
+		{resources.Limits, corev1.ResourceCPU, "limits.cpu", "coreLimit", spec.CoreLimit},
+		{resources.Limits, corev1.ResourceMemory, "limits.memory", "memoryLimit", spec.MemoryLimit},
+	}
+	for _, f := range quantityFields {
+		quantity, ok := f.list[f.name]
+		if !ok || f.value == nil {
+			continue
+		}
+		s := *f.value
+		if f.name == corev1.ResourceMemory {
+			s = util.ConvertJavaMemoryStringToK8sMemoryString(s)
+		}
+		value, err := resource.ParseQuantity(s)
+		if err != nil {
+			return fmt.Errorf("%s %s %q is invalid: %v", role, f.field, *f.value, err)
+		}
+		if value.Cmp(quantity) != 0 {
+			return fmt.Errorf("%s resources.%s %s conflicts with %s %q", role, f.path, quantity.String(), f.field, *f.value)
+		}
+	}
+
+	if quantity, ok := resources.Requests[corev1.ResourceMemory]; ok && spec.Memory != nil {
+		bytes, err := parseJavaMemoryString(*spec.Memory)
+		if err != nil {
+			return fmt.Errorf("%s memory %q is invalid: %v", role, *spec.Memory, err)
+		}
+		if bytes != quantity.Value() {
+			return fmt.Errorf("%s resources.requests.memory %s conflicts with memory %q", role, quantity.String(), *spec.Memory)
+		}
+	}
+
+	return nil
+}
+
+// validateInteractive ensures that the main application file is set unless the application is interactive, in which
+// case the driver runs a server that is part of Spark on the JVM instead.
+func validateInteractive(app *v1beta2.SparkApplication) error {
+	if app.Spec.Interactive == nil {
+		if app.Spec.MainApplicationFile == nil || *app.Spec.MainApplicationFile == "" {
+			return fmt.Errorf("mainApplicationFile must be set unless the application is interactive")
+		}
+		return nil
+	}
+	if app.Spec.MainApplicationFile != nil || app.Spec.MainClass != nil {
+		return fmt.Errorf("mainApplicationFile and mainClass cannot be set for interactive applications")
+	}
+	if app.Spec.Type != v1beta2.SparkApplicationTypeJava && app.Spec.Type != v1beta2.SparkApplicationTypeScala {
+		return fmt.Errorf("interactive applications must be of type Java or Scala, got %s", app.Spec.Type)
+	}
+	if app.Spec.Interactive.IdleTimeoutSeconds != nil && !util.IsSparkUIEnabled(app, true) {
+		return fmt.Errorf("interactive idleTimeoutSeconds requires the driver web UI to be enabled")
+	}
+	return nil
+}
+
+// validateEnvSecretRefs ensures the envSecretRefs of a driver or executor map to distinct and valid environment variables.
+func validateEnvSecretRefs(role string, spec v1beta2.SparkPodSpec) error {
+	envNames := make(map[string]bool)
+	for name := range spec.EnvSecretKeyRefs {
+		envNames[name] = true
+	}
+	for _, ref := range spec.EnvSecretRefs {
+		envName := util.GetEnvSecretRefEnvName(ref)
+		if errs := validation.IsEnvVarName(envName); len(errs) > 0 {
+			return fmt.Errorf("%s envSecretRefs has invalid environment variable name %q: %s", role, envName, strings.Join(errs, ", "))
+		}
+		if envNames[envName] {
+			return fmt.Errorf("%s envSecretRefs has duplicate environment variable %q", role, envName)
+		}
+		envNames[envName] = true
+	}
+	return nil
+}
+
+// validateVaultSecrets ensures the Vault secrets of a driver or executor are complete, and that the ones injected
+// by the Vault Agent authenticate as a single role and are rendered to distinct files.
+func validateVaultSecrets(role string, spec v1beta2.SparkPodSpec) error {
+	var vaultRole string
+	files := make(map[string]bool)
+	for _, secret := range spec.Secrets {
+		if secret.Type != v1beta2.SecretTypeVault {
+			if secret.Vault != nil {
+				return fmt.Errorf("%s secret %q sets vault but is of type %s", role, secret.Name, secret.Type)
+			}
+			continue
+		}
+		if secret.Vault == nil {
+			return fmt.Errorf("%s secret %q of type %s requires vault", role, secret.Name, secret.Type)
+		}
+
+		switch secret.Vault.Injector {
+		case "", v1beta2.VaultInjectorAgent:
+			if secret.Vault.Role == "" {
+				return fmt.Errorf("%s secret %q injected by the Vault Agent requires vault.role", role, secret.Name)
+			}
+			if vaultRole != "" && secret.Vault.Role != vaultRole {
+				return fmt.Errorf("%s secrets injected by the Vault Agent must use a single vault.role, got %q and %q", role, vaultRole, secret.Vault.Role)
+			}
+			vaultRole = secret.Vault.Role
+			file := util.GetVaultSecretFileName(secret)
+			if files[file] {
+				return fmt.Errorf("%s secrets injected by the Vault Agent have duplicate file name %q", role, file)
+			}
+			files[file] = true
+		case v1beta2.VaultInjectorCSI:
+			if secret.Vault.SecretProviderClass == "" {
+				return fmt.Errorf("%s secret %q injected by the CSI driver requires vault.secretProviderClass", role, secret.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateEnvSecretRefKeys checks that the Secret keys referenced by envSecretRefs exist. Missing keys are rejected
+// in enforce mode and reported as warnings in warn mode.
+func (v *SparkApplicationValidator) validateEnvSecretRefKeys(ctx context.Context, app *v1beta2.SparkApplication) (admission.Warnings, error) {
+	if v.envSecretRefValidation == EnvSecretRefValidationDisabled || v.envSecretRefValidation == "" {
+		return nil, nil
+	}
+
+	var problems []string
+	secrets := make(map[string]*corev1.Secret)
+	refs := append(slices.Clone(app.Spec.Driver.EnvSecretRefs), app.Spec.Executor.EnvSecretRefs...)
+	for _, ref := range refs {
+		secret, ok := secrets[ref.SecretName]
+		if !ok {
+			secret = &corev1.Secret{}
+			if err := v.client.Get(ctx, client.ObjectKey{Namespace: app.Namespace, Name: ref.SecretName}, secret); err != nil {
+				if !errors.IsNotFound(err) {
+					problems = append(problems, fmt.Sprintf("failed to get secret %s/%s: %v", app.Namespace, ref.SecretName, err))
+					continue
+				}
+				secret = nil
+			}
+			secrets[ref.SecretName] = secret
+		}
+
+		if secret == nil {
+			problems = append(problems, fmt.Sprintf("secret %s/%s referenced by envSecretRefs does not exist", app.Namespace, ref.SecretName))
+			continue
+		}
+		if _, ok := secret.Data[ref.Key]; !ok {
+			problems = append(problems, fmt.Sprintf("secret %s/%s does not contain key %q referenced by envSecretRefs", app.Namespace, ref.SecretName, ref.Key))
+		}
+	}
+
+	slices.Sort(problems)
+	problems = slices.Compact(problems)
+	if len(problems) == 0 {
+		return nil, nil
+	}
+	if v.envSecretRefValidation == EnvSecretRefValidationEnforce {
+		return nil, fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return problems, nil
+}
+
+// validateStreamingCheckpointLocation ensures the streaming checkpoint location survives driver restarts.
+func (v *SparkApplicationValidator) validateStreamingCheckpointLocation(app *v1beta2.SparkApplication) error {
+	if app.Spec.Streaming == nil || app.Spec.Streaming.CheckpointLocation == nil {
+		return nil
+	}
+
+	location := *app.Spec.Streaming.CheckpointLocation
+	u, err := url.Parse(location)
+	if err != nil || location == "" {
+		return fmt.Errorf("invalid streaming checkpointLocation %q", location)
+	}
+	if conf, ok := app.Spec.SparkConf[common.SparkSQLStreamingCheckpointLocation]; ok && conf != location {
+		return fmt.Errorf("streaming checkpointLocation %q conflicts with spark conf %s=%q", location, common.SparkSQLStreamingCheckpointLocation, conf)
+	}
+	if !util.IsLocalCheckpointLocation(location) {
+		return nil
+	}
+
+	if !path.IsAbs(u.Path) {
+		return fmt.Errorf("local streaming checkpointLocation %q must be an absolute path", location)
+	}
+	volume := util.GetStreamingCheckpointVolume(app)
+	if volume == nil {
+		return fmt.Errorf("local streaming checkpointLocation %q must be inside a driver volume mount", location)
+	}
+	if volume.PersistentVolumeClaim == nil {
+		return fmt.Errorf("local streaming checkpointLocation %q must be on a volume backed by a PersistentVolumeClaim, got volume %q", location, volume.Name)
+	}
 	return nil
 }
 
@@ -172,38 +977,64 @@ func (v *SparkApplicationValidator) validateName(name string) error {
 	return nil
 }
 
+// validateSparkVersion rejects options relying on features the declared Spark version does not support.
 func (v *SparkApplicationValidator) validateSparkVersion(app *v1beta2.SparkApplication) error {
-	// The pod template feature requires Spark version 3.0.0 or higher.
+	var features []util.SparkFeature
 	if app.Spec.Driver.Template != nil || app.Spec.Executor.Template != nil {
-		if util.CompareSemanticVersion(app.Spec.SparkVersion, "3.0.0") < 0 {
-			return fmt.Errorf("pod template feature requires Spark version 3.0.0 or higher")
+		features = append(features, util.SparkFeaturePodTemplate)
+	}
+	if da := app.Spec.DynamicAllocation; da != nil && da.Enabled && da.ShuffleTrackingEnabled != nil && *da.ShuffleTrackingEnabled {
+		features = append(features, util.SparkFeatureDynamicAllocationShuffleTracking)
+	}
+	if util.TaskMetricsEnabled(app) {
+		features = append(features, util.SparkFeaturePlugins)
+	}
+	if interactive := app.Spec.Interactive; interactive != nil && interactive.Protocol != v1beta2.InteractiveProtocolThrift {
+		features = append(features, util.SparkFeatureConnect)
+	}
+	if app.Spec.Executor.DecommissionOnNodeEviction != nil && *app.Spec.Executor.DecommissionOnNodeEviction {
+		features = append(features, util.SparkFeatureDecommission)
+	}
+	if pvc := app.Spec.Executor.EphemeralPVC; pvc != nil {
+		features = append(features, util.SparkFeatureOnDemandPVC)
+		if pvc.ReuseClaims != nil && *pvc.ReuseClaims {
+			features = append(features, util.SparkFeaturePVCReuse)
+		}
+	}
+
+	for _, feature := range features {
+		if !util.SparkVersionSupports(app.Spec.SparkVersion, feature) {
+			return fmt.Errorf("%s requires Spark version %s or higher, got %q", feature, util.GetSparkFeatureMinVersion(feature), app.Spec.SparkVersion)
 		}
 	}
 	return nil
 }
 
-func (v *SparkApplicationValidator) validateResourceUsage(ctx context.Context, app *v1beta2.SparkApplication) error {
-	requests, err := getResourceList(app)
-	if err != nil {
-		return fmt.Errorf("failed to calculate resource quests: %v", err)
+// sparkVersionWarnings warns about Spark versions outside the range supported by the operator.
+func (v *SparkApplicationValidator) sparkVersionWarnings(app *v1beta2.SparkApplication) admission.Warnings {
+	if util.IsSupportedSparkVersion(app.Spec.SparkVersion) {
+		return nil
 	}
-
-	resourceQuotaList := &corev1.ResourceQuotaList{}
-	if err := v.client.List(ctx, resourceQuotaList, client.InNamespace(app.Namespace)); err != nil {
-		return fmt.Errorf("failed to list resource quotas: %v", err)
+	return admission.Warnings{
+		fmt.Sprintf("Spark version %q is not supported by the operator, supported versions are %s to %s.x",
+			app.Spec.SparkVersion, util.MinSupportedSparkVersion, util.MaxSupportedSparkMajorVersion),
 	}
+}
 
-	for _, resourceQuota := range resourceQuotaList.Items {
-		// Scope selectors not currently supported, ignore any ResourceQuota that does not match everything.
-		// TODO: Add support for scope selectors.
-		if resourceQuota.Spec.ScopeSelector != nil || len(resourceQuota.Spec.Scopes) > 0 {
-			continue
-		}
+func (v *SparkApplicationValidator) validateResourceUsage(ctx context.Context, app *v1beta2.SparkApplication) error {
+	return ValidateResourceQuotas(ctx, v.client, app)
+}
 
-		if !validateResourceQuota(requests, resourceQuota) {
-			return fmt.Errorf("failed to validate resource quota \"%s/%s\"", resourceQuota.Namespace, resourceQuota.Name)
-		}
+// validateLimitRangeUsage rejects SparkApplications whose driver or executor resources violate a LimitRange
+// of their namespace, which would otherwise only surface once the pods are rejected by the API server.
+func (v *SparkApplicationValidator) validateLimitRangeUsage(ctx context.Context, app *v1beta2.SparkApplication) error {
+	if v.limitRangeValidation == "" || v.limitRangeValidation == LimitRangeValidationDisabled {
+		return nil
 	}
 
-	return nil
+	limitRanges, err := listLimitRanges(ctx, v.client, app.Namespace)
+	if err != nil {
+		return err
+	}
+	return validateLimitRanges(app, limitRanges)
 }
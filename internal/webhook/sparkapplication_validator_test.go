@@ -21,15 +21,18 @@ import (
 	"strings"
 	"testing"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
 )
 
 func TestSparkApplicationValidatorValidateCreate_NodeSelectorConflict(t *testing.T) {
@@ -52,35 +55,1269 @@ func TestSparkApplicationValidatorValidateCreate_Success(t *testing.T) {
 	}
 }
 
+func TestSparkApplicationValidatorValidateCreate_DependsOn(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.DependsOn = []string{"extract", "transform"}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.DependsOn = []string{"extract", app.Name}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "cannot depend on itself") {
+		t.Fatalf("expected self-dependency validation error, got %v", err)
+	}
+
+	app.Spec.DependsOn = []string{"Invalid_Name"}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "invalid dependsOn name") {
+		t.Fatalf("expected dependsOn name validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_DriverDiagnostics(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Driver.Diagnostics = &v1beta2.DriverDiagnostics{ClaimName: ptr.To("spark-dumps"), Collect: ptr.To(true)}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.Driver.Diagnostics.SizeLimit = ptr.To(resource.MustParse("1Gi"))
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "cannot be set along with claimName") {
+		t.Fatalf("expected sizeLimit validation error, got %v", err)
+	}
+
+	app.Spec.Driver.Diagnostics = &v1beta2.DriverDiagnostics{ClaimName: ptr.To("Spark_Dumps")}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "invalid driver diagnostics claimName") {
+		t.Fatalf("expected claimName validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_PrometheusServlet(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Monitoring = &v1beta2.MonitoringSpec{
+		PrometheusServlet: &v1beta2.PrometheusServletSpec{
+			RemoteWrite: &v1beta2.PrometheusRemoteWriteSpec{URL: "https://prometheus.example.com/api/v1/write"},
+		},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	testCases := []struct {
+		name    string
+		mutate  func(app *v1beta2.SparkApplication)
+		wantErr string
+	}{
+		{
+			name: "Prometheus JMX exporter",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Monitoring.Prometheus = &v1beta2.PrometheusSpec{}
+			},
+			wantErr: "prometheus and prometheusServlet cannot both be set",
+		},
+		{
+			name: "Spark UI disabled",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.SparkConf = map[string]string{"spark.ui.enabled": "false"}
+			},
+			wantErr: "requires the Spark UI to be enabled",
+		},
+		{
+			name: "executor metrics path",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Monitoring.PrometheusServlet.Path = ptr.To("/metrics/executors/prometheus")
+			},
+			wantErr: "invalid monitoring prometheusServlet path",
+		},
+		{
+			name: "invalid remote-write URL",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Monitoring.PrometheusServlet.RemoteWrite.URL = "prometheus:9090"
+			},
+			wantErr: "invalid monitoring prometheusServlet remoteWrite url",
+		},
+		{
+			name: "Spark UI port conflicts with driver port",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.SparkConf = map[string]string{"spark.ui.port": "7078"}
+			},
+			wantErr: "driver port 7078 conflicts with Spark UI port",
+		},
+		{
+			name: "Prometheus agent port conflicts with Spark UI port",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.SparkConf = map[string]string{"spark.ui.port": "9095"}
+			},
+			wantErr: "Prometheus agent port 9095 conflicts with Spark UI port",
+		},
+		{
+			name: "driver container port conflicts with Prometheus agent port",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.Ports = []v1beta2.Port{{Name: "metrics", Protocol: "TCP", ContainerPort: 9095}}
+			},
+			wantErr: "driver metrics port 9095 conflicts with Prometheus agent port",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := app.DeepCopy()
+			tc.mutate(app)
+			if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_OOMMaxMemory(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.RestartPolicy = v1beta2.RestartPolicy{
+		Type:                       v1beta2.RestartPolicyOnFailure,
+		OnFailureRetries:           ptr.To[int32](3),
+		OnOOMIncreaseMemoryPercent: ptr.To[int32](50),
+		OnOOMMaxMemory:             ptr.To("8g"),
+	}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.RestartPolicy.OnOOMMaxMemory = ptr.To("eight gigs")
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "invalid restartPolicy.onOOMMaxMemory") {
+		t.Fatalf("expected onOOMMaxMemory validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_ExecutionWindow(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.ExecutionWindow = &v1beta2.ExecutionWindow{
+		Windows:     []string{"CRON_TZ=UTC 0 22 * * *;8h"},
+		OnWindowEnd: v1beta2.ExecutionWindowEndPause,
+	}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.ExecutionWindow.Windows = append(app.Spec.ExecutionWindow.Windows, "0 22 * * *")
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "invalid execution window") {
+		t.Fatalf("expected execution window validation error, got %v", err)
+	}
+}
+
 func TestSparkApplicationValidatorValidateCreate_DriverIngressDuplicatePort(t *testing.T) {
 	validator := newTestValidator(t, false)
 
-	app := newSparkApplication()
-	app.Spec.DriverIngressOptions = []v1beta2.DriverIngressConfiguration{
+	app := newSparkApplication()
+	app.Spec.DriverIngressOptions = []v1beta2.DriverIngressConfiguration{
+		{
+			ServicePort:      ptr.To[int32](4040),
+			IngressURLFormat: "http://spark-a",
+		},
+		{
+			ServicePort:      ptr.To[int32](4040),
+			IngressURLFormat: "http://spark-b",
+		},
+	}
+
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "duplicate ServicePort") {
+		t.Fatalf("expected duplicate service port error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_DriverService(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Driver.Service = &v1beta2.DriverServiceSpec{
+		Type: v1beta2.DriverServiceTypeNodePort,
+		Ports: []v1beta2.DriverServicePort{
+			{Name: "thrift", Port: 10000, NodePort: ptr.To[int32](30000)},
+		},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.Driver.Service.Type = v1beta2.DriverServiceTypeClusterIP
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "cannot set nodePort") {
+		t.Fatalf("expected node port validation error, got %v", err)
+	}
+
+	app.Spec.Driver.Service.Ports = []v1beta2.DriverServicePort{
+		{Name: "thrift", Port: 10000},
+		{Name: "thrift-http", Port: 10000},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "duplicate port") {
+		t.Fatalf("expected duplicate port error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_ClientModeExecutorTemplate(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Mode = v1beta2.DeployModeClient
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.Executor.Template = &corev1.PodTemplateSpec{}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "client mode") {
+		t.Fatalf("expected client mode executor template error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_Interactive(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.MainApplicationFile = nil
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "mainApplicationFile must be set") {
+		t.Fatalf("expected missing mainApplicationFile error, got %v", err)
+	}
+
+	app.Spec.Interactive = &v1beta2.InteractiveSpec{Protocol: v1beta2.InteractiveProtocolConnect, IdleTimeoutSeconds: ptr.To[int64](600)}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.MainClass = ptr.To("org.example.Main")
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "interactive") {
+		t.Fatalf("expected interactive mainClass error, got %v", err)
+	}
+	app.Spec.MainClass = nil
+
+	app.Spec.SparkVersion = "3.3.2"
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "Spark Connect") {
+		t.Fatalf("expected Spark Connect version error, got %v", err)
+	}
+	app.Spec.Interactive.Protocol = v1beta2.InteractiveProtocolThrift
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success for Thrift Server, got %v", err)
+	}
+
+	app.Spec.Driver.UI = &v1beta2.DriverUISpec{Enabled: ptr.To(false)}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "web UI") {
+		t.Fatalf("expected web UI error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_ExecutorInPlaceScaling(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Executor.InPlaceScaling = &v1beta2.ExecutorInPlaceScaling{}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "pluginClass") {
+		t.Fatalf("expected missing pluginClass error, got %v", err)
+	}
+
+	app.Spec.Executor.InPlaceScaling.PluginClass = "org.kubeflow.spark.operator.plugin.ExecutorScalingPlugin"
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.Executor.Instances = ptr.To(int32(101))
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "maxInstances 100") {
+		t.Fatalf("expected default maxInstances error, got %v", err)
+	}
+
+	app.Spec.Executor.InPlaceScaling.MaxInstances = ptr.To(int32(200))
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected success, got %v", err)
+	}
+
+	app.Spec.Executor.InPlaceScaling.MaxInstances = ptr.To(int32(0))
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "must be positive") {
+		t.Fatalf("expected non-positive maxInstances error, got %v", err)
+	}
+	app.Spec.Executor.InPlaceScaling.MaxInstances = nil
+	app.Spec.Executor.Instances = nil
+
+	app.Spec.DynamicAllocation = &v1beta2.DynamicAllocation{Enabled: true}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "dynamic allocation") {
+		t.Fatalf("expected dynamic allocation conflict error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_ExecutorPodDisruptionBudgetConflict(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	minAvailable := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(1)
+	app.Spec.Executor.PodDisruptionBudget = &v1beta2.ExecutorPodDisruptionBudget{
+		MinAvailable:   &minAvailable,
+		MaxUnavailable: &maxUnavailable,
+	}
+
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "podDisruptionBudget") {
+		t.Fatalf("expected pod disruption budget validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_StreamingCheckpointLocation(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	testCases := []struct {
+		name     string
+		location string
+		volume   corev1.VolumeSource
+		wantErr  string
+	}{
+		{
+			name:     "remote location",
+			location: "s3a://bucket/checkpoints",
+		},
+		{
+			name:     "location on persistent volume claim",
+			location: "file:///checkpoints/query",
+			volume:   corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "checkpoints"}},
+		},
+		{
+			name:     "relative location",
+			location: "checkpoints/query",
+			volume:   corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "checkpoints"}},
+			wantErr:  "absolute path",
+		},
+		{
+			name:     "location outside volume mounts",
+			location: "/tmp/checkpoints",
+			volume:   corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "checkpoints"}},
+			wantErr:  "inside a driver volume mount",
+		},
+		{
+			name:     "location on ephemeral volume",
+			location: "/checkpoints/query",
+			volume:   corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+			wantErr:  "PersistentVolumeClaim",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Streaming = &v1beta2.StreamingSpec{CheckpointLocation: ptr.To(tc.location)}
+			app.Spec.Volumes = []corev1.Volume{{Name: "checkpoints", VolumeSource: tc.volume}}
+			app.Spec.Driver.VolumeMounts = []corev1.VolumeMount{{Name: "checkpoints", MountPath: "/checkpoints"}}
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected checkpoint location to be valid, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_PodTemplateRequiresSpark3(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.SparkVersion = "2.4.0"
+	app.Spec.Driver.Template = &corev1.PodTemplateSpec{}
+
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "requires Spark version 3.0.0 or higher") {
+		t.Fatalf("expected spark version validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_FeatureRequiresSparkVersion(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.SparkVersion = "3.0.3"
+	app.Spec.Executor.DecommissionOnNodeEviction = ptr.To(true)
+
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "executor decommissioning requires Spark version 3.1.0 or higher") {
+		t.Fatalf("expected spark version validation error, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_UnsupportedSparkVersionWarning(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	warnings, err := validator.ValidateCreate(context.Background(), app)
+	if err != nil || len(warnings) != 0 {
+		t.Fatalf("expected no warnings for Spark %s, got %v, %v", app.Spec.SparkVersion, warnings, err)
+	}
+
+	app.Spec.SparkVersion = "3.2.4"
+	warnings, err = validator.ValidateCreate(context.Background(), app)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "not supported") {
+		t.Fatalf("expected unsupported Spark version warning, got %v", warnings)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_DriverUIConflict(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	app := newSparkApplication()
+	app.Spec.Driver.UI = &v1beta2.DriverUISpec{Enabled: ptr.To(false)}
+	app.Spec.SparkConf = map[string]string{"spark.ui.enabled": "true"}
+	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "conflicts with spark conf spark.ui.enabled") {
+		t.Fatalf("expected driver ui conflict error, got %v", err)
+	}
+
+	app.Spec.SparkConf = map[string]string{"spark.ui.enabled": "false"}
+	if _, err := validator.ValidateCreate(context.Background(), app); err != nil {
+		t.Fatalf("expected successful validation, got %v", err)
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_Resources(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	testCases := []struct {
+		name    string
+		mutate  func(spec *v1beta2.ExecutorSpec)
+		wantErr string
+	}{
+		{
+			name: "consistent with string-based fields",
+			mutate: func(spec *v1beta2.ExecutorSpec) {
+				spec.CoreRequest = ptr.To("500m")
+				spec.Memory = ptr.To("4096m")
+				spec.MemoryLimit = ptr.To("5g")
+			},
+		},
+		{
+			name: "conflicting cpu request",
+			mutate: func(spec *v1beta2.ExecutorSpec) {
+				spec.CoreRequest = ptr.To("1")
+			},
+			wantErr: "executor resources.requests.cpu 500m conflicts with coreRequest",
+		},
+		{
+			name: "conflicting memory request",
+			mutate: func(spec *v1beta2.ExecutorSpec) {
+				spec.Memory = ptr.To("2g")
+			},
+			wantErr: "executor resources.requests.memory 4Gi conflicts with memory",
+		},
+		{
+			name: "conflicting memory limit",
+			mutate: func(spec *v1beta2.ExecutorSpec) {
+				spec.MemoryLimit = ptr.To("4Gi")
+			},
+			wantErr: "executor resources.limits.memory 5Gi conflicts with memoryLimit",
+		},
+		{
+			name: "claims",
+			mutate: func(spec *v1beta2.ExecutorSpec) {
+				spec.Resources.Claims = []corev1.ResourceClaim{{Name: "gpu"}}
+			},
+			wantErr: "executor resources.claims is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Executor.Resources = &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:              resource.MustParse("500m"),
+					corev1.ResourceMemory:           resource.MustParse("4Gi"),
+					corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("5Gi"),
+				},
+			}
+			tc.mutate(&app.Spec.Executor)
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_Hooks(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	jobTemplate := &batchv1.JobTemplateSpec{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers:    []corev1.Container{{Name: "notify", Image: "curlimages/curl"}},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+	lifecycle := &corev1.Lifecycle{
+		PreStop: &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"sleep", "5"}}},
+	}
+
+	testCases := []struct {
+		name    string
+		hooks   v1beta2.Hooks
+		mutate  func(spec *v1beta2.SparkApplicationSpec)
+		wantErr string
+	}{
+		{
+			name: "valid hooks",
+			hooks: v1beta2.Hooks{
+				Driver: lifecycle,
+				Operator: []v1beta2.OperatorHook{
+					{Name: "cleanup", Events: []v1beta2.HookEvent{v1beta2.HookEventCompleted, v1beta2.HookEventFailed}, Job: jobTemplate},
+					{Name: "notify", Events: []v1beta2.HookEvent{v1beta2.HookEventSubmitted, v1beta2.HookEventFailedSubmission}, HTTP: &v1beta2.HTTPHook{URL: "https://example.com/hook"}},
+				},
+			},
+		},
+		{
+			name:    "driver lifecycle conflict",
+			hooks:   v1beta2.Hooks{Driver: lifecycle},
+			mutate:  func(spec *v1beta2.SparkApplicationSpec) { spec.Driver.Lifecycle = lifecycle },
+			wantErr: "hooks.driver cannot be defined together with driver lifecycle",
+		},
+		{
+			name: "duplicate name",
+			hooks: v1beta2.Hooks{
+				Operator: []v1beta2.OperatorHook{
+					{Name: "notify", Events: []v1beta2.HookEvent{v1beta2.HookEventSubmitted}, Job: jobTemplate},
+					{Name: "notify", Events: []v1beta2.HookEvent{v1beta2.HookEventFailed}, Job: jobTemplate},
+				},
+			},
+			wantErr: `duplicate hook name "notify"`,
+		},
+		{
+			name: "invalid event",
+			hooks: v1beta2.Hooks{
+				Operator: []v1beta2.OperatorHook{
+					{Name: "notify", Events: []v1beta2.HookEvent{"Running"}, Job: jobTemplate},
+				},
+			},
+			wantErr: `hook "notify" has invalid event "Running"`,
+		},
+		{
+			name: "both job and http",
+			hooks: v1beta2.Hooks{
+				Operator: []v1beta2.OperatorHook{
+					{Name: "notify", Events: []v1beta2.HookEvent{v1beta2.HookEventFailed}, Job: jobTemplate, HTTP: &v1beta2.HTTPHook{URL: "https://example.com/hook"}},
+				},
+			},
+			wantErr: `hook "notify" must specify exactly one of job and http`,
+		},
+		{
+			name: "relative url",
+			hooks: v1beta2.Hooks{
+				Operator: []v1beta2.OperatorHook{
+					{Name: "notify", Events: []v1beta2.HookEvent{v1beta2.HookEventFailed}, HTTP: &v1beta2.HTTPHook{URL: "/hook"}},
+				},
+			},
+			wantErr: `hook "notify" has invalid http url "/hook"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Hooks = &tc.hooks
+			if tc.mutate != nil {
+				tc.mutate(&app.Spec)
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_Notifications(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	testCases := []struct {
+		name     string
+		webhooks []v1beta2.NotificationWebhook
+		emails   []v1beta2.NotificationEmail
+		wantErr  string
+	}{
+		{
+			name: "valid webhooks",
+			webhooks: []v1beta2.NotificationWebhook{
+				{Name: "airflow", URL: "https://airflow.example.com/callback", Events: []v1beta2.NotificationEvent{v1beta2.NotificationEventCompleted, v1beta2.NotificationEventFailed}},
+				{
+					Name:       "audit",
+					URL:        "http://audit.default.svc:8080",
+					AuthSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "audit-token"}, Key: "token"},
+				},
+				{
+					Name:      "slack",
+					Type:      v1beta2.NotificationWebhookTypeSlack,
+					URLSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "slack-webhook"}, Key: "url"},
+				},
+			},
+			emails: []v1beta2.NotificationEmail{{Name: "oncall", To: []string{"oncall@example.com", "Data Team <data@example.com>"}}},
+		},
+		{
+			name: "duplicate name",
+			webhooks: []v1beta2.NotificationWebhook{
+				{Name: "airflow", URL: "https://airflow.example.com/callback"},
+				{Name: "airflow", URL: "https://airflow.example.com/other"},
+			},
+			wantErr: `duplicate notification name "airflow"`,
+		},
+		{
+			name:     "relative url",
+			webhooks: []v1beta2.NotificationWebhook{{Name: "airflow", URL: "/callback"}},
+			wantErr:  `notification webhook "airflow" has invalid url "/callback"`,
+		},
+		{
+			name:     "invalid event",
+			webhooks: []v1beta2.NotificationWebhook{{Name: "airflow", URL: "https://airflow.example.com/callback", Events: []v1beta2.NotificationEvent{"Completed"}}},
+			wantErr:  `notification "airflow" has invalid event "Completed"`,
+		},
+		{
+			name: "auth secret without key",
+			webhooks: []v1beta2.NotificationWebhook{
+				{
+					Name:       "airflow",
+					URL:        "https://airflow.example.com/callback",
+					AuthSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "airflow-token"}},
+				},
+			},
+			wantErr: `notification webhook "airflow" authSecret must specify both name and key`,
+		},
+		{
+			name: "both url and url secret",
+			webhooks: []v1beta2.NotificationWebhook{
+				{
+					Name:      "slack",
+					Type:      v1beta2.NotificationWebhookTypeSlack,
+					URL:       "https://hooks.slack.com/services/T000/B000/XXXX",
+					URLSecret: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "slack-webhook"}, Key: "url"},
+				},
+			},
+			wantErr: `notification webhook "slack" must specify exactly one of url and urlSecret`,
+		},
+		{
+			name:    "email without recipients",
+			emails:  []v1beta2.NotificationEmail{{Name: "oncall"}},
+			wantErr: `notification email "oncall" has no recipients`,
+		},
+		{
+			name:    "invalid email recipient",
+			emails:  []v1beta2.NotificationEmail{{Name: "oncall", To: []string{"oncall"}}},
+			wantErr: `notification email "oncall" has invalid recipient "oncall"`,
+		},
+		{
+			name:     "email with the name of a webhook",
+			webhooks: []v1beta2.NotificationWebhook{{Name: "oncall", URL: "https://example.com/callback"}},
+			emails:   []v1beta2.NotificationEmail{{Name: "oncall", To: []string{"oncall@example.com"}}},
+			wantErr:  `duplicate notification name "oncall"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Notifications = &v1beta2.NotificationSpec{Webhooks: tc.webhooks, Emails: tc.emails}
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_EnvSecretRefs(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+
+	tests := []struct {
+		name        string
+		mode        EnvSecretRefValidationMode
+		refs        []v1beta2.EnvSecretRef
+		wantErr     string
+		wantWarning string
+	}{
+		{
+			name: "existing key",
+			mode: EnvSecretRefValidationEnforce,
+			refs: []v1beta2.EnvSecretRef{{SecretName: "db-credentials", Key: "password", EnvName: ptr.To("DB_PASSWORD")}},
+		},
+		{
+			name:    "missing key is rejected in enforce mode",
+			mode:    EnvSecretRefValidationEnforce,
+			refs:    []v1beta2.EnvSecretRef{{SecretName: "db-credentials", Key: "user", EnvName: ptr.To("DB_USER")}},
+			wantErr: `does not contain key "user"`,
+		},
+		{
+			name:        "missing secret is a warning in warn mode",
+			mode:        EnvSecretRefValidationWarn,
+			refs:        []v1beta2.EnvSecretRef{{SecretName: "missing", Key: "token", EnvName: ptr.To("TOKEN")}},
+			wantWarning: "secret default/missing referenced by envSecretRefs does not exist",
+		},
+		{
+			name: "missing secret is ignored when disabled",
+			mode: EnvSecretRefValidationDisabled,
+			refs: []v1beta2.EnvSecretRef{{SecretName: "missing", Key: "token", EnvName: ptr.To("TOKEN")}},
+		},
+		{
+			name:    "invalid environment variable name",
+			mode:    EnvSecretRefValidationDisabled,
+			refs:    []v1beta2.EnvSecretRef{{SecretName: "db-credentials", Key: "password", EnvName: ptr.To("1PASSWORD")}},
+			wantErr: "invalid environment variable name",
+		},
+		{
+			name: "duplicate environment variable",
+			mode: EnvSecretRefValidationDisabled,
+			refs: []v1beta2.EnvSecretRef{
+				{SecretName: "db-credentials", Key: "password"},
+				{SecretName: "other", Key: "password"},
+			},
+			wantErr: `duplicate environment variable "password"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(t, false, secret)
+			validator.envSecretRefValidation = tc.mode
+
+			app := newSparkApplication()
+			app.Spec.Executor.EnvSecretRefs = tc.refs
+			warnings, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if tc.wantWarning == "" && len(warnings) != 0 {
+				t.Fatalf("expected no warnings, got %v", warnings)
+			}
+			if tc.wantWarning != "" && (len(warnings) != 1 || warnings[0] != tc.wantWarning) {
+				t.Fatalf("expected warning %q, got %v", tc.wantWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_VaultSecrets(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	testCases := []struct {
+		name    string
+		secrets []v1beta2.SecretInfo
+		wantErr string
+	}{
 		{
-			ServicePort:      ptr.To[int32](4040),
-			IngressURLFormat: "http://spark-a",
+			name: "valid secrets",
+			secrets: []v1beta2.SecretInfo{
+				{Name: "secret/data/spark/s3", Path: "/etc/s3", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+				{Name: "secret/data/spark/jdbc", Path: "/etc/jdbc", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark", Template: "{{ .Data.data.password }}"}},
+				{Name: "jdbc", Path: "/etc/csi", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Injector: v1beta2.VaultInjectorCSI, SecretProviderClass: "vault-jdbc"}},
+				{Name: "gcp", Path: "/etc/gcp", Type: v1beta2.SecretTypeGCPServiceAccount},
+			},
 		},
 		{
-			ServicePort:      ptr.To[int32](4040),
-			IngressURLFormat: "http://spark-b",
+			name:    "missing vault",
+			secrets: []v1beta2.SecretInfo{{Name: "secret/data/spark/s3", Path: "/etc/s3", Type: v1beta2.SecretTypeVault}},
+			wantErr: `driver secret "secret/data/spark/s3" of type Vault requires vault`,
+		},
+		{
+			name:    "vault on a generic secret",
+			secrets: []v1beta2.SecretInfo{{Name: "s3", Path: "/etc/s3", Type: v1beta2.SecretTypeGeneric, Vault: &v1beta2.VaultSecret{Role: "spark"}}},
+			wantErr: `driver secret "s3" sets vault but is of type Generic`,
+		},
+		{
+			name:    "missing role",
+			secrets: []v1beta2.SecretInfo{{Name: "secret/data/spark/s3", Path: "/etc/s3", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{}}},
+			wantErr: `driver secret "secret/data/spark/s3" injected by the Vault Agent requires vault.role`,
+		},
+		{
+			name: "different roles",
+			secrets: []v1beta2.SecretInfo{
+				{Name: "secret/data/spark/s3", Path: "/etc/s3", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+				{Name: "secret/data/spark/jdbc", Path: "/etc/jdbc", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "jdbc"}},
+			},
+			wantErr: `driver secrets injected by the Vault Agent must use a single vault.role, got "spark" and "jdbc"`,
+		},
+		{
+			name: "duplicate file",
+			secrets: []v1beta2.SecretInfo{
+				{Name: "secret/data/team-a/creds", Path: "/etc/a", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+				{Name: "secret/data/team-b/creds", Path: "/etc/b", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+			},
+			wantErr: `driver secrets injected by the Vault Agent have duplicate file name "creds"`,
+		},
+		{
+			name:    "missing secret provider class",
+			secrets: []v1beta2.SecretInfo{{Name: "jdbc", Path: "/etc/jdbc", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Injector: v1beta2.VaultInjectorCSI}}},
+			wantErr: `driver secret "jdbc" injected by the CSI driver requires vault.secretProviderClass`,
 		},
 	}
 
-	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "duplicate ServicePort") {
-		t.Fatalf("expected duplicate service port error, got %v", err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Driver.Secrets = tc.secrets
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
 	}
 }
 
-func TestSparkApplicationValidatorValidateCreate_PodTemplateRequiresSpark3(t *testing.T) {
+func TestSparkApplicationValidatorValidateCreate_CloudStorage(t *testing.T) {
 	validator := newTestValidator(t, false)
 
-	app := newSparkApplication()
-	app.Spec.SparkVersion = "2.4.0"
-	app.Spec.Driver.Template = &corev1.PodTemplateSpec{}
+	testCases := []struct {
+		name    string
+		storage *v1beta2.CloudStorageSpec
+		gcp     *v1beta2.GCPSpec
+		wantErr string
+	}{
+		{
+			name: "valid cloud storage",
+			storage: &v1beta2.CloudStorageSpec{
+				S3:    &v1beta2.S3StorageSpec{Endpoint: ptr.To("http://minio:9000"), CredentialsSecret: ptr.To("minio")},
+				GCS:   &v1beta2.GCSStorageSpec{ProjectID: ptr.To("my-project")},
+				Azure: &v1beta2.AzureStorageSpec{StorageAccount: "sparkdata", ManagedIdentity: &v1beta2.AzureManagedIdentity{}},
+			},
+		},
+		{
+			name:    "valid role",
+			storage: &v1beta2.CloudStorageSpec{S3: &v1beta2.S3StorageSpec{RoleARN: ptr.To("arn:aws-us-gov:iam::123456789012:role/team/spark")}},
+		},
+		{
+			name: "credentials secret and role",
+			storage: &v1beta2.CloudStorageSpec{
+				S3: &v1beta2.S3StorageSpec{CredentialsSecret: ptr.To("s3"), RoleARN: ptr.To("arn:aws:iam::123456789012:role/spark")},
+			},
+			wantErr: "cloudStorage s3 credentialsSecret and roleArn cannot both be set",
+		},
+		{
+			name:    "invalid role",
+			storage: &v1beta2.CloudStorageSpec{S3: &v1beta2.S3StorageSpec{RoleARN: ptr.To("arn:aws:iam::123456789012:user/spark")}},
+			wantErr: `invalid cloudStorage s3 roleArn "arn:aws:iam::123456789012:user/spark"`,
+		},
+		{
+			name:    "invalid storage account",
+			storage: &v1beta2.CloudStorageSpec{Azure: &v1beta2.AzureStorageSpec{StorageAccount: "Spark-Data"}},
+			wantErr: `invalid cloudStorage azure storageAccount "Spark-Data"`,
+		},
+		{
+			name:    "workload identity",
+			storage: &v1beta2.CloudStorageSpec{GCS: &v1beta2.GCSStorageSpec{ProjectID: ptr.To("my-project")}},
+			gcp:     &v1beta2.GCPSpec{WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@my-project.iam.gserviceaccount.com"}},
+		},
+		{
+			name:    "invalid Google service account",
+			gcp:     &v1beta2.GCPSpec{WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@example.com"}},
+			wantErr: `invalid gcp workloadIdentity serviceAccount "spark@example.com"`,
+		},
+		{
+			name:    "workload identity and service account key",
+			storage: &v1beta2.CloudStorageSpec{GCS: &v1beta2.GCSStorageSpec{ServiceAccountKeySecret: ptr.To("gcs-key")}},
+			gcp:     &v1beta2.GCPSpec{WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@my-project.iam.gserviceaccount.com"}},
+			wantErr: "gcp workloadIdentity cannot be used with cloudStorage gcs serviceAccountKeySecret",
+		},
+		{
+			name: "account key and managed identity",
+			storage: &v1beta2.CloudStorageSpec{
+				Azure: &v1beta2.AzureStorageSpec{StorageAccount: "sparkdata", AccountKeySecret: ptr.To("key"), ManagedIdentity: &v1beta2.AzureManagedIdentity{}},
+			},
+			wantErr: "cloudStorage azure accountKeySecret and managedIdentity cannot both be set",
+		},
+	}
 
-	if _, err := validator.ValidateCreate(context.Background(), app); err == nil || !strings.Contains(err.Error(), "requires Spark version 3.0.0 or higher") {
-		t.Fatalf("expected spark version validation error, got %v", err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.CloudStorage = tc.storage
+			app.Spec.GCP = tc.gcp
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_Azure(t *testing.T) {
+	validator := newTestValidator(t, false)
+	identity := &v1beta2.AzureWorkloadIdentity{
+		ClientID: "00000000-0000-0000-0000-000000000001",
+		TenantID: "00000000-0000-0000-0000-000000000002",
+	}
+
+	testCases := []struct {
+		name    string
+		azure   *v1beta2.AzureSpec
+		storage *v1beta2.CloudStorageSpec
+		wantErr string
+	}{
+		{
+			name: "workload identity and key vault",
+			azure: &v1beta2.AzureSpec{
+				WorkloadIdentity: identity,
+				KeyVault: &v1beta2.AzureKeyVault{
+					Name:    "spark-vault",
+					Objects: []v1beta2.AzureKeyVaultObject{{Name: "password"}, {Name: "tls", Type: ptr.To("cert"), Alias: ptr.To("tls.pem")}},
+				},
+			},
+			storage: &v1beta2.CloudStorageSpec{Azure: &v1beta2.AzureStorageSpec{StorageAccount: "sparkdata"}},
+		},
+		{
+			name:    "invalid client ID",
+			azure:   &v1beta2.AzureSpec{WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{ClientID: "spark", TenantID: identity.TenantID}},
+			wantErr: `invalid azure workloadIdentity clientId "spark"`,
+		},
+		{
+			name:    "workload identity and managed identity",
+			azure:   &v1beta2.AzureSpec{WorkloadIdentity: identity},
+			storage: &v1beta2.CloudStorageSpec{Azure: &v1beta2.AzureStorageSpec{StorageAccount: "sparkdata", ManagedIdentity: &v1beta2.AzureManagedIdentity{}}},
+			wantErr: "azure workloadIdentity cannot be used with cloudStorage azure managedIdentity",
+		},
+		{
+			name:    "key vault without workload identity",
+			azure:   &v1beta2.AzureSpec{KeyVault: &v1beta2.AzureKeyVault{Name: "spark-vault", Objects: []v1beta2.AzureKeyVaultObject{{Name: "password"}}}},
+			wantErr: "azure keyVault requires azure workloadIdentity",
+		},
+		{
+			name: "objects mounted as the same file",
+			azure: &v1beta2.AzureSpec{
+				WorkloadIdentity: identity,
+				KeyVault: &v1beta2.AzureKeyVault{
+					Name:    "spark-vault",
+					Objects: []v1beta2.AzureKeyVaultObject{{Name: "password"}, {Name: "db-password", Alias: ptr.To("password")}},
+				},
+			},
+			wantErr: `azure keyVault objects are mounted as the same file "password"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.Azure = tc.azure
+			app.Spec.CloudStorage = tc.storage
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_HadoopSecurity(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	testCases := []struct {
+		name    string
+		mutate  func(app *v1beta2.SparkApplication)
+		wantErr string
+	}{
+		{
+			name: "principal with keytab",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.HadoopSecurity.Principal = "spark/etl.example.com@EXAMPLE.COM"
+				app.Spec.HadoopSecurity.AccessHadoopFileSystems = []string{"hdfs://namenode2:8020"}
+			},
+		},
+		{
+			name: "principal without realm",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.HadoopSecurity.Principal = "etl"
+			},
+			wantErr: `invalid hadoopSecurity principal "etl"`,
+		},
+		{
+			name: "client mode",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Mode = v1beta2.DeployModeClient
+			},
+			wantErr: "hadoopSecurity cannot be used in client mode",
+		},
+		{
+			name: "proxy user",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.ProxyUser = ptr.To("analyst")
+			},
+			wantErr: "hadoopSecurity cannot be used with proxyUser",
+		},
+		{
+			name: "filesystem without scheme",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.HadoopSecurity.AccessHadoopFileSystems = []string{"namenode2:8020"}
+			},
+			wantErr: `invalid hadoopSecurity accessHadoopFileSystems "namenode2:8020"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			app.Spec.HadoopSecurity = &v1beta2.HadoopSecuritySpec{Principal: "etl@EXAMPLE.COM", KerberosSecret: "etl-kerberos"}
+			tc.mutate(app)
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_IAMRoles(t *testing.T) {
+	validator := newTestValidator(t, false)
+	driverRole := "arn:aws:iam::123456789012:role/driver"
+	executorRole := "arn:aws:iam::123456789012:role/executor"
+
+	testCases := []struct {
+		name    string
+		mutate  func(app *v1beta2.SparkApplication)
+		wantErr string
+	}{
+		{
+			name: "different roles with different service accounts",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+				app.Spec.Driver.IAMRoleARN = ptr.To(driverRole)
+				app.Spec.Executor.ServiceAccount = ptr.To("spark-executor")
+				app.Spec.Executor.IAMRoleARN = ptr.To(executorRole)
+			},
+		},
+		{
+			name: "invalid role",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Executor.IAMRoleARN = ptr.To("spark")
+			},
+			wantErr: `invalid executor iamRoleArn "spark"`,
+		},
+		{
+			name: "role with static credentials",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.IAMRoleARN = ptr.To(driverRole)
+				app.Spec.CloudStorage = &v1beta2.CloudStorageSpec{S3: &v1beta2.S3StorageSpec{CredentialsSecret: ptr.To("s3")}}
+			},
+			wantErr: "driver iamRoleArn cannot be used with cloudStorage s3 credentialsSecret",
+		},
+		{
+			name: "different roles with the auto service account",
+			mutate: func(app *v1beta2.SparkApplication) {
+				app.Spec.Driver.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+				app.Spec.Driver.IAMRoleARN = ptr.To(driverRole)
+				app.Spec.Executor.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+				app.Spec.Executor.IAMRoleARN = ptr.To(executorRole)
+			},
+			wantErr: "driver and executor running with the auto service account must use the same iamRoleArn",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := newSparkApplication()
+			tc.mutate(app)
+
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected success, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSparkApplicationValidatorValidateCreate_VolumePolicy(t *testing.T) {
+	hostPath := corev1.Volume{
+		Name:         "host",
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/run"}},
+	}
+	emptyDir := corev1.Volume{
+		Name:         "scratch",
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}
+
+	tests := []struct {
+		name      string
+		policy    *VolumePolicy
+		namespace string
+		volumes   []corev1.Volume
+		template  []corev1.Volume
+		sparkConf map[string]string
+		hadoop    map[string]string
+		wantErr   string
+	}{
+		{
+			name:    "no policy",
+			volumes: []corev1.Volume{hostPath},
+		},
+		{
+			name:    "denied type is rejected",
+			policy:  &VolumePolicy{DeniedTypes: []string{"hostPath", "csi"}},
+			volumes: []corev1.Volume{emptyDir, hostPath},
+			wantErr: `spec.volumes: volume "host" of type hostPath is not allowed`,
+		},
+		{
+			name:     "denied type in pod template is rejected",
+			policy:   &VolumePolicy{DeniedTypes: []string{"hostPath"}},
+			template: []corev1.Volume{hostPath},
+			wantErr:  `spec.executor.template.spec.volumes: volume "host" of type hostPath is not allowed`,
+		},
+		{
+			name:    "type missing from allowed types is rejected",
+			policy:  &VolumePolicy{AllowedTypes: []string{"configMap", "emptyDir"}},
+			volumes: []corev1.Volume{hostPath},
+			wantErr: `volume "host" of type hostPath is not allowed`,
+		},
+		{
+			name:    "allowed type is admitted",
+			policy:  &VolumePolicy{AllowedTypes: []string{"configMap", "emptyDir"}},
+			volumes: []corev1.Volume{emptyDir},
+		},
+		{
+			name:      "exempt namespace is admitted",
+			policy:    &VolumePolicy{DeniedTypes: []string{"hostPath"}, ExemptNamespaces: []string{"kube-system"}},
+			namespace: "kube-system",
+			volumes:   []corev1.Volume{hostPath},
+		},
+		{
+			name:   "denied type in spark conf is rejected",
+			policy: &VolumePolicy{DeniedTypes: []string{"hostPath"}},
+			sparkConf: map[string]string{
+				"spark.kubernetes.executor.volumes.hostPath.host.mount.path":   "/host",
+				"spark.kubernetes.executor.volumes.hostPath.host.options.path": "/",
+			},
+			wantErr: `spec.sparkConf[spark.kubernetes.executor.volumes.hostPath.host.mount.path]: volume "host" of type hostPath is not allowed`,
+		},
+		{
+			name:      "type missing from allowed types in spark conf is rejected",
+			policy:    &VolumePolicy{AllowedTypes: []string{"configMap", "emptyDir"}},
+			sparkConf: map[string]string{"spark.kubernetes.driver.volumes.nfs.data.options.server": "nfs.example.com"},
+			wantErr:   `volume "data" of type nfs is not allowed`,
+		},
+		{
+			name:    "denied type in hadoop conf is rejected",
+			policy:  &VolumePolicy{DeniedTypes: []string{"hostPath"}},
+			hadoop:  map[string]string{"spark.kubernetes.driver.volumes.hostPath.host.mount.path": "/host"},
+			wantErr: `spec.hadoopConf[spark.kubernetes.driver.volumes.hostPath.host.mount.path]: volume "host" of type hostPath is not allowed`,
+		},
+		{
+			name:   "allowed type in spark conf is admitted",
+			policy: &VolumePolicy{AllowedTypes: []string{"emptyDir"}},
+			sparkConf: map[string]string{
+				"spark.kubernetes.driver.volumes.emptyDir.scratch.mount.path": "/scratch",
+				"spark.kubernetes.driver.podTemplateFile":                     "/opt/spark/template.yaml",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			validator := newTestValidator(t, false)
+			validator.volumePolicy = tc.policy
+
+			app := newSparkApplication()
+			if tc.namespace != "" {
+				app.Namespace = tc.namespace
+			}
+			app.Spec.Volumes = tc.volumes
+			if tc.template != nil {
+				app.Spec.Executor.Template = &corev1.PodTemplateSpec{Spec: corev1.PodSpec{Volumes: tc.template}}
+			}
+			app.Spec.SparkConf = tc.sparkConf
+			app.Spec.HadoopConf = tc.hadoop
+			_, err := validator.ValidateCreate(context.Background(), app)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestVolumePolicyValidate(t *testing.T) {
+	if err := (&VolumePolicy{AllowedTypes: []string{"configMap"}, DeniedTypes: []string{"hostPath", "csi"}}).Validate(); err != nil {
+		t.Fatalf("expected valid policy, got %v", err)
+	}
+	if err := (&VolumePolicy{DeniedTypes: []string{"hostpath"}}).Validate(); err == nil || !strings.Contains(err.Error(), `unknown volume type "hostpath"`) {
+		t.Fatalf("expected unknown volume type error, got %v", err)
 	}
 }
 
@@ -146,6 +1383,72 @@ func TestSparkApplicationValidatorValidateUpdate_SpecChangedTriggersValidation(t
 	}
 }
 
+func TestSparkApplicationValidatorValidateUpdate_ImmutableFields(t *testing.T) {
+	validator := newTestValidator(t, false)
+
+	tests := []struct {
+		name    string
+		state   v1beta2.ApplicationStateType
+		update  func(app *v1beta2.SparkApplication)
+		wantErr string
+	}{
+		{
+			name:  "running application with immutable fields changed",
+			state: v1beta2.ApplicationStateRunning,
+			update: func(app *v1beta2.SparkApplication) {
+				app.Spec.Type = v1beta2.SparkApplicationTypePython
+				app.Spec.MainApplicationFile = ptr.To("local:///other.py")
+				app.Spec.Executor.Image = ptr.To("spark:4.0.1")
+			},
+			wantErr: "cannot update immutable fields of RUNNING SparkApplication: spec.type, spec.mainApplicationFile, spec.executor.image",
+		},
+		{
+			name:  "submitted application with image changed",
+			state: v1beta2.ApplicationStateSubmitted,
+			update: func(app *v1beta2.SparkApplication) {
+				app.Spec.Image = ptr.To("spark:4.0.1")
+			},
+			wantErr: "cannot update immutable fields of SUBMITTED SparkApplication: spec.image",
+		},
+		{
+			name:  "running application with mutable fields changed",
+			state: v1beta2.ApplicationStateRunning,
+			update: func(app *v1beta2.SparkApplication) {
+				app.Spec.Executor.Instances = ptr.To[int32](3)
+				app.Spec.Arguments = []string{"1000"}
+			},
+		},
+		{
+			name:  "completed application with immutable fields changed",
+			state: v1beta2.ApplicationStateCompleted,
+			update: func(app *v1beta2.SparkApplication) {
+				app.Spec.Mode = v1beta2.DeployModeClient
+				app.Spec.Image = ptr.To("spark:4.0.1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldApp := newSparkApplication()
+			oldApp.Status.AppState.State = tt.state
+			newApp := oldApp.DeepCopy()
+			tt.update(newApp)
+
+			_, err := validator.ValidateUpdate(context.Background(), oldApp, newApp)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestSparkApplicationValidatorValidateUpdate_SuccessWithSpecChange(t *testing.T) {
 	quota := &corev1.ResourceQuota{
 		ObjectMeta: metav1.ObjectMeta{
@@ -230,7 +1533,7 @@ func newTestValidator(t *testing.T, enforceQuota bool, objs ...client.Object) *S
 		builder = builder.WithObjects(objs...)
 	}
 
-	return NewSparkApplicationValidator(builder.Build(), enforceQuota)
+	return NewSparkApplicationValidator(builder.Build(), enforceQuota, EnvSecretRefValidationDisabled, nil, LimitRangeValidationDisabled, false)
 }
 
 func newTestScheme(t *testing.T) *runtime.Scheme {
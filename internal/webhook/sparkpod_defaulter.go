@@ -19,6 +19,8 @@ package webhook
 import (
 	"context"
 	"fmt"
+	"maps"
+	"path/filepath"
 	"reflect"
 	"slices"
 	"strings"
@@ -28,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -39,21 +42,26 @@ import (
 
 const (
 	maxNameLength = 63
+
+	// diagnosticsCollectorScript keeps the diagnostics collector running until the operator stops it by signaling it.
+	diagnosticsCollectorScript = "trap 'exit 0' TERM INT; while true; do sleep 1; done"
 )
 
 // +kubebuilder:webhook:admissionReviewVersions=v1,failurePolicy=fail,groups="",matchPolicy=Exact,mutating=true,name=mutate-pod.sparkoperator.k8s.io,path=/mutate--v1-pod,reinvocationPolicy=Never,resources=pods,sideEffects=None,verbs=create;update,versions=v1,webhookVersions=v1
 
 // SparkPodDefaulter defaults Spark pods.
 type SparkPodDefaulter struct {
-	client             client.Client
-	sparkJobNamespaces map[string]bool
+	client                           client.Client
+	sparkJobNamespaces               map[string]bool
+	enableRestrictedSecurityDefaults bool
+	placementPolicy                  *PlacementPolicy
 }
 
 // SparkPodDefaulter implements admission.CustomDefaulter.
 var _ admission.CustomDefaulter = &SparkPodDefaulter{}
 
 // NewSparkPodDefaulter creates a new SparkPodDefaulter instance.
-func NewSparkPodDefaulter(client client.Client, namespaces []string) *SparkPodDefaulter {
+func NewSparkPodDefaulter(client client.Client, namespaces []string, enableRestrictedSecurityDefaults bool, placementPolicy *PlacementPolicy) *SparkPodDefaulter {
 	nsMap := make(map[string]bool)
 	if len(namespaces) == 0 {
 		nsMap[metav1.NamespaceAll] = true
@@ -64,8 +72,10 @@ func NewSparkPodDefaulter(client client.Client, namespaces []string) *SparkPodDe
 	}
 
 	return &SparkPodDefaulter{
-		client:             client,
-		sparkJobNamespaces: nsMap,
+		client:                           client,
+		sparkJobNamespaces:               nsMap,
+		enableRestrictedSecurityDefaults: enableRestrictedSecurityDefaults,
+		placementPolicy:                  placementPolicy,
 	}
 }
 
@@ -76,6 +86,7 @@ func (d *SparkPodDefaulter) Default(ctx context.Context, obj runtime.Object) err
 		return nil
 	}
 
+	ctx = util.WithCorrelationID(ctx, util.GetPodCorrelationID(pod))
 	logger := log.FromContext(ctx)
 	namespace := pod.Namespace
 	if !d.isSparkJobNamespace(namespace) {
@@ -91,11 +102,28 @@ func (d *SparkPodDefaulter) Default(ctx context.Context, obj runtime.Object) err
 	if err := d.client.Get(ctx, types.NamespacedName{Name: appName, Namespace: namespace}, app); err != nil {
 		return fmt.Errorf("failed to get SparkApplication %s/%s: %v", namespace, appName, err)
 	}
+	// The operator creates the driver pod with the customizations applied in client mode, but label policies are
+	// only known to the webhook.
+	if app.Spec.Mode == v1beta2.DeployModeClient && util.IsDriverPod(pod) {
+		return applyLabelPolicies(ctx, d.client, pod, app)
+	}
+	// Executors are scheduled with the scheduling profile the application was submitted with.
+	util.ApplySchedulingProfile(app)
 
 	logger.Info("Mutating Pod", "phase", pod.Status.Phase)
 	if err := mutateSparkPod(pod, app); err != nil {
 		return fmt.Errorf("failed to mutate Spark pod: %v", err)
 	}
+	d.placementPolicy.apply(pod, app)
+	if err := applyLabelPolicies(ctx, d.client, pod, app); err != nil {
+		return err
+	}
+
+	if d.enableRestrictedSecurityDefaults {
+		if err := addRestrictedSecurityDefaults(pod, app); err != nil {
+			return fmt.Errorf("failed to apply restricted security defaults to Spark pod: %v", err)
+		}
+	}
 
 	return nil
 }
@@ -107,10 +135,17 @@ func addMemoryLimit(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	}
 
 	var memoryLimit *string
+	var role string
 	if util.IsDriverPod(pod) {
 		memoryLimit = app.Spec.Driver.MemoryLimit
+		role = common.SparkRoleDriver
 	} else if util.IsExecutorPod(pod) {
 		memoryLimit = app.Spec.Executor.MemoryLimit
+		role = common.SparkRoleExecutor
+	}
+	// A memory limit increased after the container was OOMKilled takes precedence over the spec.
+	if adjustment := util.GetMemoryAdjustment(app, role); adjustment != nil && adjustment.MemoryLimit != nil {
+		memoryLimit = adjustment.MemoryLimit
 	}
 
 	if memoryLimit == nil {
@@ -136,6 +171,33 @@ func (d *SparkPodDefaulter) isSparkJobNamespace(ns string) bool {
 	return d.sparkJobNamespaces[metav1.NamespaceAll] || d.sparkJobNamespaces[ns]
 }
 
+// MutateSparkPodTemplate applies the customizations of the given SparkApplication to the given driver or executor
+// pod template, so that Spark pods created from the template need not be mutated by the webhook.
+func MutateSparkPodTemplate(template *corev1.PodTemplateSpec, role string, app *v1beta2.SparkApplication) error {
+	pod := &corev1.Pod{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	// The Spark role label identifies the pod to the mutations and is set by Spark on the pods anyway.
+	_, hasRole := pod.Labels[common.LabelSparkRole]
+	pod.Labels = maps.Clone(pod.Labels)
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+	pod.Labels[common.LabelSparkRole] = role
+
+	if err := mutateSparkPod(pod, app); err != nil {
+		return err
+	}
+
+	if !hasRole {
+		delete(pod.Labels, common.LabelSparkRole)
+	}
+	template.ObjectMeta = pod.ObjectMeta
+	template.Spec = pod.Spec
+	return nil
+}
+
 type mutateSparkPodOption func(pod *corev1.Pod, app *v1beta2.SparkApplication) error
 
 func mutateSparkPod(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
@@ -147,20 +209,32 @@ func mutateSparkPod(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 		addSparkConfigMap,
 		addGeneralConfigMaps,
 		addVolumes,
+		addVaultSecrets,
+		addAWSWebIdentity,
+		addAzureWorkloadIdentity,
+		addAzureKeyVault,
 		addContainerPorts,
 		addHostNetwork,
 		addHostAliases,
 		addInitContainers,
 		addSidecarContainers,
 		addDNSConfig,
+		addSubdomain,
 		addPriorityClassName,
 		addSchedulerName,
 		addNodeSelectors,
 		addAffinity,
+		addArch,
+		addZoneColocation,
 		addTolerations,
+		addTopologySpreadConstraints,
 		addMemoryLimit,
 		addGPU,
+		addResources,
 		addPrometheusConfig,
+		addPrometheusAgent,
+		addLoggingConfig,
+		addDriverDiagnostics,
 		addContainerSecurityContext,
 		addPodSecurityContext,
 		addTerminationGracePeriodSeconds,
@@ -223,6 +297,170 @@ func addVolumes(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addVaultSecrets mounts the Vault secrets injected with the Secrets Store CSI driver.
+func addVaultSecrets(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	var secrets []v1beta2.SecretInfo
+	if util.IsDriverPod(pod) {
+		secrets = app.Spec.Driver.Secrets
+	} else if util.IsExecutorPod(pod) {
+		secrets = app.Spec.Executor.Secrets
+	}
+
+	for i, secret := range secrets {
+		if !util.IsVaultSecret(secret, v1beta2.VaultInjectorCSI) {
+			continue
+		}
+		name := fmt.Sprintf("%s%d", common.VaultSecretVolumeNamePrefix, i)
+		_ = addVolume(pod, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				CSI: &corev1.CSIVolumeSource{
+					Driver:   common.SecretsStoreCSIDriver,
+					ReadOnly: ptr.To(true),
+					VolumeAttributes: map[string]string{
+						common.SecretsStoreCSIVolumeAttributeSecretProviderClass: secret.Vault.SecretProviderClass,
+					},
+				},
+			},
+		})
+		if err := addVolumeMount(pod, corev1.VolumeMount{Name: name, MountPath: secret.Path, ReadOnly: true}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAWSWebIdentity projects a web identity token of the service account of the pod and points the AWS SDK to it,
+// so that the S3A connector assumes the IAM role of the driver or executors.
+func addAWSWebIdentity(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	var roleARN string
+	if util.IsDriverPod(pod) {
+		roleARN = util.GetAWSRoleARN(app, &app.Spec.Driver.SparkPodSpec)
+	} else if util.IsExecutorPod(pod) {
+		roleARN = util.GetAWSRoleARN(app, &app.Spec.Executor.SparkPodSpec)
+	}
+	if roleARN == "" {
+		return nil
+	}
+
+	_ = addVolume(pod, corev1.Volume{
+		Name: common.AWSWebIdentityTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          common.AWSWebIdentityTokenAudience,
+							ExpirationSeconds: ptr.To[int64](common.AWSWebIdentityTokenExpirationSeconds),
+							Path:              common.AWSWebIdentityTokenFileName,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err := addVolumeMount(pod, corev1.VolumeMount{
+		Name:      common.AWSWebIdentityTokenVolumeName,
+		MountPath: common.AWSWebIdentityTokenMountPath,
+		ReadOnly:  true,
+	}); err != nil {
+		return err
+	}
+
+	if err := addEnvironmentVariable(pod, common.EnvAWSRoleARN, roleARN); err != nil {
+		return err
+	}
+	tokenFile := filepath.Join(common.AWSWebIdentityTokenMountPath, common.AWSWebIdentityTokenFileName)
+	if err := addEnvironmentVariable(pod, common.EnvAWSWebIdentityTokenFile, tokenFile); err != nil {
+		return err
+	}
+	// The region makes the SDK call the regional STS endpoint instead of the global one.
+	if storage := app.Spec.CloudStorage; storage != nil && storage.S3 != nil && storage.S3.Region != nil {
+		if err := addEnvironmentVariable(pod, common.EnvAWSRegion, *storage.S3.Region); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAzureWorkloadIdentity projects a federated token of the service account of the pod and points the Azure
+// identity libraries to it, as the Azure Workload Identity webhook does, so that the pod authenticates as its
+// Microsoft Entra workload identity whether the webhook is installed or not.
+func addAzureWorkloadIdentity(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	identity := util.GetAzureWorkloadIdentity(app)
+	if identity == nil || !(util.IsDriverPod(pod) || util.IsExecutorPod(pod)) {
+		return nil
+	}
+	// The Azure Workload Identity webhook already mutated the pod.
+	if slices.ContainsFunc(pod.Spec.Volumes, func(v corev1.Volume) bool {
+		return v.Name == common.AzureWorkloadIdentityTokenVolumeName
+	}) {
+		return nil
+	}
+
+	_ = addVolume(pod, corev1.Volume{
+		Name: common.AzureWorkloadIdentityTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{
+					{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          common.AzureWorkloadIdentityTokenAudience,
+							ExpirationSeconds: ptr.To[int64](common.AzureWorkloadIdentityTokenExpirationSeconds),
+							Path:              common.AzureWorkloadIdentityTokenFileName,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err := addVolumeMount(pod, corev1.VolumeMount{
+		Name:      common.AzureWorkloadIdentityTokenVolumeName,
+		MountPath: common.AzureWorkloadIdentityTokenMountPath,
+		ReadOnly:  true,
+	}); err != nil {
+		return err
+	}
+
+	tokenFile := filepath.Join(common.AzureWorkloadIdentityTokenMountPath, common.AzureWorkloadIdentityTokenFileName)
+	for _, env := range []corev1.EnvVar{
+		{Name: common.EnvAzureClientID, Value: identity.ClientID},
+		{Name: common.EnvAzureTenantID, Value: identity.TenantID},
+		{Name: common.EnvAzureFederatedTokenFile, Value: tokenFile},
+		{Name: common.EnvAzureAuthorityHost, Value: common.AzureAuthorityHost},
+	} {
+		if err := addEnvironmentVariable(pod, env.Name, env.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addAzureKeyVault mounts the objects of the Azure Key Vault with the SecretProviderClass created by the controller.
+func addAzureKeyVault(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if app.Spec.Azure == nil || app.Spec.Azure.KeyVault == nil || !(util.IsDriverPod(pod) || util.IsExecutorPod(pod)) {
+		return nil
+	}
+
+	_ = addVolume(pod, corev1.Volume{
+		Name: common.AzureKeyVaultVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:   common.SecretsStoreCSIDriver,
+				ReadOnly: ptr.To(true),
+				VolumeAttributes: map[string]string{
+					common.SecretsStoreCSIVolumeAttributeSecretProviderClass: util.GetAzureKeyVaultSecretProviderClassName(app),
+				},
+			},
+		},
+	})
+	return addVolumeMount(pod, corev1.VolumeMount{
+		Name:      common.AzureKeyVaultVolumeName,
+		MountPath: ptr.Deref(app.Spec.Azure.KeyVault.MountPath, common.DefaultAzureKeyVaultMountPath),
+		ReadOnly:  true,
+	})
+}
+
 func addVolume(pod *corev1.Pod, volume corev1.Volume) error {
 	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
 	return nil
@@ -396,6 +634,144 @@ func addPrometheusConfig(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addPrometheusAgent adds the Prometheus agent pushing the metrics served by the PrometheusServlet sink to the
+// remote-write endpoint of the application as a native sidecar of the driver pod, so that it does not keep the
+// pod running once the driver container has terminated.
+func addPrometheusAgent(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.IsDriverPod(pod) || !util.PrometheusRemoteWriteEnabled(app) {
+		return nil
+	}
+	if slices.ContainsFunc(pod.Spec.InitContainers, func(c corev1.Container) bool {
+		return c.Name == common.PrometheusAgentContainerName
+	}) {
+		return nil
+	}
+
+	remoteWrite := app.Spec.Monitoring.PrometheusServlet.RemoteWrite
+	configVolumeName := util.GetPrometheusAgentConfigMapName(app) + "-vol"
+	dataVolumeName := common.PrometheusAgentContainerName + "-data"
+	if err := addConfigMapVolume(pod, util.GetPrometheusAgentConfigMapName(app), configVolumeName); err != nil {
+		return err
+	}
+	_ = addVolume(pod, corev1.Volume{
+		Name:         dataVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	mounts := []corev1.VolumeMount{
+		{Name: configVolumeName, MountPath: common.PrometheusAgentConfigMountPath, ReadOnly: true},
+		{Name: dataVolumeName, MountPath: common.PrometheusAgentDataMountPath},
+	}
+	if secret := remoteWrite.BearerTokenSecret; secret != nil {
+		tokenVolumeName := common.PrometheusAgentContainerName + "-token"
+		_ = addVolume(pod, corev1.Volume{
+			Name: tokenVolumeName,
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+				SecretName: secret.Name,
+				Items:      []corev1.KeyToPath{{Key: secret.Key, Path: common.PrometheusAgentTokenKey}},
+				Optional:   secret.Optional,
+			}},
+		})
+		mounts = append(mounts, corev1.VolumeMount{Name: tokenVolumeName, MountPath: common.PrometheusAgentTokenMountPath, ReadOnly: true})
+	}
+
+	agent := corev1.Container{
+		Name:  common.PrometheusAgentContainerName,
+		Image: ptr.Deref(remoteWrite.Image, common.DefaultPrometheusAgentImage),
+		Args: []string{
+			"--agent",
+			fmt.Sprintf("--config.file=%s/%s", common.PrometheusAgentConfigMountPath, common.PrometheusAgentConfigKey),
+			fmt.Sprintf("--storage.agent.path=%s", common.PrometheusAgentDataMountPath),
+			fmt.Sprintf("--web.listen-address=:%d", common.PrometheusAgentPort),
+		},
+		RestartPolicy: ptr.To(corev1.ContainerRestartPolicyAlways),
+		VolumeMounts:  mounts,
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+		},
+	}
+	if app.Spec.Driver.SecurityContext != nil {
+		agent.SecurityContext = app.Spec.Driver.SecurityContext.DeepCopy()
+	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, agent)
+	return nil
+}
+
+func addLoggingConfig(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.JSONLoggingEnabled(app) {
+		return nil
+	}
+
+	name := util.GetLoggingConfigMapName(app)
+	volumeName := name + "-vol"
+	if err := addConfigMapVolume(pod, name, volumeName); err != nil {
+		return err
+	}
+	if err := addConfigMapVolumeMount(pod, volumeName, common.LoggingConfigMapMountPath); err != nil {
+		return fmt.Errorf("failed to mount volume %s in path %s: %v", volumeName, common.LoggingConfigMapMountPath, err)
+	}
+	return nil
+}
+
+// addDriverDiagnostics mounts the diagnostics volume into the driver container and, if the dumps are collected,
+// adds the collector sidecar which keeps the pod running once the driver container has terminated.
+func addDriverDiagnostics(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.IsDriverPod(pod) || !util.DriverDiagnosticsEnabled(app) {
+		return nil
+	}
+	if slices.ContainsFunc(pod.Spec.Volumes, func(v corev1.Volume) bool { return v.Name == common.DiagnosticsVolumeName }) {
+		return nil
+	}
+
+	diagnostics := app.Spec.Driver.Diagnostics
+	volume := corev1.Volume{Name: common.DiagnosticsVolumeName}
+	if diagnostics.ClaimName != nil {
+		volume.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{ClaimName: *diagnostics.ClaimName}
+	} else {
+		volume.EmptyDir = &corev1.EmptyDirVolumeSource{SizeLimit: diagnostics.SizeLimit}
+	}
+	mount := corev1.VolumeMount{Name: common.DiagnosticsVolumeName, MountPath: common.DiagnosticsMountPath}
+	_ = addVolume(pod, volume)
+	if err := addVolumeMount(pod, mount); err != nil {
+		return err
+	}
+
+	if !util.DriverDiagnosticsCollectionEnabled(app) {
+		return nil
+	}
+	i := findContainer(pod)
+	image := pod.Spec.Containers[i].Image
+	if image == "" {
+		// Containers of pod templates get their image from the submission.
+		image = ptr.Deref(app.Spec.Driver.Image, ptr.Deref(app.Spec.Image, ""))
+	}
+	collector := corev1.Container{
+		Name:         common.DiagnosticsCollectorContainerName,
+		Image:        image,
+		Command:      []string{"sh", "-c", diagnosticsCollectorScript},
+		VolumeMounts: []corev1.VolumeMount{mount},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("16Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+		},
+	}
+	if app.Spec.Driver.SecurityContext != nil {
+		collector.SecurityContext = app.Spec.Driver.SecurityContext.DeepCopy()
+	}
+	pod.Spec.Containers = append(pod.Spec.Containers, collector)
+	return nil
+}
+
 func addContainerPorts(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var ports []v1beta2.Port
 
@@ -465,6 +841,128 @@ func addAffinity(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addArch requires the pod to be scheduled on nodes of its CPU architecture. As the terms of a required node
+// affinity are ORed, the requirement is added to each of them.
+func addArch(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	var arch v1beta2.Arch
+	if util.IsDriverPod(pod) {
+		arch = util.GetDriverArch(app)
+	} else if util.IsExecutorPod(pod) {
+		arch = util.GetExecutorArch(app)
+	}
+	if arch == "" {
+		return nil
+	}
+
+	addRequiredNodeSelectorRequirement(pod, corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelArchStable,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{string(arch)},
+	})
+	return nil
+}
+
+// addRequiredNodeSelectorRequirement adds the given requirement to every required node selector term of the pod.
+func addRequiredNodeSelectorRequirement(pod *corev1.Pod, requirement corev1.NodeSelectorRequirement) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	selector := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(selector.NodeSelectorTerms) == 0 {
+		selector.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range selector.NodeSelectorTerms {
+		term := &selector.NodeSelectorTerms[i]
+		if !slices.ContainsFunc(term.MatchExpressions, func(r corev1.NodeSelectorRequirement) bool {
+			return reflect.DeepEqual(r, requirement)
+		}) {
+			term.MatchExpressions = append(term.MatchExpressions, requirement)
+		}
+	}
+}
+
+// addZoneColocation schedules executor pods in the zone of the driver pod if spec.placement.colocateInZone is set.
+// Once the controller recorded the zone of the driver, executors get a node affinity on it. Executors created before
+// that get a pod affinity on the driver pod with the zone as topology key instead.
+func addZoneColocation(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.IsExecutorPod(pod) || app.Spec.Placement == nil || app.Spec.Placement.ColocateInZone == nil {
+		return nil
+	}
+	required := *app.Spec.Placement.ColocateInZone == v1beta2.ZoneColocationRequired
+
+	if zone := app.Status.DriverInfo.Zone; zone != "" {
+		requirement := corev1.NodeSelectorRequirement{
+			Key:      corev1.LabelTopologyZone,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{zone},
+		}
+		if required {
+			addRequiredNodeSelectorRequirement(pod, requirement)
+			return nil
+		}
+		addPreferredSchedulingTerm(pod, corev1.PreferredSchedulingTerm{
+			Weight:     100,
+			Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{requirement}},
+		})
+		return nil
+	}
+
+	term := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				common.LabelSparkAppName: app.Name,
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+		},
+		TopologyKey: corev1.LabelTopologyZone,
+	}
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.PodAffinity == nil {
+		pod.Spec.Affinity.PodAffinity = &corev1.PodAffinity{}
+	}
+	podAffinity := pod.Spec.Affinity.PodAffinity
+	if required {
+		if !slices.ContainsFunc(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, func(t corev1.PodAffinityTerm) bool {
+			return reflect.DeepEqual(t, term)
+		}) {
+			podAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(podAffinity.RequiredDuringSchedulingIgnoredDuringExecution, term)
+		}
+		return nil
+	}
+	weighted := corev1.WeightedPodAffinityTerm{Weight: 100, PodAffinityTerm: term}
+	if !slices.ContainsFunc(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution, func(t corev1.WeightedPodAffinityTerm) bool {
+		return reflect.DeepEqual(t, weighted)
+	}) {
+		podAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(podAffinity.PreferredDuringSchedulingIgnoredDuringExecution, weighted)
+	}
+	return nil
+}
+
+// addPreferredSchedulingTerm adds the given preferred node affinity term to the pod unless it already has it.
+func addPreferredSchedulingTerm(pod *corev1.Pod, term corev1.PreferredSchedulingTerm) {
+	if pod.Spec.Affinity == nil {
+		pod.Spec.Affinity = &corev1.Affinity{}
+	}
+	if pod.Spec.Affinity.NodeAffinity == nil {
+		pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := pod.Spec.Affinity.NodeAffinity
+	if !slices.ContainsFunc(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, func(t corev1.PreferredSchedulingTerm) bool {
+		return reflect.DeepEqual(t, term)
+	}) {
+		nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution, term)
+	}
+}
+
 func addTolerations(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var tolerations []corev1.Toleration
 	if util.IsDriverPod(pod) {
@@ -481,6 +979,31 @@ func addTolerations(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addTopologySpreadConstraints adds the topology spread constraints of the executors to executor pods. Constraints
+// without a label selector are made to select the executors of the application.
+func addTopologySpreadConstraints(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.IsExecutorPod(pod) {
+		return nil
+	}
+
+	for _, constraint := range app.Spec.Executor.TopologySpreadConstraints {
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					common.LabelSparkAppName: app.Name,
+					common.LabelSparkRole:    common.SparkRoleExecutor,
+				},
+			}
+		}
+		if !slices.ContainsFunc(pod.Spec.TopologySpreadConstraints, func(c corev1.TopologySpreadConstraint) bool {
+			return reflect.DeepEqual(c, constraint)
+		}) {
+			pod.Spec.TopologySpreadConstraints = append(pod.Spec.TopologySpreadConstraints, constraint)
+		}
+	}
+	return nil
+}
+
 func addNodeSelectors(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var nodeSelector map[string]string
 	if util.IsDriverPod(pod) {
@@ -513,6 +1036,17 @@ func addDNSConfig(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addSubdomain makes the driver pod resolvable under the headless Service created by the operator for it.
+func addSubdomain(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if !util.IsDriverPod(pod) {
+		return nil
+	}
+	if name := util.GetDriverHeadlessServiceName(app); name != "" {
+		pod.Spec.Subdomain = name
+	}
+	return nil
+}
+
 func addSchedulerName(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var schedulerName *string
 	// NOTE: Preferred to use `BatchScheduler` if application spec has it configured.
@@ -586,6 +1120,53 @@ func addContainerSecurityContext(pod *corev1.Pod, app *v1beta2.SparkApplication)
 	return nil
 }
 
+// addRestrictedSecurityDefaults fills in the security settings required by the Pod Security Standards
+// `restricted` profile wherever they are left unset, unless the SparkApplication opts out through the
+// AnnotationRestrictedSecurityDefaults annotation. Settings given explicitly by users are preserved.
+func addRestrictedSecurityDefaults(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	if app.Annotations[common.AnnotationRestrictedSecurityDefaults] == "false" {
+		return nil
+	}
+
+	// Security contexts may be shared with the SparkApplication spec, so work on copies.
+	if pod.Spec.SecurityContext == nil {
+		pod.Spec.SecurityContext = &corev1.PodSecurityContext{}
+	} else {
+		pod.Spec.SecurityContext = pod.Spec.SecurityContext.DeepCopy()
+	}
+	if pod.Spec.SecurityContext.RunAsNonRoot == nil {
+		pod.Spec.SecurityContext.RunAsNonRoot = ptr.To(true)
+	}
+	if pod.Spec.SecurityContext.SeccompProfile == nil {
+		pod.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}
+	}
+
+	for i := range pod.Spec.InitContainers {
+		addRestrictedContainerSecurityDefaults(&pod.Spec.InitContainers[i])
+	}
+	for i := range pod.Spec.Containers {
+		addRestrictedContainerSecurityDefaults(&pod.Spec.Containers[i])
+	}
+	return nil
+}
+
+func addRestrictedContainerSecurityDefaults(container *corev1.Container) {
+	if container.SecurityContext == nil {
+		container.SecurityContext = &corev1.SecurityContext{}
+	} else {
+		container.SecurityContext = container.SecurityContext.DeepCopy()
+	}
+	if container.SecurityContext.AllowPrivilegeEscalation == nil {
+		container.SecurityContext.AllowPrivilegeEscalation = ptr.To(false)
+	}
+	if container.SecurityContext.Capabilities == nil {
+		container.SecurityContext.Capabilities = &corev1.Capabilities{}
+	}
+	if !slices.Contains(container.SecurityContext.Capabilities.Drop, "ALL") {
+		container.SecurityContext.Capabilities.Drop = append(container.SecurityContext.Capabilities.Drop, "ALL")
+	}
+}
+
 func addSidecarContainers(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var sidecars []corev1.Container
 	if util.IsDriverPod(pod) {
@@ -655,6 +1236,46 @@ func addGPU(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	return nil
 }
 
+// addResources adds the structured resources of the driver or executor to the Spark container. The cpu and
+// memory are left out as Spark sets those itself from the corresponding string-based fields.
+func addResources(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
+	var resources *corev1.ResourceRequirements
+	if util.IsDriverPod(pod) {
+		resources = app.Spec.Driver.Resources
+	}
+	if util.IsExecutorPod(pod) {
+		resources = app.Spec.Executor.Resources
+	}
+	if resources == nil {
+		return nil
+	}
+
+	i := findContainer(pod)
+	if i < 0 {
+		return fmt.Errorf("failed to add resources as Spark container was not found in pod %s", pod.Name)
+	}
+	container := &pod.Spec.Containers[i]
+	for name, quantity := range resources.Requests {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		if container.Resources.Requests == nil {
+			container.Resources.Requests = make(corev1.ResourceList)
+		}
+		container.Resources.Requests[name] = quantity
+	}
+	for name, quantity := range resources.Limits {
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory {
+			continue
+		}
+		if container.Resources.Limits == nil {
+			container.Resources.Limits = make(corev1.ResourceList)
+		}
+		container.Resources.Limits[name] = quantity
+	}
+	return nil
+}
+
 func addHostNetwork(pod *corev1.Pod, app *v1beta2.SparkApplication) error {
 	var hostNetwork *bool
 	if util.IsDriverPod(pod) {
@@ -695,8 +1316,14 @@ func addPodLifeCycleConfig(pod *corev1.Pod, app *v1beta2.SparkApplication) error
 	var lifeCycle *corev1.Lifecycle
 	if util.IsDriverPod(pod) {
 		lifeCycle = app.Spec.Driver.Lifecycle
+		if lifeCycle == nil && app.Spec.Hooks != nil {
+			lifeCycle = app.Spec.Hooks.Driver
+		}
 	} else if util.IsExecutorPod(pod) {
 		lifeCycle = app.Spec.Executor.Lifecycle
+		if lifeCycle == nil && app.Spec.Hooks != nil {
+			lifeCycle = app.Spec.Hooks.Executor
+		}
 	}
 	if lifeCycle == nil {
 		return nil
@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
@@ -310,6 +311,273 @@ func TestPatchSparkPod_Volumes(t *testing.T) {
 	assert.Equal(t, app.Spec.Driver.VolumeMounts[1], modifiedPod.Spec.Containers[0].VolumeMounts[2])
 }
 
+func TestPatchSparkPod_VaultSecrets(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Secrets: []v1beta2.SecretInfo{
+						{Name: "secret/data/spark/s3", Path: "/etc/s3", Type: v1beta2.SecretTypeVault, Vault: &v1beta2.VaultSecret{Role: "spark"}},
+						{
+							Name: "jdbc",
+							Path: "/etc/jdbc",
+							Type: v1beta2.SecretTypeVault,
+							Vault: &v1beta2.VaultSecret{
+								Injector:            v1beta2.VaultInjectorCSI,
+								SecretProviderClass: "vault-jdbc",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedPod, err := getModifiedPod(pod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the secret injected by the CSI driver is mounted.
+	assert.Equal(t, []corev1.Volume{{
+		Name: "vault-secret-1",
+		VolumeSource: corev1.VolumeSource{
+			CSI: &corev1.CSIVolumeSource{
+				Driver:           common.SecretsStoreCSIDriver,
+				ReadOnly:         ptr.To(true),
+				VolumeAttributes: map[string]string{"secretProviderClass": "vault-jdbc"},
+			},
+		},
+	}}, modifiedPod.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{Name: "vault-secret-1", MountPath: "/etc/jdbc", ReadOnly: true}}, modifiedPod.Spec.Containers[0].VolumeMounts)
+}
+
+func TestPatchSparkPod_AWSWebIdentity(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			CloudStorage: &v1beta2.CloudStorageSpec{
+				S3: &v1beta2.S3StorageSpec{
+					Region:  ptr.To("eu-west-1"),
+					RoleARN: ptr.To("arn:aws:iam::123456789012:role/spark"),
+				},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+				},
+			},
+		},
+	}
+
+	modifiedPod, err := getModifiedPod(pod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []corev1.Volume{{
+		Name: "spark-aws-iam-token",
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          "sts.amazonaws.com",
+						ExpirationSeconds: ptr.To[int64](86400),
+						Path:              "token",
+					},
+				}},
+			},
+		},
+	}}, modifiedPod.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{{
+		Name:      "spark-aws-iam-token",
+		MountPath: "/var/run/secrets/sparkoperator.k8s.io/aws-iam-token",
+		ReadOnly:  true,
+	}}, modifiedPod.Spec.Containers[0].VolumeMounts)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "AWS_ROLE_ARN", Value: "arn:aws:iam::123456789012:role/spark"},
+		{Name: "AWS_WEB_IDENTITY_TOKEN_FILE", Value: "/var/run/secrets/sparkoperator.k8s.io/aws-iam-token/token"},
+		{Name: "AWS_REGION", Value: "eu-west-1"},
+	}, modifiedPod.Spec.Containers[0].Env)
+}
+
+func TestPatchSparkPod_AWSWebIdentityPerRole(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{IAMRoleARN: ptr.To("arn:aws:iam::123456789012:role/driver")},
+			},
+			CloudStorage: &v1beta2.CloudStorageSpec{
+				S3: &v1beta2.S3StorageSpec{RoleARN: ptr.To("arn:aws:iam::123456789012:role/spark")},
+			},
+		},
+	}
+
+	for role, wantRoleARN := range map[string]string{
+		common.SparkRoleDriver:   "arn:aws:iam::123456789012:role/driver",
+		common.SparkRoleExecutor: "arn:aws:iam::123456789012:role/spark",
+	} {
+		t.Run(role, func(t *testing.T) {
+			containerName := common.SparkDriverContainerName
+			if role == common.SparkRoleExecutor {
+				containerName = common.SparkExecutorContainerName
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "spark-" + role,
+					Labels: map[string]string{
+						common.LabelSparkRole:               role,
+						common.LabelLaunchedBySparkOperator: "true",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: containerName, Image: "spark:latest"}},
+				},
+			}
+
+			modifiedPod, err := getModifiedPod(pod, app)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Contains(t, modifiedPod.Spec.Containers[0].Env, corev1.EnvVar{Name: "AWS_ROLE_ARN", Value: wantRoleARN})
+		})
+	}
+}
+
+func TestPatchSparkPod_AzureWorkloadIdentity(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Azure: &v1beta2.AzureSpec{
+				WorkloadIdentity: &v1beta2.AzureWorkloadIdentity{
+					ClientID: "00000000-0000-0000-0000-000000000001",
+					TenantID: "00000000-0000-0000-0000-000000000002",
+				},
+				KeyVault: &v1beta2.AzureKeyVault{
+					Name:    "spark-vault",
+					Objects: []v1beta2.AzureKeyVaultObject{{Name: "db-password"}},
+				},
+			},
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedPod, err := getModifiedPod(pod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []corev1.Volume{
+		{
+			Name: "azure-identity-token",
+			VolumeSource: corev1.VolumeSource{
+				Projected: &corev1.ProjectedVolumeSource{
+					Sources: []corev1.VolumeProjection{{
+						ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+							Audience:          "api://AzureADTokenExchange",
+							ExpirationSeconds: ptr.To[int64](3600),
+							Path:              "azure-identity-token",
+						},
+					}},
+				},
+			},
+		},
+		{
+			Name: "azure-key-vault",
+			VolumeSource: corev1.VolumeSource{
+				CSI: &corev1.CSIVolumeSource{
+					Driver:           "secrets-store.csi.k8s.io",
+					ReadOnly:         ptr.To(true),
+					VolumeAttributes: map[string]string{"secretProviderClass": "spark-test-azure-key-vault"},
+				},
+			},
+		},
+	}, modifiedPod.Spec.Volumes)
+	assert.Equal(t, []corev1.VolumeMount{
+		{Name: "azure-identity-token", MountPath: "/var/run/secrets/azure/tokens", ReadOnly: true},
+		{Name: "azure-key-vault", MountPath: "/etc/spark/azure-key-vault", ReadOnly: true},
+	}, modifiedPod.Spec.Containers[0].VolumeMounts)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "AZURE_CLIENT_ID", Value: "00000000-0000-0000-0000-000000000001"},
+		{Name: "AZURE_TENANT_ID", Value: "00000000-0000-0000-0000-000000000002"},
+		{Name: "AZURE_FEDERATED_TOKEN_FILE", Value: "/var/run/secrets/azure/tokens/azure-identity-token"},
+		{Name: "AZURE_AUTHORITY_HOST", Value: "https://login.microsoftonline.com/"},
+	}, modifiedPod.Spec.Containers[0].Env)
+
+	// The token is not projected again in pods mutated by the Azure Workload Identity webhook.
+	pod.Spec.Volumes = []corev1.Volume{{Name: "azure-identity-token"}}
+	app.Spec.Azure.KeyVault = nil
+	modifiedPod, err = getModifiedPod(pod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []corev1.Volume{{Name: "azure-identity-token"}}, modifiedPod.Spec.Volumes)
+	assert.Empty(t, modifiedPod.Spec.Containers[0].Env)
+}
+
 func TestPatchSparkPod_Affinity(t *testing.T) {
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
@@ -366,6 +634,90 @@ func TestPatchSparkPod_Affinity(t *testing.T) {
 		modifiedPod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution[0].TopologyKey)
 }
 
+func TestPatchSparkPod_Arch(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Arch: ptr.To(v1beta2.ArchAMD64),
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Arch: ptr.To(v1beta2.ArchARM64),
+					Affinity: &corev1.Affinity{
+						NodeAffinity: &corev1.NodeAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+								NodeSelectorTerms: []corev1.NodeSelectorTerm{
+									{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}}},
+									{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	amd64 := corev1.NodeSelectorRequirement{Key: corev1.LabelArchStable, Operator: corev1.NodeSelectorOpIn, Values: []string{"amd64"}}
+	terms := modifiedDriverPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Equal(t, []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{amd64}}}, terms)
+
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+				},
+			},
+		},
+	}
+
+	// The requirement is added to every term, as a node only has to match one of them.
+	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	terms = modifiedExecutorPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	assert.Len(t, terms, 2)
+	for _, term := range terms {
+		assert.Len(t, term.MatchExpressions, 2)
+		assert.Equal(t, []string{"arm64"}, term.MatchExpressions[1].Values)
+	}
+	assert.Len(t, app.Spec.Executor.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions, 1)
+}
+
 func TestPatchSparkPod_ConfigMaps(t *testing.T) {
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
@@ -417,48 +769,242 @@ func TestPatchSparkPod_ConfigMaps(t *testing.T) {
 	assert.Equal(t, "/path/to/bar", modifiedPod.Spec.Containers[0].VolumeMounts[1].MountPath)
 }
 
-func TestPatchSparkPod_SparkConfigMap(t *testing.T) {
-	sparkConfMapName := "spark-conf"
+func TestPatchSparkPod_SparkConfigMap(t *testing.T) {
+	sparkConfMapName := "spark-conf"
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			SparkConfigMap: &sparkConfMapName,
+		},
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedPod, err := getModifiedPod(pod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Len(t, modifiedPod.Spec.Volumes, 1)
+	assert.Equal(t, common.SparkConfigMapVolumeName, modifiedPod.Spec.Volumes[0].Name)
+	assert.NotNil(t, modifiedPod.Spec.Volumes[0].ConfigMap)
+	assert.Len(t, modifiedPod.Spec.Containers[0].VolumeMounts, 1)
+	assert.Equal(t, common.DefaultSparkConfDir, modifiedPod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	assert.Len(t, modifiedPod.Spec.Containers[0].Env, 1)
+	assert.Equal(t, common.DefaultSparkConfDir, modifiedPod.Spec.Containers[0].Env[0].Value)
+}
+
+func TestPatchSparkPod_LoggingConfigMap(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Logging: &v1beta2.LoggingSpec{Format: v1beta2.LogFormatJSON},
+		},
+	}
+
+	for _, role := range []string{common.SparkRoleDriver, common.SparkRoleExecutor} {
+		containerName := common.SparkDriverContainerName
+		if role == common.SparkRoleExecutor {
+			containerName = common.SparkExecutorContainerName
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "spark-" + role,
+				Labels: map[string]string{
+					common.LabelSparkRole:               role,
+					common.LabelLaunchedBySparkOperator: "true",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  containerName,
+						Image: "spark:latest",
+					},
+				},
+			},
+		}
+
+		modifiedPod, err := getModifiedPod(pod, app)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Len(t, modifiedPod.Spec.Volumes, 1)
+		assert.Equal(t, "spark-test-log4j2-vol", modifiedPod.Spec.Volumes[0].Name)
+		assert.NotNil(t, modifiedPod.Spec.Volumes[0].ConfigMap)
+		assert.Equal(t, "spark-test-log4j2", modifiedPod.Spec.Volumes[0].ConfigMap.Name)
+		assert.Len(t, modifiedPod.Spec.Containers[0].VolumeMounts, 1)
+		assert.Equal(t, common.LoggingConfigMapMountPath, modifiedPod.Spec.Containers[0].VolumeMounts[0].MountPath)
+	}
+}
+
+func TestPatchSparkPod_DriverDiagnostics(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Image: ptr.To("spark:latest"),
+			Driver: v1beta2.DriverSpec{
+				Diagnostics: &v1beta2.DriverDiagnostics{
+					SizeLimit: ptr.To(resource.MustParse("2Gi")),
+				},
+			},
+		},
+	}
+	newPod := func(role string) *corev1.Pod {
+		containerName := common.SparkDriverContainerName
+		if role == common.SparkRoleExecutor {
+			containerName = common.SparkExecutorContainerName
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "spark-" + role,
+				Labels: map[string]string{
+					common.LabelSparkRole:               role,
+					common.LabelLaunchedBySparkOperator: "true",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: containerName, Image: "spark:latest"}},
+			},
+		}
+	}
+
+	// Executors are left untouched.
+	modifiedPod, err := getModifiedPod(newPod(common.SparkRoleExecutor), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, modifiedPod.Spec.Volumes)
+
+	// Dumps are written to an emptyDir without collector by default.
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleDriver), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedPod.Spec.Volumes, 1)
+	assert.Equal(t, common.DiagnosticsVolumeName, modifiedPod.Spec.Volumes[0].Name)
+	assert.Equal(t, "2Gi", modifiedPod.Spec.Volumes[0].EmptyDir.SizeLimit.String())
+	assert.Equal(t, []corev1.VolumeMount{{Name: common.DiagnosticsVolumeName, MountPath: common.DiagnosticsMountPath}}, modifiedPod.Spec.Containers[0].VolumeMounts)
+	assert.Len(t, modifiedPod.Spec.Containers, 1)
+
+	// Collection writes to the claim and adds the collector sidecar mounting the same volume.
+	app.Spec.Driver.Diagnostics = &v1beta2.DriverDiagnostics{
+		ClaimName: ptr.To("spark-dumps"),
+		Collect:   ptr.To(true),
+	}
+	app.Spec.Driver.SecurityContext = &corev1.SecurityContext{RunAsUser: ptr.To[int64](185)}
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleDriver), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedPod.Spec.Volumes, 1)
+	assert.Equal(t, "spark-dumps", modifiedPod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Len(t, modifiedPod.Spec.Containers, 2)
+	collector := modifiedPod.Spec.Containers[1]
+	assert.Equal(t, common.DiagnosticsCollectorContainerName, collector.Name)
+	assert.Equal(t, "spark:latest", collector.Image)
+	assert.Equal(t, []corev1.VolumeMount{{Name: common.DiagnosticsVolumeName, MountPath: common.DiagnosticsMountPath}}, collector.VolumeMounts)
+	assert.Equal(t, ptr.To[int64](185), collector.SecurityContext.RunAsUser)
+}
+
+func TestPatchSparkPod_PrometheusAgent(t *testing.T) {
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "spark-test",
 			UID:  "spark-test-1",
 		},
 		Spec: v1beta2.SparkApplicationSpec{
-			SparkConfigMap: &sparkConfMapName,
-		},
-	}
-
-	pod := &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: "spark-driver",
-			Labels: map[string]string{
-				common.LabelSparkRole:               common.SparkRoleDriver,
-				common.LabelLaunchedBySparkOperator: "true",
+			Monitoring: &v1beta2.MonitoringSpec{
+				ExposeDriverMetrics: true,
+				PrometheusServlet: &v1beta2.PrometheusServletSpec{
+					RemoteWrite: &v1beta2.PrometheusRemoteWriteSpec{
+						URL: "https://prometheus.example.com/api/v1/write",
+						BearerTokenSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "remote-write"},
+							Key:                  "bearer-token",
+						},
+					},
+				},
 			},
 		},
-		Spec: corev1.PodSpec{
-			Containers: []corev1.Container{
-				{
-					Name:  common.SparkDriverContainerName,
-					Image: "spark-driver:latest",
+	}
+	newPod := func(role string) *corev1.Pod {
+		containerName := common.SparkDriverContainerName
+		if role == common.SparkRoleExecutor {
+			containerName = common.SparkExecutorContainerName
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "spark-" + role,
+				Labels: map[string]string{
+					common.LabelSparkRole:               role,
+					common.LabelLaunchedBySparkOperator: "true",
 				},
 			},
-		},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: containerName, Image: "spark:latest"}},
+			},
+		}
 	}
 
-	modifiedPod, err := getModifiedPod(pod, app)
+	// Executor metrics are served by the driver, so executors are left untouched.
+	modifiedPod, err := getModifiedPod(newPod(common.SparkRoleExecutor), app)
 	if err != nil {
 		t.Fatal(err)
 	}
+	assert.Empty(t, modifiedPod.Spec.Volumes)
+	assert.Empty(t, modifiedPod.Spec.InitContainers)
 
-	assert.Len(t, modifiedPod.Spec.Volumes, 1)
-	assert.Equal(t, common.SparkConfigMapVolumeName, modifiedPod.Spec.Volumes[0].Name)
-	assert.NotNil(t, modifiedPod.Spec.Volumes[0].ConfigMap)
-	assert.Len(t, modifiedPod.Spec.Containers[0].VolumeMounts, 1)
-	assert.Equal(t, common.DefaultSparkConfDir, modifiedPod.Spec.Containers[0].VolumeMounts[0].MountPath)
-	assert.Len(t, modifiedPod.Spec.Containers[0].Env, 1)
-	assert.Equal(t, common.DefaultSparkConfDir, modifiedPod.Spec.Containers[0].Env[0].Value)
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleDriver), app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedPod.Spec.Volumes, 3)
+	assert.Equal(t, "spark-test-prom-agent-conf", modifiedPod.Spec.Volumes[0].ConfigMap.Name)
+	assert.NotNil(t, modifiedPod.Spec.Volumes[1].EmptyDir)
+	assert.Equal(t, "remote-write", modifiedPod.Spec.Volumes[2].Secret.SecretName)
+	assert.Equal(t, []corev1.KeyToPath{{Key: "bearer-token", Path: common.PrometheusAgentTokenKey}}, modifiedPod.Spec.Volumes[2].Secret.Items)
+	assert.Len(t, modifiedPod.Spec.Containers, 1)
+	assert.Len(t, modifiedPod.Spec.InitContainers, 1)
+	agent := modifiedPod.Spec.InitContainers[0]
+	assert.Equal(t, common.PrometheusAgentContainerName, agent.Name)
+	assert.Equal(t, common.DefaultPrometheusAgentImage, agent.Image)
+	assert.Equal(t, ptr.To(corev1.ContainerRestartPolicyAlways), agent.RestartPolicy)
+	assert.Contains(t, agent.Args, "--config.file=/etc/prometheus-agent/prometheus-agent.yaml")
+	assert.Len(t, agent.VolumeMounts, 3)
+
+	// The agent is not added twice.
+	modifiedPod, err = getModifiedPod(modifiedPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedPod.Spec.InitContainers, 1)
 }
 
 func TestPatchSparkPod_HadoopConfigMap(t *testing.T) {
@@ -710,6 +1256,70 @@ func TestPatchSparkPod_SecurityContext(t *testing.T) {
 	assert.Equal(t, app.Spec.Executor.SecurityContext, modifiedExecutorPod.Spec.Containers[0].SecurityContext)
 }
 
+func TestPatchSparkPod_RestrictedSecurityDefaults(t *testing.T) {
+	var user int64 = 185
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					PodSecurityContext: &corev1.PodSecurityContext{
+						RunAsUser:    &user,
+						RunAsNonRoot: ptr.To(false),
+					},
+				},
+			},
+		},
+	}
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := addRestrictedSecurityDefaults(modifiedDriverPod, app); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &user, modifiedDriverPod.Spec.SecurityContext.RunAsUser)
+	assert.Equal(t, ptr.To(false), modifiedDriverPod.Spec.SecurityContext.RunAsNonRoot)
+	assert.Equal(t, &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault}, modifiedDriverPod.Spec.SecurityContext.SeccompProfile)
+	assert.Equal(t, ptr.To(false), modifiedDriverPod.Spec.Containers[0].SecurityContext.AllowPrivilegeEscalation)
+	assert.Equal(t, []corev1.Capability{"ALL"}, modifiedDriverPod.Spec.Containers[0].SecurityContext.Capabilities.Drop)
+
+	optedOutApp := app.DeepCopy()
+	optedOutApp.Annotations = map[string]string{common.AnnotationRestrictedSecurityDefaults: "false"}
+	optedOutPod, err := getModifiedPod(driverPod, optedOutApp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := addRestrictedSecurityDefaults(optedOutPod, optedOutApp); err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, optedOutPod.Spec.SecurityContext.SeccompProfile)
+	assert.Nil(t, optedOutPod.Spec.Containers[0].SecurityContext)
+}
+
 func TestPatchSparkPod_SchedulerName(t *testing.T) {
 	var schedulerName = "another_scheduler"
 	var defaultScheduler = "default-scheduler"
@@ -921,9 +1531,177 @@ func TestPatchSparkPod_Sidecars(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Len(t, modifiedDriverPod.Spec.Containers, 3)
-	assert.Equal(t, "sidecar1", modifiedDriverPod.Spec.Containers[1].Name)
-	assert.Equal(t, "sidecar2", modifiedDriverPod.Spec.Containers[2].Name)
+	assert.Len(t, modifiedDriverPod.Spec.Containers, 3)
+	assert.Equal(t, "sidecar1", modifiedDriverPod.Spec.Containers[1].Name)
+	assert.Equal(t, "sidecar2", modifiedDriverPod.Spec.Containers[2].Name)
+
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+				},
+			},
+		},
+	}
+
+	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedExecutorPod.Spec.Containers, 3)
+	assert.Equal(t, "sidecar1", modifiedExecutorPod.Spec.Containers[1].Name)
+	assert.Equal(t, "sidecar2", modifiedExecutorPod.Spec.Containers[2].Name)
+}
+
+func TestPatchSparkPod_InitContainers(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name:  "init-container1",
+							Image: "init-container1:latest",
+						},
+						{
+							Name:  "init-container2",
+							Image: "init-container2:latest",
+						},
+					},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name:  "init-container1",
+							Image: "init-container1:latest",
+						},
+						{
+							Name:  "init-container2",
+							Image: "init-container2:latest",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedDriverPod.Spec.InitContainers, 2)
+	assert.Equal(t, "init-container1", modifiedDriverPod.Spec.InitContainers[0].Name)
+	assert.Equal(t, "init-container2", modifiedDriverPod.Spec.InitContainers[1].Name)
+
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+				},
+			},
+		},
+	}
+
+	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, modifiedExecutorPod.Spec.InitContainers, 2)
+	assert.Equal(t, "init-container1", modifiedExecutorPod.Spec.InitContainers[0].Name)
+	assert.Equal(t, "init-container2", modifiedExecutorPod.Spec.InitContainers[1].Name)
+}
+
+func TestPatchSparkPod_DNSConfig(t *testing.T) {
+	aVal := "5"
+	sampleDNSConfig := &corev1.PodDNSConfig{
+		Nameservers: []string{"8.8.8.8", "4.4.4.4"},
+		Searches:    []string{"svc.cluster.local", "cluster.local"},
+		Options: []corev1.PodDNSConfigOption{
+			{Name: "ndots", Value: &aVal},
+		},
+	}
+
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Driver: v1beta2.DriverSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{DNSConfig: sampleDNSConfig},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{DNSConfig: sampleDNSConfig},
+			},
+		},
+	}
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotNil(t, modifiedDriverPod.Spec.DNSConfig)
+	assert.Equal(t, sampleDNSConfig, modifiedDriverPod.Spec.DNSConfig)
 
 	executorPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -947,12 +1725,12 @@ func TestPatchSparkPod_Sidecars(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Len(t, modifiedExecutorPod.Spec.Containers, 3)
-	assert.Equal(t, "sidecar1", modifiedExecutorPod.Spec.Containers[1].Name)
-	assert.Equal(t, "sidecar2", modifiedExecutorPod.Spec.Containers[2].Name)
+
+	assert.NotNil(t, modifiedExecutorPod.Spec.DNSConfig)
+	assert.Equal(t, sampleDNSConfig, modifiedExecutorPod.Spec.DNSConfig)
 }
 
-func TestPatchSparkPod_InitContainers(t *testing.T) {
+func TestPatchSparkPod_Subdomain(t *testing.T) {
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "spark-test",
@@ -960,31 +1738,8 @@ func TestPatchSparkPod_InitContainers(t *testing.T) {
 		},
 		Spec: v1beta2.SparkApplicationSpec{
 			Driver: v1beta2.DriverSpec{
-				SparkPodSpec: v1beta2.SparkPodSpec{
-					InitContainers: []corev1.Container{
-						{
-							Name:  "init-container1",
-							Image: "init-container1:latest",
-						},
-						{
-							Name:  "init-container2",
-							Image: "init-container2:latest",
-						},
-					},
-				},
-			},
-			Executor: v1beta2.ExecutorSpec{
-				SparkPodSpec: v1beta2.SparkPodSpec{
-					InitContainers: []corev1.Container{
-						{
-							Name:  "init-container1",
-							Image: "init-container1:latest",
-						},
-						{
-							Name:  "init-container2",
-							Image: "init-container2:latest",
-						},
-					},
+				HeadlessService: &v1beta2.DriverHeadlessServiceSpec{
+					Name: ptr.To("spark-test-driver-headless"),
 				},
 			},
 		},
@@ -1007,14 +1762,11 @@ func TestPatchSparkPod_InitContainers(t *testing.T) {
 			},
 		},
 	}
-
 	modifiedDriverPod, err := getModifiedPod(driverPod, app)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Len(t, modifiedDriverPod.Spec.InitContainers, 2)
-	assert.Equal(t, "init-container1", modifiedDriverPod.Spec.InitContainers[0].Name)
-	assert.Equal(t, "init-container2", modifiedDriverPod.Spec.InitContainers[1].Name)
+	assert.Equal(t, "spark-test-driver-headless", modifiedDriverPod.Spec.Subdomain)
 
 	executorPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1033,91 +1785,180 @@ func TestPatchSparkPod_InitContainers(t *testing.T) {
 			},
 		},
 	}
-
 	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.Len(t, modifiedExecutorPod.Spec.InitContainers, 2)
-	assert.Equal(t, "init-container1", modifiedExecutorPod.Spec.InitContainers[0].Name)
-	assert.Equal(t, "init-container2", modifiedExecutorPod.Spec.InitContainers[1].Name)
+	assert.Empty(t, modifiedExecutorPod.Spec.Subdomain)
 }
 
-func TestPatchSparkPod_DNSConfig(t *testing.T) {
-	aVal := "5"
-	sampleDNSConfig := &corev1.PodDNSConfig{
-		Nameservers: []string{"8.8.8.8", "4.4.4.4"},
-		Searches:    []string{"svc.cluster.local", "cluster.local"},
-		Options: []corev1.PodDNSConfigOption{
-			{Name: "ndots", Value: &aVal},
-		},
+func TestPatchSparkPod_TopologySpreadConstraints(t *testing.T) {
+	zone := corev1.TopologySpreadConstraint{
+		MaxSkew:           1,
+		TopologyKey:       corev1.LabelTopologyZone,
+		WhenUnsatisfiable: corev1.ScheduleAnyway,
+	}
+	host := corev1.TopologySpreadConstraint{
+		MaxSkew:           2,
+		TopologyKey:       corev1.LabelHostname,
+		WhenUnsatisfiable: corev1.DoNotSchedule,
+		LabelSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"team": "analytics"}},
 	}
-
 	app := &v1beta2.SparkApplication{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "spark-test",
 			UID:  "spark-test-1",
 		},
 		Spec: v1beta2.SparkApplicationSpec{
-			Driver: v1beta2.DriverSpec{
-				SparkPodSpec: v1beta2.SparkPodSpec{DNSConfig: sampleDNSConfig},
-			},
 			Executor: v1beta2.ExecutorSpec{
-				SparkPodSpec: v1beta2.SparkPodSpec{DNSConfig: sampleDNSConfig},
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zone, host},
 			},
 		},
 	}
 
-	driverPod := &corev1.Pod{
+	executorPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "spark-driver",
+			Name: "spark-executor",
 			Labels: map[string]string{
-				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelSparkRole:               common.SparkRoleExecutor,
 				common.LabelLaunchedBySparkOperator: "true",
 			},
 		},
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
 				{
-					Name:  common.SparkDriverContainerName,
-					Image: "spark-driver:latest",
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
 				},
 			},
 		},
 	}
-
-	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
 	if err != nil {
 		t.Fatal(err)
 	}
-	assert.NotNil(t, modifiedDriverPod.Spec.DNSConfig)
-	assert.Equal(t, sampleDNSConfig, modifiedDriverPod.Spec.DNSConfig)
+	zone.LabelSelector = &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			common.LabelSparkAppName: "spark-test",
+			common.LabelSparkRole:    common.SparkRoleExecutor,
+		},
+	}
+	assert.Equal(t, []corev1.TopologySpreadConstraint{zone, host}, modifiedExecutorPod.Spec.TopologySpreadConstraints)
 
-	executorPod := &corev1.Pod{
+	driverPod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "spark-executor",
+			Name: "spark-driver",
 			Labels: map[string]string{
-				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelSparkRole:               common.SparkRoleDriver,
 				common.LabelLaunchedBySparkOperator: "true",
 			},
 		},
 		Spec: corev1.PodSpec{
 			Containers: []corev1.Container{
 				{
-					Name:  common.SparkExecutorContainerName,
-					Image: "spark-executor:latest",
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
 				},
 			},
 		},
 	}
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, modifiedDriverPod.Spec.TopologySpreadConstraints)
+}
 
-	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
+func TestPatchSparkPod_ZoneColocation(t *testing.T) {
+	newApp := func(colocation v1beta2.ZoneColocation, zone string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "spark-test",
+				UID:  "spark-test-1",
+			},
+			Spec: v1beta2.SparkApplicationSpec{
+				Placement: &v1beta2.PlacementSpec{ColocateInZone: ptr.To(colocation)},
+			},
+			Status: v1beta2.SparkApplicationStatus{
+				DriverInfo: v1beta2.DriverInfo{Zone: zone},
+			},
+		}
+	}
+	newPod := func(role string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "spark-" + role,
+				Labels: map[string]string{
+					common.LabelSparkRole:               role,
+					common.LabelLaunchedBySparkOperator: "true",
+				},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:  common.SparkExecutorContainerName,
+						Image: "spark-executor:latest",
+					},
+				},
+			},
+		}
+	}
+	zoneRequirement := corev1.NodeSelectorRequirement{
+		Key:      corev1.LabelTopologyZone,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   []string{"us-east-1a"},
+	}
+	driverTerm := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{
+				common.LabelSparkAppName: "spark-test",
+				common.LabelSparkRole:    common.SparkRoleDriver,
+			},
+		},
+		TopologyKey: corev1.LabelTopologyZone,
+	}
+
+	// A known zone of the driver is required through node affinity.
+	modifiedPod, err := getModifiedPod(newPod(common.SparkRoleExecutor), newApp(v1beta2.ZoneColocationRequired, "us-east-1a"))
 	if err != nil {
 		t.Fatal(err)
 	}
+	assert.Equal(t, []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{zoneRequirement}}},
+		modifiedPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+	assert.Nil(t, modifiedPod.Spec.Affinity.PodAffinity)
 
-	assert.NotNil(t, modifiedExecutorPod.Spec.DNSConfig)
-	assert.Equal(t, sampleDNSConfig, modifiedExecutorPod.Spec.DNSConfig)
+	// A known zone of the driver is preferred through node affinity.
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleExecutor), newApp(v1beta2.ZoneColocationPreferred, "us-east-1a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, modifiedPod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+	assert.Equal(t, []corev1.PreferredSchedulingTerm{{
+		Weight:     100,
+		Preference: corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{zoneRequirement}},
+	}}, modifiedPod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+
+	// Without a known zone, executors fall back to pod affinity on the driver pod.
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleExecutor), newApp(v1beta2.ZoneColocationRequired, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, modifiedPod.Spec.Affinity.NodeAffinity)
+	assert.Equal(t, []corev1.PodAffinityTerm{driverTerm}, modifiedPod.Spec.Affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution)
+
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleExecutor), newApp(v1beta2.ZoneColocationPreferred, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []corev1.WeightedPodAffinityTerm{{Weight: 100, PodAffinityTerm: driverTerm}},
+		modifiedPod.Spec.Affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution)
+
+	// The driver pod is left untouched.
+	modifiedPod, err = getModifiedPod(newPod(common.SparkRoleDriver), newApp(v1beta2.ZoneColocationRequired, "us-east-1a"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Nil(t, modifiedPod.Spec.Affinity)
 }
 
 func TestPatchSparkPod_NodeSector(t *testing.T) {
@@ -1992,6 +2833,85 @@ func TestPatchSparkPod_Lifecycle(t *testing.T) {
 	}
 }
 
+func TestPatchSparkPod_HooksLifecycle(t *testing.T) {
+	preStopTest := &corev1.ExecAction{
+		Command: []string{"/bin/sh", "-c", "echo Hello from the pre stop hook > /usr/share/message"},
+	}
+	postStartTest := &corev1.ExecAction{
+		Command: []string{"/bin/sh", "-c", "echo Hello from the post start hook > /usr/share/message"},
+	}
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Hooks: &v1beta2.Hooks{
+				Driver: &corev1.Lifecycle{
+					PreStop: &corev1.LifecycleHandler{Exec: preStopTest},
+				},
+				Executor: &corev1.Lifecycle{
+					PreStop: &corev1.LifecycleHandler{Exec: preStopTest},
+				},
+			},
+			Executor: v1beta2.ExecutorSpec{
+				Lifecycle: &corev1.Lifecycle{
+					PostStart: &corev1.LifecycleHandler{Exec: postStartTest},
+				},
+			},
+		},
+	}
+
+	driverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-driver",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleDriver,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkDriverContainerName,
+					Image: "spark-driver:latest",
+				},
+			},
+		},
+	}
+
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+				},
+			},
+		},
+	}
+
+	modifiedDriverPod, err := getModifiedPod(driverPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modifiedExecutorPod, err := getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The driver falls back to the lifecycle hook while the executor lifecycle takes precedence.
+	assert.Equal(t, preStopTest, modifiedDriverPod.Spec.Containers[0].Lifecycle.PreStop.Exec)
+	assert.Nil(t, modifiedExecutorPod.Spec.Containers[0].Lifecycle.PreStop)
+	assert.Equal(t, postStartTest, modifiedExecutorPod.Spec.Containers[0].Lifecycle.PostStart.Exec)
+}
+
 func getModifiedPod(old *corev1.Pod, app *v1beta2.SparkApplication) (*corev1.Pod, error) {
 	newPod := old.DeepCopy()
 	if err := mutateSparkPod(newPod, app); err != nil {
@@ -2348,4 +3268,80 @@ func TestPatchSparkPod_MemoryLimit(t *testing.T) {
 	assert.Equal(t, "10Gi", expectedExecutorMemoryLimit.String())
 	assert.NotEqual(t, expectedExecutorMemoryRequest.String(), expectedExecutorMemoryLimit.String())
 
+	// The memory limit increased after the executors were OOMKilled takes precedence over the spec.
+	app.Status.MemoryAdjustments = []v1beta2.MemoryAdjustment{
+		{Role: common.SparkRoleExecutor, Memory: "1229m", MemoryLimit: ptr.To("12Gi"), Adjustments: 1},
+	}
+	modifiedExecutorPod, err = getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedExecutorMemoryLimit = modifiedExecutorPod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory]
+	assert.Equal(t, "12Gi", expectedExecutorMemoryLimit.String())
+}
+
+func TestPatchSparkPod_Resources(t *testing.T) {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-test-resources",
+			UID:  "spark-test-1",
+		},
+		Spec: v1beta2.SparkApplicationSpec{
+			Executor: v1beta2.ExecutorSpec{
+				SparkPodSpec: v1beta2.SparkPodSpec{
+					Resources: &corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:              resource.MustParse("2"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceMemory:           resource.MustParse("8Gi"),
+							corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+							"hugepages-2Mi":                 resource.MustParse("1Gi"),
+							"example.com/fpga":              resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	executorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "spark-executor",
+			Labels: map[string]string{
+				common.LabelSparkRole:               common.SparkRoleExecutor,
+				common.LabelLaunchedBySparkOperator: "true",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name:  common.SparkExecutorContainerName,
+					Image: "spark-executor:latest",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("1"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	modifiedPod, err := getModifiedPod(executorPod, app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The cpu and memory are set by Spark and left as is.
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceCPU:              resource.MustParse("1"),
+		corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+	}, modifiedPod.Spec.Containers[0].Resources.Requests)
+	assert.Equal(t, corev1.ResourceList{
+		corev1.ResourceEphemeralStorage: resource.MustParse("20Gi"),
+		"hugepages-2Mi":                 resource.MustParse("1Gi"),
+		"example.com/fpga":              resource.MustParse("1"),
+	}, modifiedPod.Spec.Containers[0].Resources.Limits)
 }
@@ -0,0 +1,145 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// VolumePolicy restricts the types of volumes that SparkApplications may declare, e.g. to keep tenants from
+// mounting hostPath or inline CSI volumes. Volume types are named after the fields of the Kubernetes volume
+// source, e.g. hostPath, csi, emptyDir or persistentVolumeClaim.
+type VolumePolicy struct {
+	// AllowedTypes lists the only volume types that may be used. Empty allows every type that is not denied.
+	AllowedTypes []string
+	// DeniedTypes lists the volume types that may not be used.
+	DeniedTypes []string
+	// ExemptNamespaces lists the namespaces the policy does not apply to.
+	ExemptNamespaces []string
+}
+
+// volumeTypes lists the names of the volume types, i.e. the JSON names of the fields of corev1.VolumeSource.
+var volumeTypes = func() []string {
+	var names []string
+	t := reflect.TypeOf(corev1.VolumeSource{})
+	for i := 0; i < t.NumField(); i++ {
+		names = append(names, strings.Split(t.Field(i).Tag.Get("json"), ",")[0])
+	}
+	return names
+}()
+
+// sparkConfVolumeKeyPattern matches the Spark properties declaring driver and executor volumes, e.g.
+// spark.kubernetes.driver.volumes.hostPath.data.mount.path, capturing the volume type and name.
+var sparkConfVolumeKeyPattern = regexp.MustCompile(`^spark\.kubernetes\.(?:driver|executor)\.volumes\.([^.]+)\.([^.]+)\.`)
+
+// Validate checks that the allowed and denied volume types of the policy are known volume types.
+func (p *VolumePolicy) Validate() error {
+	for _, volumeType := range slices.Concat(p.AllowedTypes, p.DeniedTypes) {
+		if !slices.Contains(volumeTypes, volumeType) {
+			return fmt.Errorf("unknown volume type %q, must be one of %s", volumeType, strings.Join(volumeTypes, ", "))
+		}
+	}
+	return nil
+}
+
+// validateSpec checks the volumes declared by the given SparkApplication spec, including the ones of the
+// driver and executor pod templates and the ones declared through Spark properties, against the policy.
+// A nil policy admits every volume.
+func (p *VolumePolicy) validateSpec(namespace string, spec *v1beta2.SparkApplicationSpec) error {
+	if p == nil || slices.Contains(p.ExemptNamespaces, namespace) {
+		return nil
+	}
+
+	if err := p.validateVolumes("spec.volumes", spec.Volumes); err != nil {
+		return err
+	}
+	if spec.Driver.Template != nil {
+		if err := p.validateVolumes("spec.driver.template.spec.volumes", spec.Driver.Template.Spec.Volumes); err != nil {
+			return err
+		}
+	}
+	if spec.Executor.Template != nil {
+		if err := p.validateVolumes("spec.executor.template.spec.volumes", spec.Executor.Template.Spec.Volumes); err != nil {
+			return err
+		}
+	}
+	if err := p.validateConf("spec.sparkConf", spec.SparkConf); err != nil {
+		return err
+	}
+	// Hadoop properties are submitted with the spark.hadoop. prefix, they are checked all the same so that the
+	// policy does not depend on how they are passed to spark-submit.
+	if err := p.validateConf("spec.hadoopConf", spec.HadoopConf); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateConf checks the volumes declared through the spark.kubernetes.{driver,executor}.volumes.<type>.<name>.*
+// properties of the given configuration, which Spark adds to the driver and executor pods.
+func (p *VolumePolicy) validateConf(field string, conf map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(conf)) {
+		match := sparkConfVolumeKeyPattern.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		if err := p.validateVolumeType(fmt.Sprintf("%s[%s]", field, key), match[2], match[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *VolumePolicy) validateVolumes(field string, volumes []corev1.Volume) error {
+	for _, volume := range volumes {
+		volumeType := getVolumeType(volume)
+		if volumeType == "" {
+			continue
+		}
+		if err := p.validateVolumeType(field, volume.Name, volumeType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *VolumePolicy) validateVolumeType(field string, name string, volumeType string) error {
+	if slices.Contains(p.DeniedTypes, volumeType) ||
+		(len(p.AllowedTypes) > 0 && !slices.Contains(p.AllowedTypes, volumeType)) {
+		return fmt.Errorf("%s: volume %q of type %s is not allowed by the volume policy", field, name, volumeType)
+	}
+	return nil
+}
+
+// getVolumeType returns the type of the given volume, or an empty string if it has no volume source set.
+func getVolumeType(volume corev1.Volume) string {
+	v := reflect.ValueOf(volume.VolumeSource)
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Field(i).IsNil() {
+			return volumeTypes[i]
+		}
+	}
+	return ""
+}
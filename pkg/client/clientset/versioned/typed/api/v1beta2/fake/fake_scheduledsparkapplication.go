@@ -19,19 +19,20 @@ package fake
 
 import (
 	v1beta2 "github.com/kubeflow/spark-operator/v2/api/v1beta2"
-	apiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/typed/api/v1beta2"
+	apiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/applyconfiguration/api/v1beta2"
+	typedapiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/typed/api/v1beta2"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeScheduledSparkApplications implements ScheduledSparkApplicationInterface
 type fakeScheduledSparkApplications struct {
-	*gentype.FakeClientWithList[*v1beta2.ScheduledSparkApplication, *v1beta2.ScheduledSparkApplicationList]
+	*gentype.FakeClientWithListAndApply[*v1beta2.ScheduledSparkApplication, *v1beta2.ScheduledSparkApplicationList, *apiv1beta2.ScheduledSparkApplicationApplyConfiguration]
 	Fake *FakeSparkoperatorV1beta2
 }
 
-func newFakeScheduledSparkApplications(fake *FakeSparkoperatorV1beta2, namespace string) apiv1beta2.ScheduledSparkApplicationInterface {
+func newFakeScheduledSparkApplications(fake *FakeSparkoperatorV1beta2, namespace string) typedapiv1beta2.ScheduledSparkApplicationInterface {
 	return &fakeScheduledSparkApplications{
-		gentype.NewFakeClientWithList[*v1beta2.ScheduledSparkApplication, *v1beta2.ScheduledSparkApplicationList](
+		gentype.NewFakeClientWithListAndApply[*v1beta2.ScheduledSparkApplication, *v1beta2.ScheduledSparkApplicationList, *apiv1beta2.ScheduledSparkApplicationApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1beta2.SchemeGroupVersion.WithResource("scheduledsparkapplications"),
@@ -19,19 +19,20 @@ package fake
 
 import (
 	v1beta2 "github.com/kubeflow/spark-operator/v2/api/v1beta2"
-	apiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/typed/api/v1beta2"
+	apiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/applyconfiguration/api/v1beta2"
+	typedapiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/typed/api/v1beta2"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeSparkApplications implements SparkApplicationInterface
 type fakeSparkApplications struct {
-	*gentype.FakeClientWithList[*v1beta2.SparkApplication, *v1beta2.SparkApplicationList]
+	*gentype.FakeClientWithListAndApply[*v1beta2.SparkApplication, *v1beta2.SparkApplicationList, *apiv1beta2.SparkApplicationApplyConfiguration]
 	Fake *FakeSparkoperatorV1beta2
 }
 
-func newFakeSparkApplications(fake *FakeSparkoperatorV1beta2, namespace string) apiv1beta2.SparkApplicationInterface {
+func newFakeSparkApplications(fake *FakeSparkoperatorV1beta2, namespace string) typedapiv1beta2.SparkApplicationInterface {
 	return &fakeSparkApplications{
-		gentype.NewFakeClientWithList[*v1beta2.SparkApplication, *v1beta2.SparkApplicationList](
+		gentype.NewFakeClientWithListAndApply[*v1beta2.SparkApplication, *v1beta2.SparkApplicationList, *apiv1beta2.SparkApplicationApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1beta2.SchemeGroupVersion.WithResource("sparkapplications"),
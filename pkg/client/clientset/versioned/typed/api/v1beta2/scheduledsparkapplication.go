@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	apiv1beta2 "github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	applyconfigurationapiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/applyconfiguration/api/v1beta2"
 	scheme "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type ScheduledSparkApplicationInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*apiv1beta2.ScheduledSparkApplicationList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1beta2.ScheduledSparkApplication, err error)
+	Apply(ctx context.Context, scheduledSparkApplication *applyconfigurationapiv1beta2.ScheduledSparkApplicationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1beta2.ScheduledSparkApplication, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, scheduledSparkApplication *applyconfigurationapiv1beta2.ScheduledSparkApplicationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1beta2.ScheduledSparkApplication, err error)
 	ScheduledSparkApplicationExpansion
 }
 
 // scheduledSparkApplications implements ScheduledSparkApplicationInterface
 type scheduledSparkApplications struct {
-	*gentype.ClientWithList[*apiv1beta2.ScheduledSparkApplication, *apiv1beta2.ScheduledSparkApplicationList]
+	*gentype.ClientWithListAndApply[*apiv1beta2.ScheduledSparkApplication, *apiv1beta2.ScheduledSparkApplicationList, *applyconfigurationapiv1beta2.ScheduledSparkApplicationApplyConfiguration]
 }
 
 // newScheduledSparkApplications returns a ScheduledSparkApplications
 func newScheduledSparkApplications(c *SparkoperatorV1beta2Client, namespace string) *scheduledSparkApplications {
 	return &scheduledSparkApplications{
-		gentype.NewClientWithList[*apiv1beta2.ScheduledSparkApplication, *apiv1beta2.ScheduledSparkApplicationList](
+		gentype.NewClientWithListAndApply[*apiv1beta2.ScheduledSparkApplication, *apiv1beta2.ScheduledSparkApplicationList, *applyconfigurationapiv1beta2.ScheduledSparkApplicationApplyConfiguration](
 			"scheduledsparkapplications",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -21,6 +21,7 @@ import (
 	context "context"
 
 	apiv1beta2 "github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	applyconfigurationapiv1beta2 "github.com/kubeflow/spark-operator/v2/pkg/client/applyconfiguration/api/v1beta2"
 	scheme "github.com/kubeflow/spark-operator/v2/pkg/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -46,18 +47,21 @@ type SparkApplicationInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*apiv1beta2.SparkApplicationList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1beta2.SparkApplication, err error)
+	Apply(ctx context.Context, sparkApplication *applyconfigurationapiv1beta2.SparkApplicationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1beta2.SparkApplication, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, sparkApplication *applyconfigurationapiv1beta2.SparkApplicationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1beta2.SparkApplication, err error)
 	SparkApplicationExpansion
 }
 
 // sparkApplications implements SparkApplicationInterface
 type sparkApplications struct {
-	*gentype.ClientWithList[*apiv1beta2.SparkApplication, *apiv1beta2.SparkApplicationList]
+	*gentype.ClientWithListAndApply[*apiv1beta2.SparkApplication, *apiv1beta2.SparkApplicationList, *applyconfigurationapiv1beta2.SparkApplicationApplyConfiguration]
 }
 
 // newSparkApplications returns a SparkApplications
 func newSparkApplications(c *SparkoperatorV1beta2Client, namespace string) *sparkApplications {
 	return &sparkApplications{
-		gentype.NewClientWithList[*apiv1beta2.SparkApplication, *apiv1beta2.SparkApplicationList](
+		gentype.NewClientWithListAndApply[*apiv1beta2.SparkApplication, *apiv1beta2.SparkApplicationList, *applyconfigurationapiv1beta2.SparkApplicationApplyConfiguration](
 			"sparkapplications",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -0,0 +1,206 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+// Hadoop properties of the S3A connector.
+const (
+	// HadoopS3AEndpoint is the endpoint of the S3 API.
+	HadoopS3AEndpoint = "fs.s3a.endpoint"
+
+	// HadoopS3AEndpointRegion is the AWS region of the S3 API.
+	HadoopS3AEndpointRegion = "fs.s3a.endpoint.region"
+
+	// HadoopS3APathStyleAccess enables path style access to buckets.
+	HadoopS3APathStyleAccess = "fs.s3a.path.style.access"
+
+	// HadoopS3ACredentialsProvider is the list of classes providing the AWS credentials.
+	HadoopS3ACredentialsProvider = "fs.s3a.aws.credentials.provider"
+
+	// AWSWebIdentityCredentialsProviderV1 reads web identity credentials with the AWS SDK v1 used by Hadoop 3.3.
+	AWSWebIdentityCredentialsProviderV1 = "com.amazonaws.auth.WebIdentityTokenCredentialsProvider"
+
+	// AWSWebIdentityCredentialsProviderV2 reads web identity credentials with the AWS SDK v2 used by Hadoop 3.4.
+	AWSWebIdentityCredentialsProviderV2 = "software.amazon.awssdk.auth.credentials.WebIdentityTokenFileCredentialsProvider"
+)
+
+// Hadoop properties of the Cloud Storage connector.
+const (
+	// HadoopGCSImpl is the FileSystem implementation of the gs scheme.
+	HadoopGCSImpl = "fs.gs.impl"
+
+	// HadoopGCSAbstractFileSystemImpl is the AbstractFileSystem implementation of the gs scheme.
+	HadoopGCSAbstractFileSystemImpl = "fs.AbstractFileSystem.gs.impl"
+
+	// HadoopGCSProjectID is the Google Cloud project of the buckets.
+	HadoopGCSProjectID = "fs.gs.project.id"
+
+	// HadoopGCSAuthType is the authentication type of the Cloud Storage connector.
+	HadoopGCSAuthType = "fs.gs.auth.type"
+
+	// GCSFileSystem is the FileSystem implementation of the Cloud Storage connector.
+	GCSFileSystem = "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFileSystem"
+
+	// GCSAbstractFileSystem is the AbstractFileSystem implementation of the Cloud Storage connector.
+	GCSAbstractFileSystem = "com.google.cloud.hadoop.fs.gcs.GoogleHadoopFS"
+
+	// GCSAuthTypeApplicationDefault authenticates with the application default credentials.
+	GCSAuthTypeApplicationDefault = "APPLICATION_DEFAULT"
+)
+
+// Hadoop properties of the ABFS connector, formatted with the host name of the storage account.
+const (
+	// HadoopAzureAccountKeyTemplate is the access key of a storage account.
+	HadoopAzureAccountKeyTemplate = "fs.azure.account.key.%s"
+
+	// HadoopAzureAccountAuthTypeTemplate is the authentication type of a storage account.
+	HadoopAzureAccountAuthTypeTemplate = "fs.azure.account.auth.type.%s"
+
+	// HadoopAzureAccountOAuthProviderTypeTemplate is the class providing the OAuth tokens of a storage account.
+	HadoopAzureAccountOAuthProviderTypeTemplate = "fs.azure.account.oauth.provider.type.%s"
+
+	// HadoopAzureAccountOAuthMSITenantTemplate is the tenant of the managed identity of a storage account.
+	HadoopAzureAccountOAuthMSITenantTemplate = "fs.azure.account.oauth2.msi.tenant.%s"
+
+	// HadoopAzureAccountOAuthClientIDTemplate is the client ID of the identity of a storage account.
+	HadoopAzureAccountOAuthClientIDTemplate = "fs.azure.account.oauth2.client.id.%s"
+
+	// HadoopAzureAccountOAuthTokenFileTemplate is the federated token file of the workload identity of a storage
+	// account.
+	HadoopAzureAccountOAuthTokenFileTemplate = "fs.azure.account.oauth2.token.file.%s"
+
+	// AzureStorageAccountHostTemplate is the host name of the Data Lake Storage endpoint of a storage account.
+	AzureStorageAccountHostTemplate = "%s.dfs.core.windows.net"
+
+	// AzureAuthTypeOAuth authenticates with OAuth tokens.
+	AzureAuthTypeOAuth = "OAuth"
+
+	// AzureAuthTypeSharedKey authenticates with the access key of the storage account.
+	AzureAuthTypeSharedKey = "SharedKey"
+
+	// AzureMSITokenProvider provides the OAuth tokens of a managed identity.
+	AzureMSITokenProvider = "org.apache.hadoop.fs.azurebfs.oauth2.MsiTokenProvider"
+
+	// AzureWorkloadIdentityTokenProvider provides the OAuth tokens of a workload identity, from Hadoop 3.4.
+	AzureWorkloadIdentityTokenProvider = "org.apache.hadoop.fs.azurebfs.oauth2.WorkloadIdentityTokenProvider"
+)
+
+// Environment variables and keys of the Secrets holding cloud storage credentials.
+const (
+	// EnvAWSAccessKeyID is the AWS access key ID, also the key of the S3 credentials Secret holding it.
+	EnvAWSAccessKeyID = "AWS_ACCESS_KEY_ID"
+
+	// EnvAWSSecretAccessKey is the AWS secret access key, also the key of the S3 credentials Secret holding it.
+	EnvAWSSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+
+	// EnvAWSRegion is the default AWS region.
+	EnvAWSRegion = "AWS_REGION"
+
+	// EnvAWSRoleARN is the IAM role assumed with a web identity token.
+	EnvAWSRoleARN = "AWS_ROLE_ARN"
+
+	// EnvAWSWebIdentityTokenFile is the path of the web identity token.
+	EnvAWSWebIdentityTokenFile = "AWS_WEB_IDENTITY_TOKEN_FILE"
+
+	// EnvAzureStorageAccountKey is the access key of a storage account, also the key of the Secret holding it.
+	EnvAzureStorageAccountKey = "AZURE_STORAGE_ACCOUNT_KEY"
+
+	// EnvAzureClientID is the client ID of the workload identity.
+	EnvAzureClientID = "AZURE_CLIENT_ID"
+
+	// EnvAzureTenantID is the tenant of the workload identity.
+	EnvAzureTenantID = "AZURE_TENANT_ID"
+
+	// EnvAzureFederatedTokenFile is the path of the federated token of the workload identity.
+	EnvAzureFederatedTokenFile = "AZURE_FEDERATED_TOKEN_FILE"
+
+	// EnvAzureAuthorityHost is the Microsoft Entra authority the federated token is exchanged with.
+	EnvAzureAuthorityHost = "AZURE_AUTHORITY_HOST"
+)
+
+const (
+	// AnnotationEKSRoleARN is the annotation of a service account giving the IAM role assumed by its pods, read by
+	// the EKS Pod Identity Webhook.
+	AnnotationEKSRoleARN = "eks.amazonaws.com/role-arn"
+
+	// AnnotationGKEServiceAccount is the annotation of a service account binding it to a Google service account with
+	// GKE Workload Identity.
+	AnnotationGKEServiceAccount = "iam.gke.io/gcp-service-account"
+
+	// GCSConnectorArtifact is the name of the artifact of the Cloud Storage connector, which its jars are named after.
+	GCSConnectorArtifact = "gcs-connector"
+
+	// GoogleServiceAccountDomain is the domain of the emails of the Google service accounts created in a project.
+	GoogleServiceAccountDomain = ".iam.gserviceaccount.com"
+
+	// AWSWebIdentityTokenVolumeName is the name of the volume projecting the web identity token.
+	AWSWebIdentityTokenVolumeName = "spark-aws-iam-token"
+
+	// AWSWebIdentityTokenMountPath is the mount path of the web identity token volume.
+	AWSWebIdentityTokenMountPath = "/var/run/secrets/sparkoperator.k8s.io/aws-iam-token"
+
+	// AWSWebIdentityTokenFileName is the name of the web identity token in its volume.
+	AWSWebIdentityTokenFileName = "token"
+
+	// AWSWebIdentityTokenAudience is the audience of the web identity token expected by AWS STS.
+	AWSWebIdentityTokenAudience = "sts.amazonaws.com"
+
+	// AWSWebIdentityTokenExpirationSeconds is the validity of the projected web identity token.
+	AWSWebIdentityTokenExpirationSeconds = 86400
+
+	// GCSServiceAccountKeyMountPath is the mount path of the Secret holding the Google service account key.
+	GCSServiceAccountKeyMountPath = "/etc/spark/cloud-storage/gcs"
+
+	// LabelAzureWorkloadIdentityUse is the label of a pod using Microsoft Entra Workload ID, read by the Azure
+	// Workload Identity webhook.
+	LabelAzureWorkloadIdentityUse = "azure.workload.identity/use"
+
+	// AnnotationAzureWorkloadIdentityClientID is the annotation of a service account giving the client ID of the
+	// workload identity of its pods.
+	AnnotationAzureWorkloadIdentityClientID = "azure.workload.identity/client-id"
+
+	// AnnotationAzureWorkloadIdentityTenantID is the annotation of a service account giving the tenant of the
+	// workload identity of its pods.
+	AnnotationAzureWorkloadIdentityTenantID = "azure.workload.identity/tenant-id"
+
+	// AzureWorkloadIdentityTokenVolumeName is the name of the volume projecting the federated token, the same as
+	// the one of the Azure Workload Identity webhook so that the token is projected once.
+	AzureWorkloadIdentityTokenVolumeName = "azure-identity-token"
+
+	// AzureWorkloadIdentityTokenMountPath is the mount path of the federated token volume.
+	AzureWorkloadIdentityTokenMountPath = "/var/run/secrets/azure/tokens"
+
+	// AzureWorkloadIdentityTokenFileName is the name of the federated token in its volume.
+	AzureWorkloadIdentityTokenFileName = "azure-identity-token"
+
+	// AzureWorkloadIdentityTokenAudience is the audience of the federated token expected by Microsoft Entra ID.
+	AzureWorkloadIdentityTokenAudience = "api://AzureADTokenExchange"
+
+	// AzureWorkloadIdentityTokenExpirationSeconds is the validity of the projected federated token.
+	AzureWorkloadIdentityTokenExpirationSeconds = 3600
+
+	// AzureAuthorityHost is the Microsoft Entra authority of the Azure public cloud.
+	AzureAuthorityHost = "https://login.microsoftonline.com/"
+
+	// AzureKeyVaultVolumeName is the name of the volume mounting the objects of the Azure Key Vault.
+	AzureKeyVaultVolumeName = "azure-key-vault"
+
+	// DefaultAzureKeyVaultMountPath is the default mount path of the objects of the Azure Key Vault.
+	DefaultAzureKeyVaultMountPath = "/etc/spark/azure-key-vault"
+
+	// SecretsStoreCSIProviderAzure is the Azure Key Vault provider of the Secrets Store CSI driver.
+	SecretsStoreCSIProviderAzure = "azure"
+)
@@ -20,6 +20,13 @@ const (
 	ErrorCodePodAlreadyExists = "code=409"
 )
 
+// LogKeyCorrelationID is the key of the ID correlating the operator log lines of an attempt of a SparkApplication,
+// from its submission through the mutation of its pods to their events.
+const LogKeyCorrelationID = "correlationID"
+
+// StatusFieldManager is the field manager used when the operator writes status fields with server-side apply.
+const StatusFieldManager = "spark-operator-status"
+
 const (
 	SparkApplicationFinalizerName          = "sparkoperator.k8s.io/finalizer"
 	ScheduledSparkApplicationFinalizerName = "sparkoperator.k8s.io/finalizer"
@@ -42,6 +49,34 @@ const (
 	TLSKey  = "tls.key"
 )
 
+// Taints placed on nodes that are about to be drained or reclaimed, e.g. by cluster autoscalers
+// or cloud provider termination handlers for spot/preemptible instances.
+var NodeEvictionTaintKeys = []string{
+	"ToBeDeletedByClusterAutoscaler",
+	"karpenter.sh/disrupted",
+	"karpenter.sh/disruption",
+	"cloud.google.com/impending-node-termination",
+	"aws-node-termination-handler/spot-itn",
+	"aws-node-termination-handler/asg-lifecycle-termination",
+	"aws-node-termination-handler/scheduled-maintenance",
+	"node.cloudprovider.kubernetes.io/shutdown",
+}
+
+// Reasons of waiting containers whose image cannot be pulled.
+var ImagePullFailureReasons = []string{
+	"ErrImagePull",
+	"ImagePullBackOff",
+	"InvalidImageName",
+	"ErrImageNeverPull",
+}
+
+// ServiceAccountAuto is the service account of Spark pods running with a service account, role and role binding
+// provisioned by the operator for their SparkApplication.
+const ServiceAccountAuto = "auto"
+
+// DefaultImageRegistry is the registry of container images whose reference does not name a registry.
+const DefaultImageRegistry = "docker.io"
+
 // Kubernetes volume types.
 const (
 	VolumeTypeEmptyDir              = "emptyDir"
@@ -54,3 +89,10 @@ const (
 	// Epsilon is a small number used to compare 64 bit floating point numbers.
 	Epsilon = 1e-9
 )
+
+// Names of the PriorityClasses managed by the operator.
+const (
+	PriorityClassSparkCritical    = "spark-critical"
+	PriorityClassSparkDefault     = "spark-default"
+	PriorityClassSparkPreemptible = "spark-preemptible"
+)
@@ -0,0 +1,29 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+const (
+	// DiagnosticsVolumeName is the name of the volume the driver JVM writes heap dumps and fatal error logs to.
+	DiagnosticsVolumeName = "spark-diagnostics"
+
+	// DiagnosticsMountPath is the mount path of the diagnostics volume in the driver and collector containers.
+	DiagnosticsMountPath = "/var/spark/diagnostics"
+
+	// DiagnosticsCollectorContainerName is the name of the sidecar container keeping the diagnostics volume
+	// available after the driver container has terminated, until the dumps have been collected.
+	DiagnosticsCollectorContainerName = "spark-diagnostics-collector"
+)
@@ -22,8 +22,22 @@ const (
 
 	EventSparkApplicationSubmitted = "SparkApplicationSubmitted"
 
+	EventSparkApplicationSubmissionResumed = "SparkApplicationSubmissionResumed"
+
+	EventSparkApplicationSubmissionQueued = "SparkApplicationSubmissionQueued"
+
+	EventSparkApplicationQuotaWait = "SparkApplicationQuotaWait"
+
+	EventSparkApplicationDependenciesPending = "SparkApplicationDependenciesPending"
+
+	EventSparkApplicationRestartRequested = "SparkApplicationRestartRequested"
+
+	EventSparkApplicationCancelled = "SparkApplicationCancelled"
+
 	EventSparkApplicationSubmissionFailed = "SparkApplicationSubmissionFailed"
 
+	EventSparkApplicationPreflightFailed = "SparkApplicationPreflightFailed"
+
 	EventSparkApplicationCompleted = "SparkApplicationCompleted"
 
 	EventSparkApplicationFailed = "SparkApplicationFailed"
@@ -35,6 +49,42 @@ const (
 	EventSparkApplicationSuspended = "SparkApplicationSuspended"
 
 	EventSparkApplicationResuming = "SparkApplicationResuming"
+
+	EventSparkApplicationStreamingStalled = "SparkApplicationStreamingStalled"
+
+	EventSparkApplicationHookSucceeded = "SparkApplicationHookSucceeded"
+
+	EventSparkApplicationHookFailed = "SparkApplicationHookFailed"
+
+	EventSparkApplicationNotificationDelivered = "SparkApplicationNotificationDelivered"
+
+	EventSparkApplicationNotificationFailed = "SparkApplicationNotificationFailed"
+
+	EventSparkApplicationArchived = "SparkApplicationArchived"
+
+	EventSparkApplicationArchiveFailed = "SparkApplicationArchiveFailed"
+
+	EventSparkApplicationDiagnosticsCollected = "SparkApplicationDiagnosticsCollected"
+
+	EventSparkApplicationDiagnosticsCollectionFailed = "SparkApplicationDiagnosticsCollectionFailed"
+
+	EventSparkApplicationMemoryIncreased = "SparkApplicationMemoryIncreased"
+
+	EventSparkApplicationSchedulingProfileFallback = "SparkApplicationSchedulingProfileFallback"
+
+	EventSparkApplicationExecutionWindowEnded = "SparkApplicationExecutionWindowEnded"
+
+	EventSparkApplicationDriftCorrected = "SparkApplicationDriftCorrected"
+
+	EventSparkApplicationSparkConfigMapReloaded = "SparkApplicationSparkConfigMapReloaded"
+
+	EventSparkApplicationExecutorsScaled = "SparkApplicationExecutorsScaled"
+
+	EventSparkApplicationIdleTimeout = "SparkApplicationIdleTimeout"
+
+	EventSparkApplicationDryRun = "SparkApplicationDryRun"
+
+	EventSparkApplicationResourceNotManaged = "SparkApplicationResourceNotManaged"
 )
 
 // Spark driver events
@@ -61,4 +111,20 @@ const (
 	EventSparkExecutorFailed = "SparkExecutorFailed"
 
 	EventSparkExecutorUnknown = "SparkExecutorUnknown"
+
+	EventSparkExecutorDecommissioning = "SparkExecutorDecommissioning"
+
+	EventSparkExecutorImagePullFailed = "SparkExecutorImagePullFailed"
+)
+
+// PriorityClass events
+const (
+	EventPriorityClassConflict = "PriorityClassConflict"
+
+	EventPriorityClassRecreated = "PriorityClassRecreated"
+)
+
+// Namespace onboarding events
+const (
+	EventNamespaceResourceNotManaged = "NamespaceResourceNotManaged"
 )
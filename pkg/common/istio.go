@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+const (
+	// ServiceMeshModeIstio makes Spark pods compatible with the Istio sidecar injection.
+	ServiceMeshModeIstio = "istio"
+)
+
+const (
+	// IstioAnnotationExcludeInboundPorts is the annotation listing the inbound ports the Istio sidecar does not intercept.
+	IstioAnnotationExcludeInboundPorts = "traffic.sidecar.istio.io/excludeInboundPorts"
+
+	// IstioAnnotationExcludeOutboundPorts is the annotation listing the outbound ports the Istio sidecar does not intercept.
+	IstioAnnotationExcludeOutboundPorts = "traffic.sidecar.istio.io/excludeOutboundPorts"
+
+	// IstioAnnotationProxyConfig is the annotation overriding the proxy configuration of the Istio sidecar of a pod.
+	IstioAnnotationProxyConfig = "proxy.istio.io/config"
+
+	// IstioAnnotationNativeSidecar is the annotation injecting the Istio sidecar as a Kubernetes native sidecar,
+	// which does not keep the pod running once its other containers have terminated.
+	IstioAnnotationNativeSidecar = "sidecar.istio.io/nativeSidecar"
+
+	// IstioProxyConfigHoldApplication is the proxy configuration delaying the start of the application containers
+	// until the Istio sidecar is ready.
+	IstioProxyConfigHoldApplication = `{"holdApplicationUntilProxyStarts":true}`
+
+	// IstioProxyContainerName is the name of the Istio sidecar container.
+	IstioProxyContainerName = "istio-proxy"
+)
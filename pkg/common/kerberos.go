@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+// Spark configuration properties of the Kerberos authentication to secured Hadoop services.
+const (
+	// SparkKerberosPrincipal is the Kerberos principal the application logs in as.
+	SparkKerberosPrincipal = "spark.kerberos.principal"
+
+	// SparkKerberosKeytab is the keytab of the principal, read by spark-submit and shipped to the driver.
+	SparkKerberosKeytab = "spark.kerberos.keytab"
+
+	// SparkKerberosAccessHadoopFileSystems is the list of the secured Hadoop filesystems besides the default one
+	// which delegation tokens are obtained for.
+	SparkKerberosAccessHadoopFileSystems = "spark.kerberos.access.hadoopFileSystems"
+
+	// SparkKerberosRenewalCredentials tells the driver which credentials to obtain new delegation tokens with.
+	SparkKerberosRenewalCredentials = "spark.kerberos.renewal.credentials"
+
+	// SparkKubernetesKerberosKrb5Path is the Kerberos configuration file mounted in the driver and executors.
+	SparkKubernetesKerberosKrb5Path = "spark.kubernetes.kerberos.krb5.path"
+
+	// KerberosRenewalCredentialsKeytab obtains new delegation tokens by logging in with the keytab.
+	KerberosRenewalCredentialsKeytab = "keytab"
+)
+
+const (
+	// HadoopSecurityAuthentication is the Hadoop property of the authentication to Hadoop services.
+	HadoopSecurityAuthentication = "hadoop.security.authentication"
+
+	// HadoopSecurityAuthenticationKerberos authenticates to Hadoop services with Kerberos.
+	HadoopSecurityAuthenticationKerberos = "kerberos"
+
+	// KerberosKeytabKey is the key of the keytab in the Kerberos Secret of a SparkApplication.
+	KerberosKeytabKey = "krb5.keytab"
+
+	// KerberosConfKey is the key of the Kerberos configuration in the Kerberos Secret of a SparkApplication.
+	KerberosConfKey = "krb5.conf"
+
+	// EnvSparkSubmitOpts is the environment variable of the JVM options of spark-submit.
+	EnvSparkSubmitOpts = "SPARK_SUBMIT_OPTS"
+
+	// JavaSecurityKrb5Conf is the system property of the Kerberos configuration file of the JVM.
+	JavaSecurityKrb5Conf = "java.security.krb5.conf"
+)
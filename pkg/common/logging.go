@@ -0,0 +1,81 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+const (
+	// LoggingConfigMapNameSuffix is the name suffix of the ConfigMap holding the log4j2 configuration.
+	LoggingConfigMapNameSuffix = "log4j2"
+
+	// LoggingConfigMapMountPath is the mount path of the log4j2 ConfigMap.
+	LoggingConfigMapMountPath = "/etc/spark/logging"
+
+	// Log4j2ConfigKey is the key of the log4j2 configuration in the logging ConfigMap.
+	Log4j2ConfigKey = "log4j2.properties"
+)
+
+// log4j2LoggerLevels quiets the same third-party loggers as the log4j2 template shipped with Spark.
+const log4j2LoggerLevels = `
+logger.repl.name = org.apache.spark.repl.Main
+logger.repl.level = warn
+logger.thriftserver.name = org.apache.spark.sql.hive.thriftserver.SparkSQLCLIDriver
+logger.thriftserver.level = warn
+logger.jetty1.name = org.sparkproject.jetty
+logger.jetty1.level = warn
+logger.jetty2.name = org.sparkproject.jetty.util.component.AbstractLifeCycle
+logger.jetty2.level = error
+logger.replexprTyper.name = org.apache.spark.repl.SparkIMain$exprTyper
+logger.replexprTyper.level = info
+logger.replSparkILoopInterpreter.name = org.apache.spark.repl.SparkILoop$SparkILoopInterpreter
+logger.replSparkILoopInterpreter.level = info
+logger.parquet1.name = org.apache.parquet
+logger.parquet1.level = error
+logger.parquet2.name = parquet
+logger.parquet2.level = error
+logger.RetryingHMSHandler.name = org.apache.hadoop.hive.metastore.RetryingHMSHandler
+logger.RetryingHMSHandler.level = fatal
+logger.FunctionRegistry.name = org.apache.hadoop.hive.ql.exec.FunctionRegistry
+logger.FunctionRegistry.level = error
+`
+
+// DefaultJSONLog4j2Properties is the log4j2 configuration writing JSON logs to stdout for Spark 3.x.
+// It relies on JsonLayout from log4j-core, as the JSON template layout is not bundled with Spark 3.x.
+const DefaultJSONLog4j2Properties = `
+rootLogger.level = info
+rootLogger.appenderRef.stdout.ref = console
+
+appender.console.type = Console
+appender.console.name = console
+appender.console.target = SYSTEM_OUT
+appender.console.layout.type = JsonLayout
+appender.console.layout.compact = true
+appender.console.layout.eventEol = true
+appender.console.layout.properties = true
+appender.console.layout.stacktraceAsString = true
+` + log4j2LoggerLevels
+
+// DefaultStructuredLog4j2Properties is the log4j2 configuration writing JSON logs to stdout for Spark 4.x
+// and later, using the structured logging layout shipped with Spark.
+const DefaultStructuredLog4j2Properties = `
+rootLogger.level = info
+rootLogger.appenderRef.stdout.ref = console
+
+appender.console.type = Console
+appender.console.name = console
+appender.console.target = SYSTEM_OUT
+appender.console.layout.type = JsonTemplateLayout
+appender.console.layout.eventTemplateUri = classpath:org/apache/spark/SparkLayout.json
+` + log4j2LoggerLevels
@@ -26,6 +26,10 @@ const (
 
 	MetricSparkApplicationRunningCount = "spark_application_running_count"
 
+	// MetricSparkApplicationSubmittedTimestampSeconds is the time at which a SparkApplication currently in the
+	// SUBMITTED state was submitted, used to alert on applications whose driver never starts.
+	MetricSparkApplicationSubmittedTimestampSeconds = "spark_application_submitted_timestamp_seconds"
+
 	MetricSparkApplicationSuccessCount = "spark_application_success_count"
 
 	MetricSparkApplicationFailureCount = "spark_application_failure_count"
@@ -39,6 +43,19 @@ const (
 	MetricSparkApplicationStartLatencySecondsHistogram = "spark_application_start_latency_seconds_histogram"
 )
 
+// ScheduledSparkApplication metric names.
+const (
+	MetricScheduledSparkApplicationRunSuccessCount = "scheduled_spark_application_run_success_count"
+
+	MetricScheduledSparkApplicationRunFailureCount = "scheduled_spark_application_run_failure_count"
+)
+
+// CloudEvents metric names.
+const (
+	// MetricCloudEventsDroppedCount is the number of CloudEvents that were not delivered by namespace and reason.
+	MetricCloudEventsDroppedCount = "cloudevents_dropped_count"
+)
+
 // Spark executor metric names.
 const (
 	MetricSparkExecutorRunningCount = "spark_executor_running_count"
@@ -46,4 +63,49 @@ const (
 	MetricSparkExecutorSuccessCount = "spark_executor_success_count"
 
 	MetricSparkExecutorFailureCount = "spark_executor_failure_count"
+
+	MetricSparkExecutorLeakedPVCCount = "spark_executor_leaked_pvc_count"
+
+	// MetricSparkExecutorTerminationCount is the number of terminated executors by application and termination reason.
+	MetricSparkExecutorTerminationCount = "spark_executor_termination_count"
+)
+
+// Reasons of Spark executor terminations, used as the reason label of the executor termination metric.
+const (
+	// ExecutorTerminationReasonCompleted is the reason of executors exiting successfully.
+	ExecutorTerminationReasonCompleted = "Completed"
+
+	// ExecutorTerminationReasonError is the reason of executors exiting with an error.
+	ExecutorTerminationReasonError = "Error"
+
+	// ExecutorTerminationReasonOOMKilled is the reason of executors killed for exceeding their memory limit.
+	ExecutorTerminationReasonOOMKilled = "OOMKilled"
+
+	// ExecutorTerminationReasonEvicted is the reason of executors evicted or preempted from their nodes.
+	ExecutorTerminationReasonEvicted = "Evicted"
+
+	// ExecutorTerminationReasonDecommissioned is the reason of executors decommissioned by the operator
+	// because their nodes were being evicted.
+	ExecutorTerminationReasonDecommissioned = "Decommissioned"
+)
+
+// Spark task metric names. These are reported by the task metrics driver plugin.
+const (
+	MetricSparkApplicationTaskCompletedCount = "spark_application_task_completed_count"
+
+	MetricSparkApplicationTaskFailedCount = "spark_application_task_failed_count"
+
+	MetricSparkApplicationShuffleReadBytes = "spark_application_shuffle_read_bytes"
+
+	MetricSparkApplicationShuffleWriteBytes = "spark_application_shuffle_write_bytes"
+
+	MetricSparkApplicationMemorySpilledBytes = "spark_application_memory_spilled_bytes"
+
+	MetricSparkApplicationDiskSpilledBytes = "spark_application_disk_spilled_bytes"
+)
+
+const (
+	// TaskMetricsPath is the path on the operator metrics server to which the task metrics driver plugin
+	// reports task metrics.
+	TaskMetricsPath = "/task-metrics"
 )
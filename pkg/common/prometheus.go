@@ -137,3 +137,52 @@ const DefaultPrometheusPortProtocol string = "TCP"
 
 // DefaultPrometheusPortName is the default port name used by the Prometheus JMX exporter.
 const DefaultPrometheusPortName string = "jmx-exporter"
+
+const (
+	// PrometheusServletSinkClass is the class of the native PrometheusServlet metrics sink of Spark.
+	PrometheusServletSinkClass = "org.apache.spark.metrics.sink.PrometheusServlet"
+
+	// SparkPrometheusServletSinkClass is the Spark configuration key for the class of the PrometheusServlet sink.
+	SparkPrometheusServletSinkClass = "spark.metrics.conf.*.sink.prometheusServlet.class"
+
+	// SparkPrometheusServletSinkPath is the Spark configuration key for the path of the PrometheusServlet sink.
+	SparkPrometheusServletSinkPath = "spark.metrics.conf.*.sink.prometheusServlet.path"
+
+	// DefaultPrometheusServletPath is the default path of the Spark UI the driver metrics are served on.
+	DefaultPrometheusServletPath = "/metrics/prometheus"
+
+	// PrometheusServletExecutorMetricsPath is the path of the Spark UI the executor metrics are served on.
+	PrometheusServletExecutorMetricsPath = "/metrics/executors/prometheus"
+)
+
+const (
+	// PrometheusAgentConfigMapNameSuffix is the name suffix of the ConfigMap of the Prometheus agent.
+	PrometheusAgentConfigMapNameSuffix = "prom-agent-conf"
+
+	// PrometheusAgentConfigKey is the key of the Prometheus agent configuration in its ConfigMap.
+	PrometheusAgentConfigKey = "prometheus-agent.yaml"
+
+	// PrometheusAgentConfigMountPath is the mount path of the ConfigMap of the Prometheus agent.
+	PrometheusAgentConfigMountPath = "/etc/prometheus-agent"
+
+	// PrometheusAgentTokenMountPath is the mount path of the bearer token of the Prometheus remote-write endpoint.
+	PrometheusAgentTokenMountPath = "/var/run/secrets/prometheus-agent"
+
+	// PrometheusAgentTokenKey is the file name of the bearer token of the Prometheus remote-write endpoint.
+	PrometheusAgentTokenKey = "token"
+
+	// PrometheusAgentDataMountPath is the mount path of the write-ahead log of the Prometheus agent.
+	PrometheusAgentDataMountPath = "/prometheus"
+
+	// PrometheusAgentContainerName is the name of the Prometheus agent sidecar of the driver pod.
+	PrometheusAgentContainerName = "prometheus-agent"
+
+	// PrometheusAgentPort is the port of the HTTP server of the Prometheus agent.
+	PrometheusAgentPort int32 = 9095
+
+	// DefaultPrometheusAgentImage is the default image of the Prometheus agent.
+	DefaultPrometheusAgentImage = "quay.io/prometheus/prometheus:v3.5.0"
+
+	// DefaultPrometheusAgentIntervalSeconds is the default interval at which the Prometheus agent scrapes the metrics.
+	DefaultPrometheusAgentIntervalSeconds = 30
+)
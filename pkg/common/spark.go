@@ -20,6 +20,12 @@ package common
 const (
 	EnvSparkHome = "SPARK_HOME"
 
+	// EnvSparkLocalDirs is the environment variable for the comma-separated list of scratch directories of Spark.
+	EnvSparkLocalDirs = "SPARK_LOCAL_DIRS"
+
+	// EnvSparkDriverBindAddress is the environment variable for the IP address of the driver pod.
+	EnvSparkDriverBindAddress = "SPARK_DRIVER_BIND_ADDRESS"
+
 	EnvKubernetesServiceHost = "KUBERNETES_SERVICE_HOST"
 
 	EnvKubernetesServicePort = "KUBERNETES_SERVICE_PORT"
@@ -30,6 +36,12 @@ const (
 	// SparkAppName is the configuration property for application name.
 	SparkAppName = "spark.app.name"
 
+	// SparkAppID is the configuration property for the application ID.
+	SparkAppID = "spark.app.id"
+
+	// SparkDriverHost is the configuration property for the address executors connect to the driver at.
+	SparkDriverHost = "spark.driver.host"
+
 	SparkDriverCores = "spark.driver.cores"
 
 	SparkDriverMemory = "spark.driver.memory"
@@ -46,9 +58,152 @@ const (
 
 	SparkExecutorMemoryOverhead = "spark.executor.memoryOverhead"
 
+	// SparkJars is the configuration property for the jars added to the classpath of the driver and executors.
+	SparkJars = "spark.jars"
+
+	// SparkJarsPackages is the configuration property for the Maven coordinates of the jars added to the classpath
+	// of the driver and executors.
+	SparkJarsPackages = "spark.jars.packages"
+
+	// SparkDriverExtraClassPath is the configuration property for the entries prepended to the classpath of the driver.
+	SparkDriverExtraClassPath = "spark.driver.extraClassPath"
+
+	// SparkExecutorExtraClassPath is the configuration property for the entries prepended to the classpath of the
+	// executors.
+	SparkExecutorExtraClassPath = "spark.executor.extraClassPath"
+
+	// SparkExecutorPySparkMemory is the Spark configuration key for the memory of the Python workers of an executor.
+	SparkExecutorPySparkMemory = "spark.executor.pyspark.memory"
+
 	SparkUIProxyBase = "spark.ui.proxyBase"
 
 	SparkUIProxyRedirectURI = "spark.ui.proxyRedirectUri"
+
+	SparkUIEnabled = "spark.ui.enabled"
+
+	// SparkUIPrometheusEnabled is the Spark configuration key for serving the executor metrics in the Prometheus
+	// format on the Spark UI of the driver.
+	SparkUIPrometheusEnabled = "spark.ui.prometheus.enabled"
+
+	// SparkMetricsNamespace is the Spark configuration key for the root namespace of the metrics reported by Spark.
+	SparkMetricsNamespace = "spark.metrics.namespace"
+
+	// SparkDriverPort is the Spark configuration key for the port the driver listens on for executors.
+	SparkDriverPort = "spark.driver.port"
+
+	// SparkBlockManagerPort is the Spark configuration key for the port of the block managers of the driver and executors.
+	SparkBlockManagerPort = "spark.blockManager.port"
+
+	// SparkDriverBlockManagerPort is the Spark configuration key for the port of the block manager of the driver.
+	SparkDriverBlockManagerPort = "spark.driver.blockManager.port"
+
+	// DefaultSparkDriverPort is the default driver port of Spark on Kubernetes.
+	DefaultSparkDriverPort = 7078
+
+	// DefaultSparkBlockManagerPort is the default block manager port of Spark on Kubernetes.
+	DefaultSparkBlockManagerPort = 7079
+
+	// SparkDriverPortName is the name of the driver port in the headless Service of the driver.
+	SparkDriverPortName = "driver-rpc-port"
+
+	// SparkBlockManagerPortName is the name of the block manager port in the headless Service of the driver.
+	SparkBlockManagerPortName = "blockmanager"
+
+	// SparkConnectGRPCBindingPort is the Spark configuration key for the port of the Spark Connect server.
+	SparkConnectGRPCBindingPort = "spark.connect.grpc.binding.port"
+
+	// SparkHiveThriftServerPort is the Spark configuration key for the port of the Spark Thrift Server.
+	SparkHiveThriftServerPort = "spark.hadoop.hive.server2.thrift.port"
+
+	// SparkConnectServerClass is the main class of the Spark Connect server.
+	SparkConnectServerClass = "org.apache.spark.sql.connect.service.SparkConnectServer"
+
+	// SparkThriftServerClass is the main class of the Spark Thrift Server.
+	SparkThriftServerClass = "org.apache.spark.sql.hive.thriftserver.HiveThriftServer2"
+
+	// SparkInternalResource is the primary resource of applications whose main class is part of Spark.
+	SparkInternalResource = "spark-internal"
+
+	// DefaultSparkConnectPort is the default port of the Spark Connect server.
+	DefaultSparkConnectPort = 15002
+
+	// DefaultSparkThriftServerPort is the default port of the Spark Thrift Server.
+	DefaultSparkThriftServerPort = 10000
+
+	// SparkSQLStreamingCheckpointLocation is the Spark configuration key for the default checkpoint location of streaming queries.
+	SparkSQLStreamingCheckpointLocation = "spark.sql.streaming.checkpointLocation"
+
+	// DefaultStreamingLivenessCheckPath is the default path of the metrics scraped by the streaming liveness check.
+	DefaultStreamingLivenessCheckPath = "/metrics/prometheus"
+
+	// DefaultStreamingLivenessCheckPeriodSeconds is the default period of the streaming liveness check.
+	DefaultStreamingLivenessCheckPeriodSeconds = 30
+
+	// DefaultStreamingLivenessCheckMaxRestarts is the default number of restarts triggered by the streaming liveness check.
+	DefaultStreamingLivenessCheckMaxRestarts = 3
+
+	// DefaultExecutorInPlaceScalingPath is the default path on the driver the number of executors is POSTed to.
+	DefaultExecutorInPlaceScalingPath = "/executors/scale"
+
+	// DefaultExecutorInPlaceScalingPort is the default port the executor scaling plugin serves its endpoint on.
+	DefaultExecutorInPlaceScalingPort = 4045
+
+	// DefaultExecutorInPlaceScalingMaxInstances is the default largest number of executors an application can be scaled to in place.
+	DefaultExecutorInPlaceScalingMaxInstances = 100
+
+	// SparkPlugins is the Spark configuration key for specifying the comma-separated list of Spark plugins.
+	SparkPlugins = "spark.plugins"
+
+	// SparkLogStructuredLoggingEnabled is the Spark configuration key for enabling structured logging (Spark 4.0+).
+	SparkLogStructuredLoggingEnabled = "spark.log.structuredLogging.enabled"
+)
+
+// Task metrics driver plugin properties.
+const (
+	// SparkTaskMetricsEndpoint is the Spark configuration key for the URL the task metrics plugin reports to.
+	SparkTaskMetricsEndpoint = "spark.sparkoperator.taskMetrics.endpoint"
+
+	// SparkTaskMetricsSink is the Spark configuration key for the kind of endpoint the task metrics plugin reports to,
+	// either `operator` or `pushgateway`.
+	SparkTaskMetricsSink = "spark.sparkoperator.taskMetrics.sink"
+
+	// SparkTaskMetricsInterval is the Spark configuration key for the task metrics reporting interval in seconds.
+	SparkTaskMetricsInterval = "spark.sparkoperator.taskMetrics.intervalSeconds"
+
+	// SparkTaskMetricsNamespace is the Spark configuration key for the namespace of the SparkApplication.
+	SparkTaskMetricsNamespace = "spark.sparkoperator.taskMetrics.namespace"
+
+	// SparkTaskMetricsAppName is the Spark configuration key for the name of the SparkApplication.
+	SparkTaskMetricsAppName = "spark.sparkoperator.taskMetrics.appName"
+
+	TaskMetricsSinkOperator = "operator"
+
+	TaskMetricsSinkPushgateway = "pushgateway"
+
+	DefaultTaskMetricsIntervalSeconds = 30
+)
+
+// Executor scaling driver plugin properties.
+const (
+	// SparkExecutorScalingPath is the Spark configuration key for the path the executor scaling plugin serves.
+	SparkExecutorScalingPath = "spark.sparkoperator.executorScaling.path"
+
+	// SparkExecutorScalingPort is the Spark configuration key for the port the executor scaling plugin listens on.
+	SparkExecutorScalingPort = "spark.sparkoperator.executorScaling.port"
+
+	// SparkExecutorScalingMaxExecutors is the Spark configuration key for the largest number of executors the executor
+	// scaling plugin requests.
+	SparkExecutorScalingMaxExecutors = "spark.sparkoperator.executorScaling.maxExecutors"
+
+	// EnvExecutorScalingToken is the driver environment variable holding the token the operator sends to the executor
+	// scaling plugin, read from the executor scaling Secret of the submission.
+	EnvExecutorScalingToken = "SPARK_OPERATOR_EXECUTOR_SCALING_TOKEN"
+
+	// ExecutorScalingSecretNameSuffix is the name suffix of the Secret holding the executor scaling token.
+	ExecutorScalingSecretNameSuffix = "executor-scaling"
+
+	// ExecutorScalingTokenKey is the key of the token in the executor scaling Secret.
+	ExecutorScalingTokenKey = "token"
 )
 
 // Spark on Kubernetes properties.
@@ -256,6 +411,43 @@ const (
 	SparkDynamicAllocationShuffleTrackingTimeout = "spark.dynamicAllocation.shuffleTracking.timeout"
 )
 
+// Decommission properties.
+// Ref: https://spark.apache.org/docs/latest/configuration.html#spark-configuration
+const (
+	// SparkDecommissionEnabled is the Spark configuration key for specifying if executors are
+	// decommissioned gracefully when they are asked to shut down.
+	SparkDecommissionEnabled = "spark.decommission.enabled"
+
+	// SparkStorageDecommissionEnabled is the Spark configuration key for specifying if blocks are
+	// migrated off decommissioning executors.
+	SparkStorageDecommissionEnabled = "spark.storage.decommission.enabled"
+)
+
+// On-demand PersistentVolumeClaim properties.
+// Ref: https://spark.apache.org/docs/latest/running-on-kubernetes.html#using-kubernetes-volumes
+const (
+	// SparkKubernetesDriverOwnPersistentVolumeClaim is the Spark configuration key for specifying if the driver
+	// owns the on-demand persistent volume claims instead of the executors.
+	SparkKubernetesDriverOwnPersistentVolumeClaim = "spark.kubernetes.driver.ownPersistentVolumeClaim"
+
+	// SparkKubernetesDriverReusePersistentVolumeClaim is the Spark configuration key for specifying if the driver
+	// reuses the persistent volume claims of deleted executors.
+	SparkKubernetesDriverReusePersistentVolumeClaim = "spark.kubernetes.driver.reusePersistentVolumeClaim"
+
+	// SparkKubernetesDriverWaitToReusePersistentVolumeClaim is the Spark configuration key for specifying if the
+	// driver waits for reusable persistent volume claims before creating new executors.
+	SparkKubernetesDriverWaitToReusePersistentVolumeClaim = "spark.kubernetes.driver.waitToReusePersistentVolumeClaim"
+
+	// SparkOnDemandClaimName is the claim name that makes Spark create a persistent volume claim per executor.
+	SparkOnDemandClaimName = "OnDemand"
+
+	// DefaultEphemeralPVCVolumeName is the default name of the executor on-demand persistent volume claim volume.
+	DefaultEphemeralPVCVolumeName = SparkLocalDirVolumePrefix + "1"
+
+	// DefaultEphemeralPVCMountPath is the default mount path of the executor on-demand persistent volume claim volume.
+	DefaultEphemeralPVCMountPath = "/data"
+)
+
 const (
 	// SparkRoleDriver is the value of the spark-role label for the driver.
 	SparkRoleDriver = "driver"
@@ -322,6 +514,9 @@ const (
 	// LabelScheduledSparkAppName is the name of the label for the ScheduledSparkApplication object name.
 	LabelScheduledSparkAppName = LabelAnnotationPrefix + "scheduled-app-name"
 
+	// LabelBackfill is a label on the SparkApplications of the backfill runs of a ScheduledSparkApplication.
+	LabelBackfill = LabelAnnotationPrefix + "backfill"
+
 	// LabelLaunchedBySparkOperator is a label on Spark pods launched through the Spark Operator.
 	LabelLaunchedBySparkOperator = LabelAnnotationPrefix + "launched-by-spark-operator"
 
@@ -334,8 +529,59 @@ const (
 	// LabelSubmissionID is the label that records the submission ID of the current run of an application.
 	LabelSubmissionID = LabelAnnotationPrefix + "submission-id"
 
+	// LabelOrphaned is the label on the resources of a SparkApplication with the Orphan cascade delete policy,
+	// which are not deleted along with it.
+	LabelOrphaned = LabelAnnotationPrefix + "orphaned"
+
+	// LabelHookName is the name of the label for the name of the operator hook a Job was created for.
+	LabelHookName = LabelAnnotationPrefix + "hook-name"
+
 	// LabelSparkExecutorID is the label that records executor pod ID
 	LabelSparkExecutorID = "spark-exec-id"
+
+	// LabelLivyBatchID is the label that records the Livy batch ID of a SparkApplication submitted through the
+	// Livy API of the gateway.
+	LabelLivyBatchID = LabelAnnotationPrefix + "livy-batch-id"
+)
+
+const (
+	// AnnotationRestrictedSecurityDefaults is the annotation on a SparkApplication that opts it out of the
+	// restricted security defaults applied by the webhook when set to "false".
+	AnnotationRestrictedSecurityDefaults = LabelAnnotationPrefix + "restricted-security-defaults"
+
+	// AnnotationPlacementDefaults is the annotation on a SparkApplication that opts it out of the default
+	// tolerations, node selector and topology spread constraints of the placement policy of the webhook when set
+	// to "false".
+	AnnotationPlacementDefaults = LabelAnnotationPrefix + "placement-defaults"
+
+	// AnnotationRestartedAt is the annotation on a SparkApplication that requests a restart whenever its value
+	// changes, typically to the current time, like `kubectl rollout restart` does for Deployments.
+	AnnotationRestartedAt = "spark-operator.kubeflow.org/restartedAt"
+
+	// AnnotationSparkConfigMapHash is the annotation on the driver pod of a SparkApplication with the Signal
+	// SparkConfigMap reload policy that records the hash of the current data of its SparkConfigMap.
+	AnnotationSparkConfigMapHash = LabelAnnotationPrefix + "spark-conf-hash"
+
+	// AnnotationSubmittedBy is the annotation on a SparkApplication submitted through the gateway that records
+	// the name of the user who submitted it.
+	AnnotationSubmittedBy = LabelAnnotationPrefix + "submitted-by"
+
+	// AnnotationLivyBatchName is the annotation on a SparkApplication submitted through the Livy API of the gateway
+	// that records the name given to the batch.
+	AnnotationLivyBatchName = LabelAnnotationPrefix + "livy-batch-name"
+
+	// AnnotationTriggerRun is the annotation on a ScheduledSparkApplication that immediately starts a run outside
+	// of its schedule when set to "true". The controller removes it once the run is handled.
+	AnnotationTriggerRun = LabelAnnotationPrefix + "trigger-run"
+
+	// AnnotationDryRun is the annotation on a SparkApplication that renders the resources the operator would
+	// create for it into a ConfigMap instead of submitting it when set to "true".
+	AnnotationDryRun = LabelAnnotationPrefix + "dry-run"
+
+	// AnnotationWebhookPolicyState is the annotation on the webhook configurations of the operator that records the
+	// settings of their webhooks before and after the webhook configuration policy was applied, so that settings
+	// the policy no longer requires are reverted.
+	AnnotationWebhookPolicyState = LabelAnnotationPrefix + "webhook-policy-state"
 )
 
 const (
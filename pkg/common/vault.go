@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+// Annotations of the Vault Agent Injector.
+const (
+	VaultAnnotationPrefix = "vault.hashicorp.com/"
+
+	// VaultAnnotationAgentInject enables the Vault Agent Injector for a pod.
+	VaultAnnotationAgentInject = VaultAnnotationPrefix + "agent-inject"
+
+	// VaultAnnotationRole is the Vault role the pod authenticates as.
+	VaultAnnotationRole = VaultAnnotationPrefix + "role"
+
+	// VaultAnnotationAgentPrePopulateOnly only injects the Vault Agent init container, so that no Vault Agent
+	// sidecar keeps Spark pods running after Spark terminated.
+	VaultAnnotationAgentPrePopulateOnly = VaultAnnotationPrefix + "agent-pre-populate-only"
+
+	// VaultAnnotationAgentInjectSecretTemplate is the annotation template mapping a file to the Vault path of its secret.
+	VaultAnnotationAgentInjectSecretTemplate = VaultAnnotationPrefix + "agent-inject-secret-%s"
+
+	// VaultAnnotationAgentInjectTemplateTemplate is the annotation template of the template rendering a file.
+	VaultAnnotationAgentInjectTemplateTemplate = VaultAnnotationPrefix + "agent-inject-template-%s"
+
+	// VaultAnnotationSecretVolumePathTemplate is the annotation template of the directory a file is rendered to.
+	VaultAnnotationSecretVolumePathTemplate = VaultAnnotationPrefix + "secret-volume-path-%s"
+)
+
+const (
+	// SecretsStoreCSIDriver is the name of the Secrets Store CSI driver.
+	SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+
+	// SecretsStoreCSIVolumeAttributeSecretProviderClass is the volume attribute naming the SecretProviderClass of a volume.
+	SecretsStoreCSIVolumeAttributeSecretProviderClass = "secretProviderClass"
+
+	// VaultSecretVolumeNamePrefix is the name prefix of the volumes mounting Vault secrets with the CSI driver.
+	VaultSecretVolumeNamePrefix = "vault-secret-"
+)
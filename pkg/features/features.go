@@ -43,6 +43,21 @@ const (
 	// owner: @ChenYi015
 	// alpha: v2.5.0
 	LoadSparkDefaults featuregate.Feature = "LoadSparkDefaults"
+
+	// ExecutorDecommission enables watching nodes for cordons and termination taints so that executors of
+	// SparkApplications with `spec.executor.decommissionOnNodeEviction` set are decommissioned gracefully
+	// before their nodes go away.
+	//
+	// alpha: v2.5.0
+	ExecutorDecommission featuregate.Feature = "ExecutorDecommission"
+
+	// PodTemplateMutation makes the controller apply the customizations otherwise made by the mutating webhook,
+	// e.g. volumes, affinity, tolerations, environment variables and security contexts, to the driver and
+	// executor pod templates passed to spark-submit. Spark pods are then no longer mutated by the webhook,
+	// so the operator can run without it on clusters that prohibit mutating webhooks. Requires Spark 3.0.0+.
+	//
+	// alpha: v2.5.0
+	PodTemplateMutation featuregate.Feature = "PodTemplateMutation"
 )
 
 // To add a new feature gate, follow these steps:
@@ -83,6 +98,10 @@ var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	PartialRestart: {Default: false, PreRelease: featuregate.Alpha},
 
 	LoadSparkDefaults: {Default: false, PreRelease: featuregate.Alpha},
+
+	ExecutorDecommission: {Default: false, PreRelease: featuregate.Alpha},
+
+	PodTemplateMutation: {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // SetFeatureGateDuringTest sets the specified feature gate to the specified value during a test.
@@ -95,6 +114,15 @@ func Enabled(f featuregate.Feature) bool {
 	return utilfeature.DefaultFeatureGate.Enabled(f)
 }
 
+// GetAll returns whether each of the spark-operator-specific feature gates is enabled.
+func GetAll() map[featuregate.Feature]bool {
+	gates := make(map[featuregate.Feature]bool, len(defaultFeatureGates))
+	for f := range defaultFeatureGates {
+		gates[f] = Enabled(f)
+	}
+	return gates
+}
+
 // SetEnable helper function that can be used to set the enabled value of a feature gate,
 // it should only be used in integration test pending the merge of
 // https://github.com/kubernetes/kubernetes/pull/118346
@@ -80,3 +80,12 @@ func TestSetFeatureGateDuringTestHelper(t *testing.T) {
 
 	// After the test, the feature gate will be automatically restored to its original value
 }
+
+func TestGetAll(t *testing.T) {
+	SetFeatureGateDuringTest(t, ExecutorDecommission, true)
+
+	gates := GetAll()
+	assert.Len(t, gates, len(defaultFeatureGates))
+	assert.True(t, gates[ExecutorDecommission])
+	assert.False(t, gates[PartialRestart])
+}
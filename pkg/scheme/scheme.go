@@ -18,11 +18,13 @@ limitations under the License.
 package scheme
 
 import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	schedulingv1alpha1 "sigs.k8s.io/scheduler-plugins/apis/scheduling/v1alpha1"
 
+	"github.com/kubeflow/spark-operator/v2/api/v1"
 	"github.com/kubeflow/spark-operator/v2/api/v1alpha1"
 	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
 )
@@ -40,6 +42,9 @@ func init() {
 	// +kubebuilder:scaffold:scheme
 
 	utilruntime.Must(clientgoscheme.AddToScheme(WebhookScheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(WebhookScheme))
+	utilruntime.Must(v1.AddToScheme(WebhookScheme))
+	utilruntime.Must(v1alpha1.AddToScheme(WebhookScheme))
 	utilruntime.Must(v1beta2.AddToScheme(WebhookScheme))
 	// +kubebuilder:scaffold:scheme
 }
@@ -0,0 +1,44 @@
+/*
+Copyright 2017 Google LLC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+// NewStripMetadataTransform returns a cache transform that drops the managed fields and the given annotations
+// of objects before they are stored in the informer cache. It must only be used for objects that the operator
+// never writes back with an update, as the stripped annotations would be removed from the object.
+func NewStripMetadataTransform(annotations ...string) toolscache.TransformFunc {
+	return func(in any) (any, error) {
+		obj, err := meta.Accessor(in)
+		if err != nil {
+			return in, nil
+		}
+		if obj.GetManagedFields() != nil {
+			obj.SetManagedFields(nil)
+		}
+		if objAnnotations := obj.GetAnnotations(); len(objAnnotations) > 0 {
+			for _, key := range annotations {
+				delete(objAnnotations, key)
+			}
+			obj.SetAnnotations(objAnnotations)
+		}
+		return in, nil
+	}
+}
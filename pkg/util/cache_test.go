@@ -0,0 +1,53 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var _ = Describe("NewStripMetadataTransform", func() {
+	It("Should drop managed fields and the given annotations", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-pod",
+				Annotations: map[string]string{
+					corev1.LastAppliedConfigAnnotation: "{}",
+					"prometheus.io/scrape":             "true",
+				},
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kubectl"}},
+			},
+		}
+
+		out, err := util.NewStripMetadataTransform(corev1.LastAppliedConfigAnnotation)(pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(BeIdenticalTo(pod))
+		Expect(pod.ManagedFields).To(BeNil())
+		Expect(pod.Annotations).To(Equal(map[string]string{"prometheus.io/scrape": "true"}))
+	})
+
+	It("Should pass through objects without metadata", func() {
+		out, err := util.NewStripMetadataTransform()("not an object")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal("not an object"))
+	})
+})
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// GetCorrelationID returns the ID correlating the log lines of the current attempt of the given SparkApplication,
+// which is its submission ID. It is empty until the application is first submitted.
+func GetCorrelationID(app *v1beta2.SparkApplication) string {
+	return app.Status.SubmissionID
+}
+
+// GetPodCorrelationID returns the ID correlating the log lines of the given Spark pod with those of the attempt of
+// the SparkApplication it belongs to, which is the submission ID it is labeled with.
+func GetPodCorrelationID(pod *corev1.Pod) string {
+	return pod.Labels[common.LabelSubmissionID]
+}
+
+// WithCorrelationID returns a copy of ctx whose logger adds the given correlation ID to every log line, or ctx
+// itself if the ID is empty.
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	if id == "" {
+		return ctx
+	}
+	return log.IntoContext(ctx, log.FromContext(ctx).WithValues(common.LogKeyCorrelationID, id))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var _ = Describe("GetPodCorrelationID", func() {
+	It("Should return the submission ID the pod is labeled with", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{common.LabelSubmissionID: "submission-id"},
+			},
+		}
+		Expect(util.GetPodCorrelationID(pod)).To(Equal("submission-id"))
+		Expect(util.GetPodCorrelationID(&corev1.Pod{})).To(BeEmpty())
+	})
+})
+
+var _ = Describe("WithCorrelationID", func() {
+	var lines []string
+	ctx := log.IntoContext(context.Background(), funcr.New(func(prefix, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{}))
+
+	BeforeEach(func() {
+		lines = nil
+	})
+
+	It("Should add the correlation ID to every log line", func() {
+		log.FromContext(util.WithCorrelationID(ctx, "submission-id")).Info("Submitting")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"correlationID"="submission-id"`))
+	})
+
+	It("Should leave the logger unchanged if the correlation ID is empty", func() {
+		log.FromContext(util.WithCorrelationID(ctx, "")).Info("Submitting")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).NotTo(ContainSubstring("correlationID"))
+	})
+})
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// DefaultMetadataPropagation is the propagation of the labels and annotations of the SparkApplications that do not
+// set spec.metadataPropagation, unless the operator is configured with another policy: all the labels and no
+// annotations are propagated.
+var DefaultMetadataPropagation = v1beta2.MetadataPropagation{Labels: []string{"*"}}
+
+// GetPropagatedLabels returns the labels of the given app selected by the given propagation, or by
+// DefaultMetadataPropagation if it is nil. Kueue labels are never propagated.
+func GetPropagatedLabels(app *v1beta2.SparkApplication, propagation *v1beta2.MetadataPropagation) map[string]string {
+	if propagation == nil {
+		propagation = &DefaultMetadataPropagation
+	}
+	labels := make(map[string]string)
+	for key, value := range app.Labels {
+		// Don't propagate Kueue labels to driver pod.
+		// This is a quick workaround to avoid issues in Kueue integration.
+		// ref: https://github.com/kubeflow/spark-operator/issues/2669#issuecomment-3500165528
+		if strings.HasPrefix(key, common.KueueLabelPrefix) {
+			continue
+		}
+		if MatchesMetadataKey(key, propagation.Labels) {
+			labels[key] = value
+		}
+	}
+	return labels
+}
+
+// GetPropagatedAnnotations returns the annotations of the given app selected by the given propagation, or by
+// DefaultMetadataPropagation if it is nil. The last applied configuration of kubectl is never propagated.
+func GetPropagatedAnnotations(app *v1beta2.SparkApplication, propagation *v1beta2.MetadataPropagation) map[string]string {
+	if propagation == nil {
+		propagation = &DefaultMetadataPropagation
+	}
+	annotations := make(map[string]string)
+	for key, value := range app.Annotations {
+		if key == corev1.LastAppliedConfigAnnotation {
+			continue
+		}
+		if MatchesMetadataKey(key, propagation.Annotations) {
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
+
+// MatchesMetadataKey returns whether the given label or annotation key matches any of the given keys, which match
+// by prefix if they end with `*`.
+func MatchesMetadataKey(key string, keys []string) bool {
+	for _, k := range keys {
+		if prefix, ok := strings.CutSuffix(k, "*"); ok {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if key == k {
+			return true
+		}
+	}
+	return false
+}
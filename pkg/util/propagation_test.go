@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var _ = Describe("Metadata propagation", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				"team":                          "data",
+				"cost-center.example.com/id":    "42",
+				"cost-center.example.com/owner": "alice",
+				"kueue.x-k8s.io/queue-name":     "default",
+			},
+			Annotations: map[string]string{
+				"owner.example.com/contact":        "data@example.com",
+				"note":                             "nightly",
+				corev1.LastAppliedConfigAnnotation: "{}",
+			},
+		},
+	}
+
+	Context("GetPropagatedLabels", func() {
+		It("Should propagate all labels but Kueue labels by default", func() {
+			Expect(util.GetPropagatedLabels(app, nil)).To(Equal(map[string]string{
+				"team":                          "data",
+				"cost-center.example.com/id":    "42",
+				"cost-center.example.com/owner": "alice",
+			}))
+		})
+
+		It("Should propagate the labels matching the given keys and prefixes", func() {
+			propagation := &v1beta2.MetadataPropagation{Labels: []string{"team", "cost-center.example.com/*", "kueue.x-k8s.io/*"}}
+			Expect(util.GetPropagatedLabels(app, propagation)).To(Equal(map[string]string{
+				"team":                          "data",
+				"cost-center.example.com/id":    "42",
+				"cost-center.example.com/owner": "alice",
+			}))
+		})
+
+		It("Should propagate no labels if no keys are given", func() {
+			Expect(util.GetPropagatedLabels(app, &v1beta2.MetadataPropagation{})).To(BeEmpty())
+		})
+	})
+
+	Context("GetPropagatedAnnotations", func() {
+		It("Should propagate no annotations by default", func() {
+			Expect(util.GetPropagatedAnnotations(app, nil)).To(BeEmpty())
+		})
+
+		It("Should propagate the annotations matching the given keys but the last applied configuration", func() {
+			propagation := &v1beta2.MetadataPropagation{Annotations: []string{"*"}}
+			Expect(util.GetPropagatedAnnotations(app, propagation)).To(Equal(map[string]string{
+				"owner.example.com/contact": "data@example.com",
+				"note":                      "nightly",
+			}))
+		})
+	})
+})
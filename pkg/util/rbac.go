@@ -0,0 +1,43 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// GetDriverPolicyRules returns the permissions the driver needs to run its executors: creating and deleting
+// executor pods, the ConfigMap holding their configuration, their on-demand persistent volume claims, and services.
+func GetDriverPolicyRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     []string{"get", "list", "watch", "create", "delete", "deletecollection"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps", "persistentvolumeclaims"},
+			Verbs:     []string{"get", "list", "create", "delete"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"services"},
+			Verbs:     []string{"get", "create", "delete"},
+		},
+	}
+}
@@ -19,13 +19,18 @@ package util
 import (
 	"crypto/md5"
 	"fmt"
+	"maps"
+	"net/url"
+	"path"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -95,7 +100,7 @@ func ShouldRetry(app *v1beta2.SparkApplication) bool {
 				return true
 			}
 		}
-	case v1beta2.ApplicationStateFailedSubmission:
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
 		switch app.Spec.RestartPolicy.Type {
 		case v1beta2.RestartPolicyAlways:
 			return true
@@ -112,7 +117,7 @@ func ShouldRetry(app *v1beta2.SparkApplication) bool {
 func TimeUntilNextRetryDue(app *v1beta2.SparkApplication) (time.Duration, error) {
 	var retryInterval *int64
 	switch app.Status.AppState.State {
-	case v1beta2.ApplicationStateFailedSubmission:
+	case v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed:
 		retryInterval = app.Spec.RestartPolicy.OnSubmissionFailureRetryInterval
 	case v1beta2.ApplicationStateFailing:
 		retryInterval = app.Spec.RestartPolicy.OnFailureRetryInterval
@@ -181,6 +186,127 @@ func GetDefaultUIIngressName(app *v1beta2.SparkApplication) string {
 	return generateName(app.Name, "ui-ingress")
 }
 
+// GetDriverServiceName returns the name of the Service exposing the ports of spec.driver.service.
+func GetDriverServiceName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "driver")
+}
+
+// GetInteractiveServiceName returns the name of the Service exposing the endpoint of an interactive application.
+func GetInteractiveServiceName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "interactive")
+}
+
+// GetDryRunConfigMapName returns the name of the ConfigMap the dry run of the given SparkApplication is rendered to.
+func GetDryRunConfigMapName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "dry-run")
+}
+
+// IsDryRun returns whether the given SparkApplication is annotated to be rendered instead of submitted.
+func IsDryRun(app *v1beta2.SparkApplication) bool {
+	return app.Annotations[common.AnnotationDryRun] == "true"
+}
+
+// GetDriverHeadlessServiceName returns the name of the headless Service created by the operator for the driver,
+// or an empty string if none is requested.
+func GetDriverHeadlessServiceName(app *v1beta2.SparkApplication) string {
+	if app.Spec.Driver.HeadlessService == nil {
+		return ""
+	}
+	return ptr.Deref(app.Spec.Driver.HeadlessService.Name, "")
+}
+
+func GetExecutorPodDisruptionBudgetName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "executor-pdb")
+}
+
+func GetNetworkPolicyName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "network-policy")
+}
+
+// GetAutoServiceAccountName returns the name of the service account, role and role binding provisioned by the operator
+// for the given SparkApplication.
+func GetAutoServiceAccountName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "spark")
+}
+
+// UseAutoServiceAccount returns whether the driver or the executors of the given SparkApplication run with the
+// service account provisioned by the operator.
+func UseAutoServiceAccount(app *v1beta2.SparkApplication) bool {
+	return ptr.Deref(app.Spec.Driver.ServiceAccount, "") == common.ServiceAccountAuto ||
+		ptr.Deref(app.Spec.Executor.ServiceAccount, "") == common.ServiceAccountAuto
+}
+
+// GetServiceAccountName returns the service account of Spark pods with the given service account spec,
+// substituting the service account provisioned by the operator for `auto`.
+func GetServiceAccountName(app *v1beta2.SparkApplication, serviceAccount *string) string {
+	if ptr.Deref(serviceAccount, "") == common.ServiceAccountAuto {
+		return GetAutoServiceAccountName(app)
+	}
+	return ptr.Deref(serviceAccount, "")
+}
+
+// GetAWSRoleARN returns the AWS IAM role assumed with a web identity token by the pods of the given SparkApplication
+// with the given pod spec, or an empty string if they do not assume any.
+func GetAWSRoleARN(app *v1beta2.SparkApplication, spec *v1beta2.SparkPodSpec) string {
+	if spec.IAMRoleARN != nil {
+		return *spec.IAMRoleARN
+	}
+	if app.Spec.CloudStorage != nil && app.Spec.CloudStorage.S3 != nil {
+		return ptr.Deref(app.Spec.CloudStorage.S3.RoleARN, "")
+	}
+	return ""
+}
+
+// UseAWSWebIdentity returns whether the driver or the executors of the given SparkApplication assume an AWS IAM role
+// with a web identity token.
+func UseAWSWebIdentity(app *v1beta2.SparkApplication) bool {
+	return GetAWSRoleARN(app, &app.Spec.Driver.SparkPodSpec) != "" || GetAWSRoleARN(app, &app.Spec.Executor.SparkPodSpec) != ""
+}
+
+// UseGCS returns whether the driver and executors of the given SparkApplication access Google Cloud Storage with the
+// Cloud Storage connector configured by the operator.
+func UseGCS(app *v1beta2.SparkApplication) bool {
+	return (app.Spec.CloudStorage != nil && app.Spec.CloudStorage.GCS != nil) ||
+		(app.Spec.GCP != nil && app.Spec.GCP.WorkloadIdentity != nil)
+}
+
+// GetGCPProjectID returns the Google Cloud project of the buckets accessed by the given SparkApplication, which
+// defaults to the project of the Google service account its driver and executors run as.
+func GetGCPProjectID(app *v1beta2.SparkApplication) string {
+	if app.Spec.CloudStorage != nil && app.Spec.CloudStorage.GCS != nil && app.Spec.CloudStorage.GCS.ProjectID != nil {
+		return *app.Spec.CloudStorage.GCS.ProjectID
+	}
+	if app.Spec.GCP != nil && app.Spec.GCP.WorkloadIdentity != nil {
+		_, domain, _ := strings.Cut(app.Spec.GCP.WorkloadIdentity.ServiceAccount, "@")
+		if project, ok := strings.CutSuffix(domain, common.GoogleServiceAccountDomain); ok {
+			return project
+		}
+	}
+	return ""
+}
+
+// GetAzureWorkloadIdentity returns the Microsoft Entra identity the driver and executors of the given
+// SparkApplication run as, or nil if they do not use Microsoft Entra Workload ID.
+func GetAzureWorkloadIdentity(app *v1beta2.SparkApplication) *v1beta2.AzureWorkloadIdentity {
+	if app.Spec.Azure == nil {
+		return nil
+	}
+	return app.Spec.Azure.WorkloadIdentity
+}
+
+// GetAzureKeyVaultSecretProviderClassName returns the name of the SecretProviderClass mounting the objects of the
+// Azure Key Vault of the given SparkApplication.
+func GetAzureKeyVaultSecretProviderClassName(app *v1beta2.SparkApplication) string {
+	return generateName(app.Name, "azure-key-vault")
+}
+
+// GetHookJobName returns the name of the Job created for the given operator hook and event of the current
+// submission of the given SparkApplication.
+func GetHookJobName(app *v1beta2.SparkApplication, hookName string, event v1beta2.HookEvent) string {
+	hash := fmt.Sprintf("%x", md5.Sum([]byte(hookName+"/"+string(event)+"/"+app.Status.SubmissionID)))
+	return generateName(app.Name, "hook-"+hash[:8])
+}
+
 func GetResourceLabels(app *v1beta2.SparkApplication) map[string]string {
 	labels := map[string]string{
 		common.LabelSparkAppName: app.Name,
@@ -191,6 +317,16 @@ func GetResourceLabels(app *v1beta2.SparkApplication) map[string]string {
 	return labels
 }
 
+// GetDependentResourceLabels returns the labels of the executor PVCs, Services and ConfigMaps of the given app,
+// which include the orphaned label if they are not deleted along with it.
+func GetDependentResourceLabels(app *v1beta2.SparkApplication) map[string]string {
+	labels := GetResourceLabels(app)
+	if IsOrphaningDependents(app) {
+		labels[common.LabelOrphaned] = "true"
+	}
+	return labels
+}
+
 func GetWebUIServiceLabels(app *v1beta2.SparkApplication) map[string]string {
 	labels := map[string]string{}
 	if app.Spec.SparkUIOptions != nil && app.Spec.SparkUIOptions.ServiceLabels != nil {
@@ -241,11 +377,177 @@ func GetPrometheusConfigMapName(app *v1beta2.SparkApplication) string {
 	return fmt.Sprintf("%s-%s", app.Name, common.PrometheusConfigMapNameSuffix)
 }
 
+// IsRestartRequested returns whether the restartedAt annotation of the given SparkApplication requests
+// a restart that has not been carried out by a submission yet.
+func IsRestartRequested(app *v1beta2.SparkApplication) bool {
+	restartedAt := app.Annotations[common.AnnotationRestartedAt]
+	return restartedAt != "" && restartedAt != app.Status.LastRestartedAt
+}
+
+// DriverDiagnosticsEnabled returns if the driver JVM is configured to write dumps to the diagnostics volume.
+func DriverDiagnosticsEnabled(app *v1beta2.SparkApplication) bool {
+	return app.Spec.Driver.Diagnostics != nil
+}
+
+// DriverDiagnosticsCollectionEnabled returns if the dumps of the driver are collected when it fails.
+func DriverDiagnosticsCollectionEnabled(app *v1beta2.SparkApplication) bool {
+	return DriverDiagnosticsEnabled(app) && ptr.Deref(app.Spec.Driver.Diagnostics.Collect, false)
+}
+
+// OOMRemediationEnabled returns if the memory of the driver or executors is increased when the application is
+// retried after their containers were OOMKilled.
+func OOMRemediationEnabled(app *v1beta2.SparkApplication) bool {
+	return app.Spec.RestartPolicy.OnOOMIncreaseMemoryPercent != nil && *app.Spec.RestartPolicy.OnOOMIncreaseMemoryPercent > 0
+}
+
+// GetMemoryAdjustment returns the memory of the given role, driver or executor, increased after it was OOMKilled,
+// or nil if it was not increased.
+func GetMemoryAdjustment(app *v1beta2.SparkApplication, role string) *v1beta2.MemoryAdjustment {
+	for i := range app.Status.MemoryAdjustments {
+		if app.Status.MemoryAdjustments[i].Role == role {
+			return &app.Status.MemoryAdjustments[i]
+		}
+	}
+	return nil
+}
+
+// GetSchedulingProfile returns the scheduling profile the executors of the current attempt are scheduled with,
+// which is the first one until the application falls back to another, or nil if the application has none.
+func GetSchedulingProfile(app *v1beta2.SparkApplication) *v1beta2.SchedulingProfile {
+	for i := range app.Spec.SchedulingProfiles {
+		if app.Spec.SchedulingProfiles[i].Name == app.Status.SchedulingProfile {
+			return &app.Spec.SchedulingProfiles[i]
+		}
+	}
+	if len(app.Spec.SchedulingProfiles) > 0 {
+		return &app.Spec.SchedulingProfiles[0]
+	}
+	return nil
+}
+
+// ApplySchedulingProfile adds the node selector, tolerations and affinity of the current scheduling profile to the
+// executor spec of the given SparkApplication.
+func ApplySchedulingProfile(app *v1beta2.SparkApplication) {
+	profile := GetSchedulingProfile(app)
+	if profile == nil {
+		return
+	}
+	executor := &app.Spec.Executor
+	if len(profile.NodeSelector) > 0 {
+		if executor.NodeSelector == nil {
+			executor.NodeSelector = make(map[string]string, len(profile.NodeSelector))
+		}
+		maps.Copy(executor.NodeSelector, profile.NodeSelector)
+	}
+	executor.Tolerations = append(executor.Tolerations, profile.Tolerations...)
+	if profile.Affinity != nil {
+		executor.Affinity = profile.Affinity.DeepCopy()
+	}
+}
+
+// GetDriverArch returns the CPU architecture of the nodes the driver is scheduled on, or an empty string if any.
+func GetDriverArch(app *v1beta2.SparkApplication) v1beta2.Arch {
+	return getPodArch(app, app.Spec.Driver.Arch)
+}
+
+// GetExecutorArch returns the CPU architecture of the nodes the executors are scheduled on, or an empty string
+// if any.
+func GetExecutorArch(app *v1beta2.SparkApplication) v1beta2.Arch {
+	return getPodArch(app, app.Spec.Executor.Arch)
+}
+
+func getPodArch(app *v1beta2.SparkApplication, arch *v1beta2.Arch) v1beta2.Arch {
+	if arch != nil {
+		return *arch
+	}
+	if app.Spec.Arch != nil {
+		return *app.Spec.Arch
+	}
+	return ""
+}
+
+// JSONLoggingEnabled returns if the driver and executors are configured to write JSON logs to stdout.
+func JSONLoggingEnabled(app *v1beta2.SparkApplication) bool {
+	return app.Spec.Logging != nil && app.Spec.Logging.Format == v1beta2.LogFormatJSON
+}
+
+// GetLoggingConfigMapName returns the name of the ConfigMap for the log4j2 configuration.
+func GetLoggingConfigMapName(app *v1beta2.SparkApplication) string {
+	return fmt.Sprintf("%s-%s", app.Name, common.LoggingConfigMapNameSuffix)
+}
+
+// TaskMetricsEnabled returns if the task metrics driver plugin is enabled or not.
+func TaskMetricsEnabled(app *v1beta2.SparkApplication) bool {
+	return app.Spec.Monitoring != nil && app.Spec.Monitoring.TaskMetrics != nil
+}
+
+// IsSparkUIEnabled returns whether the Spark web UI of the given SparkApplication is enabled. The UI setting
+// of the driver takes precedence over spark.ui.enabled in the Spark configuration, which takes precedence
+// over enabledByDefault.
+func IsSparkUIEnabled(app *v1beta2.SparkApplication, enabledByDefault bool) bool {
+	if app.Spec.Driver.UI != nil && app.Spec.Driver.UI.Enabled != nil {
+		return *app.Spec.Driver.UI.Enabled
+	}
+	if enabled, err := strconv.ParseBool(app.Spec.SparkConf[common.SparkUIEnabled]); err == nil {
+		return enabled
+	}
+	return enabledByDefault
+}
+
 // PrometheusMonitoringEnabled returns if Prometheus monitoring is enabled or not.
 func PrometheusMonitoringEnabled(app *v1beta2.SparkApplication) bool {
 	return app.Spec.Monitoring != nil && app.Spec.Monitoring.Prometheus != nil
 }
 
+// PrometheusServletEnabled returns if the PrometheusServlet metrics sink is enabled or not.
+func PrometheusServletEnabled(app *v1beta2.SparkApplication) bool {
+	return app.Spec.Monitoring != nil && app.Spec.Monitoring.PrometheusServlet != nil
+}
+
+// PrometheusRemoteWriteEnabled returns if the metrics of the PrometheusServlet sink are pushed to a Prometheus
+// remote-write endpoint or not.
+func PrometheusRemoteWriteEnabled(app *v1beta2.SparkApplication) bool {
+	return PrometheusServletEnabled(app) && app.Spec.Monitoring.PrometheusServlet.RemoteWrite != nil
+}
+
+// GetPrometheusServletPath returns the path of the Spark UI the driver metrics are served on by the
+// PrometheusServlet sink.
+func GetPrometheusServletPath(app *v1beta2.SparkApplication) string {
+	if app.Spec.Monitoring.PrometheusServlet.Path != nil && *app.Spec.Monitoring.PrometheusServlet.Path != "" {
+		return *app.Spec.Monitoring.PrometheusServlet.Path
+	}
+	return common.DefaultPrometheusServletPath
+}
+
+// GetPrometheusAgentConfigMapName returns the name of the ConfigMap of the Prometheus agent sidecar of the driver.
+func GetPrometheusAgentConfigMapName(app *v1beta2.SparkApplication) string {
+	return fmt.Sprintf("%s-%s", app.Name, common.PrometheusAgentConfigMapNameSuffix)
+}
+
+// GetExecutorScalingSecretName returns the name of the Secret holding the token of the executor scaling plugin.
+func GetExecutorScalingSecretName(app *v1beta2.SparkApplication) string {
+	return fmt.Sprintf("%s-%s", app.Name, common.ExecutorScalingSecretNameSuffix)
+}
+
+// GetExecutorInPlaceScalingMaxInstances returns the largest number of executors the given SparkApplication can be
+// scaled to in place.
+func GetExecutorInPlaceScalingMaxInstances(app *v1beta2.SparkApplication) int32 {
+	if scaling := app.Spec.Executor.InPlaceScaling; scaling != nil && scaling.MaxInstances != nil {
+		return *scaling.MaxInstances
+	}
+	return common.DefaultExecutorInPlaceScalingMaxInstances
+}
+
+// GetSparkUIPort returns the port of the Spark web UI from spark.ui.port in the Spark configuration if it is
+// valid, otherwise the default port. The Spark ConfigMap of the application is not taken into account.
+func GetSparkUIPort(app *v1beta2.SparkApplication) int32 {
+	port, err := strconv.ParseInt(app.Spec.SparkConf[common.SparkUIPortKey], 10, 32)
+	if err != nil {
+		return common.DefaultSparkWebUIPort
+	}
+	return int32(port)
+}
+
 // HasPrometheusConfigFile returns if Prometheus monitoring uses a configuration file in the container.
 func HasPrometheusConfigFile(app *v1beta2.SparkApplication) bool {
 	return PrometheusMonitoringEnabled(app) &&
@@ -275,6 +577,59 @@ func ExposeExecutorMetrics(app *v1beta2.SparkApplication) bool {
 	return app.Spec.Monitoring != nil && app.Spec.Monitoring.ExposeExecutorMetrics
 }
 
+// GetStreamingCheckpointLocation returns the checkpoint location of the streaming queries of the given app,
+// or an empty string if none is configured.
+func GetStreamingCheckpointLocation(app *v1beta2.SparkApplication) string {
+	if app.Spec.Streaming == nil || app.Spec.Streaming.CheckpointLocation == nil {
+		return ""
+	}
+	return *app.Spec.Streaming.CheckpointLocation
+}
+
+// IsLocalCheckpointLocation returns if the given checkpoint location refers to the local filesystem of the driver.
+func IsLocalCheckpointLocation(location string) bool {
+	u, err := url.Parse(location)
+	if err != nil {
+		return false
+	}
+	return u.Scheme == "" || u.Scheme == "file"
+}
+
+// GetStreamingCheckpointVolume returns the volume of the driver that contains the local streaming checkpoint
+// location of the given app, or nil if the location is remote or not inside any driver volume mount.
+func GetStreamingCheckpointVolume(app *v1beta2.SparkApplication) *corev1.Volume {
+	location := GetStreamingCheckpointLocation(app)
+	if location == "" || !IsLocalCheckpointLocation(location) {
+		return nil
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil
+	}
+	checkpointPath := path.Clean(u.Path)
+
+	// Pick the deepest mount containing the checkpoint location as mounts may be nested.
+	var mount *corev1.VolumeMount
+	for i, m := range app.Spec.Driver.VolumeMounts {
+		mountPath := path.Clean(m.MountPath)
+		if checkpointPath != mountPath && !strings.HasPrefix(checkpointPath, strings.TrimSuffix(mountPath, "/")+"/") {
+			continue
+		}
+		if mount == nil || len(mountPath) > len(path.Clean(mount.MountPath)) {
+			mount = &app.Spec.Driver.VolumeMounts[i]
+		}
+	}
+	if mount == nil {
+		return nil
+	}
+	for i, volume := range app.Spec.Volumes {
+		if volume.Name == mount.Name {
+			return &app.Spec.Volumes[i]
+		}
+	}
+	return nil
+}
+
 // GetOwnerReference returns an OwnerReference pointing to the given app.
 func GetOwnerReference(app *v1beta2.SparkApplication) metav1.OwnerReference {
 	return metav1.OwnerReference{
@@ -287,6 +642,21 @@ func GetOwnerReference(app *v1beta2.SparkApplication) metav1.OwnerReference {
 	}
 }
 
+// GetDependentOwnerReferences returns the owner references of the executor PVCs, Services and ConfigMaps of the
+// given app, which are none if they are not deleted along with it.
+func GetDependentOwnerReferences(app *v1beta2.SparkApplication) []metav1.OwnerReference {
+	if IsOrphaningDependents(app) {
+		return nil
+	}
+	return []metav1.OwnerReference{GetOwnerReference(app)}
+}
+
+// IsOrphaningDependents returns whether the executor PVCs, Services and ConfigMaps of the given app are left
+// behind when it is deleted.
+func IsOrphaningDependents(app *v1beta2.SparkApplication) bool {
+	return ptr.Deref(app.Spec.CascadeDeletePolicy, v1beta2.CascadeDeletePolicyCascade) == v1beta2.CascadeDeletePolicyOrphan
+}
+
 // GetDriverState returns the driver state from the given driver pod.
 func GetDriverState(pod *corev1.Pod) v1beta2.DriverState {
 	switch pod.Status.Phase {
@@ -320,6 +690,13 @@ func GetExecutorState(pod *corev1.Pod) v1beta2.ExecutorState {
 	case corev1.PodPending:
 		return v1beta2.ExecutorStatePending
 	case corev1.PodRunning:
+		// Sidecar containers may keep the pod running after the executor container terminated.
+		if state := GetExecutorContainerTerminatedState(pod); state != nil {
+			if state.ExitCode == 0 {
+				return v1beta2.ExecutorStateCompleted
+			}
+			return v1beta2.ExecutorStateFailed
+		}
 		return v1beta2.ExecutorStateRunning
 	case corev1.PodSucceeded:
 		return v1beta2.ExecutorStateCompleted
@@ -367,6 +744,60 @@ func IsExecutorTerminated(executorState v1beta2.ExecutorState) bool {
 	return executorState == v1beta2.ExecutorStateCompleted || executorState == v1beta2.ExecutorStateFailed
 }
 
+// GetApplicationHealth maps the given application state to the health reported in the SparkApplication status.
+func GetApplicationHealth(state v1beta2.ApplicationStateType) v1beta2.ApplicationHealth {
+	switch state {
+	case v1beta2.ApplicationStateRunning, v1beta2.ApplicationStateCompleted, v1beta2.ApplicationStateSuspended:
+		return v1beta2.ApplicationHealthHealthy
+	case v1beta2.ApplicationStateFailed, v1beta2.ApplicationStateFailedSubmission, v1beta2.ApplicationStatePreflightFailed,
+		v1beta2.ApplicationStateFailing, v1beta2.ApplicationStateUnknown:
+		return v1beta2.ApplicationHealthDegraded
+	default:
+		return v1beta2.ApplicationHealthProgressing
+	}
+}
+
+// GetApplicationPhase returns the phase reported in the status of the given SparkApplication. It is derived from
+// the Completed, Failed and DriverReady conditions, which must be up to date with the application state, so that
+// the phase never contradicts them. Failures, including failed submissions and pre-flight checks, are only reported
+// once the application will not be retried.
+func GetApplicationPhase(app *v1beta2.SparkApplication) v1beta2.ApplicationPhase {
+	conditions := app.Status.Conditions
+	switch {
+	case meta.IsStatusConditionTrue(conditions, v1beta2.SparkApplicationConditionCompleted):
+		return v1beta2.ApplicationPhaseSucceeded
+	case meta.IsStatusConditionTrue(conditions, v1beta2.SparkApplicationConditionFailed):
+		if ShouldRetry(app) {
+			return v1beta2.ApplicationPhasePending
+		}
+		return v1beta2.ApplicationPhaseFailed
+	case meta.IsStatusConditionTrue(conditions, v1beta2.SparkApplicationConditionDriverReady):
+		return v1beta2.ApplicationPhaseRunning
+	}
+
+	// The driver is still terminating, or its state is unknown.
+	switch app.Status.AppState.State {
+	case v1beta2.ApplicationStateSucceeding, v1beta2.ApplicationStateFailing, v1beta2.ApplicationStateUnknown:
+		return v1beta2.ApplicationPhaseRunning
+	default:
+		return v1beta2.ApplicationPhasePending
+	}
+}
+
+// GetNodeEvictionReason returns why the given node is about to be drained or reclaimed,
+// or an empty string if the node is not being evicted.
+func GetNodeEvictionReason(node *corev1.Node) string {
+	if node.Spec.Unschedulable {
+		return "NodeCordoned"
+	}
+	for _, taint := range node.Spec.Taints {
+		if slices.Contains(common.NodeEvictionTaintKeys, taint.Key) {
+			return fmt.Sprintf("NodeTainted(%s)", taint.Key)
+		}
+	}
+	return ""
+}
+
 // DriverStateToApplicationState converts driver state to application state.
 func DriverStateToApplicationState(driverState v1beta2.DriverState) v1beta2.ApplicationStateType {
 	switch driverState {
@@ -507,3 +938,11 @@ func IsDynamicAllocationEnabled(app *v1beta2.SparkApplication) bool {
 	dynamicAllocationConfVal, _ := strconv.ParseBool(app.Spec.SparkConf[common.SparkDynamicAllocationEnabled])
 	return dynamicAllocationConfVal
 }
+
+// GetEnvSecretRefEnvName returns the name of the environment variable populated by the given EnvSecretRef.
+func GetEnvSecretRefEnvName(ref v1beta2.EnvSecretRef) string {
+	if ref.EnvName != nil && *ref.EnvName != "" {
+		return *ref.EnvName
+	}
+	return ref.Key
+}
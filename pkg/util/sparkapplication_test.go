@@ -328,6 +328,111 @@ var _ = Describe("GetDefaultUIIngressName", func() {
 	})
 })
 
+var _ = Describe("GetExecutorPodDisruptionBudgetName", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "test-namespace",
+		},
+	}
+
+	It("Should return the executor PodDisruptionBudget name", func() {
+		Expect(util.GetExecutorPodDisruptionBudgetName(app)).To(Equal("test-app-executor-pdb"))
+	})
+
+	appWithLongName := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app-with-a-long-name-that-would-be-over-63-characters",
+			Namespace: "test-namespace",
+		},
+	}
+
+	It("Should truncate the app name so the PodDisruptionBudget name is below 63 characters", func() {
+		pdbName := util.GetExecutorPodDisruptionBudgetName(appWithLongName)
+		Expect(len(pdbName)).To(BeNumerically("<=", 63))
+		Expect(pdbName).To(HavePrefix(appWithLongName.Name[:41]))
+		Expect(pdbName).To(HaveSuffix("-executor-pdb"))
+	})
+})
+
+var _ = Describe("GetNetworkPolicyName", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "test-namespace",
+		},
+	}
+
+	It("Should return the NetworkPolicy name", func() {
+		Expect(util.GetNetworkPolicyName(app)).To(Equal("test-app-network-policy"))
+	})
+})
+
+var _ = Describe("GetServiceAccountName", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-app",
+			Namespace: "test-namespace",
+		},
+	}
+
+	It("Should return the given service account", func() {
+		Expect(util.GetServiceAccountName(app, ptr.To("spark"))).To(Equal("spark"))
+		Expect(util.GetServiceAccountName(app, nil)).To(BeEmpty())
+		Expect(util.UseAutoServiceAccount(app)).To(BeFalse())
+	})
+
+	It("Should return the service account provisioned by the operator if set to auto", func() {
+		withAuto := app.DeepCopy()
+		withAuto.Spec.Executor.ServiceAccount = ptr.To(common.ServiceAccountAuto)
+		Expect(util.GetServiceAccountName(withAuto, withAuto.Spec.Executor.ServiceAccount)).To(Equal("test-app-spark"))
+		Expect(util.UseAutoServiceAccount(withAuto)).To(BeTrue())
+	})
+})
+
+var _ = Describe("GetAWSRoleARN", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "test-namespace"},
+	}
+
+	It("Should return no role if none is set", func() {
+		Expect(util.GetAWSRoleARN(app, &app.Spec.Driver.SparkPodSpec)).To(BeEmpty())
+		Expect(util.UseAWSWebIdentity(app)).To(BeFalse())
+	})
+
+	It("Should prefer the role of the pod to the role of the S3 storage", func() {
+		withRoles := app.DeepCopy()
+		withRoles.Spec.CloudStorage = &v1beta2.CloudStorageSpec{S3: &v1beta2.S3StorageSpec{RoleARN: ptr.To("arn:aws:iam::123456789012:role/spark")}}
+		withRoles.Spec.Driver.IAMRoleARN = ptr.To("arn:aws:iam::123456789012:role/driver")
+		Expect(util.GetAWSRoleARN(withRoles, &withRoles.Spec.Driver.SparkPodSpec)).To(Equal("arn:aws:iam::123456789012:role/driver"))
+		Expect(util.GetAWSRoleARN(withRoles, &withRoles.Spec.Executor.SparkPodSpec)).To(Equal("arn:aws:iam::123456789012:role/spark"))
+		Expect(util.UseAWSWebIdentity(withRoles)).To(BeTrue())
+	})
+})
+
+var _ = Describe("GetGCPProjectID", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", Namespace: "test-namespace"},
+	}
+
+	It("Should not use Cloud Storage if not configured", func() {
+		Expect(util.UseGCS(app)).To(BeFalse())
+		Expect(util.GetGCPProjectID(app)).To(BeEmpty())
+	})
+
+	It("Should default to the project of the Google service account", func() {
+		withGCP := app.DeepCopy()
+		withGCP.Spec.GCP = &v1beta2.GCPSpec{
+			WorkloadIdentity: &v1beta2.GCPWorkloadIdentity{ServiceAccount: "spark@my-project.iam.gserviceaccount.com"},
+		}
+		Expect(util.UseGCS(withGCP)).To(BeTrue())
+		Expect(util.GetGCPProjectID(withGCP)).To(Equal("my-project"))
+
+		withGCP.Spec.CloudStorage = &v1beta2.CloudStorageSpec{GCS: &v1beta2.GCSStorageSpec{ProjectID: ptr.To("data-project")}}
+		Expect(util.GetGCPProjectID(withGCP)).To(Equal("data-project"))
+	})
+})
+
 var _ = Describe("IsDriverTerminated", func() {
 	It("Should check whether driver is terminated", func() {
 		Expect(util.IsDriverTerminated(v1beta2.DriverStatePending)).To(BeFalse())
@@ -358,6 +463,192 @@ var _ = Describe("DriverStateToApplicationState", func() {
 	})
 })
 
+var _ = Describe("GetApplicationHealth", func() {
+	It("Should map application state to health correctly", func() {
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateNew)).To(Equal(v1beta2.ApplicationHealthProgressing))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateSubmitted)).To(Equal(v1beta2.ApplicationHealthProgressing))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateRunning)).To(Equal(v1beta2.ApplicationHealthHealthy))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateSucceeding)).To(Equal(v1beta2.ApplicationHealthProgressing))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateCompleted)).To(Equal(v1beta2.ApplicationHealthHealthy))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateSuspended)).To(Equal(v1beta2.ApplicationHealthHealthy))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateFailing)).To(Equal(v1beta2.ApplicationHealthDegraded))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateFailed)).To(Equal(v1beta2.ApplicationHealthDegraded))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateFailedSubmission)).To(Equal(v1beta2.ApplicationHealthDegraded))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStatePreflightFailed)).To(Equal(v1beta2.ApplicationHealthDegraded))
+		Expect(util.GetApplicationHealth(v1beta2.ApplicationStateUnknown)).To(Equal(v1beta2.ApplicationHealthDegraded))
+	})
+})
+
+var _ = Describe("GetApplicationPhase", func() {
+	// newApp returns a SparkApplication in the given state with the given conditions set to true.
+	newApp := func(state v1beta2.ApplicationStateType, restartPolicy v1beta2.RestartPolicy, conditions ...string) *v1beta2.SparkApplication {
+		app := &v1beta2.SparkApplication{
+			Spec:   v1beta2.SparkApplicationSpec{RestartPolicy: restartPolicy},
+			Status: v1beta2.SparkApplicationStatus{AppState: v1beta2.ApplicationState{State: state}},
+		}
+		for _, condition := range conditions {
+			app.Status.Conditions = append(app.Status.Conditions, metav1.Condition{Type: condition, Status: metav1.ConditionTrue})
+		}
+		return app
+	}
+	never := v1beta2.RestartPolicy{Type: v1beta2.RestartPolicyNever}
+	onSubmissionFailure := v1beta2.RestartPolicy{Type: v1beta2.RestartPolicyOnFailure, OnSubmissionFailureRetries: ptr.To[int32](3)}
+
+	DescribeTable("Should derive the phase from the conditions and state of the application",
+		func(app *v1beta2.SparkApplication, expected v1beta2.ApplicationPhase) {
+			Expect(util.GetApplicationPhase(app)).To(Equal(expected))
+		},
+		Entry("new", newApp(v1beta2.ApplicationStateNew, never), v1beta2.ApplicationPhasePending),
+		Entry("submitted", newApp(v1beta2.ApplicationStateSubmitted, never, v1beta2.SparkApplicationConditionSubmitted), v1beta2.ApplicationPhasePending),
+		Entry("pending rerun", newApp(v1beta2.ApplicationStatePendingRerun, never), v1beta2.ApplicationPhasePending),
+		Entry("suspended", newApp(v1beta2.ApplicationStateSuspended, never), v1beta2.ApplicationPhasePending),
+		Entry("running", newApp(v1beta2.ApplicationStateRunning, never, v1beta2.SparkApplicationConditionDriverReady), v1beta2.ApplicationPhaseRunning),
+		Entry("succeeding", newApp(v1beta2.ApplicationStateSucceeding, never), v1beta2.ApplicationPhaseRunning),
+		Entry("failing", newApp(v1beta2.ApplicationStateFailing, never), v1beta2.ApplicationPhaseRunning),
+		Entry("unknown", newApp(v1beta2.ApplicationStateUnknown, never), v1beta2.ApplicationPhaseRunning),
+		Entry("completed", newApp(v1beta2.ApplicationStateCompleted, never, v1beta2.SparkApplicationConditionCompleted), v1beta2.ApplicationPhaseSucceeded),
+		Entry("failed", newApp(v1beta2.ApplicationStateFailed, never, v1beta2.SparkApplicationConditionFailed), v1beta2.ApplicationPhaseFailed),
+		Entry("failed submission without retries",
+			newApp(v1beta2.ApplicationStateFailedSubmission, never, v1beta2.SparkApplicationConditionFailed), v1beta2.ApplicationPhaseFailed),
+		Entry("failed pre-flight checks without retries",
+			newApp(v1beta2.ApplicationStatePreflightFailed, never, v1beta2.SparkApplicationConditionFailed), v1beta2.ApplicationPhaseFailed),
+		Entry("failed submission to be retried",
+			newApp(v1beta2.ApplicationStateFailedSubmission, onSubmissionFailure, v1beta2.SparkApplicationConditionFailed), v1beta2.ApplicationPhasePending),
+		Entry("failed pre-flight checks to be retried",
+			newApp(v1beta2.ApplicationStatePreflightFailed, onSubmissionFailure, v1beta2.SparkApplicationConditionFailed), v1beta2.ApplicationPhasePending),
+	)
+})
+
+var _ = Describe("GetSchedulingProfile", func() {
+	app := &v1beta2.SparkApplication{
+		Spec: v1beta2.SparkApplicationSpec{
+			SchedulingProfiles: []v1beta2.SchedulingProfile{{Name: "spot"}, {Name: "on-demand"}},
+		},
+	}
+
+	It("Should return the first profile before falling back", func() {
+		Expect(util.GetSchedulingProfile(app).Name).To(Equal("spot"))
+	})
+
+	It("Should return the profile recorded in the status", func() {
+		app := app.DeepCopy()
+		app.Status.SchedulingProfile = "on-demand"
+		Expect(util.GetSchedulingProfile(app).Name).To(Equal("on-demand"))
+	})
+
+	It("Should return nil without profiles", func() {
+		Expect(util.GetSchedulingProfile(&v1beta2.SparkApplication{})).To(BeNil())
+	})
+})
+
+var _ = Describe("GetDependentOwnerReferences", func() {
+	app := &v1beta2.SparkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-app", UID: "test-uid"},
+	}
+
+	It("Should make the application own its dependents by default", func() {
+		Expect(util.GetDependentOwnerReferences(app)).To(Equal([]metav1.OwnerReference{util.GetOwnerReference(app)}))
+		Expect(util.GetDependentResourceLabels(app)).NotTo(HaveKey(common.LabelOrphaned))
+	})
+
+	It("Should orphan and label dependents with the Orphan policy", func() {
+		app := app.DeepCopy()
+		app.Spec.CascadeDeletePolicy = ptr.To(v1beta2.CascadeDeletePolicyOrphan)
+		Expect(util.GetDependentOwnerReferences(app)).To(BeEmpty())
+		Expect(util.GetDependentResourceLabels(app)).To(HaveKeyWithValue(common.LabelOrphaned, "true"))
+		Expect(util.GetDependentResourceLabels(app)).To(HaveKeyWithValue(common.LabelSparkAppName, "test-app"))
+	})
+})
+
+var _ = Describe("GetNodeEvictionReason", func() {
+	It("Should return an empty reason for schedulable nodes without eviction taints", func() {
+		node := &corev1.Node{
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{{Key: "dedicated", Value: "spark", Effect: corev1.TaintEffectNoSchedule}},
+			},
+		}
+		Expect(util.GetNodeEvictionReason(node)).To(BeEmpty())
+	})
+
+	It("Should detect cordoned nodes", func() {
+		node := &corev1.Node{Spec: corev1.NodeSpec{Unschedulable: true}}
+		Expect(util.GetNodeEvictionReason(node)).To(Equal("NodeCordoned"))
+	})
+
+	It("Should detect nodes tainted for termination", func() {
+		node := &corev1.Node{
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{{Key: "aws-node-termination-handler/spot-itn", Effect: corev1.TaintEffectNoSchedule}},
+			},
+		}
+		Expect(util.GetNodeEvictionReason(node)).To(Equal("NodeTainted(aws-node-termination-handler/spot-itn)"))
+	})
+})
+
+var _ = Describe("GetStreamingCheckpointVolume", func() {
+	newApp := func(location string) *v1beta2.SparkApplication {
+		return &v1beta2.SparkApplication{
+			Spec: v1beta2.SparkApplicationSpec{
+				Streaming: &v1beta2.StreamingSpec{CheckpointLocation: &location},
+				Volumes: []corev1.Volume{
+					{Name: "data"},
+					{Name: "checkpoints"},
+				},
+				Driver: v1beta2.DriverSpec{
+					SparkPodSpec: v1beta2.SparkPodSpec{
+						VolumeMounts: []corev1.VolumeMount{
+							{Name: "data", MountPath: "/data"},
+							{Name: "checkpoints", MountPath: "/data/checkpoints"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("Should return nil for remote checkpoint locations", func() {
+		Expect(util.GetStreamingCheckpointVolume(newApp("gs://bucket/data/checkpoints"))).To(BeNil())
+	})
+
+	It("Should return nil for locations outside of volume mounts", func() {
+		Expect(util.GetStreamingCheckpointVolume(newApp("/database"))).To(BeNil())
+	})
+
+	It("Should return the deepest volume containing the location", func() {
+		Expect(util.GetStreamingCheckpointVolume(newApp("file:///data/checkpoints/query")).Name).To(Equal("checkpoints"))
+		Expect(util.GetStreamingCheckpointVolume(newApp("/data/other")).Name).To(Equal("data"))
+	})
+})
+
+var _ = Describe("IsSparkUIEnabled", func() {
+	It("Should fall back to the operator default", func() {
+		app := &v1beta2.SparkApplication{}
+		Expect(util.IsSparkUIEnabled(app, true)).To(BeTrue())
+		Expect(util.IsSparkUIEnabled(app, false)).To(BeFalse())
+	})
+
+	It("Should honor spark.ui.enabled in the Spark configuration", func() {
+		app := &v1beta2.SparkApplication{
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConf: map[string]string{common.SparkUIEnabled: "true"},
+			},
+		}
+		Expect(util.IsSparkUIEnabled(app, false)).To(BeTrue())
+	})
+
+	It("Should give precedence to the driver UI setting", func() {
+		app := &v1beta2.SparkApplication{
+			Spec: v1beta2.SparkApplicationSpec{
+				SparkConf: map[string]string{common.SparkUIEnabled: "true"},
+				Driver: v1beta2.DriverSpec{
+					UI: &v1beta2.DriverUISpec{Enabled: ptr.To(false)},
+				},
+			},
+		}
+		Expect(util.IsSparkUIEnabled(app, true)).To(BeFalse())
+	})
+})
+
 var _ = Describe("Check if IsDynamicAllocationEnabled", func() {
 	Context("when app.Spec.DynamicAllocation is True", func() {
 		app := &v1beta2.SparkApplication{
@@ -423,3 +714,27 @@ var _ = Describe("Check if IsDynamicAllocationEnabled", func() {
 		})
 	})
 })
+
+var _ = Describe("GetExecutorState", func() {
+	newPod := func(phase corev1.PodPhase, containerStatuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{Phase: phase, ContainerStatuses: containerStatuses}}
+	}
+	terminated := func(exitCode int32) corev1.ContainerStatus {
+		return corev1.ContainerStatus{
+			Name:  common.Spark3DefaultExecutorContainerName,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}},
+		}
+	}
+
+	It("Should return running for a running pod", func() {
+		Expect(util.GetExecutorState(newPod(corev1.PodRunning))).To(Equal(v1beta2.ExecutorStateRunning))
+	})
+
+	It("Should return completed for a running pod whose executor container succeeded", func() {
+		Expect(util.GetExecutorState(newPod(corev1.PodRunning, terminated(0)))).To(Equal(v1beta2.ExecutorStateCompleted))
+	})
+
+	It("Should return failed for a running pod whose executor container failed", func() {
+		Expect(util.GetExecutorState(newPod(corev1.PodRunning, terminated(1)))).To(Equal(v1beta2.ExecutorStateFailed))
+	})
+})
@@ -17,6 +17,9 @@ limitations under the License.
 package util
 
 import (
+	"slices"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kubeflow/spark-operator/v2/pkg/common"
@@ -65,3 +68,25 @@ func IsPodReady(pod *corev1.Pod) bool {
 	}
 	return false
 }
+
+// GetImagePullFailure returns the status of the first container of the given pod that is waiting because
+// its image cannot be pulled, or nil if there is none.
+func GetImagePullFailure(pod *corev1.Pod) *corev1.ContainerStatus {
+	statuses := append(slices.Clone(pod.Status.InitContainerStatuses), pod.Status.ContainerStatuses...)
+	for i := range statuses {
+		waiting := statuses[i].State.Waiting
+		if waiting != nil && slices.Contains(common.ImagePullFailureReasons, waiting.Reason) {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// GetImageRegistry returns the registry host of the given container image reference.
+func GetImageRegistry(image string) string {
+	first, _, found := strings.Cut(image, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		return first
+	}
+	return common.DefaultImageRegistry
+}
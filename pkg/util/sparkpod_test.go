@@ -347,3 +347,42 @@ var _ = Describe("GetSparkExecutorID", func() {
 		})
 	})
 })
+
+var _ = Describe("GetImagePullFailure", func() {
+	It("Should return nil if no container fails to pull its image", func() {
+		pod := &corev1.Pod{
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "spark-kubernetes-executor",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+				}},
+			},
+		}
+		Expect(util.GetImagePullFailure(pod)).To(BeNil())
+	})
+
+	It("Should return the init container failing to pull its image", func() {
+		pod := &corev1.Pod{
+			Status: corev1.PodStatus{
+				InitContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "init",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+				}},
+			},
+		}
+		Expect(util.GetImagePullFailure(pod).Name).To(Equal("init"))
+	})
+})
+
+var _ = Describe("GetImageRegistry", func() {
+	It("Should return the registry of fully qualified images", func() {
+		Expect(util.GetImageRegistry("quay.io/spark/spark:4.0.0")).To(Equal("quay.io"))
+		Expect(util.GetImageRegistry("localhost:5000/spark")).To(Equal("localhost:5000"))
+		Expect(util.GetImageRegistry("localhost/spark")).To(Equal("localhost"))
+	})
+
+	It("Should return the default registry for short image names", func() {
+		Expect(util.GetImageRegistry("spark:4.0.0")).To(Equal("docker.io"))
+		Expect(util.GetImageRegistry("apache/spark:4.0.0")).To(Equal("docker.io"))
+	})
+})
@@ -0,0 +1,104 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+// SparkFeature is a Spark capability relied upon by the operator whose availability depends on the Spark version.
+type SparkFeature string
+
+const (
+	SparkFeaturePodTemplate                      SparkFeature = "pod template"
+	SparkFeatureDynamicAllocationShuffleTracking SparkFeature = "dynamic allocation shuffle tracking"
+	SparkFeaturePlugins                          SparkFeature = "Spark plugins"
+	SparkFeatureDecommission                     SparkFeature = "executor decommissioning"
+	SparkFeatureOnDemandPVC                      SparkFeature = "on-demand executor PVCs"
+	SparkFeaturePVCReuse                         SparkFeature = "executor PVC reuse"
+	SparkFeaturePVCWaitToReuse                   SparkFeature = "waiting for reusable executor PVCs"
+	SparkFeatureConnect                          SparkFeature = "Spark Connect"
+	SparkFeatureStructuredLogging                SparkFeature = "structured logging"
+	SparkFeatureAWSSDKV2                         SparkFeature = "S3A connector built on the AWS SDK v2"
+)
+
+// sparkVersionMatrix lists the Spark release lines known to the operator, oldest first, together with the
+// features the operator relies on that were introduced in each of them.
+var sparkVersionMatrix = []struct {
+	version  string
+	features []SparkFeature
+}{
+	{version: "3.0.0", features: []SparkFeature{SparkFeaturePodTemplate, SparkFeatureDynamicAllocationShuffleTracking, SparkFeaturePlugins}},
+	{version: "3.1.0", features: []SparkFeature{SparkFeatureDecommission, SparkFeatureOnDemandPVC}},
+	{version: "3.2.0", features: []SparkFeature{SparkFeaturePVCReuse}},
+	{version: "3.3.0"},
+	{version: "3.4.0", features: []SparkFeature{SparkFeaturePVCWaitToReuse, SparkFeatureConnect}},
+	{version: "3.5.0"},
+	{version: "4.0.0", features: []SparkFeature{SparkFeatureStructuredLogging, SparkFeatureAWSSDKV2}},
+	{version: "4.1.0"},
+}
+
+const (
+	// MinSupportedSparkVersion is the oldest Spark version supported by the operator.
+	MinSupportedSparkVersion = "3.3.0"
+
+	// MaxSupportedSparkMajorVersion is the newest Spark major version supported by the operator.
+	MaxSupportedSparkMajorVersion = "4"
+)
+
+// GetSparkFeatureMinVersion returns the first Spark version supporting the given feature.
+func GetSparkFeatureMinVersion(feature SparkFeature) string {
+	for _, line := range sparkVersionMatrix {
+		for _, f := range line.features {
+			if f == feature {
+				return line.version
+			}
+		}
+	}
+	return ""
+}
+
+// GetSparkFeatureMinVersions returns the first Spark version supporting each of the known features.
+func GetSparkFeatureMinVersions() map[SparkFeature]string {
+	versions := make(map[SparkFeature]string)
+	for _, line := range sparkVersionMatrix {
+		for _, f := range line.features {
+			versions[f] = line.version
+		}
+	}
+	return versions
+}
+
+// SparkVersionSupports returns whether the given Spark version supports the given feature.
+func SparkVersionSupports(version string, feature SparkFeature) bool {
+	minVersion := GetSparkFeatureMinVersion(feature)
+	return minVersion != "" && CompareSemanticVersion(version, minVersion) >= 0
+}
+
+// IsSupportedSparkVersion returns whether the given Spark version is within the range supported by the operator.
+func IsSupportedSparkVersion(version string) bool {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if !semver.IsValid(version) {
+		return false
+	}
+	return CompareSemanticVersion(version, MinSupportedSparkVersion) >= 0 &&
+		semver.Compare(semver.Major(version), "v"+MaxSupportedSparkMajorVersion) <= 0
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubeflow/spark-operator/v2/pkg/util"
+)
+
+var _ = Describe("SparkVersionSupports", func() {
+	It("Should return the version a feature was introduced in", func() {
+		Expect(util.GetSparkFeatureMinVersion(util.SparkFeatureDecommission)).To(Equal("3.1.0"))
+		Expect(util.GetSparkFeatureMinVersion(util.SparkFeatureConnect)).To(Equal("3.4.0"))
+		Expect(util.GetSparkFeatureMinVersion(util.SparkFeature("unknown"))).To(BeEmpty())
+	})
+
+	It("Should return the version each feature was introduced in", func() {
+		versions := util.GetSparkFeatureMinVersions()
+		Expect(versions).To(HaveKeyWithValue(util.SparkFeaturePodTemplate, "3.0.0"))
+		Expect(versions).To(HaveKeyWithValue(util.SparkFeatureStructuredLogging, "4.0.0"))
+		Expect(versions).To(HaveKeyWithValue(util.SparkFeatureAWSSDKV2, "4.0.0"))
+	})
+
+	It("Should support features introduced in or before the given version", func() {
+		Expect(util.SparkVersionSupports("3.4.1", util.SparkFeatureConnect)).To(BeTrue())
+		Expect(util.SparkVersionSupports("4.0.0", util.SparkFeatureConnect)).To(BeTrue())
+		Expect(util.SparkVersionSupports("4.0.0", util.SparkFeatureStructuredLogging)).To(BeTrue())
+	})
+
+	It("Should not support features introduced after the given version", func() {
+		Expect(util.SparkVersionSupports("3.3.2", util.SparkFeatureConnect)).To(BeFalse())
+		Expect(util.SparkVersionSupports("3.5.3", util.SparkFeatureStructuredLogging)).To(BeFalse())
+		Expect(util.SparkVersionSupports("", util.SparkFeaturePodTemplate)).To(BeFalse())
+	})
+})
+
+var _ = Describe("IsSupportedSparkVersion", func() {
+	It("Should accept versions from 3.3 to 4.x", func() {
+		Expect(util.IsSupportedSparkVersion("3.3.0")).To(BeTrue())
+		Expect(util.IsSupportedSparkVersion("3.5.3")).To(BeTrue())
+		Expect(util.IsSupportedSparkVersion("4.1.0")).To(BeTrue())
+	})
+
+	It("Should reject versions outside of the supported range", func() {
+		Expect(util.IsSupportedSparkVersion("3.2.4")).To(BeFalse())
+		Expect(util.IsSupportedSparkVersion("5.0.0")).To(BeFalse())
+		Expect(util.IsSupportedSparkVersion("latest")).To(BeFalse())
+	})
+})
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/pkg/common"
+)
+
+// IsVaultSecret returns whether the given secret is injected from Vault with the given injector.
+func IsVaultSecret(secret v1beta2.SecretInfo, injector v1beta2.VaultInjector) bool {
+	if secret.Type != v1beta2.SecretTypeVault || secret.Vault == nil {
+		return false
+	}
+	if secret.Vault.Injector == "" {
+		return injector == v1beta2.VaultInjectorAgent
+	}
+	return secret.Vault.Injector == injector
+}
+
+// GetVaultSecretFileName returns the name of the file the given Vault secret is rendered to by the Vault Agent.
+func GetVaultSecretFileName(secret v1beta2.SecretInfo) string {
+	if secret.Vault != nil && secret.Vault.FileName != "" {
+		return secret.Vault.FileName
+	}
+	return path.Base(secret.Name)
+}
+
+// GetVaultAgentAnnotations returns the pod annotations making the Vault Agent Injector render the given secrets
+// injected with it, or nil if there is none.
+func GetVaultAgentAnnotations(secrets []v1beta2.SecretInfo) map[string]string {
+	var annotations map[string]string
+	for _, secret := range secrets {
+		if !IsVaultSecret(secret, v1beta2.VaultInjectorAgent) {
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{
+				common.VaultAnnotationAgentInject:          "true",
+				common.VaultAnnotationAgentPrePopulateOnly: "true",
+			}
+		}
+		if secret.Vault.Role != "" {
+			annotations[common.VaultAnnotationRole] = secret.Vault.Role
+		}
+		file := GetVaultSecretFileName(secret)
+		annotations[fmt.Sprintf(common.VaultAnnotationAgentInjectSecretTemplate, file)] = secret.Name
+		annotations[fmt.Sprintf(common.VaultAnnotationSecretVolumePathTemplate, file)] = secret.Path
+		if secret.Vault.Template != "" {
+			annotations[fmt.Sprintf(common.VaultAnnotationAgentInjectTemplateTemplate, file)] = secret.Vault.Template
+		}
+	}
+	return annotations
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// TimeWindow is a recurring period of time.
+type TimeWindow struct {
+	// Schedule is the cron schedule the window starts on.
+	Schedule cron.Schedule
+	// Duration is how long the window lasts.
+	Duration time.Duration
+}
+
+// ParseTimeWindow parses a time window in the format `<cron schedule>;<duration>`, e.g. `0 2 * * SAT;4h` for every
+// Saturday from 02:00 to 06:00. The schedule may start with `CRON_TZ=<timezone>` and defaults to the local time
+// of the operator otherwise.
+func ParseTimeWindow(s string) (TimeWindow, error) {
+	spec, duration, ok := strings.Cut(s, ";")
+	if !ok {
+		return TimeWindow{}, fmt.Errorf("must be in the format <cron schedule>;<duration>")
+	}
+
+	schedule, err := cron.ParseStandard(strings.TrimSpace(spec))
+	if err != nil {
+		return TimeWindow{}, fmt.Errorf("invalid schedule: %v", err)
+	}
+
+	d, err := time.ParseDuration(strings.TrimSpace(duration))
+	if err != nil {
+		return TimeWindow{}, fmt.Errorf("invalid duration: %v", err)
+	}
+	if d <= 0 {
+		return TimeWindow{}, fmt.Errorf("invalid duration: must be positive")
+	}
+
+	return TimeWindow{Schedule: schedule, Duration: d}, nil
+}
+
+// ActiveUntil returns the end of the occurrence of the window that is active at the given time, if any.
+func (w TimeWindow) ActiveUntil(now time.Time) (time.Time, bool) {
+	// The latest occurrence that may still be active is the first one starting after now minus the duration.
+	start := w.Schedule.Next(now.Add(-w.Duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+	return start.Add(w.Duration), true
+}
+
+// GetTimeWindowEnd returns when the windows active at the given time end, if any.
+// Windows starting when the returned one ends are only accounted for once it has ended.
+func GetTimeWindowEnd(windows []TimeWindow, now time.Time) (time.Time, bool) {
+	var end time.Time
+	for _, w := range windows {
+		if until, ok := w.ActiveUntil(now); ok && until.After(end) {
+			end = until
+		}
+	}
+	return end, !end.IsZero()
+}
+
+// GetNextTimeWindowStart returns when the first of the windows starts after the given time.
+func GetNextTimeWindowStart(windows []TimeWindow, now time.Time) time.Time {
+	var start time.Time
+	for _, w := range windows {
+		if next := w.Schedule.Next(now); !next.IsZero() && (start.IsZero() || next.Before(start)) {
+			start = next
+		}
+	}
+	return start
+}
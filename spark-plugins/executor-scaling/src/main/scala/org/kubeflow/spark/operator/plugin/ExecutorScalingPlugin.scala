@@ -0,0 +1,164 @@
+/*
+ * Copyright 2025 The Kubeflow authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package org.kubeflow.spark.operator.plugin
+
+import java.net.InetSocketAddress
+import java.nio.charset.StandardCharsets
+import java.security.MessageDigest
+import java.util.{Collections, LinkedHashSet, Map => JMap}
+
+import scala.collection.JavaConverters._
+import scala.io.Source
+
+import com.sun.net.httpserver.{HttpExchange, HttpHandler, HttpServer}
+import org.apache.spark.SparkContext
+import org.apache.spark.api.plugin.{DriverPlugin, ExecutorPlugin, PluginContext, SparkPlugin}
+import org.apache.spark.scheduler.{SparkListener, SparkListenerExecutorAdded, SparkListenerExecutorRemoved}
+import org.slf4j.LoggerFactory
+
+/**
+ * Spark plugin serving the endpoint the Spark operator calls to change the number of executors of a running
+ * application.
+ *
+ * The plugin is configured by the operator when `spec.executor.inPlaceScaling` is set on a SparkApplication, through
+ * the `spark.sparkoperator.executorScaling.*` properties and the token of the submission in the
+ * `SPARK_OPERATOR_EXECUTOR_SCALING_TOKEN` environment variable, which requests must carry as a bearer token.
+ */
+class ExecutorScalingPlugin extends SparkPlugin {
+  override def driverPlugin(): DriverPlugin = new ExecutorScalingDriverPlugin
+
+  override def executorPlugin(): ExecutorPlugin = null
+}
+
+class ExecutorScalingDriverPlugin extends DriverPlugin {
+  import ExecutorScalingDriverPlugin._
+
+  private val log = LoggerFactory.getLogger(classOf[ExecutorScalingDriverPlugin])
+
+  // The registered executors in the order they were added, the last ones being removed first when scaling down.
+  private val executors = new LinkedHashSet[String]
+
+  private var sc: SparkContext = _
+  private var path: String = _
+  private var token: Array[Byte] = _
+  private var maxExecutors: Int = _
+  private var server: HttpServer = _
+
+  override def init(sc: SparkContext, ctx: PluginContext): JMap[String, String] = {
+    this.sc = sc
+    val conf = sc.getConf
+    path = conf.get(PathKey, DefaultPath)
+    val port = conf.getInt(PortKey, DefaultPort)
+    maxExecutors = conf.getInt(MaxExecutorsKey, DefaultMaxExecutors)
+    token = sys.env.get(TokenEnv).filter(_.nonEmpty).map(_.getBytes(StandardCharsets.UTF_8)).getOrElse(
+      throw new IllegalArgumentException(s"$TokenEnv must hold the token of the executor scaling requests"))
+    // The endpoint is only served on the address of the driver pod, not on all its interfaces.
+    val host = conf.get(BindAddressKey, conf.get(HostKey))
+
+    sc.addSparkListener(new SparkListener {
+      override def onExecutorAdded(executorAdded: SparkListenerExecutorAdded): Unit =
+        executors.synchronized(executors.add(executorAdded.executorId))
+
+      override def onExecutorRemoved(executorRemoved: SparkListenerExecutorRemoved): Unit =
+        executors.synchronized(executors.remove(executorRemoved.executorId))
+    })
+
+    server = HttpServer.create(new InetSocketAddress(host, port), 0)
+    server.createContext(path, new HttpHandler {
+      override def handle(exchange: HttpExchange): Unit = serve(exchange)
+    })
+    server.start()
+    log.info(s"Serving executor scaling requests on $host:$port at $path")
+    Collections.emptyMap()
+  }
+
+  override def shutdown(): Unit = {
+    if (server != null) {
+      server.stop(0)
+    }
+  }
+
+  private def serve(exchange: HttpExchange): Unit = {
+    try {
+      val status = if (exchange.getRequestURI.getPath != path) {
+        404
+      } else if (!authorized(exchange)) {
+        401
+      } else if (exchange.getRequestMethod != "POST") {
+        405
+      } else {
+        val body = Source.fromInputStream(exchange.getRequestBody, StandardCharsets.UTF_8.name()).mkString
+        TotalExecutorsPattern.findFirstMatchIn(body) match {
+          case Some(m) if scale(m.group(1).toInt) => 200
+          case Some(_) => 503
+          case None => 400
+        }
+      }
+      exchange.sendResponseHeaders(status, -1)
+    } catch {
+      case e: Exception =>
+        log.warn("Failed to scale executors", e)
+        exchange.sendResponseHeaders(500, -1)
+    } finally {
+      exchange.close()
+    }
+  }
+
+  /** Returns whether the request carries the token of the submission, compared in constant time. */
+  private def authorized(exchange: HttpExchange): Boolean = {
+    val header = Option(exchange.getRequestHeaders.getFirst("Authorization")).getOrElse("")
+    header.startsWith(BearerPrefix) &&
+      MessageDigest.isEqual(header.stripPrefix(BearerPrefix).getBytes(StandardCharsets.UTF_8), token)
+  }
+
+  /**
+   * Scales the application to the given number of executors, capped to the maximum configured by the operator.
+   * Executors beyond it are killed, which lowers the number of executors requested from the cluster manager
+   * accordingly, before the total is requested so that executors that are pending are accounted for as well.
+   */
+  private def scale(requestedExecutors: Int): Boolean = synchronized {
+    val totalExecutors = math.min(requestedExecutors, maxExecutors)
+    if (totalExecutors < requestedExecutors) {
+      log.warn(s"Capping the $requestedExecutors requested executors to the maximum of $maxExecutors")
+    }
+    val registered = executors.synchronized(executors.asScala.toList)
+    if (registered.size > totalExecutors) {
+      val excess = registered.drop(totalExecutors)
+      log.info(s"Killing executors ${excess.mkString(",")} to scale down to $totalExecutors executors")
+      sc.killExecutors(excess)
+    }
+    log.info(s"Requesting $totalExecutors executors")
+    sc.requestTotalExecutors(totalExecutors, 0, Map.empty)
+  }
+}
+
+object ExecutorScalingDriverPlugin {
+  val PathKey = "spark.sparkoperator.executorScaling.path"
+  val PortKey = "spark.sparkoperator.executorScaling.port"
+  val MaxExecutorsKey = "spark.sparkoperator.executorScaling.maxExecutors"
+  val TokenEnv = "SPARK_OPERATOR_EXECUTOR_SCALING_TOKEN"
+
+  val DefaultPath = "/executors/scale"
+  val DefaultPort = 4045
+  val DefaultMaxExecutors = 100
+
+  private val BindAddressKey = "spark.driver.bindAddress"
+  private val HostKey = "spark.driver.host"
+  private val BearerPrefix = "Bearer "
+
+  private val TotalExecutorsPattern = """"totalExecutors"\s*:\s*(\d+)""".r
+}
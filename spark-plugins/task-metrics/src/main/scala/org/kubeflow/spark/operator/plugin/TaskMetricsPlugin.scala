@@ -0,0 +1,179 @@
+/*
+ * Copyright 2025 The Kubeflow authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     https://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package org.kubeflow.spark.operator.plugin
+
+import java.net.{HttpURLConnection, URL, URLEncoder}
+import java.nio.charset.StandardCharsets
+import java.nio.file.{Files, Paths}
+import java.util.{Collections, Map => JMap}
+import java.util.concurrent.{Executors, ScheduledExecutorService, ThreadFactory, TimeUnit}
+import java.util.concurrent.atomic.AtomicLong
+
+import org.apache.spark.SparkContext
+import org.apache.spark.api.plugin.{DriverPlugin, ExecutorPlugin, PluginContext, SparkPlugin}
+import org.apache.spark.scheduler.{SparkListener, SparkListenerTaskEnd}
+import org.slf4j.LoggerFactory
+
+/**
+ * Spark plugin reporting the task metrics of the application to the Spark operator, or to a Prometheus Pushgateway.
+ *
+ * The plugin is configured by the operator when `spec.monitoring.taskMetrics` is set on a SparkApplication, through
+ * the `spark.sparkoperator.taskMetrics.*` properties.
+ */
+class TaskMetricsPlugin extends SparkPlugin {
+  override def driverPlugin(): DriverPlugin = new TaskMetricsDriverPlugin
+
+  override def executorPlugin(): ExecutorPlugin = null
+}
+
+class TaskMetricsDriverPlugin extends DriverPlugin {
+  import TaskMetricsDriverPlugin._
+
+  private val log = LoggerFactory.getLogger(classOf[TaskMetricsDriverPlugin])
+
+  private val completedTasks = new AtomicLong
+  private val failedTasks = new AtomicLong
+  private val shuffleReadBytes = new AtomicLong
+  private val shuffleWriteBytes = new AtomicLong
+  private val memoryBytesSpilled = new AtomicLong
+  private val diskBytesSpilled = new AtomicLong
+
+  private var sink: String = _
+  private var endpoint: String = _
+  private var namespace: String = _
+  private var appName: String = _
+  private var scheduler: ScheduledExecutorService = _
+
+  override def init(sc: SparkContext, ctx: PluginContext): JMap[String, String] = {
+    val conf = sc.getConf
+    sink = conf.get(SinkKey, SinkOperator)
+    endpoint = conf.get(EndpointKey)
+    namespace = conf.get(NamespaceKey)
+    appName = conf.get(AppNameKey)
+    val interval = conf.getLong(IntervalKey, DefaultIntervalSeconds)
+
+    sc.addSparkListener(new SparkListener {
+      override def onTaskEnd(taskEnd: SparkListenerTaskEnd): Unit = {
+        if (taskEnd.taskInfo.successful) {
+          completedTasks.incrementAndGet()
+        } else if (taskEnd.taskInfo.failed) {
+          failedTasks.incrementAndGet()
+        }
+        val metrics = taskEnd.taskMetrics
+        if (metrics != null) {
+          shuffleReadBytes.addAndGet(metrics.shuffleReadMetrics.totalBytesRead)
+          shuffleWriteBytes.addAndGet(metrics.shuffleWriteMetrics.bytesWritten)
+          memoryBytesSpilled.addAndGet(metrics.memoryBytesSpilled)
+          diskBytesSpilled.addAndGet(metrics.diskBytesSpilled)
+        }
+      }
+    })
+
+    scheduler = Executors.newSingleThreadScheduledExecutor(new ThreadFactory {
+      override def newThread(r: Runnable): Thread = {
+        val thread = new Thread(r, "task-metrics-reporter")
+        thread.setDaemon(true)
+        thread
+      }
+    })
+    scheduler.scheduleWithFixedDelay(new Runnable {
+      override def run(): Unit = report()
+    }, interval, interval, TimeUnit.SECONDS)
+    Collections.emptyMap()
+  }
+
+  override def shutdown(): Unit = {
+    if (scheduler != null) {
+      scheduler.shutdownNow()
+      report()
+    }
+  }
+
+  private def report(): Unit = {
+    try {
+      val status = if (sink == SinkPushgateway) pushToPushgateway() else postToOperator()
+      if (status >= 300) {
+        log.warn(s"Failed to report task metrics to $endpoint: HTTP $status")
+      }
+    } catch {
+      case e: Exception => log.warn(s"Failed to report task metrics to $endpoint", e)
+    }
+  }
+
+  private def postToOperator(): Int = {
+    val body =
+      s"""{"namespace":${jsonString(namespace)},"appName":${jsonString(appName)},""" +
+        s""""completedTasks":${completedTasks.get},"failedTasks":${failedTasks.get},""" +
+        s""""shuffleReadBytes":${shuffleReadBytes.get},"shuffleWriteBytes":${shuffleWriteBytes.get},""" +
+        s""""memoryBytesSpilled":${memoryBytesSpilled.get},"diskBytesSpilled":${diskBytesSpilled.get}}"""
+    // The service account token is read for every report as projected tokens are rotated by the kubelet.
+    val token = new String(Files.readAllBytes(Paths.get(ServiceAccountTokenPath)), StandardCharsets.UTF_8).trim
+    send(new URL(endpoint), "POST", "application/json", Some(s"Bearer $token"), body)
+  }
+
+  private def pushToPushgateway(): Int = {
+    val body = Seq(
+      "spark_application_task_completed_count" -> completedTasks.get,
+      "spark_application_task_failed_count" -> failedTasks.get,
+      "spark_application_shuffle_read_bytes" -> shuffleReadBytes.get,
+      "spark_application_shuffle_write_bytes" -> shuffleWriteBytes.get,
+      "spark_application_memory_spilled_bytes" -> memoryBytesSpilled.get,
+      "spark_application_disk_spilled_bytes" -> diskBytesSpilled.get
+    ).map { case (name, value) => s"# TYPE $name gauge\n$name $value\n" }.mkString
+    val url = new URL(s"${endpoint.stripSuffix("/")}/metrics/job/spark_task_metrics" +
+      s"/namespace/${pathSegment(namespace)}/app_name/${pathSegment(appName)}")
+    send(url, "PUT", "text/plain; version=0.0.4", None, body)
+  }
+
+  private def send(url: URL, method: String, contentType: String, authorization: Option[String], body: String): Int = {
+    val connection = url.openConnection().asInstanceOf[HttpURLConnection]
+    try {
+      connection.setRequestMethod(method)
+      connection.setConnectTimeout(RequestTimeoutMillis)
+      connection.setReadTimeout(RequestTimeoutMillis)
+      connection.setDoOutput(true)
+      connection.setRequestProperty("Content-Type", contentType)
+      authorization.foreach(connection.setRequestProperty("Authorization", _))
+      val out = connection.getOutputStream
+      try out.write(body.getBytes(StandardCharsets.UTF_8)) finally out.close()
+      connection.getResponseCode
+    } finally {
+      connection.disconnect()
+    }
+  }
+}
+
+object TaskMetricsDriverPlugin {
+  val EndpointKey = "spark.sparkoperator.taskMetrics.endpoint"
+  val SinkKey = "spark.sparkoperator.taskMetrics.sink"
+  val IntervalKey = "spark.sparkoperator.taskMetrics.intervalSeconds"
+  val NamespaceKey = "spark.sparkoperator.taskMetrics.namespace"
+  val AppNameKey = "spark.sparkoperator.taskMetrics.appName"
+
+  val SinkOperator = "operator"
+  val SinkPushgateway = "pushgateway"
+
+  val DefaultIntervalSeconds = 30L
+  val RequestTimeoutMillis = 10000
+  val ServiceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+  private def jsonString(value: String): String =
+    "\"" + value.replace("\\", "\\\\").replace("\"", "\\\"") + "\""
+
+  private def pathSegment(value: String): String =
+    URLEncoder.encode(value, StandardCharsets.UTF_8.name()).replace("+", "%20")
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+)
+
+// argoResourceTemplate holds the parts of an Argo Workflows resource template the operator has to satisfy.
+type argoResourceTemplate struct {
+	manifest         string
+	successCondition string
+	failureCondition string
+}
+
+var _ = Describe("Argo Workflows resource template", func() {
+	Context("spark-pi-workflow", func() {
+		ctx := context.Background()
+		path := filepath.Join("..", "..", "examples", "argo", "spark-pi-workflow.yaml")
+		app := &v1beta2.SparkApplication{}
+		template := &argoResourceTemplate{}
+
+		BeforeEach(func() {
+			By("Parsing resource template from WorkflowTemplate")
+			var err error
+			template, err = parseArgoResourceTemplate(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(template.successCondition).NotTo(BeEmpty())
+			Expect(template.failureCondition).NotTo(BeEmpty())
+
+			By("Parsing SparkApplication from resource template manifest")
+			decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(template.manifest), 100)
+			Expect(decoder.Decode(app)).NotTo(HaveOccurred())
+
+			By("Creating SparkApplication")
+			Expect(k8sClient.Create(ctx, app)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			key := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+			Expect(k8sClient.Get(ctx, key, app)).To(Succeed())
+
+			By("Deleting SparkApplication")
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+		})
+
+		It("should satisfy the success condition and not the failure condition once completed", func() {
+			key := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+
+			By("Checking neither condition is satisfied before the application runs")
+			Expect(k8sClient.Get(ctx, key, app)).To(Succeed())
+			Expect(matchesArgoResourceCondition(app, template.failureCondition)).To(BeFalse())
+
+			By("Waiting for SparkApplication to complete")
+			Expect(waitForSparkApplicationCompleted(ctx, key)).NotTo(HaveOccurred())
+
+			By("Evaluating the resource template conditions against the final status")
+			Expect(k8sClient.Get(ctx, key, app)).To(Succeed())
+			Expect(app.Status.Phase).To(Equal(v1beta2.ApplicationPhaseSucceeded))
+			Expect(matchesArgoResourceCondition(app, template.successCondition)).To(BeTrue())
+			Expect(matchesArgoResourceCondition(app, template.failureCondition)).To(BeFalse())
+		})
+	})
+})
+
+// parseArgoResourceTemplate returns the first resource template of the WorkflowTemplate in the given file.
+func parseArgoResourceTemplate(path string) (*argoResourceTemplate, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	decoder := yaml.NewYAMLOrJSONDecoder(file, 100)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("no resource template found in %s", path)
+			}
+			return nil, err
+		}
+		if obj.GetKind() != "WorkflowTemplate" {
+			continue
+		}
+
+		templates, _, err := unstructured.NestedSlice(obj.Object, "spec", "templates")
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range templates {
+			resource, found, err := unstructured.NestedMap(t.(map[string]interface{}), "resource")
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				continue
+			}
+			return &argoResourceTemplate{
+				manifest:         fmt.Sprint(resource["manifest"]),
+				successCondition: fmt.Sprint(resource["successCondition"]),
+				failureCondition: fmt.Sprint(resource["failureCondition"]),
+			}, nil
+		}
+	}
+}
+
+// matchesArgoResourceCondition evaluates a resource template condition against the SparkApplication the same
+// way Argo Workflows does, i.e. as label selector requirements keyed by dotted paths into the object.
+func matchesArgoResourceCondition(app *v1beta2.SparkApplication, condition string) bool {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	Expect(err).NotTo(HaveOccurred())
+
+	requirements, err := labels.ParseToRequirements(condition)
+	Expect(err).NotTo(HaveOccurred())
+	for _, requirement := range requirements {
+		set := labels.Set{}
+		value, found, err := unstructured.NestedFieldNoCopy(obj, strings.Split(requirement.Key(), ".")...)
+		Expect(err).NotTo(HaveOccurred())
+		if found {
+			set[requirement.Key()] = fmt.Sprint(value)
+		}
+		if !requirement.Matches(set) {
+			return false
+		}
+	}
+	return true
+}
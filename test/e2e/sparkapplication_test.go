@@ -334,6 +334,7 @@ var _ = Describe("Example SparkApplication", func() {
 			Expect(final_app.Status.AppState.State).To(Equal(v1beta2.ApplicationStateFailed))
 			Expect(final_app.Status.AppState.ErrorMessage).To(ContainSubstring("driver container failed"))
 			Expect(final_app.Status.ExecutionAttempts).To(Equal(*app.Spec.RestartPolicy.OnFailureRetries + 1))
+			Expect(final_app.Status.Phase).To(Equal(v1beta2.ApplicationPhaseFailed))
 
 			By("Only valid statuses appear in other apps")
 			validStatuses := []v1beta2.ApplicationStateType{
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The Kubeflow authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/spark-operator/v2/api/v1beta2"
+	"github.com/kubeflow/spark-operator/v2/internal/runner"
+)
+
+var _ = Describe("Tekton spark-application Task", func() {
+	Context("spark-pi-pipeline", func() {
+		ctx := context.Background()
+		taskPath := filepath.Join("..", "..", "config", "tekton", "spark-application-task.yaml")
+		pipelinePath := filepath.Join("..", "..", "examples", "tekton", "spark-pi-pipeline.yaml")
+		app := &v1beta2.SparkApplication{}
+
+		BeforeEach(func() {
+			By("Parsing SparkApplication from the manifest param of the Pipeline")
+			pipeline := findManifest(pipelinePath, "Pipeline")
+			tasks, _, err := unstructured.NestedSlice(pipeline.Object, "spec", "tasks")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).NotTo(BeEmpty())
+			params, _, err := unstructured.NestedSlice(tasks[0].(map[string]interface{}), "params")
+			Expect(err).NotTo(HaveOccurred())
+
+			manifest := ""
+			for _, param := range params {
+				if param.(map[string]interface{})["name"] == "manifest" {
+					manifest = param.(map[string]interface{})["value"].(string)
+				}
+			}
+			Expect(manifest).NotTo(BeEmpty())
+			decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 100)
+			Expect(decoder.Decode(app)).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			if app.Name == "" {
+				return
+			}
+			key := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+			Expect(k8sClient.Get(ctx, key, app)).To(Succeed())
+
+			By("Deleting SparkApplication")
+			Expect(k8sClient.Delete(ctx, app)).To(Succeed())
+		})
+
+		It("should declare the results written by the run command", func() {
+			task := findManifest(taskPath, "Task")
+			results, _, err := unstructured.NestedSlice(task.Object, "spec", "results")
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, result := range results {
+				names = append(names, result.(map[string]interface{})["name"].(string))
+			}
+			Expect(names).To(ConsistOf(runner.ResultAppID, runner.ResultDuration, runner.ResultFailureReason, runner.ResultState))
+		})
+
+		It("should block until the SparkApplication completes and report its results", func() {
+			By("Running SparkApplication")
+			watchClient, err := client.NewWithWatch(cfg, client.Options{Scheme: scheme.Scheme})
+			Expect(err).NotTo(HaveOccurred())
+			runCtx, cancel := context.WithTimeout(ctx, WaitTimeout)
+			defer cancel()
+			result, err := runner.Run(runCtx, watchClient, app)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Checking the result against the final status")
+			Expect(result.Succeeded()).To(BeTrue())
+			Expect(result.Name).To(HavePrefix(app.GenerateName))
+			Expect(result.AppID).To(Equal(app.Status.SparkApplicationID))
+			Expect(result.AppID).NotTo(BeEmpty())
+			Expect(result.Duration).To(BeNumerically(">", time.Duration(0)))
+			Expect(result.FailureReason).To(BeEmpty())
+
+			By("Writing results")
+			dir := GinkgoT().TempDir()
+			Expect(result.WriteTo(dir)).To(Succeed())
+			appID, err := os.ReadFile(filepath.Join(dir, runner.ResultAppID))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(appID)).To(Equal(result.AppID))
+		})
+	})
+})
+
+// findManifest returns the first object of the given kind in the given multi-document YAML file.
+func findManifest(path string, kind string) *unstructured.Unstructured {
+	file, err := os.Open(path)
+	Expect(err).NotTo(HaveOccurred())
+	defer file.Close()
+
+	decoder := yaml.NewYAMLOrJSONDecoder(file, 100)
+	for {
+		obj := &unstructured.Unstructured{}
+		Expect(decoder.Decode(&obj.Object)).To(Succeed(), "no %s found in %s", kind, path)
+		if obj.GetKind() == kind {
+			return obj
+		}
+	}
+}
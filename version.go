@@ -22,14 +22,14 @@ import (
 )
 
 type VersionInfo struct {
-	Version      string
-	BuildDate    string
-	GitCommit    string
-	GitTag       string
-	GitTreeState string
-	GoVersion    string
-	Compiler     string
-	Platform     string
+	Version      string `json:"version"`
+	BuildDate    string `json:"buildDate"`
+	GitCommit    string `json:"gitCommit"`
+	GitTag       string `json:"gitTag,omitempty"`
+	GitTreeState string `json:"gitTreeState"`
+	GoVersion    string `json:"goVersion"`
+	Compiler     string `json:"compiler"`
+	Platform     string `json:"platform"`
 }
 
 var (
@@ -71,6 +71,11 @@ func getVersion() VersionInfo {
 	}
 }
 
+// GetVersion returns the version information of the running binary.
+func GetVersion() VersionInfo {
+	return getVersion()
+}
+
 // PrintVersion info directly by command
 func PrintVersion(short bool) {
 	v := getVersion()